@@ -23,6 +23,7 @@ import (
 
 	"github.com/ksonnet/ksonnet/pkg/actions"
 	"github.com/ksonnet/ksonnet/pkg/clicmd"
+	kslog "github.com/ksonnet/ksonnet/pkg/log"
 )
 
 // Version is overridden using `-X main.version` during release builds
@@ -45,7 +46,13 @@ func main() {
 		os.Exit(1)
 	}
 
-	if err := rootCmd.Execute(); err != nil {
+	err = rootCmd.Execute()
+
+	// Warnings are held back while a command runs so they aren't lost in its
+	// output.
+	kslog.FlushWarnings()
+
+	if err != nil {
 		// PersistentPreRunE may not have been run for early
 		// errors, like invalid command line flags.
 		logFmt := &log.TextFormatter{
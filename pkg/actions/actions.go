@@ -32,22 +32,42 @@ import (
 )
 
 const (
+	// OptionAcrossApps is acrossApps option. Used to clone an environment from the app in another directory.
+	OptionAcrossApps = "across-apps"
+	// OptionAll is all option. Used to show the version of each environment's generated ksonnet-lib.
+	OptionAll = "all"
+	// OptionAllowUnresolved is allowUnresolved option. Used to keep images whose digests can't be resolved.
+	OptionAllowUnresolved = "allow-unresolved"
 	// OptionApp is app option.
 	OptionApp = "app"
+	// OptionAppName is the name of the application. Used to select an app in repositories with several.
+	OptionAppName = "app-name"
 	// OptionAppRoot is the root directory of the application.
 	OptionAppRoot = "app-root"
+	// OptionApply is apply option. Used to make changes that are only reported otherwise.
+	OptionApply = "apply"
 	// OptionArguments is arguments option. Used for passing arguments to prototypes.
 	OptionArguments = "arguments"
 	// OptionAsString is asString. Used for setting values as strings.
 	OptionAsString = "as-string"
+	// OptionCheckReachability is checkReachability option. Used to check if environment servers are reachable.
+	OptionCheckReachability = "check-reachability"
 	// OptionClientConfig is clientConfig option.
 	OptionClientConfig = "client-config"
+	// OptionCommandLine is commandLine option. Used to record the command that created an environment.
+	OptionCommandLine = "command-line"
 	// OptionComponentName is a componentName option.
 	OptionComponentName = "component-name"
 	// OptionComponentNames is componentNames option.
 	OptionComponentNames = "component-names"
+	// OptionContext is context option. Used for the name of a kubeconfig context.
+	OptionContext = "context"
 	// OptionCreate is create option.
 	OptionCreate = "create"
+	// OptionDefaultPDB is defaultPDB option. Used for the default pod disruption budget of an environment.
+	OptionDefaultPDB = "default-pdb"
+	// OptionDefaultReplicas is defaultReplicas option. Used for the default replica count of an environment.
+	OptionDefaultReplicas = "default-replicas"
 	// OptionDryRun is dryRun option.
 	OptionDryRun = "dry-run"
 	// OptionEnvName is envName option.
@@ -56,24 +76,64 @@ const (
 	OptionEnvName1 = "env-name-1"
 	// OptionEnvName2 is envName1. Used for param diff.
 	OptionEnvName2 = "env-name-2"
+	// OptionEnvNames is envNames option. Used for commands operating on multiple environments.
+	OptionEnvNames = "env-names"
+	// OptionExcludeComponents is excludeComponents option. Used to exclude components from an environment.
+	OptionExcludeComponents = "exclude-components"
 	// OptionExtVarFiles is jsonnet ext var files.
 	OptionExtVarFiles = "ext-vars-files"
 	// OptionExtVars is jsonnet ext vars.
 	OptionExtVars = "ext-vars"
+	// OptionFailFast is failFast option. Used to stop at the first environment that can't be added.
+	OptionFailFast = "fail-fast"
+	// OptionFailOn is failOn option. Used to select the kinds of differences that cause a failure.
+	OptionFailOn = "fail-on"
+	// OptionFailOnUnmanaged is failOnUnmanaged option. Used to refuse to update objects not created by ksonnet.
+	OptionFailOnUnmanaged = "fail-on-unmanaged"
+	// OptionFilter is filter option. Used to list environments with the given tags.
+	OptionFilter = "filter"
+	// OptionFilename is filename option. Used for reading input from a file.
+	OptionFilename = "filename"
+	// OptionFilenameTemplate is filenameTemplate option. Used to name the files objects are written to.
+	OptionFilenameTemplate = "filename-template"
+	// OptionFix is fix option. Used to apply objects that drifted from their configuration.
+	OptionFix = "fix"
 	// OptionForce is force option.
 	OptionForce = "force"
 	// OptionFormat is format option.
 	OptionFormat = "format"
+	// OptionFromFile is fromFile option. Used to add the environments listed in a file.
+	OptionFromFile = "from-file"
+	// OptionFromHelmValues is fromHelmValues option. Used to seed environment params from a Helm values.yaml.
+	OptionFromHelmValues = "from-helm-values"
+	// OptionFromPatch is fromPatch option. Used to apply patches written by `ks diff --output=patch`.
+	OptionFromPatch = "from-patch"
 	// OptionFs is fs option.
 	OptionFs = "fs"
+	// OptionFullRegen is fullRegen option. Used to generate ksonnet-lib from scratch.
+	OptionFullRegen = "full-regen"
 	// OptionGcTag is gcTag option.
 	OptionGcTag = "gc-tag"
+	// OptionGenerateGitignore is generateGitignore option. Used to exclude secret files from an environment with .gitignore.
+	OptionGenerateGitignore = "generate-gitignore"
 	// OptionGlobal is global option.
 	OptionGlobal = "global"
 	// OptionGracePeriod is gracePeriod option.
 	OptionGracePeriod = "grace-period"
+	// OptionHPARange is hpaRange option. Used for the default HPA bounds of an environment.
+	OptionHPARange = "hpa-range"
 	// OptionHTTPClient is the http.Client for outbound network requests.
 	OptionHTTPClient = "http-client"
+	// OptionIgnoreAnnotation is ignoreAnnotation option. Used to annotate an environment's objects as key=value.
+	OptionIgnoreAnnotation = "ignore-annotation"
+	// OptionIgnoreObject is ignoreObject option. Used to limit an ignore annotation to objects as Kind or Kind/name.
+	OptionIgnoreObject = "ignore-object"
+	// OptionIgnoreSelector is ignoreSelector option. Used to limit an ignore annotation to objects matching a label selector.
+	OptionIgnoreSelector = "ignore-selector"
+	// OptionIncludeComponents is includeComponents option. Used to limit an environment to components.
+	OptionIncludeComponents = "include-components"
+	// OptionInherits is inherits option. Used to name the environment an environment inherits parameters from.
+	OptionInherits = "inherits"
 	// OptionInstalled is for listing installed packages.
 	OptionInstalled = "only-installed"
 	// OptionJPaths is jsonnet paths.
@@ -84,57 +144,131 @@ const (
 	OptionName = "name"
 	// OptionModule is component module option.
 	OptionModule = "module"
+	// OptionNamePrefix is namePrefix option. Used for prefixing object names in an environment.
+	OptionNamePrefix = "name-prefix"
 	// OptionNamespace is a cluster namespace option
 	OptionNamespace = "namespace"
 	// OptionNewRoot is init new root path option.
 	OptionNewRoot = "root-path"
 	// OptionNewEnvName is newEnvName option. Used for renaming environments.
 	OptionNewEnvName = "new-env-name"
+	// OptionNoCache is noCache option. Used to generate ksonnet-lib from scratch instead of reusing another version.
+	OptionNoCache = "no-cache"
+	// OptionObject is object option. Used to select a single object as Kind/name.
+	OptionObject = "object"
+	// OptionObjectParallelism is objectParallelism option. Used for the number of objects applied at once.
+	OptionObjectParallelism = "object-parallelism"
 	// OptionOutput is output option.
 	OptionOutput = "output"
+	// OptionOutputDir is outputDir option. Used to write each object to its own file in a directory.
+	OptionOutputDir = "output-dir"
 	// OptionOverride is override option.
 	OptionOverride = "override"
 	// OptionPackageName is packageName option.
 	OptionPackageName = "package-name"
+	// OptionParamDeletes is paramDeletes option. Used to remove parameters of an environment.
+	OptionParamDeletes = "param-deletes"
+	// OptionParams is params option. Used to set parameters of an environment, as <key>=<value>.
+	OptionParams = "params"
 	// OptionPath is path option.
 	OptionPath = "path"
+	// OptionPostApplyComponents is postApplyComponents option. Used for seeding components from prototypes.
+	OptionPostApplyComponents = "post-apply-components"
+	// OptionProxy is proxy option. Used to download API specs and packages through an HTTP proxy.
+	OptionProxy = "proxy"
+	// OptionPurge is purge option. Used to delete environments instead of moving them to the trash.
+	OptionPurge = "purge"
 	// OptionQuery is query option.
 	OptionQuery = "query"
+	// OptionReadOnly is readOnly option. Used to forbid commands that change clusters.
+	OptionReadOnly = "read-only"
+	// OptionRecord is record option. Used to record the provenance of a new environment.
+	OptionRecord = "record"
+	// OptionReportBreakage is reportBreakage option. Used to report components that may break with a new api spec.
+	OptionReportBreakage = "report-breakage"
+	// OptionResetMetadata is resetMetadata option. Used to regenerate ksonnet-lib for an environment.
+	OptionResetMetadata = "reset-metadata"
 	// OptionResolveImage is resolve image option. It is used to resolve docker image references
 	// when setting parameters.
 	OptionResolveImage = "resolve-image"
+	// OptionResolveImages is resolveImages option. Used to pin the images of rendered objects to digests.
+	OptionResolveImages = "resolve-images"
+	// OptionSchema is schema option. Used to describe an environment's effective configuration as JSON.
+	OptionSchema = "schema"
+	// OptionSelector is selector option. Used to limit objects to those matching a label selector.
+	OptionSelector = "selector"
 	// OptionServer is server option.
 	OptionServer = "server"
+	// OptionServerCert is serverCert option. Used to pin the certificate authority of an environment's server.
+	OptionServerCert = "server-cert"
 	// OptionServerURI is serverURI option.
 	OptionServerURI = "server-uri"
+	// OptionServiceAccount is serviceAccount option. Used to set the default service account of an environment's pods.
+	OptionServiceAccount = "service-account"
+	// OptionShowSame is showSame option. Used to print the fields two environments have in common.
+	OptionShowSame = "show-same"
 	// OptionSkipCheckUpgrade tells app not to emit upgrade warnings, probably because the user is already upgrading.
 	OptionSkipCheckUpgrade = "skip-check-upgrade"
 	// OptionSkipDefaultRegistries is skipDefaultRegistries option. Used by init.
 	OptionSkipDefaultRegistries = "skip-default-registries"
 	// OptionSkipGc is skipGc option.
 	OptionSkipGc = "skip-gc"
+	// OptionSkipLib is skipLib option. Used by env add to leave ksonnet-lib
+	// ungenerated.
+	OptionSkipLib = "skip-lib"
+	// OptionSkipNsCheck is skipNsCheck option. Used by env add to skip checking
+	// that the namespace exists on the cluster.
+	OptionSkipNsCheck = "skip-ns-check"
 	// OptionSpecFlag is specFlag option. Used for setting k8s spec.
 	OptionSpecFlag = "spec-flag"
 	// OptionSrc1 is src1 option.
 	OptionSrc1 = "src-1"
 	// OptionSrc2 is src2 option.
 	OptionSrc2 = "src-2"
+	// OptionStaleContexts is staleContexts option. Used to list environments whose kubeconfig context is stale.
+	OptionStaleContexts = "stale-contexts"
+	// OptionStrict is strict option. Used to fail instead of warning when a check doesn't pass.
+	OptionStrict = "strict"
+	// OptionSummaryOnly is summaryOnly option. Used to only count the differences between locations.
+	OptionSummaryOnly = "summary-only"
+	// OptionTags is tags option. Used to set or remove tags of an environment.
+	OptionTags = "tags"
+	// OptionThreeWay is threeWay option. Used to diff against live objects using the last applied configuration.
+	OptionThreeWay = "three-way"
+	// OptionTimeout is timeout option.
+	OptionTimeout = "timeout"
 	// OptionTlaVarFiles is jsonnet tla var files.
 	OptionTlaVarFiles = "tla-var-files"
 	// OptionTlaVars is jsonnet tla vars.
 	OptionTlaVars = "tla-vars"
 	// OptionTLSSkipVerify specifies that tls server certifactes should not be verified.
 	OptionTLSSkipVerify = "tls-skip-verify"
+	// OptionTree is tree option. Used to list environments as a tree of their names.
+	OptionTree = "tree"
+	// OptionUnreachable is unreachable option. Used to list environments whose cluster doesn't respond.
+	OptionUnreachable = "unreachable"
 	// OptionUnset is unset option.
 	OptionUnset = "unset"
+	// OptionUnsetFields is unsetFields option. Used to remove optional fields of an environment.
+	OptionUnsetFields = "unset-fields"
 	// OptionURI is uri option. Used for setting registry URI.
 	OptionURI = "URI"
+	// OptionUser is user option. Used to set the user recorded as an environment's creator.
+	OptionUser = "user"
+	// OptionWait is wait option. Used to wait for applied objects to be ready.
+	OptionWait = "wait"
+	// OptionWaitReachable is waitReachable option. Used to wait for a new server to respond before saving an environment.
+	OptionWaitReachable = "wait-reachable"
+	// OptionWithClusterVersion is withClusterVersion option. Used to show the live Kubernetes version of each environment.
+	OptionWithClusterVersion = "with-cluster-version"
 	// OptionWithoutModules is without modules option.
 	OptionWithoutModules = "without-modules"
 	// OptionValue is value option.
 	OptionValue = "value"
 	// OptionVersion is version option.
 	OptionVersion = "version"
+	// OptionYes is yes option. Used to confirm removing several environments at once.
+	OptionYes = "yes"
 )
 
 const (
@@ -142,6 +276,10 @@ const (
 	OutputWide = "wide"
 	// OutputJSON is JSON output
 	OutputJSON = "json"
+	// OutputPatch is patch output
+	OutputPatch = "patch"
+	// OutputEnv is shell variable output
+	OutputEnv = "env"
 )
 
 var (
@@ -164,6 +302,20 @@ func (e *missingOptionError) Error() string {
 	return fmt.Sprintf("missing required %s option", e.name)
 }
 
+type readOnlyError struct {
+	command string
+}
+
+func newReadOnlyError(command string) *readOnlyError {
+	return &readOnlyError{
+		command: command,
+	}
+}
+
+func (e *readOnlyError) Error() string {
+	return fmt.Sprintf("%s can change the cluster and is not allowed in read-only mode", e.command)
+}
+
 type invalidOptionError struct {
 	name string
 }
@@ -282,6 +434,21 @@ func (o *optionLoader) LoadOptionalInt(name string) int {
 	return a
 }
 
+func (o *optionLoader) LoadDuration(name string) time.Duration {
+	i := o.load(name)
+	if i == nil {
+		return 0
+	}
+
+	a, ok := i.(time.Duration)
+	if !ok {
+		o.err = newInvalidOptionError(name)
+		return 0
+	}
+
+	return a
+}
+
 func (o *optionLoader) LoadString(name string) string {
 	i := o.load(name)
 	if i == nil {
@@ -326,6 +493,20 @@ func (o *optionLoader) LoadStringSlice(name string) []string {
 	return a
 }
 
+func (o *optionLoader) LoadOptionalStringSlice(name string) []string {
+	i := o.loadOptional(name)
+	if i == nil {
+		return nil
+	}
+
+	a, ok := i.([]string)
+	if !ok {
+		return nil
+	}
+
+	return a
+}
+
 func (o *optionLoader) LoadClientConfig() *client.Config {
 	i := o.load(OptionClientConfig)
 	if i == nil {
@@ -363,18 +544,30 @@ func (o *optionLoader) LoadApp() app.App {
 	}
 	var httpClient = o.LoadHTTPClient()
 	if httpClient == nil {
-		o.err = errors.New("initializing http client")
+		if o.err == nil {
+			o.err = errors.New("initializing http client")
+		}
 		return nil
 	}
 	var appRoot = o.LoadOptionalString(OptionAppRoot)
 
-	appRoot, err := app.FindRoot(fs, appRoot)
+	var err error
+	if appName := o.LoadOptionalString(OptionAppName); appName != "" {
+		appRoot, err = app.FindNamedRoot(fs, appRoot, appName)
+	} else {
+		appRoot, err = app.FindRoot(fs, appRoot)
+	}
 	if err != nil {
 		o.err = errors.Wrapf(err, "finding app root from starting path: %s", appRoot)
 		return nil
 	}
 
-	a, err = app.Load(fs, httpClient, appRoot)
+	var opts []app.Opt
+	if o.LoadOptionalBool(OptionNoCache) {
+		opts = append(opts, app.OptNoCache())
+	}
+
+	a, err = app.Load(fs, httpClient, appRoot, opts...)
 	if err != nil {
 		o.err = errors.Wrap(err, "initializing app")
 		return nil
@@ -407,8 +600,14 @@ func (o *optionLoader) LoadHTTPClient() *http.Client {
 
 	timeoutSeconds := 10
 
+	proxy, err := proxyFunc(o.LoadOptionalString(OptionProxy))
+	if err != nil {
+		o.err = err
+		return nil
+	}
+
 	var defaultTransport http.RoundTripper = &http.Transport{
-		Proxy: http.ProxyFromEnvironment,
+		Proxy: proxy,
 		DialContext: (&net.Dialer{
 			Timeout:   30 * time.Second,
 			KeepAlive: 30 * time.Second,
@@ -0,0 +1,81 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package actions
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// autoAPISpecTimeout is the time to wait for the cluster of an environment
+// with an auto api spec to report its version.
+const autoAPISpecTimeout = 5 * time.Second
+
+type syncAPISpecFn func(a app.App, clientConfig *client.Config, envName string) error
+
+// syncAPISpec detects the Kubernetes version of the cluster of an environment
+// with an auto api spec, and regenerates ksonnet-lib if the version changed.
+func syncAPISpec(a app.App, clientConfig *client.Config, envName string) error {
+	return updateAutoAPISpec(a, envName, func() (string, error) {
+		return clientConfig.EnvironmentAPISpec(a, envName, autoAPISpecTimeout)
+	})
+}
+
+// updateAutoAPISpec updates the Kubernetes version of an environment with an
+// auto api spec to the one returned by detectFn. If the version can't be
+// detected, e.g. because the cluster is unreachable, the version recorded for
+// the environment is used.
+func updateAutoAPISpec(a app.App, envName string, detectFn func() (string, error)) error {
+	env, err := a.Environment(envName)
+	if err != nil {
+		return err
+	}
+
+	if env.APISpec != app.AutoAPISpec {
+		return nil
+	}
+
+	k8sAPISpec, err := detectFn()
+	if err != nil {
+		if env.KubernetesVersion == "" {
+			return errors.Wrapf(err, "detect Kubernetes version of environment %q", envName)
+		}
+
+		logrus.Warnf("Unable to detect the Kubernetes version of environment %q, using %s: %v",
+			envName, env.KubernetesVersion, err)
+		return nil
+	}
+
+	if k8sAPISpec == fmt.Sprintf("version:%s", env.KubernetesVersion) {
+		return nil
+	}
+
+	logrus.Infof("Kubernetes version of environment %q changed from %q to %q, updating ksonnet-lib",
+		envName, env.KubernetesVersion, k8sAPISpec)
+
+	isOverride := a.IsEnvOverride(envName)
+	if isOverride {
+		// Libraries will always derive from the primary app.yaml
+		env.Libraries = nil
+	}
+
+	return a.AddEnvironment(env, k8sAPISpec, isOverride)
+}
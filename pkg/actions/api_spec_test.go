@@ -0,0 +1,95 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package actions
+
+import (
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	amocks "github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_updateAutoAPISpec(t *testing.T) {
+	cases := []struct {
+		name       string
+		env        *app.EnvironmentConfig
+		detected   string
+		detectErr  error
+		isOverride bool
+		expected   string
+		isErr      bool
+	}{
+		{
+			name:     "version changed",
+			env:      &app.EnvironmentConfig{Name: "default", APISpec: "auto", KubernetesVersion: "v1.8.0"},
+			detected: "version:v1.9.0",
+			expected: "version:v1.9.0",
+		},
+		{
+			name:       "version changed in override",
+			env:        &app.EnvironmentConfig{Name: "default", APISpec: "auto", KubernetesVersion: "v1.8.0"},
+			detected:   "version:v1.9.0",
+			isOverride: true,
+			expected:   "version:v1.9.0",
+		},
+		{
+			name:     "version unchanged",
+			env:      &app.EnvironmentConfig{Name: "default", APISpec: "auto", KubernetesVersion: "v1.8.0"},
+			detected: "version:v1.8.0",
+		},
+		{
+			name:      "cluster unreachable",
+			env:       &app.EnvironmentConfig{Name: "default", APISpec: "auto", KubernetesVersion: "v1.8.0"},
+			detectErr: errors.New("connection refused"),
+		},
+		{
+			name:      "cluster unreachable without a recorded version",
+			env:       &app.EnvironmentConfig{Name: "default", APISpec: "auto"},
+			detectErr: errors.New("connection refused"),
+			isErr:     true,
+		},
+		{
+			name: "api spec is not auto",
+			env:  &app.EnvironmentConfig{Name: "default", KubernetesVersion: "v1.8.0"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withApp(t, func(appMock *amocks.App) {
+				appMock.On("Environment", "default").Return(tc.env, nil)
+				appMock.On("IsEnvOverride", "default").Return(tc.isOverride)
+				if tc.expected != "" {
+					appMock.On("AddEnvironment", tc.env, tc.expected, tc.isOverride).Return(nil)
+				}
+
+				detectFn := func() (string, error) {
+					require.Equal(t, "auto", tc.env.APISpec, "version should only be detected for auto api specs")
+					return tc.detected, tc.detectErr
+				}
+
+				err := updateAutoAPISpec(appMock, "default", detectFn)
+				if tc.isErr {
+					require.Error(t, err)
+					return
+				}
+				require.NoError(t, err)
+			})
+		})
+	}
+}
@@ -16,13 +16,20 @@
 package actions
 
 import (
+	"time"
+
 	"github.com/ksonnet/ksonnet/pkg/app"
 	"github.com/ksonnet/ksonnet/pkg/client"
 	"github.com/ksonnet/ksonnet/pkg/cluster"
+	"github.com/ksonnet/ksonnet/pkg/diff"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 type runApplyFn func(cluster.ApplyConfig, ...cluster.ApplyOpts) error
 
+type runApplyPatchesFn func(cluster.ApplyPatchesConfig, ...cluster.ApplyPatchesOpts) error
+
 // RunApply runs `apply`.
 func RunApply(m map[string]interface{}) error {
 	a, err := newApply(m)
@@ -37,16 +44,27 @@ type applyOpt func(*Apply)
 
 // Apply collects options for applying objects to a cluster.
 type Apply struct {
-	app            app.App
-	clientConfig   *client.Config
-	componentNames []string
-	create         bool
-	dryRun         bool
-	envName        string
-	gcTag          string
-	skipGc         bool
-
-	runApplyFn runApplyFn
+	allowUnresolved bool
+	app             app.App
+	clientConfig    *client.Config
+	componentNames  []string
+	create          bool
+	dryRun          bool
+	envName         string
+	failUnmanaged   bool
+	fromPatch       string
+	gcTag           string
+	parallelism     int
+	readOnly        bool
+	resolveImages   bool
+	selector        string
+	skipGc          bool
+	timeout         time.Duration
+	wait            bool
+
+	runApplyFn        runApplyFn
+	runApplyPatchesFn runApplyPatchesFn
+	syncAPISpecFn     syncAPISpecFn
 }
 
 // RunApply runs `apply`
@@ -54,15 +72,29 @@ func newApply(m map[string]interface{}, opts ...applyOpt) (*Apply, error) {
 	ol := newOptionLoader(m)
 
 	a := &Apply{
-		app:            ol.LoadApp(),
-		clientConfig:   ol.LoadClientConfig(),
-		componentNames: ol.LoadStringSlice(OptionComponentNames),
-		create:         ol.LoadBool(OptionCreate),
-		dryRun:         ol.LoadBool(OptionDryRun),
-		gcTag:          ol.LoadString(OptionGcTag),
-		skipGc:         ol.LoadBool(OptionSkipGc),
+		allowUnresolved: ol.LoadOptionalBool(OptionAllowUnresolved),
+		app:             ol.LoadApp(),
+		clientConfig:    ol.LoadClientConfig(),
+		componentNames:  ol.LoadStringSlice(OptionComponentNames),
+		create:          ol.LoadBool(OptionCreate),
+		dryRun:          ol.LoadBool(OptionDryRun),
+		failUnmanaged:   ol.LoadOptionalBool(OptionFailOnUnmanaged),
+		fromPatch:       ol.LoadOptionalString(OptionFromPatch),
+		gcTag:           ol.LoadString(OptionGcTag),
+		parallelism:     ol.LoadOptionalInt(OptionObjectParallelism),
+		readOnly:        ol.LoadOptionalBool(OptionReadOnly),
+		resolveImages:   ol.LoadOptionalBool(OptionResolveImages),
+		selector:        ol.LoadOptionalString(OptionSelector),
+		skipGc:          ol.LoadBool(OptionSkipGc),
+		wait:            ol.LoadOptionalBool(OptionWait),
+
+		runApplyFn:        cluster.RunApply,
+		runApplyPatchesFn: cluster.RunApplyPatches,
+		syncAPISpecFn:     syncAPISpec,
+	}
 
-		runApplyFn: cluster.RunApply,
+	if a.wait {
+		a.timeout = ol.LoadDuration(OptionTimeout)
 	}
 
 	if ol.err != nil {
@@ -81,6 +113,43 @@ func newApply(m map[string]interface{}, opts ...applyOpt) (*Apply, error) {
 }
 
 func (a *Apply) run() error {
+	if a.readOnly && !a.dryRun {
+		return newReadOnlyError("apply")
+	}
+
+	if a.parallelism < 0 {
+		return errors.Errorf("object parallelism can't be negative, was %d", a.parallelism)
+	}
+
+	if a.allowUnresolved && !a.resolveImages {
+		return errors.New("--allow-unresolved requires --resolve-images")
+	}
+
+	if a.wait && a.timeout <= 0 {
+		return errors.Errorf("--wait requires a positive timeout, was %s", a.timeout)
+	}
+
+	if err := checkEnvOnline(a.app, a.envName); err != nil {
+		return err
+	}
+
+	if a.fromPatch != "" {
+		return a.applyPatches()
+	}
+
+	var selector labels.Selector
+	if a.selector != "" {
+		var err error
+		selector, err = labels.Parse(a.selector)
+		if err != nil {
+			return errors.Wrapf(err, "parsing selector %q", a.selector)
+		}
+	}
+
+	if err := a.syncAPISpecFn(a.app, a.clientConfig, a.envName); err != nil {
+		return err
+	}
+
 	config := cluster.ApplyConfig{
 		App:            a.app,
 		ClientConfig:   a.clientConfig,
@@ -90,11 +159,60 @@ func (a *Apply) run() error {
 		EnvName:        a.envName,
 		GcTag:          a.gcTag,
 		SkipGc:         a.skipGc,
+
+		ObjectParallelism:     a.parallelism,
+		Selector:              selector,
+		ResolveImages:         a.resolveImages,
+		AllowUnresolvedImages: a.allowUnresolved,
+		FailOnUnmanaged:       a.failUnmanaged,
+
+		Wait:        a.wait,
+		WaitTimeout: a.timeout,
 	}
 
 	return a.runApplyFn(config)
 }
 
+// applyPatches applies the patches in a file written by `ks diff --output=patch`,
+// instead of rendering the environment's components.
+func (a *Apply) applyPatches() error {
+	if len(a.componentNames) > 0 || a.selector != "" || a.gcTag != "" || a.resolveImages || a.failUnmanaged || a.wait {
+		return errors.New("--from-patch can't be used with --component, --selector, --gc-tag, --resolve-images, --fail-on-unmanaged or --wait")
+	}
+
+	f, err := a.app.Fs().Open(a.fromPatch)
+	if err != nil {
+		return errors.Wrapf(err, "open %s", a.fromPatch)
+	}
+	defer f.Close()
+
+	patches, err := diff.ReadPatches(f)
+	if err != nil {
+		return errors.Wrapf(err, "read %s", a.fromPatch)
+	}
+
+	config := cluster.ApplyPatchesConfig{
+		App:          a.app,
+		ClientConfig: a.clientConfig,
+		Create:       a.create,
+		DryRun:       a.dryRun,
+		EnvName:      a.envName,
+	}
+
+	for _, p := range patches {
+		config.Patches = append(config.Patches, cluster.ObjectPatch{
+			APIVersion: p.APIVersion,
+			Kind:       p.Kind,
+			Namespace:  p.Namespace,
+			Name:       p.Name,
+			Create:     p.Operation == diff.PatchOperationCreate,
+			Patch:      p.Patch,
+		})
+	}
+
+	return a.runApplyPatchesFn(config)
+}
+
 func (a *Apply) setCurrentEnv(name string) {
 	a.envName = name
 }
@@ -17,10 +17,13 @@ package actions
 
 import (
 	"testing"
+	"time"
 
+	"github.com/ksonnet/ksonnet/pkg/app"
 	amocks "github.com/ksonnet/ksonnet/pkg/app/mocks"
 	"github.com/ksonnet/ksonnet/pkg/client"
 	"github.com/ksonnet/ksonnet/pkg/cluster"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -50,6 +53,7 @@ func TestApply(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			withApp(t, func(appMock *amocks.App) {
 				appMock.On("CurrentEnvironment").Return(tc.currentName)
+				appMock.On("Environment", "default").Return(&app.EnvironmentConfig{}, nil)
 
 				in := map[string]interface{}{
 					OptionApp:            appMock,
@@ -78,6 +82,10 @@ func TestApply(t *testing.T) {
 						assert.Equal(t, expected, config)
 						return nil
 					}
+					a.syncAPISpecFn = func(a app.App, clientConfig *client.Config, envName string) error {
+						assert.Equal(t, "default", envName)
+						return nil
+					}
 				}
 
 				a, err := newApply(in, runApplyOpt)
@@ -94,6 +102,289 @@ func TestApply(t *testing.T) {
 	}
 }
 
+func TestApply_read_only(t *testing.T) {
+	cases := []struct {
+		name   string
+		dryRun bool
+		isErr  bool
+	}{
+		{
+			name:  "apply",
+			isErr: true,
+		},
+		{
+			name:   "dry run",
+			dryRun: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withApp(t, func(appMock *amocks.App) {
+				appMock.On("Environment", "default").Return(&app.EnvironmentConfig{}, nil)
+
+				in := map[string]interface{}{
+					OptionApp:            appMock,
+					OptionClientConfig:   &client.Config{},
+					OptionComponentNames: []string{},
+					OptionCreate:         true,
+					OptionDryRun:         tc.dryRun,
+					OptionEnvName:        "default",
+					OptionGcTag:          "gc-tag",
+					OptionReadOnly:       true,
+					OptionSkipGc:         false,
+				}
+
+				applied := false
+				runApplyOpt := func(a *Apply) {
+					a.runApplyFn = func(config cluster.ApplyConfig, opts ...cluster.ApplyOpts) error {
+						applied = true
+						return nil
+					}
+					a.syncAPISpecFn = func(a app.App, clientConfig *client.Config, envName string) error {
+						return nil
+					}
+				}
+
+				a, err := newApply(in, runApplyOpt)
+				require.NoError(t, err)
+
+				err = a.run()
+				if tc.isErr {
+					require.Error(t, err)
+					assert.False(t, applied)
+					return
+				}
+				require.NoError(t, err)
+				assert.True(t, applied)
+			})
+		})
+	}
+}
+
+func TestApply_negative_object_parallelism(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		in := map[string]interface{}{
+			OptionApp:               appMock,
+			OptionClientConfig:      &client.Config{},
+			OptionComponentNames:    []string{},
+			OptionCreate:            true,
+			OptionDryRun:            false,
+			OptionEnvName:           "default",
+			OptionGcTag:             "",
+			OptionObjectParallelism: -1,
+			OptionSkipGc:            false,
+		}
+
+		runApplyOpt := func(a *Apply) {
+			a.runApplyFn = func(config cluster.ApplyConfig, opts ...cluster.ApplyOpts) error {
+				t.Error("objects should not be applied")
+				return nil
+			}
+		}
+
+		a, err := newApply(in, runApplyOpt)
+		require.NoError(t, err)
+
+		err = a.run()
+		require.Error(t, err)
+	})
+}
+
+func TestApply_selector(t *testing.T) {
+	cases := []struct {
+		name     string
+		selector string
+		isErr    bool
+	}{
+		{
+			name:     "valid selector",
+			selector: "tier=frontend",
+		},
+		{
+			name:     "invalid selector",
+			selector: "tier in (frontend",
+			isErr:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withApp(t, func(appMock *amocks.App) {
+				appMock.On("Environment", "default").Return(&app.EnvironmentConfig{}, nil)
+
+				in := map[string]interface{}{
+					OptionApp:            appMock,
+					OptionClientConfig:   &client.Config{},
+					OptionComponentNames: []string{},
+					OptionCreate:         true,
+					OptionDryRun:         false,
+					OptionEnvName:        "default",
+					OptionGcTag:          "",
+					OptionSelector:       tc.selector,
+					OptionSkipGc:         false,
+				}
+
+				var selector string
+				runApplyOpt := func(a *Apply) {
+					a.runApplyFn = func(config cluster.ApplyConfig, opts ...cluster.ApplyOpts) error {
+						selector = config.Selector.String()
+						return nil
+					}
+					a.syncAPISpecFn = func(a app.App, clientConfig *client.Config, envName string) error {
+						return nil
+					}
+				}
+
+				a, err := newApply(in, runApplyOpt)
+				require.NoError(t, err)
+
+				err = a.run()
+				if tc.isErr {
+					require.Error(t, err)
+					return
+				}
+				require.NoError(t, err)
+				assert.Equal(t, tc.selector, selector)
+			})
+		})
+	}
+}
+
+func TestApply_resolve_images(t *testing.T) {
+	cases := []struct {
+		name            string
+		resolveImages   bool
+		allowUnresolved bool
+		isErr           bool
+	}{
+		{
+			name:          "resolve images",
+			resolveImages: true,
+		},
+		{
+			name:            "allow unresolved",
+			resolveImages:   true,
+			allowUnresolved: true,
+		},
+		{
+			name:            "allow unresolved without resolving",
+			allowUnresolved: true,
+			isErr:           true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withApp(t, func(appMock *amocks.App) {
+				appMock.On("Environment", "default").Return(&app.EnvironmentConfig{}, nil)
+
+				in := map[string]interface{}{
+					OptionApp:             appMock,
+					OptionClientConfig:    &client.Config{},
+					OptionComponentNames:  []string{},
+					OptionCreate:          true,
+					OptionDryRun:          false,
+					OptionEnvName:         "default",
+					OptionGcTag:           "",
+					OptionSkipGc:          false,
+					OptionResolveImages:   tc.resolveImages,
+					OptionAllowUnresolved: tc.allowUnresolved,
+				}
+
+				var got cluster.ApplyConfig
+				runApplyOpt := func(a *Apply) {
+					a.runApplyFn = func(config cluster.ApplyConfig, opts ...cluster.ApplyOpts) error {
+						got = config
+						return nil
+					}
+					a.syncAPISpecFn = func(a app.App, clientConfig *client.Config, envName string) error {
+						return nil
+					}
+				}
+
+				a, err := newApply(in, runApplyOpt)
+				require.NoError(t, err)
+
+				err = a.run()
+				if tc.isErr {
+					require.Error(t, err)
+					return
+				}
+				require.NoError(t, err)
+				assert.Equal(t, tc.resolveImages, got.ResolveImages)
+				assert.Equal(t, tc.allowUnresolved, got.AllowUnresolvedImages)
+			})
+		})
+	}
+}
+
+func TestApply_fail_on_unmanaged(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		appMock.On("Environment", "default").Return(&app.EnvironmentConfig{}, nil)
+
+		in := map[string]interface{}{
+			OptionApp:             appMock,
+			OptionClientConfig:    &client.Config{},
+			OptionComponentNames:  []string{},
+			OptionCreate:          true,
+			OptionDryRun:          false,
+			OptionEnvName:         "default",
+			OptionGcTag:           "",
+			OptionSkipGc:          false,
+			OptionFailOnUnmanaged: true,
+		}
+
+		var got cluster.ApplyConfig
+		runApplyOpt := func(a *Apply) {
+			a.runApplyFn = func(config cluster.ApplyConfig, opts ...cluster.ApplyOpts) error {
+				got = config
+				return nil
+			}
+			a.syncAPISpecFn = func(a app.App, clientConfig *client.Config, envName string) error {
+				return nil
+			}
+		}
+
+		a, err := newApply(in, runApplyOpt)
+		require.NoError(t, err)
+
+		err = a.run()
+		require.NoError(t, err)
+		assert.True(t, got.FailOnUnmanaged)
+	})
+}
+
+func TestApply_offline(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		appMock.On("Environment", "default").Return(&app.EnvironmentConfig{Offline: true}, nil)
+
+		in := map[string]interface{}{
+			OptionApp:            appMock,
+			OptionClientConfig:   &client.Config{},
+			OptionComponentNames: []string{},
+			OptionCreate:         true,
+			OptionDryRun:         false,
+			OptionEnvName:        "default",
+			OptionGcTag:          "",
+			OptionSkipGc:         false,
+		}
+
+		runApplyOpt := func(a *Apply) {
+			a.runApplyFn = func(config cluster.ApplyConfig, opts ...cluster.ApplyOpts) error {
+				t.Error("objects should not be applied")
+				return nil
+			}
+		}
+
+		a, err := newApply(in, runApplyOpt)
+		require.NoError(t, err)
+
+		err = a.run()
+		require.Error(t, err)
+	})
+}
+
 func TestApply_invalid_input(t *testing.T) {
 	withApp(t, func(appMock *amocks.App) {
 		in := map[string]interface{}{
@@ -110,3 +401,174 @@ func TestApply_requires_app(t *testing.T) {
 	_, err := newApply(in)
 	require.Error(t, err)
 }
+
+func TestApply_from_patch(t *testing.T) {
+	patches := `[
+  {
+    "apiVersion": "v1",
+    "kind": "ConfigMap",
+    "namespace": "default",
+    "name": "new",
+    "operation": "create",
+    "patch": {"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"new"}}
+  },
+  {
+    "apiVersion": "apps/v1",
+    "kind": "Deployment",
+    "namespace": "default",
+    "name": "web",
+    "operation": "patch",
+    "patch": {"spec":{"replicas":3}}
+  }
+]`
+
+	cases := []struct {
+		name           string
+		componentNames []string
+		isErr          bool
+	}{
+		{
+			name: "in general",
+		},
+		{
+			name:           "with components",
+			componentNames: []string{"web"},
+			isErr:          true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withApp(t, func(appMock *amocks.App) {
+				appMock.On("Environment", "default").Return(&app.EnvironmentConfig{}, nil)
+
+				err := afero.WriteFile(appMock.Fs(), "/patches.json", []byte(patches), 0644)
+				require.NoError(t, err)
+
+				in := map[string]interface{}{
+					OptionApp:            appMock,
+					OptionClientConfig:   &client.Config{},
+					OptionComponentNames: tc.componentNames,
+					OptionCreate:         true,
+					OptionDryRun:         false,
+					OptionEnvName:        "default",
+					OptionFromPatch:      "/patches.json",
+					OptionGcTag:          "",
+					OptionSkipGc:         false,
+				}
+
+				expected := cluster.ApplyPatchesConfig{
+					App:          appMock,
+					ClientConfig: &client.Config{},
+					Create:       true,
+					EnvName:      "default",
+					Patches: []cluster.ObjectPatch{
+						{
+							APIVersion: "v1",
+							Kind:       "ConfigMap",
+							Namespace:  "default",
+							Name:       "new",
+							Create:     true,
+							Patch:      []byte(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"new"}}`),
+						},
+						{
+							APIVersion: "apps/v1",
+							Kind:       "Deployment",
+							Namespace:  "default",
+							Name:       "web",
+							Patch:      []byte(`{"spec":{"replicas":3}}`),
+						},
+					},
+				}
+
+				applied := false
+				runApplyOpt := func(a *Apply) {
+					a.runApplyFn = func(config cluster.ApplyConfig, opts ...cluster.ApplyOpts) error {
+						t.Error("the environment's components should not be applied")
+						return nil
+					}
+					a.runApplyPatchesFn = func(config cluster.ApplyPatchesConfig, opts ...cluster.ApplyPatchesOpts) error {
+						assert.Equal(t, expected, config)
+						applied = true
+						return nil
+					}
+				}
+
+				a, err := newApply(in, runApplyOpt)
+				require.NoError(t, err)
+
+				err = a.run()
+				if tc.isErr {
+					require.Error(t, err)
+					assert.False(t, applied)
+					return
+				}
+
+				require.NoError(t, err)
+				assert.True(t, applied)
+			})
+		})
+	}
+}
+
+func TestApply_wait(t *testing.T) {
+	cases := []struct {
+		name    string
+		timeout time.Duration
+		isErr   bool
+	}{
+		{
+			name:    "with timeout",
+			timeout: 5 * time.Minute,
+		},
+		{
+			name:  "without timeout",
+			isErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withApp(t, func(appMock *amocks.App) {
+				appMock.On("Environment", "default").Return(&app.EnvironmentConfig{}, nil)
+
+				in := map[string]interface{}{
+					OptionApp:            appMock,
+					OptionClientConfig:   &client.Config{},
+					OptionComponentNames: []string{},
+					OptionCreate:         true,
+					OptionDryRun:         false,
+					OptionEnvName:        "default",
+					OptionGcTag:          "",
+					OptionSkipGc:         false,
+					OptionWait:           true,
+					OptionTimeout:        tc.timeout,
+				}
+
+				var got cluster.ApplyConfig
+				runApplyOpt := func(a *Apply) {
+					a.runApplyFn = func(config cluster.ApplyConfig, opts ...cluster.ApplyOpts) error {
+						got = config
+						return nil
+					}
+					a.syncAPISpecFn = func(a app.App, clientConfig *client.Config, envName string) error {
+						return nil
+					}
+				}
+
+				a, err := newApply(in, runApplyOpt)
+				require.NoError(t, err)
+
+				err = a.run()
+				if tc.isErr {
+					require.Error(t, err)
+					return
+				}
+				require.NoError(t, err)
+
+				assert.True(t, got.Wait)
+				assert.Equal(t, tc.timeout, got.WaitTimeout)
+			})
+		})
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package actions
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+)
+
+// RunCompleteEnvNames runs `__complete-env-names`
+func RunCompleteEnvNames(m map[string]interface{}) error {
+	c, err := NewCompleteEnvNames(m)
+	if err != nil {
+		return err
+	}
+
+	return c.Run()
+}
+
+// CompleteEnvNames writes the completions of a partial environment name for
+// shell completion. To initialize CompleteEnvNames, use the
+// `NewCompleteEnvNames` constructor.
+type CompleteEnvNames struct {
+	app     app.App
+	partial string
+	out     io.Writer
+}
+
+// NewCompleteEnvNames creates an instance of CompleteEnvNames.
+func NewCompleteEnvNames(m map[string]interface{}) (*CompleteEnvNames, error) {
+	ol := newOptionLoader(m)
+
+	c := &CompleteEnvNames{
+		app:     ol.LoadApp(),
+		partial: ol.LoadOptionalString(OptionEnvName),
+
+		out: os.Stdout,
+	}
+
+	if ol.err != nil {
+		return nil, ol.err
+	}
+
+	return c, nil
+}
+
+// Run writes the completions, one per line. Environment names are paths, so
+// only the next segment of the names after the partial name is completed:
+// `us-w` completes to `us-west/` if there are environments below it.
+func (c *CompleteEnvNames) Run() error {
+	environments, err := c.app.Environments()
+	if err != nil {
+		return err
+	}
+
+	var names []string
+	for name := range environments {
+		names = append(names, name)
+	}
+
+	for _, completion := range envNameCompletions(names, c.partial) {
+		if _, err := fmt.Fprintln(c.out, completion); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// envNameCompletions returns the sorted completions of a partial environment
+// name. Names with more segments after the one being completed are completed
+// up to, and including, the next slash.
+func envNameCompletions(names []string, partial string) []string {
+	seen := make(map[string]bool)
+	var completions []string
+
+	for _, name := range names {
+		if !strings.HasPrefix(name, partial) {
+			continue
+		}
+
+		completion := name
+		if i := strings.Index(name[len(partial):], "/"); i >= 0 {
+			completion = name[:len(partial)+i+1]
+		}
+
+		if !seen[completion] {
+			seen[completion] = true
+			completions = append(completions, completion)
+		}
+	}
+
+	sort.Strings(completions)
+	return completions
+}
@@ -0,0 +1,100 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package actions
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	amocks "github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompleteEnvNames(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		envs := app.EnvironmentConfigs{
+			"default":         &app.EnvironmentConfig{},
+			"us-east/prod":    &app.EnvironmentConfig{},
+			"us-west/prod":    &app.EnvironmentConfig{},
+			"us-west/staging": &app.EnvironmentConfig{},
+		}
+		appMock.On("Environments").Return(envs, nil)
+
+		in := map[string]interface{}{
+			OptionApp:     appMock,
+			OptionEnvName: "us-w",
+		}
+
+		a, err := NewCompleteEnvNames(in)
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		a.out = &buf
+
+		err = a.Run()
+		require.NoError(t, err)
+
+		require.Equal(t, "us-west/\n", buf.String())
+	})
+}
+
+func TestCompleteEnvNames_requires_app(t *testing.T) {
+	in := make(map[string]interface{})
+	_, err := NewCompleteEnvNames(in)
+	require.Error(t, err)
+}
+
+func Test_envNameCompletions(t *testing.T) {
+	names := []string{"us-west/staging", "default", "us-west/prod", "us-east/prod", "us-west"}
+
+	cases := []struct {
+		name     string
+		partial  string
+		expected []string
+	}{
+		{
+			name:     "no partial name",
+			expected: []string{"default", "us-east/", "us-west", "us-west/"},
+		},
+		{
+			name:     "completes to the next slash",
+			partial:  "us-w",
+			expected: []string{"us-west", "us-west/"},
+		},
+		{
+			name:     "completes the last segment",
+			partial:  "us-west/",
+			expected: []string{"us-west/prod", "us-west/staging"},
+		},
+		{
+			name:     "complete name",
+			partial:  "default",
+			expected: []string{"default"},
+		},
+		{
+			name:    "no match",
+			partial: "eu",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := envNameCompletions(names, tc.partial)
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
@@ -15,7 +15,10 @@
 
 package actions
 
-import "github.com/pkg/errors"
+import (
+	"github.com/ksonnet/ksonnet/pkg/audit"
+	"github.com/pkg/errors"
+)
 
 type environmentMetadata interface {
 	CurrentEnvironment() string
@@ -36,5 +39,6 @@ func setCurrentEnv(em environmentMetadata, ce currentEnver, ol *optionLoader) er
 	}
 
 	ce.setCurrentEnv(envName)
+	audit.SetEnvironment(envName)
 	return nil
 }
@@ -42,6 +42,7 @@ type Delete struct {
 	componentNames []string
 	envName        string
 	gracePeriod    int64
+	readOnly       bool
 
 	runDeleteFn runDeleteFn
 }
@@ -55,6 +56,7 @@ func newDelete(m map[string]interface{}, opts ...deleteOpt) (*Delete, error) {
 		clientConfig:   ol.LoadClientConfig(),
 		componentNames: ol.LoadStringSlice(OptionComponentNames),
 		gracePeriod:    ol.LoadInt64(OptionGracePeriod),
+		readOnly:       ol.LoadOptionalBool(OptionReadOnly),
 
 		runDeleteFn: cluster.RunDelete,
 	}
@@ -75,6 +77,10 @@ func newDelete(m map[string]interface{}, opts ...deleteOpt) (*Delete, error) {
 }
 
 func (d *Delete) run() error {
+	if d.readOnly {
+		return newReadOnlyError("delete")
+	}
+
 	config := cluster.DeleteConfig{
 		App:            d.app,
 		ClientConfig:   d.clientConfig,
@@ -88,6 +88,32 @@ func TestDelete(t *testing.T) {
 	}
 }
 
+func TestDelete_read_only(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		in := map[string]interface{}{
+			OptionApp:            appMock,
+			OptionClientConfig:   &client.Config{},
+			OptionComponentNames: []string{},
+			OptionEnvName:        "default",
+			OptionGracePeriod:    int64(3),
+			OptionReadOnly:       true,
+		}
+
+		runDeleteOpt := func(a *Delete) {
+			a.runDeleteFn = func(config cluster.DeleteConfig, opts ...cluster.DeleteOpts) error {
+				t.Error("resources should not be deleted in read-only mode")
+				return nil
+			}
+		}
+
+		a, err := newDelete(in, runDeleteOpt)
+		require.NoError(t, err)
+
+		err = a.run()
+		require.Error(t, err)
+	})
+}
+
 func TestDelete_invalid_input(t *testing.T) {
 	withApp(t, func(appMock *amocks.App) {
 		in := map[string]interface{}{
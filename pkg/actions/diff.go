@@ -18,6 +18,7 @@ package actions
 import (
 	"bufio"
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -26,8 +27,20 @@ import (
 	"github.com/fatih/color"
 	"github.com/ksonnet/ksonnet/pkg/app"
 	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/ksonnet/ksonnet/pkg/cluster"
 	"github.com/ksonnet/ksonnet/pkg/diff"
 	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// DiffAdded is the category of objects that would be created.
+	DiffAdded = "added"
+	// DiffRemoved is the category of objects that would be deleted.
+	DiffRemoved = "removed"
+	// DiffChanged is the category of objects that would be modified.
+	DiffChanged = "changed"
 )
 
 var (
@@ -55,8 +68,23 @@ type Diff struct {
 	src1         string
 	src2         string
 	components   []string
+	output       string
+	summaryOnly  bool
+	fix          bool
+	dryRun       bool
+	readOnly     bool
+	failOn       []string
+	threeWay     bool
 
-	diffFn func(app.App, *client.Config, []string, *diff.Location, *diff.Location) (io.Reader, error)
+	// summary is set once the summary of the differences has been computed.
+	summary *diff.Summary
+
+	diffFn         func(app.App, *client.Config, []string, *diff.Location, *diff.Location) (io.Reader, error)
+	threeWayDiffFn func(app.App, *client.Config, []string, *diff.Location, *diff.Location) (io.Reader, error)
+	patchFn        func(app.App, *client.Config, []string, *diff.Location, *diff.Location) ([]diff.Patch, error)
+	summaryFn      func(app.App, *client.Config, []string, *diff.Location, *diff.Location) (diff.Summary, error)
+	driftedFn      func(app.App, *client.Config, []string, *diff.Location, *diff.Location) ([]*unstructured.Unstructured, error)
+	runApplyFn     runApplyFn
 
 	out io.Writer
 }
@@ -71,8 +99,20 @@ func NewDiff(m map[string]interface{}) (*Diff, error) {
 		src1:         ol.LoadString(OptionSrc1),
 		src2:         ol.LoadOptionalString(OptionSrc2),
 		components:   ol.LoadStringSlice(OptionComponentNames),
+		output:       ol.LoadOptionalString(OptionOutput),
+		summaryOnly:  ol.LoadOptionalBool(OptionSummaryOnly),
+		fix:          ol.LoadOptionalBool(OptionFix),
+		dryRun:       ol.LoadOptionalBool(OptionDryRun),
+		readOnly:     ol.LoadOptionalBool(OptionReadOnly),
+		failOn:       ol.LoadOptionalStringSlice(OptionFailOn),
+		threeWay:     ol.LoadOptionalBool(OptionThreeWay),
 
-		diffFn: diff.DefaultDiff,
+		diffFn:         diff.DefaultDiff,
+		threeWayDiffFn: diff.DefaultThreeWayDiff,
+		patchFn:        diff.DefaultPatches,
+		summaryFn:      diff.DefaultSummary,
+		driftedFn:      diff.DefaultDrifted,
+		runApplyFn:     cluster.RunApply,
 
 		out: os.Stdout,
 	}
@@ -81,6 +121,31 @@ func NewDiff(m map[string]interface{}) (*Diff, error) {
 		return nil, ol.err
 	}
 
+	if d.summaryOnly {
+		if d.output != "" && d.output != OutputJSON {
+			return nil, errors.Errorf("invalid output %q for --summary-only", d.output)
+		}
+	} else if d.output != "" && d.output != OutputPatch {
+		return nil, errors.Errorf("invalid output %q", d.output)
+	}
+
+	if d.threeWay && (d.summaryOnly || d.output != "") {
+		return nil, errors.New("--three-way can't be used with --summary-only or --output")
+	}
+
+	if d.dryRun && !d.fix {
+		return nil, errors.New("--dry-run can only be used with --fix")
+	}
+
+	for _, category := range d.failOn {
+		switch category {
+		case DiffAdded, DiffRemoved, DiffChanged:
+		default:
+			return nil, errors.Errorf("invalid --fail-on category %q; valid categories are %s, %s and %s",
+				category, DiffAdded, DiffRemoved, DiffChanged)
+		}
+	}
+
 	return d, nil
 }
 
@@ -93,7 +158,66 @@ func (d *Diff) Run() error {
 	}
 	location2 := diff.NewLocation(d.src2)
 
-	r, err := d.diffFn(d.app, d.clientConfig, d.components, location1, location2)
+	if d.fix {
+		if err := d.checkFix(location1, location2); err != nil {
+			return err
+		}
+	}
+
+	err := d.diff(location1, location2)
+	if d.fix && err == ErrDiffFound {
+		return d.fixDrift(location1, location2)
+	}
+
+	if len(d.failOn) > 0 && err == ErrDiffFound {
+		return d.checkFailOn(location1, location2)
+	}
+
+	return err
+}
+
+// checkFailOn returns ErrDiffFound if objects would be added, removed or
+// changed and the category was selected with --fail-on.
+func (d *Diff) checkFailOn(location1, location2 *diff.Location) error {
+	if d.summary == nil {
+		summary, err := d.summaryFn(d.app, d.clientConfig, d.components, location1, location2)
+		if err != nil {
+			return err
+		}
+		d.summary = &summary
+	}
+
+	counts := map[string]int{
+		DiffAdded:   d.summary.Created,
+		DiffRemoved: d.summary.Deleted,
+		DiffChanged: d.summary.Modified,
+	}
+
+	for _, category := range d.failOn {
+		if counts[category] > 0 {
+			return ErrDiffFound
+		}
+	}
+
+	return nil
+}
+
+// diff writes the differences between the locations in the selected output.
+func (d *Diff) diff(location1, location2 *diff.Location) error {
+	if d.summaryOnly {
+		return d.writeSummary(location1, location2)
+	}
+
+	if d.output == OutputPatch {
+		return d.writePatches(location1, location2)
+	}
+
+	diffFn := d.diffFn
+	if d.threeWay {
+		diffFn = d.threeWayDiffFn
+	}
+
+	r, err := diffFn(d.app, d.clientConfig, d.components, location1, location2)
 	if err != nil {
 		return err
 	}
@@ -131,3 +255,120 @@ func (d *Diff) Run() error {
 
 	return nil
 }
+
+// writePatches writes a JSON array with a patch for each object that differs.
+func (d *Diff) writePatches(location1, location2 *diff.Location) error {
+	patches, err := d.patchFn(d.app, d.clientConfig, d.components, location1, location2)
+	if err != nil {
+		return err
+	}
+
+	if patches == nil {
+		patches = []diff.Patch{}
+	}
+
+	b, err := json.MarshalIndent(patches, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(d.out, string(b))
+
+	if len(patches) > 0 {
+		return ErrDiffFound
+	}
+
+	return nil
+}
+
+// diffSummary is the JSON form of a diff summary.
+type diffSummary struct {
+	Src1 string `json:"src1"`
+	Src2 string `json:"src2"`
+	diff.Summary
+}
+
+// writeSummary writes the number of objects that differ between the locations.
+func (d *Diff) writeSummary(location1, location2 *diff.Location) error {
+	summary, err := d.summaryFn(d.app, d.clientConfig, d.components, location1, location2)
+	if err != nil {
+		return err
+	}
+	d.summary = &summary
+
+	if d.output == OutputJSON {
+		b, err := json.MarshalIndent(diffSummary{
+			Src1:    location1.String(),
+			Src2:    location2.String(),
+			Summary: summary,
+		}, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintln(d.out, string(b))
+	} else {
+		fmt.Fprintf(d.out, "%s vs %s: %d created, %d modified, %d deleted\n",
+			location1, location2, summary.Created, summary.Modified, summary.Deleted)
+	}
+
+	if summary.Changed() {
+		return ErrDiffFound
+	}
+
+	return nil
+}
+
+// checkFix checks that drift between the locations can be fixed. Only the
+// objects of an environment's cluster can be fixed from its local manifests.
+func (d *Diff) checkFix(location1, location2 *diff.Location) error {
+	if location1.Destination() != "local" || location2.Destination() != "remote" ||
+		location1.EnvName() != location2.EnvName() {
+		return errors.Errorf("--fix requires local and remote locations of the same environment, not %s and %s",
+			location1, location2)
+	}
+
+	if d.readOnly && !d.dryRun {
+		return newReadOnlyError("diff --fix")
+	}
+
+	return nil
+}
+
+// fixDrift applies the local objects that differ from, or are missing in, the
+// cluster. Other objects are left alone, and garbage collection is skipped.
+func (d *Diff) fixDrift(location1, location2 *diff.Location) error {
+	objects, err := d.driftedFn(d.app, d.clientConfig, d.components, location1, location2)
+	if err != nil {
+		return errors.Wrap(err, "find drifted objects")
+	}
+
+	if len(objects) == 0 {
+		// The differences can't be fixed by applying objects.
+		return ErrDiffFound
+	}
+
+	for _, obj := range objects {
+		logrus.Infof("fixing drift of %s %s", obj.GetKind(), obj.GetName())
+	}
+
+	config := cluster.ApplyConfig{
+		App:          d.app,
+		ClientConfig: d.clientConfig,
+		Create:       true,
+		DryRun:       d.dryRun,
+		EnvName:      location1.EnvName(),
+		SkipGc:       true,
+		Objects:      objects,
+	}
+
+	if err := d.runApplyFn(config); err != nil {
+		return errors.Wrap(err, "fix drift")
+	}
+
+	if d.dryRun {
+		return ErrDiffFound
+	}
+
+	return nil
+}
@@ -24,9 +24,11 @@ import (
 	"github.com/ksonnet/ksonnet/pkg/app"
 	amocks "github.com/ksonnet/ksonnet/pkg/app/mocks"
 	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/ksonnet/ksonnet/pkg/cluster"
 	"github.com/ksonnet/ksonnet/pkg/diff"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
 func TestDiff(t *testing.T) {
@@ -107,6 +109,461 @@ func TestDiff(t *testing.T) {
 	}
 }
 
+func TestDiff_patch_output(t *testing.T) {
+	cases := []struct {
+		name       string
+		patches    []diff.Patch
+		outputFile string
+		isErr      bool
+	}{
+		{
+			name: "patches found",
+			patches: []diff.Patch{
+				{
+					APIVersion: "apps/v1",
+					Kind:       "Deployment",
+					Namespace:  "default",
+					Name:       "guestbook",
+					Operation:  diff.PatchOperationPatch,
+					Patch:      []byte(`{"spec":{"replicas":3}}`),
+				},
+			},
+			outputFile: "diff/patches.json",
+			isErr:      true,
+		},
+		{
+			name:       "no patches",
+			outputFile: "diff/no-patches.json",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withApp(t, func(appMock *amocks.App) {
+				in := map[string]interface{}{
+					OptionApp:            appMock,
+					OptionClientConfig:   &client.Config{},
+					OptionComponentNames: []string{},
+					OptionSrc1:           "default",
+					OptionOutput:         OutputPatch,
+				}
+
+				d, err := NewDiff(in)
+				require.NoError(t, err)
+
+				var buf bytes.Buffer
+				d.out = &buf
+
+				d.diffFn = func(a app.App, c *client.Config, components []string, l1 *diff.Location, l2 *diff.Location) (io.Reader, error) {
+					t.Errorf("unexpected call: diff")
+					return nil, nil
+				}
+
+				d.patchFn = func(a app.App, c *client.Config, components []string, l1 *diff.Location, l2 *diff.Location) ([]diff.Patch, error) {
+					assert.Equal(t, "local:default", l1.String(), "location1")
+					assert.Equal(t, "remote:default", l2.String(), "location2")
+					return tc.patches, nil
+				}
+
+				err = d.Run()
+				if tc.isErr {
+					require.Equal(t, ErrDiffFound, err)
+				} else {
+					require.NoError(t, err)
+				}
+
+				assertOutput(t, tc.outputFile, buf.String())
+			})
+		})
+	}
+}
+
+func TestDiff_summary_output(t *testing.T) {
+	cases := []struct {
+		name       string
+		output     string
+		summary    diff.Summary
+		outputFile string
+		isErr      bool
+	}{
+		{
+			name:       "text",
+			summary:    diff.Summary{Created: 1, Modified: 2},
+			outputFile: "diff/summary.txt",
+			isErr:      true,
+		},
+		{
+			name:       "json",
+			output:     OutputJSON,
+			summary:    diff.Summary{Deleted: 3},
+			outputFile: "diff/summary.json",
+			isErr:      true,
+		},
+		{
+			name:       "no differences",
+			outputFile: "diff/no-summary.txt",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withApp(t, func(appMock *amocks.App) {
+				in := map[string]interface{}{
+					OptionApp:            appMock,
+					OptionClientConfig:   &client.Config{},
+					OptionComponentNames: []string{},
+					OptionSrc1:           "default",
+					OptionOutput:         tc.output,
+					OptionSummaryOnly:    true,
+				}
+
+				d, err := NewDiff(in)
+				require.NoError(t, err)
+
+				var buf bytes.Buffer
+				d.out = &buf
+
+				d.diffFn = func(a app.App, c *client.Config, components []string, l1 *diff.Location, l2 *diff.Location) (io.Reader, error) {
+					t.Errorf("unexpected call: diff")
+					return nil, nil
+				}
+
+				d.summaryFn = func(a app.App, c *client.Config, components []string, l1 *diff.Location, l2 *diff.Location) (diff.Summary, error) {
+					assert.Equal(t, "local:default", l1.String(), "location1")
+					assert.Equal(t, "remote:default", l2.String(), "location2")
+					return tc.summary, nil
+				}
+
+				err = d.Run()
+				if tc.isErr {
+					require.Equal(t, ErrDiffFound, err)
+				} else {
+					require.NoError(t, err)
+				}
+
+				assertOutput(t, tc.outputFile, buf.String())
+			})
+		})
+	}
+}
+
+func TestDiff_summary_invalid_output(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		in := map[string]interface{}{
+			OptionApp:            appMock,
+			OptionClientConfig:   &client.Config{},
+			OptionComponentNames: []string{},
+			OptionSrc1:           "default",
+			OptionOutput:         OutputPatch,
+			OptionSummaryOnly:    true,
+		}
+
+		_, err := NewDiff(in)
+		require.Error(t, err)
+	})
+}
+
+func TestDiff_fix(t *testing.T) {
+	drifted := []*unstructured.Unstructured{
+		{Object: map[string]interface{}{"kind": "Deployment", "metadata": map[string]interface{}{"name": "web"}}},
+	}
+
+	cases := []struct {
+		name      string
+		src1      string
+		src2      string
+		diffText  string
+		dryRun    bool
+		readOnly  bool
+		drifted   []*unstructured.Unstructured
+		isApplied bool
+		err       error
+		isErr     bool
+	}{
+		{
+			name:      "drift fixed",
+			src1:      "default",
+			diffText:  "+foo\n",
+			drifted:   drifted,
+			isApplied: true,
+		},
+		{
+			name:      "dry run",
+			src1:      "default",
+			diffText:  "+foo\n",
+			dryRun:    true,
+			drifted:   drifted,
+			isApplied: true,
+			err:       ErrDiffFound,
+		},
+		{
+			name: "no drift",
+			src1: "default",
+		},
+		{
+			name:     "drift that can't be applied",
+			src1:     "default",
+			diffText: "-foo\n",
+			err:      ErrDiffFound,
+		},
+		{
+			name:  "two environments",
+			src1:  "local:default",
+			src2:  "remote:other",
+			isErr: true,
+		},
+		{
+			name:     "read-only",
+			src1:     "default",
+			readOnly: true,
+			isErr:    true,
+		},
+		{
+			name:      "read-only dry run",
+			src1:      "default",
+			diffText:  "+foo\n",
+			dryRun:    true,
+			readOnly:  true,
+			drifted:   drifted,
+			isApplied: true,
+			err:       ErrDiffFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withApp(t, func(appMock *amocks.App) {
+				in := map[string]interface{}{
+					OptionApp:            appMock,
+					OptionClientConfig:   &client.Config{},
+					OptionComponentNames: []string{},
+					OptionSrc1:           tc.src1,
+					OptionSrc2:           tc.src2,
+					OptionFix:            true,
+					OptionDryRun:         tc.dryRun,
+					OptionReadOnly:       tc.readOnly,
+				}
+
+				d, err := NewDiff(in)
+				require.NoError(t, err)
+
+				var buf bytes.Buffer
+				d.out = &buf
+
+				d.diffFn = func(a app.App, c *client.Config, components []string, l1 *diff.Location, l2 *diff.Location) (io.Reader, error) {
+					return strings.NewReader(tc.diffText), nil
+				}
+
+				d.driftedFn = func(a app.App, c *client.Config, components []string, l1 *diff.Location, l2 *diff.Location) ([]*unstructured.Unstructured, error) {
+					assert.Equal(t, "local:default", l1.String(), "location1")
+					assert.Equal(t, "remote:default", l2.String(), "location2")
+					return tc.drifted, nil
+				}
+
+				isApplied := false
+				d.runApplyFn = func(config cluster.ApplyConfig, opts ...cluster.ApplyOpts) error {
+					isApplied = true
+
+					expected := cluster.ApplyConfig{
+						App:          appMock,
+						ClientConfig: &client.Config{},
+						Create:       true,
+						DryRun:       tc.dryRun,
+						EnvName:      "default",
+						SkipGc:       true,
+						Objects:      drifted,
+					}
+					assert.Equal(t, expected, config)
+					return nil
+				}
+
+				err = d.Run()
+				switch {
+				case tc.isErr:
+					require.Error(t, err)
+				case tc.err != nil:
+					require.Equal(t, tc.err, err)
+				default:
+					require.NoError(t, err)
+				}
+
+				assert.Equal(t, tc.isApplied, isApplied)
+			})
+		})
+	}
+}
+
+func TestDiff_fail_on(t *testing.T) {
+	cases := []struct {
+		name        string
+		failOn      []string
+		summaryOnly bool
+		summary     diff.Summary
+		isFailure   bool
+	}{
+		{
+			name:      "changed only with removed selected",
+			failOn:    []string{DiffAdded, DiffRemoved},
+			summary:   diff.Summary{Modified: 2},
+			isFailure: false,
+		},
+		{
+			name:      "removed with removed selected",
+			failOn:    []string{DiffAdded, DiffRemoved},
+			summary:   diff.Summary{Modified: 2, Deleted: 1},
+			isFailure: true,
+		},
+		{
+			name:        "summary output",
+			failOn:      []string{DiffAdded},
+			summaryOnly: true,
+			summary:     diff.Summary{Created: 1},
+			isFailure:   true,
+		},
+		{
+			name:        "summary output without selected changes",
+			failOn:      []string{DiffChanged},
+			summaryOnly: true,
+			summary:     diff.Summary{Created: 1},
+			isFailure:   false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withApp(t, func(appMock *amocks.App) {
+				in := map[string]interface{}{
+					OptionApp:            appMock,
+					OptionClientConfig:   &client.Config{},
+					OptionComponentNames: []string{},
+					OptionSrc1:           "default",
+					OptionFailOn:         tc.failOn,
+					OptionSummaryOnly:    tc.summaryOnly,
+				}
+
+				d, err := NewDiff(in)
+				require.NoError(t, err)
+
+				var buf bytes.Buffer
+				d.out = &buf
+
+				d.diffFn = func(a app.App, c *client.Config, components []string, l1 *diff.Location, l2 *diff.Location) (io.Reader, error) {
+					return strings.NewReader("+foo\n"), nil
+				}
+
+				summaries := 0
+				d.summaryFn = func(a app.App, c *client.Config, components []string, l1 *diff.Location, l2 *diff.Location) (diff.Summary, error) {
+					summaries++
+					return tc.summary, nil
+				}
+
+				err = d.Run()
+				if tc.isFailure {
+					require.Equal(t, ErrDiffFound, err)
+				} else {
+					require.NoError(t, err)
+				}
+
+				assert.Equal(t, 1, summaries, "summary should be computed once")
+			})
+		})
+	}
+}
+
+func TestDiff_three_way(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		in := map[string]interface{}{
+			OptionApp:            appMock,
+			OptionClientConfig:   &client.Config{},
+			OptionComponentNames: []string{},
+			OptionSrc1:           "default",
+			OptionThreeWay:       true,
+		}
+
+		d, err := NewDiff(in)
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		d.out = &buf
+
+		d.diffFn = func(app.App, *client.Config, []string, *diff.Location, *diff.Location) (io.Reader, error) {
+			t.Error("unexpected two-way diff")
+			return strings.NewReader(""), nil
+		}
+
+		d.threeWayDiffFn = func(a app.App, c *client.Config, components []string, l1 *diff.Location, l2 *diff.Location) (io.Reader, error) {
+			assert.Equal(t, "local:default", l1.String(), "location1")
+			assert.Equal(t, "remote:default", l2.String(), "location2")
+			return strings.NewReader("-  replicas: 3\n+  replicas: 5\n"), nil
+		}
+
+		err = d.Run()
+		require.Equal(t, ErrDiffFound, err)
+		assert.Contains(t, buf.String(), "replicas: 5")
+	})
+}
+
+func TestDiff_three_way_invalid_output(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		in := map[string]interface{}{
+			OptionApp:            appMock,
+			OptionClientConfig:   &client.Config{},
+			OptionComponentNames: []string{},
+			OptionSrc1:           "default",
+			OptionOutput:         OutputPatch,
+			OptionThreeWay:       true,
+		}
+
+		_, err := NewDiff(in)
+		require.Error(t, err)
+	})
+}
+
+func TestDiff_invalid_fail_on(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		in := map[string]interface{}{
+			OptionApp:            appMock,
+			OptionClientConfig:   &client.Config{},
+			OptionComponentNames: []string{},
+			OptionSrc1:           "default",
+			OptionFailOn:         []string{"renamed"},
+		}
+
+		_, err := NewDiff(in)
+		require.Error(t, err)
+	})
+}
+
+func TestDiff_dry_run_requires_fix(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		in := map[string]interface{}{
+			OptionApp:            appMock,
+			OptionClientConfig:   &client.Config{},
+			OptionComponentNames: []string{},
+			OptionSrc1:           "default",
+			OptionDryRun:         true,
+		}
+
+		_, err := NewDiff(in)
+		require.Error(t, err)
+	})
+}
+
+func TestDiff_invalid_output(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		in := map[string]interface{}{
+			OptionApp:            appMock,
+			OptionClientConfig:   &client.Config{},
+			OptionComponentNames: []string{},
+			OptionSrc1:           "default",
+			OptionOutput:         "yaml",
+		}
+
+		_, err := NewDiff(in)
+		require.Error(t, err)
+	})
+}
+
 func TestDiff_requires_app(t *testing.T) {
 	in := make(map[string]interface{})
 	_, err := NewDiff(in)
@@ -16,8 +16,21 @@
 package actions
 
 import (
+	"fmt"
+	"io"
+	"os"
+	"os/user"
+	"path"
+	"strings"
+	"time"
+
 	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/ksonnet/ksonnet/pkg/component"
 	"github.com/ksonnet/ksonnet/pkg/env"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/afero"
 )
 
 // RunEnvAdd runs `env add`
@@ -35,11 +48,38 @@ type EnvAdd struct {
 	app         app.App
 	envName     string
 	server      string
+	serverCert  string
 	namespace   string
+	context     string
 	k8sSpecFlag string
+	skipLib     bool
 	isOverride  bool
+	seeds       []string
+	record      bool
+	user        string
+	commandLine string
+	gitignore   bool
+	helmValues  string
+	inherits    string
+	checkReach  bool
+	skipNsCheck bool
+	strict      bool
+	dryRun      bool
+	out         io.Writer
 
-	envCreateFn func(a app.App, d env.Destination, name, k8sSpecFlag string, overrideData, paramsData []byte, isOverride bool) error
+	envCreateFn     func(a app.App, d env.Destination, name, k8sSpecFlag string, overrideData, paramsData []byte, isOverride bool) error
+	overrideDataFn  func(a app.App, data env.TemplateData) ([]byte, error)
+	seedComponentFn func(a app.App, prototypeName, componentName string) error
+	currentUserFn   func() (string, error)
+	nowFn           func() time.Time
+	gitignoreFn     func(a app.App, envName string) error
+	componentsFn    func(a app.App) ([]string, error)
+	helmValuesFn    func(a app.App, envName string, data []byte, componentNames []string) ([]string, error)
+	probeServerFn   func(server string, timeout time.Duration) error
+	namespaceFn     func(server, namespace string, timeout time.Duration) (bool, error)
+	knownServerFn   func(server string) (bool, error)
+	checkInheritFn  func(a app.App, name, parent string) error
+	createOpsFn     func(a app.App, name string, isOverride bool) ([]env.Operation, error)
 }
 
 // NewEnvAdd creates an instance of EnvAdd.
@@ -50,11 +90,52 @@ func NewEnvAdd(m map[string]interface{}) (*EnvAdd, error) {
 		app:         ol.LoadApp(),
 		envName:     ol.LoadString(OptionEnvName),
 		server:      ol.LoadString(OptionServer),
+		serverCert:  ol.LoadOptionalString(OptionServerCert),
 		namespace:   ol.LoadString(OptionModule),
+		context:     ol.LoadOptionalString(OptionContext),
 		k8sSpecFlag: ol.LoadString(OptionSpecFlag),
+		skipLib:     ol.LoadOptionalBool(OptionSkipLib),
 		isOverride:  ol.LoadBool(OptionOverride),
+		seeds:       ol.LoadOptionalStringSlice(OptionPostApplyComponents),
+		record:      ol.LoadOptionalBool(OptionRecord),
+		user:        ol.LoadOptionalString(OptionUser),
+		commandLine: ol.LoadOptionalString(OptionCommandLine),
+		gitignore:   ol.LoadOptionalBool(OptionGenerateGitignore),
+		helmValues:  ol.LoadOptionalString(OptionFromHelmValues),
+		inherits:    ol.LoadOptionalString(OptionInherits),
+		checkReach:  ol.LoadOptionalBool(OptionCheckReachability),
+		skipNsCheck: ol.LoadOptionalBool(OptionSkipNsCheck),
+		strict:      ol.LoadOptionalBool(OptionStrict),
+		dryRun:      ol.LoadOptionalBool(OptionDryRun),
+		out:         os.Stdout,
+
+		envCreateFn:     env.Create,
+		overrideDataFn:  env.OverrideData,
+		seedComponentFn: seedComponent,
+		currentUserFn:   currentUser,
+		nowFn:           time.Now,
+		gitignoreFn:     env.EnsureGitignore,
+		componentsFn:    componentNames,
+		helmValuesFn:    env.ImportHelmValues,
+		checkInheritFn:  env.CheckInheritance,
+		createOpsFn:     env.CreateOperations,
+	}
+
+	if ea.checkReach {
+		clientConfig := ol.LoadClientConfig()
+		ea.probeServerFn = func(server string, timeout time.Duration) error {
+			_, err := clientConfig.ServerVersionAt(server, ea.serverCert, timeout)
+			return err
+		}
+	}
 
-		envCreateFn: env.Create,
+	if !ea.skipNsCheck {
+		if clientConfig, ok := ol.loadOptional(OptionClientConfig).(*client.Config); ok {
+			ea.namespaceFn = func(server, namespace string, timeout time.Duration) (bool, error) {
+				return clientConfig.NamespaceExistsAt(server, ea.serverCert, namespace, timeout)
+			}
+			ea.knownServerFn = clientConfig.HasServer
+		}
 	}
 
 	if ol.err != nil {
@@ -66,15 +147,369 @@ func NewEnvAdd(m map[string]interface{}) (*EnvAdd, error) {
 
 // Run assigns targets to an environment.
 func (ea *EnvAdd) Run() error {
-	destination := env.NewDestination(ea.server, ea.namespace)
+	// A server resolved from a context is used as the kubeconfig has it.
+	if ea.context == "" && ea.server != "" {
+		server, err := normalizeServer(ea.server)
+		if err != nil {
+			return err
+		}
+		ea.server = server
+	}
+
+	if ea.checkReach {
+		if err := ea.checkServer(); err != nil {
+			return err
+		}
+	}
+
+	if ea.namespaceFn != nil && ea.server != "" {
+		if err := ea.checkNamespace(); err != nil {
+			return err
+		}
+	}
+
+	if ea.inherits != "" {
+		if err := ea.checkInheritFn(ea.app, ea.envName, ea.inherits); err != nil {
+			return err
+		}
+	}
+
+	overrideData, err := ea.overrideDataFn(ea.app, env.TemplateData{
+		Name:      ea.envName,
+		Namespace: ea.namespace,
+		Server:    ea.server,
+	})
+	if err != nil {
+		return err
+	}
+
+	if ea.dryRun {
+		return ea.writeOperations()
+	}
 
-	return ea.envCreateFn(
+	destination := env.NewContextDestination(ea.server, ea.namespace, ea.context)
+	destination.SetServerCert(ea.serverCert)
+
+	k8sSpecFlag := ea.k8sSpecFlag
+	if ea.skipLib {
+		k8sSpecFlag = ""
+	}
+
+	err = ea.envCreateFn(
 		ea.app,
 		destination,
 		ea.envName,
-		ea.k8sSpecFlag,
-		env.DefaultOverrideData,
+		k8sSpecFlag,
+		overrideData,
 		env.DefaultParamsData,
 		ea.isOverride,
 	)
+	if err != nil {
+		return err
+	}
+
+	if ea.skipLib && ea.k8sSpecFlag != "" {
+		if err := ea.setLibPending(); err != nil {
+			return errors.Wrap(err, "record ungenerated ksonnet-lib")
+		}
+	}
+
+	if ea.inherits != "" {
+		if err := ea.setInherits(); err != nil {
+			return errors.Wrap(err, "set environment parent")
+		}
+	}
+
+	if ea.record {
+		if err := ea.recordProvenance(); err != nil {
+			return errors.Wrap(err, "record environment provenance")
+		}
+	}
+
+	if ea.gitignore {
+		if err := ea.gitignoreFn(ea.app, ea.envName); err != nil {
+			return errors.Wrap(err, "generate .gitignore")
+		}
+	}
+
+	for _, seed := range ea.seeds {
+		prototypeName, componentName := parseSeed(seed)
+		if err := ea.seedComponentFn(ea.app, prototypeName, componentName); err != nil {
+			return errors.Wrapf(err, "seed component from prototype %q", prototypeName)
+		}
+	}
+
+	if ea.helmValues != "" {
+		if err := ea.importHelmValues(); err != nil {
+			return errors.Wrap(err, "import Helm values")
+		}
+	}
+
+	return nil
+}
+
+// writeOperations writes the changes adding the environment would make,
+// without making them.
+func (ea *EnvAdd) writeOperations() error {
+	ops, err := ea.createOpsFn(ea.app, ea.envName, ea.isOverride)
+	if err != nil {
+		return err
+	}
+
+	envDir := path.Join(app.EnvironmentDirName, ea.envName)
+
+	if ea.k8sSpecFlag != "" && !ea.skipLib {
+		ops = append(ops, env.Operation{
+			Action: env.OpGenerate,
+			Path:   app.LibDirName + "/",
+			Detail: fmt.Sprintf("ksonnet-lib for %s, unless it was generated before", ea.k8sSpecFlag),
+		})
+	}
+
+	if ea.gitignore {
+		ops = append(ops, env.Operation{Action: env.OpUpdate, Path: path.Join(envDir, ".gitignore")})
+	}
+
+	for _, seed := range ea.seeds {
+		prototypeName, componentName := parseSeed(seed)
+		ops = append(ops, env.Operation{
+			Action: env.OpGenerate,
+			Path:   "components/",
+			Detail: fmt.Sprintf("component %q from prototype %q, unless it exists", componentName, prototypeName),
+		})
+	}
+
+	if ea.helmValues != "" {
+		ops = append(ops, env.Operation{
+			Action: env.OpUpdate,
+			Path:   path.Join(envDir, "params.libsonnet"),
+			Detail: "parameters from " + ea.helmValues,
+		})
+	}
+
+	fmt.Fprintf(ea.out, "Adding environment %q would:\n", ea.envName)
+	return env.WriteOperations(ea.out, ops)
+}
+
+// normalizeServer normalizes a server given by the user, and warns when it is
+// assumed to use https.
+func normalizeServer(server string) (string, error) {
+	normalized, assumed, err := env.NormalizeServer(server)
+	if err != nil {
+		return "", err
+	}
+
+	if assumed {
+		logrus.Warnf("server %q has no scheme; assuming %q", server, normalized)
+	}
+
+	return normalized, nil
+}
+
+// checkServer checks that the server of the environment responds to a version
+// request. An unreachable server is an error in strict mode, and a warning
+// otherwise.
+func (ea *EnvAdd) checkServer() error {
+	err := ea.probeServerFn(ea.server, reachabilityTimeout)
+	if err == nil {
+		return nil
+	}
+
+	msg := fmt.Sprintf("server %q is unreachable", ea.server)
+	if ea.strict {
+		return errors.Wrap(err, msg)
+	}
+
+	logrus.Warnf("%s: %v; the environment is added anyway", msg, err)
+	return nil
+}
+
+// checkNamespace checks that the namespace of the environment exists on its
+// cluster. A missing namespace is an error in strict mode, and a warning
+// otherwise. If the cluster can't be asked, e.g. when scaffolding offline, the
+// check is skipped. It is also skipped for a server that isn't a kubeconfig
+// cluster when no server certificate is given, as the server couldn't be
+// trusted with credentials.
+func (ea *EnvAdd) checkNamespace() error {
+	if ea.serverCert == "" {
+		known, err := ea.knownServerFn(ea.server)
+		if err != nil {
+			logrus.Debugf("unable to look up server %q in kubeconfig: %v", ea.server, err)
+		}
+		if !known {
+			logrus.Infof("skipping the check that namespace %q exists: server %q isn't a kubeconfig cluster and no server certificate was given",
+				ea.namespace, ea.server)
+			return nil
+		}
+	}
+
+	exists, err := ea.namespaceFn(ea.server, ea.namespace, reachabilityTimeout)
+	if err != nil {
+		logrus.Debugf("unable to check that namespace %q exists on the cluster at %q: %v", ea.namespace, ea.server, err)
+		return nil
+	}
+
+	if exists {
+		return nil
+	}
+
+	msg := fmt.Sprintf("namespace %q doesn't exist on the cluster at %q", ea.namespace, ea.server)
+	if ea.strict {
+		return errors.New(msg)
+	}
+
+	logrus.Warnf("%s; the environment is added anyway", msg)
+	return nil
+}
+
+// importHelmValues maps a Helm values.yaml onto the environment's params.
+// Components are seeded first so values for them can be mapped.
+func (ea *EnvAdd) importHelmValues() error {
+	data, err := afero.ReadFile(ea.app.Fs(), ea.helmValues)
+	if err != nil {
+		return err
+	}
+
+	names, err := ea.componentsFn(ea.app)
+	if err != nil {
+		return errors.Wrap(err, "find components")
+	}
+
+	unmapped, err := ea.helmValuesFn(ea.app, ea.envName, data, names)
+	if err != nil {
+		return err
+	}
+
+	for _, path := range unmapped {
+		logrus.Warnf("Helm value %q could not be mapped to a parameter; it is listed in the environment's params.libsonnet", path)
+	}
+
+	return nil
+}
+
+// componentNames returns the namespaced names of all components in the app.
+func componentNames(a app.App) ([]string, error) {
+	modules, err := component.Modules(a)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, m := range modules {
+		components, err := m.Components()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, c := range components {
+			names = append(names, c.Name(true))
+		}
+	}
+
+	return names, nil
+}
+
+// setInherits saves the environment the new environment inherits parameters
+// from.
+func (ea *EnvAdd) setInherits() error {
+	e, err := ea.app.Environment(ea.envName)
+	if err != nil {
+		return err
+	}
+
+	e.Inherits = ea.inherits
+	if ea.isOverride {
+		e.Libraries = nil
+	}
+
+	return ea.app.AddEnvironment(e, "", ea.isOverride)
+}
+
+// setLibPending saves the API spec of the environment's ksonnet-lib, so it is
+// generated the first time it is needed.
+func (ea *EnvAdd) setLibPending() error {
+	e, err := ea.app.Environment(ea.envName)
+	if err != nil {
+		return err
+	}
+
+	e.LibPending = ea.k8sSpecFlag
+	if ea.isOverride {
+		e.Libraries = nil
+	}
+
+	return ea.app.AddEnvironment(e, "", ea.isOverride)
+}
+
+// recordProvenance saves who created the environment, when, and how.
+func (ea *EnvAdd) recordProvenance() error {
+	userName := ea.user
+	if userName == "" {
+		var err error
+		if userName, err = ea.currentUserFn(); err != nil {
+			return errors.Wrap(err, "find current user; use --as-user to set it")
+		}
+	}
+
+	source := "server " + ea.server
+	if ea.context != "" {
+		source = "context " + ea.context
+	}
+
+	e, err := ea.app.Environment(ea.envName)
+	if err != nil {
+		return err
+	}
+
+	e.Provenance = &app.EnvironmentProvenance{
+		User:      userName,
+		Timestamp: ea.nowFn().UTC().Format(time.RFC3339),
+		Command:   ea.commandLine,
+		Source:    source,
+	}
+	if ea.isOverride {
+		e.Libraries = nil
+	}
+
+	return ea.app.AddEnvironment(e, "", ea.isOverride)
+}
+
+// currentUser returns the name of the user running ks.
+func currentUser() (string, error) {
+	u, err := user.Current()
+	if err != nil {
+		return "", err
+	}
+
+	return u.Username, nil
+}
+
+// parseSeed parses a seed in the form `<prototype>[:<component>]`. If the
+// component name is omitted, the last segment of the prototype name is used.
+func parseSeed(seed string) (prototypeName, componentName string) {
+	prototypeName = seed
+	if i := strings.Index(seed, ":"); i != -1 {
+		return seed[:i], seed[i+1:]
+	}
+
+	componentName = prototypeName
+	if i := strings.LastIndex(prototypeName, "."); i != -1 {
+		componentName = prototypeName[i+1:]
+	}
+
+	return prototypeName, componentName
+}
+
+// seedComponent generates a component from a prototype. Components are shared
+// by all environments, so nothing is generated if the component already exists.
+func seedComponent(a app.App, prototypeName, componentName string) error {
+	if _, c, err := component.ResolvePath(a, componentName); err == nil && c != nil {
+		logrus.Infof("component %q already exists, not generating it from %q", componentName, prototypeName)
+		return nil
+	}
+
+	return RunPrototypeUse(map[string]interface{}{
+		OptionApp:       a,
+		OptionArguments: []string{prototypeName, componentName},
+	})
 }
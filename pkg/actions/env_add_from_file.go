@@ -0,0 +1,260 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package actions
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/ksonnet/ksonnet/pkg/env"
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+)
+
+// RunEnvAddFromFile runs `env add --from-file`.
+func RunEnvAddFromFile(m map[string]interface{}) error {
+	ea, err := NewEnvAddFromFile(m)
+	if err != nil {
+		return err
+	}
+
+	return ea.Run()
+}
+
+// envFile is a file listing environments to add.
+type envFile struct {
+	Environments []envFileEntry `json:"environments"`
+}
+
+// envFileEntry is an environment listed in an environments file. The server
+// and namespace are taken from the context, or the current context, if the
+// server isn't set.
+type envFileEntry struct {
+	Name      string `json:"name"`
+	Server    string `json:"server,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Context   string `json:"context,omitempty"`
+	Spec      string `json:"spec,omitempty"`
+}
+
+// envDestination is where the environment of an envFileEntry points to.
+type envDestination struct {
+	server     string
+	namespace  string
+	context    string
+	serverCert string
+}
+
+// EnvAddFromFile adds the environments listed in a file.
+type EnvAddFromFile struct {
+	app          app.App
+	path         string
+	failFast     bool
+	isOverride   bool
+	skipLib      bool
+	skipNsCheck  bool
+	clientConfig *client.Config
+	out          io.Writer
+
+	envAddFn      func(m map[string]interface{}) error
+	destinationFn func(entry envFileEntry) (envDestination, error)
+	defaultSpecFn func() (string, error)
+	clusterSpecFn func() string
+}
+
+// NewEnvAddFromFile creates an instance of EnvAddFromFile.
+func NewEnvAddFromFile(m map[string]interface{}) (*EnvAddFromFile, error) {
+	ol := newOptionLoader(m)
+
+	ea := &EnvAddFromFile{
+		app:          ol.LoadApp(),
+		path:         ol.LoadString(OptionFromFile),
+		failFast:     ol.LoadOptionalBool(OptionFailFast),
+		isOverride:   ol.LoadOptionalBool(OptionOverride),
+		skipLib:      ol.LoadOptionalBool(OptionSkipLib),
+		skipNsCheck:  ol.LoadOptionalBool(OptionSkipNsCheck),
+		clientConfig: ol.LoadClientConfig(),
+		out:          os.Stdout,
+
+		envAddFn: RunEnvAdd,
+	}
+
+	if ol.err != nil {
+		return nil, ol.err
+	}
+
+	ea.destinationFn = ea.destination
+	ea.defaultSpecFn = ea.app.DefaultAPISpec
+	ea.clusterSpecFn = ea.clientConfig.GetAPISpec
+
+	return ea, nil
+}
+
+// Run adds each environment of the file. Failures are reported and the
+// remaining environments are added, unless failFast is set.
+func (ea *EnvAddFromFile) Run() error {
+	entries, err := ea.readEntries()
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, entry := range entries {
+		if err := ea.add(entry); err != nil {
+			if ea.failFast {
+				return errors.Wrapf(err, "add environment %q", entry.Name)
+			}
+
+			fmt.Fprintf(ea.out, "%s: failed: %v\n", entry.Name, err)
+			failed++
+			continue
+		}
+
+		fmt.Fprintf(ea.out, "%s: added\n", entry.Name)
+	}
+
+	if failed > 0 {
+		return errors.Errorf("%d of %d environments could not be added", failed, len(entries))
+	}
+
+	return nil
+}
+
+// readEntries reads the environments of the file. It is an error if a name
+// is invalid or used more than once, so nothing is added from a file with a
+// mistake in it.
+func (ea *EnvAddFromFile) readEntries() ([]envFileEntry, error) {
+	data, err := afero.ReadFile(ea.app.Fs(), ea.path)
+	if err != nil {
+		return nil, err
+	}
+
+	var f envFile
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		return nil, errors.Wrapf(err, "parse environments file %s", ea.path)
+	}
+
+	if len(f.Environments) == 0 {
+		return nil, errors.Errorf("environments file %s doesn't list any environments", ea.path)
+	}
+
+	counts := make(map[string]int)
+	for i, entry := range f.Environments {
+		if err := env.ValidateName(entry.Name); err != nil {
+			return nil, errors.Wrapf(err, "environment %d of %s", i+1, ea.path)
+		}
+		counts[entry.Name]++
+	}
+
+	var duplicates []string
+	for name, count := range counts {
+		if count > 1 {
+			duplicates = append(duplicates, name)
+		}
+	}
+
+	if len(duplicates) > 0 {
+		sort.Strings(duplicates)
+		return nil, errors.Errorf("environments file %s lists %s more than once", ea.path, strings.Join(duplicates, ", "))
+	}
+
+	return f.Environments, nil
+}
+
+// add adds the environment of an entry with `env add`.
+func (ea *EnvAddFromFile) add(entry envFileEntry) error {
+	d, err := ea.destinationFn(entry)
+	if err != nil {
+		return err
+	}
+
+	spec := entry.Spec
+	if spec == "" {
+		if spec, err = ea.defaultSpecFn(); err != nil {
+			return err
+		}
+	}
+	if spec == "" {
+		spec = ea.clusterSpecFn()
+	}
+
+	m := map[string]interface{}{
+		OptionApp:          ea.app,
+		OptionEnvName:      entry.Name,
+		OptionServer:       d.server,
+		OptionServerCert:   d.serverCert,
+		OptionContext:      d.context,
+		OptionModule:       d.namespace,
+		OptionSpecFlag:     spec,
+		OptionOverride:     ea.isOverride,
+		OptionSkipLib:      ea.skipLib,
+		OptionSkipNsCheck:  ea.skipNsCheck,
+		OptionClientConfig: ea.clientConfig,
+	}
+
+	return ea.envAddFn(m)
+}
+
+// destination resolves the server and namespace of an entry. If the server
+// isn't set, they are taken from the entry's context, or the current context,
+// as `env add` does. The namespace defaults to `default`.
+func (ea *EnvAddFromFile) destination(entry envFileEntry) (envDestination, error) {
+	d := envDestination{
+		server:    entry.Server,
+		namespace: entry.Namespace,
+	}
+
+	if entry.Server != "" && entry.Context != "" {
+		return d, errors.New("server and context are mutually exclusive, because context has a server")
+	}
+
+	if d.server == "" {
+		server, namespace, err := ea.clientConfig.ResolveContext(entry.Context)
+		if err != nil {
+			return d, err
+		}
+
+		d.server = server
+		if d.namespace == "" {
+			d.namespace = namespace
+		}
+
+		d.context = entry.Context
+		if d.context == "" {
+			if _, d.context, err = ea.clientConfig.Contexts(); err != nil {
+				return d, err
+			}
+		}
+
+		cert, err := ea.clientConfig.ContextServerCert(d.context)
+		if err != nil {
+			return d, err
+		}
+		d.serverCert = string(cert)
+	}
+
+	if d.namespace == "" {
+		d.namespace = "default"
+	}
+
+	return d, nil
+}
@@ -0,0 +1,186 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package actions
+
+import (
+	"bytes"
+	"testing"
+
+	amocks "github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const envAddFile = `environments:
+- name: us-west/staging
+  context: staging
+- name: us-west/prod
+  server: https://prod.example.com
+  namespace: web
+  spec: version:v1.10.3
+- name: dev
+  server: https://dev.example.com
+`
+
+func TestEnvAddFromFile(t *testing.T) {
+	cases := []struct {
+		name     string
+		file     string
+		failFast bool
+		failOn   string
+		added    []string
+		output   string
+		isErr    bool
+	}{
+		{
+			name:   "in general",
+			file:   envAddFile,
+			added:  []string{"us-west/staging", "us-west/prod", "dev"},
+			output: "us-west/staging: added\nus-west/prod: added\ndev: added\n",
+		},
+		{
+			name:   "continue past failures",
+			file:   envAddFile,
+			failOn: "us-west/prod",
+			added:  []string{"us-west/staging", "dev"},
+			output: "us-west/staging: added\nus-west/prod: failed: environment exists\ndev: added\n",
+			isErr:  true,
+		},
+		{
+			name:     "fail fast",
+			file:     envAddFile,
+			failFast: true,
+			failOn:   "us-west/prod",
+			added:    []string{"us-west/staging"},
+			output:   "us-west/staging: added\n",
+			isErr:    true,
+		},
+		{
+			name:  "duplicate names",
+			file:  envAddFile + "- name: dev\n  context: dev\n",
+			isErr: true,
+		},
+		{
+			name:  "invalid name",
+			file:  "environments:\n- name: us-west//prod\n",
+			isErr: true,
+		},
+		{
+			name:  "no environments",
+			file:  "environments: []\n",
+			isErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withApp(t, func(appMock *amocks.App) {
+				require.NoError(t, afero.WriteFile(appMock.Fs(), "/envs.yaml", []byte(tc.file), 0644))
+
+				in := map[string]interface{}{
+					OptionApp:          appMock,
+					OptionFromFile:     "/envs.yaml",
+					OptionFailFast:     tc.failFast,
+					OptionSkipLib:      true,
+					OptionClientConfig: &client.Config{},
+				}
+
+				a, err := NewEnvAddFromFile(in)
+				require.NoError(t, err)
+
+				var buf bytes.Buffer
+				a.out = &buf
+
+				a.destinationFn = func(entry envFileEntry) (envDestination, error) {
+					d := envDestination{server: entry.Server, namespace: entry.Namespace}
+					if d.server == "" {
+						d.server = "https://" + entry.Context + ".example.com"
+						d.context = entry.Context
+					}
+					if d.namespace == "" {
+						d.namespace = "default"
+					}
+					return d, nil
+				}
+				a.defaultSpecFn = func() (string, error) {
+					return "", nil
+				}
+				a.clusterSpecFn = func() string {
+					return "version:v1.8.0"
+				}
+
+				var added []string
+				specs := make(map[string]string)
+				a.envAddFn = func(m map[string]interface{}) error {
+					name := m[OptionEnvName].(string)
+					if name == tc.failOn {
+						return errors.New("environment exists")
+					}
+
+					assert.Equal(t, appMock, m[OptionApp])
+					assert.Equal(t, true, m[OptionSkipLib])
+					added = append(added, name)
+					specs[name] = m[OptionSpecFlag].(string)
+					return nil
+				}
+
+				err = a.Run()
+				if tc.isErr {
+					require.Error(t, err)
+				} else {
+					require.NoError(t, err)
+				}
+
+				assert.Equal(t, tc.added, added)
+				assert.Equal(t, tc.output, buf.String())
+
+				if tc.failOn == "" && !tc.isErr {
+					assert.Equal(t, "version:v1.10.3", specs["us-west/prod"])
+					assert.Equal(t, "version:v1.8.0", specs["dev"])
+				}
+			})
+		})
+	}
+}
+
+func TestEnvAddFromFile_destination(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		in := map[string]interface{}{
+			OptionApp:          appMock,
+			OptionFromFile:     "/envs.yaml",
+			OptionClientConfig: &client.Config{},
+		}
+
+		a, err := NewEnvAddFromFile(in)
+		require.NoError(t, err)
+
+		d, err := a.destination(envFileEntry{Name: "dev", Server: "https://dev.example.com"})
+		require.NoError(t, err)
+		assert.Equal(t, envDestination{server: "https://dev.example.com", namespace: "default"}, d)
+
+		_, err = a.destination(envFileEntry{Name: "dev", Server: "https://dev.example.com", Context: "dev"})
+		require.Error(t, err)
+	})
+}
+
+func TestEnvAddFromFile_requires_app(t *testing.T) {
+	in := make(map[string]interface{})
+	_, err := NewEnvAddFromFile(in)
+	require.Error(t, err)
+}
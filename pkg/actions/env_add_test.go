@@ -16,12 +16,18 @@
 package actions
 
 import (
+	"bytes"
 	"testing"
+	"time"
 
 	"github.com/ksonnet/ksonnet/pkg/app"
 	amocks "github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/ksonnet/ksonnet/pkg/client"
 	"github.com/ksonnet/ksonnet/pkg/env"
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -63,8 +69,764 @@ func TestEnvAdd(t *testing.T) {
 	})
 }
 
+func TestEnvAdd_context(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		in := map[string]interface{}{
+			OptionApp:      appMock,
+			OptionEnvName:  "my-app",
+			OptionServer:   "http://example.com",
+			OptionModule:   "default",
+			OptionContext:  "dev",
+			OptionSpecFlag: "flag",
+			OptionOverride: false,
+		}
+
+		a, err := NewEnvAdd(in)
+		require.NoError(t, err)
+
+		a.envCreateFn = func(a app.App, d env.Destination, name, specFlag string, od, pd []byte, override bool) error {
+			expectedDest := env.NewContextDestination("http://example.com", "default", "dev")
+			assert.Equal(t, expectedDest, d)
+			return nil
+		}
+
+		err = a.Run()
+		require.NoError(t, err)
+	})
+}
+
+func TestEnvAdd_server_cert(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		in := map[string]interface{}{
+			OptionApp:        appMock,
+			OptionEnvName:    "my-app",
+			OptionServer:     "https://example.com",
+			OptionServerCert: "cert",
+			OptionModule:     "default",
+			OptionSpecFlag:   "flag",
+			OptionOverride:   false,
+		}
+
+		a, err := NewEnvAdd(in)
+		require.NoError(t, err)
+
+		a.envCreateFn = func(a app.App, d env.Destination, name, specFlag string, od, pd []byte, override bool) error {
+			expectedDest := env.NewDestination("https://example.com", "default")
+			expectedDest.SetServerCert("cert")
+			assert.Equal(t, expectedDest, d)
+			return nil
+		}
+
+		err = a.Run()
+		require.NoError(t, err)
+	})
+}
+
+func TestEnvAdd_post_apply_components(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		in := map[string]interface{}{
+			OptionApp:      appMock,
+			OptionEnvName:  "my-app",
+			OptionServer:   "http://example.com",
+			OptionModule:   "default",
+			OptionSpecFlag: "flag",
+			OptionOverride: false,
+			OptionPostApplyComponents: []string{
+				"io.ksonnet.pkg.monitoring-agent",
+				"io.ksonnet.pkg.configMap:logging-config",
+			},
+		}
+
+		a, err := NewEnvAdd(in)
+		require.NoError(t, err)
+
+		created := false
+		a.envCreateFn = func(a app.App, d env.Destination, name, specFlag string, od, pd []byte, override bool) error {
+			created = true
+			return nil
+		}
+
+		var seeded [][]string
+		a.seedComponentFn = func(a app.App, prototypeName, componentName string) error {
+			assert.True(t, created, "environment should be created before seeding components")
+			seeded = append(seeded, []string{prototypeName, componentName})
+			return nil
+		}
+
+		err = a.Run()
+		require.NoError(t, err)
+
+		expected := [][]string{
+			{"io.ksonnet.pkg.monitoring-agent", "monitoring-agent"},
+			{"io.ksonnet.pkg.configMap", "logging-config"},
+		}
+		assert.Equal(t, expected, seeded)
+	})
+}
+
+func TestEnvAdd_record(t *testing.T) {
+	now := time.Date(2018, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name       string
+		user       string
+		context    string
+		isOverride bool
+		expected   *app.EnvironmentProvenance
+	}{
+		{
+			name:    "current user from context",
+			context: "dev",
+			expected: &app.EnvironmentProvenance{
+				User:      "alice",
+				Timestamp: "2018-06-01T12:00:00Z",
+				Command:   "ks env add my-env --record",
+				Source:    "context dev",
+			},
+		},
+		{
+			name:       "explicit user from server",
+			user:       "bob",
+			isOverride: true,
+			expected: &app.EnvironmentProvenance{
+				User:      "bob",
+				Timestamp: "2018-06-01T12:00:00Z",
+				Command:   "ks env add my-env --record",
+				Source:    "server http://example.com",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withApp(t, func(appMock *amocks.App) {
+				in := map[string]interface{}{
+					OptionApp:         appMock,
+					OptionEnvName:     "my-env",
+					OptionServer:      "http://example.com",
+					OptionModule:      "default",
+					OptionContext:     tc.context,
+					OptionSpecFlag:    "flag",
+					OptionOverride:    tc.isOverride,
+					OptionRecord:      true,
+					OptionUser:        tc.user,
+					OptionCommandLine: "ks env add my-env --record",
+				}
+
+				a, err := NewEnvAdd(in)
+				require.NoError(t, err)
+
+				a.envCreateFn = func(a app.App, d env.Destination, name, specFlag string, od, pd []byte, override bool) error {
+					return nil
+				}
+				a.currentUserFn = func() (string, error) {
+					return "alice", nil
+				}
+				a.nowFn = func() time.Time {
+					return now
+				}
+
+				created := &app.EnvironmentConfig{
+					Name:      "my-env",
+					Libraries: app.LibraryConfigs{"lib": &app.LibraryConfig{Name: "lib"}},
+				}
+				appMock.On("Environment", "my-env").Return(created, nil)
+
+				expectedLibraries := created.Libraries
+				if tc.isOverride {
+					expectedLibraries = nil
+				}
+				appMock.On("AddEnvironment", mock.MatchedBy(func(e *app.EnvironmentConfig) bool {
+					return assert.Equal(t, tc.expected, e.Provenance) &&
+						assert.Equal(t, expectedLibraries, e.Libraries)
+				}), "", tc.isOverride).Return(nil)
+
+				err = a.Run()
+				require.NoError(t, err)
+			})
+		})
+	}
+}
+
+func TestEnvAdd_record_current_user_error(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		in := map[string]interface{}{
+			OptionApp:      appMock,
+			OptionEnvName:  "my-env",
+			OptionServer:   "http://example.com",
+			OptionModule:   "default",
+			OptionSpecFlag: "flag",
+			OptionOverride: false,
+			OptionRecord:   true,
+		}
+
+		a, err := NewEnvAdd(in)
+		require.NoError(t, err)
+
+		a.envCreateFn = func(a app.App, d env.Destination, name, specFlag string, od, pd []byte, override bool) error {
+			return nil
+		}
+		a.currentUserFn = func() (string, error) {
+			return "", errors.New("unknown user")
+		}
+
+		err = a.Run()
+		require.Error(t, err)
+	})
+}
+
+func TestEnvAdd_inherit(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		in := map[string]interface{}{
+			OptionApp:      appMock,
+			OptionEnvName:  "prod",
+			OptionServer:   "http://example.com",
+			OptionModule:   "default",
+			OptionSpecFlag: "flag",
+			OptionOverride: false,
+			OptionInherits: "base",
+		}
+
+		a, err := NewEnvAdd(in)
+		require.NoError(t, err)
+
+		var created bool
+		a.envCreateFn = func(a app.App, d env.Destination, name, specFlag string, od, pd []byte, override bool) error {
+			created = true
+			return nil
+		}
+
+		appMock.On("Environments").Return(app.EnvironmentConfigs{
+			"base": &app.EnvironmentConfig{Name: "base"},
+		}, nil)
+		appMock.On("Environment", "prod").Return(&app.EnvironmentConfig{Name: "prod"}, nil)
+		appMock.On("AddEnvironment", mock.MatchedBy(func(e *app.EnvironmentConfig) bool {
+			return e.Name == "prod" && e.Inherits == "base"
+		}), "", false).Return(nil)
+
+		err = a.Run()
+		require.NoError(t, err)
+		require.True(t, created)
+	})
+}
+
+func TestEnvAdd_inherit_missing_parent(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		in := map[string]interface{}{
+			OptionApp:      appMock,
+			OptionEnvName:  "prod",
+			OptionServer:   "http://example.com",
+			OptionModule:   "default",
+			OptionSpecFlag: "flag",
+			OptionOverride: false,
+			OptionInherits: "base",
+		}
+
+		a, err := NewEnvAdd(in)
+		require.NoError(t, err)
+
+		a.envCreateFn = func(a app.App, d env.Destination, name, specFlag string, od, pd []byte, override bool) error {
+			t.Errorf("unexpected call: create %q", name)
+			return nil
+		}
+
+		appMock.On("Environments").Return(app.EnvironmentConfigs{}, nil)
+
+		err = a.Run()
+		require.Error(t, err)
+		assert.Equal(t, `environment "prod" inherits from "base", which does not exist`, err.Error())
+	})
+}
+
+func TestEnvAdd_skip_lib(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		in := map[string]interface{}{
+			OptionApp:      appMock,
+			OptionEnvName:  "scratch",
+			OptionServer:   "http://example.com",
+			OptionModule:   "default",
+			OptionSpecFlag: "version:v1.10.3",
+			OptionOverride: false,
+			OptionSkipLib:  true,
+		}
+
+		a, err := NewEnvAdd(in)
+		require.NoError(t, err)
+
+		var created bool
+		a.envCreateFn = func(a app.App, d env.Destination, name, specFlag string, od, pd []byte, override bool) error {
+			created = true
+			assert.Empty(t, specFlag, "the lib is generated when the environment is created")
+			return nil
+		}
+
+		appMock.On("Environment", "scratch").Return(&app.EnvironmentConfig{Name: "scratch"}, nil)
+		appMock.On("AddEnvironment", mock.MatchedBy(func(e *app.EnvironmentConfig) bool {
+			return e.Name == "scratch" && e.LibPending == "version:v1.10.3"
+		}), "", false).Return(nil)
+
+		err = a.Run()
+		require.NoError(t, err)
+		require.True(t, created)
+	})
+}
+
+func TestEnvAdd_generate_gitignore(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		in := map[string]interface{}{
+			OptionApp:               appMock,
+			OptionEnvName:           "my-env",
+			OptionServer:            "http://example.com",
+			OptionModule:            "default",
+			OptionSpecFlag:          "flag",
+			OptionOverride:          false,
+			OptionGenerateGitignore: true,
+		}
+
+		a, err := NewEnvAdd(in)
+		require.NoError(t, err)
+
+		created := false
+		a.envCreateFn = func(a app.App, d env.Destination, name, specFlag string, od, pd []byte, override bool) error {
+			created = true
+			return nil
+		}
+
+		var gitignoreEnv string
+		a.gitignoreFn = func(a app.App, envName string) error {
+			assert.True(t, created, "environment should be created before generating .gitignore")
+			gitignoreEnv = envName
+			return nil
+		}
+
+		err = a.Run()
+		require.NoError(t, err)
+		assert.Equal(t, "my-env", gitignoreEnv)
+	})
+}
+
+func TestEnvAdd_dry_run(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		in := map[string]interface{}{
+			OptionApp:                 appMock,
+			OptionEnvName:             "my-env",
+			OptionServer:              "http://example.com",
+			OptionModule:              "default",
+			OptionSpecFlag:            "version:v1.9.5",
+			OptionOverride:            false,
+			OptionGenerateGitignore:   true,
+			OptionPostApplyComponents: []string{"io.ksonnet.pkg.redis"},
+			OptionDryRun:              true,
+		}
+
+		a, err := NewEnvAdd(in)
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		a.out = &buf
+
+		a.envCreateFn = func(a app.App, d env.Destination, name, specFlag string, od, pd []byte, override bool) error {
+			t.Errorf("unexpected call: create")
+			return nil
+		}
+		a.gitignoreFn = func(a app.App, envName string) error {
+			t.Errorf("unexpected call: generate .gitignore")
+			return nil
+		}
+		a.seedComponentFn = func(a app.App, prototypeName, componentName string) error {
+			t.Errorf("unexpected call: seed component")
+			return nil
+		}
+		a.createOpsFn = func(a app.App, name string, isOverride bool) ([]env.Operation, error) {
+			return []env.Operation{
+				{Action: env.OpCreate, Path: "environments/my-env/main.jsonnet"},
+				{Action: env.OpUpdate, Path: "app.yaml"},
+			}, nil
+		}
+
+		err = a.Run()
+		require.NoError(t, err)
+
+		expected := `Adding environment "my-env" would:
+  create   environments/my-env/main.jsonnet
+  update   app.yaml
+  generate lib/ (ksonnet-lib for version:v1.9.5, unless it was generated before)
+  update   environments/my-env/.gitignore
+  generate components/ (component "redis" from prototype "io.ksonnet.pkg.redis", unless it exists)
+`
+		assert.Equal(t, expected, buf.String())
+	})
+}
+
+func TestEnvAdd_check_reachability(t *testing.T) {
+	cases := []struct {
+		name     string
+		strict   bool
+		probeErr error
+		isErr    bool
+		created  bool
+	}{
+		{
+			name:    "reachable",
+			created: true,
+		},
+		{
+			name:     "unreachable",
+			probeErr: errors.New("connection refused"),
+			created:  true,
+		},
+		{
+			name:     "unreachable in strict mode",
+			strict:   true,
+			probeErr: errors.New("connection refused"),
+			isErr:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withApp(t, func(appMock *amocks.App) {
+				in := map[string]interface{}{
+					OptionApp:               appMock,
+					OptionEnvName:           "my-env",
+					OptionServer:            "https://typo.example.com",
+					OptionModule:            "default",
+					OptionSpecFlag:          "flag",
+					OptionOverride:          false,
+					OptionCheckReachability: true,
+					OptionSkipNsCheck:       true,
+					OptionStrict:            tc.strict,
+					OptionClientConfig:      &client.Config{},
+				}
+
+				a, err := NewEnvAdd(in)
+				require.NoError(t, err)
+
+				a.probeServerFn = func(server string, timeout time.Duration) error {
+					assert.Equal(t, "https://typo.example.com", server)
+					return tc.probeErr
+				}
+
+				created := false
+				a.envCreateFn = func(a app.App, d env.Destination, name, specFlag string, od, pd []byte, override bool) error {
+					created = true
+					return nil
+				}
+
+				err = a.Run()
+				if tc.isErr {
+					require.Error(t, err)
+				} else {
+					require.NoError(t, err)
+				}
+				assert.Equal(t, tc.created, created)
+			})
+		})
+	}
+}
+
+func TestEnvAdd_namespace_check(t *testing.T) {
+	cases := []struct {
+		name        string
+		strict      bool
+		skipNsCheck bool
+		unknown     bool
+		serverCert  string
+		exists      bool
+		checkErr    error
+		created     bool
+		isErr       bool
+	}{
+		{
+			name:    "namespace exists",
+			exists:  true,
+			created: true,
+		},
+		{
+			name:    "namespace missing",
+			created: true,
+		},
+		{
+			name:   "namespace missing in strict mode",
+			strict: true,
+			isErr:  true,
+		},
+		{
+			name:     "cluster unreachable",
+			strict:   true,
+			checkErr: errors.New("connection refused"),
+			created:  true,
+		},
+		{
+			name:        "skipped",
+			strict:      true,
+			skipNsCheck: true,
+			created:     true,
+		},
+		{
+			name:    "server not in kubeconfig",
+			strict:  true,
+			unknown: true,
+			created: true,
+		},
+		{
+			name:       "server not in kubeconfig with server cert",
+			strict:     true,
+			unknown:    true,
+			serverCert: "cert",
+			isErr:      true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withApp(t, func(appMock *amocks.App) {
+				in := map[string]interface{}{
+					OptionApp:          appMock,
+					OptionEnvName:      "my-env",
+					OptionServer:       "https://example.com",
+					OptionModule:       "stating",
+					OptionSpecFlag:     "flag",
+					OptionOverride:     false,
+					OptionSkipNsCheck:  tc.skipNsCheck,
+					OptionStrict:       tc.strict,
+					OptionServerCert:   tc.serverCert,
+					OptionClientConfig: &client.Config{},
+				}
+
+				a, err := NewEnvAdd(in)
+				require.NoError(t, err)
+
+				if tc.skipNsCheck {
+					require.Nil(t, a.namespaceFn)
+				} else {
+					a.namespaceFn = func(server, namespace string, timeout time.Duration) (bool, error) {
+						assert.Equal(t, "https://example.com", server)
+						assert.Equal(t, "stating", namespace)
+						assert.False(t, tc.unknown && tc.serverCert == "", "a server unknown to kubeconfig should not be asked")
+						return tc.exists, tc.checkErr
+					}
+					a.knownServerFn = func(server string) (bool, error) {
+						assert.Equal(t, "https://example.com", server)
+						return !tc.unknown, nil
+					}
+				}
+
+				created := false
+				a.envCreateFn = func(a app.App, d env.Destination, name, specFlag string, od, pd []byte, override bool) error {
+					created = true
+					return nil
+				}
+
+				err = a.Run()
+				if tc.isErr {
+					require.Error(t, err)
+				} else {
+					require.NoError(t, err)
+				}
+				assert.Equal(t, tc.created, created)
+			})
+		})
+	}
+}
+
+func TestEnvAdd_from_helm_values(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		values := []byte("nginx:\n  replicas: 3\n")
+		require.NoError(t, afero.WriteFile(appMock.Fs(), "/values.yaml", values, 0644))
+
+		in := map[string]interface{}{
+			OptionApp:                 appMock,
+			OptionEnvName:             "my-env",
+			OptionServer:              "http://example.com",
+			OptionModule:              "default",
+			OptionSpecFlag:            "flag",
+			OptionOverride:            false,
+			OptionPostApplyComponents: []string{"io.ksonnet.pkg.nginx"},
+			OptionFromHelmValues:      "/values.yaml",
+		}
+
+		a, err := NewEnvAdd(in)
+		require.NoError(t, err)
+
+		a.envCreateFn = func(a app.App, d env.Destination, name, specFlag string, od, pd []byte, override bool) error {
+			return nil
+		}
+
+		seeded := false
+		a.seedComponentFn = func(a app.App, prototypeName, componentName string) error {
+			seeded = true
+			return nil
+		}
+
+		a.componentsFn = func(a app.App) ([]string, error) {
+			assert.True(t, seeded, "components should be seeded before importing Helm values")
+			return []string{"nginx"}, nil
+		}
+
+		var imported []byte
+		a.helmValuesFn = func(a app.App, envName string, data []byte, componentNames []string) ([]string, error) {
+			assert.Equal(t, "my-env", envName)
+			assert.Equal(t, []string{"nginx"}, componentNames)
+			imported = data
+			return []string{"ingress"}, nil
+		}
+
+		err = a.Run()
+		require.NoError(t, err)
+		assert.Equal(t, values, imported)
+	})
+}
+
+func TestEnvAdd_from_helm_values_missing_file(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		in := map[string]interface{}{
+			OptionApp:            appMock,
+			OptionEnvName:        "my-env",
+			OptionServer:         "http://example.com",
+			OptionModule:         "default",
+			OptionSpecFlag:       "flag",
+			OptionOverride:       false,
+			OptionFromHelmValues: "/missing.yaml",
+		}
+
+		a, err := NewEnvAdd(in)
+		require.NoError(t, err)
+
+		a.envCreateFn = func(a app.App, d env.Destination, name, specFlag string, od, pd []byte, override bool) error {
+			return nil
+		}
+
+		err = a.Run()
+		require.Error(t, err)
+	})
+}
+
 func TestEnvAdd_requires_app(t *testing.T) {
 	in := make(map[string]interface{})
 	_, err := NewEnvAdd(in)
 	require.Error(t, err)
 }
+
+func TestEnvAdd_template(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		tmpl := "// {{.Name}} in {{.Namespace}} on {{.Server}}\n"
+		require.NoError(t, afero.WriteFile(appMock.Fs(), "/templates/environment.jsonnet", []byte(tmpl), 0644))
+
+		in := map[string]interface{}{
+			OptionApp:      appMock,
+			OptionEnvName:  "us-west/staging",
+			OptionServer:   "http://example.com",
+			OptionModule:   "web",
+			OptionSpecFlag: "flag",
+			OptionOverride: false,
+		}
+
+		a, err := NewEnvAdd(in)
+		require.NoError(t, err)
+
+		var overrideData []byte
+		a.envCreateFn = func(a app.App, d env.Destination, name, specFlag string, od, pd []byte, override bool) error {
+			overrideData = od
+			return nil
+		}
+
+		err = a.Run()
+		require.NoError(t, err)
+
+		assert.Equal(t, "// us-west/staging in web on http://example.com\n", string(overrideData))
+	})
+}
+
+func TestEnvAdd_invalid_template(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		require.NoError(t, afero.WriteFile(appMock.Fs(), "/templates/environment.jsonnet", []byte("{{.Cluster}}"), 0644))
+
+		in := map[string]interface{}{
+			OptionApp:      appMock,
+			OptionEnvName:  "us-west/staging",
+			OptionServer:   "http://example.com",
+			OptionModule:   "web",
+			OptionSpecFlag: "flag",
+			OptionOverride: false,
+		}
+
+		a, err := NewEnvAdd(in)
+		require.NoError(t, err)
+
+		a.envCreateFn = func(a app.App, d env.Destination, name, specFlag string, od, pd []byte, override bool) error {
+			t.Fatal("environment was created")
+			return nil
+		}
+
+		err = a.Run()
+		require.Error(t, err)
+	})
+}
+
+func TestEnvAdd_normalize_server(t *testing.T) {
+	cases := []struct {
+		name     string
+		server   string
+		context  string
+		expected string
+		isErr    bool
+	}{
+		{
+			name:     "schemeless",
+			server:   "example.com:6443",
+			expected: "https://example.com:6443",
+		},
+		{
+			name:     "trailing slash",
+			server:   "https://example.com:6443/",
+			expected: "https://example.com:6443",
+		},
+		{
+			name:   "query string",
+			server: "https://example.com:6443?timeout=5s",
+			isErr:  true,
+		},
+		{
+			name:     "from context",
+			server:   "https://rancher.example.com/k8s/clusters/c-1",
+			context:  "dev",
+			expected: "https://rancher.example.com/k8s/clusters/c-1",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withApp(t, func(appMock *amocks.App) {
+				in := map[string]interface{}{
+					OptionApp:      appMock,
+					OptionEnvName:  "default",
+					OptionServer:   tc.server,
+					OptionContext:  tc.context,
+					OptionModule:   "default",
+					OptionSpecFlag: "flag",
+					OptionOverride: false,
+				}
+
+				a, err := NewEnvAdd(in)
+				require.NoError(t, err)
+
+				created := false
+				a.envCreateFn = func(a app.App, d env.Destination, name, specFlag string, od, pd []byte, override bool) error {
+					created = true
+					assert.Equal(t, tc.expected, d.Server())
+					return nil
+				}
+
+				err = a.Run()
+				if tc.isErr {
+					require.Error(t, err)
+				} else {
+					require.NoError(t, err)
+				}
+
+				assert.Equal(t, !tc.isErr, created)
+			})
+		})
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package actions
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/ksonnet/ksonnet/pkg/util/table"
+	"github.com/pkg/errors"
+)
+
+type contextsFn func(clientConfig *client.Config) ([]string, error)
+
+// RunEnvCheckContexts runs `env check-contexts`.
+func RunEnvCheckContexts(m map[string]interface{}) error {
+	ecc, err := NewEnvCheckContexts(m)
+	if err != nil {
+		return err
+	}
+
+	return ecc.Run()
+}
+
+// EnvCheckContexts checks that the kubeconfig contexts environments were
+// created from still exist.
+type EnvCheckContexts struct {
+	app          app.App
+	clientConfig *client.Config
+	out          io.Writer
+
+	contextsFn contextsFn
+}
+
+// NewEnvCheckContexts creates an instance of EnvCheckContexts.
+func NewEnvCheckContexts(m map[string]interface{}) (*EnvCheckContexts, error) {
+	ol := newOptionLoader(m)
+
+	ecc := &EnvCheckContexts{
+		app:          ol.LoadApp(),
+		clientConfig: ol.LoadClientConfig(),
+		out:          os.Stdout,
+
+		contextsFn: kubeconfigContexts,
+	}
+
+	if ol.err != nil {
+		return nil, ol.err
+	}
+
+	return ecc, nil
+}
+
+// Run lists the environments whose context no longer exists in the kubeconfig.
+// Environments that weren't created from a context are skipped. It returns an
+// error if any environment references a missing context.
+func (ecc *EnvCheckContexts) Run() error {
+	environments, err := ecc.app.Environments()
+	if err != nil {
+		return err
+	}
+
+	contexts, err := ecc.contextsFn(ecc.clientConfig)
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[string]bool)
+	for _, context := range contexts {
+		existing[context] = true
+	}
+
+	var envNames []string
+	for name, env := range environments {
+		if env.Context != "" && !existing[env.Context] {
+			envNames = append(envNames, name)
+		}
+	}
+	sort.Strings(envNames)
+
+	if len(envNames) == 0 {
+		fmt.Fprintln(ecc.out, "All environment contexts exist in the kubeconfig")
+		return nil
+	}
+
+	t := table.New("envCheckContexts", ecc.out)
+	t.SetHeader([]string{"name", "missing context", "fix"})
+
+	for _, name := range envNames {
+		fix := fmt.Sprintf("ks env set %s --context=<context>", name)
+		t.Append([]string{name, environments[name].Context, fix})
+	}
+
+	if err := t.Render(); err != nil {
+		return err
+	}
+
+	return errors.Errorf("%d environment(s) reference missing contexts", len(envNames))
+}
+
+func kubeconfigContexts(clientConfig *client.Config) ([]string, error) {
+	contexts, _, err := clientConfig.Contexts()
+	return contexts, err
+}
@@ -0,0 +1,93 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package actions
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	amocks "github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvCheckContexts(t *testing.T) {
+	cases := []struct {
+		name         string
+		environments app.EnvironmentConfigs
+		outputFile   string
+		isErr        bool
+	}{
+		{
+			name: "all contexts exist",
+			environments: app.EnvironmentConfigs{
+				"default": &app.EnvironmentConfig{Name: "default", Context: "dev"},
+				"prod":    &app.EnvironmentConfig{Name: "prod"},
+			},
+			outputFile: "env/check-contexts/ok.txt",
+		},
+		{
+			name: "missing contexts",
+			environments: app.EnvironmentConfigs{
+				"default":         &app.EnvironmentConfig{Name: "default", Context: "dev"},
+				"prod":            &app.EnvironmentConfig{Name: "prod", Context: "prod-old"},
+				"us-west/staging": &app.EnvironmentConfig{Name: "us-west/staging", Context: "staging"},
+				"local":           &app.EnvironmentConfig{Name: "local"},
+			},
+			outputFile: "env/check-contexts/missing.txt",
+			isErr:      true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withApp(t, func(appMock *amocks.App) {
+				appMock.On("Environments").Return(tc.environments, nil)
+
+				in := map[string]interface{}{
+					OptionApp:          appMock,
+					OptionClientConfig: &client.Config{},
+				}
+
+				a, err := NewEnvCheckContexts(in)
+				require.NoError(t, err)
+
+				a.contextsFn = func(clientConfig *client.Config) ([]string, error) {
+					return []string{"dev", "prod"}, nil
+				}
+
+				var buf bytes.Buffer
+				a.out = &buf
+
+				err = a.Run()
+				if tc.isErr {
+					require.Error(t, err)
+				} else {
+					require.NoError(t, err)
+				}
+
+				assertOutput(t, tc.outputFile, buf.String())
+			})
+		})
+	}
+}
+
+func TestEnvCheckContexts_requires_app(t *testing.T) {
+	in := make(map[string]interface{})
+	_, err := NewEnvCheckContexts(in)
+	require.Error(t, err)
+}
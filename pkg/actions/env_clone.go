@@ -0,0 +1,112 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package actions
+
+import (
+	"net/http"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/ksonnet/ksonnet/pkg/env"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/afero"
+)
+
+// RunEnvClone runs `env clone`.
+func RunEnvClone(m map[string]interface{}) error {
+	ec, err := NewEnvClone(m)
+	if err != nil {
+		return err
+	}
+
+	return ec.Run()
+}
+
+// EnvClone copies an environment, either within an app or from another app.
+type EnvClone struct {
+	app        app.App
+	envName    string
+	newName    string
+	acrossApps string
+	httpClient *http.Client
+
+	findRootFn func(fs afero.Fs, cwd string) (string, error)
+	loadAppFn  func(fs afero.Fs, httpClient *http.Client, appRoot string, opts ...app.Opt) (app.App, error)
+	cloneFn    func(src app.App, srcName string, dst app.App, dstName string) error
+	warningsFn func(src app.App, srcName string, dst app.App) ([]string, error)
+}
+
+// NewEnvClone creates an instance of EnvClone.
+func NewEnvClone(m map[string]interface{}) (*EnvClone, error) {
+	ol := newOptionLoader(m)
+
+	ec := &EnvClone{
+		app:        ol.LoadApp(),
+		envName:    ol.LoadString(OptionEnvName),
+		newName:    ol.LoadOptionalString(OptionNewEnvName),
+		acrossApps: ol.LoadOptionalString(OptionAcrossApps),
+		httpClient: ol.LoadHTTPClient(),
+
+		findRootFn: app.FindRoot,
+		loadAppFn:  app.Load,
+		cloneFn:    env.Clone,
+		warningsFn: env.CloneWarnings,
+	}
+
+	if ol.err != nil {
+		return nil, ol.err
+	}
+
+	return ec, nil
+}
+
+// Run clones the environment. Incompatibilities between an environment and
+// the app it is cloned into are logged as warnings.
+func (ec *EnvClone) Run() error {
+	newName := ec.newName
+	if newName == "" {
+		newName = ec.envName
+	}
+
+	if ec.acrossApps == "" {
+		if newName == ec.envName {
+			return errors.New("cloning an environment within an app requires a new name")
+		}
+
+		return ec.cloneFn(ec.app, ec.envName, ec.app, newName)
+	}
+
+	root, err := ec.findRootFn(ec.app.Fs(), ec.acrossApps)
+	if err != nil {
+		return errors.Wrapf(err, "finding app in %s", ec.acrossApps)
+	}
+
+	src, err := ec.loadAppFn(ec.app.Fs(), ec.httpClient, root)
+	if err != nil {
+		return errors.Wrapf(err, "loading app in %s", root)
+	}
+
+	warnings, err := ec.warningsFn(src, ec.envName, ec.app)
+	if err != nil {
+		return err
+	}
+
+	for _, w := range warnings {
+		log.Warn(w)
+	}
+
+	return ec.cloneFn(src, ec.envName, ec.app, newName)
+}
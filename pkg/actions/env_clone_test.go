@@ -0,0 +1,119 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package actions
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	amocks "github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvClone(t *testing.T) {
+	cases := []struct {
+		name       string
+		newName    string
+		acrossApps string
+		srcRoot    string
+		dstName    string
+		isErr      bool
+	}{
+		{
+			name:    "within the app",
+			newName: "staging",
+			srcRoot: "/",
+			dstName: "staging",
+		},
+		{
+			name:  "within the app without a new name",
+			isErr: true,
+		},
+		{
+			name:       "across apps",
+			acrossApps: "/other/app/components",
+			srcRoot:    "/other/app",
+			dstName:    "prod",
+		},
+		{
+			name:       "across apps with a new name",
+			newName:    "production",
+			acrossApps: "/other/app",
+			srcRoot:    "/other/app",
+			dstName:    "production",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withApp(t, func(appMock *amocks.App) {
+				in := map[string]interface{}{
+					OptionApp:        appMock,
+					OptionEnvName:    "prod",
+					OptionNewEnvName: tc.newName,
+					OptionAcrossApps: tc.acrossApps,
+				}
+
+				a, err := NewEnvClone(in)
+				require.NoError(t, err)
+
+				srcMock := &amocks.App{}
+				srcMock.On("Root").Return("/other/app")
+
+				a.findRootFn = func(fs afero.Fs, cwd string) (string, error) {
+					assert.Equal(t, tc.acrossApps, cwd)
+					return "/other/app", nil
+				}
+				a.loadAppFn = func(fs afero.Fs, httpClient *http.Client, appRoot string, opts ...app.Opt) (app.App, error) {
+					assert.Equal(t, "/other/app", appRoot)
+					return srcMock, nil
+				}
+				a.warningsFn = func(src app.App, srcName string, dst app.App) ([]string, error) {
+					return []string{"incompatible"}, nil
+				}
+
+				var cloned bool
+				a.cloneFn = func(src app.App, srcName string, dst app.App, dstName string) error {
+					cloned = true
+					assert.Equal(t, tc.srcRoot, src.Root())
+					assert.Equal(t, "prod", srcName)
+					assert.Equal(t, appMock, dst)
+					assert.Equal(t, tc.dstName, dstName)
+					return nil
+				}
+
+				err = a.Run()
+				if tc.isErr {
+					require.Error(t, err)
+					assert.False(t, cloned)
+					return
+				}
+
+				require.NoError(t, err)
+				assert.True(t, cloned)
+			})
+		})
+	}
+}
+
+func TestEnvClone_requires_app(t *testing.T) {
+	in := make(map[string]interface{})
+	_, err := NewEnvClone(in)
+	require.Error(t, err)
+}
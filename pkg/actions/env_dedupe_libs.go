@@ -0,0 +1,338 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package actions
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/blang/semver"
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/ksonnet/ksonnet/pkg/lib"
+	str "github.com/ksonnet/ksonnet/pkg/util/strings"
+	"github.com/ksonnet/ksonnet/pkg/util/table"
+)
+
+// RunEnvDedupeLibs runs `env dedupe-libs`.
+func RunEnvDedupeLibs(m map[string]interface{}) error {
+	edl, err := NewEnvDedupeLibs(m)
+	if err != nil {
+		return err
+	}
+
+	return edl.Run()
+}
+
+// EnvDedupeLibs finds environments on the same cluster that could use a single
+// generated ksonnet-lib, and optionally makes them use it.
+type EnvDedupeLibs struct {
+	app   app.App
+	apply bool
+	out   io.Writer
+
+	libGeneratedFn    func(a app.App, version string) (bool, error)
+	sameDefinitionsFn func(a app.App, version, other string) (bool, error)
+	shareLibFn        func(a app.App, envName, version string) error
+	removeLibFn       func(a app.App, version string) error
+}
+
+// NewEnvDedupeLibs creates an instance of EnvDedupeLibs.
+func NewEnvDedupeLibs(m map[string]interface{}) (*EnvDedupeLibs, error) {
+	ol := newOptionLoader(m)
+
+	edl := &EnvDedupeLibs{
+		app:   ol.LoadApp(),
+		apply: ol.LoadOptionalBool(OptionApply),
+		out:   os.Stdout,
+
+		libGeneratedFn:    libGenerated,
+		sameDefinitionsFn: sameLibDefinitions,
+		shareLibFn:        shareLib,
+		removeLibFn:       removeLib,
+	}
+
+	if ol.err != nil {
+		return nil, ol.err
+	}
+
+	return edl, nil
+}
+
+// clusterEnv is an environment in a group of environments on the same
+// cluster.
+type clusterEnv struct {
+	name   string
+	server string
+	config *app.EnvironmentConfig
+	// version is the Kubernetes version of the ksonnet-lib the environment
+	// can share, if any.
+	version string
+	status  string
+}
+
+// Run groups the environments by the normalized URI of their server and, for
+// each cluster with several environments, lists the ksonnet-lib each
+// environment uses. An environment can share the ksonnet-lib of the newest
+// Kubernetes version generated for its cluster if the type definitions are
+// the same, so the objects it generates don't change. With apply, those
+// environments are updated to use it, and ksonnet-lib that no environment
+// uses any more is removed.
+func (edl *EnvDedupeLibs) Run() error {
+	environments, err := edl.app.Environments()
+	if err != nil {
+		return err
+	}
+
+	groups := make(map[string][]*clusterEnv)
+	for name, config := range environments {
+		if config.Destination == nil || config.Destination.Server == "" {
+			continue
+		}
+
+		server := config.Destination.Server
+		cluster, err := str.NormalizeURL(server)
+		if err != nil {
+			cluster = server
+		}
+
+		groups[cluster] = append(groups[cluster], &clusterEnv{name: name, server: server, config: config})
+	}
+
+	var clusters []string
+	for cluster, envs := range groups {
+		if len(envs) > 1 {
+			clusters = append(clusters, cluster)
+		}
+	}
+	sort.Strings(clusters)
+
+	if len(clusters) == 0 {
+		fmt.Fprintln(edl.out, "No environments share a cluster")
+		return nil
+	}
+
+	var shared []*clusterEnv
+	var rows [][]string
+	for _, cluster := range clusters {
+		envs := groups[cluster]
+		sort.Slice(envs, func(i, j int) bool {
+			return envs[i].name < envs[j].name
+		})
+
+		if err := edl.planCluster(envs); err != nil {
+			return err
+		}
+
+		for _, ce := range envs {
+			if ce.version != "" {
+				shared = append(shared, ce)
+			}
+			rows = append(rows, []string{ce.server, ce.name, ce.status})
+		}
+	}
+
+	t := table.New("envDedupeLibs", edl.out)
+	t.SetHeader([]string{"server", "environment", "ksonnet-lib"})
+	t.AppendBulk(rows)
+	if err := t.Render(); err != nil {
+		return err
+	}
+
+	if len(shared) == 0 {
+		fmt.Fprintln(edl.out, "\nNo environments can share ksonnet-lib")
+		return nil
+	}
+
+	unused := unusedLibVersions(environments, shared)
+
+	if !edl.apply {
+		fmt.Fprintf(edl.out, "\n%d environment(s) can share the ksonnet-lib of their cluster\n", len(shared))
+		for _, version := range unused {
+			fmt.Fprintf(edl.out, "ksonnet-lib for %s would no longer be used and would be removed\n", version)
+		}
+		fmt.Fprintln(edl.out, "Run `ks env dedupe-libs --apply` to share it")
+		return nil
+	}
+
+	for _, ce := range shared {
+		if err := edl.shareLibFn(edl.app, ce.name, ce.version); err != nil {
+			return err
+		}
+	}
+
+	for _, version := range unused {
+		if err := edl.removeLibFn(edl.app, version); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(edl.out, "\n%d environment(s) now share the ksonnet-lib of their cluster\n", len(shared))
+	for _, version := range unused {
+		fmt.Fprintf(edl.out, "Removed ksonnet-lib for %s\n", version)
+	}
+
+	return nil
+}
+
+// planCluster sets the status of the environments of a cluster, and the
+// Kubernetes version of the ksonnet-lib of the environments that can share
+// it.
+func (edl *EnvDedupeLibs) planCluster(envs []*clusterEnv) error {
+	generated := make(map[string]bool)
+	var target string
+	for _, ce := range envs {
+		version := ce.config.KubernetesVersion
+		if ce.config.APISpec == app.AutoAPISpec || version == "" {
+			continue
+		}
+
+		if _, ok := generated[version]; !ok {
+			ok, err := edl.libGeneratedFn(edl.app, version)
+			if err != nil {
+				return err
+			}
+			generated[version] = ok
+		}
+
+		if generated[version] && (target == "" || newerVersion(version, target)) {
+			target = version
+		}
+	}
+
+	for _, ce := range envs {
+		version := ce.config.KubernetesVersion
+
+		switch {
+		case ce.config.APISpec == app.AutoAPISpec:
+			ce.status = "detected from cluster"
+		case version == "":
+			ce.status = "no Kubernetes version"
+		case version == target:
+			ce.status = version
+		case !generated[version]:
+			ce.status = fmt.Sprintf("%s (not generated)", version)
+		default:
+			same, err := edl.sameDefinitionsFn(edl.app, version, target)
+			if err != nil {
+				return err
+			}
+
+			if !same {
+				ce.status = fmt.Sprintf("%s (type definitions differ from %s)", version, target)
+				continue
+			}
+
+			ce.version = target
+			ce.status = fmt.Sprintf("%s -> %s", version, target)
+		}
+	}
+
+	return nil
+}
+
+// unusedLibVersions returns the Kubernetes versions whose ksonnet-lib no
+// environment uses once the shared environments use their cluster's, sorted.
+func unusedLibVersions(environments app.EnvironmentConfigs, shared []*clusterEnv) []string {
+	sharing := make(map[string]string)
+	for _, ce := range shared {
+		sharing[ce.name] = ce.version
+	}
+
+	used := make(map[string]bool)
+	for name, config := range environments {
+		if version, ok := sharing[name]; ok {
+			used[version] = true
+			continue
+		}
+		used[config.KubernetesVersion] = true
+	}
+
+	seen := make(map[string]bool)
+	var unused []string
+	for _, ce := range shared {
+		version := ce.config.KubernetesVersion
+		if used[version] || seen[version] {
+			continue
+		}
+		seen[version] = true
+		unused = append(unused, version)
+	}
+	sort.Strings(unused)
+
+	return unused
+}
+
+// newerVersion returns true if Kubernetes version a is newer than b.
+func newerVersion(a, b string) bool {
+	va, errA := semver.ParseTolerant(a)
+	vb, errB := semver.ParseTolerant(b)
+	if errA != nil || errB != nil {
+		return a > b
+	}
+
+	return va.GT(vb)
+}
+
+func newLibManager(a app.App, version string) (*lib.Manager, error) {
+	return lib.NewManager("version:"+version, a.Fs(), filepath.Join(a.Root(), app.LibDirName), nil)
+}
+
+func libGenerated(a app.App, version string) (bool, error) {
+	libManager, err := newLibManager(a, version)
+	if err != nil {
+		return false, err
+	}
+
+	return libManager.Generated()
+}
+
+func sameLibDefinitions(a app.App, version, other string) (bool, error) {
+	libManager, err := newLibManager(a, version)
+	if err != nil {
+		return false, err
+	}
+
+	return libManager.SameDefinitions(other)
+}
+
+func removeLib(a app.App, version string) error {
+	libManager, err := newLibManager(a, version)
+	if err != nil {
+		return err
+	}
+
+	return libManager.Remove()
+}
+
+// shareLib makes an environment use the ksonnet-lib already generated for
+// version.
+func shareLib(a app.App, envName, version string) error {
+	env, err := a.Environment(envName)
+	if err != nil {
+		return err
+	}
+
+	isOverride := a.IsEnvOverride(envName)
+	if isOverride {
+		// Libraries will always derive from the primary app.yaml
+		env.Libraries = nil
+	}
+
+	return a.AddEnvironment(env, "version:"+version, isOverride)
+}
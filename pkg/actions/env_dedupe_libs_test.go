@@ -0,0 +1,202 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package actions
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	amocks "github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func dedupeLibsEnv(name, server, version string) *app.EnvironmentConfig {
+	return &app.EnvironmentConfig{
+		Name:              name,
+		KubernetesVersion: version,
+		Destination:       &app.EnvironmentDestinationSpec{Server: server, Namespace: name},
+	}
+}
+
+func TestEnvDedupeLibs(t *testing.T) {
+	preview := dedupeLibsEnv("preview", "https://a.example.com", "v1.9.1")
+	preview.APISpec = app.AutoAPISpec
+
+	environments := app.EnvironmentConfigs{
+		"dev":     dedupeLibsEnv("dev", "https://a.example.com", "v1.9.0"),
+		"staging": dedupeLibsEnv("staging", "https://A.example.com:443/", "v1.9.0"),
+		"prod":    dedupeLibsEnv("prod", "https://a.example.com", "v1.9.1"),
+		"legacy":  dedupeLibsEnv("legacy", "https://a.example.com", "v1.8.0"),
+		"preview": preview,
+		"lone":    dedupeLibsEnv("lone", "https://b.example.com", "v1.9.0"),
+		"ci":      dedupeLibsEnv("ci", "https://c.example.com", "v1.7.0"),
+		"ci-next": dedupeLibsEnv("ci-next", "https://c.example.com", "v1.6.0"),
+		"local":   &app.EnvironmentConfig{Name: "local", KubernetesVersion: "v1.9.0"},
+	}
+
+	cases := []struct {
+		name       string
+		apply      bool
+		outputFile string
+		shared     map[string]string
+		removed    []string
+	}{
+		{
+			name:       "report",
+			outputFile: "env/dedupe-libs/report.txt",
+		},
+		{
+			name:       "apply",
+			apply:      true,
+			outputFile: "env/dedupe-libs/apply.txt",
+			shared: map[string]string{
+				"dev":     "v1.9.1",
+				"staging": "v1.9.1",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withApp(t, func(appMock *amocks.App) {
+				appMock.On("Environments").Return(environments, nil)
+
+				in := map[string]interface{}{
+					OptionApp:   appMock,
+					OptionApply: tc.apply,
+				}
+
+				a, err := NewEnvDedupeLibs(in)
+				require.NoError(t, err)
+
+				var buf bytes.Buffer
+				a.out = &buf
+
+				a.libGeneratedFn = func(a app.App, version string) (bool, error) {
+					return version != "v1.6.0", nil
+				}
+				a.sameDefinitionsFn = func(a app.App, version, other string) (bool, error) {
+					assert.Equal(t, "v1.9.1", other)
+					return version == "v1.9.0", nil
+				}
+
+				shared := make(map[string]string)
+				a.shareLibFn = func(a app.App, envName, version string) error {
+					shared[envName] = version
+					return nil
+				}
+
+				var removed []string
+				a.removeLibFn = func(a app.App, version string) error {
+					removed = append(removed, version)
+					return nil
+				}
+
+				err = a.Run()
+				require.NoError(t, err)
+
+				assertOutput(t, tc.outputFile, buf.String())
+
+				if tc.apply {
+					assert.Equal(t, tc.shared, shared)
+				} else {
+					assert.Empty(t, shared)
+				}
+
+				// ksonnet-lib for v1.9.0 is still used by "lone" and "local".
+				assert.Empty(t, removed)
+			})
+		})
+	}
+}
+
+func TestEnvDedupeLibs_removes_unused(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		environments := app.EnvironmentConfigs{
+			"dev":  dedupeLibsEnv("dev", "https://a.example.com", "v1.9.0"),
+			"prod": dedupeLibsEnv("prod", "https://a.example.com", "v1.9.1"),
+		}
+		appMock.On("Environments").Return(environments, nil)
+
+		in := map[string]interface{}{
+			OptionApp:   appMock,
+			OptionApply: true,
+		}
+
+		a, err := NewEnvDedupeLibs(in)
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		a.out = &buf
+
+		a.libGeneratedFn = func(a app.App, version string) (bool, error) {
+			return true, nil
+		}
+		a.sameDefinitionsFn = func(a app.App, version, other string) (bool, error) {
+			return true, nil
+		}
+		a.shareLibFn = func(a app.App, envName, version string) error {
+			assert.Equal(t, "dev", envName)
+			assert.Equal(t, "v1.9.1", version)
+			return nil
+		}
+
+		var removed []string
+		a.removeLibFn = func(a app.App, version string) error {
+			removed = append(removed, version)
+			return nil
+		}
+
+		err = a.Run()
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"v1.9.0"}, removed)
+		assert.Contains(t, buf.String(), "Removed ksonnet-lib for v1.9.0\n")
+	})
+}
+
+func TestEnvDedupeLibs_no_shared_clusters(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		environments := app.EnvironmentConfigs{
+			"dev":  dedupeLibsEnv("dev", "https://a.example.com", "v1.9.0"),
+			"prod": dedupeLibsEnv("prod", "https://b.example.com", "v1.9.1"),
+		}
+		appMock.On("Environments").Return(environments, nil)
+
+		in := map[string]interface{}{
+			OptionApp: appMock,
+		}
+
+		a, err := NewEnvDedupeLibs(in)
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		a.out = &buf
+
+		err = a.Run()
+		require.NoError(t, err)
+
+		require.Equal(t, "No environments share a cluster\n", buf.String())
+	})
+}
+
+func TestEnvDedupeLibs_requires_app(t *testing.T) {
+	in := make(map[string]interface{})
+	_, err := NewEnvDedupeLibs(in)
+	require.Error(t, err)
+}
@@ -16,6 +16,7 @@
 package actions
 
 import (
+	"encoding/json"
 	"io"
 	"os"
 
@@ -38,6 +39,7 @@ func RunEnvDescribe(m map[string]interface{}) error {
 type EnvDescribe struct {
 	app     app.App
 	envName string
+	schema  bool
 	out     io.Writer
 }
 
@@ -48,6 +50,7 @@ func NewEnvDescribe(m map[string]interface{}) (*EnvDescribe, error) {
 	ed := &EnvDescribe{
 		app:     ol.LoadApp(),
 		envName: ol.LoadString(OptionEnvName),
+		schema:  ol.LoadOptionalBool(OptionSchema),
 
 		out: os.Stdout,
 	}
@@ -68,6 +71,10 @@ func (ed *EnvDescribe) Run() error {
 
 	env.Name = ed.envName
 
+	if ed.schema {
+		return ed.writeSchema(env)
+	}
+
 	b, err := yaml.Marshal(env)
 	if err != nil {
 		return err
@@ -76,3 +83,29 @@ func (ed *EnvDescribe) Run() error {
 	_, err = ed.out.Write(b)
 	return err
 }
+
+// writeSchema writes the effective configuration of the environment as JSON.
+func (ed *EnvDescribe) writeSchema(env *app.EnvironmentConfig) error {
+	environments, err := ed.app.Environments()
+	if err != nil {
+		return err
+	}
+
+	inherits, err := environments.InheritanceChain(ed.envName)
+	if err != nil {
+		return err
+	}
+
+	schema, err := env.Schema(inherits)
+	if err != nil {
+		return err
+	}
+
+	b, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = ed.out.Write(append(b, '\n'))
+	return err
+}
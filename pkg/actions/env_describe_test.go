@@ -52,6 +52,44 @@ func TestEnvDescribe(t *testing.T) {
 	})
 }
 
+func TestEnvDescribe_schema(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		envName := "us-west/staging"
+
+		env := &app.EnvironmentConfig{
+			KubernetesVersion: "v1.10.3",
+			Inherits:          "base",
+			Destination: &app.EnvironmentDestinationSpec{
+				Server:    "https://staging.example.com",
+				Namespace: "web",
+			},
+		}
+
+		appMock.On("Environment", envName).Return(env, nil)
+		appMock.On("Environments").Return(app.EnvironmentConfigs{
+			envName: env,
+			"base":  &app.EnvironmentConfig{Name: "base"},
+		}, nil)
+
+		in := map[string]interface{}{
+			OptionApp:     appMock,
+			OptionEnvName: envName,
+			OptionSchema:  true,
+		}
+
+		a, err := NewEnvDescribe(in)
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		a.out = &buf
+
+		err = a.Run()
+		require.NoError(t, err)
+
+		assertOutput(t, "env/describe/schema.json", buf.String())
+	})
+}
+
 func TestEnvDescribe_requires_app(t *testing.T) {
 	in := make(map[string]interface{})
 	_, err := NewEnvDescribe(in)
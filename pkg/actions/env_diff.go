@@ -0,0 +1,131 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package actions
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+)
+
+// RunEnvDiff runs `env diff`.
+func RunEnvDiff(m map[string]interface{}) error {
+	ed, err := NewEnvDiff(m)
+	if err != nil {
+		return err
+	}
+
+	return ed.Run()
+}
+
+// EnvDiff shows the differences between the configuration of two
+// environments.
+type EnvDiff struct {
+	app      app.App
+	envName1 string
+	envName2 string
+	showSame bool
+	out      io.Writer
+}
+
+// NewEnvDiff creates an instance of EnvDiff.
+func NewEnvDiff(m map[string]interface{}) (*EnvDiff, error) {
+	ol := newOptionLoader(m)
+
+	ed := &EnvDiff{
+		app:      ol.LoadApp(),
+		envName1: ol.LoadString(OptionEnvName1),
+		envName2: ol.LoadString(OptionEnvName2),
+		showSame: ol.LoadOptionalBool(OptionShowSame),
+
+		out: os.Stdout,
+	}
+
+	if ol.err != nil {
+		return nil, ol.err
+	}
+
+	return ed, nil
+}
+
+// envField is a field of an environment compared by `env diff`.
+type envField struct {
+	name  string
+	value string
+}
+
+// Run prints the fields of the environments that differ, as removed from the
+// first and added in the second, like `ks diff`. Fields that are the same are
+// only printed with showSame.
+func (ed *EnvDiff) Run() error {
+	env1, err := ed.app.Environment(ed.envName1)
+	if err != nil {
+		return err
+	}
+
+	env2, err := ed.app.Environment(ed.envName2)
+	if err != nil {
+		return err
+	}
+
+	fields1 := envDiffFields(env1)
+	fields2 := envDiffFields(env2)
+
+	fmt.Fprintf(ed.out, "--- %s\n+++ %s\n", ed.envName1, ed.envName2)
+
+	for i := range fields1 {
+		name, value1, value2 := fields1[i].name, fields1[i].value, fields2[i].value
+
+		if value1 == value2 {
+			if ed.showSame {
+				fmt.Fprintf(ed.out, "  %s: %s\n", name, value1)
+			}
+			continue
+		}
+
+		if _, err := diffRemoveColor.Fprintf(ed.out, "- %s: %s\n", name, value1); err != nil {
+			return err
+		}
+		if _, err := diffAddColor.Fprintf(ed.out, "+ %s: %s\n", name, value2); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// envDiffFields returns the fields of an environment that `env diff` compares,
+// in the order they are printed. The Kubernetes version is the version of the
+// generated ksonnet-lib of the environment.
+func envDiffFields(e *app.EnvironmentConfig) []envField {
+	var server, namespace string
+	if e.Destination != nil {
+		server = e.Destination.Server
+		namespace = e.Destination.Namespace
+	}
+
+	return []envField{
+		{name: "server", value: server},
+		{name: "namespace", value: namespace},
+		{name: "context", value: e.Context},
+		{name: "apiSpec", value: e.APISpec},
+		{name: "k8sVersion", value: e.KubernetesVersion},
+		{name: "targets", value: strings.Join(e.Targets, ",")},
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package actions
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	amocks "github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvDiff(t *testing.T) {
+	staging := &app.EnvironmentConfig{
+		KubernetesVersion: "v1.9.5",
+		Destination: &app.EnvironmentDestinationSpec{
+			Server:    "https://staging.example.com",
+			Namespace: "web",
+		},
+		Context: "staging",
+		Targets: []string{"frontend"},
+	}
+	prod := &app.EnvironmentConfig{
+		KubernetesVersion: "v1.10.3",
+		Destination: &app.EnvironmentDestinationSpec{
+			Server:    "https://prod.example.com",
+			Namespace: "web",
+		},
+		Context: "prod",
+		Targets: []string{"frontend"},
+	}
+
+	cases := []struct {
+		name     string
+		showSame bool
+		expected string
+	}{
+		{
+			name: "in general",
+			expected: "--- staging\n+++ prod\n" +
+				"- server: https://staging.example.com\n+ server: https://prod.example.com\n" +
+				"- context: staging\n+ context: prod\n" +
+				"- k8sVersion: v1.9.5\n+ k8sVersion: v1.10.3\n",
+		},
+		{
+			name:     "show same",
+			showSame: true,
+			expected: "--- staging\n+++ prod\n" +
+				"- server: https://staging.example.com\n+ server: https://prod.example.com\n" +
+				"  namespace: web\n" +
+				"- context: staging\n+ context: prod\n" +
+				"  apiSpec: \n" +
+				"- k8sVersion: v1.9.5\n+ k8sVersion: v1.10.3\n" +
+				"  targets: frontend\n",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withApp(t, func(appMock *amocks.App) {
+				appMock.On("Environment", "staging").Return(staging, nil)
+				appMock.On("Environment", "prod").Return(prod, nil)
+
+				in := map[string]interface{}{
+					OptionApp:      appMock,
+					OptionEnvName1: "staging",
+					OptionEnvName2: "prod",
+					OptionShowSame: tc.showSame,
+				}
+
+				a, err := NewEnvDiff(in)
+				require.NoError(t, err)
+
+				var buf bytes.Buffer
+				a.out = &buf
+
+				err = a.Run()
+				require.NoError(t, err)
+
+				assert.Equal(t, tc.expected, buf.String())
+			})
+		})
+	}
+}
+
+func TestEnvDiff_missing_environment(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		appMock.On("Environment", "staging").Return(&app.EnvironmentConfig{}, nil)
+		appMock.On("Environment", "prod").Return(nil, errors.New(`environment "prod" was not found`))
+
+		in := map[string]interface{}{
+			OptionApp:      appMock,
+			OptionEnvName1: "staging",
+			OptionEnvName2: "prod",
+		}
+
+		a, err := NewEnvDiff(in)
+		require.NoError(t, err)
+
+		err = a.Run()
+		require.EqualError(t, err, `environment "prod" was not found`)
+	})
+}
+
+func TestEnvDiff_requires_app(t *testing.T) {
+	in := make(map[string]interface{})
+	_, err := NewEnvDiff(in)
+	require.Error(t, err)
+}
@@ -0,0 +1,144 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package actions
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/pkg/errors"
+	clientcmdlatest "k8s.io/client-go/tools/clientcmd/api/latest"
+	clientcmdapiv1 "k8s.io/client-go/tools/clientcmd/api/v1"
+)
+
+type execFn func(name string, args []string, env []string) error
+
+// RunEnvExec runs `env exec`.
+func RunEnvExec(m map[string]interface{}) error {
+	ee, err := NewEnvExec(m)
+	if err != nil {
+		return err
+	}
+
+	return ee.Run()
+}
+
+// EnvExec runs a command against the cluster of an environment.
+type EnvExec struct {
+	app          app.App
+	envName      string
+	command      []string
+	clientConfig *client.Config
+	readOnly     bool
+
+	execFn execFn
+}
+
+// NewEnvExec creates an instance of EnvExec.
+func NewEnvExec(m map[string]interface{}) (*EnvExec, error) {
+	ol := newOptionLoader(m)
+
+	ee := &EnvExec{
+		app:          ol.LoadApp(),
+		envName:      ol.LoadString(OptionEnvName),
+		command:      ol.LoadStringSlice(OptionArguments),
+		clientConfig: ol.LoadClientConfig(),
+		readOnly:     ol.LoadOptionalBool(OptionReadOnly),
+
+		execFn: runCommand,
+	}
+
+	if ol.err != nil {
+		return nil, ol.err
+	}
+
+	return ee, nil
+}
+
+// Run runs the command with KUBECONFIG pointing at a temporary kubeconfig
+// whose current context targets the environment.
+func (ee *EnvExec) Run() error {
+	if len(ee.command) == 0 {
+		return errors.New("command is required")
+	}
+
+	// The command is arbitrary, so it can't be trusted to leave the cluster
+	// unchanged.
+	if ee.readOnly {
+		return newReadOnlyError("env exec")
+	}
+
+	env, err := ee.app.Environment(ee.envName)
+	if err != nil {
+		return err
+	}
+
+	kubeConfig, err := ee.clientConfig.EnvironmentKubeConfig(ee.app, ee.envName)
+	if err != nil {
+		return err
+	}
+
+	var v1Config clientcmdapiv1.Config
+	if err = clientcmdlatest.Scheme.Convert(kubeConfig, &v1Config, nil); err != nil {
+		return errors.Wrap(err, "convert kubeconfig")
+	}
+	v1Config.APIVersion = clientcmdlatest.Version
+	v1Config.Kind = "Config"
+
+	// JSON is valid YAML, so kubectl and friends can read the file as is.
+	b, err := json.Marshal(&v1Config)
+	if err != nil {
+		return errors.Wrap(err, "marshal kubeconfig")
+	}
+
+	f, err := ioutil.TempFile("", "ks-kubeconfig")
+	if err != nil {
+		return errors.Wrap(err, "create temporary kubeconfig")
+	}
+	defer os.Remove(f.Name())
+
+	if _, err = f.Write(b); err != nil {
+		f.Close()
+		return errors.Wrap(err, "write temporary kubeconfig")
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+
+	cmdEnv := append(os.Environ(),
+		fmt.Sprintf("KUBECONFIG=%s", f.Name()),
+		fmt.Sprintf("KS_ENV=%s", ee.envName),
+		fmt.Sprintf("KS_ENV_SERVER=%s", env.Destination.Server),
+		fmt.Sprintf("KS_ENV_NAMESPACE=%s", env.Destination.Namespace),
+	)
+
+	return ee.execFn(ee.command[0], ee.command[1:], cmdEnv)
+}
+
+func runCommand(name string, args []string, env []string) error {
+	cmd := exec.Command(name, args...)
+	cmd.Env = env
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	return cmd.Run()
+}
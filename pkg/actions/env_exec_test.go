@@ -0,0 +1,153 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package actions
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	amocks "github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestEnvExec(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		envName := "us-west/prod"
+
+		env := &app.EnvironmentConfig{
+			Destination: &app.EnvironmentDestinationSpec{
+				Server:    "https://prod.example.com",
+				Namespace: "web",
+			},
+		}
+
+		appMock.On("Environment", envName).Return(env, nil)
+
+		kubeConfig := clientcmdapi.Config{
+			CurrentContext: "dev",
+			Clusters: map[string]*clientcmdapi.Cluster{
+				"dev":  {Server: "https://dev.example.com"},
+				"prod": {Server: "https://prod.example.com"},
+			},
+			AuthInfos: map[string]*clientcmdapi.AuthInfo{
+				"admin": {Token: "token"},
+			},
+			Contexts: map[string]*clientcmdapi.Context{
+				"dev": {Cluster: "dev", AuthInfo: "admin", Namespace: "default"},
+			},
+		}
+
+		overrides := clientcmd.ConfigOverrides{}
+		clientConfig := &client.Config{
+			Overrides: &overrides,
+			Config:    clientcmd.NewDefaultClientConfig(kubeConfig, &overrides),
+		}
+
+		in := map[string]interface{}{
+			OptionApp:          appMock,
+			OptionEnvName:      envName,
+			OptionArguments:    []string{"kubectl", "get", "pods"},
+			OptionClientConfig: clientConfig,
+		}
+
+		a, err := NewEnvExec(in)
+		require.NoError(t, err)
+
+		var ran bool
+		a.execFn = func(name string, args []string, env []string) error {
+			ran = true
+
+			assert.Equal(t, "kubectl", name)
+			assert.Equal(t, []string{"get", "pods"}, args)
+			assert.Contains(t, env, "KS_ENV=us-west/prod")
+			assert.Contains(t, env, "KS_ENV_NAMESPACE=web")
+
+			var path string
+			for _, e := range env {
+				if strings.HasPrefix(e, "KUBECONFIG=") {
+					path = strings.TrimPrefix(e, "KUBECONFIG=")
+				}
+			}
+
+			got, err := clientcmd.LoadFromFile(path)
+			require.NoError(t, err)
+
+			require.Equal(t, "ksonnet-us-west-prod", got.CurrentContext)
+			ctx := got.Contexts[got.CurrentContext]
+			require.NotNil(t, ctx)
+			assert.Equal(t, "prod", ctx.Cluster)
+			assert.Equal(t, "admin", ctx.AuthInfo)
+			assert.Equal(t, "web", ctx.Namespace)
+
+			return nil
+		}
+
+		err = a.Run()
+		require.NoError(t, err)
+		require.True(t, ran)
+	})
+}
+
+func TestEnvExec_requires_command(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		in := map[string]interface{}{
+			OptionApp:          appMock,
+			OptionEnvName:      "default",
+			OptionArguments:    []string{},
+			OptionClientConfig: &client.Config{},
+		}
+
+		a, err := NewEnvExec(in)
+		require.NoError(t, err)
+
+		err = a.Run()
+		require.Error(t, err)
+	})
+}
+
+func TestEnvExec_read_only(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		in := map[string]interface{}{
+			OptionApp:          appMock,
+			OptionEnvName:      "default",
+			OptionArguments:    []string{"kubectl", "get", "pods"},
+			OptionClientConfig: &client.Config{},
+			OptionReadOnly:     true,
+		}
+
+		a, err := NewEnvExec(in)
+		require.NoError(t, err)
+
+		a.execFn = func(name string, args []string, env []string) error {
+			t.Error("command should not run in read-only mode")
+			return nil
+		}
+
+		err = a.Run()
+		require.Error(t, err)
+	})
+}
+
+func TestEnvExec_requires_app(t *testing.T) {
+	in := make(map[string]interface{})
+	_, err := NewEnvExec(in)
+	require.Error(t, err)
+}
@@ -0,0 +1,117 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package actions
+
+import (
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/ksonnet/ksonnet/pkg/env"
+	"github.com/ksonnet/ksonnet/pkg/lib"
+	"github.com/pkg/errors"
+)
+
+// RunEnvInitFromScratch runs `env init-from-scratch`.
+func RunEnvInitFromScratch(m map[string]interface{}) error {
+	ei, err := NewEnvInitFromScratch(m)
+	if err != nil {
+		return err
+	}
+
+	return ei.Run()
+}
+
+// EnvInitFromScratch creates an environment that doesn't need a cluster. Its
+// server is a placeholder, and its ksonnet-lib is generated from the OpenAPI
+// spec bundled with ksonnet.
+type EnvInitFromScratch struct {
+	app       app.App
+	envName   string
+	namespace string
+
+	envCreateFn    func(a app.App, d env.Destination, name, k8sSpecFlag string, overrideData, paramsData []byte, isOverride bool) error
+	overrideDataFn func(a app.App, data env.TemplateData) ([]byte, error)
+}
+
+// NewEnvInitFromScratch creates an instance of EnvInitFromScratch.
+func NewEnvInitFromScratch(m map[string]interface{}) (*EnvInitFromScratch, error) {
+	ol := newOptionLoader(m)
+
+	ei := &EnvInitFromScratch{
+		app:       ol.LoadApp(),
+		envName:   ol.LoadString(OptionEnvName),
+		namespace: ol.LoadOptionalString(OptionNamespace),
+
+		envCreateFn:    env.Create,
+		overrideDataFn: env.OverrideData,
+	}
+
+	if ol.err != nil {
+		return nil, ol.err
+	}
+
+	return ei, nil
+}
+
+// Run creates the offline environment.
+func (ei *EnvInitFromScratch) Run() error {
+	destination := env.NewDestination(env.OfflineServer, ei.namespace)
+
+	overrideData, err := ei.overrideDataFn(ei.app, env.TemplateData{
+		Name:      ei.envName,
+		Namespace: ei.namespace,
+		Server:    env.OfflineServer,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = ei.envCreateFn(
+		ei.app,
+		destination,
+		ei.envName,
+		"offline:"+lib.OfflineKubernetesVersion,
+		overrideData,
+		env.DefaultParamsData,
+		false,
+	)
+	if err != nil {
+		return err
+	}
+
+	e, err := ei.app.Environment(ei.envName)
+	if err != nil {
+		return err
+	}
+
+	e.Offline = true
+
+	return ei.app.AddEnvironment(e, "", false)
+}
+
+// checkEnvOnline returns an error if an environment was created without a
+// cluster and hasn't been pointed at one since.
+func checkEnvOnline(a app.App, envName string) error {
+	e, err := a.Environment(envName)
+	if err != nil {
+		return err
+	}
+
+	if e.Offline {
+		return errors.Errorf("environment %q is offline; point it at a cluster with `ks env set %s --server` or `--context` first",
+			envName, envName)
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package actions
+
+import (
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	amocks "github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/ksonnet/ksonnet/pkg/env"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvInitFromScratch(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		in := map[string]interface{}{
+			OptionApp:       appMock,
+			OptionEnvName:   "local",
+			OptionNamespace: "dev",
+		}
+
+		a, err := NewEnvInitFromScratch(in)
+		require.NoError(t, err)
+
+		a.envCreateFn = func(a app.App, d env.Destination, name, specFlag string, od, pd []byte, override bool) error {
+			assert.Equal(t, env.NewDestination(env.OfflineServer, "dev"), d)
+			assert.Equal(t, "local", name)
+			assert.Equal(t, "offline:v1.10.3", specFlag)
+			assert.False(t, override)
+			return nil
+		}
+
+		created := &app.EnvironmentConfig{
+			Name:              "local",
+			KubernetesVersion: "v1.10.3",
+			Destination: &app.EnvironmentDestinationSpec{
+				Namespace: "dev",
+				Server:    env.OfflineServer,
+			},
+		}
+		appMock.On("Environment", "local").Return(created, nil)
+
+		expected := *created
+		expected.Offline = true
+		appMock.On("AddEnvironment", &expected, "", false).Return(nil)
+
+		err = a.Run()
+		require.NoError(t, err)
+	})
+}
+
+func TestEnvInitFromScratch_requires_app(t *testing.T) {
+	in := make(map[string]interface{})
+	_, err := NewEnvInitFromScratch(in)
+	require.Error(t, err)
+}
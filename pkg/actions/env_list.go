@@ -16,15 +16,41 @@
 package actions
 
 import (
+	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/ksonnet/ksonnet/pkg/lib"
+	str "github.com/ksonnet/ksonnet/pkg/util/strings"
 	"github.com/ksonnet/ksonnet/pkg/util/table"
 	"github.com/pkg/errors"
 )
 
+const (
+	// clusterProbeParallelism is the number of environments whose cluster is
+	// probed at once.
+	clusterProbeParallelism = 8
+	// clusterVersionTimeout is how long to wait for an environment's cluster
+	// to report its version.
+	clusterVersionTimeout = 5 * time.Second
+	// clusterHealthzTimeout is how long to wait for an environment's cluster
+	// to respond before it is listed as unreachable.
+	clusterHealthzTimeout = 5 * time.Second
+	// unknownClusterVersion is shown for environments whose cluster can't be
+	// reached.
+	unknownClusterVersion = "unknown"
+	// libNotGenerated is shown as the spec version of environments whose
+	// ksonnet-lib hasn't been generated.
+	libNotGenerated = "not generated"
+)
+
 // RunEnvList runs `env list`
 func RunEnvList(m map[string]interface{}) error {
 	nl, err := NewEnvList(m)
@@ -38,10 +64,21 @@ func RunEnvList(m map[string]interface{}) error {
 // EnvList lists available namespaces. To initialize EnvList,
 // use the `NewEnvList` constructor.
 type EnvList struct {
-	envListFn       func() (app.EnvironmentConfigs, error)
-	envIsOverrideFn func(name string) bool
-	outputType      string
-	out             io.Writer
+	envListFn        func() (app.EnvironmentConfigs, error)
+	envIsOverrideFn  func(name string) bool
+	contextServersFn func() (map[string]string, error)
+	serverVersionFn  func(envName string) (string, error)
+	healthzFn        func(envName string) error
+	libVersionFn     func(k8sVersion string) (string, error)
+	outputType       string
+	envName          string
+	filter           map[string]string
+	staleContexts    bool
+	clusterVersion   bool
+	unreachable      bool
+	all              bool
+	tree             bool
+	out              io.Writer
 }
 
 // NewEnvList creates an instance of EnvList
@@ -50,16 +87,79 @@ func NewEnvList(m map[string]interface{}) (*EnvList, error) {
 
 	a := ol.LoadApp()
 	outputType := ol.LoadOptionalString(OptionOutput)
+	envName := ol.LoadOptionalString(OptionEnvName)
+	staleContexts := ol.LoadOptionalBool(OptionStaleContexts)
+	clusterVersion := ol.LoadOptionalBool(OptionWithClusterVersion)
+	unreachable := ol.LoadOptionalBool(OptionUnreachable)
+	all := ol.LoadOptionalBool(OptionAll)
+	tree := ol.LoadOptionalBool(OptionTree)
+	tags := ol.LoadOptionalStringSlice(OptionFilter)
+
+	var clientConfig *client.Config
+	if staleContexts || clusterVersion || unreachable {
+		clientConfig = ol.LoadClientConfig()
+	}
 
 	if ol.err != nil {
 		return nil, ol.err
 	}
 
+	filter, err := app.ParseTagFilter(tags)
+	if err != nil {
+		return nil, err
+	}
+
+	if staleContexts && unreachable {
+		return nil, errors.New("--stale-contexts and --unreachable can't be used together")
+	}
+
+	if all && (staleContexts || unreachable) {
+		return nil, errors.New("--all can't be used with --stale-contexts or --unreachable")
+	}
+
+	if outputType == OutputEnv && (staleContexts || unreachable) {
+		return nil, errors.New("--output=env can't be used with --stale-contexts or --unreachable")
+	}
+
+	if tree && (outputType != "" || staleContexts || unreachable || clusterVersion || all) {
+		return nil, errors.New("--tree can't be used with --output, --stale-contexts, --unreachable, --with-cluster-version or --all")
+	}
+
 	el := &EnvList{
 		outputType:      outputType,
+		envName:         envName,
+		filter:          filter,
+		staleContexts:   staleContexts,
+		clusterVersion:  clusterVersion,
+		unreachable:     unreachable,
+		all:             all,
+		tree:            tree,
 		envListFn:       a.Environments,
 		envIsOverrideFn: a.IsEnvOverride,
-		out:             os.Stdout,
+		libVersionFn: func(k8sVersion string) (string, error) {
+			libManager, err := lib.NewManager("version:"+k8sVersion, a.Fs(), filepath.Join(a.Root(), app.LibDirName), nil)
+			if err != nil {
+				return "", err
+			}
+
+			return libManager.GeneratedVersion()
+		},
+		out: os.Stdout,
+	}
+
+	if clientConfig != nil {
+		el.contextServersFn = clientConfig.ContextServers
+		el.serverVersionFn = func(envName string) (string, error) {
+			serverVersion, err := clientConfig.EnvironmentServerVersion(a, envName, clusterVersionTimeout)
+			if err != nil {
+				return "", err
+			}
+
+			return serverVersion.GitVersion, nil
+		}
+		el.healthzFn = func(envName string) error {
+			return clientConfig.EnvironmentHealthz(a, envName, clusterHealthzTimeout)
+		}
 	}
 
 	return el, nil
@@ -72,8 +172,49 @@ func (el *EnvList) Run() error {
 		return err
 	}
 
+	if el.envName != "" {
+		env, ok := environments[el.envName]
+		if !ok {
+			return errors.Errorf("environment %q was not found", el.envName)
+		}
+
+		environments = app.EnvironmentConfigs{el.envName: env}
+	}
+
+	for name, env := range environments {
+		if !env.MatchesTags(el.filter) {
+			delete(environments, name)
+		}
+	}
+
+	if el.outputType == OutputEnv {
+		return el.writeEnvVars(environments)
+	}
+
+	if el.tree {
+		return el.writeTree(environments)
+	}
+
+	if el.staleContexts {
+		return el.listStaleContexts(environments)
+	}
+
+	if el.unreachable {
+		return el.listUnreachable(environments)
+	}
+
+	header := []string{"name", "override", "kubernetes-version", "namespace", "server"}
+	var clusterVersions map[string]string
+	if el.clusterVersion {
+		header = append(header, "cluster-version")
+		clusterVersions = el.clusterVersions(environments)
+	}
+	if el.all {
+		header = append(header, "spec-version", "up-to-date")
+	}
+
 	t := table.New("envList", el.out)
-	t.SetHeader([]string{"name", "override", "kubernetes-version", "namespace", "server"})
+	t.SetHeader(header)
 
 	f, err := table.DetectFormat(el.outputType)
 	if err != nil {
@@ -89,13 +230,341 @@ func (el *EnvList) Run() error {
 			override = "*"
 		}
 
-		rows = append(rows, []string{
+		// Environments without a destination are listed with an empty
+		// namespace and server, so JSON and YAML always have every field.
+		var namespace, server string
+		if env.Destination != nil {
+			namespace, server = env.Destination.Namespace, env.Destination.Server
+		}
+
+		row := []string{
 			name,
 			override,
 			env.KubernetesVersion,
-			env.Destination.Namespace,
-			env.Destination.Server,
-		})
+			namespace,
+			server,
+		}
+
+		if el.clusterVersion {
+			row = append(row, clusterVersions[name])
+		}
+
+		if el.all {
+			specVersion, upToDate, err := el.libStatus(env.KubernetesVersion)
+			if err != nil {
+				return errors.Wrapf(err, "checking ksonnet-lib of environment %q", name)
+			}
+			row = append(row, specVersion, upToDate)
+		}
+
+		rows = append(rows, row)
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i][0] < rows[j][0]
+	})
+
+	t.AppendBulk(rows)
+
+	return t.Render()
+}
+
+// envTreeNode is a segment of the environment names in `env list --tree`. A
+// node is an environment if env is set, and a plain directory otherwise.
+type envTreeNode struct {
+	env      *app.EnvironmentConfig
+	children map[string]*envTreeNode
+}
+
+// writeTree writes the environments as a tree of the segments of their names,
+// like the layout of the environments directory. Each environment is annotated
+// with its namespace and server.
+func (el *EnvList) writeTree(environments app.EnvironmentConfigs) error {
+	root := &envTreeNode{children: make(map[string]*envTreeNode)}
+
+	for name, env := range environments {
+		node := root
+		for _, segment := range strings.Split(name, "/") {
+			child, ok := node.children[segment]
+			if !ok {
+				child = &envTreeNode{children: make(map[string]*envTreeNode)}
+				node.children[segment] = child
+			}
+			node = child
+		}
+		node.env = env
+	}
+
+	if _, err := fmt.Fprintln(el.out, app.EnvironmentDirName); err != nil {
+		return err
+	}
+
+	return writeTreeChildren(el.out, root, "")
+}
+
+// writeTreeChildren writes the children of node in name order. prefix is
+// written before each line, to continue the branches of node's ancestors.
+func writeTreeChildren(w io.Writer, node *envTreeNode, prefix string) error {
+	var names []string
+	for name := range node.children {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for i, name := range names {
+		child := node.children[name]
+
+		branch, indent := "├── ", "│   "
+		if i == len(names)-1 {
+			branch, indent = "└── ", "    "
+		}
+
+		line := prefix + branch + name
+		if child.env != nil {
+			line += " " + envTreeAnnotation(child.env)
+		}
+
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+
+		if err := writeTreeChildren(w, child, prefix+indent); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// envTreeAnnotation describes the destination of an environment in
+// `env list --tree`.
+func envTreeAnnotation(env *app.EnvironmentConfig) string {
+	if env.Destination == nil {
+		return "(no destination)"
+	}
+
+	return fmt.Sprintf("(namespace: %s, server: %s)", env.Destination.Namespace, env.Destination.Server)
+}
+
+// libStatus returns the version of the swagger that the ksonnet-lib for an
+// environment's Kubernetes version was generated from, and whether it is that
+// Kubernetes version. Environments without a Kubernetes version have no
+// ksonnet-lib, so both are empty.
+func (el *EnvList) libStatus(k8sVersion string) (specVersion, upToDate string, err error) {
+	if k8sVersion == "" {
+		return "", "", nil
+	}
+
+	specVersion, err = el.libVersionFn(k8sVersion)
+	if err != nil {
+		return "", "", err
+	}
+
+	if specVersion == "" {
+		return libNotGenerated, "no", nil
+	}
+
+	if strings.TrimPrefix(specVersion, "v") != strings.TrimPrefix(k8sVersion, "v") {
+		return specVersion, "no", nil
+	}
+
+	return specVersion, "yes", nil
+}
+
+// writeEnvVars writes the details of a single environment as shell variable
+// assignments, so they can be used with `eval`.
+func (el *EnvList) writeEnvVars(environments app.EnvironmentConfigs) error {
+	if len(environments) != 1 {
+		return errors.Errorf("--output=env requires a single environment, but %d were selected; select one with --name", len(environments))
+	}
+
+	for name, env := range environments {
+		var server, namespace string
+		if env.Destination != nil {
+			server, namespace = env.Destination.Server, env.Destination.Namespace
+		}
+
+		vars := [][]string{
+			{"KS_ENV_NAME", name},
+			{"KS_ENV_URI", server},
+			{"KS_ENV_NAMESPACE", namespace},
+			{"KS_ENV_KUBERNETES_VERSION", env.KubernetesVersion},
+		}
+
+		if el.clusterVersion {
+			vars = append(vars, []string{"KS_ENV_CLUSTER_VERSION", el.clusterVersions(environments)[name]})
+		}
+
+		if el.all {
+			specVersion, upToDate, err := el.libStatus(env.KubernetesVersion)
+			if err != nil {
+				return errors.Wrapf(err, "checking ksonnet-lib of environment %q", name)
+			}
+			vars = append(vars, []string{"KS_ENV_SPEC_VERSION", specVersion}, []string{"KS_ENV_SPEC_UP_TO_DATE", upToDate})
+		}
+
+		for _, v := range vars {
+			if _, err := fmt.Fprintf(el.out, "%s=%s\n", v[0], shellQuote(v[1])); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// shellQuote quotes s for a POSIX shell. Within single quotes nothing is
+// special, so only single quotes themselves need to be replaced.
+func shellQuote(s string) string {
+	return "'" + strings.Replace(s, "'", `'\''`, -1) + "'"
+}
+
+// clusterVersions probes the cluster of each environment for its version.
+// Environments whose cluster can't be reached are reported as unknown.
+func (el *EnvList) clusterVersions(environments app.EnvironmentConfigs) map[string]string {
+	var names []string
+	for name := range environments {
+		names = append(names, name)
+	}
+
+	return probeClusters(names, func(name string) string {
+		version, err := el.serverVersionFn(name)
+		if err != nil || version == "" {
+			return unknownClusterVersion
+		}
+
+		return version
+	})
+}
+
+// listUnreachable lists the environments without a server, and the ones
+// whose cluster doesn't respond to a health check.
+func (el *EnvList) listUnreachable(environments app.EnvironmentConfigs) error {
+	t := table.New("envListUnreachable", el.out)
+	t.SetHeader([]string{"name", "problem", "server", "message"})
+
+	f, err := table.DetectFormat(el.outputType)
+	if err != nil {
+		return errors.Wrap(err, "detecting output format")
+	}
+	t.SetFormat(f)
+
+	var rows [][]string
+
+	var names []string
+	for name, env := range environments {
+		if env.Destination == nil || env.Destination.Server == "" {
+			rows = append(rows, []string{name, "no server", "", ""})
+			continue
+		}
+
+		names = append(names, name)
+	}
+
+	failures := probeClusters(names, func(name string) string {
+		if err := el.healthzFn(name); err != nil {
+			return err.Error()
+		}
+
+		return ""
+	})
+
+	for name, message := range failures {
+		if message == "" {
+			continue
+		}
+
+		rows = append(rows, []string{name, "unreachable", environments[name].Destination.Server, message})
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i][0] < rows[j][0]
+	})
+
+	t.AppendBulk(rows)
+
+	return t.Render()
+}
+
+// probeClusters calls probe for each environment, up to
+// clusterProbeParallelism at a time, and returns the results by environment.
+func probeClusters(names []string, probe func(name string) string) map[string]string {
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+
+	results := make(map[string]string)
+	sem := make(chan struct{}, clusterProbeParallelism)
+
+	for _, name := range names {
+		name := name
+
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			result := probe(name)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			results[name] = result
+		}()
+	}
+
+	wg.Wait()
+
+	return results
+}
+
+// listStaleContexts lists the environments created from a kubeconfig context
+// that either no longer exists, or whose cluster server no longer matches the
+// server of the environment.
+func (el *EnvList) listStaleContexts(environments app.EnvironmentConfigs) error {
+	contextServers, err := el.contextServersFn()
+	if err != nil {
+		return err
+	}
+
+	t := table.New("envListStaleContexts", el.out)
+	t.SetHeader([]string{"name", "context", "problem", "server", "context-server"})
+
+	f, err := table.DetectFormat(el.outputType)
+	if err != nil {
+		return errors.Wrap(err, "detecting output format")
+	}
+	t.SetFormat(f)
+
+	var rows [][]string
+
+	for name, env := range environments {
+		if env.Context == "" {
+			continue
+		}
+
+		var server string
+		if env.Destination != nil {
+			server = env.Destination.Server
+		}
+
+		contextServer, ok := contextServers[env.Context]
+		if !ok {
+			rows = append(rows, []string{name, env.Context, "context missing", server, ""})
+			continue
+		}
+
+		matches, err := sameServer(server, contextServer)
+		if err != nil {
+			return err
+		}
+
+		if !matches {
+			rows = append(rows, []string{name, env.Context, "server mismatch", server, contextServer})
+		}
 	}
 
 	sort.Slice(rows, func(i, j int) bool {
@@ -105,5 +574,23 @@ func (el *EnvList) Run() error {
 	t.AppendBulk(rows)
 
 	return t.Render()
+}
+
+// sameServer returns true if two server URLs are equal once normalized.
+func sameServer(a, b string) (bool, error) {
+	if a == "" || b == "" {
+		return a == b, nil
+	}
+
+	normalizedA, err := str.NormalizeURL(a)
+	if err != nil {
+		return false, err
+	}
+
+	normalizedB, err := str.NormalizeURL(b)
+	if err != nil {
+		return false, err
+	}
 
+	return normalizedA == normalizedB, nil
 }
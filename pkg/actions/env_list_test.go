@@ -17,13 +17,16 @@ package actions
 
 import (
 	"bytes"
+	"fmt"
 	"path/filepath"
 	"testing"
 
 	"github.com/ksonnet/ksonnet/pkg/app"
 	amocks "github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/ksonnet/ksonnet/pkg/client"
 	"github.com/ksonnet/ksonnet/pkg/util/test"
 	"github.com/pkg/errors"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -56,6 +59,15 @@ func TestEnvList(t *testing.T) {
 		appMock.On("IsEnvOverride", mock.Anything).Return(false)
 	}
 
+	setupNoDestination := func(appMock *amocks.App) {
+		envs := app.EnvironmentConfigs{
+			"default": {KubernetesVersion: "v1.7.0"},
+		}
+
+		appMock.On("Environments").Return(envs, nil)
+		appMock.On("IsEnvOverride", mock.Anything).Return(false)
+	}
+
 	envListFail := func(appMock *amocks.App) {
 		appMock.On("Environments").Return(nil, errors.New("failed"))
 		appMock.On("IsEnvOverride", mock.Anything).Return(false)
@@ -85,6 +97,18 @@ func TestEnvList(t *testing.T) {
 			outputType:   "json",
 			expectedFile: filepath.Join("env", "list", "output.json"),
 		},
+		{
+			name:         "yaml output",
+			initApp:      setupValidApp,
+			outputType:   "yaml",
+			expectedFile: filepath.Join("env", "list", "output.yaml"),
+		},
+		{
+			name:         "yaml output without destination",
+			initApp:      setupNoDestination,
+			outputType:   "yaml",
+			expectedFile: filepath.Join("env", "list", "no-destination.yaml"),
+		},
 		{
 			name:       "invalid output format",
 			initApp:    setupValidApp,
@@ -126,8 +150,483 @@ func TestEnvList(t *testing.T) {
 	}
 }
 
+func TestEnvList_stale_contexts(t *testing.T) {
+	cases := []struct {
+		name         string
+		outputType   string
+		expectedFile string
+	}{
+		{
+			name:         "table output",
+			expectedFile: filepath.Join("env", "list", "stale-contexts.txt"),
+		},
+		{
+			name:         "json output",
+			outputType:   "json",
+			expectedFile: filepath.Join("env", "list", "stale-contexts.json"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withApp(t, func(appMock *amocks.App) {
+				newEnv := func(context, server string) *app.EnvironmentConfig {
+					return &app.EnvironmentConfig{
+						Context: context,
+						Destination: &app.EnvironmentDestinationSpec{
+							Namespace: "default",
+							Server:    server,
+						},
+					}
+				}
+
+				envs := app.EnvironmentConfigs{
+					"default": newEnv("dev", "https://dev.example.com"),
+					"local":   newEnv("", "https://local.example.com"),
+					"prod":    newEnv("prod", "https://prod.example.com"),
+					"staging": newEnv("staging", "https://staging.example.com"),
+					"test":    newEnv("test", "https://test.example.com:443/"),
+				}
+				appMock.On("Environments").Return(envs, nil)
+
+				in := map[string]interface{}{
+					OptionApp:           appMock,
+					OptionClientConfig:  &client.Config{},
+					OptionOutput:        tc.outputType,
+					OptionStaleContexts: true,
+				}
+
+				a, err := NewEnvList(in)
+				require.NoError(t, err)
+
+				a.contextServersFn = func() (map[string]string, error) {
+					return map[string]string{
+						"dev":  "https://dev.example.com",
+						"prod": "https://prod-2.example.com",
+						"test": "https://test.example.com",
+					}, nil
+				}
+
+				var buf bytes.Buffer
+				a.out = &buf
+
+				err = a.Run()
+				require.NoError(t, err)
+
+				test.AssertOutput(t, tc.expectedFile, buf.String())
+			})
+		})
+	}
+}
+
+func TestEnvList_with_cluster_version(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		newEnv := func(namespace string) *app.EnvironmentConfig {
+			return &app.EnvironmentConfig{
+				KubernetesVersion: "v1.7.0",
+				Destination: &app.EnvironmentDestinationSpec{
+					Namespace: namespace,
+					Server:    "http://example.com",
+				},
+			}
+		}
+
+		envs := app.EnvironmentConfigs{
+			"default": newEnv("default"),
+			"prod":    newEnv("prod"),
+		}
+		appMock.On("Environments").Return(envs, nil)
+		appMock.On("IsEnvOverride", mock.Anything).Return(false)
+
+		in := map[string]interface{}{
+			OptionApp:                appMock,
+			OptionClientConfig:       &client.Config{},
+			OptionWithClusterVersion: true,
+		}
+
+		a, err := NewEnvList(in)
+		require.NoError(t, err)
+
+		a.serverVersionFn = func(envName string) (string, error) {
+			if envName == "prod" {
+				return "", errors.New("connection refused")
+			}
+
+			return "v1.10.3", nil
+		}
+
+		var buf bytes.Buffer
+		a.out = &buf
+
+		err = a.Run()
+		require.NoError(t, err)
+
+		test.AssertOutput(t, filepath.Join("env", "list", "cluster-version.txt"), buf.String())
+	})
+}
+
+func TestEnvList_all(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		newEnv := func(k8sVersion string) *app.EnvironmentConfig {
+			return &app.EnvironmentConfig{
+				KubernetesVersion: k8sVersion,
+				Destination: &app.EnvironmentDestinationSpec{
+					Namespace: "default",
+					Server:    "http://example.com",
+				},
+			}
+		}
+
+		envs := app.EnvironmentConfigs{
+			"default": newEnv("v1.7.0"),
+			"dev":     newEnv("v1.9.0"),
+			"prod":    newEnv("v1.8.0"),
+			"scratch": newEnv(""),
+		}
+		appMock.On("Environments").Return(envs, nil)
+		appMock.On("IsEnvOverride", mock.Anything).Return(false)
+
+		fs := appMock.Fs()
+		swagger := `{"info": {"version": "%s"}, "definitions": {}}`
+		stageSwagger := func(dir, version string) {
+			path := filepath.Join("/lib", "ksonnet-lib", dir, "swagger.json")
+			require.NoError(t, afero.WriteFile(fs, path, []byte(fmt.Sprintf(swagger, version)), 0644))
+		}
+		stageSwagger("v1.7.0", "v1.7.0")
+		stageSwagger("v1.8.0", "v1.7.3")
+
+		in := map[string]interface{}{
+			OptionApp: appMock,
+			OptionAll: true,
+		}
+
+		a, err := NewEnvList(in)
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		a.out = &buf
+
+		err = a.Run()
+		require.NoError(t, err)
+
+		test.AssertOutput(t, filepath.Join("env", "list", "all.txt"), buf.String())
+	})
+}
+
+func TestEnvList_all_with_stale_contexts(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		in := map[string]interface{}{
+			OptionApp:           appMock,
+			OptionClientConfig:  &client.Config{},
+			OptionAll:           true,
+			OptionStaleContexts: true,
+		}
+
+		_, err := NewEnvList(in)
+		require.Error(t, err)
+	})
+}
+
+func TestEnvList_unreachable(t *testing.T) {
+	cases := []struct {
+		name         string
+		outputType   string
+		expectedFile string
+	}{
+		{
+			name:         "table output",
+			expectedFile: filepath.Join("env", "list", "unreachable.txt"),
+		},
+		{
+			name:         "json output",
+			outputType:   "json",
+			expectedFile: filepath.Join("env", "list", "unreachable.json"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withApp(t, func(appMock *amocks.App) {
+				newEnv := func(server string) *app.EnvironmentConfig {
+					return &app.EnvironmentConfig{
+						Destination: &app.EnvironmentDestinationSpec{
+							Namespace: "default",
+							Server:    server,
+						},
+					}
+				}
+
+				envs := app.EnvironmentConfigs{
+					"default": newEnv("https://dev.example.com"),
+					"local":   newEnv(""),
+					"old":     newEnv("https://old.example.com"),
+					"prod":    newEnv("https://prod.example.com"),
+				}
+				appMock.On("Environments").Return(envs, nil)
+
+				in := map[string]interface{}{
+					OptionApp:          appMock,
+					OptionClientConfig: &client.Config{},
+					OptionOutput:       tc.outputType,
+					OptionUnreachable:  true,
+				}
+
+				a, err := NewEnvList(in)
+				require.NoError(t, err)
+
+				a.healthzFn = func(envName string) error {
+					switch envName {
+					case "old":
+						return errors.New("i/o timeout")
+					case "local":
+						t.Error("environments without a server should not be probed")
+					}
+
+					return nil
+				}
+
+				var buf bytes.Buffer
+				a.out = &buf
+
+				err = a.Run()
+				require.NoError(t, err)
+
+				test.AssertOutput(t, tc.expectedFile, buf.String())
+			})
+		})
+	}
+}
+
+func TestEnvList_unreachable_with_stale_contexts(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		in := map[string]interface{}{
+			OptionApp:           appMock,
+			OptionClientConfig:  &client.Config{},
+			OptionStaleContexts: true,
+			OptionUnreachable:   true,
+		}
+
+		_, err := NewEnvList(in)
+		require.Error(t, err)
+	})
+}
+
+func TestEnvList_env_output(t *testing.T) {
+	envs := app.EnvironmentConfigs{
+		"default": &app.EnvironmentConfig{
+			KubernetesVersion: "v1.7.0",
+			Destination: &app.EnvironmentDestinationSpec{
+				Namespace: "default",
+				Server:    "http://example.com",
+			},
+		},
+		"us-west/staging": &app.EnvironmentConfig{
+			KubernetesVersion: "v1.10.3",
+			Destination: &app.EnvironmentDestinationSpec{
+				Namespace: "staging",
+				Server:    "https://staging.example.com:8443",
+			},
+		},
+	}
+
+	cases := []struct {
+		name     string
+		envName  string
+		expected string
+		isErr    bool
+	}{
+		{
+			name:    "selected environment",
+			envName: "us-west/staging",
+			expected: "KS_ENV_NAME='us-west/staging'\n" +
+				"KS_ENV_URI='https://staging.example.com:8443'\n" +
+				"KS_ENV_NAMESPACE='staging'\n" +
+				"KS_ENV_KUBERNETES_VERSION='v1.10.3'\n",
+		},
+		{
+			name:  "several environments",
+			isErr: true,
+		},
+		{
+			name:    "unknown environment",
+			envName: "missing",
+			isErr:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withApp(t, func(appMock *amocks.App) {
+				appMock.On("Environments").Return(envs, nil)
+				appMock.On("IsEnvOverride", mock.Anything).Return(false)
+
+				in := map[string]interface{}{
+					OptionApp:     appMock,
+					OptionOutput:  OutputEnv,
+					OptionEnvName: tc.envName,
+				}
+
+				a, err := NewEnvList(in)
+				require.NoError(t, err)
+
+				var buf bytes.Buffer
+				a.out = &buf
+
+				err = a.Run()
+				if tc.isErr {
+					require.Error(t, err)
+					return
+				}
+
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, buf.String())
+			})
+		})
+	}
+}
+
+func TestEnvList_env_output_with_unreachable(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		in := map[string]interface{}{
+			OptionApp:          appMock,
+			OptionClientConfig: &client.Config{},
+			OptionOutput:       OutputEnv,
+			OptionUnreachable:  true,
+		}
+
+		_, err := NewEnvList(in)
+		require.Error(t, err)
+	})
+}
+
+func TestEnvList_filter(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		envs := app.EnvironmentConfigs{
+			"us-west/staging": {
+				KubernetesVersion: "v1.7.0",
+				Destination:       &app.EnvironmentDestinationSpec{Namespace: "staging", Server: "http://example.com"},
+				Tags:              map[string]string{"region": "us-west", "tier": "staging"},
+			},
+			"us-west/prod": {
+				KubernetesVersion: "v1.7.0",
+				Destination:       &app.EnvironmentDestinationSpec{Namespace: "prod", Server: "http://example.com"},
+				Tags:              map[string]string{"region": "us-west", "tier": "prod"},
+			},
+			"us-east/prod": {
+				KubernetesVersion: "v1.7.0",
+				Destination:       &app.EnvironmentDestinationSpec{Namespace: "prod", Server: "http://example.com"},
+				Tags:              map[string]string{"region": "us-east", "tier": "prod"},
+			},
+			"default": {
+				KubernetesVersion: "v1.7.0",
+				Destination:       &app.EnvironmentDestinationSpec{Namespace: "default", Server: "http://example.com"},
+			},
+		}
+
+		appMock.On("Environments").Return(envs, nil)
+		appMock.On("IsEnvOverride", mock.Anything).Return(false)
+
+		in := map[string]interface{}{
+			OptionApp:    appMock,
+			OptionFilter: []string{"tier=prod", "region=us-west"},
+		}
+
+		a, err := NewEnvList(in)
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		a.out = &buf
+
+		err = a.Run()
+		require.NoError(t, err)
+
+		test.AssertOutput(t, filepath.Join("env", "list", "filter.txt"), buf.String())
+	})
+}
+
+func TestEnvList_invalid_filter(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		in := map[string]interface{}{
+			OptionApp:    appMock,
+			OptionFilter: []string{"tier"},
+		}
+
+		_, err := NewEnvList(in)
+		require.Error(t, err)
+	})
+}
+
+func Test_shellQuote(t *testing.T) {
+	cases := []struct {
+		in       string
+		expected string
+	}{
+		{in: "", expected: "''"},
+		{in: "https://example.com", expected: "'https://example.com'"},
+		{in: "$(rm -rf /)", expected: "'$(rm -rf /)'"},
+		{in: "it's", expected: `'it'\''s'`},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.in, func(t *testing.T) {
+			require.Equal(t, tc.expected, shellQuote(tc.in))
+		})
+	}
+}
+
 func TestEnvList_requires_app(t *testing.T) {
 	in := make(map[string]interface{})
 	_, err := NewEnvList(in)
 	require.Error(t, err)
 }
+
+func TestEnvList_tree(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		envs := app.EnvironmentConfigs{
+			"default": {
+				Destination: &app.EnvironmentDestinationSpec{Namespace: "default", Server: "http://example.com"},
+			},
+			"us-west/staging": {
+				Destination: &app.EnvironmentDestinationSpec{Namespace: "staging", Server: "https://us-west.example.com"},
+			},
+			"us-west/prod/a": {
+				Destination: &app.EnvironmentDestinationSpec{Namespace: "prod", Server: "https://a.us-west.example.com"},
+			},
+			"us-west/prod/b": {
+				Destination: &app.EnvironmentDestinationSpec{Namespace: "prod", Server: "https://b.us-west.example.com"},
+			},
+			"us-east/prod": {},
+		}
+
+		appMock.On("Environments").Return(envs, nil)
+
+		in := map[string]interface{}{
+			OptionApp:  appMock,
+			OptionTree: true,
+		}
+
+		a, err := NewEnvList(in)
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		a.out = &buf
+
+		err = a.Run()
+		require.NoError(t, err)
+
+		test.AssertOutput(t, filepath.Join("env", "list", "tree.txt"), buf.String())
+	})
+}
+
+func TestEnvList_tree_with_output(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		in := map[string]interface{}{
+			OptionApp:    appMock,
+			OptionTree:   true,
+			OptionOutput: "json",
+		}
+
+		_, err := NewEnvList(in)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,121 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package actions
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/ksonnet/ksonnet/pkg/util/table"
+	"github.com/pkg/errors"
+)
+
+type pingFn func(a app.App, clientConfig *client.Config, envName string, timeout time.Duration) error
+
+// RunEnvPing runs `env ping`.
+func RunEnvPing(m map[string]interface{}) error {
+	ep, err := NewEnvPing(m)
+	if err != nil {
+		return err
+	}
+
+	return ep.Run()
+}
+
+// EnvPing checks the health of the clusters of one or more environments.
+type EnvPing struct {
+	app          app.App
+	envNames     []string
+	clientConfig *client.Config
+	timeout      time.Duration
+	out          io.Writer
+
+	pingFn pingFn
+}
+
+// NewEnvPing creates an instance of EnvPing.
+func NewEnvPing(m map[string]interface{}) (*EnvPing, error) {
+	ol := newOptionLoader(m)
+
+	ep := &EnvPing{
+		app:          ol.LoadApp(),
+		envNames:     ol.LoadStringSlice(OptionEnvNames),
+		clientConfig: ol.LoadClientConfig(),
+		timeout:      ol.LoadDuration(OptionTimeout),
+		out:          os.Stdout,
+
+		pingFn: pingEnvironment,
+	}
+
+	if ol.err != nil {
+		return nil, ol.err
+	}
+
+	return ep, nil
+}
+
+// Run pings each environment's server and prints a summary. It returns an
+// error if any of the environments is unhealthy.
+func (ep *EnvPing) Run() error {
+	if len(ep.envNames) == 0 {
+		return errors.New("at least one environment is required")
+	}
+
+	t := table.New("envPing", ep.out)
+	t.SetHeader([]string{"name", "status", "message"})
+
+	unhealthy := 0
+	for _, envName := range ep.envNames {
+		status, message := "ok", ""
+		if err := ep.ping(envName); err != nil {
+			status, message = "error", err.Error()
+			unhealthy++
+		}
+
+		t.Append([]string{envName, status, message})
+	}
+
+	if err := t.Render(); err != nil {
+		return err
+	}
+
+	if len(ep.envNames) > 1 {
+		fmt.Fprintf(ep.out, "\n%d of %d environment(s) healthy\n",
+			len(ep.envNames)-unhealthy, len(ep.envNames))
+	}
+
+	if unhealthy > 0 {
+		return errors.Errorf("%d environment(s) unhealthy", unhealthy)
+	}
+
+	return nil
+}
+
+func (ep *EnvPing) ping(envName string) error {
+	if _, err := ep.app.Environment(envName); err != nil {
+		return err
+	}
+
+	return ep.pingFn(ep.app, ep.clientConfig, envName, ep.timeout)
+}
+
+func pingEnvironment(a app.App, clientConfig *client.Config, envName string, timeout time.Duration) error {
+	return clientConfig.EnvironmentHealthz(a, envName, timeout)
+}
@@ -0,0 +1,103 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package actions
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	amocks "github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvPing(t *testing.T) {
+	cases := []struct {
+		name       string
+		envNames   []string
+		outputFile string
+		isErr      bool
+	}{
+		{
+			name:       "healthy environment",
+			envNames:   []string{"default"},
+			outputFile: "env/ping/healthy.txt",
+		},
+		{
+			name:       "multiple environments",
+			envNames:   []string{"default", "prod", "missing"},
+			outputFile: "env/ping/multiple.txt",
+			isErr:      true,
+		},
+		{
+			name:  "no environments",
+			isErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withApp(t, func(appMock *amocks.App) {
+				env := &app.EnvironmentConfig{}
+				appMock.On("Environment", "default").Return(env, nil)
+				appMock.On("Environment", "prod").Return(env, nil)
+				appMock.On("Environment", "missing").Return(nil, errors.New("environment \"missing\" was not found"))
+
+				in := map[string]interface{}{
+					OptionApp:          appMock,
+					OptionEnvNames:     tc.envNames,
+					OptionClientConfig: &client.Config{},
+					OptionTimeout:      time.Second,
+				}
+
+				a, err := NewEnvPing(in)
+				require.NoError(t, err)
+
+				a.pingFn = func(a app.App, clientConfig *client.Config, envName string, timeout time.Duration) error {
+					assert.Equal(t, time.Second, timeout)
+					if envName == "prod" {
+						return errors.New("connection refused")
+					}
+					return nil
+				}
+
+				var buf bytes.Buffer
+				a.out = &buf
+
+				err = a.Run()
+				if tc.isErr {
+					require.Error(t, err)
+				} else {
+					require.NoError(t, err)
+				}
+
+				if tc.outputFile != "" {
+					assertOutput(t, tc.outputFile, buf.String())
+				}
+			})
+		})
+	}
+}
+
+func TestEnvPing_requires_app(t *testing.T) {
+	in := make(map[string]interface{})
+	_, err := NewEnvPing(in)
+	require.Error(t, err)
+}
@@ -0,0 +1,117 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package actions
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/ksonnet/ksonnet/pkg/env"
+)
+
+// RunEnvPruneEmpty runs `env prune-empty`.
+func RunEnvPruneEmpty(m map[string]interface{}) error {
+	epe, err := NewEnvPruneEmpty(m)
+	if err != nil {
+		return err
+	}
+
+	return epe.Run()
+}
+
+// EnvPruneEmpty removes environment directories which have no configuration.
+type EnvPruneEmpty struct {
+	app    app.App
+	dryRun bool
+	force  bool
+	in     io.Reader
+	out    io.Writer
+
+	skeletonsFn      func(app.App) ([]string, error)
+	pruneSkeletonsFn func(app.App, []string) error
+}
+
+// NewEnvPruneEmpty creates an instance of EnvPruneEmpty.
+func NewEnvPruneEmpty(m map[string]interface{}) (*EnvPruneEmpty, error) {
+	ol := newOptionLoader(m)
+
+	epe := &EnvPruneEmpty{
+		app:    ol.LoadApp(),
+		dryRun: ol.LoadBool(OptionDryRun),
+		force:  ol.LoadBool(OptionForce),
+		in:     os.Stdin,
+		out:    os.Stdout,
+
+		skeletonsFn:      env.Skeletons,
+		pruneSkeletonsFn: env.PruneSkeletons,
+	}
+
+	if ol.err != nil {
+		return nil, ol.err
+	}
+
+	return epe, nil
+}
+
+// Run lists the environment skeletons, and removes them once confirmed.
+func (epe *EnvPruneEmpty) Run() error {
+	skeletons, err := epe.skeletonsFn(epe.app)
+	if err != nil {
+		return err
+	}
+
+	if len(skeletons) == 0 {
+		fmt.Fprintln(epe.out, "No environment directories without a configuration were found")
+		return nil
+	}
+
+	fmt.Fprintln(epe.out, "Environment directories without a configuration in app.yaml:")
+	for _, skeleton := range skeletons {
+		fmt.Fprintf(epe.out, "  %s\n", filepath.Join(app.EnvironmentDirName, skeleton))
+	}
+
+	if epe.dryRun {
+		return nil
+	}
+
+	if !epe.force && !epe.confirm(len(skeletons)) {
+		fmt.Fprintln(epe.out, "Nothing was removed")
+		return nil
+	}
+
+	return epe.pruneSkeletonsFn(epe.app, skeletons)
+}
+
+func (epe *EnvPruneEmpty) confirm(count int) bool {
+	fmt.Fprintf(epe.out, "Remove %d directories? [y/N] ", count)
+
+	answer, err := bufio.NewReader(epe.in).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return false
+	}
+
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package actions
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	amocks "github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvPruneEmpty(t *testing.T) {
+	listing := "Environment directories without a configuration in app.yaml:\n  environments/failed\n"
+
+	cases := []struct {
+		name      string
+		skeletons []string
+		dryRun    bool
+		force     bool
+		answer    string
+		output    string
+		isPruned  bool
+	}{
+		{
+			name:   "no skeletons",
+			output: "No environment directories without a configuration were found\n",
+		},
+		{
+			name:      "confirmed",
+			skeletons: []string{"failed"},
+			answer:    "y\n",
+			output:    listing + "Remove 1 directories? [y/N] ",
+			isPruned:  true,
+		},
+		{
+			name:      "declined",
+			skeletons: []string{"failed"},
+			answer:    "\n",
+			output:    listing + "Remove 1 directories? [y/N] Nothing was removed\n",
+		},
+		{
+			name:      "forced",
+			skeletons: []string{"failed"},
+			force:     true,
+			output:    listing,
+			isPruned:  true,
+		},
+		{
+			name:      "dry run",
+			skeletons: []string{"failed"},
+			dryRun:    true,
+			force:     true,
+			output:    listing,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withApp(t, func(appMock *amocks.App) {
+				in := map[string]interface{}{
+					OptionApp:    appMock,
+					OptionDryRun: tc.dryRun,
+					OptionForce:  tc.force,
+				}
+
+				a, err := NewEnvPruneEmpty(in)
+				require.NoError(t, err)
+
+				a.skeletonsFn = func(app.App) ([]string, error) {
+					return tc.skeletons, nil
+				}
+
+				var isPruned bool
+				a.pruneSkeletonsFn = func(_ app.App, skeletons []string) error {
+					assert.Equal(t, tc.skeletons, skeletons)
+					isPruned = true
+					return nil
+				}
+
+				var buf bytes.Buffer
+				a.in = strings.NewReader(tc.answer)
+				a.out = &buf
+
+				err = a.Run()
+				require.NoError(t, err)
+
+				assert.Equal(t, tc.isPruned, isPruned)
+				assert.Equal(t, tc.output, buf.String())
+			})
+		})
+	}
+}
+
+func TestEnvPruneEmpty_requires_app(t *testing.T) {
+	in := make(map[string]interface{})
+	_, err := NewEnvPruneEmpty(in)
+	require.Error(t, err)
+}
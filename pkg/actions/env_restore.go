@@ -0,0 +1,62 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package actions
+
+import (
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/ksonnet/ksonnet/pkg/env"
+)
+
+// RunEnvRestore runs `env restore`.
+func RunEnvRestore(m map[string]interface{}) error {
+	er, err := NewEnvRestore(m)
+	if err != nil {
+		return err
+	}
+
+	return er.Run()
+}
+
+// EnvRestore restores an environment removed with `env rm` from the trash.
+type EnvRestore struct {
+	app     app.App
+	envName string
+
+	envRestoreFn func(a app.App, name string) error
+}
+
+// NewEnvRestore creates an instance of EnvRestore.
+func NewEnvRestore(m map[string]interface{}) (*EnvRestore, error) {
+	ol := newOptionLoader(m)
+
+	er := &EnvRestore{
+		app:     ol.LoadApp(),
+		envName: ol.LoadString(OptionEnvName),
+
+		envRestoreFn: env.Restore,
+	}
+
+	if ol.err != nil {
+		return nil, ol.err
+	}
+
+	return er, nil
+}
+
+// Run restores the environment.
+func (er *EnvRestore) Run() error {
+	return er.envRestoreFn(er.app, er.envName)
+}
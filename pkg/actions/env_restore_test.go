@@ -0,0 +1,55 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package actions
+
+import (
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	amocks "github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvRestore(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		in := map[string]interface{}{
+			OptionApp:     appMock,
+			OptionEnvName: "us-west/staging",
+		}
+
+		a, err := NewEnvRestore(in)
+		require.NoError(t, err)
+
+		var restored bool
+		a.envRestoreFn = func(a app.App, name string) error {
+			assert.Equal(t, appMock, a)
+			assert.Equal(t, "us-west/staging", name)
+			restored = true
+			return nil
+		}
+
+		err = a.Run()
+		require.NoError(t, err)
+		assert.True(t, restored)
+	})
+}
+
+func TestEnvRestore_requires_app(t *testing.T) {
+	in := make(map[string]interface{})
+	_, err := NewEnvRestore(in)
+	require.Error(t, err)
+}
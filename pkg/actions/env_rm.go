@@ -16,10 +16,24 @@
 package actions
 
 import (
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
 	"github.com/ksonnet/ksonnet/pkg/app"
 	"github.com/ksonnet/ksonnet/pkg/env"
+	str "github.com/ksonnet/ksonnet/pkg/util/strings"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
 )
 
+// envPatternChars are the characters that make an environment name passed to
+// `env rm` a glob pattern. They are not allowed in environment names.
+const envPatternChars = "*?["
+
 // RunEnvRm runs `env rm`
 func RunEnvRm(m map[string]interface{}) error {
 	ea, err := NewEnvRm(m)
@@ -37,8 +51,16 @@ type EnvRm struct {
 	app        app.App
 	envName    string
 	isOverride bool
+	yes        bool
+	dryRun     bool
+	force      bool
+	purge      bool
+	out        io.Writer
 
-	envDeleteFn envDeleteFn
+	envDeleteFn    envDeleteFn
+	envDeleteOpsFn func(a app.App, name string, override bool) ([]env.Operation, error)
+	inheritorsFn   func(a app.App, name string) ([]string, error)
+	referencesFn   func(a app.App, name string) ([]string, error)
 }
 
 // NewEnvRm creates an instance of EnvRm.
@@ -49,8 +71,21 @@ func NewEnvRm(m map[string]interface{}) (*EnvRm, error) {
 		app:        ol.LoadApp(),
 		envName:    ol.LoadString(OptionEnvName),
 		isOverride: ol.LoadBool(OptionOverride),
+		yes:        ol.LoadOptionalBool(OptionYes),
+		dryRun:     ol.LoadOptionalBool(OptionDryRun),
+		force:      ol.LoadOptionalBool(OptionForce),
+		purge:      ol.LoadOptionalBool(OptionPurge),
+		out:        os.Stdout,
+
+		envDeleteFn:    env.Trash,
+		envDeleteOpsFn: env.TrashOperations,
+		inheritorsFn:   env.Inheritors,
+		referencesFn:   env.References,
+	}
 
-		envDeleteFn: env.Delete,
+	if ea.purge {
+		ea.envDeleteFn = env.Delete
+		ea.envDeleteOpsFn = env.DeleteOperations
 	}
 
 	if ol.err != nil {
@@ -60,11 +95,131 @@ func NewEnvRm(m map[string]interface{}) (*EnvRm, error) {
 	return ea, nil
 }
 
-// Run assigns targets to an environment.
+// Run removes the environment, or every environment matching a glob pattern.
 func (er *EnvRm) Run() error {
-	return er.envDeleteFn(
-		er.app,
-		er.envName,
-		er.isOverride,
-	)
+	if !strings.ContainsAny(er.envName, envPatternChars) {
+		if err := er.checkInheritors(er.envName, nil); err != nil {
+			return err
+		}
+		return er.remove(er.envName)
+	}
+
+	names, err := er.matchEnvironments()
+	if err != nil {
+		return err
+	}
+
+	if len(names) == 0 {
+		return errors.Errorf("no environments match %q", er.envName)
+	}
+
+	if len(names) > 1 && !er.yes && !er.dryRun {
+		return errors.Errorf("%q matches %d environments (%s); pass --yes to remove all of them",
+			er.envName, len(names), strings.Join(names, ", "))
+	}
+
+	for _, name := range names {
+		if err := er.checkInheritors(name, names); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range names {
+		if err := er.remove(name); err != nil {
+			return errors.Wrapf(err, "remove environment %q", name)
+		}
+	}
+
+	return nil
+}
+
+// remove removes an environment, moving its files to the trash unless it is
+// purged. With a dry run, the files that would be moved or removed are listed
+// instead.
+func (er *EnvRm) remove(name string) error {
+	if err := er.warnReferences(name); err != nil {
+		return err
+	}
+
+	if !er.dryRun {
+		return er.envDeleteFn(er.app, name, er.isOverride)
+	}
+
+	ops, err := er.envDeleteOpsFn(er.app, name, er.isOverride)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(er.out, "Removing environment %q would:\n", name)
+	return env.WriteOperations(er.out, ops)
+}
+
+// checkInheritors returns an error if environments that aren't being removed
+// inherit parameters from an environment.
+func (er *EnvRm) checkInheritors(name string, removing []string) error {
+	inheritors, err := er.inheritorsFn(er.app, name)
+	if err != nil {
+		return err
+	}
+
+	var remaining []string
+	for _, inheritor := range inheritors {
+		if !str.InSlice(inheritor, removing) {
+			remaining = append(remaining, inheritor)
+		}
+	}
+
+	if len(remaining) > 0 {
+		return errors.Errorf("environment %q can't be removed while it is inherited by %s; run `ks env set <env-name> --unset=inherits` on them first",
+			name, strings.Join(remaining, ", "))
+	}
+
+	return nil
+}
+
+// warnReferences warns about the component, parameter and environment files
+// that refer to an environment by name, as they will refer to an environment
+// that doesn't exist once it is removed. The files aren't searched with
+// --force.
+func (er *EnvRm) warnReferences(name string) error {
+	if er.force {
+		return nil
+	}
+
+	paths, err := er.referencesFn(er.app, name)
+	if err != nil {
+		return err
+	}
+
+	if len(paths) > 0 {
+		log.Warnf("environment %q is referenced by %s; they will refer to an environment that doesn't exist",
+			name, strings.Join(paths, ", "))
+	}
+
+	return nil
+}
+
+// matchEnvironments returns the sorted names of the environments matching
+// the glob pattern given as the environment name. As in a shell, `*` doesn't
+// match the `/` between levels of an environment's name.
+func (er *EnvRm) matchEnvironments() ([]string, error) {
+	environments, err := er.app.Environments()
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for name := range environments {
+		ok, err := path.Match(er.envName, name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "match environments with %q", er.envName)
+		}
+
+		if ok {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+	return names, nil
 }
@@ -16,10 +16,13 @@
 package actions
 
 import (
+	"bytes"
+	"reflect"
 	"testing"
 
 	"github.com/ksonnet/ksonnet/pkg/app"
 	amocks "github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/ksonnet/ksonnet/pkg/env"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -38,6 +41,9 @@ func TestEnvRm(t *testing.T) {
 		a, err := NewEnvRm(in)
 		require.NoError(t, err)
 
+		a.inheritorsFn = func(a app.App, name string) ([]string, error) {
+			return nil, nil
+		}
 		a.envDeleteFn = func(a app.App, name string, override bool) error {
 			assert.Equal(t, appMock, a)
 			assert.Equal(t, aName, name)
@@ -51,8 +57,297 @@ func TestEnvRm(t *testing.T) {
 	})
 }
 
+func TestEnvRm_pattern(t *testing.T) {
+	environments := app.EnvironmentConfigs{
+		"default":         &app.EnvironmentConfig{},
+		"us-west/staging": &app.EnvironmentConfig{},
+		"us-west/prod":    &app.EnvironmentConfig{},
+		"us-west/a/b":     &app.EnvironmentConfig{},
+		"us-east/staging": &app.EnvironmentConfig{},
+	}
+
+	cases := []struct {
+		name     string
+		pattern  string
+		yes      bool
+		expected []string
+		isErr    bool
+	}{
+		{
+			name:     "one match",
+			pattern:  "us-*/prod",
+			expected: []string{"us-west/prod"},
+		},
+		{
+			name:     "several matches confirmed",
+			pattern:  "us-west/*",
+			yes:      true,
+			expected: []string{"us-west/prod", "us-west/staging"},
+		},
+		{
+			name:    "several matches not confirmed",
+			pattern: "*/staging",
+			isErr:   true,
+		},
+		{
+			name:    "no matches",
+			pattern: "eu-*/staging",
+			yes:     true,
+			isErr:   true,
+		},
+		{
+			name:    "invalid pattern",
+			pattern: "us-west/[",
+			yes:     true,
+			isErr:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withApp(t, func(appMock *amocks.App) {
+				appMock.On("Environments").Return(environments, nil)
+
+				in := map[string]interface{}{
+					OptionApp:      appMock,
+					OptionEnvName:  tc.pattern,
+					OptionOverride: false,
+					OptionYes:      tc.yes,
+				}
+
+				a, err := NewEnvRm(in)
+				require.NoError(t, err)
+
+				var removed []string
+				a.envDeleteFn = func(a app.App, name string, override bool) error {
+					removed = append(removed, name)
+					return nil
+				}
+
+				err = a.Run()
+				if tc.isErr {
+					require.Error(t, err)
+					assert.Empty(t, removed)
+					return
+				}
+
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, removed)
+			})
+		})
+	}
+}
+
+func TestEnvRm_inherited(t *testing.T) {
+	environments := app.EnvironmentConfigs{
+		"base":            &app.EnvironmentConfig{Name: "base"},
+		"us-west/staging": &app.EnvironmentConfig{Name: "us-west/staging", Inherits: "base"},
+		"us-west/prod":    &app.EnvironmentConfig{Name: "us-west/prod", Inherits: "us-west/staging"},
+	}
+
+	cases := []struct {
+		name     string
+		envName  string
+		expected []string
+		errMsg   string
+	}{
+		{
+			name:    "parent",
+			envName: "base",
+			errMsg:  "environment \"base\" can't be removed while it is inherited by us-west/staging; run `ks env set <env-name> --unset=inherits` on them first",
+		},
+		{
+			name:     "parent removed with its inheritors",
+			envName:  "us-west/*",
+			expected: []string{"us-west/prod", "us-west/staging"},
+		},
+		{
+			name:     "inheritor",
+			envName:  "us-west/prod",
+			expected: []string{"us-west/prod"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withApp(t, func(appMock *amocks.App) {
+				appMock.On("Environments").Return(environments, nil)
+
+				in := map[string]interface{}{
+					OptionApp:      appMock,
+					OptionEnvName:  tc.envName,
+					OptionOverride: false,
+					OptionYes:      true,
+				}
+
+				a, err := NewEnvRm(in)
+				require.NoError(t, err)
+
+				var removed []string
+				a.envDeleteFn = func(a app.App, name string, override bool) error {
+					removed = append(removed, name)
+					return nil
+				}
+
+				err = a.Run()
+				if tc.errMsg != "" {
+					require.Error(t, err)
+					assert.Equal(t, tc.errMsg, err.Error())
+					assert.Empty(t, removed)
+					return
+				}
+
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, removed)
+			})
+		})
+	}
+}
+
+func TestEnvRm_references(t *testing.T) {
+	cases := []struct {
+		name     string
+		force    bool
+		searched bool
+	}{
+		{
+			name:     "in general",
+			searched: true,
+		},
+		{
+			name:  "force",
+			force: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withApp(t, func(appMock *amocks.App) {
+				in := map[string]interface{}{
+					OptionApp:      appMock,
+					OptionEnvName:  "prod",
+					OptionOverride: false,
+					OptionForce:    tc.force,
+				}
+
+				a, err := NewEnvRm(in)
+				require.NoError(t, err)
+
+				a.inheritorsFn = func(a app.App, name string) ([]string, error) {
+					return nil, nil
+				}
+
+				var searched bool
+				a.referencesFn = func(a app.App, name string) ([]string, error) {
+					searched = true
+					assert.Equal(t, "prod", name)
+					return []string{"components/params.libsonnet"}, nil
+				}
+
+				var deleted bool
+				a.envDeleteFn = func(a app.App, name string, override bool) error {
+					deleted = true
+					return nil
+				}
+
+				err = a.Run()
+				require.NoError(t, err)
+
+				assert.Equal(t, tc.searched, searched)
+				assert.True(t, deleted)
+			})
+		})
+	}
+}
+
+func TestEnvRm_purge(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		cases := []struct {
+			name     string
+			purge    bool
+			deleteFn interface{}
+			opsFn    interface{}
+		}{
+			{
+				name:     "moves to the trash",
+				deleteFn: env.Trash,
+				opsFn:    env.TrashOperations,
+			},
+			{
+				name:     "purge",
+				purge:    true,
+				deleteFn: env.Delete,
+				opsFn:    env.DeleteOperations,
+			},
+		}
+
+		for _, tc := range cases {
+			t.Run(tc.name, func(t *testing.T) {
+				in := map[string]interface{}{
+					OptionApp:      appMock,
+					OptionEnvName:  "default",
+					OptionOverride: false,
+					OptionPurge:    tc.purge,
+				}
+
+				a, err := NewEnvRm(in)
+				require.NoError(t, err)
+
+				assert.Equal(t, reflect.ValueOf(tc.deleteFn).Pointer(), reflect.ValueOf(a.envDeleteFn).Pointer())
+				assert.Equal(t, reflect.ValueOf(tc.opsFn).Pointer(), reflect.ValueOf(a.envDeleteOpsFn).Pointer())
+			})
+		}
+	})
+}
+
 func TestEnvRm_requires_app(t *testing.T) {
 	in := make(map[string]interface{})
 	_, err := NewEnvRm(in)
 	require.Error(t, err)
 }
+
+func TestEnvRm_dry_run(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		environments := app.EnvironmentConfigs{
+			"us-west/staging": &app.EnvironmentConfig{},
+			"us-west/prod":    &app.EnvironmentConfig{},
+		}
+		appMock.On("Environments").Return(environments, nil)
+
+		in := map[string]interface{}{
+			OptionApp:      appMock,
+			OptionEnvName:  "us-west/*",
+			OptionOverride: false,
+			OptionDryRun:   true,
+		}
+
+		a, err := NewEnvRm(in)
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		a.out = &buf
+
+		a.envDeleteFn = func(a app.App, name string, override bool) error {
+			t.Errorf("unexpected call: delete %q", name)
+			return nil
+		}
+		a.envDeleteOpsFn = func(a app.App, name string, override bool) ([]env.Operation, error) {
+			return []env.Operation{
+				{Action: env.OpRemove, Path: "environments/" + name + "/main.jsonnet"},
+				{Action: env.OpUpdate, Path: "app.yaml"},
+			}, nil
+		}
+
+		err = a.Run()
+		require.NoError(t, err)
+
+		expected := `Removing environment "us-west/prod" would:
+  remove   environments/us-west/prod/main.jsonnet
+  update   app.yaml
+Removing environment "us-west/staging" would:
+  remove   environments/us-west/staging/main.jsonnet
+  update   app.yaml
+`
+		require.Equal(t, expected, buf.String())
+	})
+}
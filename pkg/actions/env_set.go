@@ -16,11 +16,72 @@
 package actions
 
 import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	mp "github.com/ksonnet/ksonnet/metadata/params"
 	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/ksonnet/ksonnet/pkg/cluster"
 	"github.com/ksonnet/ksonnet/pkg/env"
+	"github.com/ksonnet/ksonnet/pkg/lib"
+	"github.com/ksonnet/ksonnet/pkg/util/jsonnet"
+	str "github.com/ksonnet/ksonnet/pkg/util/strings"
+	"github.com/ksonnet/ksonnet/pkg/util/table"
 	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// Fields of an environment, as named by `env set --unset`.
+const (
+	envFieldAPISpec           = "api-spec"
+	envFieldContext           = "context"
+	envFieldDefaultPDB        = "default-pdb"
+	envFieldDefaultReplicas   = "default-replicas"
+	envFieldExcludeComponents = "exclude-components"
+	envFieldHPARange          = "hpa-range"
+	envFieldIgnoreAnnotations = "ignore-annotations"
+	envFieldIncludeComponents = "include-components"
+	envFieldInherits          = "inherits"
+	envFieldName              = "name"
+	envFieldNamePrefix        = "name-prefix"
+	envFieldNamespace         = "namespace"
+	envFieldServer            = "server"
+	envFieldServiceAccount    = "service-account"
+
+	// defaultEnvNamespace is the namespace of environments that don't set one.
+	defaultEnvNamespace = "default"
+
+	// serverProbeInterval is how long to wait between attempts to reach a new
+	// server with `env set --wait-reachable`.
+	serverProbeInterval = 2 * time.Second
 )
 
+// unsettableEnvFields are the fields that can be removed with `env set --unset`.
+var unsettableEnvFields = []string{
+	envFieldAPISpec,
+	envFieldContext,
+	envFieldDefaultPDB,
+	envFieldDefaultReplicas,
+	envFieldExcludeComponents,
+	envFieldHPARange,
+	envFieldIgnoreAnnotations,
+	envFieldIncludeComponents,
+	envFieldInherits,
+	envFieldNamePrefix,
+	envFieldNamespace,
+	envFieldServiceAccount,
+}
+
 // EnvSetNamespace is an option for setting a new namespace name.
 func EnvSetNamespace(nsName string) EnvSetOpt {
 	return func(es *EnvSet) {
@@ -52,6 +113,8 @@ func RunEnvSet(m map[string]interface{}) error {
 // func types for renaming and updating environments
 type envRenameFn func(a app.App, from, to string, override bool) error
 type saveFn func(a app.App, envName, k8sAPISpec string, spec *app.EnvironmentConfig, override bool) error
+type regenLibFn func(a app.App, k8sAPISpec string, httpClient *http.Client) error
+type generatedSwaggerFn func(a app.App, k8sVersion string, httpClient *http.Client) ([]byte, error)
 
 // EnvSet sets targets for an environment.
 type EnvSet struct {
@@ -60,11 +123,46 @@ type EnvSet struct {
 	newName    string
 	newNsName  string
 	newServer  string
+	newContext string
 	newAPISpec string
+	newPrefix  string
+	newSA      string
+	replicas   int
+	hpaRange   string
+	pdb        string
+	ignore     string
+	ignoreObj  string
+	ignoreSel  string
+	include    []string
+	exclude    []string
+	inherits   string
+	tags       []string
+	unset      []string
+	params     []string
+	paramDels  []string
 	isOverride bool
+	fullRegen  bool
+	resetLib   bool
+	breakage   bool
+	wait       bool
+	dryRun     bool
+	timeout    time.Duration
+	out        io.Writer
 
-	envRenameFn envRenameFn
-	saveFn      saveFn
+	httpClient         *http.Client
+	envRenameFn        envRenameFn
+	renameOpsFn        func(a app.App, from, to string, override bool) ([]env.Operation, error)
+	saveFn             saveFn
+	regenLibFn         regenLibFn
+	renderFn           func(a app.App, envName string, componentNames []string) ([]*unstructured.Unstructured, error)
+	generatedSwaggerFn generatedSwaggerFn
+	probeServerFn      func(server string, timeout time.Duration) error
+	checkInheritFn     func(a app.App, name, parent string) error
+	snapshotFn         func(a app.App, envPath, newPath string) (*env.Snapshot, error)
+	nowFn              func() time.Time
+	sleepFn            func(time.Duration)
+	setParamsFn        func(a app.App, envName string, p mp.Params) error
+	deleteParamFn      func(a app.App, envName, paramName string) error
 }
 
 // NewEnvSet creates an instance of EnvSet.
@@ -77,11 +175,53 @@ func NewEnvSet(m map[string]interface{}) (*EnvSet, error) {
 		newName:    ol.LoadOptionalString(OptionNewEnvName),
 		newNsName:  ol.LoadOptionalString(OptionNamespace),
 		newServer:  ol.LoadOptionalString(OptionServer),
+		newContext: ol.LoadOptionalString(OptionContext),
 		newAPISpec: ol.LoadOptionalString(OptionSpecFlag),
+		newPrefix:  ol.LoadOptionalString(OptionNamePrefix),
+		newSA:      ol.LoadOptionalString(OptionServiceAccount),
+		replicas:   ol.LoadOptionalInt(OptionDefaultReplicas),
+		hpaRange:   ol.LoadOptionalString(OptionHPARange),
+		pdb:        ol.LoadOptionalString(OptionDefaultPDB),
+		ignore:     ol.LoadOptionalString(OptionIgnoreAnnotation),
+		ignoreObj:  ol.LoadOptionalString(OptionIgnoreObject),
+		ignoreSel:  ol.LoadOptionalString(OptionIgnoreSelector),
+		include:    ol.LoadOptionalStringSlice(OptionIncludeComponents),
+		exclude:    ol.LoadOptionalStringSlice(OptionExcludeComponents),
+		inherits:   ol.LoadOptionalString(OptionInherits),
+		tags:       ol.LoadOptionalStringSlice(OptionTags),
+		unset:      ol.LoadOptionalStringSlice(OptionUnsetFields),
+		params:     ol.LoadOptionalStringSlice(OptionParams),
+		paramDels:  ol.LoadOptionalStringSlice(OptionParamDeletes),
 		isOverride: ol.LoadOptionalBool(OptionOverride),
+		fullRegen:  ol.LoadOptionalBool(OptionFullRegen),
+		resetLib:   ol.LoadOptionalBool(OptionResetMetadata),
+		breakage:   ol.LoadOptionalBool(OptionReportBreakage),
+		wait:       ol.LoadOptionalBool(OptionWaitReachable),
+		dryRun:     ol.LoadOptionalBool(OptionDryRun),
+		out:        os.Stdout,
 
-		envRenameFn: env.Rename,
-		saveFn:      save,
+		httpClient:         ol.LoadHTTPClient(),
+		envRenameFn:        env.Rename,
+		renameOpsFn:        env.RenameOperations,
+		saveFn:             save,
+		regenLibFn:         regenLib,
+		renderFn:           cluster.Render,
+		generatedSwaggerFn: generatedSwagger,
+		checkInheritFn:     env.CheckInheritance,
+		snapshotFn:         env.TakeSnapshot,
+		nowFn:              time.Now,
+		sleepFn:            time.Sleep,
+		setParamsFn:        env.SetGlobalParams,
+		deleteParamFn:      env.UnsetGlobalParams,
+	}
+
+	if es.wait {
+		es.timeout = ol.LoadDuration(OptionTimeout)
+		clientConfig := ol.LoadClientConfig()
+		es.probeServerFn = func(server string, timeout time.Duration) error {
+			_, err := clientConfig.ServerVersionAt(server, "", timeout)
+			return err
+		}
 	}
 
 	if ol.err != nil {
@@ -98,17 +238,386 @@ func (es *EnvSet) Run() error {
 		return err
 	}
 
-	if err := es.updateName(es.isOverride); err != nil {
+	if es.fullRegen && (es.newAPISpec == "" || es.newAPISpec == app.AutoAPISpec) {
+		return errors.New("full regeneration requires an api spec")
+	}
+
+	if es.ignore == "" && (es.ignoreObj != "" || es.ignoreSel != "") {
+		return errors.New("limiting the objects of an ignore annotation requires an ignore annotation")
+	}
+
+	if es.breakage && es.dryRun {
+		return errors.New("reporting breakage needs the environment to be changed, and can't be combined with a dry run")
+	}
+
+	if es.resetLib {
+		return es.resetMetadata(env)
+	}
+
+	// A server resolved from a context is used as the kubeconfig has it.
+	if es.newContext == "" && es.newServer != "" {
+		server, err := normalizeServer(es.newServer)
+		if err != nil {
+			return err
+		}
+		es.newServer = server
+	}
+
+	if es.wait {
+		if err := es.waitForServer(); err != nil {
+			return err
+		}
+	}
+
+	var report *breakageReport
+	if es.breakage {
+		if report, err = es.prepareBreakageReport(env); err != nil {
+			return err
+		}
+	}
+
+	// All changes are validated before the environment is renamed or saved,
+	// so an invalid change leaves the environment as it was.
+	newEnv, k8sAPISpec, err := es.newEnvConfig(*env, es.newNsName, es.newServer, es.newAPISpec, es.isOverride)
+	if err != nil {
+		return err
+	}
+
+	params, err := parseEnvParams(es.params, es.paramDels)
+	if err != nil {
+		return err
+	}
+
+	envPath := env.Path
+	if envPath == "" {
+		envPath = es.envName
+	}
+
+	if es.dryRun {
+		return es.writeOperations(newEnv, k8sAPISpec, envPath, params)
+	}
+
+	// Renaming and saving are separate writes, so the environment is restored
+	// from a snapshot if a later one fails.
+	snapshot, err := es.snapshotFn(es.app, envPath, es.newName)
+	if err != nil {
+		return errors.Wrapf(err, "saving environment %q before changing it", es.envName)
+	}
+
+	name := es.envName
+	if err := es.apply(newEnv, k8sAPISpec, params); err != nil {
+		return rollback(snapshot, name, err)
+	}
+
+	if report != nil {
+		return es.reportBreakage(report)
+	}
+
+	return nil
+}
+
+// writeOperations writes the changes that renaming and saving the environment
+// would make, without making them.
+func (es *EnvSet) writeOperations(newEnv *app.EnvironmentConfig, k8sAPISpec, envPath string, params mp.Params) error {
+	var ops []env.Operation
+
+	if es.newName != "" {
+		renameOps, err := es.renameOpsFn(es.app, es.envName, es.newName, es.isOverride)
+		if err != nil {
+			return err
+		}
+		ops = append(ops, renameOps...)
+	}
+
+	if newEnv != nil {
+		if es.fullRegen {
+			ops = append(ops, env.Operation{
+				Action: env.OpGenerate,
+				Path:   app.LibDirName + "/",
+				Detail: "ksonnet-lib for " + k8sAPISpec + ", replacing the generated copy",
+			})
+		} else if k8sAPISpec != "" {
+			ops = append(ops, env.Operation{
+				Action: env.OpGenerate,
+				Path:   app.LibDirName + "/",
+				Detail: fmt.Sprintf("ksonnet-lib for %s, unless it was generated before", k8sAPISpec),
+			})
+		}
+
+		if es.newName == "" {
+			// Renaming already saves the environment config.
+			ops = append(ops, env.ConfigOperation(es.isOverride))
+		}
+	}
+
+	if len(params) > 0 || len(es.paramDels) > 0 {
+		if es.newName != "" {
+			envPath = es.newName
+		}
+		ops = append(ops, env.GlobalsOperation(envPath, paramsDetail(params, es.paramDels)))
+	}
+
+	if len(ops) == 0 {
+		fmt.Fprintf(es.out, "Environment %q would not be changed\n", es.envName)
+		return nil
+	}
+
+	fmt.Fprintf(es.out, "Changing environment %q would:\n", es.envName)
+	return env.WriteOperations(es.out, ops)
+}
+
+// waitForServer waits for the environment's new server to respond as a
+// Kubernetes API server, so a mistyped server isn't saved. It gives up, and
+// the environment is left unchanged, once the timeout has passed.
+func (es *EnvSet) waitForServer() error {
+	if es.newServer == "" {
+		return errors.New("waiting for the server to be reachable requires a new server")
+	}
+
+	if es.timeout <= 0 {
+		return errors.New("waiting for the server to be reachable requires a positive timeout")
+	}
+
+	deadline := es.nowFn().Add(es.timeout)
+	for {
+		err := es.probeServerFn(es.newServer, deadline.Sub(es.nowFn()))
+		if err == nil {
+			return nil
+		}
+
+		if !es.nowFn().Add(serverProbeInterval).Before(deadline) {
+			return errors.Wrapf(err, "server %q was not reachable within %s; environment %q was not changed",
+				es.newServer, es.timeout, es.envName)
+		}
+
+		es.sleepFn(serverProbeInterval)
+	}
+}
+
+// breakageReport is what is known about an environment before its api spec
+// changes.
+type breakageReport struct {
+	oldVersion string
+	oldSwagger []byte
+	objects    []*unstructured.Unstructured
+}
+
+// prepareBreakageReport renders the environment's objects with the types of
+// its current api spec, before the api spec changes.
+func (es *EnvSet) prepareBreakageReport(env *app.EnvironmentConfig) (*breakageReport, error) {
+	if es.newAPISpec == "" || es.newAPISpec == app.AutoAPISpec {
+		return nil, errors.New("reporting breakage requires an api spec")
+	}
+
+	if env.KubernetesVersion == "" {
+		return nil, errors.Errorf("environment %q does not record a Kubernetes version to compare with", es.envName)
+	}
+
+	oldSwagger, err := es.generatedSwaggerFn(es.app, env.KubernetesVersion, es.httpClient)
+	if err != nil {
+		return nil, err
+	}
+
+	objects, err := es.renderFn(es.app, es.envName, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "rendering environment %q", es.envName)
+	}
+
+	return &breakageReport{
+		oldVersion: env.KubernetesVersion,
+		oldSwagger: oldSwagger,
+		objects:    objects,
+	}, nil
+}
+
+// reportBreakage writes the objects that may no longer be accepted by the
+// types of the environment's new api spec.
+func (es *EnvSet) reportBreakage(report *breakageReport) error {
+	envName := es.envName
+	if es.newName != "" {
+		envName = es.newName
+	}
+
+	env, err := es.app.Environment(envName)
+	if err != nil {
+		return err
+	}
+
+	newSwagger, err := es.generatedSwaggerFn(es.app, env.KubernetesVersion, es.httpClient)
+	if err != nil {
+		return err
+	}
+
+	breakages, err := lib.FindBreakages(report.oldSwagger, newSwagger, report.objects)
+	if err != nil {
 		return err
 	}
 
-	if err := es.updateEnvConfig(*env, es.newNsName, es.newServer, es.newAPISpec, es.isOverride); err != nil {
+	if len(breakages) == 0 {
+		fmt.Fprintf(es.out, "No components use types that were removed or changed from %s to %s\n",
+			report.oldVersion, env.KubernetesVersion)
+		return nil
+	}
+
+	fmt.Fprintf(es.out, "Components that may break from %s to %s:\n\n", report.oldVersion, env.KubernetesVersion)
+
+	t := table.New("envSetBreakage", es.out)
+	t.SetHeader([]string{"component", "api-version", "kind", "name", "field", "problem"})
+	for _, b := range breakages {
+		t.Append([]string{b.Component, b.APIVersion, b.Kind, b.Name, b.Field, b.Problem})
+	}
+
+	return t.Render()
+}
+
+// resetMetadata regenerates ksonnet-lib for the Kubernetes version already
+// recorded for the environment, without changing the environment itself.
+func (es *EnvSet) resetMetadata(envConfig *app.EnvironmentConfig) error {
+	if es.newName != "" || es.newNsName != "" || es.newServer != "" || es.newContext != "" || es.newAPISpec != "" ||
+		es.newPrefix != "" || es.newSA != "" || es.replicas != 0 || es.hpaRange != "" || es.pdb != "" ||
+		es.inherits != "" || es.ignore != "" || len(es.include) > 0 || len(es.exclude) > 0 || len(es.tags) > 0 ||
+		len(es.unset) > 0 || len(es.params) > 0 || len(es.paramDels) > 0 {
+		return errors.New("resetting metadata can't be combined with other changes")
+	}
+
+	if envConfig.KubernetesVersion == "" {
+		return errors.Errorf("environment %q does not record a Kubernetes version; set an api spec to regenerate its metadata", es.envName)
+	}
+
+	if es.dryRun {
+		fmt.Fprintf(es.out, "Changing environment %q would:\n", es.envName)
+		return env.WriteOperations(es.out, []env.Operation{{
+			Action: env.OpGenerate,
+			Path:   app.LibDirName + "/",
+			Detail: "ksonnet-lib for version:" + envConfig.KubernetesVersion + ", replacing the generated copy",
+		}})
+	}
+
+	return es.regenLibFn(es.app, "version:"+envConfig.KubernetesVersion, es.httpClient)
+}
+
+// apply renames the environment, saves its new config and updates its
+// parameters.
+func (es *EnvSet) apply(newEnv *app.EnvironmentConfig, k8sAPISpec string, params mp.Params) error {
+	if err := es.updateName(es.isOverride); err != nil {
 		return err
 	}
 
+	if newEnv != nil {
+		newEnv.Name = es.envName
+		if err := es.saveEnvConfig(newEnv, k8sAPISpec, es.isOverride); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range es.paramDels {
+		if err := es.deleteParamFn(es.app, es.envName, name); err != nil {
+			return errors.Wrapf(err, "removing parameter %q", name)
+		}
+	}
+
+	if len(params) > 0 {
+		if err := es.setParamsFn(es.app, es.envName, params); err != nil {
+			return errors.Wrap(err, "setting parameters")
+		}
+	}
+
 	return nil
 }
 
+// parseEnvParams parses the parameters given as <key>=<value> to set for an
+// environment. Numbers and booleans are typed; other values, and values in
+// double quotes, are strings. A parameter can only be changed once.
+func parseEnvParams(raw, deletes []string) (mp.Params, error) {
+	params := mp.Params{}
+	seen := make(map[string]bool)
+
+	checkKey := func(key string) error {
+		switch {
+		case key == "":
+			return errors.New("parameter name can't be empty")
+		case strings.Contains(key, "."):
+			return errors.Errorf("parameter %q can't be a nested path; use `ks param set --env` for nested parameters", key)
+		case seen[key]:
+			return errors.Errorf("parameter %q can only be changed once", key)
+		}
+
+		seen[key] = true
+		return nil
+	}
+
+	for _, p := range raw {
+		parts := strings.SplitN(p, "=", 2)
+		if len(parts) != 2 {
+			return nil, errors.Errorf("parameter %q is not of the form <key>=<value>", p)
+		}
+
+		key, rawValue := strings.TrimSpace(parts[0]), parts[1]
+		if err := checkKey(key); err != nil {
+			return nil, err
+		}
+
+		value, err := decodeParamValue(rawValue)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parameter %q", key)
+		}
+
+		params[key] = value
+	}
+
+	for _, key := range deletes {
+		if err := checkKey(strings.TrimSpace(key)); err != nil {
+			return nil, err
+		}
+	}
+
+	return params, nil
+}
+
+// decodeParamValue types the value of a parameter. A value in double quotes is
+// always a string, so e.g. `"5"` can be set as a string.
+func decodeParamValue(s string) (interface{}, error) {
+	if s == "" {
+		return "", nil
+	}
+
+	if len(s) >= 2 && strings.HasPrefix(s, `"`) && strings.HasSuffix(s, `"`) {
+		return strconv.Unquote(s)
+	}
+
+	return jsonnet.DecodeValue(s)
+}
+
+// paramsDetail describes the parameter changes of a dry run.
+func paramsDetail(params mp.Params, deletes []string) string {
+	var names []string
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var parts []string
+	if len(names) > 0 {
+		parts = append(parts, "set parameters "+strings.Join(names, ", "))
+	}
+	if len(deletes) > 0 {
+		parts = append(parts, "remove parameters "+strings.Join(deletes, ", "))
+	}
+
+	return strings.Join(parts, "; ")
+}
+
+// rollback restores an environment from the snapshot taken before a change
+// failed, and returns the failure.
+func rollback(snapshot *env.Snapshot, name string, cause error) error {
+	if err := snapshot.Restore(); err != nil {
+		return errors.Errorf("%v; restoring environment %q also failed, so it may be partly changed: %v", cause, name, err)
+	}
+
+	log.Infof("Restored environment %q after a failed change", name)
+	return cause
+}
+
 func (es *EnvSet) updateName(isOverride bool) error {
 	if es.newName != "" {
 		if err := es.envRenameFn(es.app, es.envName, es.newName, isOverride); err != nil {
@@ -121,17 +630,20 @@ func (es *EnvSet) updateName(isOverride bool) error {
 	return nil
 }
 
-// updateEnvConfig merges the provided environment config with optional override settings and the  creates and saves a new environment config based on the provided
-// base configuration. It will be merged with the provided configuration settings.
-// If isOverride is specified, Libraries will be filtered out of the merged configuration, as those should always be
+// newEnvConfig returns a copy of the provided environment config with all of
+// the settings applied, and the api spec to generate ksonnet-lib for, if any.
+// The config is nil if there is nothing to update. If isOverride is specified,
+// Libraries will be filtered out of the config, as those should always be
 // managed in the primary application config.
-func (es *EnvSet) updateEnvConfig(env app.EnvironmentConfig, namespace, server, k8sAPISpec string, isOverride bool) error {
+func (es *EnvSet) newEnvConfig(env app.EnvironmentConfig, namespace, server, k8sAPISpec string, isOverride bool) (*app.EnvironmentConfig, string, error) {
 	if env.Name == "" {
-		return errors.Errorf("empty environment name")
+		return nil, "", errors.Errorf("empty environment name")
 	}
-	if namespace == "" && server == "" && k8sAPISpec == "" {
+	if namespace == "" && server == "" && k8sAPISpec == "" && es.newPrefix == "" &&
+		es.newSA == "" && es.replicas == 0 && es.hpaRange == "" && es.pdb == "" && es.ignore == "" &&
+		len(es.include) == 0 && len(es.exclude) == 0 && es.inherits == "" && len(es.tags) == 0 && len(es.unset) == 0 {
 		// Nothing to update
-		return nil
+		return nil, "", nil
 	}
 
 	newEnv := env
@@ -147,6 +659,13 @@ func (es *EnvSet) updateEnvConfig(env app.EnvironmentConfig, namespace, server,
 	}
 	if server != "" {
 		destination.Server = server
+		// The server is only derived from a context if it was resolved from one.
+		newEnv.Context = es.newContext
+		// Setting a real server brings an offline environment online.
+		newEnv.Offline = false
+		// The certificate authority of the old server doesn't identify the
+		// new one.
+		destination.ServerCert = ""
 	}
 	if namespace != "" {
 		destination.Namespace = namespace
@@ -154,13 +673,416 @@ func (es *EnvSet) updateEnvConfig(env app.EnvironmentConfig, namespace, server,
 
 	newEnv.Destination = destination
 
+	if es.newPrefix != "" {
+		newEnv.NamePrefix = es.newPrefix
+	}
+
+	if es.newSA != "" {
+		newEnv.ServiceAccount = es.newSA
+	}
+
+	if err := es.updateDefaults(&newEnv); err != nil {
+		return nil, "", err
+	}
+
+	if err := es.updateIgnoreAnnotations(&newEnv); err != nil {
+		return nil, "", err
+	}
+
+	if err := es.updateComponentScope(&newEnv); err != nil {
+		return nil, "", err
+	}
+
+	if es.inherits != "" {
+		if err := es.checkInheritFn(es.app, env.Name, es.inherits); err != nil {
+			return nil, "", err
+		}
+		newEnv.Inherits = es.inherits
+	}
+
+	if err := newEnv.UpdateTags(es.tags); err != nil {
+		return nil, "", err
+	}
+
+	if len(es.unset) > 0 {
+		if err := es.unsetFields(&newEnv); err != nil {
+			return nil, "", err
+		}
+
+		if err := validateEnvSpec(&newEnv); err != nil {
+			return nil, "", errors.Wrap(err, "environment is invalid after unsetting fields")
+		}
+	}
+
+	switch k8sAPISpec {
+	case "":
+	case app.AutoAPISpec:
+		// The version is detected from the cluster when the environment is
+		// applied or shown.
+		newEnv.APISpec = app.AutoAPISpec
+		k8sAPISpec = ""
+	default:
+		newEnv.APISpec = ""
+
+		if !es.fullRegen && isCurrentAPISpec(k8sAPISpec, env.KubernetesVersion) {
+			fmt.Fprintf(es.out, "ksonnet-lib for environment %q is already up to date with Kubernetes %s; use --reset-metadata to regenerate it\n",
+				env.Name, env.KubernetesVersion)
+			k8sAPISpec = ""
+		}
+	}
+
 	// isOverride will be set by app.AddEnvironment
 	if isOverride {
 		// Libraries will always derive from the primary app.yaml
 		newEnv.Libraries = nil
 	}
 
-	return es.saveFn(es.app, newEnv.Name, k8sAPISpec, &newEnv, isOverride)
+	return &newEnv, k8sAPISpec, nil
+}
+
+// saveEnvConfig saves an environment config, generating ksonnet-lib for the
+// api spec first if full regeneration was requested.
+func (es *EnvSet) saveEnvConfig(env *app.EnvironmentConfig, k8sAPISpec string, isOverride bool) error {
+	if es.fullRegen {
+		if err := es.regenLibFn(es.app, k8sAPISpec, es.httpClient); err != nil {
+			return err
+		}
+	}
+
+	return es.saveFn(es.app, env.Name, k8sAPISpec, env, isOverride)
+}
+
+// updateDefaults sets the replica, HPA and PDB defaults of an environment.
+func (es *EnvSet) updateDefaults(env *app.EnvironmentConfig) error {
+	if es.replicas == 0 && es.hpaRange == "" && es.pdb == "" {
+		return nil
+	}
+
+	defaults := app.EnvironmentDefaults{}
+	if env.Defaults != nil {
+		defaults = *env.Defaults
+	}
+
+	if es.replicas < 0 {
+		return errors.Errorf("default replicas must be positive, was %d", es.replicas)
+	}
+	if es.replicas > 0 {
+		defaults.Replicas = int64(es.replicas)
+	}
+
+	if es.hpaRange != "" {
+		min, max, err := parseHPARange(es.hpaRange)
+		if err != nil {
+			return err
+		}
+		defaults.HPAMinReplicas = min
+		defaults.HPAMaxReplicas = max
+	}
+
+	if es.pdb != "" {
+		minAvailable, maxUnavailable, err := parseDefaultPDB(es.pdb)
+		if err != nil {
+			return err
+		}
+		defaults.PDBMinAvailable = minAvailable
+		defaults.PDBMaxUnavailable = maxUnavailable
+	}
+
+	env.Defaults = &defaults
+	return nil
+}
+
+// updateIgnoreAnnotations adds the ignore annotation of --ignore-annotation to
+// an environment. An existing ignore annotation with the same key and the
+// same objects is replaced.
+func (es *EnvSet) updateIgnoreAnnotations(env *app.EnvironmentConfig) error {
+	if es.ignore == "" {
+		return nil
+	}
+
+	ia, err := parseIgnoreAnnotation(es.ignore, es.ignoreObj, es.ignoreSel)
+	if err != nil {
+		return err
+	}
+
+	var annotations []app.EnvironmentIgnoreAnnotation
+	for _, cur := range env.IgnoreAnnotations {
+		if cur.Key == ia.Key && cur.Kind == ia.Kind && cur.Name == ia.Name && cur.Selector == ia.Selector {
+			continue
+		}
+		annotations = append(annotations, cur)
+	}
+
+	env.IgnoreAnnotations = append(annotations, ia)
+	return nil
+}
+
+// updateComponentScope adds the components of --include-component and
+// --exclude-component to an environment. Including a component that was
+// excluded removes it from the excluded components. Excluded components are
+// left in the included components, since exclusion takes precedence, so that
+// the environment's scope never widens by emptying them.
+func (es *EnvSet) updateComponentScope(env *app.EnvironmentConfig) error {
+	for _, name := range es.include {
+		if name == "" {
+			return errors.New("included component names can't be empty")
+		}
+		if str.InSlice(name, es.exclude) {
+			return errors.Errorf("component %q can't be both included and excluded", name)
+		}
+	}
+	for _, name := range es.exclude {
+		if name == "" {
+			return errors.New("excluded component names can't be empty")
+		}
+	}
+
+	if len(es.include) > 0 {
+		env.IncludeComponents = addComponents(env.IncludeComponents, es.include)
+		env.ExcludeComponents = removeComponents(env.ExcludeComponents, es.include)
+	}
+
+	if len(es.exclude) > 0 {
+		env.ExcludeComponents = addComponents(env.ExcludeComponents, es.exclude)
+	}
+
+	return nil
+}
+
+// addComponents adds the names that aren't already in components.
+func addComponents(components, names []string) []string {
+	out := append([]string{}, components...)
+	for _, name := range names {
+		if !str.InSlice(name, out) {
+			out = append(out, name)
+		}
+	}
+
+	return out
+}
+
+// removeComponents removes names from components. It returns nil if no
+// components are left.
+func removeComponents(components, names []string) []string {
+	var out []string
+	for _, name := range components {
+		if !str.InSlice(name, names) {
+			out = append(out, name)
+		}
+	}
+
+	return out
+}
+
+// parseIgnoreAnnotation parses an annotation in the form `<key>=<value>`,
+// limited to the objects in the form `<Kind>[/<name>]` that match a label
+// selector.
+func parseIgnoreAnnotation(annotation, object, selector string) (app.EnvironmentIgnoreAnnotation, error) {
+	var ia app.EnvironmentIgnoreAnnotation
+
+	parts := strings.SplitN(annotation, "=", 2)
+	if len(parts) != 2 {
+		return ia, errors.Errorf("ignore annotation %q is not in the form <key>=<value>", annotation)
+	}
+	if errs := validation.IsQualifiedName(parts[0]); len(errs) > 0 {
+		return ia, errors.Errorf("ignore annotation key %q is invalid: %s", parts[0], strings.Join(errs, "; "))
+	}
+	ia.Key, ia.Value = parts[0], parts[1]
+
+	if object != "" {
+		parts = strings.SplitN(object, "/", 2)
+		if parts[0] == "" || (len(parts) == 2 && parts[1] == "") {
+			return ia, errors.Errorf("object %q is not in the form <Kind>[/<name>]", object)
+		}
+		ia.Kind = parts[0]
+		if len(parts) == 2 {
+			ia.Name = parts[1]
+		}
+	}
+
+	if _, err := labels.Parse(selector); err != nil {
+		return ia, errors.Wrap(err, "parsing ignore selector")
+	}
+	ia.Selector = selector
+
+	return ia, nil
+}
+
+// unsetFields removes the optional fields named by --unset from an
+// environment. A namespace is always needed, so unsetting it resets it to the
+// default namespace.
+func (es *EnvSet) unsetFields(env *app.EnvironmentConfig) error {
+	for _, field := range es.unset {
+		if es.isSetting(field) {
+			return errors.Errorf("field %q can't be set and unset at the same time", field)
+		}
+
+		switch field {
+		case envFieldName, envFieldServer:
+			return errors.Errorf("field %q is required and can't be unset", field)
+		case envFieldNamespace:
+			if env.Destination != nil {
+				env.Destination.Namespace = defaultEnvNamespace
+			}
+		case envFieldNamePrefix:
+			env.NamePrefix = ""
+		case envFieldServiceAccount:
+			env.ServiceAccount = ""
+		case envFieldDefaultReplicas:
+			if env.Defaults != nil {
+				env.Defaults.Replicas = 0
+			}
+		case envFieldHPARange:
+			if env.Defaults != nil {
+				env.Defaults.HPAMinReplicas = 0
+				env.Defaults.HPAMaxReplicas = 0
+			}
+		case envFieldDefaultPDB:
+			if env.Defaults != nil {
+				env.Defaults.PDBMinAvailable = ""
+				env.Defaults.PDBMaxUnavailable = ""
+			}
+		case envFieldIgnoreAnnotations:
+			env.IgnoreAnnotations = nil
+		case envFieldIncludeComponents:
+			env.IncludeComponents = nil
+		case envFieldExcludeComponents:
+			env.ExcludeComponents = nil
+		case envFieldAPISpec:
+			// The Kubernetes version that was detected last is kept.
+			env.APISpec = ""
+		case envFieldContext:
+			env.Context = ""
+		case envFieldInherits:
+			env.Inherits = ""
+		default:
+			return errors.Errorf("unknown field %q; fields that can be unset are: %s",
+				field, strings.Join(unsettableEnvFields, ", "))
+		}
+	}
+
+	if env.Defaults != nil && *env.Defaults == (app.EnvironmentDefaults{}) {
+		env.Defaults = nil
+	}
+
+	return nil
+}
+
+// isSetting returns true if a field is also being set.
+func (es *EnvSet) isSetting(field string) bool {
+	switch field {
+	case envFieldNamespace:
+		return es.newNsName != ""
+	case envFieldNamePrefix:
+		return es.newPrefix != ""
+	case envFieldServiceAccount:
+		return es.newSA != ""
+	case envFieldDefaultReplicas:
+		return es.replicas != 0
+	case envFieldHPARange:
+		return es.hpaRange != ""
+	case envFieldDefaultPDB:
+		return es.pdb != ""
+	case envFieldIgnoreAnnotations:
+		return es.ignore != ""
+	case envFieldIncludeComponents:
+		return len(es.include) > 0
+	case envFieldExcludeComponents:
+		return len(es.exclude) > 0
+	case envFieldAPISpec:
+		return es.newAPISpec != ""
+	case envFieldContext:
+		return es.newContext != ""
+	case envFieldInherits:
+		return es.inherits != ""
+	default:
+		return false
+	}
+}
+
+// parseHPARange parses an HPA range in the form `<min>:<max>`.
+func parseHPARange(s string) (min, max int64, err error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 2 {
+		return 0, 0, errors.Errorf("HPA range %q is not in the form <min>:<max>", s)
+	}
+
+	min, err = strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, 0, errors.Errorf("HPA range %q has an invalid minimum", s)
+	}
+
+	max, err = strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, 0, errors.Errorf("HPA range %q has an invalid maximum", s)
+	}
+
+	if min < 1 || max < min {
+		return 0, 0, errors.Errorf("HPA range %q must have a minimum of at least 1 and a maximum of at least the minimum", s)
+	}
+
+	return min, max, nil
+}
+
+// parseDefaultPDB parses a pod disruption budget policy in the form
+// `minAvailable=<value>` or `maxUnavailable=<value>`, where the value is a
+// count or a percentage. Only one of the returned values is set.
+func parseDefaultPDB(s string) (minAvailable, maxUnavailable string, err error) {
+	parts := strings.SplitN(s, "=", 2)
+	if len(parts) != 2 {
+		return "", "", errors.Errorf("default PDB %q is not in the form minAvailable=<value> or maxUnavailable=<value>", s)
+	}
+
+	value := parts[1]
+	if pct := strings.TrimSuffix(value, "%"); pct != value {
+		n, err := strconv.Atoi(pct)
+		if err != nil || n < 0 || n > 100 {
+			return "", "", errors.Errorf("default PDB %q has an invalid percentage", s)
+		}
+	} else if n, err := strconv.Atoi(value); err != nil || n < 0 {
+		return "", "", errors.Errorf("default PDB %q has an invalid count", s)
+	}
+
+	switch parts[0] {
+	case "minAvailable":
+		return value, "", nil
+	case "maxUnavailable":
+		return "", value, nil
+	default:
+		return "", "", errors.Errorf("default PDB %q must set minAvailable or maxUnavailable", s)
+	}
+}
+
+// regenLib generates ksonnet-lib for an api spec from scratch, replacing
+// any previously generated copy once generation succeeded.
+func regenLib(a app.App, k8sAPISpec string, httpClient *http.Client) error {
+	libManager, err := lib.NewManager(k8sAPISpec, a.Fs(), filepath.Join(a.Root(), app.LibDirName), httpClient)
+	if err != nil {
+		return err
+	}
+
+	libManager.CacheDir = app.LibCachePath(a.Root())
+	return libManager.ReplaceLibData()
+}
+
+func generatedSwagger(a app.App, k8sVersion string, httpClient *http.Client) ([]byte, error) {
+	libManager, err := lib.NewManager("version:"+k8sVersion, a.Fs(), filepath.Join(a.Root(), app.LibDirName), httpClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return libManager.GeneratedSwagger()
+}
+
+// isCurrentAPISpec returns true if an api spec selects the Kubernetes version
+// an environment already uses.
+func isCurrentAPISpec(k8sAPISpec, k8sVersion string) bool {
+	if k8sVersion == "" || !strings.HasPrefix(k8sAPISpec, "version:") {
+		return false
+	}
+
+	version := strings.TrimPrefix(k8sAPISpec, "version:")
+	return strings.TrimPrefix(version, "v") == strings.TrimPrefix(k8sVersion, "v")
 }
 
 func save(a app.App, envName, k8sAPISpec string, env *app.EnvironmentConfig, override bool) error {
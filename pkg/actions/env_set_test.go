@@ -16,12 +16,21 @@
 package actions
 
 import (
+	"bytes"
+	"net/http"
 	"testing"
+	"time"
 
+	mp "github.com/ksonnet/ksonnet/metadata/params"
 	"github.com/ksonnet/ksonnet/pkg/app"
 	amocks "github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/ksonnet/ksonnet/pkg/env"
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
 func TestEnvSet(t *testing.T) {
@@ -29,27 +38,60 @@ func TestEnvSet(t *testing.T) {
 	newName := "new_env_name"
 	oldNamespace := "old_namespace"
 	namespace := "new_namespace"
-	oldServer := "old_server"
-	server := "new_server"
+	oldServer := "https://old.example.com"
+	server := "https://new.example.com"
 	newk8sAPISpec := "version:new_api_spec"
+	versionedEnvName := "versioned_env"
+	offlineEnvName := "offline_env"
+	customizedEnvName := "customized_env"
 
 	environmentMockFn := func(name string) *app.EnvironmentConfig {
-		return &app.EnvironmentConfig{
+		env := &app.EnvironmentConfig{
 			Name: name,
 			Destination: &app.EnvironmentDestinationSpec{
 				Namespace: oldNamespace,
 				Server:    oldServer,
 			},
 		}
+		if name == versionedEnvName {
+			env.KubernetesVersion = "v1.8.0"
+		}
+		if name == offlineEnvName {
+			env.Offline = true
+		}
+		if name == customizedEnvName {
+			env.NamePrefix = "staging-"
+			env.ServiceAccount = "app-sa"
+			env.Defaults = &app.EnvironmentDefaults{
+				Replicas:          3,
+				HPAMinReplicas:    1,
+				HPAMaxReplicas:    5,
+				PDBMaxUnavailable: "1",
+			}
+			env.IgnoreAnnotations = []app.EnvironmentIgnoreAnnotation{
+				{Key: "fluxcd.io/ignore", Value: "false", Selector: "tier=frontend"},
+			}
+			env.ExcludeComponents = []string{"debug"}
+			env.Inherits = "base"
+		}
+		return env
 	}
 
 	withApp(t, func(appMock *amocks.App) {
+		appMock.On("Environments").Return(app.EnvironmentConfigs{
+			envName: environmentMockFn(envName),
+			"base":  &app.EnvironmentConfig{Name: "base"},
+			"child": &app.EnvironmentConfig{Name: "child", Inherits: envName},
+		}, nil)
+
 		cases := []struct {
 			name        string
 			in          map[string]interface{}
 			spec        *app.EnvironmentConfig
 			envRenameFn func(t *testing.T) envRenameFn
 			saveFn      func(t *testing.T) saveFn
+			regenLibFn  func(t *testing.T) regenLibFn
+			isErr       bool
 		}{
 			{
 				name: "rename environment",
@@ -108,6 +150,48 @@ func TestEnvSet(t *testing.T) {
 					}
 				},
 			},
+			{
+				name: "set new server on offline environment",
+				in: map[string]interface{}{
+					OptionApp:     appMock,
+					OptionEnvName: offlineEnvName,
+					OptionServer:  server,
+				},
+				saveFn: func(t *testing.T) saveFn {
+					return func(a app.App, envName, k8sAPISpec string, spec *app.EnvironmentConfig, override bool) error {
+						assert.Equal(t, &app.EnvironmentConfig{
+							Name: envName,
+							Destination: &app.EnvironmentDestinationSpec{
+								Namespace: oldNamespace,
+								Server:    server,
+							},
+						}, spec)
+						return nil
+					}
+				},
+			},
+			{
+				name: "set new server from context",
+				in: map[string]interface{}{
+					OptionApp:     appMock,
+					OptionEnvName: envName,
+					OptionServer:  server,
+					OptionContext: "dev",
+				},
+				saveFn: func(t *testing.T) saveFn {
+					return func(a app.App, envName, k8sAPISpec string, spec *app.EnvironmentConfig, override bool) error {
+						assert.Equal(t, &app.EnvironmentConfig{
+							Name: envName,
+							Destination: &app.EnvironmentDestinationSpec{
+								Namespace: oldNamespace,
+								Server:    server,
+							},
+							Context: "dev",
+						}, spec)
+						return nil
+					}
+				},
+			},
 			{
 				name: "set new api spec",
 				in: map[string]interface{}{
@@ -122,6 +206,367 @@ func TestEnvSet(t *testing.T) {
 					}
 				},
 			},
+			{
+				name: "set auto api spec",
+				in: map[string]interface{}{
+					OptionApp:      appMock,
+					OptionEnvName:  envName,
+					OptionSpecFlag: "auto",
+				},
+				saveFn: func(t *testing.T) saveFn {
+					return func(a app.App, envName, k8sAPISpec string, spec *app.EnvironmentConfig, override bool) error {
+						assert.Empty(t, k8sAPISpec)
+						assert.Equal(t, "auto", spec.APISpec)
+						return nil
+					}
+				},
+			},
+			{
+				name: "full regen with auto api spec",
+				in: map[string]interface{}{
+					OptionApp:       appMock,
+					OptionEnvName:   envName,
+					OptionSpecFlag:  "auto",
+					OptionFullRegen: true,
+				},
+				isErr: true,
+			},
+			{
+				name: "set name prefix",
+				in: map[string]interface{}{
+					OptionApp:        appMock,
+					OptionEnvName:    envName,
+					OptionNamePrefix: "staging-",
+				},
+				saveFn: func(t *testing.T) saveFn {
+					return func(a app.App, envName, k8sAPISpec string, spec *app.EnvironmentConfig, override bool) error {
+						assert.Equal(t, &app.EnvironmentConfig{
+							Name: envName,
+							Destination: &app.EnvironmentDestinationSpec{
+								Namespace: oldNamespace,
+								Server:    oldServer,
+							},
+							NamePrefix: "staging-",
+						}, spec)
+						return nil
+					}
+				},
+			},
+			{
+				name: "set service account",
+				in: map[string]interface{}{
+					OptionApp:            appMock,
+					OptionEnvName:        envName,
+					OptionServiceAccount: "app-sa",
+				},
+				saveFn: func(t *testing.T) saveFn {
+					return func(a app.App, envName, k8sAPISpec string, spec *app.EnvironmentConfig, override bool) error {
+						assert.Equal(t, &app.EnvironmentConfig{
+							Name: envName,
+							Destination: &app.EnvironmentDestinationSpec{
+								Namespace: oldNamespace,
+								Server:    oldServer,
+							},
+							ServiceAccount: "app-sa",
+						}, spec)
+						return nil
+					}
+				},
+			},
+			{
+				name: "set new api spec with full regeneration",
+				in: map[string]interface{}{
+					OptionApp:       appMock,
+					OptionEnvName:   envName,
+					OptionSpecFlag:  newk8sAPISpec,
+					OptionFullRegen: true,
+				},
+				regenLibFn: func(t *testing.T) regenLibFn {
+					return func(a app.App, k8sAPISpec string, httpClient *http.Client) error {
+						assert.Equal(t, newk8sAPISpec, k8sAPISpec)
+						return nil
+					}
+				},
+				saveFn: func(t *testing.T) saveFn {
+					return func(a app.App, envName, k8sAPISpec string, spec *app.EnvironmentConfig, override bool) error {
+						assert.Equal(t, newk8sAPISpec, k8sAPISpec)
+						return nil
+					}
+				},
+			},
+			{
+				name: "full regeneration without api spec",
+				in: map[string]interface{}{
+					OptionApp:       appMock,
+					OptionEnvName:   envName,
+					OptionFullRegen: true,
+				},
+				isErr: true,
+			},
+			{
+				name: "set replica and hpa defaults",
+				in: map[string]interface{}{
+					OptionApp:             appMock,
+					OptionEnvName:         envName,
+					OptionDefaultReplicas: 3,
+					OptionHPARange:        "3:10",
+				},
+				saveFn: func(t *testing.T) saveFn {
+					return func(a app.App, envName, k8sAPISpec string, spec *app.EnvironmentConfig, override bool) error {
+						expected := &app.EnvironmentDefaults{
+							Replicas:       3,
+							HPAMinReplicas: 3,
+							HPAMaxReplicas: 10,
+						}
+						assert.Equal(t, expected, spec.Defaults)
+						return nil
+					}
+				},
+			},
+			{
+				name: "invalid hpa range",
+				in: map[string]interface{}{
+					OptionApp:      appMock,
+					OptionEnvName:  envName,
+					OptionHPARange: "10:3",
+				},
+				isErr: true,
+			},
+			{
+				name: "set pdb default",
+				in: map[string]interface{}{
+					OptionApp:        appMock,
+					OptionEnvName:    customizedEnvName,
+					OptionDefaultPDB: "minAvailable=50%",
+				},
+				saveFn: func(t *testing.T) saveFn {
+					return func(a app.App, envName, k8sAPISpec string, spec *app.EnvironmentConfig, override bool) error {
+						expected := &app.EnvironmentDefaults{
+							Replicas:        3,
+							HPAMinReplicas:  1,
+							HPAMaxReplicas:  5,
+							PDBMinAvailable: "50%",
+						}
+						assert.Equal(t, expected, spec.Defaults)
+						return nil
+					}
+				},
+			},
+			{
+				name: "invalid pdb default",
+				in: map[string]interface{}{
+					OptionApp:        appMock,
+					OptionEnvName:    envName,
+					OptionDefaultPDB: "minAvailable=150%",
+				},
+				isErr: true,
+			},
+			{
+				name: "pdb default without a policy",
+				in: map[string]interface{}{
+					OptionApp:        appMock,
+					OptionEnvName:    envName,
+					OptionDefaultPDB: "minReplicas=1",
+				},
+				isErr: true,
+			},
+			{
+				name: "add ignore annotation",
+				in: map[string]interface{}{
+					OptionApp:              appMock,
+					OptionEnvName:          customizedEnvName,
+					OptionIgnoreAnnotation: "argocd.argoproj.io/sync-options=Prune=false",
+					OptionIgnoreObject:     "ConfigMap/settings",
+				},
+				saveFn: func(t *testing.T) saveFn {
+					return func(a app.App, envName, k8sAPISpec string, spec *app.EnvironmentConfig, override bool) error {
+						expected := []app.EnvironmentIgnoreAnnotation{
+							{Key: "fluxcd.io/ignore", Value: "false", Selector: "tier=frontend"},
+							{Key: "argocd.argoproj.io/sync-options", Value: "Prune=false", Kind: "ConfigMap", Name: "settings"},
+						}
+						assert.Equal(t, expected, spec.IgnoreAnnotations)
+						return nil
+					}
+				},
+			},
+			{
+				name: "replace ignore annotation",
+				in: map[string]interface{}{
+					OptionApp:              appMock,
+					OptionEnvName:          customizedEnvName,
+					OptionIgnoreAnnotation: "fluxcd.io/ignore=true",
+					OptionIgnoreSelector:   "tier=frontend",
+				},
+				saveFn: func(t *testing.T) saveFn {
+					return func(a app.App, envName, k8sAPISpec string, spec *app.EnvironmentConfig, override bool) error {
+						expected := []app.EnvironmentIgnoreAnnotation{
+							{Key: "fluxcd.io/ignore", Value: "true", Selector: "tier=frontend"},
+						}
+						assert.Equal(t, expected, spec.IgnoreAnnotations)
+						return nil
+					}
+				},
+			},
+			{
+				name: "include components",
+				in: map[string]interface{}{
+					OptionApp:               appMock,
+					OptionEnvName:           customizedEnvName,
+					OptionIncludeComponents: []string{"web", "debug"},
+				},
+				saveFn: func(t *testing.T) saveFn {
+					return func(a app.App, envName, k8sAPISpec string, spec *app.EnvironmentConfig, override bool) error {
+						assert.Equal(t, []string{"web", "debug"}, spec.IncludeComponents)
+						assert.Nil(t, spec.ExcludeComponents)
+						return nil
+					}
+				},
+			},
+			{
+				name: "exclude components",
+				in: map[string]interface{}{
+					OptionApp:               appMock,
+					OptionEnvName:           customizedEnvName,
+					OptionExcludeComponents: []string{"worker", "debug"},
+				},
+				saveFn: func(t *testing.T) saveFn {
+					return func(a app.App, envName, k8sAPISpec string, spec *app.EnvironmentConfig, override bool) error {
+						assert.Nil(t, spec.IncludeComponents)
+						assert.Equal(t, []string{"debug", "worker"}, spec.ExcludeComponents)
+						return nil
+					}
+				},
+			},
+			{
+				name: "include and exclude the same component",
+				in: map[string]interface{}{
+					OptionApp:               appMock,
+					OptionEnvName:           envName,
+					OptionIncludeComponents: []string{"web"},
+					OptionExcludeComponents: []string{"web"},
+				},
+				isErr: true,
+			},
+			{
+				name: "ignore object without ignore annotation",
+				in: map[string]interface{}{
+					OptionApp:          appMock,
+					OptionEnvName:      envName,
+					OptionIgnoreObject: "ConfigMap",
+				},
+				isErr: true,
+			},
+			{
+				name: "invalid ignore annotation",
+				in: map[string]interface{}{
+					OptionApp:              appMock,
+					OptionEnvName:          envName,
+					OptionIgnoreAnnotation: "fluxcd.io/ignore",
+				},
+				isErr: true,
+			},
+			{
+				name: "invalid ignore annotation key",
+				in: map[string]interface{}{
+					OptionApp:              appMock,
+					OptionEnvName:          envName,
+					OptionIgnoreAnnotation: "flux cd/ignore=true",
+				},
+				isErr: true,
+			},
+			{
+				name: "invalid ignore object",
+				in: map[string]interface{}{
+					OptionApp:              appMock,
+					OptionEnvName:          envName,
+					OptionIgnoreAnnotation: "fluxcd.io/ignore=true",
+					OptionIgnoreObject:     "ConfigMap/",
+				},
+				isErr: true,
+			},
+			{
+				name: "invalid ignore selector",
+				in: map[string]interface{}{
+					OptionApp:              appMock,
+					OptionEnvName:          envName,
+					OptionIgnoreAnnotation: "fluxcd.io/ignore=true",
+					OptionIgnoreSelector:   "tier in (frontend",
+				},
+				isErr: true,
+			},
+			{
+				name: "reset metadata",
+				in: map[string]interface{}{
+					OptionApp:           appMock,
+					OptionEnvName:       versionedEnvName,
+					OptionResetMetadata: true,
+				},
+				regenLibFn: func(t *testing.T) regenLibFn {
+					return func(a app.App, k8sAPISpec string, httpClient *http.Client) error {
+						assert.Equal(t, "version:v1.8.0", k8sAPISpec)
+						return nil
+					}
+				},
+			},
+			{
+				name: "reset metadata without kubernetes version",
+				in: map[string]interface{}{
+					OptionApp:           appMock,
+					OptionEnvName:       envName,
+					OptionResetMetadata: true,
+				},
+				isErr: true,
+			},
+			{
+				name: "reset metadata with other changes",
+				in: map[string]interface{}{
+					OptionApp:           appMock,
+					OptionEnvName:       versionedEnvName,
+					OptionNamespace:     namespace,
+					OptionResetMetadata: true,
+				},
+				isErr: true,
+			},
+			{
+				name: "reset metadata with default replicas",
+				in: map[string]interface{}{
+					OptionApp:             appMock,
+					OptionEnvName:         versionedEnvName,
+					OptionDefaultReplicas: 3,
+					OptionResetMetadata:   true,
+				},
+				isErr: true,
+			},
+			{
+				name: "reset metadata with hpa range",
+				in: map[string]interface{}{
+					OptionApp:           appMock,
+					OptionEnvName:       versionedEnvName,
+					OptionHPARange:      "2:5",
+					OptionResetMetadata: true,
+				},
+				isErr: true,
+			},
+			{
+				name: "reset metadata with default pdb",
+				in: map[string]interface{}{
+					OptionApp:           appMock,
+					OptionEnvName:       versionedEnvName,
+					OptionDefaultPDB:    "minAvailable=1",
+					OptionResetMetadata: true,
+				},
+				isErr: true,
+			},
+			{
+				name: "reset metadata with parent",
+				in: map[string]interface{}{
+					OptionApp:           appMock,
+					OptionEnvName:       versionedEnvName,
+					OptionInherits:      "base",
+					OptionResetMetadata: true,
+				},
+				isErr: true,
+			},
 			{
 				name: "set everything at once",
 				in: map[string]interface{}{
@@ -133,7 +578,8 @@ func TestEnvSet(t *testing.T) {
 					OptionSpecFlag:   newk8sAPISpec,
 				},
 				saveFn: func(t *testing.T) saveFn {
-					return func(a app.App, newName, k8sAPISpec string, spec *app.EnvironmentConfig, override bool) error {
+					return func(a app.App, savedName, k8sAPISpec string, spec *app.EnvironmentConfig, override bool) error {
+						assert.Equal(t, newName, savedName)
 						assert.Equal(t, &app.EnvironmentConfig{
 							Name: newName,
 							Destination: &app.EnvironmentDestinationSpec{
@@ -155,6 +601,140 @@ func TestEnvSet(t *testing.T) {
 					}
 				},
 			},
+			{
+				name: "rename with an invalid change",
+				in: map[string]interface{}{
+					OptionApp:        appMock,
+					OptionEnvName:    envName,
+					OptionNewEnvName: newName,
+					OptionHPARange:   "10:3",
+				},
+				isErr: true,
+			},
+			{
+				name: "unset fields",
+				in: map[string]interface{}{
+					OptionApp:         appMock,
+					OptionEnvName:     customizedEnvName,
+					OptionUnsetFields: []string{"namespace", "name-prefix", "default-replicas", "ignore-annotations", "exclude-components"},
+				},
+				saveFn: func(t *testing.T) saveFn {
+					return func(a app.App, envName, k8sAPISpec string, spec *app.EnvironmentConfig, override bool) error {
+						assert.Equal(t, &app.EnvironmentConfig{
+							Name: envName,
+							Destination: &app.EnvironmentDestinationSpec{
+								Namespace: "default",
+								Server:    oldServer,
+							},
+							ServiceAccount: "app-sa",
+							Defaults: &app.EnvironmentDefaults{
+								HPAMinReplicas:    1,
+								HPAMaxReplicas:    5,
+								PDBMaxUnavailable: "1",
+							},
+							Inherits: "base",
+						}, spec)
+						return nil
+					}
+				},
+			},
+			{
+				name: "unset required field",
+				in: map[string]interface{}{
+					OptionApp:         appMock,
+					OptionEnvName:     envName,
+					OptionUnsetFields: []string{"server"},
+				},
+				isErr: true,
+			},
+			{
+				name: "unset unknown field",
+				in: map[string]interface{}{
+					OptionApp:         appMock,
+					OptionEnvName:     envName,
+					OptionUnsetFields: []string{"label:team"},
+				},
+				isErr: true,
+			},
+			{
+				name: "set and unset the same field",
+				in: map[string]interface{}{
+					OptionApp:         appMock,
+					OptionEnvName:     envName,
+					OptionNamePrefix:  "staging-",
+					OptionUnsetFields: []string{"name-prefix"},
+				},
+				isErr: true,
+			},
+			{
+				name: "set parent",
+				in: map[string]interface{}{
+					OptionApp:      appMock,
+					OptionEnvName:  envName,
+					OptionInherits: "base",
+				},
+				saveFn: func(t *testing.T) saveFn {
+					return func(a app.App, envName, k8sAPISpec string, spec *app.EnvironmentConfig, override bool) error {
+						assert.Equal(t, "base", spec.Inherits)
+						return nil
+					}
+				},
+			},
+			{
+				name: "set parent that inherits from the environment",
+				in: map[string]interface{}{
+					OptionApp:      appMock,
+					OptionEnvName:  envName,
+					OptionInherits: "child",
+				},
+				isErr: true,
+			},
+			{
+				name: "set missing parent",
+				in: map[string]interface{}{
+					OptionApp:      appMock,
+					OptionEnvName:  envName,
+					OptionInherits: "qa",
+				},
+				isErr: true,
+			},
+			{
+				name: "unset parent",
+				in: map[string]interface{}{
+					OptionApp:         appMock,
+					OptionEnvName:     customizedEnvName,
+					OptionUnsetFields: []string{"inherits"},
+				},
+				saveFn: func(t *testing.T) saveFn {
+					return func(a app.App, envName, k8sAPISpec string, spec *app.EnvironmentConfig, override bool) error {
+						assert.Empty(t, spec.Inherits)
+						return nil
+					}
+				},
+			},
+			{
+				name: "set tags",
+				in: map[string]interface{}{
+					OptionApp:     appMock,
+					OptionEnvName: envName,
+					OptionTags:    []string{"region=us-west", "tier=prod", "tier-"},
+				},
+				saveFn: func(t *testing.T) saveFn {
+					return func(a app.App, envName, k8sAPISpec string, spec *app.EnvironmentConfig, override bool) error {
+						assert.Equal(t, map[string]string{"region": "us-west"}, spec.Tags)
+						return nil
+					}
+				},
+			},
+			{
+				name: "set invalid tag",
+				in: map[string]interface{}{
+					OptionApp:     appMock,
+					OptionEnvName: envName,
+					OptionTags:    []string{"region"},
+				},
+				isErr: true,
+			},
 			// TODO add tests for overrides here
 		}
 
@@ -181,9 +761,22 @@ func TestEnvSet(t *testing.T) {
 					}
 				}
 
+				if tc.regenLibFn != nil {
+					a.regenLibFn = tc.regenLibFn(t)
+				} else {
+					a.regenLibFn = func(a app.App, k8sAPISpec string, httpClient *http.Client) error {
+						t.Errorf("unexpected call: regenerate lib")
+						return nil
+					}
+				}
+
 				appMock.On("Environment", tc.in[OptionEnvName]).Return(environmentMockFn, nil)
 
 				err = a.Run()
+				if tc.isErr {
+					require.Error(t, err)
+					return
+				}
 				require.NoError(t, err)
 
 			})
@@ -191,8 +784,697 @@ func TestEnvSet(t *testing.T) {
 	})
 }
 
+func TestEnvSet_report_breakage(t *testing.T) {
+	swaggers := map[string]string{
+		"v1.8.0":  `{"definitions":{"apps.v1beta1.Deployment":{"x-kubernetes-group-version-kind":[{"group":"apps","version":"v1beta1","kind":"Deployment"}]}}}`,
+		"v1.9.0":  `{"definitions":{"apps.v1beta1.Deployment":{"x-kubernetes-group-version-kind":[{"group":"apps","version":"v1beta1","kind":"Deployment"}]}}}`,
+		"v1.16.0": `{"definitions":{"apps.v1.Deployment":{"x-kubernetes-group-version-kind":[{"group":"apps","version":"v1","kind":"Deployment"}]}}}`,
+	}
+
+	cases := []struct {
+		name       string
+		oldVersion string
+		apiSpec    string
+		expected   string
+		isErr      bool
+	}{
+		{
+			name:       "with breakage",
+			oldVersion: "v1.8.0",
+			apiSpec:    "version:v1.16.0",
+			expected: "Components that may break from v1.8.0 to v1.16.0:\n\n" +
+				"COMPONENT API-VERSION  KIND       NAME FIELD PROBLEM\n" +
+				"========= ===========  ====       ==== ===== =======\n" +
+				"web       apps/v1beta1 Deployment web        kind removed; available in apps/v1\n",
+		},
+		{
+			name:       "without breakage",
+			oldVersion: "v1.8.0",
+			apiSpec:    "version:v1.9.0",
+			expected:   "No components use types that were removed or changed from v1.8.0 to v1.9.0\n",
+		},
+		{
+			name:       "auto api spec",
+			oldVersion: "v1.8.0",
+			apiSpec:    "auto",
+			isErr:      true,
+		},
+		{
+			name:    "no recorded version",
+			apiSpec: "version:v1.16.0",
+			isErr:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withApp(t, func(appMock *amocks.App) {
+				version := tc.oldVersion
+				appMock.On("Environment", "default").Return(func(string) *app.EnvironmentConfig {
+					return &app.EnvironmentConfig{
+						Name:              "default",
+						KubernetesVersion: version,
+						Destination:       &app.EnvironmentDestinationSpec{Namespace: "default"},
+					}
+				}, nil)
+
+				in := map[string]interface{}{
+					OptionApp:            appMock,
+					OptionEnvName:        "default",
+					OptionSpecFlag:       tc.apiSpec,
+					OptionReportBreakage: true,
+				}
+
+				a, err := NewEnvSet(in)
+				require.NoError(t, err)
+
+				a.saveFn = func(a app.App, envName, k8sAPISpec string, spec *app.EnvironmentConfig, override bool) error {
+					version = k8sAPISpec[len("version:"):]
+					return nil
+				}
+				a.renderFn = func(_ app.App, envName string, componentNames []string) ([]*unstructured.Unstructured, error) {
+					assert.Equal(t, version, tc.oldVersion, "objects should be rendered before the api spec changes")
+					obj := &unstructured.Unstructured{}
+					obj.SetAPIVersion("apps/v1beta1")
+					obj.SetKind("Deployment")
+					obj.SetName("web")
+					obj.SetLabels(map[string]string{"ksonnet.io/component": "web"})
+					return []*unstructured.Unstructured{obj}, nil
+				}
+				a.generatedSwaggerFn = func(_ app.App, k8sVersion string, _ *http.Client) ([]byte, error) {
+					return []byte(swaggers[k8sVersion]), nil
+				}
+
+				var buf bytes.Buffer
+				a.out = &buf
+
+				err = a.Run()
+				if tc.isErr {
+					require.Error(t, err)
+					return
+				}
+
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, buf.String())
+			})
+		})
+	}
+}
+
+func TestEnvSet_api_spec_up_to_date(t *testing.T) {
+	cases := []struct {
+		name         string
+		apiSpec      string
+		fullRegen    bool
+		expectedSpec string
+		upToDate     bool
+	}{
+		{
+			name:     "same version",
+			apiSpec:  "version:v1.8.0",
+			upToDate: true,
+		},
+		{
+			name:     "same version without v prefix",
+			apiSpec:  "version:1.8.0",
+			upToDate: true,
+		},
+		{
+			name:         "new version",
+			apiSpec:      "version:v1.9.0",
+			expectedSpec: "version:v1.9.0",
+		},
+		{
+			name:         "same version with full regeneration",
+			apiSpec:      "version:v1.8.0",
+			fullRegen:    true,
+			expectedSpec: "version:v1.8.0",
+		},
+		{
+			name:         "file",
+			apiSpec:      "file:swagger.json",
+			expectedSpec: "file:swagger.json",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withApp(t, func(appMock *amocks.App) {
+				appMock.On("Environment", "default").Return(&app.EnvironmentConfig{
+					Name:              "default",
+					KubernetesVersion: "v1.8.0",
+					Destination:       &app.EnvironmentDestinationSpec{Namespace: "default"},
+				}, nil)
+
+				in := map[string]interface{}{
+					OptionApp:       appMock,
+					OptionEnvName:   "default",
+					OptionSpecFlag:  tc.apiSpec,
+					OptionFullRegen: tc.fullRegen,
+				}
+
+				a, err := NewEnvSet(in)
+				require.NoError(t, err)
+
+				a.regenLibFn = func(app.App, string, *http.Client) error {
+					return nil
+				}
+
+				saved := false
+				a.saveFn = func(a app.App, envName, k8sAPISpec string, spec *app.EnvironmentConfig, override bool) error {
+					saved = true
+					assert.Equal(t, tc.expectedSpec, k8sAPISpec)
+					return nil
+				}
+
+				var buf bytes.Buffer
+				a.out = &buf
+
+				err = a.Run()
+				require.NoError(t, err)
+
+				assert.True(t, saved)
+				if tc.upToDate {
+					assert.Contains(t, buf.String(), "already up to date")
+				} else {
+					assert.Empty(t, buf.String())
+				}
+			})
+		})
+	}
+}
+
+func TestEnvSet_wait_reachable(t *testing.T) {
+	cases := []struct {
+		name      string
+		server    string
+		timeout   time.Duration
+		reachable int
+		probes    int
+		saved     bool
+		isErr     bool
+	}{
+		{
+			name:      "reachable",
+			server:    "https://new.example.com",
+			timeout:   30 * time.Second,
+			reachable: 1,
+			probes:    1,
+			saved:     true,
+		},
+		{
+			name:      "reachable after retrying",
+			server:    "https://new.example.com",
+			timeout:   30 * time.Second,
+			reachable: 3,
+			probes:    3,
+			saved:     true,
+		},
+		{
+			name:    "timed out",
+			server:  "https://typo.example.com",
+			timeout: 5 * time.Second,
+			probes:  3,
+			isErr:   true,
+		},
+		{
+			name:    "no new server",
+			timeout: 30 * time.Second,
+			isErr:   true,
+		},
+		{
+			name:   "no timeout",
+			server: "https://new.example.com",
+			isErr:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withApp(t, func(appMock *amocks.App) {
+				appMock.On("Environment", "default").Return(&app.EnvironmentConfig{
+					Name:        "default",
+					Destination: &app.EnvironmentDestinationSpec{Server: "https://old.example.com", Namespace: "default"},
+				}, nil)
+
+				in := map[string]interface{}{
+					OptionApp:           appMock,
+					OptionEnvName:       "default",
+					OptionServer:        tc.server,
+					OptionWaitReachable: true,
+					OptionTimeout:       tc.timeout,
+					OptionClientConfig:  &client.Config{},
+				}
+
+				a, err := NewEnvSet(in)
+				require.NoError(t, err)
+
+				now := time.Date(2018, 6, 1, 0, 0, 0, 0, time.UTC)
+				a.nowFn = func() time.Time { return now }
+				a.sleepFn = func(d time.Duration) { now = now.Add(d) }
+
+				probes := 0
+				a.probeServerFn = func(server string, timeout time.Duration) error {
+					probes++
+					assert.Equal(t, tc.server, server)
+					assert.True(t, timeout > 0, "each probe should be limited by the remaining time")
+					if probes == tc.reachable {
+						return nil
+					}
+					return errors.New("connection refused")
+				}
+
+				saved := false
+				a.saveFn = func(a app.App, envName, k8sAPISpec string, spec *app.EnvironmentConfig, override bool) error {
+					saved = true
+					assert.Equal(t, tc.server, spec.Destination.Server)
+					return nil
+				}
+
+				err = a.Run()
+				if tc.isErr {
+					require.Error(t, err)
+				} else {
+					require.NoError(t, err)
+				}
+
+				assert.Equal(t, tc.probes, probes)
+				assert.Equal(t, tc.saved, saved)
+			})
+		})
+	}
+}
+
+func TestEnvSet_dry_run(t *testing.T) {
+	cases := []struct {
+		name     string
+		in       map[string]interface{}
+		expected string
+		isErr    bool
+	}{
+		{
+			name: "rename and namespace",
+			in: map[string]interface{}{
+				OptionNewEnvName: "renamed",
+				OptionNamespace:  "new-ns",
+			},
+			expected: `Changing environment "default" would:
+  move     environments/default -> environments/renamed
+  update   app.yaml
+`,
+		},
+		{
+			name: "api spec",
+			in: map[string]interface{}{
+				OptionSpecFlag: "version:v1.9.0",
+			},
+			expected: `Changing environment "default" would:
+  generate lib/ (ksonnet-lib for version:v1.9.0, unless it was generated before)
+  update   app.yaml
+`,
+		},
+		{
+			name: "reset metadata",
+			in: map[string]interface{}{
+				OptionResetMetadata: true,
+			},
+			expected: `Changing environment "default" would:
+  generate lib/ (ksonnet-lib for version:v1.8.0, replacing the generated copy)
+`,
+		},
+		{
+			name:     "no changes",
+			in:       map[string]interface{}{},
+			expected: "Environment \"default\" would not be changed\n",
+		},
+		{
+			name: "params",
+			in: map[string]interface{}{
+				OptionParams:       []string{"replicas=5", "debug=true"},
+				OptionParamDeletes: []string{"tier"},
+			},
+			expected: `Changing environment "default" would:
+  update   environments/default/globals.libsonnet (set parameters debug, replicas; remove parameters tier)
+`,
+		},
+		{
+			name: "rename and params",
+			in: map[string]interface{}{
+				OptionNewEnvName: "renamed",
+				OptionParams:     []string{"replicas=5"},
+			},
+			expected: `Changing environment "default" would:
+  move     environments/default -> environments/renamed
+  update   app.yaml
+  update   environments/renamed/globals.libsonnet (set parameters replicas)
+`,
+		},
+		{
+			name: "invalid params",
+			in: map[string]interface{}{
+				OptionParams: []string{"replicas"},
+			},
+			isErr: true,
+		},
+		{
+			name: "report breakage",
+			in: map[string]interface{}{
+				OptionNamespace:      "new-ns",
+				OptionReportBreakage: true,
+			},
+			isErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withApp(t, func(appMock *amocks.App) {
+				appMock.On("Environment", "default").Return(&app.EnvironmentConfig{
+					Name:              "default",
+					KubernetesVersion: "v1.8.0",
+					Destination:       &app.EnvironmentDestinationSpec{Namespace: "default"},
+				}, nil)
+
+				in := map[string]interface{}{
+					OptionApp:     appMock,
+					OptionEnvName: "default",
+					OptionDryRun:  true,
+				}
+				for k, v := range tc.in {
+					in[k] = v
+				}
+
+				a, err := NewEnvSet(in)
+				require.NoError(t, err)
+
+				var buf bytes.Buffer
+				a.out = &buf
+
+				a.envRenameFn = func(a app.App, from, to string, override bool) error {
+					t.Errorf("unexpected call: rename %q to %q", from, to)
+					return nil
+				}
+				a.renameOpsFn = func(a app.App, from, to string, override bool) ([]env.Operation, error) {
+					return []env.Operation{
+						{Action: env.OpMove, Path: "environments/" + from, To: "environments/" + to},
+						env.ConfigOperation(override),
+					}, nil
+				}
+				a.saveFn = func(a app.App, envName, k8sAPISpec string, spec *app.EnvironmentConfig, override bool) error {
+					t.Errorf("unexpected call: save %q", envName)
+					return nil
+				}
+				a.regenLibFn = func(app.App, string, *http.Client) error {
+					t.Errorf("unexpected call: regenerate ksonnet-lib")
+					return nil
+				}
+				a.setParamsFn = func(a app.App, envName string, p mp.Params) error {
+					t.Errorf("unexpected call: set params of %q", envName)
+					return nil
+				}
+				a.deleteParamFn = func(a app.App, envName, paramName string) error {
+					t.Errorf("unexpected call: delete param %q of %q", paramName, envName)
+					return nil
+				}
+
+				err = a.Run()
+				if tc.isErr {
+					require.Error(t, err)
+					return
+				}
+				require.NoError(t, err)
+
+				assert.Equal(t, tc.expected, buf.String())
+			})
+		})
+	}
+}
+
+func TestEnvSet_rollback(t *testing.T) {
+	const appYAML = "apiVersion: 0.3.0\nenvironments:\n  default:\n    path: default\n"
+
+	cases := []struct {
+		name        string
+		envRenameFn envRenameFn
+		saveFn      saveFn
+	}{
+		{
+			name: "rename fails part way",
+			envRenameFn: func(a app.App, from, to string, override bool) error {
+				fs := a.Fs()
+				require.NoError(t, fs.MkdirAll("/environments/renamed", app.DefaultFolderPermissions))
+				require.NoError(t, fs.Rename("/environments/default/main.jsonnet", "/environments/renamed/main.jsonnet"))
+				return errors.New("rename failed")
+			},
+			saveFn: func(a app.App, envName, k8sAPISpec string, spec *app.EnvironmentConfig, override bool) error {
+				t.Errorf("unexpected call: save %q", envName)
+				return nil
+			},
+		},
+		{
+			name: "save fails after rename",
+			envRenameFn: func(a app.App, from, to string, override bool) error {
+				fs := a.Fs()
+				require.NoError(t, fs.MkdirAll("/environments/renamed", app.DefaultFolderPermissions))
+				require.NoError(t, fs.Rename("/environments/default/main.jsonnet", "/environments/renamed/main.jsonnet"))
+				require.NoError(t, fs.Remove("/environments/default"))
+				return afero.WriteFile(fs, "/app.yaml", []byte("renamed"), 0644)
+			},
+			saveFn: func(a app.App, envName, k8sAPISpec string, spec *app.EnvironmentConfig, override bool) error {
+				return errors.New("save failed")
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withApp(t, func(appMock *amocks.App) {
+				fs := appMock.Fs()
+				require.NoError(t, afero.WriteFile(fs, "/app.yaml", []byte(appYAML), 0644))
+				require.NoError(t, fs.MkdirAll("/environments/default", app.DefaultFolderPermissions))
+				require.NoError(t, afero.WriteFile(fs, "/environments/default/main.jsonnet", []byte("{}"), 0644))
+
+				appMock.On("Environment", "default").Return(&app.EnvironmentConfig{
+					Name:        "default",
+					Path:        "default",
+					Destination: &app.EnvironmentDestinationSpec{Namespace: "default"},
+				}, nil)
+
+				in := map[string]interface{}{
+					OptionApp:        appMock,
+					OptionEnvName:    "default",
+					OptionNewEnvName: "renamed",
+					OptionNamespace:  "new-ns",
+				}
+
+				a, err := NewEnvSet(in)
+				require.NoError(t, err)
+
+				a.envRenameFn = tc.envRenameFn
+				a.saveFn = tc.saveFn
+
+				require.Error(t, a.Run())
+
+				b, err := afero.ReadFile(fs, "/app.yaml")
+				require.NoError(t, err)
+				assert.Equal(t, appYAML, string(b))
+
+				b, err = afero.ReadFile(fs, "/environments/default/main.jsonnet")
+				require.NoError(t, err)
+				assert.Equal(t, "{}", string(b))
+
+				exists, err := afero.Exists(fs, "/environments/renamed")
+				require.NoError(t, err)
+				assert.False(t, exists)
+			})
+		})
+	}
+}
+
 func TestEnvSet_requires_app(t *testing.T) {
 	in := make(map[string]interface{})
 	_, err := NewEnvSet(in)
 	require.Error(t, err)
 }
+
+func TestEnvSet_normalize_server(t *testing.T) {
+	cases := []struct {
+		name     string
+		server   string
+		context  string
+		expected string
+		isErr    bool
+	}{
+		{
+			name:     "schemeless",
+			server:   "new.example.com:6443",
+			expected: "https://new.example.com:6443",
+		},
+		{
+			name:     "trailing slash",
+			server:   "https://new.example.com:6443/",
+			expected: "https://new.example.com:6443",
+		},
+		{
+			name:   "query string",
+			server: "https://new.example.com:6443?timeout=5s",
+			isErr:  true,
+		},
+		{
+			name:     "from context",
+			server:   "https://rancher.example.com/k8s/clusters/c-1",
+			context:  "dev",
+			expected: "https://rancher.example.com/k8s/clusters/c-1",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withApp(t, func(appMock *amocks.App) {
+				appMock.On("Environment", "default").Return(&app.EnvironmentConfig{
+					Name:        "default",
+					Destination: &app.EnvironmentDestinationSpec{Server: "https://old.example.com", Namespace: "default"},
+				}, nil)
+
+				in := map[string]interface{}{
+					OptionApp:     appMock,
+					OptionEnvName: "default",
+					OptionServer:  tc.server,
+					OptionContext: tc.context,
+				}
+
+				a, err := NewEnvSet(in)
+				require.NoError(t, err)
+
+				saved := false
+				a.saveFn = func(a app.App, envName, k8sAPISpec string, spec *app.EnvironmentConfig, override bool) error {
+					saved = true
+					assert.Equal(t, tc.expected, spec.Destination.Server)
+					return nil
+				}
+
+				err = a.Run()
+				if tc.isErr {
+					require.Error(t, err)
+				} else {
+					require.NoError(t, err)
+				}
+
+				assert.Equal(t, !tc.isErr, saved)
+			})
+		})
+	}
+}
+
+func TestEnvSet_params(t *testing.T) {
+	cases := []struct {
+		name       string
+		params     []string
+		deletes    []string
+		newName    string
+		expEnv     string
+		expParams  mp.Params
+		expDeletes []string
+		isErr      bool
+	}{
+		{
+			name:      "typed values",
+			params:    []string{"replicas=5", "ratio=0.5", "debug=true", "tier=frontend", `version="5"`, "ports=[80,443]", "empty="},
+			expEnv:    "default",
+			expParams: mp.Params{"replicas": 5, "ratio": 0.5, "debug": true, "tier": "frontend", "version": "5", "ports": []interface{}{float64(80), float64(443)}, "empty": ""},
+		},
+		{
+			name:       "delete",
+			deletes:    []string{"replicas", "debug"},
+			expEnv:     "default",
+			expDeletes: []string{"replicas", "debug"},
+		},
+		{
+			name:       "after rename",
+			params:     []string{"replicas=5"},
+			deletes:    []string{"debug"},
+			newName:    "renamed",
+			expEnv:     "renamed",
+			expParams:  mp.Params{"replicas": 5},
+			expDeletes: []string{"debug"},
+		},
+		{
+			name:   "missing value",
+			params: []string{"replicas"},
+			isErr:  true,
+		},
+		{
+			name:   "empty name",
+			params: []string{"=5"},
+			isErr:  true,
+		},
+		{
+			name:   "nested",
+			params: []string{"image.tag=1.0"},
+			isErr:  true,
+		},
+		{
+			name:   "set twice",
+			params: []string{"replicas=5", "replicas=6"},
+			isErr:  true,
+		},
+		{
+			name:    "set and delete",
+			params:  []string{"replicas=5"},
+			deletes: []string{"replicas"},
+			isErr:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withApp(t, func(appMock *amocks.App) {
+				appMock.On("Environment", "default").Return(&app.EnvironmentConfig{
+					Name:        "default",
+					Destination: &app.EnvironmentDestinationSpec{Server: "https://example.com", Namespace: "default"},
+				}, nil)
+
+				in := map[string]interface{}{
+					OptionApp:          appMock,
+					OptionEnvName:      "default",
+					OptionNewEnvName:   tc.newName,
+					OptionParams:       tc.params,
+					OptionParamDeletes: tc.deletes,
+				}
+
+				a, err := NewEnvSet(in)
+				require.NoError(t, err)
+
+				a.envRenameFn = func(a app.App, from, to string, override bool) error {
+					return nil
+				}
+
+				var gotParams mp.Params
+				a.setParamsFn = func(a app.App, envName string, p mp.Params) error {
+					assert.Equal(t, tc.expEnv, envName)
+					gotParams = p
+					return nil
+				}
+
+				var gotDeletes []string
+				a.deleteParamFn = func(a app.App, envName, paramName string) error {
+					assert.Equal(t, tc.expEnv, envName)
+					gotDeletes = append(gotDeletes, paramName)
+					return nil
+				}
+
+				err = a.Run()
+				if tc.isErr {
+					require.Error(t, err)
+					assert.Nil(t, gotParams)
+					assert.Nil(t, gotDeletes)
+					return
+				}
+				require.NoError(t, err)
+
+				assert.Equal(t, tc.expParams, gotParams)
+				assert.Equal(t, tc.expDeletes, gotDeletes)
+			})
+		})
+	}
+}
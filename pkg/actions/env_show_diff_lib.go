@@ -0,0 +1,106 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package actions
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/ksonnet/ksonnet/pkg/lib"
+	"github.com/pkg/errors"
+)
+
+type diffLibFn func(a app.App, k8sVersion string, httpClient *http.Client, w io.Writer) (bool, error)
+
+// RunEnvShowDiffLib runs `env show-diff-lib`.
+func RunEnvShowDiffLib(m map[string]interface{}) error {
+	esdl, err := NewEnvShowDiffLib(m)
+	if err != nil {
+		return err
+	}
+
+	return esdl.Run()
+}
+
+// EnvShowDiffLib shows the differences between the ksonnet-lib generated for
+// an environment and a fresh generation of it.
+type EnvShowDiffLib struct {
+	app        app.App
+	envName    string
+	httpClient *http.Client
+	out        io.Writer
+
+	diffLibFn diffLibFn
+}
+
+// NewEnvShowDiffLib creates an instance of EnvShowDiffLib.
+func NewEnvShowDiffLib(m map[string]interface{}) (*EnvShowDiffLib, error) {
+	ol := newOptionLoader(m)
+
+	esdl := &EnvShowDiffLib{
+		app:        ol.LoadApp(),
+		envName:    ol.LoadString(OptionEnvName),
+		httpClient: ol.LoadHTTPClient(),
+		out:        os.Stdout,
+
+		diffLibFn: diffLib,
+	}
+
+	if ol.err != nil {
+		return nil, ol.err
+	}
+
+	return esdl, nil
+}
+
+// Run writes a unified diff between the generated ksonnet-lib files of the
+// environment and freshly generated ones. It returns an error if they differ.
+func (esdl *EnvShowDiffLib) Run() error {
+	env, err := esdl.app.Environment(esdl.envName)
+	if err != nil {
+		return err
+	}
+
+	if env.KubernetesVersion == "" {
+		return errors.Errorf("environment %q does not record a Kubernetes version", esdl.envName)
+	}
+
+	differs, err := esdl.diffLibFn(esdl.app, env.KubernetesVersion, esdl.httpClient, esdl.out)
+	if err != nil {
+		return err
+	}
+
+	if !differs {
+		fmt.Fprintf(esdl.out, "ksonnet-lib for %s matches a fresh generation\n", env.KubernetesVersion)
+		return nil
+	}
+
+	return errors.Errorf("ksonnet-lib for %s was edited or generated by another version of ksonnet; regenerate it with `ks env set %s --reset-metadata`",
+		env.KubernetesVersion, esdl.envName)
+}
+
+func diffLib(a app.App, k8sVersion string, httpClient *http.Client, w io.Writer) (bool, error) {
+	libManager, err := lib.NewManager("version:"+k8sVersion, a.Fs(), filepath.Join(a.Root(), app.LibDirName), httpClient)
+	if err != nil {
+		return false, err
+	}
+
+	return libManager.DiffGenerated(w)
+}
@@ -0,0 +1,97 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package actions
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	amocks "github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvShowDiffLib(t *testing.T) {
+	cases := []struct {
+		name    string
+		diff    string
+		diffErr error
+		output  string
+		isErr   bool
+	}{
+		{
+			name:   "unchanged",
+			output: "ksonnet-lib for v1.10.3 matches a fresh generation\n",
+		},
+		{
+			name:   "changed",
+			diff:   "--- cached/k8s.libsonnet\n+++ generated/k8s.libsonnet\n-edited\n",
+			output: "--- cached/k8s.libsonnet\n+++ generated/k8s.libsonnet\n-edited\n",
+			isErr:  true,
+		},
+		{
+			name:    "diff failed",
+			diffErr: errors.New("failed"),
+			isErr:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withApp(t, func(appMock *amocks.App) {
+				env := &app.EnvironmentConfig{Name: "default", KubernetesVersion: "v1.10.3"}
+				appMock.On("Environment", "default").Return(env, nil)
+
+				in := map[string]interface{}{
+					OptionApp:     appMock,
+					OptionEnvName: "default",
+				}
+
+				a, err := NewEnvShowDiffLib(in)
+				require.NoError(t, err)
+
+				a.diffLibFn = func(_ app.App, k8sVersion string, _ *http.Client, w io.Writer) (bool, error) {
+					assert.Equal(t, "v1.10.3", k8sVersion)
+					_, err := io.WriteString(w, tc.diff)
+					require.NoError(t, err)
+					return tc.diff != "", tc.diffErr
+				}
+
+				var buf bytes.Buffer
+				a.out = &buf
+
+				err = a.Run()
+				if tc.isErr {
+					require.Error(t, err)
+				} else {
+					require.NoError(t, err)
+				}
+
+				require.Equal(t, tc.output, buf.String())
+			})
+		})
+	}
+}
+
+func TestEnvShowDiffLib_requires_app(t *testing.T) {
+	in := make(map[string]interface{})
+	_, err := NewEnvShowDiffLib(in)
+	require.Error(t, err)
+}
@@ -84,9 +84,6 @@ func genLib(a app.App, k8sSpecFlag, libPath string, httpClient *http.Client) err
 		return err
 	}
 
-	if err = a.Fs().RemoveAll(libPath); err != nil {
-		return err
-	}
-
-	return libManager.GenerateLibData()
+	libManager.CacheDir = app.LibCachePath(a.Root())
+	return libManager.ReplaceLibData()
 }
@@ -0,0 +1,149 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package actions
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/ksonnet/ksonnet/pkg/lib"
+	"github.com/pkg/errors"
+)
+
+// RunEnvUpgrade runs `env upgrade`.
+func RunEnvUpgrade(m map[string]interface{}) error {
+	eu, err := NewEnvUpgrade(m)
+	if err != nil {
+		return err
+	}
+
+	return eu.Run()
+}
+
+// EnvUpgrade regenerates the ksonnet-lib of an environment from the OpenAPI
+// spec served by its cluster. To initialize EnvUpgrade, use the
+// `NewEnvUpgrade` constructor.
+type EnvUpgrade struct {
+	app          app.App
+	envName      string
+	clientConfig *client.Config
+	timeout      time.Duration
+	out          io.Writer
+
+	openAPIFn func(a app.App, clientConfig *client.Config, envName string, timeout time.Duration) ([]byte, error)
+	regenFn   func(a app.App, source string, data []byte) (string, error)
+}
+
+// NewEnvUpgrade creates an instance of EnvUpgrade.
+func NewEnvUpgrade(m map[string]interface{}) (*EnvUpgrade, error) {
+	ol := newOptionLoader(m)
+
+	eu := &EnvUpgrade{
+		app:          ol.LoadApp(),
+		envName:      ol.LoadString(OptionEnvName),
+		clientConfig: ol.LoadClientConfig(),
+		timeout:      ol.LoadDuration(OptionTimeout),
+		out:          os.Stdout,
+
+		openAPIFn: environmentOpenAPI,
+		regenFn:   regenLibFromOpenAPI,
+	}
+
+	if ol.err != nil {
+		return nil, ol.err
+	}
+
+	return eu, nil
+}
+
+// Run retrieves the OpenAPI spec from the server of the environment,
+// regenerates ksonnet-lib from it, and records the Kubernetes version of the
+// spec for the environment. If the server can't be reached, or ksonnet-lib
+// can't be generated, the environment and its ksonnet-lib are left as they
+// were.
+func (eu *EnvUpgrade) Run() error {
+	env, err := eu.app.Environment(eu.envName)
+	if err != nil {
+		return err
+	}
+
+	if env.Destination == nil || env.Destination.Server == "" {
+		return errors.Errorf("environment %q has no server to retrieve the OpenAPI spec from", eu.envName)
+	}
+	server := env.Destination.Server
+
+	data, err := eu.openAPIFn(eu.app, eu.clientConfig, eu.envName, eu.timeout)
+	if err != nil {
+		return errors.Wrapf(err, "retrieve OpenAPI spec of environment %q from %s", eu.envName, server)
+	}
+
+	version, err := eu.regenFn(eu.app, server, data)
+	if err != nil {
+		return errors.Wrapf(err, "regenerate ksonnet-lib of environment %q", eu.envName)
+	}
+
+	oldVersion := env.KubernetesVersion
+	if version != oldVersion || env.LibPending != "" {
+		isOverride := eu.app.IsEnvOverride(eu.envName)
+		if isOverride {
+			// Libraries will always derive from the primary app.yaml
+			env.Libraries = nil
+		}
+
+		env.KubernetesVersion = version
+		env.LibPending = ""
+		if err := eu.app.AddEnvironment(env, "", isOverride); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case oldVersion == "":
+		fmt.Fprintf(eu.out, "Generated ksonnet-lib for environment %q with Kubernetes %s from %s\n", eu.envName, version, server)
+	case version == oldVersion:
+		fmt.Fprintf(eu.out, "Regenerated ksonnet-lib for environment %q with Kubernetes %s from %s\n", eu.envName, version, server)
+	default:
+		fmt.Fprintf(eu.out, "Upgraded ksonnet-lib for environment %q from Kubernetes %s to %s from %s\n", eu.envName, oldVersion, version, server)
+	}
+
+	return nil
+}
+
+func environmentOpenAPI(a app.App, clientConfig *client.Config, envName string, timeout time.Duration) ([]byte, error) {
+	return clientConfig.EnvironmentOpenAPI(a, envName, timeout)
+}
+
+// regenLibFromOpenAPI regenerates ksonnet-lib from an OpenAPI spec and returns
+// its Kubernetes version. An existing ksonnet-lib for the version is only
+// replaced once generation succeeded.
+func regenLibFromOpenAPI(a app.App, source string, data []byte) (string, error) {
+	libManager, err := lib.NewManagerFromOpenAPI(source, data, a.Fs(), filepath.Join(a.Root(), app.LibDirName))
+	if err != nil {
+		return "", err
+	}
+
+	libManager.CacheDir = app.LibCachePath(a.Root())
+	if err := libManager.ReplaceLibData(); err != nil {
+		return "", err
+	}
+
+	return libManager.K8sVersion, nil
+}
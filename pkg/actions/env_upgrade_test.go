@@ -0,0 +1,148 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package actions
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	amocks "github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvUpgrade(t *testing.T) {
+	cases := []struct {
+		name       string
+		oldVersion string
+		libPending string
+		newVersion string
+		openAPIErr error
+		regenErr   error
+		saved      bool
+		expected   string
+		isErr      bool
+	}{
+		{
+			name:       "upgraded cluster",
+			oldVersion: "v1.7.0",
+			newVersion: "v1.8.4",
+			saved:      true,
+			expected:   "Upgraded ksonnet-lib for environment \"default\" from Kubernetes v1.7.0 to v1.8.4 from https://cluster\n",
+		},
+		{
+			name:       "same version",
+			oldVersion: "v1.8.4",
+			newVersion: "v1.8.4",
+			expected:   "Regenerated ksonnet-lib for environment \"default\" with Kubernetes v1.8.4 from https://cluster\n",
+		},
+		{
+			name:       "lib pending",
+			libPending: "version:v1.8.4",
+			newVersion: "v1.8.4",
+			saved:      true,
+			expected:   "Generated ksonnet-lib for environment \"default\" with Kubernetes v1.8.4 from https://cluster\n",
+		},
+		{
+			name:       "unreachable server",
+			oldVersion: "v1.7.0",
+			openAPIErr: errors.New("connection refused"),
+			isErr:      true,
+		},
+		{
+			name:       "generation fails",
+			oldVersion: "v1.7.0",
+			newVersion: "v1.8.4",
+			regenErr:   errors.New("generate failed"),
+			isErr:      true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withApp(t, func(appMock *amocks.App) {
+				env := &app.EnvironmentConfig{
+					Name:              "default",
+					KubernetesVersion: tc.oldVersion,
+					LibPending:        tc.libPending,
+					Destination: &app.EnvironmentDestinationSpec{
+						Server:    "https://cluster",
+						Namespace: "default",
+					},
+				}
+				appMock.On("Environment", "default").Return(env, nil)
+				appMock.On("IsEnvOverride", "default").Return(false)
+				appMock.On("AddEnvironment", mock.MatchedBy(func(e *app.EnvironmentConfig) bool {
+					return e.KubernetesVersion == tc.newVersion && e.LibPending == ""
+				}), "", false).Return(nil)
+
+				in := map[string]interface{}{
+					OptionApp:          appMock,
+					OptionEnvName:      "default",
+					OptionClientConfig: &client.Config{},
+					OptionTimeout:      time.Second,
+				}
+
+				a, err := NewEnvUpgrade(in)
+				require.NoError(t, err)
+
+				var buf bytes.Buffer
+				a.out = &buf
+
+				a.openAPIFn = func(a app.App, clientConfig *client.Config, envName string, timeout time.Duration) ([]byte, error) {
+					assert.Equal(t, "default", envName)
+					assert.Equal(t, time.Second, timeout)
+					return []byte("spec"), tc.openAPIErr
+				}
+
+				var regenerated bool
+				a.regenFn = func(a app.App, source string, data []byte) (string, error) {
+					regenerated = true
+					assert.Equal(t, "https://cluster", source)
+					assert.Equal(t, "spec", string(data))
+					return tc.newVersion, tc.regenErr
+				}
+
+				err = a.Run()
+				if tc.isErr {
+					require.Error(t, err)
+					appMock.AssertNotCalled(t, "AddEnvironment", mock.Anything, mock.Anything, mock.Anything)
+					assert.Equal(t, tc.openAPIErr == nil, regenerated)
+					return
+				}
+				require.NoError(t, err)
+
+				if tc.saved {
+					appMock.AssertCalled(t, "AddEnvironment", env, "", false)
+				} else {
+					appMock.AssertNotCalled(t, "AddEnvironment", mock.Anything, mock.Anything, mock.Anything)
+				}
+				assert.Equal(t, tc.expected, buf.String())
+			})
+		})
+	}
+}
+
+func TestEnvUpgrade_requires_app(t *testing.T) {
+	in := make(map[string]interface{})
+	_, err := NewEnvUpgrade(in)
+	require.Error(t, err)
+}
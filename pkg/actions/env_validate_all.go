@@ -0,0 +1,327 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package actions
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/ksonnet/ksonnet/pkg/cluster"
+	"github.com/ksonnet/ksonnet/pkg/lib"
+	"github.com/ksonnet/ksonnet/pkg/log"
+	str "github.com/ksonnet/ksonnet/pkg/util/strings"
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+)
+
+const (
+	// ValidateAllFormatJSON is the JSON report format for `env validate-all`.
+	ValidateAllFormatJSON = "json"
+	// ValidateAllFormatJUnit is the JUnit XML report format for `env validate-all`.
+	ValidateAllFormatJUnit = "junit"
+
+	validateCheckSpec         = "spec"
+	validateCheckLib          = "lib"
+	validateCheckJsonnet      = "jsonnet"
+	validateCheckReachability = "reachability"
+
+	reachabilityTimeout = 5 * time.Second
+)
+
+type reachabilityFn func(a app.App, clientConfig *client.Config, envName string) error
+
+// RunEnvValidateAll runs `env validate-all`.
+func RunEnvValidateAll(m map[string]interface{}) error {
+	va, err := NewEnvValidateAll(m)
+	if err != nil {
+		return err
+	}
+
+	return va.Run()
+}
+
+// EnvValidateAll validates every environment in an app and reports the
+// results in a machine readable format.
+type EnvValidateAll struct {
+	app               app.App
+	clientConfig      *client.Config
+	format            string
+	checkReachability bool
+	out               io.Writer
+
+	findObjectsFn  findObjectsFn
+	reachabilityFn reachabilityFn
+}
+
+// NewEnvValidateAll creates an instance of EnvValidateAll.
+func NewEnvValidateAll(m map[string]interface{}) (*EnvValidateAll, error) {
+	ol := newOptionLoader(m)
+
+	va := &EnvValidateAll{
+		app:               ol.LoadApp(),
+		clientConfig:      ol.LoadClientConfig(),
+		format:            ol.LoadOptionalString(OptionFormat),
+		checkReachability: ol.LoadOptionalBool(OptionCheckReachability),
+
+		out:            os.Stdout,
+		findObjectsFn:  cluster.Render,
+		reachabilityFn: checkReachability,
+	}
+
+	if ol.err != nil {
+		return nil, ol.err
+	}
+
+	if va.format == "" {
+		va.format = ValidateAllFormatJSON
+	}
+
+	if va.format != ValidateAllFormatJSON && va.format != ValidateAllFormatJUnit {
+		return nil, errors.Errorf("unsupported report format %q", va.format)
+	}
+
+	return va, nil
+}
+
+type validateAllReport struct {
+	Passed       bool                     `json:"passed"`
+	Environments []validateAllEnvironment `json:"environments"`
+	Warnings     []string                 `json:"warnings"`
+}
+
+type validateAllEnvironment struct {
+	Name   string             `json:"name"`
+	Passed bool               `json:"passed"`
+	Checks []validateAllCheck `json:"checks"`
+}
+
+type validateAllCheck struct {
+	Name    string `json:"name"`
+	Passed  bool   `json:"passed"`
+	Skipped bool   `json:"skipped,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// Run validates all environments and writes the report.
+func (va *EnvValidateAll) Run() error {
+	envs, err := va.app.Environments()
+	if err != nil {
+		return err
+	}
+
+	var names []string
+	for name := range envs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	report := validateAllReport{Passed: true}
+	failures := 0
+
+	for _, name := range names {
+		result := va.validate(name, envs[name])
+		if !result.Passed {
+			report.Passed = false
+			failures++
+		}
+
+		report.Environments = append(report.Environments, result)
+	}
+
+	switch va.format {
+	case ValidateAllFormatJUnit:
+		err = writeJUnitReport(va.out, report)
+	default:
+		// Warnings are part of the JSON report, so they aren't printed again
+		// when the command finishes.
+		report.Warnings = log.TakeWarnings()
+		err = writeJSONReport(va.out, report)
+	}
+	if err != nil {
+		return err
+	}
+
+	if failures > 0 {
+		return errors.Errorf("validation failed for %d environment(s)", failures)
+	}
+
+	return nil
+}
+
+func (va *EnvValidateAll) validate(name string, env *app.EnvironmentConfig) validateAllEnvironment {
+	result := validateAllEnvironment{Name: name, Passed: true}
+
+	add := func(check validateAllCheck) {
+		if !check.Passed && !check.Skipped {
+			result.Passed = false
+		}
+		result.Checks = append(result.Checks, check)
+	}
+
+	add(newValidateCheck(validateCheckSpec, validateEnvSpec(env)))
+
+	libErr := va.validateLib(env)
+	add(newValidateCheck(validateCheckLib, libErr))
+
+	if libErr != nil {
+		add(validateAllCheck{
+			Name:    validateCheckJsonnet,
+			Skipped: true,
+			Message: "ksonnet-lib is missing",
+		})
+	} else {
+		_, err := va.findObjectsFn(va.app, name, nil)
+		add(newValidateCheck(validateCheckJsonnet, err))
+	}
+
+	if va.checkReachability {
+		add(newValidateCheck(validateCheckReachability, va.reachabilityFn(va.app, va.clientConfig, name)))
+	}
+
+	return result
+}
+
+// validateLib checks that ksonnet-lib has been generated for the environment's
+// Kubernetes version. It does not generate it.
+func (va *EnvValidateAll) validateLib(env *app.EnvironmentConfig) error {
+	if env.KubernetesVersion == "" {
+		return errors.New("kubernetes version is not set")
+	}
+
+	libPath := filepath.Join(va.app.Root(), app.LibDirName)
+	for _, dir := range []string{filepath.Join(libPath, lib.KsonnetLibHome), libPath} {
+		ok, err := afero.DirExists(va.app.Fs(), filepath.Join(dir, env.KubernetesVersion))
+		if err != nil {
+			return err
+		}
+
+		if ok {
+			return nil
+		}
+	}
+
+	return errors.Errorf("ksonnet-lib for %s has not been generated", env.KubernetesVersion)
+}
+
+func validateEnvSpec(env *app.EnvironmentConfig) error {
+	if env.Destination == nil {
+		return errors.New("destination is not set")
+	}
+
+	if env.Destination.Server == "" {
+		return errors.New("server is not set")
+	}
+
+	if _, err := str.NormalizeURL(env.Destination.Server); err != nil {
+		return errors.Wrapf(err, "invalid server %q", env.Destination.Server)
+	}
+
+	if env.Destination.Namespace == "" {
+		return errors.New("namespace is not set")
+	}
+
+	return nil
+}
+
+func newValidateCheck(name string, err error) validateAllCheck {
+	check := validateAllCheck{Name: name, Passed: err == nil}
+	if err != nil {
+		check.Message = err.Error()
+	}
+
+	return check
+}
+
+func checkReachability(a app.App, clientConfig *client.Config, envName string) error {
+	_, err := clientConfig.EnvironmentServerVersion(a, envName, reachabilityTimeout)
+	return err
+}
+
+func writeJSONReport(w io.Writer, report validateAllReport) error {
+	b, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(w, string(b))
+	return err
+}
+
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Skipped  int             `xml:"skipped,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+}
+
+func writeJUnitReport(w io.Writer, report validateAllReport) error {
+	var suites junitTestSuites
+
+	for _, env := range report.Environments {
+		suite := junitTestSuite{Name: env.Name}
+
+		for _, check := range env.Checks {
+			tc := junitTestCase{Name: check.Name, ClassName: env.Name}
+
+			switch {
+			case check.Skipped:
+				tc.Skipped = &junitMessage{Message: check.Message}
+				suite.Skipped++
+			case !check.Passed:
+				tc.Failure = &junitMessage{Message: check.Message}
+				suite.Failures++
+			}
+
+			suite.Tests++
+			suite.Cases = append(suite.Cases, tc)
+		}
+
+		suites.Suites = append(suites.Suites, suite)
+	}
+
+	b, err := xml.MarshalIndent(suites, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "%s%s\n", xml.Header, b)
+	return err
+}
@@ -0,0 +1,135 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package actions
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	amocks "github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestEnvValidateAll(t *testing.T) {
+	cases := []struct {
+		name              string
+		format            string
+		checkReachability bool
+		outputFile        string
+	}{
+		{
+			name:       "json",
+			format:     ValidateAllFormatJSON,
+			outputFile: "env/validate-all/output.json",
+		},
+		{
+			name:       "junit",
+			format:     ValidateAllFormatJUnit,
+			outputFile: "env/validate-all/output.xml",
+		},
+		{
+			name:              "with reachability",
+			format:            ValidateAllFormatJSON,
+			checkReachability: true,
+			outputFile:        "env/validate-all/reachability.json",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withApp(t, func(appMock *amocks.App) {
+				envs := app.EnvironmentConfigs{
+					"default": &app.EnvironmentConfig{
+						KubernetesVersion: "v1.7.0",
+						Destination: &app.EnvironmentDestinationSpec{
+							Namespace: "default",
+							Server:    "http://example.com",
+						},
+					},
+					"broken": &app.EnvironmentConfig{
+						KubernetesVersion: "v1.7.0",
+						Destination: &app.EnvironmentDestinationSpec{
+							Server: "http://example.com",
+						},
+					},
+					"nolib": &app.EnvironmentConfig{
+						KubernetesVersion: "v1.9.0",
+						Destination: &app.EnvironmentDestinationSpec{
+							Namespace: "default",
+							Server:    "http://example.com",
+						},
+					},
+				}
+				appMock.On("Environments").Return(envs, nil)
+
+				err := appMock.Fs().MkdirAll("/lib/ksonnet-lib/v1.7.0", 0755)
+				require.NoError(t, err)
+
+				in := map[string]interface{}{
+					OptionApp:               appMock,
+					OptionClientConfig:      &client.Config{},
+					OptionFormat:            tc.format,
+					OptionCheckReachability: tc.checkReachability,
+				}
+
+				a, err := NewEnvValidateAll(in)
+				require.NoError(t, err)
+
+				a.findObjectsFn = func(a app.App, envName string, componentNames []string) ([]*unstructured.Unstructured, error) {
+					if envName == "broken" {
+						return nil, errors.New("evaluation failed")
+					}
+					return nil, nil
+				}
+
+				a.reachabilityFn = func(a app.App, clientConfig *client.Config, envName string) error {
+					return nil
+				}
+
+				var buf bytes.Buffer
+				a.out = &buf
+
+				err = a.Run()
+				require.Error(t, err)
+
+				assertOutput(t, tc.outputFile, buf.String())
+			})
+		})
+	}
+}
+
+func TestEnvValidateAll_invalid_format(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		in := map[string]interface{}{
+			OptionApp:          appMock,
+			OptionClientConfig: &client.Config{},
+			OptionFormat:       "yaml",
+		}
+
+		_, err := NewEnvValidateAll(in)
+		require.Error(t, err)
+	})
+}
+
+func TestEnvValidateAll_requires_app(t *testing.T) {
+	in := make(map[string]interface{})
+	_, err := NewEnvValidateAll(in)
+	require.Error(t, err)
+}
@@ -0,0 +1,128 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package actions
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/ksonnet/ksonnet/pkg/lib"
+	"github.com/ksonnet/ksonnet/pkg/util/table"
+	"github.com/pkg/errors"
+)
+
+type verifyLibFn func(a app.App, k8sVersion string) ([]lib.LibFileProblem, error)
+
+// RunEnvVerifyLib runs `env verify-lib`.
+func RunEnvVerifyLib(m map[string]interface{}) error {
+	evl, err := NewEnvVerifyLib(m)
+	if err != nil {
+		return err
+	}
+
+	return evl.Run()
+}
+
+// EnvVerifyLib checks that the ksonnet-lib generated for an environment
+// hasn't changed since it was generated.
+type EnvVerifyLib struct {
+	app     app.App
+	envName string
+	out     io.Writer
+
+	verifyLibFn verifyLibFn
+}
+
+// NewEnvVerifyLib creates an instance of EnvVerifyLib.
+func NewEnvVerifyLib(m map[string]interface{}) (*EnvVerifyLib, error) {
+	ol := newOptionLoader(m)
+
+	evl := &EnvVerifyLib{
+		app:     ol.LoadApp(),
+		envName: ol.LoadString(OptionEnvName),
+		out:     os.Stdout,
+
+		verifyLibFn: verifyLib,
+	}
+
+	if ol.err != nil {
+		return nil, ol.err
+	}
+
+	return evl, nil
+}
+
+// Run lists the generated ksonnet-lib files of the environment that were
+// modified or are missing. It returns an error if there are any.
+func (evl *EnvVerifyLib) Run() error {
+	env, err := evl.app.Environment(evl.envName)
+	if err != nil {
+		return err
+	}
+
+	if env.LibPending != "" {
+		return errors.Errorf("ksonnet-lib of environment %q hasn't been generated yet; generate it with `ks env upgrade %s`",
+			evl.envName, evl.envName)
+	}
+
+	if env.KubernetesVersion == "" {
+		return errors.Errorf("environment %q does not record a Kubernetes version", evl.envName)
+	}
+
+	problems, err := evl.verifyLibFn(evl.app, env.KubernetesVersion)
+	if err == lib.ErrNoChecksums {
+		return errors.Errorf("ksonnet-lib for %s has no checksum manifest; regenerate it with `ks env set %s --reset-metadata`",
+			env.KubernetesVersion, evl.envName)
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(problems) == 0 {
+		fmt.Fprintf(evl.out, "ksonnet-lib for %s matches its checksums\n", env.KubernetesVersion)
+		return nil
+	}
+
+	t := table.New("envVerifyLib", evl.out)
+	t.SetHeader([]string{"file", "problem"})
+
+	for _, problem := range problems {
+		path, err := filepath.Rel(evl.app.Root(), problem.Path)
+		if err != nil {
+			path = problem.Path
+		}
+		t.Append([]string{path, problem.Problem})
+	}
+
+	if err := t.Render(); err != nil {
+		return err
+	}
+
+	return errors.Errorf("%d ksonnet-lib file(s) changed since they were generated; regenerate them with `ks env set %s --reset-metadata`",
+		len(problems), evl.envName)
+}
+
+func verifyLib(a app.App, k8sVersion string) ([]lib.LibFileProblem, error) {
+	libManager, err := lib.NewManager("version:"+k8sVersion, a.Fs(), filepath.Join(a.Root(), app.LibDirName), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return libManager.Verify()
+}
@@ -0,0 +1,131 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package actions
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	amocks "github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/ksonnet/ksonnet/pkg/lib"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvVerifyLib(t *testing.T) {
+	cases := []struct {
+		name       string
+		problems   []lib.LibFileProblem
+		verifyErr  error
+		output     string
+		outputFile string
+		isErr      bool
+	}{
+		{
+			name:   "unchanged",
+			output: "ksonnet-lib for v1.10.3 matches its checksums\n",
+		},
+		{
+			name: "changed files",
+			problems: []lib.LibFileProblem{
+				{Path: "/lib/v1.10.3/k.libsonnet", Problem: lib.LibFileMissing},
+				{Path: "/lib/v1.10.3/k8s.libsonnet", Problem: lib.LibFileModified},
+			},
+			outputFile: "env/verify-lib/modified.txt",
+			isErr:      true,
+		},
+		{
+			name:      "no checksum manifest",
+			verifyErr: lib.ErrNoChecksums,
+			isErr:     true,
+		},
+		{
+			name:      "verify failed",
+			verifyErr: errors.New("failed"),
+			isErr:     true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withApp(t, func(appMock *amocks.App) {
+				env := &app.EnvironmentConfig{Name: "default", KubernetesVersion: "v1.10.3"}
+				appMock.On("Environment", "default").Return(env, nil)
+
+				in := map[string]interface{}{
+					OptionApp:     appMock,
+					OptionEnvName: "default",
+				}
+
+				a, err := NewEnvVerifyLib(in)
+				require.NoError(t, err)
+
+				a.verifyLibFn = func(_ app.App, k8sVersion string) ([]lib.LibFileProblem, error) {
+					assert.Equal(t, "v1.10.3", k8sVersion)
+					return tc.problems, tc.verifyErr
+				}
+
+				var buf bytes.Buffer
+				a.out = &buf
+
+				err = a.Run()
+				if tc.isErr {
+					require.Error(t, err)
+				} else {
+					require.NoError(t, err)
+				}
+
+				if tc.outputFile != "" {
+					assertOutput(t, tc.outputFile, buf.String())
+				} else {
+					require.Equal(t, tc.output, buf.String())
+				}
+			})
+		})
+	}
+}
+
+func TestEnvVerifyLib_lib_pending(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		env := &app.EnvironmentConfig{Name: "default", LibPending: "version:v1.10.3"}
+		appMock.On("Environment", "default").Return(env, nil)
+
+		in := map[string]interface{}{
+			OptionApp:     appMock,
+			OptionEnvName: "default",
+		}
+
+		a, err := NewEnvVerifyLib(in)
+		require.NoError(t, err)
+
+		a.verifyLibFn = func(_ app.App, k8sVersion string) ([]lib.LibFileProblem, error) {
+			t.Fatal("verified an ungenerated ksonnet-lib")
+			return nil, nil
+		}
+
+		err = a.Run()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "hasn't been generated yet")
+	})
+}
+
+func TestEnvVerifyLib_requires_app(t *testing.T) {
+	in := make(map[string]interface{})
+	_, err := NewEnvVerifyLib(in)
+	require.Error(t, err)
+}
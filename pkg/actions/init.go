@@ -40,7 +40,7 @@ func RunInit(m map[string]interface{}) error {
 	return i.Run()
 }
 
-type appLoadFn func(fs afero.Fs, httpClient *http.Client, root string) (app.App, error)
+type appLoadFn func(fs afero.Fs, httpClient *http.Client, root string, opts ...app.Opt) (app.App, error)
 
 type appInitFn func(fs afero.Fs, httpClient *http.Client, name, rootPath, envName, k8sSpecFlag, serverURI, namespace string, registries []registry.Registry) error
 
@@ -0,0 +1,276 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package actions
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/ksonnet/ksonnet/pkg/component"
+	"github.com/ksonnet/ksonnet/pkg/util/table"
+	"github.com/pkg/errors"
+)
+
+const (
+	paramImportComponentColumn = "component"
+	paramImportParamColumn     = "param"
+)
+
+// RunParamImport runs `param import`.
+func RunParamImport(m map[string]interface{}) error {
+	pi, err := NewParamImport(m)
+	if err != nil {
+		return err
+	}
+
+	return pi.Run()
+}
+
+// ParamImport imports per-environment component params from a CSV file.
+// The first row of the file is a header of the form
+// `component,param,<env>,<env>...`. Every other row sets a param of a
+// component in each environment with a non-empty cell.
+type ParamImport struct {
+	app        app.App
+	filename   string
+	envColumns []string
+	dryRun     bool
+	out        io.Writer
+
+	resolvePathFn func(a app.App, path string) (component.Module, component.Component, error)
+	setEnvFn      func(ksApp app.App, envName, name, pName, value string) error
+}
+
+// NewParamImport creates an instance of ParamImport.
+func NewParamImport(m map[string]interface{}) (*ParamImport, error) {
+	ol := newOptionLoader(m)
+
+	pi := &ParamImport{
+		app:        ol.LoadApp(),
+		filename:   ol.LoadString(OptionFilename),
+		envColumns: ol.LoadStringSlice(OptionEnvNames),
+		dryRun:     ol.LoadBool(OptionDryRun),
+		out:        os.Stdout,
+
+		resolvePathFn: component.ResolvePath,
+		setEnvFn:      setEnv,
+	}
+
+	if ol.err != nil {
+		return nil, ol.err
+	}
+
+	return pi, nil
+}
+
+type paramImportWrite struct {
+	envName   string
+	component string
+	param     string
+	value     string
+}
+
+// Run validates the CSV file and writes its params to the environments. If
+// any row is invalid, nothing is written.
+func (pi *ParamImport) Run() error {
+	records, err := pi.readRecords()
+	if err != nil {
+		return err
+	}
+
+	writes, err := pi.plan(records)
+	if err != nil {
+		return err
+	}
+
+	if pi.dryRun {
+		return pi.preview(writes)
+	}
+
+	for _, w := range writes {
+		if err := pi.setEnvFn(pi.app, w.envName, w.component, w.param, w.value); err != nil {
+			return errors.Wrapf(err, "set param %s.%s for environment %q", w.component, w.param, w.envName)
+		}
+	}
+
+	return nil
+}
+
+func (pi *ParamImport) readRecords() ([][]string, error) {
+	f, err := pi.app.Fs().Open(pi.filename)
+	if err != nil {
+		return nil, errors.Wrapf(err, "open %s", pi.filename)
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.TrimLeadingSpace = true
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, errors.Wrapf(err, "read %s", pi.filename)
+	}
+
+	if len(records) == 0 {
+		return nil, errors.Errorf("%s is empty", pi.filename)
+	}
+
+	return records, nil
+}
+
+// plan validates the records and returns the params to write, ordered by
+// environment, component and param.
+func (pi *ParamImport) plan(records [][]string) ([]paramImportWrite, error) {
+	columns, err := pi.envColumnIndexes(records[0])
+	if err != nil {
+		return nil, err
+	}
+
+	var problems []string
+	seen := make(map[string]bool)
+	var writes []paramImportWrite
+
+	for i, record := range records[1:] {
+		line := i + 2
+		componentName := strings.TrimSpace(record[0])
+		paramName := strings.TrimSpace(record[1])
+
+		if componentName == "" || paramName == "" {
+			problems = append(problems, fmt.Sprintf("line %d: component and param are required", line))
+			continue
+		}
+
+		key := componentName + "." + paramName
+		if seen[key] {
+			problems = append(problems, fmt.Sprintf("line %d: duplicate param %s", line, key))
+			continue
+		}
+		seen[key] = true
+
+		if err := pi.validateParam(componentName, paramName); err != nil {
+			problems = append(problems, fmt.Sprintf("line %d: %v", line, err))
+			continue
+		}
+
+		for envName, index := range columns {
+			value := record[index]
+			if value == "" {
+				continue
+			}
+
+			writes = append(writes, paramImportWrite{
+				envName:   envName,
+				component: componentName,
+				param:     paramName,
+				value:     value,
+			})
+		}
+	}
+
+	if len(problems) > 0 {
+		return nil, errors.Errorf("invalid params in %s:\n  %s", pi.filename, strings.Join(problems, "\n  "))
+	}
+
+	sort.Slice(writes, func(i, j int) bool {
+		if writes[i].envName != writes[j].envName {
+			return writes[i].envName < writes[j].envName
+		}
+		if writes[i].component != writes[j].component {
+			return writes[i].component < writes[j].component
+		}
+		return writes[i].param < writes[j].param
+	})
+
+	return writes, nil
+}
+
+// envColumnIndexes validates the header and returns the index of each
+// environment column to import.
+func (pi *ParamImport) envColumnIndexes(header []string) (map[string]int, error) {
+	if len(header) < 3 ||
+		!strings.EqualFold(strings.TrimSpace(header[0]), paramImportComponentColumn) ||
+		!strings.EqualFold(strings.TrimSpace(header[1]), paramImportParamColumn) {
+		return nil, errors.Errorf("header of %s must be %s,%s followed by one or more environment names",
+			pi.filename, paramImportComponentColumn, paramImportParamColumn)
+	}
+
+	envs, err := pi.app.Environments()
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make(map[string]int)
+	for i, name := range header[2:] {
+		name = strings.TrimSpace(name)
+		if _, ok := envs[name]; !ok {
+			return nil, errors.Errorf("column %q in %s is not an environment", name, pi.filename)
+		}
+		if _, ok := columns[name]; ok {
+			return nil, errors.Errorf("environment %q appears more than once in %s", name, pi.filename)
+		}
+		columns[name] = i + 2
+	}
+
+	if len(pi.envColumns) == 0 {
+		return columns, nil
+	}
+
+	selected := make(map[string]int)
+	for _, name := range pi.envColumns {
+		i, ok := columns[name]
+		if !ok {
+			return nil, errors.Errorf("environment %q does not have a column in %s", name, pi.filename)
+		}
+		selected[name] = i
+	}
+
+	return selected, nil
+}
+
+func (pi *ParamImport) validateParam(componentName, paramName string) error {
+	_, c, err := pi.resolvePathFn(pi.app, componentName)
+	if err != nil || c == nil {
+		return errors.Errorf("unknown component %q", componentName)
+	}
+
+	params, err := c.Params("")
+	if err != nil {
+		return errors.Wrapf(err, "retrieve params for component %q", componentName)
+	}
+
+	for _, p := range params {
+		if p.Key == paramName {
+			return nil
+		}
+	}
+
+	return errors.Errorf("unknown param %q for component %q", paramName, componentName)
+}
+
+func (pi *ParamImport) preview(writes []paramImportWrite) error {
+	t := table.New("paramImport", pi.out)
+	t.SetHeader([]string{"environment", "component", "param", "value"})
+
+	for _, w := range writes {
+		t.Append([]string{w.envName, w.component, w.param, w.value})
+	}
+
+	return t.Render()
+}
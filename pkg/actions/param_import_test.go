@@ -0,0 +1,170 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package actions
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	amocks "github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/ksonnet/ksonnet/pkg/component"
+	cmocks "github.com/ksonnet/ksonnet/pkg/component/mocks"
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamImport(t *testing.T) {
+	type write struct {
+		envName, component, param, value string
+	}
+
+	cases := []struct {
+		name       string
+		csv        string
+		envColumns []string
+		dryRun     bool
+		expected   []write
+		outputFile string
+		isErr      bool
+	}{
+		{
+			name: "in general",
+			csv: "component,param,default,prod\n" +
+				"guestbook,replicas,1,3\n" +
+				"guestbook,image,,\"gcr.io/guestbook:v2\"\n",
+			expected: []write{
+				{"default", "guestbook", "replicas", "1"},
+				{"prod", "guestbook", "image", "gcr.io/guestbook:v2"},
+				{"prod", "guestbook", "replicas", "3"},
+			},
+		},
+		{
+			name: "selected environment column",
+			csv: "component,param,default,prod\n" +
+				"guestbook,replicas,1,3\n",
+			envColumns: []string{"prod"},
+			expected: []write{
+				{"prod", "guestbook", "replicas", "3"},
+			},
+		},
+		{
+			name: "dry run",
+			csv: "component,param,default,prod\n" +
+				"guestbook,replicas,1,3\n",
+			dryRun:     true,
+			outputFile: "param/import/dry-run.txt",
+		},
+		{
+			name: "unknown component and param",
+			csv: "component,param,default\n" +
+				"guestbook,missing,1\n" +
+				"missing,replicas,1\n",
+			isErr: true,
+		},
+		{
+			name:  "unknown environment column",
+			csv:   "component,param,staging\n",
+			isErr: true,
+		},
+		{
+			name:  "invalid header",
+			csv:   "name,default\n",
+			isErr: true,
+		},
+		{
+			name: "inconsistent number of columns",
+			csv: "component,param,default\n" +
+				"guestbook,replicas\n",
+			isErr: true,
+		},
+		{
+			name: "selected environment without column",
+			csv: "component,param,default\n" +
+				"guestbook,replicas,1\n",
+			envColumns: []string{"prod"},
+			isErr:      true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withApp(t, func(appMock *amocks.App) {
+				envs := app.EnvironmentConfigs{
+					"default": &app.EnvironmentConfig{},
+					"prod":    &app.EnvironmentConfig{},
+				}
+				appMock.On("Environments").Return(envs, nil)
+
+				err := afero.WriteFile(appMock.Fs(), "/values.csv", []byte(tc.csv), 0644)
+				require.NoError(t, err)
+
+				in := map[string]interface{}{
+					OptionApp:      appMock,
+					OptionFilename: "/values.csv",
+					OptionEnvNames: tc.envColumns,
+					OptionDryRun:   tc.dryRun,
+				}
+
+				a, err := NewParamImport(in)
+				require.NoError(t, err)
+
+				c := &cmocks.Component{}
+				c.On("Params", "").Return([]component.ModuleParameter{
+					{Component: "guestbook", Key: "image", Value: `"gcr.io/guestbook:v1"`},
+					{Component: "guestbook", Key: "replicas", Value: "1"},
+				}, nil)
+
+				a.resolvePathFn = func(_ app.App, path string) (component.Module, component.Component, error) {
+					if path != "guestbook" {
+						return nil, nil, errors.New("not found")
+					}
+					return nil, c, nil
+				}
+
+				var got []write
+				a.setEnvFn = func(_ app.App, envName, name, pName, value string) error {
+					got = append(got, write{envName, name, pName, value})
+					return nil
+				}
+
+				var buf bytes.Buffer
+				a.out = &buf
+
+				err = a.Run()
+				if tc.isErr {
+					require.Error(t, err)
+					require.Empty(t, got)
+					return
+				}
+				require.NoError(t, err)
+
+				require.Equal(t, tc.expected, got)
+
+				if tc.outputFile != "" {
+					assertOutput(t, tc.outputFile, buf.String())
+				}
+			})
+		})
+	}
+}
+
+func TestParamImport_requires_app(t *testing.T) {
+	in := make(map[string]interface{})
+	_, err := NewParamImport(in)
+	require.Error(t, err)
+}
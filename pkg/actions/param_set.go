@@ -118,7 +118,7 @@ func (ps *ParamSet) Run() error {
 		return ps.setGlobalEnvFn(ps.app, ps.envName, ps.rawPath, value)
 	}
 
-	path := strings.Split(ps.rawPath, ".")
+	path := splitParamPath(ps.rawPath)
 
 	if ps.resolveImage {
 		s, ok := value.(string)
@@ -140,6 +140,28 @@ func (ps *ParamSet) Run() error {
 	return ps.setLocal(path, value)
 }
 
+// splitParamPath splits a dotted param path into its fields. A dot escaped
+// with a backslash is part of a field, e.g. `data.nginx\.conf` is the field
+// `nginx.conf` in `data`.
+func splitParamPath(rawPath string) []string {
+	var path []string
+	var field strings.Builder
+	for i := 0; i < len(rawPath); i++ {
+		switch {
+		case rawPath[i] == '\\' && i+1 < len(rawPath) && rawPath[i+1] == '.':
+			field.WriteByte('.')
+			i++
+		case rawPath[i] == '.':
+			path = append(path, field.String())
+			field.Reset()
+		default:
+			field.WriteByte(rawPath[i])
+		}
+	}
+
+	return append(path, field.String())
+}
+
 func (ps *ParamSet) setGlobal(path []string, value interface{}) error {
 	module, err := ps.getModuleFn(ps.app, ps.name)
 	if err != nil {
@@ -108,6 +108,48 @@ func TestParamSet_asString(t *testing.T) {
 	})
 }
 
+func TestParamSet_escaped_path(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		c := &cmocks.Component{}
+		c.On("SetParam", []string{"data", "nginx.conf"}, "@./nginx.conf").Return(nil)
+
+		in := map[string]interface{}{
+			OptionApp:   appMock,
+			OptionName:  "nginx-config",
+			OptionPath:  `data.nginx\.conf`,
+			OptionValue: "@./nginx.conf",
+		}
+
+		a, err := NewParamSet(in)
+		require.NoError(t, err)
+
+		a.resolvePathFn = func(app.App, string) (component.Module, component.Component, error) {
+			return nil, c, nil
+		}
+
+		err = a.Run()
+		require.NoError(t, err)
+	})
+}
+
+func Test_splitParamPath(t *testing.T) {
+	cases := []struct {
+		rawPath  string
+		expected []string
+	}{
+		{rawPath: "replicas", expected: []string{"replicas"}},
+		{rawPath: "a.b.c", expected: []string{"a", "b", "c"}},
+		{rawPath: `data.nginx\.conf`, expected: []string{"data", "nginx.conf"}},
+		{rawPath: `a\b.c`, expected: []string{`a\b`, "c"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.rawPath, func(t *testing.T) {
+			assert.Equal(t, tc.expected, splitParamPath(tc.rawPath))
+		})
+	}
+}
+
 func TestParamSet_resolveImage(t *testing.T) {
 	withApp(t, func(appMock *amocks.App) {
 		componentName := "deployment"
@@ -0,0 +1,115 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package actions
+
+import (
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// proxyFunc returns the proxy function of the HTTP client of actions. Without
+// a proxy URL, the proxy is taken from $HTTPS_PROXY and $HTTP_PROXY. A proxy
+// URL replaces them, but hosts matched by $NO_PROXY are still reached
+// directly.
+func proxyFunc(proxy string) (func(*http.Request) (*url.URL, error), error) {
+	if proxy == "" {
+		return http.ProxyFromEnvironment, nil
+	}
+
+	// As with $HTTP_PROXY, a proxy without a scheme is an HTTP proxy.
+	rawURL := proxy
+	if !strings.Contains(rawURL, "://") {
+		rawURL = "http://" + rawURL
+	}
+
+	proxyURL, err := url.Parse(rawURL)
+	if err != nil || proxyURL.Host == "" {
+		return nil, errors.Errorf("invalid proxy URL %q", proxy)
+	}
+
+	noProxy := noProxyRules(os.Getenv)
+
+	return func(req *http.Request) (*url.URL, error) {
+		if bypassProxy(req.URL, noProxy) {
+			return nil, nil
+		}
+		return proxyURL, nil
+	}, nil
+}
+
+// noProxyRules returns the comma separated rules of $NO_PROXY, or $no_proxy.
+func noProxyRules(getenv func(string) string) []string {
+	noProxy := getenv("NO_PROXY")
+	if noProxy == "" {
+		noProxy = getenv("no_proxy")
+	}
+
+	var rules []string
+	for _, rule := range strings.Split(noProxy, ",") {
+		if rule = strings.ToLower(strings.TrimSpace(rule)); rule != "" {
+			rules = append(rules, rule)
+		}
+	}
+
+	return rules
+}
+
+// bypassProxy reports whether a URL is reached without the proxy. As with
+// http.ProxyFromEnvironment, loopback addresses always are. A rule matches a
+// host, an IP address in a CIDR block, or a domain and its subdomains, and
+// `*` matches everything. A rule with a port only matches that port.
+func bypassProxy(u *url.URL, rules []string) bool {
+	host := strings.ToLower(u.Hostname())
+	ip := net.ParseIP(host)
+
+	if host == "localhost" || (ip != nil && ip.IsLoopback()) {
+		return true
+	}
+
+	for _, rule := range rules {
+		if rule == "*" {
+			return true
+		}
+
+		if _, cidr, err := net.ParseCIDR(rule); err == nil {
+			if ip != nil && cidr.Contains(ip) {
+				return true
+			}
+			continue
+		}
+
+		ruleHost, rulePort := rule, ""
+		if h, p, err := net.SplitHostPort(rule); err == nil {
+			ruleHost, rulePort = h, p
+		}
+
+		if rulePort != "" && rulePort != u.Port() {
+			continue
+		}
+
+		ruleHost = strings.TrimPrefix(ruleHost, ".")
+		if host == ruleHost || strings.HasSuffix(host, "."+ruleHost) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,111 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package actions
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_proxyFunc(t *testing.T) {
+	cases := []struct {
+		name     string
+		proxy    string
+		expected string
+		isErr    bool
+	}{
+		{
+			name:     "with scheme",
+			proxy:    "https://proxy.example.com:3128",
+			expected: "https://proxy.example.com:3128",
+		},
+		{
+			name:     "without scheme",
+			proxy:    "proxy.example.com:3128",
+			expected: "http://proxy.example.com:3128",
+		},
+		{
+			name:  "invalid",
+			proxy: "http://",
+			isErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fn, err := proxyFunc(tc.proxy)
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodGet, "https://raw.githubusercontent.com/kubernetes/kubernetes", nil)
+			require.NoError(t, err)
+
+			proxyURL, err := fn(req)
+			require.NoError(t, err)
+			require.NotNil(t, proxyURL)
+			assert.Equal(t, tc.expected, proxyURL.String())
+		})
+	}
+}
+
+func Test_noProxyRules(t *testing.T) {
+	env := map[string]string{
+		"no_proxy": " .example.com, 10.0.0.0/8,,Internal:8443 ",
+	}
+
+	rules := noProxyRules(func(key string) string {
+		return env[key]
+	})
+	assert.Equal(t, []string{".example.com", "10.0.0.0/8", "internal:8443"}, rules)
+}
+
+func Test_bypassProxy(t *testing.T) {
+	rules := []string{".example.com", "10.0.0.0/8", "internal:8443"}
+
+	cases := []struct {
+		url      string
+		expected bool
+	}{
+		{url: "https://example.com/spec", expected: true},
+		{url: "https://api.example.com/spec", expected: true},
+		{url: "https://example.org/spec", expected: false},
+		{url: "https://10.1.2.3:6443", expected: true},
+		{url: "https://11.1.2.3:6443", expected: false},
+		{url: "https://internal:8443", expected: true},
+		{url: "https://internal:443", expected: false},
+		{url: "http://localhost:8001", expected: true},
+		{url: "http://127.0.0.1:8001", expected: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.url, func(t *testing.T) {
+			u, err := url.Parse(tc.url)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, bypassProxy(u, rules))
+		})
+	}
+
+	u, err := url.Parse("https://example.org")
+	require.NoError(t, err)
+	assert.True(t, bypassProxy(u, []string{"*"}))
+}
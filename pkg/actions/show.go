@@ -22,6 +22,7 @@ import (
 	"github.com/ksonnet/ksonnet/pkg/app"
 	"github.com/ksonnet/ksonnet/pkg/client"
 	"github.com/ksonnet/ksonnet/pkg/cluster"
+	"github.com/pkg/errors"
 )
 
 type runShowFn func(cluster.ShowConfig, ...cluster.ShowOpts) error
@@ -40,14 +41,20 @@ type showOpt func(*Show)
 
 // Show shows objects.
 type Show struct {
-	app            app.App
-	clientConfig   *client.Config
-	componentNames []string
-	envName        string
-	format         string
-
-	out       io.Writer
-	runShowFn runShowFn
+	app              app.App
+	clientConfig     *client.Config
+	componentNames   []string
+	envName          string
+	format           string
+	object           string
+	outputDir        string
+	filenameTemplate string
+	resolveImages    bool
+	allowUnresolved  bool
+
+	out           io.Writer
+	runShowFn     runShowFn
+	syncAPISpecFn syncAPISpecFn
 }
 
 // RunShow runs `show`
@@ -55,12 +62,19 @@ func newShow(m map[string]interface{}, opts ...showOpt) (*Show, error) {
 	ol := newOptionLoader(m)
 
 	s := &Show{
-		app:            ol.LoadApp(),
-		componentNames: ol.LoadStringSlice(OptionComponentNames),
-		format:         ol.LoadString(OptionFormat),
-
-		out:       os.Stdout,
-		runShowFn: cluster.RunShow,
+		app:              ol.LoadApp(),
+		clientConfig:     ol.LoadClientConfig(),
+		componentNames:   ol.LoadStringSlice(OptionComponentNames),
+		format:           ol.LoadString(OptionFormat),
+		object:           ol.LoadOptionalString(OptionObject),
+		outputDir:        ol.LoadOptionalString(OptionOutputDir),
+		filenameTemplate: ol.LoadOptionalString(OptionFilenameTemplate),
+		resolveImages:    ol.LoadOptionalBool(OptionResolveImages),
+		allowUnresolved:  ol.LoadOptionalBool(OptionAllowUnresolved),
+
+		out:           os.Stdout,
+		runShowFn:     cluster.RunShow,
+		syncAPISpecFn: syncAPISpec,
 	}
 
 	if ol.err != nil {
@@ -79,12 +93,31 @@ func newShow(m map[string]interface{}, opts ...showOpt) (*Show, error) {
 }
 
 func (s *Show) run() error {
+	if s.filenameTemplate != "" && s.outputDir == "" {
+		return errors.New("a filename template requires an output directory")
+	}
+
+	if s.allowUnresolved && !s.resolveImages {
+		return errors.New("--allow-unresolved requires --resolve-images")
+	}
+
+	if err := s.syncAPISpecFn(s.app, s.clientConfig, s.envName); err != nil {
+		return err
+	}
+
 	config := cluster.ShowConfig{
 		App:            s.app,
 		ComponentNames: s.componentNames,
 		EnvName:        s.envName,
 		Format:         s.format,
+		Object:         s.object,
 		Out:            s.out,
+
+		OutputDir:        s.outputDir,
+		FilenameTemplate: s.filenameTemplate,
+
+		ResolveImages:         s.resolveImages,
+		AllowUnresolvedImages: s.allowUnresolved,
 	}
 
 	return s.runShowFn(config)
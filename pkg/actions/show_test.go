@@ -19,7 +19,9 @@ import (
 	"os"
 	"testing"
 
+	"github.com/ksonnet/ksonnet/pkg/app"
 	amocks "github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/ksonnet/ksonnet/pkg/client"
 	"github.com/ksonnet/ksonnet/pkg/cluster"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -53,9 +55,11 @@ func TestShow(t *testing.T) {
 
 				in := map[string]interface{}{
 					OptionApp:            appMock,
+					OptionClientConfig:   &client.Config{},
 					OptionComponentNames: []string{},
 					OptionEnvName:        tc.envName,
 					OptionFormat:         "yaml",
+					OptionObject:         "Deployment/web",
 				}
 
 				expected := cluster.ShowConfig{
@@ -63,6 +67,7 @@ func TestShow(t *testing.T) {
 					ComponentNames: []string{},
 					EnvName:        "default",
 					Format:         "yaml",
+					Object:         "Deployment/web",
 					Out:            os.Stdout,
 				}
 
@@ -71,6 +76,10 @@ func TestShow(t *testing.T) {
 						assert.Equal(t, expected, config)
 						return nil
 					}
+					a.syncAPISpecFn = func(a app.App, clientConfig *client.Config, envName string) error {
+						assert.Equal(t, "default", envName)
+						return nil
+					}
 				}
 
 				a, err := newShow(in, runShowOpt)
@@ -87,6 +96,89 @@ func TestShow(t *testing.T) {
 	}
 }
 
+func TestShow_filename_template_requires_output_dir(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		in := map[string]interface{}{
+			OptionApp:              appMock,
+			OptionClientConfig:     &client.Config{},
+			OptionComponentNames:   []string{},
+			OptionEnvName:          "default",
+			OptionFormat:           "yaml",
+			OptionFilenameTemplate: "{{.Name}}.yaml",
+		}
+
+		runShowOpt := func(a *Show) {
+			a.runShowFn = func(config cluster.ShowConfig, opts ...cluster.ShowOpts) error {
+				t.Error("objects should not be shown")
+				return nil
+			}
+		}
+
+		a, err := newShow(in, runShowOpt)
+		require.NoError(t, err)
+
+		err = a.run()
+		require.Error(t, err)
+	})
+}
+
+func TestShow_resolve_images(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		in := map[string]interface{}{
+			OptionApp:             appMock,
+			OptionClientConfig:    &client.Config{},
+			OptionComponentNames:  []string{},
+			OptionEnvName:         "default",
+			OptionFormat:          "yaml",
+			OptionResolveImages:   true,
+			OptionAllowUnresolved: true,
+		}
+
+		runShowOpt := func(a *Show) {
+			a.runShowFn = func(config cluster.ShowConfig, opts ...cluster.ShowOpts) error {
+				assert.True(t, config.ResolveImages)
+				assert.True(t, config.AllowUnresolvedImages)
+				return nil
+			}
+			a.syncAPISpecFn = func(a app.App, clientConfig *client.Config, envName string) error {
+				return nil
+			}
+		}
+
+		a, err := newShow(in, runShowOpt)
+		require.NoError(t, err)
+
+		err = a.run()
+		require.NoError(t, err)
+	})
+}
+
+func TestShow_allow_unresolved_requires_resolve_images(t *testing.T) {
+	withApp(t, func(appMock *amocks.App) {
+		in := map[string]interface{}{
+			OptionApp:             appMock,
+			OptionClientConfig:    &client.Config{},
+			OptionComponentNames:  []string{},
+			OptionEnvName:         "default",
+			OptionFormat:          "yaml",
+			OptionAllowUnresolved: true,
+		}
+
+		runShowOpt := func(a *Show) {
+			a.runShowFn = func(config cluster.ShowConfig, opts ...cluster.ShowOpts) error {
+				t.Error("objects should not be shown")
+				return nil
+			}
+		}
+
+		a, err := newShow(in, runShowOpt)
+		require.NoError(t, err)
+
+		err = a.run()
+		require.Error(t, err)
+	})
+}
+
 func TestShow_invalid_input(t *testing.T) {
 	withApp(t, func(appMock *amocks.App) {
 		in := map[string]interface{}{
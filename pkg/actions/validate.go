@@ -22,8 +22,8 @@ import (
 
 	"github.com/ksonnet/ksonnet/pkg/app"
 	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/ksonnet/ksonnet/pkg/cluster"
 	"github.com/ksonnet/ksonnet/pkg/openapi"
-	"github.com/ksonnet/ksonnet/pkg/pipeline"
 	"github.com/ksonnet/ksonnet/utils"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
@@ -79,7 +79,7 @@ func NewValidate(m map[string]interface{}) (*Validate, error) {
 		out:              os.Stdout,
 		discoveryFn:      loadDiscovery,
 		validateObjectFn: openapi.ValidateAgainstSchema,
-		findObjectsFn:    findObjects,
+		findObjectsFn:    cluster.Render,
 	}
 
 	if ol.err != nil {
@@ -130,11 +130,6 @@ func loadDiscovery(a app.App, clientConfig *client.Config, envName string) (disc
 	return d, err
 }
 
-func findObjects(a app.App, envName string, componentNames []string) ([]*unstructured.Unstructured, error) {
-	p := pipeline.New(a, envName)
-	return p.Objects(componentNames)
-}
-
 func (v *Validate) setCurrentEnv(name string) {
 	v.envName = name
 }
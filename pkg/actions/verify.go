@@ -0,0 +1,114 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package actions
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/ksonnet/ksonnet/pkg/diff"
+	"github.com/ksonnet/ksonnet/pkg/util/table"
+	"github.com/pkg/errors"
+)
+
+// RunVerify runs `verify`.
+func RunVerify(m map[string]interface{}) error {
+	v, err := NewVerify(m)
+	if err != nil {
+		return err
+	}
+
+	return v.Run()
+}
+
+// Verify compares the objects of an environment's components with the
+// objects in its cluster.
+type Verify struct {
+	app          app.App
+	envName      string
+	components   []string
+	clientConfig *client.Config
+	output       string
+	out          io.Writer
+
+	verifyFn func(a app.App, config *client.Config, components []string, envName string) ([]diff.ObjectDrift, error)
+}
+
+// NewVerify creates an instance of Verify.
+func NewVerify(m map[string]interface{}) (*Verify, error) {
+	ol := newOptionLoader(m)
+
+	v := &Verify{
+		app:          ol.LoadApp(),
+		envName:      ol.LoadString(OptionEnvName),
+		components:   ol.LoadOptionalStringSlice(OptionComponentNames),
+		clientConfig: ol.LoadClientConfig(),
+		output:       ol.LoadOptionalString(OptionOutput),
+		out:          os.Stdout,
+
+		verifyFn: diff.DefaultVerify,
+	}
+
+	if ol.err != nil {
+		return nil, ol.err
+	}
+
+	return v, nil
+}
+
+// Run lists the objects that are missing from the cluster, out of sync with
+// it, or unexpected in it. It returns ErrDiffFound if any object drifted.
+func (v *Verify) Run() error {
+	f, err := table.DetectFormat(v.output)
+	if err != nil {
+		return errors.Wrap(err, "detecting output format")
+	}
+
+	if _, err := v.app.Environment(v.envName); err != nil {
+		return err
+	}
+
+	drifts, err := v.verifyFn(v.app, v.clientConfig, v.components, v.envName)
+	if err != nil {
+		return errors.Wrapf(err, "verify environment %q", v.envName)
+	}
+
+	if len(drifts) == 0 && f == table.FormatTable {
+		fmt.Fprintf(v.out, "Environment %q is in sync with its cluster\n", v.envName)
+		return nil
+	}
+
+	t := table.New("verify", v.out)
+	t.SetFormat(f)
+	t.SetHeader([]string{"state", "apiversion", "kind", "namespace", "name"})
+
+	for _, d := range drifts {
+		t.Append([]string{d.State, d.APIVersion, d.Kind, d.Namespace, d.Name})
+	}
+
+	if err := t.Render(); err != nil {
+		return err
+	}
+
+	if len(drifts) > 0 {
+		return ErrDiffFound
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package actions
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	amocks "github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/ksonnet/ksonnet/pkg/diff"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerify(t *testing.T) {
+	drifts := []diff.ObjectDrift{
+		{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "web", Name: "frontend", State: diff.DriftOutOfSync},
+		{APIVersion: "v1", Kind: "Service", Name: "frontend", State: diff.DriftMissing},
+		{APIVersion: "v1", Kind: "ConfigMap", Namespace: "web", Name: "legacy", State: diff.DriftUnexpected},
+	}
+
+	cases := []struct {
+		name       string
+		drifts     []diff.ObjectDrift
+		output     string
+		outputFile string
+		isErr      bool
+	}{
+		{
+			name:       "drift",
+			drifts:     drifts,
+			outputFile: "verify/drift.txt",
+			isErr:      true,
+		},
+		{
+			name:       "drift as json",
+			drifts:     drifts,
+			output:     "json",
+			outputFile: "verify/drift.json",
+			isErr:      true,
+		},
+		{
+			name:       "in sync",
+			outputFile: "verify/in_sync.txt",
+		},
+		{
+			name:   "invalid output",
+			output: "csv",
+			isErr:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withApp(t, func(appMock *amocks.App) {
+				appMock.On("Environment", "prod").Return(&app.EnvironmentConfig{}, nil)
+
+				in := map[string]interface{}{
+					OptionApp:            appMock,
+					OptionEnvName:        "prod",
+					OptionComponentNames: []string{"frontend"},
+					OptionClientConfig:   &client.Config{},
+					OptionOutput:         tc.output,
+				}
+
+				a, err := NewVerify(in)
+				require.NoError(t, err)
+
+				a.verifyFn = func(a app.App, config *client.Config, components []string, envName string) ([]diff.ObjectDrift, error) {
+					assert.Equal(t, []string{"frontend"}, components)
+					assert.Equal(t, "prod", envName)
+					return tc.drifts, nil
+				}
+
+				var buf bytes.Buffer
+				a.out = &buf
+
+				err = a.Run()
+				if tc.isErr {
+					require.Error(t, err)
+				} else {
+					require.NoError(t, err)
+				}
+
+				if tc.outputFile != "" {
+					if len(tc.drifts) > 0 {
+						assert.Equal(t, ErrDiffFound, err)
+					}
+					assertOutput(t, tc.outputFile, buf.String())
+				}
+			})
+		})
+	}
+}
+
+func TestVerify_requires_app(t *testing.T) {
+	in := make(map[string]interface{})
+	_, err := NewVerify(in)
+	require.Error(t, err)
+}
@@ -20,7 +20,9 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strings"
 
+	"github.com/ghodss/yaml"
 	"github.com/pkg/errors"
 	"github.com/spf13/afero"
 )
@@ -39,6 +41,10 @@ const (
 	// LibDirName is the directory name for libraries.
 	LibDirName = "lib"
 
+	// AutoAPISpec is the api spec of environments whose Kubernetes version is
+	// detected from their cluster whenever they are applied or shown.
+	AutoAPISpec = "auto"
+
 	// currentEnvName is the file which selects the current environment.
 	currentEnvName = ".ks_environment"
 )
@@ -58,6 +64,9 @@ type App interface {
 	AddRegistry(spec *RegistryConfig, isOverride bool) error
 	// CurrentEnvironment returns the current environment name or an empty string.
 	CurrentEnvironment() string
+	// DefaultAPISpec returns the API spec of new environments when none is
+	// given, or an empty string if the app doesn't set one.
+	DefaultAPISpec() (string, error)
 	// Environment finds an environment by name.
 	Environment(name string) (*EnvironmentConfig, error)
 	// Environments returns all environments.
@@ -103,7 +112,7 @@ type App interface {
 }
 
 // Load loads the application configuration.
-func Load(fs afero.Fs, httpClient *http.Client, appRoot string) (App, error) {
+func Load(fs afero.Fs, httpClient *http.Client, appRoot string, opts ...Opt) (App, error) {
 	if fs == nil {
 		return nil, errors.New("nil fs interface")
 	}
@@ -111,13 +120,13 @@ func Load(fs afero.Fs, httpClient *http.Client, appRoot string) (App, error) {
 	_, err := fs.Stat(specPath(appRoot))
 	if os.IsNotExist(err) {
 		// During `ks init`, app.yaml will not yet exist - generate a new one.
-		return NewBaseApp(fs, appRoot, httpClient), nil
+		return NewBaseApp(fs, appRoot, httpClient, opts...), nil
 	}
 	if err != nil {
 		return nil, errors.Wrap(err, "checking existence of app configuration")
 	}
 
-	a := NewBaseApp(fs, appRoot, httpClient)
+	a := NewBaseApp(fs, appRoot, httpClient, opts...)
 	if err := a.doLoad(); err != nil {
 		return nil, errors.Wrap(err, "reading app configuration")
 	}
@@ -129,6 +138,12 @@ func app010LibPath(root string) string {
 	return filepath.Join(root, LibDirName)
 }
 
+// LibCachePath returns the path of the app's cache of generated ksonnet-libs,
+// which is keyed by Open API spec.
+func LibCachePath(root string) string {
+	return filepath.Join(root, ".ksonnet", "cache", "ksonnet-lib")
+}
+
 // StubUpdateLibData always returns no error.
 func StubUpdateLibData(fs afero.Fs, k8sSpecFlag, libPath string) (string, error) {
 	return "v1.8.7", nil
@@ -243,3 +258,83 @@ func FindRoot(fs afero.Fs, cwd string) (string, error) {
 		prev = cwd
 	}
 }
+
+// FindNamedRoot finds the ksonnet app named name, for repositories containing
+// several apps. The app containing cwd is used if it has the name, otherwise
+// the apps in the tree below cwd are searched. It is an error if no app, or
+// more than one app, has the name.
+func FindNamedRoot(fs afero.Fs, cwd, name string) (string, error) {
+	if root, err := FindRoot(fs, cwd); err == nil {
+		appName, err := readAppName(fs, root)
+		if err != nil {
+			return "", err
+		}
+
+		if appName == name {
+			return root, nil
+		}
+	}
+
+	var roots []string
+	err := afero.Walk(fs, cwd, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if fi.IsDir() {
+			// Apps aren't kept in vendored packages or hidden directories.
+			if path != cwd && (fi.Name() == "vendor" || strings.HasPrefix(fi.Name(), ".")) {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if fi.Name() != appYamlName {
+			return nil
+		}
+
+		root := filepath.Dir(path)
+		appName, err := readAppName(fs, root)
+		if err != nil {
+			return err
+		}
+
+		if appName == name {
+			roots = append(roots, root)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return "", errors.Wrapf(err, "searching for ksonnet app %q", name)
+	}
+
+	switch len(roots) {
+	case 0:
+		return "", errors.Errorf("unable to find ksonnet app %q in %s", name, cwd)
+	case 1:
+		return roots[0], nil
+	default:
+		return "", errors.Errorf("found more than one ksonnet app named %q: %s", name, strings.Join(roots, ", "))
+	}
+}
+
+// readAppName reads the name of the app at root, without loading the app.
+func readAppName(fs afero.Fs, root string) (string, error) {
+	b, err := afero.ReadFile(fs, specPath(root))
+	if err != nil {
+		return "", err
+	}
+
+	var spec struct {
+		Name string `json:"name"`
+	}
+
+	if err := yaml.Unmarshal(b, &spec); err != nil {
+		return "", errors.Wrapf(err, "reading name of app in %s", root)
+	}
+
+	return spec.Name, nil
+}
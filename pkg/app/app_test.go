@@ -99,6 +99,85 @@ func Test_findRoot(t *testing.T) {
 
 }
 
+func Test_FindNamedRoot(t *testing.T) {
+	fs := afero.NewMemMapFs()
+
+	apps := map[string]string{
+		"/repo/a":               "a",
+		"/repo/b":               "b",
+		"/repo/b/vendor/pkg":    "a",
+		"/repo/.hidden":         "a",
+		"/repo/nested/dup1":     "dup",
+		"/repo/nested/dup2":     "dup",
+		"/repo/nested/deeper/c": "c",
+	}
+
+	for root, name := range apps {
+		err := afero.WriteFile(fs, filepath.Join(root, "app.yaml"), []byte("name: "+name+"\n"), DefaultFilePermissions)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, fs.MkdirAll("/repo/b/components", DefaultFolderPermissions))
+
+	cases := []struct {
+		name     string
+		cwd      string
+		appName  string
+		expected string
+		isErr    bool
+	}{
+		{
+			name:     "below the current directory",
+			cwd:      "/repo",
+			appName:  "a",
+			expected: "/repo/a",
+		},
+		{
+			name:     "nested below the current directory",
+			cwd:      "/repo",
+			appName:  "c",
+			expected: "/repo/nested/deeper/c",
+		},
+		{
+			name:     "app containing the current directory",
+			cwd:      "/repo/b/components",
+			appName:  "b",
+			expected: "/repo/b",
+		},
+		{
+			name:    "outside of the current tree",
+			cwd:     "/repo/a",
+			appName: "b",
+			isErr:   true,
+		},
+		{
+			name:    "missing",
+			cwd:     "/repo",
+			appName: "missing",
+			isErr:   true,
+		},
+		{
+			name:    "ambiguous",
+			cwd:     "/repo",
+			appName: "dup",
+			isErr:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			root, err := FindNamedRoot(fs, tc.cwd, tc.appName)
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, root)
+		})
+	}
+}
+
 func TestApp_AddEnvironment(t *testing.T) {
 	withAppFs(t, "app010_app.yaml", func(app *baseApp) {
 		envs, err := app.Environments()
@@ -282,6 +361,35 @@ func TestApp_LibPath(t *testing.T) {
 	})
 }
 
+func TestApp_LibPath_pending(t *testing.T) {
+	withAppFs(t, "app030_app.yaml", func(app *baseApp) {
+		var generated string
+		app.libUpdater = fakeLibUpdater(func(k8sSpecFlag string, libPath string) (string, error) {
+			generated = k8sSpecFlag
+			return "v1.8.7", app.fs.MkdirAll(filepath.Join(libPath, "ksonnet-lib", "v1.8.7"), DefaultFolderPermissions)
+		})
+
+		e := &EnvironmentConfig{
+			Name:        "scratch",
+			Path:        "scratch",
+			Destination: &EnvironmentDestinationSpec{Server: "http://example.com", Namespace: "default"},
+			LibPending:  "version:v1.8.7",
+		}
+		require.NoError(t, app.AddEnvironment(e, "", false))
+
+		path, err := app.LibPath("scratch")
+		require.NoError(t, err)
+
+		require.Equal(t, "version:v1.8.7", generated)
+		require.Equal(t, filepath.Join("/", "lib", "ksonnet-lib", "v1.8.7"), path)
+
+		e, err = app.Environment("scratch")
+		require.NoError(t, err)
+		require.Equal(t, "v1.8.7", e.KubernetesVersion)
+		require.Empty(t, e.LibPending)
+	})
+}
+
 func TestApp_RemoveEnvironment(t *testing.T) {
 	withAppFs(t, "app010_app.yaml", func(app *baseApp) {
 		_, err := app.Environment("default")
@@ -365,6 +473,24 @@ func TestApp_RenameEnvironment(t *testing.T) {
 	}
 }
 
+func TestApp_RenameEnvironment_inherited(t *testing.T) {
+	withAppFs(t, "app010_app.yaml", func(app *baseApp) {
+		child, err := app.Environment("us-east/test")
+		require.NoError(t, err)
+
+		child.Inherits = "default"
+		require.NoError(t, app.AddEnvironment(child, "", false))
+
+		err = app.RenameEnvironment("default", "renamed", false)
+		require.NoError(t, err)
+
+		child, err = app.Environment("us-east/test")
+		require.NoError(t, err)
+
+		require.Equal(t, "renamed", child.Inherits)
+	})
+}
+
 func TestApp_UpdateTargets(t *testing.T) {
 	withAppFs(t, "app010_app.yaml", func(app *baseApp) {
 		err := app.UpdateTargets("default", []string{"foo"}, false)
@@ -37,6 +37,8 @@ type baseApp struct {
 
 	// LibUpdater updates ksonnet lib versions.
 	libUpdater KSLibUpdater
+	// noCache disables reusing previously generated ksonnet-lib versions.
+	noCache bool
 	// libPath caches ksonnet lib paths after generation / validation
 	libPaths map[string]string
 
@@ -61,6 +63,15 @@ func OptLibUpdater(libUpdater KSLibUpdater) Opt {
 	}
 }
 
+// OptNoCache returns an option that makes an App generate ksonnet-lib from
+// the Open API spec instead of reusing a previously generated version with
+// identical type definitions.
+func OptNoCache() Opt {
+	return func(a *baseApp) {
+		a.noCache = true
+	}
+}
+
 // optNopLoader overrides baseApp's loader to do nothing. (NOOP)
 func optNoopLoader() Opt {
 	return func(a *baseApp) {
@@ -74,12 +85,8 @@ func NewBaseApp(fs afero.Fs, root string, httpClient *http.Client, opts ...Opt)
 	ba := &baseApp{
 		fs:         fs,
 		httpClient: httpClient,
-		libUpdater: ksLibUpdater{
-			fs:         fs,
-			httpClient: httpClient,
-		},
-		root:   root,
-		config: &Spec{},
+		root:       root,
+		config:     &Spec{},
 		overrides: &Override{
 			Environments: EnvironmentConfigs{},
 			Registries:   RegistryConfigs{},
@@ -92,6 +99,15 @@ func NewBaseApp(fs afero.Fs, root string, httpClient *http.Client, opts ...Opt)
 		optFn(ba)
 	}
 
+	if ba.libUpdater == nil {
+		ba.libUpdater = ksLibUpdater{
+			fs:         fs,
+			httpClient: httpClient,
+			noCache:    ba.noCache,
+			cacheDir:   LibCachePath(root),
+		}
+	}
+
 	return ba
 }
 
@@ -186,6 +202,12 @@ func (ba *baseApp) doLoad() error {
 }
 
 func (ba *baseApp) AddRegistry(newReg *RegistryConfig, isOverride bool) error {
+	unlock, err := lockConfig(ba.fs, ba.root)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
 	if err := ba.load(); err != nil {
 		return errors.Wrap(err, "load configuration")
 	}
@@ -238,6 +260,12 @@ func libKeyByDesc(desc LibraryConfig, libs LibraryConfigs) string {
 // If spec if nil, the library reference will be removed.
 // Returns the previous reference for the named library, if one existed.
 func (ba *baseApp) UpdateLib(id string, env string, libSpec *LibraryConfig) (*LibraryConfig, error) {
+	unlock, err := lockConfig(ba.fs, ba.root)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
 	if err := ba.load(); err != nil {
 		return nil, errors.Wrap(err, "load configuration")
 	}
@@ -310,6 +338,12 @@ func updateLibInScope(desc LibraryConfig, libSpec *LibraryConfig, scope *Library
 
 // UpdateRegistry updates a registry spec and persists in app[.override].yaml
 func (ba *baseApp) UpdateRegistry(spec *RegistryConfig) error {
+	unlock, err := lockConfig(ba.fs, ba.root)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
 	if err := ba.load(); err != nil {
 		return errors.Wrap(err, "load configuration")
 	}
@@ -360,13 +394,13 @@ func (ba *baseApp) EnvironmentParams(envName string) (string, error) {
 	if envName == "" {
 		return "", errors.New("environment name is blank")
 	}
-	envParamsPath := filepath.Join(ba.Root(), EnvironmentDirName, envName, "params.libsonnet")
-	b, err := afero.ReadFile(ba.Fs(), envParamsPath)
+	envDir := filepath.Join(ba.Root(), EnvironmentDirName, envName)
+	params, err := ReadEnvironmentParams(ba.Fs(), envDir)
 	if err != nil {
 		return "", errors.Wrapf(err, "unable to read params for environment %s", envName)
 	}
 
-	return string(b), nil
+	return params, nil
 }
 
 func (ba *baseApp) VendorPath() string {
@@ -416,6 +450,28 @@ func deepCopyEnvironmentConfig(src EnvironmentConfig) *EnvironmentConfig {
 	if src.Libraries != nil {
 		e.Libraries = deepCopyLibraries(src.Libraries)
 	}
+	if src.Defaults != nil {
+		d := *src.Defaults
+		e.Defaults = &d
+	}
+	if src.Provenance != nil {
+		p := *src.Provenance
+		e.Provenance = &p
+	}
+	if src.IgnoreAnnotations != nil {
+		a := make([]EnvironmentIgnoreAnnotation, len(src.IgnoreAnnotations))
+		copy(a, src.IgnoreAnnotations)
+		e.IgnoreAnnotations = a
+	}
+	if src.IncludeComponents != nil {
+		e.IncludeComponents = append([]string{}, src.IncludeComponents...)
+	}
+	if src.ExcludeComponents != nil {
+		e.ExcludeComponents = append([]string{}, src.ExcludeComponents...)
+	}
+	if src.Tags != nil {
+		e.Tags = copyTags(src.Tags)
+	}
 
 	return &e
 }
@@ -450,16 +506,56 @@ func (ba *baseApp) mergedEnvironment(name string) *EnvironmentConfig {
 		combined := deepCopyEnvironmentConfig(*primary)
 		combined.Name = override.Name
 		combined.KubernetesVersion = override.KubernetesVersion
+		combined.LibPending = override.LibPending
 		combined.Path = override.Path
 		if override.Destination != nil {
 			d := *override.Destination
 			combined.Destination = &d
+			// Whether an environment is offline follows its destination.
+			combined.Offline = override.Offline
 		}
 		if override.Targets != nil {
 			t := make([]string, len(override.Targets))
 			copy(t, override.Targets)
 			combined.Targets = t
 		}
+		if override.NamePrefix != "" {
+			combined.NamePrefix = override.NamePrefix
+		}
+		if override.Defaults != nil {
+			d := *override.Defaults
+			combined.Defaults = &d
+		}
+		if override.Context != "" {
+			combined.Context = override.Context
+		}
+		if override.APISpec != "" {
+			combined.APISpec = override.APISpec
+		}
+		if override.ServiceAccount != "" {
+			combined.ServiceAccount = override.ServiceAccount
+		}
+		if override.Provenance != nil {
+			p := *override.Provenance
+			combined.Provenance = &p
+		}
+		if override.IgnoreAnnotations != nil {
+			a := make([]EnvironmentIgnoreAnnotation, len(override.IgnoreAnnotations))
+			copy(a, override.IgnoreAnnotations)
+			combined.IgnoreAnnotations = a
+		}
+		if override.IncludeComponents != nil {
+			combined.IncludeComponents = append([]string{}, override.IncludeComponents...)
+		}
+		if override.ExcludeComponents != nil {
+			combined.ExcludeComponents = append([]string{}, override.ExcludeComponents...)
+		}
+		if override.Inherits != "" {
+			combined.Inherits = override.Inherits
+		}
+		if override.Tags != nil {
+			combined.Tags = copyTags(override.Tags)
+		}
 		return combined
 	case hasOverride:
 		e := deepCopyEnvironmentConfig(*override)
@@ -524,10 +620,6 @@ func (ba *baseApp) AddEnvironment(newEnv *EnvironmentConfig, k8sSpecFlag string,
 		return errors.Errorf("library references not allowed in overrides")
 	}
 
-	if err := ba.load(); err != nil {
-		return errors.Wrap(err, "load configuration")
-	}
-
 	if k8sSpecFlag != "" {
 		ver, err := ba.libUpdater.UpdateKSLib(k8sSpecFlag, app010LibPath(ba.root))
 		if err != nil {
@@ -537,6 +629,24 @@ func (ba *baseApp) AddEnvironment(newEnv *EnvironmentConfig, k8sSpecFlag string,
 		newEnv.KubernetesVersion = ver
 	}
 
+	// ksonnet-lib is generated first, so other processes aren't kept waiting
+	// for the lock while it is.
+	unlock, err := lockConfig(ba.fs, ba.root)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if err := ba.load(); err != nil {
+		return errors.Wrap(err, "load configuration")
+	}
+
+	return ba.putEnvironment(newEnv, isOverride)
+}
+
+// putEnvironment sets an environment's spec and saves the app configuration.
+// The caller must hold the lock on the configuration.
+func (ba *baseApp) putEnvironment(newEnv *EnvironmentConfig, isOverride bool) error {
 	var envMap = ba.config.Environments
 	if isOverride {
 		if ba.overrides == nil {
@@ -581,8 +691,25 @@ func (ba *baseApp) Registries() (RegistryConfigs, error) {
 	return registries, nil
 }
 
+// DefaultAPISpec returns the API spec of new environments when none is given.
+func (ba *baseApp) DefaultAPISpec() (string, error) {
+	if !ba.loaded {
+		if err := ba.load(); err != nil {
+			return "", errors.Wrap(err, "load configuration")
+		}
+	}
+
+	return ba.config.DefaultAPISpec, nil
+}
+
 // RemoveEnvironment removes an environment.
 func (ba *baseApp) RemoveEnvironment(envName string, override bool) error {
+	unlock, err := lockConfig(ba.fs, ba.root)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
 	if err := ba.load(); err != nil {
 		return errors.Wrap(err, "load configuration")
 	}
@@ -603,6 +730,12 @@ func (ba *baseApp) RemoveEnvironment(envName string, override bool) error {
 
 // RenameEnvironment renames environments.
 func (ba *baseApp) RenameEnvironment(from, to string, override bool) error {
+	unlock, err := lockConfig(ba.fs, ba.root)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
 	if err := ba.load(); err != nil {
 		return errors.Wrap(err, "load configuration")
 	}
@@ -619,6 +752,19 @@ func (ba *baseApp) RenameEnvironment(from, to string, override bool) error {
 	envMap[to].Path = to
 	delete(envMap, from)
 
+	// Environments that inherit from the renamed environment follow it.
+	envMaps := []EnvironmentConfigs{ba.config.Environments}
+	if ba.overrides != nil {
+		envMaps = append(envMaps, ba.overrides.Environments)
+	}
+	for _, envs := range envMaps {
+		for _, e := range envs {
+			if e != nil && e.Inherits == from {
+				e.Inherits = to
+			}
+		}
+	}
+
 	if err := moveEnvironment(ba.fs, ba.root, from, to); err != nil {
 		return err
 	}
@@ -628,6 +774,16 @@ func (ba *baseApp) RenameEnvironment(from, to string, override bool) error {
 
 // UpdateTargets updates the list of targets. Note this overrwrite any existing targets.
 func (ba *baseApp) UpdateTargets(envName string, targets []string, isOverride bool) error {
+	unlock, err := lockConfig(ba.fs, ba.root)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if err := ba.load(); err != nil {
+		return errors.Wrap(err, "load configuration")
+	}
+
 	spec, err := ba.Environment(envName)
 	if err != nil {
 		return err
@@ -635,7 +791,7 @@ func (ba *baseApp) UpdateTargets(envName string, targets []string, isOverride bo
 
 	spec.Targets = targets
 
-	return errors.Wrap(ba.AddEnvironment(spec, "", isOverride), "update targets")
+	return errors.Wrap(ba.putEnvironment(spec, isOverride), "update targets")
 }
 
 // LibPath returns the lib path for an env environment.
@@ -649,11 +805,19 @@ func (ba *baseApp) LibPath(envName string) (string, error) {
 		return "", err
 	}
 
+	if env.LibPending != "" {
+		if err = ba.generatePendingLib(envName, env); err != nil {
+			return "", errors.Wrapf(err, "generate ksonnet-lib of environment %q", envName)
+		}
+	}
+
 	ver := fmt.Sprintf("version:%s", env.KubernetesVersion)
 	lm, err := lib.NewManager(ver, ba.fs, app010LibPath(ba.root), ba.httpClient)
 	if err != nil {
 		return "", err
 	}
+	lm.NoCache = ba.noCache
+	lm.CacheDir = LibCachePath(ba.root)
 
 	lp, err := lm.GetLibPath()
 	if err != nil {
@@ -666,6 +830,44 @@ func (ba *baseApp) LibPath(envName string) (string, error) {
 	return lp, nil
 }
 
+// generatePendingLib generates the ksonnet-lib of an environment that was
+// added without it, and records its Kubernetes version.
+func (ba *baseApp) generatePendingLib(name string, env *EnvironmentConfig) error {
+	log.Infof("Generating ksonnet-lib for environment %q", name)
+
+	ver, err := ba.libUpdater.UpdateKSLib(env.LibPending, app010LibPath(ba.root))
+	if err != nil {
+		return err
+	}
+
+	unlock, err := lockConfig(ba.fs, ba.root)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if err := ba.load(); err != nil {
+		return errors.Wrap(err, "load configuration")
+	}
+
+	envMaps := []EnvironmentConfigs{ba.config.Environments}
+	if ba.overrides != nil {
+		envMaps = append(envMaps, ba.overrides.Environments)
+	}
+
+	for _, envMap := range envMaps {
+		if e, ok := envMap[name]; ok && e != nil && e.LibPending != "" {
+			e.KubernetesVersion = ver
+			e.LibPending = ""
+		}
+	}
+
+	env.KubernetesVersion = ver
+	env.LibPending = ""
+
+	return ba.save()
+}
+
 // TODO move this to migrations somewhere
 func (ba *baseApp) checkKsonnetLib(lp string) {
 	libRoot := filepath.Join(ba.Root(), LibDirName, "ksonnet-lib")
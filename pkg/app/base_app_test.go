@@ -429,6 +429,27 @@ func Test_baseApp_load_override_invalid(t *testing.T) {
 	require.Error(t, err)
 }
 
+func Test_baseApp_DefaultAPISpec(t *testing.T) {
+	fs := afero.NewMemMapFs()
+
+	stageFile(t, fs, "app030_app.yaml", "/app.yaml")
+
+	ba := NewBaseApp(fs, "/", nil)
+
+	spec, err := ba.DefaultAPISpec()
+	require.NoError(t, err)
+	assert.Empty(t, spec)
+
+	ba.config.DefaultAPISpec = "version:v1.11.2"
+	require.NoError(t, ba.save())
+
+	ba = NewBaseApp(fs, "/", nil)
+
+	spec, err = ba.DefaultAPISpec()
+	require.NoError(t, err)
+	assert.Equal(t, "version:v1.11.2", spec)
+}
+
 func Test_baseApp_environment_override_is_merged(t *testing.T) {
 	fs := afero.NewMemMapFs()
 	ba := NewBaseApp(fs, "/", nil, optNoopLoader())
@@ -509,3 +530,34 @@ func Test_baseApp_environment_just_override(t *testing.T) {
 
 	assert.Equal(t, expected, e)
 }
+
+func Test_baseApp_OptNoCache(t *testing.T) {
+	cases := []struct {
+		name     string
+		opts     []Opt
+		expected bool
+	}{
+		{
+			name: "in general",
+			opts: []Opt{optNoopLoader()},
+		},
+		{
+			name:     "no cache",
+			opts:     []Opt{optNoopLoader(), OptNoCache()},
+			expected: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			ba := NewBaseApp(fs, "/", nil, tc.opts...)
+
+			assert.Equal(t, tc.expected, ba.noCache)
+
+			libUpdater, ok := ba.libUpdater.(ksLibUpdater)
+			require.True(t, ok)
+			assert.Equal(t, tc.expected, libUpdater.noCache)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+)
+
+const (
+	// EnvironmentParamsFile is the name of an environment's Jsonnet params file.
+	EnvironmentParamsFile = "params.libsonnet"
+	// EnvironmentParamsJSONFile is the name of an environment's JSON params file.
+	EnvironmentParamsJSONFile = "params.json"
+)
+
+// ReadEnvironmentParams returns the params source of the environment in
+// envDir. Params are read from params.libsonnet, params.json, or both. When
+// both exist, params.json is merged into the result of params.libsonnet as a
+// JSON merge patch, so its values take precedence. When only params.json
+// exists, it is merged into the component params.
+func ReadEnvironmentParams(fs afero.Fs, envDir string) (string, error) {
+	source, err := afero.ReadFile(fs, filepath.Join(envDir, EnvironmentParamsFile))
+	hasSource := err == nil
+	if err != nil && !os.IsNotExist(err) {
+		return "", err
+	}
+
+	jsonPath := filepath.Join(envDir, EnvironmentParamsJSONFile)
+	data, jsonErr := afero.ReadFile(fs, jsonPath)
+	if os.IsNotExist(jsonErr) {
+		// Return the error for the missing params.libsonnet, if any.
+		return string(source), err
+	}
+	if jsonErr != nil {
+		return "", jsonErr
+	}
+
+	var overrides map[string]interface{}
+	if err := json.Unmarshal(data, &overrides); err != nil {
+		return "", errors.Wrapf(err, "%s is not a JSON object", jsonPath)
+	}
+
+	base := `{ components: std.extVar("__ksonnet/params").components }`
+	if hasSource {
+		base = fmt.Sprintf("(\n%s\n)", source)
+	}
+
+	return fmt.Sprintf("std.mergePatch(%s, %s)\n", base, data), nil
+}
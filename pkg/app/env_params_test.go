@@ -0,0 +1,83 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadEnvironmentParams(t *testing.T) {
+	cases := []struct {
+		name      string
+		libsonnet string
+		json      string
+		expected  string
+		isErr     bool
+	}{
+		{
+			name:      "params.libsonnet",
+			libsonnet: "{ components: {} }",
+			expected:  "{ components: {} }",
+		},
+		{
+			name:      "params.libsonnet and params.json",
+			libsonnet: "{ components: {} }",
+			json:      `{"components":{"a":{"b":1}}}`,
+			expected:  "std.mergePatch((\n{ components: {} }\n), {\"components\":{\"a\":{\"b\":1}}})\n",
+		},
+		{
+			name:     "params.json",
+			json:     `{"components":{"a":{"b":1}}}`,
+			expected: "std.mergePatch({ components: std.extVar(\"__ksonnet/params\").components }, {\"components\":{\"a\":{\"b\":1}}})\n",
+		},
+		{
+			name:  "params.json is not an object",
+			json:  `[1]`,
+			isErr: true,
+		},
+		{
+			name:  "no params",
+			isErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			require.NoError(t, fs.MkdirAll("/env", 0755))
+
+			if tc.libsonnet != "" {
+				require.NoError(t, afero.WriteFile(fs, "/env/params.libsonnet", []byte(tc.libsonnet), 0644))
+			}
+			if tc.json != "" {
+				require.NoError(t, afero.WriteFile(fs, "/env/params.json", []byte(tc.json), 0644))
+			}
+
+			got, err := ReadEnvironmentParams(fs, "/env")
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
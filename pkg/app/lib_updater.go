@@ -32,6 +32,8 @@ type KSLibUpdater interface {
 type ksLibUpdater struct {
 	fs         afero.Fs
 	httpClient *http.Client
+	noCache    bool
+	cacheDir   string
 }
 
 // Implements KSLibUpdater
@@ -41,6 +43,9 @@ func (k ksLibUpdater) UpdateKSLib(k8sSpecFlag string, libPath string) (string, e
 		return "", err
 	}
 
+	lm.NoCache = k.noCache
+	lm.CacheDir = k.cacheDir
+
 	if err := lm.GenerateLibData(); err != nil {
 		return "", err
 	}
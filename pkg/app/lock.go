@@ -0,0 +1,62 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package app
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+)
+
+// configLockPath returns the path of the file that is locked while app.yaml
+// is read, changed and written.
+func configLockPath(root string) string {
+	return filepath.Join(root, ".ksonnet", "app.yaml.lock")
+}
+
+// lockConfig takes an exclusive lock on the app's configuration, so ks
+// processes changing app.yaml in parallel, e.g. scripted environment adds,
+// don't lose each other's changes. It blocks until the lock is available.
+// The returned func releases the lock. Files that aren't on the OS file
+// system, such as in tests, aren't locked.
+func lockConfig(fs afero.Fs, root string) (func(), error) {
+	path := configLockPath(root)
+	if err := fs.MkdirAll(filepath.Dir(path), DefaultFolderPermissions); err != nil {
+		return nil, errors.Wrap(err, "create app config lock")
+	}
+
+	f, err := fs.OpenFile(path, os.O_CREATE|os.O_RDWR, DefaultFilePermissions)
+	if err != nil {
+		return nil, errors.Wrap(err, "open app config lock")
+	}
+
+	osFile, ok := f.(*os.File)
+	if !ok {
+		return func() { f.Close() }, nil
+	}
+
+	if err := lockFile(osFile); err != nil {
+		f.Close()
+		return nil, errors.Wrap(err, "lock app config")
+	}
+
+	return func() {
+		unlockFile(osFile)
+		f.Close()
+	}, nil
+}
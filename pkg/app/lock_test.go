@@ -0,0 +1,146 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package app
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestApp_AddEnvironment_parallel(t *testing.T) {
+	withAppFs(t, "app010_app.yaml", func(app *baseApp) {
+		envs, err := app.Environments()
+		require.NoError(t, err)
+
+		envLen := len(envs)
+
+		const adds = 20
+		errs := make(chan error, adds)
+
+		var wg sync.WaitGroup
+		for i := 0; i < adds; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+
+				// Each environment is added by its own app, like scripted
+				// `ks env add` processes.
+				a := newSlowApp(app)
+
+				name := fmt.Sprintf("env-%d", i)
+				errs <- a.AddEnvironment(&EnvironmentConfig{
+					Name: name,
+					Destination: &EnvironmentDestinationSpec{
+						Namespace: "default",
+						Server:    "http://example.com",
+					},
+					Path: name,
+				}, "version:v1.8.7", false)
+			}(i)
+		}
+
+		wg.Wait()
+		close(errs)
+
+		for err := range errs {
+			require.NoError(t, err)
+		}
+
+		reloaded := NewBaseApp(app.fs, app.root, nil)
+		envs, err = reloaded.Environments()
+		require.NoError(t, err)
+		require.Len(t, envs, envLen+adds)
+	})
+}
+
+func TestApp_config_parallel(t *testing.T) {
+	withAppFs(t, "app010_app.yaml", func(app *baseApp) {
+		const adds = 10
+		errs := make(chan error, 2*adds+1)
+
+		var wg sync.WaitGroup
+		for i := 0; i < adds; i++ {
+			wg.Add(2)
+			go func(i int) {
+				defer wg.Done()
+
+				name := fmt.Sprintf("env-%d", i)
+				errs <- newSlowApp(app).AddEnvironment(&EnvironmentConfig{
+					Name: name,
+					Destination: &EnvironmentDestinationSpec{
+						Namespace: "default",
+						Server:    "http://example.com",
+					},
+					Path: name,
+				}, "", false)
+			}(i)
+			go func(i int) {
+				defer wg.Done()
+
+				errs <- newSlowApp(app).AddRegistry(&RegistryConfig{
+					Name:     fmt.Sprintf("registry-%d", i),
+					Protocol: "github",
+					URI:      "github.com/ksonnet/parts/tree/master/incubator",
+				}, false)
+			}(i)
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- newSlowApp(app).UpdateTargets("default", []string{"components"}, false)
+		}()
+
+		wg.Wait()
+		close(errs)
+
+		for err := range errs {
+			require.NoError(t, err)
+		}
+
+		reloaded := NewBaseApp(app.fs, app.root, nil)
+
+		envs, err := reloaded.Environments()
+		require.NoError(t, err)
+		for i := 0; i < adds; i++ {
+			require.Contains(t, envs, fmt.Sprintf("env-%d", i))
+		}
+		require.Equal(t, []string{"components"}, envs["default"].Targets)
+
+		registries, err := reloaded.Registries()
+		require.NoError(t, err)
+		for i := 0; i < adds; i++ {
+			require.Contains(t, registries, fmt.Sprintf("registry-%d", i))
+		}
+	})
+}
+
+// newSlowApp returns an app for the same directory as app, that waits after
+// reading app.yaml to widen the window for concurrent changes.
+func newSlowApp(app *baseApp) *baseApp {
+	a := NewBaseApp(app.fs, app.root, nil, OptLibUpdater(app.libUpdater))
+	a.load = func() error {
+		err := a.doLoad()
+		time.Sleep(10 * time.Millisecond)
+		return err
+	}
+
+	return a
+}
@@ -68,6 +68,27 @@ func (_m *App) CurrentEnvironment() string {
 	return r0
 }
 
+// DefaultAPISpec provides a mock function with given fields:
+func (_m *App) DefaultAPISpec() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Environment provides a mock function with given fields: name
 func (_m *App) Environment(name string) (*app.EnvironmentConfig030, error) {
 	ret := _m.Called(name)
@@ -26,6 +26,8 @@ import (
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/afero"
+
+	utilio "github.com/ksonnet/ksonnet/pkg/util/io"
 )
 
 const (
@@ -89,6 +91,20 @@ type EnvironmentConfig = EnvironmentConfig030
 // address that the environment points to.
 type EnvironmentDestinationSpec = EnvironmentDestinationSpec030
 
+// EnvironmentSchema is the effective configuration of an environment, as
+// exported for auditing.
+type EnvironmentSchema = EnvironmentSchema030
+
+// EnvironmentDefaults contains the sizing defaults for an environment.
+type EnvironmentDefaults = EnvironmentDefaults030
+
+// EnvironmentProvenance records how an environment was created.
+type EnvironmentProvenance = EnvironmentProvenance030
+
+// EnvironmentIgnoreAnnotation is an annotation set on selected objects of an
+// environment.
+type EnvironmentIgnoreAnnotation = EnvironmentIgnoreAnnotation030
+
 // LibraryConfig is the specification for a library part.
 type LibraryConfig = LibraryConfig030
 
@@ -153,7 +169,7 @@ func write(fs afero.Fs, appRoot string, spec *Spec) error {
 	}
 
 	log.Debugf("writing %s", specPath(appRoot))
-	if err = afero.WriteFile(fs, specPath(appRoot), appConfig, DefaultFilePermissions); err != nil {
+	if err = utilio.WriteFileAtomic(fs, specPath(appRoot), appConfig, DefaultFilePermissions); err != nil {
 		return errors.Wrap(err, "write app.yaml")
 	}
 
@@ -16,14 +16,18 @@
 package app
 
 import (
+	"crypto/sha256"
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	"github.com/blang/semver"
 	"github.com/ghodss/yaml"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 // Spec030 defines all the ksonnet project metadata. This includes details such as
@@ -43,6 +47,9 @@ type Spec030 struct {
 	Environments EnvironmentConfigs030 `json:"environments,omitempty"`
 	Libraries    LibraryConfigs030     `json:"libraries,omitempty"`
 	License      string                `json:"license,omitempty"`
+	// DefaultAPISpec is the API spec of new environments, as
+	// version:<version>, file:<path> or url:<url>, when none is given.
+	DefaultAPISpec string `json:"defaultAPISpec,omitempty"`
 }
 
 // RepositorySpec030 defines the spec for the upstream repository of this project.
@@ -116,6 +123,8 @@ func (e *EnvironmentConfigs030) UnmarshalJSON(b []byte) error {
 }
 
 // EnvironmentConfig030 contains the specification for ksonnet environments.
+// Optional fields also have yaml tags with omitempty, since `ks env describe`
+// encodes environments with gopkg.in/yaml, which ignores json tags.
 type EnvironmentConfig030 struct {
 	// Name is the user defined name of an environment
 	Name string `json:"-"`
@@ -132,6 +141,266 @@ type EnvironmentConfig030 struct {
 	Targets []string `json:"targets,omitempty"`
 	// Libraries specifies versioned libraries specifically used by this environment.
 	Libraries LibraryConfigs030 `json:"libraries,omitempty"`
+	// NamePrefix is prepended to the names of all objects deployed to this
+	// environment.
+	NamePrefix string `json:"namePrefix,omitempty" yaml:"nameprefix,omitempty"`
+	// Defaults are sizing values set on objects deployed to this environment
+	// that don't specify them.
+	Defaults *EnvironmentDefaults030 `json:"defaults,omitempty" yaml:"defaults,omitempty"`
+	// Context is the name of the kubeconfig context the environment was
+	// created from.
+	Context string `json:"context,omitempty" yaml:"context,omitempty"`
+	// APISpec is "auto" if the Kubernetes version of the environment is
+	// detected from its cluster.
+	APISpec string `json:"apiSpec,omitempty" yaml:"apispec,omitempty"`
+	// ServiceAccount is the service account of pods deployed to this
+	// environment that don't specify one.
+	ServiceAccount string `json:"serviceAccount,omitempty" yaml:"serviceaccount,omitempty"`
+	// Provenance records who created the environment, when, and how.
+	Provenance *EnvironmentProvenance030 `json:"provenance,omitempty" yaml:"provenance,omitempty"`
+	// Offline is true if the environment was created without a cluster, and
+	// its destination is a placeholder.
+	Offline bool `json:"offline,omitempty" yaml:"offline,omitempty"`
+	// IgnoreAnnotations are annotations set on objects deployed to this
+	// environment, so external controllers such as GitOps tools ignore them.
+	IgnoreAnnotations []EnvironmentIgnoreAnnotation030 `json:"ignoreAnnotations,omitempty" yaml:"ignoreannotations,omitempty"`
+	// IncludeComponents are the only components deployed to this environment.
+	// All components are deployed if it is empty.
+	IncludeComponents []string `json:"includeComponents,omitempty" yaml:"includecomponents,omitempty"`
+	// ExcludeComponents are components that are never deployed to this
+	// environment, even if they are included.
+	ExcludeComponents []string `json:"excludeComponents,omitempty" yaml:"excludecomponents,omitempty"`
+	// Inherits is the name of the environment whose parameters are used for
+	// components that this environment doesn't set parameters for.
+	Inherits string `json:"inherits,omitempty" yaml:"inherits,omitempty"`
+	// LibPending is the API spec of the environment's ksonnet-lib if it
+	// hasn't been generated yet. The lib is generated the first time it is
+	// needed.
+	LibPending string `json:"libPending,omitempty" yaml:"libpending,omitempty"`
+	// Tags are key/value pairs that group environments.
+	Tags map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+}
+
+// IncludesComponent returns true if a component is in the scope of the
+// environment set by its included and excluded components.
+func (e *EnvironmentConfig030) IncludesComponent(name string) bool {
+	for _, excluded := range e.ExcludeComponents {
+		if excluded == name {
+			return false
+		}
+	}
+
+	if len(e.IncludeComponents) == 0 {
+		return true
+	}
+
+	for _, included := range e.IncludeComponents {
+		if included == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// UpdateTags changes the tags of the environment. A change is either
+// <key>=<value>, which sets a tag, or <key>-, which removes it. Keys and values
+// follow the rules of Kubernetes labels.
+func (e *EnvironmentConfig030) UpdateTags(changes []string) error {
+	tags := copyTags(e.Tags)
+	if tags == nil {
+		tags = make(map[string]string)
+	}
+
+	for _, change := range changes {
+		if strings.HasSuffix(change, "-") && !strings.Contains(change, "=") {
+			key := strings.TrimSuffix(change, "-")
+			if err := validateTagKey(key); err != nil {
+				return err
+			}
+			delete(tags, key)
+			continue
+		}
+
+		key, value, err := parseTag(change)
+		if err != nil {
+			return err
+		}
+		tags[key] = value
+	}
+
+	if len(tags) == 0 {
+		tags = nil
+	}
+	e.Tags = tags
+
+	return nil
+}
+
+// MatchesTags returns true if the environment has all of the tags in filter.
+func (e *EnvironmentConfig030) MatchesTags(filter map[string]string) bool {
+	for key, value := range filter {
+		if v, ok := e.Tags[key]; !ok || v != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ParseTagFilter parses tags given as <key>=<value>, which environments must
+// all have to match.
+func ParseTagFilter(tags []string) (map[string]string, error) {
+	filter := make(map[string]string)
+	for _, tag := range tags {
+		key, value, err := parseTag(tag)
+		if err != nil {
+			return nil, err
+		}
+
+		if v, ok := filter[key]; ok && v != value {
+			return nil, errors.Errorf("tag %q is filtered by more than one value", key)
+		}
+		filter[key] = value
+	}
+
+	return filter, nil
+}
+
+// parseTag parses a tag given as <key>=<value>.
+func parseTag(tag string) (string, string, error) {
+	parts := strings.SplitN(tag, "=", 2)
+	if len(parts) != 2 {
+		return "", "", errors.Errorf("tag %q is not <key>=<value>", tag)
+	}
+
+	key, value := parts[0], parts[1]
+	if err := validateTagKey(key); err != nil {
+		return "", "", err
+	}
+
+	if msgs := validation.IsValidLabelValue(value); len(msgs) > 0 {
+		return "", "", errors.Errorf("invalid value of tag %q: %s", key, strings.Join(msgs, "; "))
+	}
+
+	return key, value, nil
+}
+
+func validateTagKey(key string) error {
+	if msgs := validation.IsQualifiedName(key); len(msgs) > 0 {
+		return errors.Errorf("invalid tag key %q: %s", key, strings.Join(msgs, "; "))
+	}
+
+	return nil
+}
+
+func copyTags(tags map[string]string) map[string]string {
+	if tags == nil {
+		return nil
+	}
+
+	c := make(map[string]string, len(tags))
+	for k, v := range tags {
+		c[k] = v
+	}
+	return c
+}
+
+// InheritanceChain returns the environments the named environment inherits
+// parameters from, starting with the one it names. It returns an error if one
+// of them doesn't exist, or if the environments inherit from each other.
+func (e EnvironmentConfigs030) InheritanceChain(name string) ([]string, error) {
+	if e[name] == nil {
+		return nil, errors.Errorf("environment %q does not exist", name)
+	}
+
+	var chain []string
+	visited := []string{name}
+	for current := name; e[current].Inherits != ""; {
+		parent := e[current].Inherits
+
+		for i, visitedName := range visited {
+			if visitedName == parent {
+				cycle := append(visited[i:], parent)
+				return nil, errors.Errorf("environments inherit from each other: %s", strings.Join(cycle, " -> "))
+			}
+		}
+
+		if e[parent] == nil {
+			return nil, errors.Errorf("environment %q inherits from %q, which does not exist", current, parent)
+		}
+
+		chain = append(chain, parent)
+		visited = append(visited, parent)
+		current = parent
+	}
+
+	return chain, nil
+}
+
+// EnvironmentSchema030 is the effective configuration of an environment, as
+// exported for auditing. Its fields are marshaled in a fixed order, so exports
+// can be diffed.
+type EnvironmentSchema030 struct {
+	// Name is the name of the environment.
+	Name string `json:"name"`
+	// Server is the Kubernetes server the environment deploys to.
+	Server string `json:"server"`
+	// Namespace is the namespace the environment deploys to.
+	Namespace string `json:"namespace"`
+	// ServerCertFingerprint is the SHA-256 fingerprint of the certificate
+	// authority the server is verified with, if the environment has one.
+	ServerCertFingerprint string `json:"serverCertFingerprint,omitempty"`
+	// Inherits are the environments the environment inherits parameters from,
+	// starting with its parent.
+	Inherits []string `json:"inherits,omitempty"`
+	// KubernetesVersion is the Kubernetes version of the environment's
+	// ksonnet-lib.
+	KubernetesVersion string `json:"k8sVersion"`
+	// APISpec is "auto" if the Kubernetes version is detected from the
+	// cluster.
+	APISpec string `json:"apiSpec,omitempty"`
+}
+
+// Schema returns the effective configuration of the environment. inherits
+// are the environments it inherits parameters from.
+func (e *EnvironmentConfig030) Schema(inherits []string) (*EnvironmentSchema030, error) {
+	schema := &EnvironmentSchema030{
+		Name:              e.Name,
+		Inherits:          inherits,
+		KubernetesVersion: e.KubernetesVersion,
+		APISpec:           e.APISpec,
+	}
+
+	if e.Destination == nil {
+		return schema, nil
+	}
+
+	schema.Server = e.Destination.Server
+	schema.Namespace = e.Destination.Namespace
+
+	if e.Destination.ServerCert != "" {
+		block, _ := pem.Decode([]byte(e.Destination.ServerCert))
+		if block == nil {
+			return nil, errors.Errorf("server certificate of environment %q is not PEM-encoded", e.Name)
+		}
+		schema.ServerCertFingerprint = fingerprint(block.Bytes)
+	}
+
+	return schema, nil
+}
+
+// fingerprint returns the SHA-256 fingerprint of data as colon separated hex
+// bytes.
+func fingerprint(data []byte) string {
+	sum := sha256.Sum256(data)
+
+	parts := make([]string, len(sum))
+	for i, b := range sum {
+		parts[i] = fmt.Sprintf("%02X", b)
+	}
+
+	return "SHA256:" + strings.Join(parts, ":")
 }
 
 // MakePath return the absolute path to the environment directory.
@@ -150,6 +419,56 @@ type EnvironmentDestinationSpec030 struct {
 	// Namespace is the namespace of the Kubernetes server that targets should
 	// be deployed to. This is "default", if not specified.
 	Namespace string `json:"namespace"`
+	// ServerCert is the PEM-encoded certificate of the authority that signed
+	// the server's certificate. It is the server cert that uniquely identifies
+	// the cluster, and is used to verify the server when it isn't in the
+	// kubeconfig.
+	ServerCert string `json:"serverCert,omitempty"`
+}
+
+// EnvironmentDefaults030 contains the sizing and availability defaults for an
+// environment. A zero value means there is no default.
+type EnvironmentDefaults030 struct {
+	// Replicas is the replica count of workloads.
+	Replicas int64 `json:"replicas,omitempty"`
+	// HPAMinReplicas is the minimum replica count of horizontal pod autoscalers.
+	HPAMinReplicas int64 `json:"hpaMinReplicas,omitempty"`
+	// HPAMaxReplicas is the maximum replica count of horizontal pod autoscalers.
+	HPAMaxReplicas int64 `json:"hpaMaxReplicas,omitempty"`
+	// PDBMinAvailable is the minAvailable, a count or a percentage, of the pod
+	// disruption budgets generated for workloads.
+	PDBMinAvailable string `json:"pdbMinAvailable,omitempty"`
+	// PDBMaxUnavailable is the maxUnavailable, a count or a percentage, of the
+	// pod disruption budgets generated for workloads.
+	PDBMaxUnavailable string `json:"pdbMaxUnavailable,omitempty"`
+}
+
+// EnvironmentProvenance030 records how an environment was created.
+type EnvironmentProvenance030 struct {
+	// User is the user who created the environment.
+	User string `json:"user,omitempty"`
+	// Timestamp is the time the environment was created, in RFC 3339 format.
+	Timestamp string `json:"timestamp,omitempty"`
+	// Command is the command line that created the environment.
+	Command string `json:"command,omitempty"`
+	// Source is where the destination of the environment was resolved from.
+	Source string `json:"source,omitempty"`
+}
+
+// EnvironmentIgnoreAnnotation030 is an annotation set on the objects of an
+// environment that match its Kind, Name, and Selector. Empty fields match
+// all objects.
+type EnvironmentIgnoreAnnotation030 struct {
+	// Key is the key of the annotation.
+	Key string `json:"key"`
+	// Value is the value of the annotation.
+	Value string `json:"value"`
+	// Kind limits the annotation to objects of this kind.
+	Kind string `json:"kind,omitempty"`
+	// Name limits the annotation to objects with this name.
+	Name string `json:"name,omitempty"`
+	// Selector limits the annotation to objects matching this label selector.
+	Selector string `json:"selector,omitempty"`
 }
 
 // LibraryConfig030 is the specification for a library part.
@@ -419,6 +419,248 @@ func TestEnvironmentSpec_MakePath(t *testing.T) {
 	require.Equal(t, expected, got)
 }
 
+func TestEnvironmentConfig_Schema(t *testing.T) {
+	cases := []struct {
+		name     string
+		env      EnvironmentConfig
+		inherits []string
+		expected *EnvironmentSchema
+		isErr    bool
+	}{
+		{
+			name: "in general",
+			env: EnvironmentConfig{
+				Name:              "us-west/staging",
+				KubernetesVersion: "v1.10.3",
+				APISpec:           "auto",
+				Destination: &EnvironmentDestinationSpec{
+					Server:     "https://staging.example.com",
+					Namespace:  "web",
+					ServerCert: "-----BEGIN CERTIFICATE-----\nY2VydA==\n-----END CERTIFICATE-----\n",
+				},
+			},
+			inherits: []string{"us-west/base", "base"},
+			expected: &EnvironmentSchema{
+				Name:                  "us-west/staging",
+				Server:                "https://staging.example.com",
+				Namespace:             "web",
+				ServerCertFingerprint: "SHA256:06:29:84:32:E8:06:6B:29:E2:22:3B:CC:23:AA:95:04:B5:6A:E5:08:FA:BF:34:35:50:88:69:B9:C3:19:0E:22",
+				Inherits:              []string{"us-west/base", "base"},
+				KubernetesVersion:     "v1.10.3",
+				APISpec:               "auto",
+			},
+		},
+		{
+			name: "no server cert",
+			env: EnvironmentConfig{
+				Name:              "default",
+				KubernetesVersion: "v1.10.3",
+				Destination: &EnvironmentDestinationSpec{
+					Server:    "https://default.example.com",
+					Namespace: "default",
+				},
+			},
+			expected: &EnvironmentSchema{
+				Name:              "default",
+				Server:            "https://default.example.com",
+				Namespace:         "default",
+				KubernetesVersion: "v1.10.3",
+			},
+		},
+		{
+			name: "server cert is not PEM-encoded",
+			env: EnvironmentConfig{
+				Name: "default",
+				Destination: &EnvironmentDestinationSpec{
+					Server:     "https://default.example.com",
+					ServerCert: "cert",
+				},
+			},
+			isErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.env.Schema(tc.inherits)
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestEnvironmentConfig_UpdateTags(t *testing.T) {
+	cases := []struct {
+		name     string
+		tags     map[string]string
+		changes  []string
+		expected map[string]string
+		isErr    bool
+	}{
+		{
+			name:     "add tags",
+			changes:  []string{"region=us-west", "tier=prod"},
+			expected: map[string]string{"region": "us-west", "tier": "prod"},
+		},
+		{
+			name:     "replace tag",
+			tags:     map[string]string{"tier": "staging"},
+			changes:  []string{"tier=prod"},
+			expected: map[string]string{"tier": "prod"},
+		},
+		{
+			name:     "remove tag",
+			tags:     map[string]string{"region": "us-west", "tier": "prod"},
+			changes:  []string{"region-"},
+			expected: map[string]string{"tier": "prod"},
+		},
+		{
+			name:    "remove last tag",
+			tags:    map[string]string{"region": "us-west"},
+			changes: []string{"region-"},
+		},
+		{
+			name:    "value ending with a dash",
+			changes: []string{"suffix=a-"},
+			isErr:   true,
+		},
+		{
+			name:    "missing value",
+			changes: []string{"region"},
+			isErr:   true,
+		},
+		{
+			name:    "invalid key",
+			changes: []string{"re gion=us-west"},
+			isErr:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := EnvironmentConfig{Name: "default", Tags: tc.tags}
+
+			err := e.UpdateTags(tc.changes)
+			if tc.isErr {
+				require.Error(t, err)
+				require.Equal(t, tc.tags, e.Tags)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expected, e.Tags)
+		})
+	}
+}
+
+func TestEnvironmentConfig_MatchesTags(t *testing.T) {
+	e := EnvironmentConfig{Tags: map[string]string{"region": "us-west", "tier": "prod"}}
+
+	filter, err := ParseTagFilter([]string{"region=us-west", "tier=prod"})
+	require.NoError(t, err)
+	assert.True(t, e.MatchesTags(filter))
+
+	filter, err = ParseTagFilter([]string{"tier=staging"})
+	require.NoError(t, err)
+	assert.False(t, e.MatchesTags(filter))
+
+	filter, err = ParseTagFilter([]string{"team=web"})
+	require.NoError(t, err)
+	assert.False(t, e.MatchesTags(filter))
+
+	assert.True(t, e.MatchesTags(nil))
+
+	_, err = ParseTagFilter([]string{"tier=prod", "tier=staging"})
+	require.Error(t, err)
+}
+
+func TestEnvironmentConfigs_InheritanceChain(t *testing.T) {
+	cases := []struct {
+		name     string
+		envs     EnvironmentConfigs
+		envName  string
+		expected []string
+		errMsg   string
+	}{
+		{
+			name: "no parent",
+			envs: EnvironmentConfigs{
+				"prod": &EnvironmentConfig{Name: "prod"},
+			},
+			envName: "prod",
+		},
+		{
+			name: "grandparent",
+			envs: EnvironmentConfigs{
+				"base":   &EnvironmentConfig{Name: "base"},
+				"prod":   &EnvironmentConfig{Name: "prod", Inherits: "base"},
+				"canary": &EnvironmentConfig{Name: "canary", Inherits: "prod"},
+			},
+			envName:  "canary",
+			expected: []string{"prod", "base"},
+		},
+		{
+			name: "missing parent",
+			envs: EnvironmentConfigs{
+				"prod": &EnvironmentConfig{Name: "prod", Inherits: "base"},
+			},
+			envName: "prod",
+			errMsg:  `environment "prod" inherits from "base", which does not exist`,
+		},
+		{
+			name: "cycle",
+			envs: EnvironmentConfigs{
+				"base": &EnvironmentConfig{Name: "base", Inherits: "prod"},
+				"prod": &EnvironmentConfig{Name: "prod", Inherits: "base"},
+			},
+			envName: "prod",
+			errMsg:  "environments inherit from each other: prod -> base -> prod",
+		},
+		{
+			name: "cycle above the environment",
+			envs: EnvironmentConfigs{
+				"a":    &EnvironmentConfig{Name: "a", Inherits: "b"},
+				"b":    &EnvironmentConfig{Name: "b", Inherits: "a"},
+				"prod": &EnvironmentConfig{Name: "prod", Inherits: "a"},
+			},
+			envName: "prod",
+			errMsg:  "environments inherit from each other: a -> b -> a",
+		},
+		{
+			name: "inherits from itself",
+			envs: EnvironmentConfigs{
+				"prod": &EnvironmentConfig{Name: "prod", Inherits: "prod"},
+			},
+			envName: "prod",
+			errMsg:  "environments inherit from each other: prod -> prod",
+		},
+		{
+			name:    "missing environment",
+			envs:    EnvironmentConfigs{},
+			envName: "prod",
+			errMsg:  `environment "prod" does not exist`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			chain, err := tc.envs.InheritanceChain(tc.envName)
+			if tc.errMsg != "" {
+				require.EqualError(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expected, chain)
+		})
+	}
+}
+
 // Test that RegistryConfigs are properly deserialized, specifically
 // their Name fields, which are handler by custom UnmarshalJSON code.
 func TestUnmarshalRegistryConfigs(t *testing.T) {
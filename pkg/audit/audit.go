@@ -0,0 +1,165 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+// Package audit records the changes ksonnet makes to apps and clusters as a
+// structured trail of events, written to a configurable sink. It is separate
+// from logging: an event is written for every mutating operation, whatever
+// the log level.
+package audit
+
+import (
+	"os/user"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Object actions recorded for the objects an operation changes.
+const (
+	ActionCreated          = "created"
+	ActionUpdated          = "updated"
+	ActionDeleted          = "deleted"
+	ActionGarbageCollected = "garbage-collected"
+)
+
+// Event is the record of a mutating operation.
+type Event struct {
+	Timestamp   time.Time         `json:"timestamp"`
+	User        string            `json:"user"`
+	Operation   string            `json:"operation"`
+	Environment string            `json:"environment,omitempty"`
+	Details     map[string]string `json:"details,omitempty"`
+	Objects     []Object          `json:"objects,omitempty"`
+	Error       string            `json:"error,omitempty"`
+}
+
+// Object is a change an operation made to a cluster object.
+type Object struct {
+	Action     string `json:"action"`
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+}
+
+// Operation collects the event of a mutating operation while it runs.
+type Operation struct {
+	sink Sink
+
+	mu    sync.Mutex
+	event Event
+}
+
+var (
+	currentMu sync.Mutex
+	current   *Operation
+
+	nowFn         = time.Now
+	currentUserFn = func() (string, error) {
+		u, err := user.Current()
+		if err != nil {
+			return "", err
+		}
+		return u.Username, nil
+	}
+)
+
+// Begin starts recording an operation, which is written to sink once it
+// finishes. Changes recorded with RecordObject and SetEnvironment until then
+// are part of the operation.
+func Begin(sink Sink, operation, environment string, details map[string]string) *Operation {
+	userName, err := currentUserFn()
+	if err != nil {
+		userName = "unknown"
+	}
+
+	op := &Operation{
+		sink: sink,
+		event: Event{
+			Timestamp:   nowFn().UTC(),
+			User:        userName,
+			Operation:   operation,
+			Environment: environment,
+			Details:     details,
+		},
+	}
+
+	currentMu.Lock()
+	current = op
+	currentMu.Unlock()
+
+	return op
+}
+
+// Finish writes the operation's event to its sink. The error the operation
+// failed with, if any, is recorded with the changes it made before failing.
+func (op *Operation) Finish(opErr error) error {
+	currentMu.Lock()
+	if current == op {
+		current = nil
+	}
+	currentMu.Unlock()
+
+	op.mu.Lock()
+	defer op.mu.Unlock()
+
+	if opErr != nil {
+		op.event.Error = opErr.Error()
+	}
+
+	if err := op.sink.Write(op.event); err != nil {
+		return errors.Wrap(err, "writing audit event")
+	}
+
+	return nil
+}
+
+// SetEnvironment sets the environment of the operation being recorded, for
+// operations that resolve it after they start. It does nothing if no
+// operation is being recorded.
+func SetEnvironment(name string) {
+	withCurrent(func(op *Operation) {
+		op.event.Environment = name
+	})
+}
+
+// RecordObject records a change to a cluster object in the operation being
+// recorded. It does nothing if no operation is being recorded.
+func RecordObject(action, apiVersion, kind, namespace, name string) {
+	withCurrent(func(op *Operation) {
+		op.event.Objects = append(op.event.Objects, Object{
+			Action:     action,
+			APIVersion: apiVersion,
+			Kind:       kind,
+			Namespace:  namespace,
+			Name:       name,
+		})
+	})
+}
+
+func withCurrent(fn func(*Operation)) {
+	currentMu.Lock()
+	op := current
+	currentMu.Unlock()
+
+	if op == nil {
+		return
+	}
+
+	op.mu.Lock()
+	defer op.mu.Unlock()
+	fn(op)
+}
@@ -0,0 +1,91 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package audit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSink struct {
+	events []Event
+	err    error
+}
+
+func (s *fakeSink) Write(e Event) error {
+	s.events = append(s.events, e)
+	return s.err
+}
+
+func withClock(t *testing.T, fn func(now time.Time)) {
+	ogNow, ogUser := nowFn, currentUserFn
+	defer func() {
+		nowFn, currentUserFn = ogNow, ogUser
+	}()
+
+	now := time.Date(2018, 6, 1, 12, 0, 0, 0, time.UTC)
+	nowFn = func() time.Time { return now }
+	currentUserFn = func() (string, error) { return "alice", nil }
+
+	fn(now)
+}
+
+func TestOperation(t *testing.T) {
+	withClock(t, func(now time.Time) {
+		sink := &fakeSink{}
+
+		op := Begin(sink, "apply", "", map[string]string{"gc-tag": "web"})
+		SetEnvironment("prod")
+		RecordObject(ActionCreated, "apps/v1", "Deployment", "web", "web")
+		RecordObject(ActionGarbageCollected, "v1", "Service", "web", "old")
+
+		err := op.Finish(errors.New("apply failed"))
+		require.NoError(t, err)
+
+		// changes after the operation finished are not recorded
+		RecordObject(ActionDeleted, "v1", "Service", "web", "web")
+
+		expected := []Event{
+			{
+				Timestamp:   now,
+				User:        "alice",
+				Operation:   "apply",
+				Environment: "prod",
+				Details:     map[string]string{"gc-tag": "web"},
+				Objects: []Object{
+					{Action: ActionCreated, APIVersion: "apps/v1", Kind: "Deployment", Namespace: "web", Name: "web"},
+					{Action: ActionGarbageCollected, APIVersion: "v1", Kind: "Service", Namespace: "web", Name: "old"},
+				},
+				Error: "apply failed",
+			},
+		}
+		assert.Equal(t, expected, sink.events)
+	})
+}
+
+func TestOperation_sink_error(t *testing.T) {
+	withClock(t, func(now time.Time) {
+		sink := &fakeSink{err: errors.New("disk full")}
+
+		op := Begin(sink, "env rm", "prod", nil)
+		err := op.Finish(nil)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,129 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package audit
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// Sink receives audit events.
+type Sink interface {
+	Write(e Event) error
+}
+
+// SinkFactory creates a sink from the argument of a sink specification, which
+// is the part after its kind and a colon.
+type SinkFactory func(arg string) (Sink, error)
+
+var (
+	sinkFactoriesMu sync.Mutex
+	sinkFactories   = map[string]SinkFactory{
+		"stdout": func(arg string) (Sink, error) {
+			return NewJSONSink(os.Stdout), nil
+		},
+		"file": func(arg string) (Sink, error) {
+			if arg == "" {
+				return nil, errors.New("file audit sink requires a path, as file:<path>")
+			}
+			return NewFileSink(arg), nil
+		},
+	}
+)
+
+// RegisterSink registers a kind of sink, so it can be selected with a sink
+// specification of <kind>[:<arg>].
+func RegisterSink(kind string, factory SinkFactory) {
+	sinkFactoriesMu.Lock()
+	defer sinkFactoriesMu.Unlock()
+
+	sinkFactories[kind] = factory
+}
+
+// NewSink creates the sink for a specification in the form <kind>[:<arg>],
+// e.g. "stdout" or "file:/var/log/ks-audit.json".
+func NewSink(spec string) (Sink, error) {
+	kind, arg := spec, ""
+	if i := strings.Index(spec, ":"); i != -1 {
+		kind, arg = spec[:i], spec[i+1:]
+	}
+
+	sinkFactoriesMu.Lock()
+	factory, ok := sinkFactories[kind]
+	var kinds []string
+	for k := range sinkFactories {
+		kinds = append(kinds, k)
+	}
+	sinkFactoriesMu.Unlock()
+
+	if !ok {
+		sort.Strings(kinds)
+		return nil, errors.Errorf("unknown audit sink %q; available sinks are: %s", kind, strings.Join(kinds, ", "))
+	}
+
+	return factory(arg)
+}
+
+// JSONSink writes each event as a line of JSON.
+type JSONSink struct {
+	w io.Writer
+}
+
+var _ Sink = (*JSONSink)(nil)
+
+// NewJSONSink creates an instance of JSONSink.
+func NewJSONSink(w io.Writer) *JSONSink {
+	return &JSONSink{w: w}
+}
+
+// Write writes an event.
+func (s *JSONSink) Write(e Event) error {
+	return json.NewEncoder(s.w).Encode(e)
+}
+
+// FileSink appends each event as a line of JSON to a file, which is created
+// if it doesn't exist. The file is only readable by its owner.
+type FileSink struct {
+	path string
+}
+
+var _ Sink = (*FileSink)(nil)
+
+// NewFileSink creates an instance of FileSink.
+func NewFileSink(path string) *FileSink {
+	return &FileSink{path: path}
+}
+
+// Write appends an event to the file.
+func (s *FileSink) Write(e Event) error {
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+
+	if err = NewJSONSink(f).Write(e); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
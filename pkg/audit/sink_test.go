@@ -0,0 +1,119 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package audit
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONSink(t *testing.T) {
+	var buf bytes.Buffer
+	s := NewJSONSink(&buf)
+
+	e := Event{
+		Timestamp: time.Date(2018, 6, 1, 12, 0, 0, 0, time.UTC),
+		User:      "alice",
+		Operation: "delete",
+		Objects: []Object{
+			{Action: ActionDeleted, APIVersion: "v1", Kind: "Service", Name: "web"},
+		},
+	}
+	require.NoError(t, s.Write(e))
+
+	expected := `{"timestamp":"2018-06-01T12:00:00Z","user":"alice","operation":"delete",` +
+		`"objects":[{"action":"deleted","apiVersion":"v1","kind":"Service","name":"web"}]}` + "\n"
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestFileSink(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "audit.json")
+	s := NewFileSink(path)
+
+	require.NoError(t, s.Write(Event{Operation: "env add"}))
+	require.NoError(t, s.Write(Event{Operation: "env rm"}))
+
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+
+	lines := bytes.Split(bytes.TrimSpace(data), []byte("\n"))
+	require.Len(t, lines, 2)
+	assert.Contains(t, string(lines[0]), `"operation":"env add"`)
+	assert.Contains(t, string(lines[1]), `"operation":"env rm"`)
+}
+
+func TestNewSink(t *testing.T) {
+	RegisterSink("test", func(arg string) (Sink, error) {
+		return &fakeSink{}, nil
+	})
+
+	cases := []struct {
+		name     string
+		spec     string
+		expected Sink
+		isErr    bool
+	}{
+		{
+			name:     "stdout",
+			spec:     "stdout",
+			expected: NewJSONSink(os.Stdout),
+		},
+		{
+			name:     "file",
+			spec:     "file:/var/log/ks-audit.json",
+			expected: NewFileSink("/var/log/ks-audit.json"),
+		},
+		{
+			name:  "file without a path",
+			spec:  "file",
+			isErr: true,
+		},
+		{
+			name:     "registered sink",
+			spec:     "test:anything",
+			expected: &fakeSink{},
+		},
+		{
+			name:  "unknown sink",
+			spec:  "syslog",
+			isErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := NewSink(tc.spec)
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
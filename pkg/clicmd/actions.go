@@ -16,8 +16,14 @@
 package clicmd
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/ksonnet/ksonnet/pkg/actions"
+	"github.com/ksonnet/ksonnet/pkg/audit"
 	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 )
 
@@ -25,24 +31,40 @@ type initName int
 
 const (
 	actionApply initName = iota
+	actionCompleteEnvNames
 	actionComponentList
 	actionComponentRm
 	actionDelete
 	actionDiff
 	actionEnvAdd
+	actionEnvAddFromFile
+	actionEnvCheckContexts
+	actionEnvClone
 	actionEnvCurrent
+	actionEnvDedupeLibs
 	actionEnvDescribe
+	actionEnvDiff
+	actionEnvExec
+	actionEnvInitFromScratch
 	actionEnvList
+	actionEnvPing
+	actionEnvPruneEmpty
+	actionEnvRestore
 	actionEnvRm
 	actionEnvSet
+	actionEnvShowDiffLib
 	actionEnvTargets
 	actionEnvUpdate
+	actionEnvUpgrade
+	actionEnvValidateAll
+	actionEnvVerifyLib
 	actionImport
 	actionInit
 	actionModuleCreate
 	actionModuleList
 	actionParamDelete
 	actionParamDiff
+	actionParamImport
 	actionParamList
 	actionParamSet
 	actionParamUnset
@@ -62,63 +84,203 @@ const (
 	actionShow
 	actionUpgrade
 	actionValidate
+	actionVerify
 )
 
 type actionFn func(map[string]interface{}) error
 
 var (
 	actionFns = map[initName]actionFn{
-		actionApply:             actions.RunApply,
-		actionComponentList:     actions.RunComponentList,
-		actionComponentRm:       actions.RunComponentRm,
-		actionDelete:            actions.RunDelete,
-		actionDiff:              actions.RunDiff,
-		actionEnvAdd:            actions.RunEnvAdd,
-		actionEnvCurrent:        actions.RunEnvCurrent,
-		actionEnvDescribe:       actions.RunEnvDescribe,
-		actionEnvList:           actions.RunEnvList,
-		actionEnvRm:             actions.RunEnvRm,
-		actionEnvSet:            actions.RunEnvSet,
-		actionEnvTargets:        actions.RunEnvTargets,
-		actionEnvUpdate:         actions.RunEnvUpdate,
-		actionImport:            actions.RunImport,
-		actionInit:              actions.RunInit,
-		actionModuleCreate:      actions.RunModuleCreate,
-		actionModuleList:        actions.RunModuleList,
-		actionParamDiff:         actions.RunParamDiff,
-		actionParamDelete:       actions.RunParamDelete,
-		actionParamUnset:        actions.RunParamDelete,
-		actionParamList:         actions.RunParamList,
-		actionParamSet:          actions.RunParamSet,
-		actionPkgDescribe:       actions.RunPkgDescribe,
-		actionPkgInstall:        actions.RunPkgInstall,
-		actionPkgList:           actions.RunPkgList,
-		actionPkgRemove:         actions.RunPkgRemove,
-		actionPrototypeDescribe: actions.RunPrototypeDescribe,
-		actionPrototypeList:     actions.RunPrototypeList,
-		actionPrototypePreview:  actions.RunPrototypePreview,
-		actionPrototypeSearch:   actions.RunPrototypeSearch,
-		actionPrototypeUse:      actions.RunPrototypeUse,
-		actionRegistryAdd:       actions.RunRegistryAdd,
-		actionRegistryDescribe:  actions.RunRegistryDescribe,
-		actionRegistryList:      actions.RunRegistryList,
-		actionRegistrySet:       actions.RunRegistrySet,
-		actionShow:              actions.RunShow,
-		actionUpgrade:           actions.RunUpgrade,
-		actionValidate:          actions.RunValidate,
+		actionApply:              actions.RunApply,
+		actionCompleteEnvNames:   actions.RunCompleteEnvNames,
+		actionComponentList:      actions.RunComponentList,
+		actionComponentRm:        actions.RunComponentRm,
+		actionDelete:             actions.RunDelete,
+		actionDiff:               actions.RunDiff,
+		actionEnvAdd:             actions.RunEnvAdd,
+		actionEnvAddFromFile:     actions.RunEnvAddFromFile,
+		actionEnvCurrent:         actions.RunEnvCurrent,
+		actionEnvDedupeLibs:      actions.RunEnvDedupeLibs,
+		actionEnvDescribe:        actions.RunEnvDescribe,
+		actionEnvDiff:            actions.RunEnvDiff,
+		actionEnvExec:            actions.RunEnvExec,
+		actionEnvCheckContexts:   actions.RunEnvCheckContexts,
+		actionEnvClone:           actions.RunEnvClone,
+		actionEnvInitFromScratch: actions.RunEnvInitFromScratch,
+		actionEnvList:            actions.RunEnvList,
+		actionEnvPing:            actions.RunEnvPing,
+		actionEnvPruneEmpty:      actions.RunEnvPruneEmpty,
+		actionEnvRestore:         actions.RunEnvRestore,
+		actionEnvRm:              actions.RunEnvRm,
+		actionEnvSet:             actions.RunEnvSet,
+		actionEnvShowDiffLib:     actions.RunEnvShowDiffLib,
+		actionEnvTargets:         actions.RunEnvTargets,
+		actionEnvUpdate:          actions.RunEnvUpdate,
+		actionEnvUpgrade:         actions.RunEnvUpgrade,
+		actionEnvValidateAll:     actions.RunEnvValidateAll,
+		actionEnvVerifyLib:       actions.RunEnvVerifyLib,
+		actionImport:             actions.RunImport,
+		actionInit:               actions.RunInit,
+		actionModuleCreate:       actions.RunModuleCreate,
+		actionModuleList:         actions.RunModuleList,
+		actionParamDiff:          actions.RunParamDiff,
+		actionParamImport:        actions.RunParamImport,
+		actionParamDelete:        actions.RunParamDelete,
+		actionParamUnset:         actions.RunParamDelete,
+		actionParamList:          actions.RunParamList,
+		actionParamSet:           actions.RunParamSet,
+		actionPkgDescribe:        actions.RunPkgDescribe,
+		actionPkgInstall:         actions.RunPkgInstall,
+		actionPkgList:            actions.RunPkgList,
+		actionPkgRemove:          actions.RunPkgRemove,
+		actionPrototypeDescribe:  actions.RunPrototypeDescribe,
+		actionPrototypeList:      actions.RunPrototypeList,
+		actionPrototypePreview:   actions.RunPrototypePreview,
+		actionPrototypeSearch:    actions.RunPrototypeSearch,
+		actionPrototypeUse:       actions.RunPrototypeUse,
+		actionRegistryAdd:        actions.RunRegistryAdd,
+		actionRegistryDescribe:   actions.RunRegistryDescribe,
+		actionRegistryList:       actions.RunRegistryList,
+		actionRegistrySet:        actions.RunRegistrySet,
+		actionShow:               actions.RunShow,
+		actionUpgrade:            actions.RunUpgrade,
+		actionValidate:           actions.RunValidate,
+		actionVerify:             actions.RunVerify,
 	}
 )
 
+// auditedActions are the actions that change apps or clusters, and the
+// operations they are recorded as in the audit log.
+var auditedActions = map[initName]string{
+	actionApply:              "apply",
+	actionComponentRm:        "component rm",
+	actionDelete:             "delete",
+	actionEnvAdd:             "env add",
+	actionEnvAddFromFile:     "env add",
+	actionEnvClone:           "env clone",
+	actionEnvDedupeLibs:      "env dedupe-libs",
+	actionEnvInitFromScratch: "env init-from-scratch",
+	actionEnvPruneEmpty:      "env prune-empty",
+	actionEnvRestore:         "env restore",
+	actionEnvRm:              "env rm",
+	actionEnvSet:             "env set",
+	actionEnvTargets:         "env targets",
+	actionEnvUpdate:          "env update",
+	actionEnvUpgrade:         "env upgrade",
+	actionImport:             "import",
+	actionInit:               "init",
+	actionModuleCreate:       "module create",
+	actionParamDelete:        "param delete",
+	actionParamImport:        "param import",
+	actionParamSet:           "param set",
+	actionParamUnset:         "param unset",
+	actionPkgInstall:         "pkg install",
+	actionPkgRemove:          "pkg remove",
+	actionPrototypeUse:       "prototype use",
+	actionRegistryAdd:        "registry add",
+	actionRegistrySet:        "registry set",
+	actionUpgrade:            "upgrade",
+}
+
+// auditIgnoredOptions are options which aren't recorded as details of
+// audited operations.
+var auditIgnoredOptions = map[string]bool{
+	actions.OptionApp:           true,
+	actions.OptionAppName:       true,
+	actions.OptionAppRoot:       true,
+	actions.OptionClientConfig:  true,
+	actions.OptionEnvName:       true,
+	actions.OptionNoCache:       true,
+	actions.OptionProxy:         true,
+	actions.OptionReadOnly:      true,
+	actions.OptionTLSSkipVerify: true,
+}
+
 func runAction(name initName, args map[string]interface{}) error {
 	fn, ok := actionFns[name]
 	if !ok {
 		return errors.Errorf("invalid action %q", name)
 	}
 
-	return fn(args)
+	operation, ok := auditedActions[name]
+	spec := viper.GetString(flagAuditLog)
+	if !ok || spec == "" {
+		return fn(args)
+	}
+
+	if dryRun, _ := args[actions.OptionDryRun].(bool); dryRun {
+		return fn(args)
+	}
+
+	// Actions with an apply option only report what they would change
+	// without it.
+	if apply, ok := args[actions.OptionApply].(bool); ok && !apply {
+		return fn(args)
+	}
+
+	sink, err := audit.NewSink(spec)
+	if err != nil {
+		return err
+	}
+
+	envName, _ := args[actions.OptionEnvName].(string)
+	op := audit.Begin(sink, operation, envName, auditDetails(args))
+
+	err = fn(args)
+	if auditErr := op.Finish(err); auditErr != nil {
+		if err != nil {
+			log.Error(auditErr)
+			return err
+		}
+		return auditErr
+	}
+
+	return err
+}
+
+// auditDetails returns the options of an action that were set, as details of
+// its audit event.
+func auditDetails(args map[string]interface{}) map[string]string {
+	details := make(map[string]string)
+	for k, v := range args {
+		if auditIgnoredOptions[k] {
+			continue
+		}
+
+		switch t := v.(type) {
+		case string:
+			if t != "" {
+				details[k] = t
+			}
+		case bool:
+			if t {
+				details[k] = "true"
+			}
+		case int:
+			if t != 0 {
+				details[k] = fmt.Sprint(t)
+			}
+		case []string:
+			if len(t) > 0 {
+				sorted := append([]string(nil), t...)
+				sort.Strings(sorted)
+				details[k] = strings.Join(sorted, ",")
+			}
+		}
+	}
+
+	if len(details) == 0 {
+		return nil
+	}
+
+	return details
 }
 
 func addGlobalOptions(m map[string]interface{}) {
 	m[actions.OptionTLSSkipVerify] = viper.GetBool(flagTLSSkipVerify)
+	m[actions.OptionProxy] = viper.GetString(flagProxy)
 	m[actions.OptionAppRoot] = viper.GetString(flagDir)
+	m[actions.OptionAppName] = viper.GetString(flagAppName)
+	m[actions.OptionReadOnly] = viper.GetBool(flagReadOnly)
+	m[actions.OptionNoCache] = viper.GetBool(flagNoCache)
 }
@@ -0,0 +1,158 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package clicmd
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/actions"
+	"github.com/ksonnet/ksonnet/pkg/audit"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_runAction_audit(t *testing.T) {
+	cases := []struct {
+		name      string
+		action    initName
+		args      map[string]interface{}
+		actionErr error
+		expected  *audit.Event
+	}{
+		{
+			name:   "mutating action",
+			action: actionEnvRm,
+			args: map[string]interface{}{
+				actions.OptionApp:      nil,
+				actions.OptionEnvName:  "prod",
+				actions.OptionOverride: true,
+				actions.OptionReadOnly: false,
+			},
+			expected: &audit.Event{
+				Operation:   "env rm",
+				Environment: "prod",
+				Details:     map[string]string{actions.OptionOverride: "true"},
+			},
+		},
+		{
+			name:   "failed action",
+			action: actionApply,
+			args: map[string]interface{}{
+				actions.OptionEnvName:        "prod",
+				actions.OptionComponentNames: []string{"web", "db"},
+			},
+			actionErr: errors.New("apply failed"),
+			expected: &audit.Event{
+				Operation:   "apply",
+				Environment: "prod",
+				Details:     map[string]string{actions.OptionComponentNames: "db,web"},
+				Error:       "apply failed",
+			},
+		},
+		{
+			name:   "dry run",
+			action: actionApply,
+			args: map[string]interface{}{
+				actions.OptionEnvName: "prod",
+				actions.OptionDryRun:  true,
+			},
+		},
+		{
+			name:   "report without apply",
+			action: actionEnvDedupeLibs,
+			args: map[string]interface{}{
+				actions.OptionApply: false,
+			},
+		},
+		{
+			name:   "apply",
+			action: actionEnvDedupeLibs,
+			args: map[string]interface{}{
+				actions.OptionApply: true,
+			},
+			expected: &audit.Event{
+				Operation: "env dedupe-libs",
+				Details:   map[string]string{actions.OptionApply: "true"},
+			},
+		},
+		{
+			name:   "read only action",
+			action: actionShow,
+			args: map[string]interface{}{
+				actions.OptionEnvName: "prod",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "audit")
+			require.NoError(t, err)
+			defer os.RemoveAll(dir)
+
+			path := filepath.Join(dir, "audit.json")
+			viper.Set(flagAuditLog, "file:"+path)
+			defer viper.Set(flagAuditLog, "")
+
+			override := func(map[string]interface{}) error {
+				return tc.actionErr
+			}
+
+			withCmd(tc.action, override, func() {
+				err = runAction(tc.action, tc.args)
+			})
+			require.Equal(t, tc.actionErr, err)
+
+			data, err := ioutil.ReadFile(path)
+			if tc.expected == nil {
+				require.True(t, os.IsNotExist(err), "no audit event should be written")
+				return
+			}
+			require.NoError(t, err)
+
+			var got audit.Event
+			require.NoError(t, json.Unmarshal(data, &got))
+
+			assert.NotEmpty(t, got.User)
+			assert.False(t, got.Timestamp.IsZero())
+			assert.Equal(t, tc.expected.Operation, got.Operation)
+			assert.Equal(t, tc.expected.Environment, got.Environment)
+			assert.Equal(t, tc.expected.Details, got.Details)
+			assert.Equal(t, tc.expected.Error, got.Error)
+		})
+	}
+}
+
+func Test_runAction_invalid_audit_sink(t *testing.T) {
+	viper.Set(flagAuditLog, "syslog")
+	defer viper.Set(flagAuditLog, "")
+
+	override := func(map[string]interface{}) error {
+		t.Error("action should not run")
+		return nil
+	}
+
+	withCmd(actionEnvRm, override, func() {
+		err := runAction(actionEnvRm, map[string]interface{}{actions.OptionEnvName: "prod"})
+		require.Error(t, err)
+	})
+}
@@ -16,6 +16,8 @@
 package clicmd
 
 import (
+	"time"
+
 	"github.com/ksonnet/ksonnet/pkg/actions"
 	"github.com/ksonnet/ksonnet/pkg/client"
 	"github.com/pkg/errors"
@@ -25,11 +27,19 @@ import (
 )
 
 const (
-	vApplyComponent = "apply-components"
-	vApplyCreate    = "apply-create"
-	vApplyGcTag     = "apply-gc-tag"
-	vApplyDryRun    = "apply-dry-run"
-	vApplySkipGc    = "apply-skip-gc"
+	vApplyComponent  = "apply-components"
+	vApplyCreate     = "apply-create"
+	vApplyGcTag      = "apply-gc-tag"
+	vApplyDryRun     = "apply-dry-run"
+	vApplyFromPatch  = "apply-from-patch"
+	vApplySkipGc     = "apply-skip-gc"
+	vApplyParallel   = "apply-object-parallelism"
+	vApplySelector   = "apply-selector"
+	vApplyResolve    = "apply-resolve-images"
+	vApplyUnresolved = "apply-allow-unresolved"
+	vApplyUnmanaged  = "apply-fail-on-unmanaged"
+	vApplyWait       = "apply-wait"
+	vApplyTimeout    = "apply-timeout"
 
 	applyShortDesc = "Apply local Kubernetes manifests (components) to remote clusters"
 	applyLong      = `
@@ -42,7 +52,37 @@ in your app's ` + "`components/`" + ` directory. When applied, the manifests are
 expanded using the parameters of the specified environment.
 
 By default, all component manifests are applied. To apply a subset of components,
-use the ` + "`--component` " + `flag, as seen in the examples below.
+use the ` + "`--component` " + `flag, as seen in the examples below. To apply only
+the objects carrying certain labels, use the ` + "`--selector`" + ` flag. Objects that
+don't match the selector are skipped, and are not garbage collected.
+
+To apply exactly the changes that were reviewed elsewhere, e.g. across a security
+boundary, use the ` + "`--from-patch`" + ` flag with a file written by ` + "`ks diff --output=patch`" + `.
+The patches are applied instead of rendering the components. Every patch is
+checked against the cluster before any is applied: objects to create must not
+exist yet, and objects to patch must exist. Patches that were already applied are
+skipped.
+
+Every object that ` + "`apply`" + ` creates or updates is labeled
+` + "`app.kubernetes.io/deploy-manager=ksonnet`" + `. ` + "`ks diff`" + ` and garbage collection only
+consider objects with this label. If other tools create objects whose names
+clash with your components, use ` + "`--fail-on-unmanaged`" + ` to fail instead of updating
+an existing object that doesn't carry the label. Objects applied before the
+failing object are not rolled back.
+
+By default, ` + "`apply`" + ` returns as soon as the cluster has accepted the objects. To
+gate a CI pipeline on the rollout instead, use ` + "`--wait`" + `: the Deployments,
+StatefulSets and Pods that were applied are polled on the environment's cluster
+until they are ready. A Deployment is ready once its rollout is complete, a
+StatefulSet once all of its replicas are ready and updated (only ready with the
+` + "`OnDelete`" + ` update strategy), and a Pod once it is ready or has succeeded. If
+they aren't ready within ` + "`--timeout`" + `, the command fails and lists the objects
+that aren't ready and why. It fails right away if a Pod has failed. ` + "`--wait`" + ` can't be
+combined with ` + "`--from-patch`" + `.
+
+If the environment's api spec was set to ` + "`auto`" + ` with ` + "`ks env set`" + `, the
+Kubernetes version of its cluster is detected first, and ksonnet-lib is
+regenerated if the version changed.
 
 Note that this command needs to be run *within* a ksonnet app directory.
 
@@ -79,6 +119,32 @@ ks apply dev -c guestbook-ui
 # This essentially deploys 'components/guestbook-ui.jsonnet' and
 # 'components/nginx-depl.jsonnet'.
 ks apply dev -c guestbook-ui -c nginx-depl --create false
+
+# Create or update all resources in the 'dev' environment, applying up to 10
+# objects at once. Objects that others are known to depend on, such as
+# namespaces, are still applied first.
+ks apply dev --object-parallelism=10
+
+# Create or update only the objects in the 'dev' environment that are labeled
+# 'tier=frontend'.
+ks apply dev --selector=tier=frontend
+
+# Apply the patches that 'ks diff prod --output=patch' wrote to 'patches.json',
+# without rendering the components of the 'prod' environment.
+ks apply prod --from-patch=patches.json
+
+# Create or update all resources in the 'prod' environment, with the image tags
+# of their containers pinned to the digests the tags currently point to.
+# Registries are logged into with the credentials in your docker config file.
+ks apply prod --resolve-images
+
+# Create or update all resources in the 'prod' environment, but fail rather than
+# update an existing object that wasn't created by ksonnet.
+ks apply prod --fail-on-unmanaged
+
+# Create or update all resources in the 'prod' environment, and wait up to 10
+# minutes for its Deployments, StatefulSets and Pods to be ready.
+ks apply prod --wait --timeout=10m
 `
 )
 
@@ -95,13 +161,21 @@ func newApplyCmd(fs afero.Fs) *cobra.Command {
 			}
 
 			m := map[string]interface{}{
-				actions.OptionClientConfig:   applyClientConfig,
-				actions.OptionComponentNames: viper.GetStringSlice(vApplyComponent),
-				actions.OptionCreate:         viper.GetBool(vApplyCreate),
-				actions.OptionDryRun:         viper.GetBool(vApplyDryRun),
-				actions.OptionEnvName:        envName,
-				actions.OptionGcTag:          viper.GetString(vApplyGcTag),
-				actions.OptionSkipGc:         viper.GetBool(vApplySkipGc),
+				actions.OptionClientConfig:      applyClientConfig,
+				actions.OptionComponentNames:    viper.GetStringSlice(vApplyComponent),
+				actions.OptionCreate:            viper.GetBool(vApplyCreate),
+				actions.OptionDryRun:            viper.GetBool(vApplyDryRun),
+				actions.OptionEnvName:           envName,
+				actions.OptionFromPatch:         viper.GetString(vApplyFromPatch),
+				actions.OptionGcTag:             viper.GetString(vApplyGcTag),
+				actions.OptionSkipGc:            viper.GetBool(vApplySkipGc),
+				actions.OptionObjectParallelism: viper.GetInt(vApplyParallel),
+				actions.OptionSelector:          viper.GetString(vApplySelector),
+				actions.OptionResolveImages:     viper.GetBool(vApplyResolve),
+				actions.OptionAllowUnresolved:   viper.GetBool(vApplyUnresolved),
+				actions.OptionFailOnUnmanaged:   viper.GetBool(vApplyUnmanaged),
+				actions.OptionWait:              viper.GetBool(vApplyWait),
+				actions.OptionTimeout:           viper.GetDuration(vApplyTimeout),
 			}
 			addGlobalOptions(m)
 
@@ -130,6 +204,30 @@ func newApplyCmd(fs afero.Fs) *cobra.Command {
 	applyCmd.Flags().String(flagGcTag, "", "A tag that's (1) added to all updated objects (2) used to garbage collect existing objects that are no longer in the manifest")
 	viper.BindPFlag(vApplyGcTag, applyCmd.Flags().Lookup(flagGcTag))
 
+	applyCmd.Flags().Int(flagObjectParallelism, 1, "Number of objects to apply at once. Objects are only applied at once if they have no known dependencies on each other")
+	viper.BindPFlag(vApplyParallel, applyCmd.Flags().Lookup(flagObjectParallelism))
+
+	applyCmd.Flags().String(flagSelector, "", "Apply only objects whose labels match this selector, e.g. tier=frontend")
+	viper.BindPFlag(vApplySelector, applyCmd.Flags().Lookup(flagSelector))
+
+	applyCmd.Flags().String(flagFromPatch, "", "Apply the patches in this file, written by 'ks diff --output=patch', instead of the components")
+	viper.BindPFlag(vApplyFromPatch, applyCmd.Flags().Lookup(flagFromPatch))
+
+	applyCmd.Flags().Bool(flagResolveImages, false, "Pin the image tags of containers to the digests they currently point to in their registries")
+	viper.BindPFlag(vApplyResolve, applyCmd.Flags().Lookup(flagResolveImages))
+
+	applyCmd.Flags().Bool(flagAllowUnresolved, false, "With --resolve-images, keep the tags of images whose digests can't be resolved instead of failing")
+	viper.BindPFlag(vApplyUnresolved, applyCmd.Flags().Lookup(flagAllowUnresolved))
+
+	applyCmd.Flags().Bool(flagFailOnUnmanaged, false, "Fail instead of updating existing objects that don't carry ksonnet's deploy manager label")
+	viper.BindPFlag(vApplyUnmanaged, applyCmd.Flags().Lookup(flagFailOnUnmanaged))
+
+	applyCmd.Flags().Bool(flagWait, false, "Wait for the applied Deployments, StatefulSets and Pods to be ready")
+	viper.BindPFlag(vApplyWait, applyCmd.Flags().Lookup(flagWait))
+
+	applyCmd.Flags().Duration(flagTimeout, 5*time.Minute, "Time to wait for objects to be ready with --wait")
+	viper.BindPFlag(vApplyTimeout, applyCmd.Flags().Lookup(flagTimeout))
+
 	applyCmd.Flags().Bool(flagDryRun, false, "Option to preview the list of operations without changing the cluster state")
 	viper.BindPFlag(vApplyDryRun, applyCmd.Flags().Lookup(flagDryRun))
 
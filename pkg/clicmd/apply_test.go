@@ -16,7 +16,9 @@
 package clicmd
 
 import (
+	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 
@@ -30,14 +32,161 @@ func Test_applyCmd(t *testing.T) {
 			args:   []string{"apply", "default"},
 			action: actionApply,
 			expected: map[string]interface{}{
-				actions.OptionApp:            mock.AnythingOfType("*app.App"),
-				actions.OptionEnvName:        "default",
-				actions.OptionGcTag:          "",
-				actions.OptionSkipGc:         false,
-				actions.OptionComponentNames: make([]string, 0),
-				actions.OptionCreate:         true,
-				actions.OptionDryRun:         false,
-				actions.OptionClientConfig:   mock.AnythingOfType("*client.Config"),
+				actions.OptionApp:               mock.AnythingOfType("*app.App"),
+				actions.OptionEnvName:           "default",
+				actions.OptionGcTag:             "",
+				actions.OptionSkipGc:            false,
+				actions.OptionComponentNames:    make([]string, 0),
+				actions.OptionCreate:            true,
+				actions.OptionDryRun:            false,
+				actions.OptionFromPatch:         "",
+				actions.OptionClientConfig:      mock.AnythingOfType("*client.Config"),
+				actions.OptionObjectParallelism: 1,
+				actions.OptionSelector:          "",
+				actions.OptionResolveImages:     false,
+				actions.OptionAllowUnresolved:   false,
+				actions.OptionFailOnUnmanaged:   false,
+				actions.OptionWait:              false,
+				actions.OptionTimeout:           5 * time.Minute,
+			},
+		},
+		{
+			name:   "with object parallelism",
+			args:   []string{"apply", "default", "--object-parallelism", "10"},
+			action: actionApply,
+			expected: map[string]interface{}{
+				actions.OptionApp:               mock.AnythingOfType("*app.App"),
+				actions.OptionEnvName:           "default",
+				actions.OptionGcTag:             "",
+				actions.OptionSkipGc:            false,
+				actions.OptionComponentNames:    make([]string, 0),
+				actions.OptionCreate:            true,
+				actions.OptionDryRun:            false,
+				actions.OptionFromPatch:         "",
+				actions.OptionClientConfig:      mock.AnythingOfType("*client.Config"),
+				actions.OptionObjectParallelism: 10,
+				actions.OptionSelector:          "",
+				actions.OptionResolveImages:     false,
+				actions.OptionAllowUnresolved:   false,
+				actions.OptionFailOnUnmanaged:   false,
+				actions.OptionWait:              false,
+				actions.OptionTimeout:           5 * time.Minute,
+			},
+		},
+		{
+			name:   "with selector",
+			args:   []string{"apply", "default", "--selector", "tier=frontend"},
+			action: actionApply,
+			expected: map[string]interface{}{
+				actions.OptionApp:               mock.AnythingOfType("*app.App"),
+				actions.OptionEnvName:           "default",
+				actions.OptionGcTag:             "",
+				actions.OptionSkipGc:            false,
+				actions.OptionComponentNames:    make([]string, 0),
+				actions.OptionCreate:            true,
+				actions.OptionDryRun:            false,
+				actions.OptionFromPatch:         "",
+				actions.OptionClientConfig:      mock.AnythingOfType("*client.Config"),
+				actions.OptionObjectParallelism: 1,
+				actions.OptionSelector:          "tier=frontend",
+				actions.OptionResolveImages:     false,
+				actions.OptionAllowUnresolved:   false,
+				actions.OptionFailOnUnmanaged:   false,
+				actions.OptionWait:              false,
+				actions.OptionTimeout:           5 * time.Minute,
+			},
+		},
+		{
+			name:   "read only",
+			args:   []string{"apply", "default", "--read-only"},
+			action: actionApply,
+			expected: map[string]interface{}{
+				actions.OptionApp:               mock.AnythingOfType("*app.App"),
+				actions.OptionEnvName:           "default",
+				actions.OptionGcTag:             "",
+				actions.OptionSkipGc:            false,
+				actions.OptionComponentNames:    make([]string, 0),
+				actions.OptionCreate:            true,
+				actions.OptionDryRun:            false,
+				actions.OptionFromPatch:         "",
+				actions.OptionClientConfig:      mock.AnythingOfType("*client.Config"),
+				actions.OptionObjectParallelism: 1,
+				actions.OptionSelector:          "",
+				actions.OptionResolveImages:     false,
+				actions.OptionAllowUnresolved:   false,
+				actions.OptionFailOnUnmanaged:   false,
+				actions.OptionWait:              false,
+				actions.OptionTimeout:           5 * time.Minute,
+				actions.OptionReadOnly:          true,
+			},
+		},
+		{
+			name:   "from patch",
+			args:   []string{"apply", "default", "--from-patch", "patches.json"},
+			action: actionApply,
+			expected: map[string]interface{}{
+				actions.OptionApp:               mock.AnythingOfType("*app.App"),
+				actions.OptionEnvName:           "default",
+				actions.OptionGcTag:             "",
+				actions.OptionSkipGc:            false,
+				actions.OptionComponentNames:    make([]string, 0),
+				actions.OptionCreate:            true,
+				actions.OptionDryRun:            false,
+				actions.OptionFromPatch:         "patches.json",
+				actions.OptionClientConfig:      mock.AnythingOfType("*client.Config"),
+				actions.OptionObjectParallelism: 1,
+				actions.OptionSelector:          "",
+				actions.OptionResolveImages:     false,
+				actions.OptionAllowUnresolved:   false,
+				actions.OptionFailOnUnmanaged:   false,
+				actions.OptionWait:              false,
+				actions.OptionTimeout:           5 * time.Minute,
+			},
+		},
+		{
+			name:   "resolve images",
+			args:   []string{"apply", "default", "--resolve-images", "--allow-unresolved"},
+			action: actionApply,
+			expected: map[string]interface{}{
+				actions.OptionApp:               mock.AnythingOfType("*app.App"),
+				actions.OptionEnvName:           "default",
+				actions.OptionGcTag:             "",
+				actions.OptionSkipGc:            false,
+				actions.OptionComponentNames:    make([]string, 0),
+				actions.OptionCreate:            true,
+				actions.OptionDryRun:            false,
+				actions.OptionFromPatch:         "",
+				actions.OptionClientConfig:      mock.AnythingOfType("*client.Config"),
+				actions.OptionObjectParallelism: 1,
+				actions.OptionSelector:          "",
+				actions.OptionResolveImages:     true,
+				actions.OptionAllowUnresolved:   true,
+				actions.OptionFailOnUnmanaged:   false,
+				actions.OptionWait:              false,
+				actions.OptionTimeout:           5 * time.Minute,
+			},
+		},
+		{
+			name:   "wait",
+			args:   []string{"apply", "default", "--wait", "--timeout", "10m"},
+			action: actionApply,
+			expected: map[string]interface{}{
+				actions.OptionApp:               mock.AnythingOfType("*app.App"),
+				actions.OptionEnvName:           "default",
+				actions.OptionGcTag:             "",
+				actions.OptionSkipGc:            false,
+				actions.OptionComponentNames:    make([]string, 0),
+				actions.OptionCreate:            true,
+				actions.OptionDryRun:            false,
+				actions.OptionFromPatch:         "",
+				actions.OptionClientConfig:      mock.AnythingOfType("*client.Config"),
+				actions.OptionObjectParallelism: 1,
+				actions.OptionSelector:          "",
+				actions.OptionResolveImages:     false,
+				actions.OptionAllowUnresolved:   false,
+				actions.OptionFailOnUnmanaged:   false,
+				actions.OptionWait:              true,
+				actions.OptionTimeout:           10 * time.Minute,
 			},
 		},
 		{
@@ -49,3 +198,37 @@ func Test_applyCmd(t *testing.T) {
 
 	runTestCmd(t, cases)
 }
+
+func Test_applyCmd_read_only_env(t *testing.T) {
+	os.Setenv(envReadOnly, "true")
+	defer os.Unsetenv(envReadOnly)
+
+	cases := []cmdTestCase{
+		{
+			name:   "read only from environment",
+			args:   []string{"apply", "default"},
+			action: actionApply,
+			expected: map[string]interface{}{
+				actions.OptionApp:               mock.AnythingOfType("*app.App"),
+				actions.OptionEnvName:           "default",
+				actions.OptionGcTag:             "",
+				actions.OptionSkipGc:            false,
+				actions.OptionComponentNames:    make([]string, 0),
+				actions.OptionCreate:            true,
+				actions.OptionDryRun:            false,
+				actions.OptionFromPatch:         "",
+				actions.OptionClientConfig:      mock.AnythingOfType("*client.Config"),
+				actions.OptionObjectParallelism: 1,
+				actions.OptionSelector:          "",
+				actions.OptionResolveImages:     false,
+				actions.OptionAllowUnresolved:   false,
+				actions.OptionFailOnUnmanaged:   false,
+				actions.OptionWait:              false,
+				actions.OptionTimeout:           5 * time.Minute,
+				actions.OptionReadOnly:          true,
+			},
+		},
+	}
+
+	runTestCmd(t, cases)
+}
@@ -0,0 +1,258 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package clicmd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ksonnet/ksonnet/pkg/actions"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+const (
+	completionShortDesc = "Output shell completion code for bash or zsh"
+	completionLong      = `
+The ` + "`completion`" + ` command outputs completion code for ` + "`bash` or `zsh`" + `. Besides
+commands and flags, it completes environment names for the commands that take
+one, such as ` + "`apply`, `show` and `env set`" + `. Environment names are completed one
+segment at a time, so ` + "`us-w<TAB>`" + ` completes to ` + "`us-west/`" + `.
+
+The names are read from the app in the current directory, or from the app given
+with ` + "`--dir` or `--app-name`" + ` on the command line.
+
+### Syntax
+`
+	completionExample = `
+# Load completion for the current bash shell. bash-completion must be installed.
+source <(ks completion bash)
+
+# Load completion for every new bash shell.
+ks completion bash > /etc/bash_completion.d/ks
+
+# Load completion for the current zsh shell.
+source <(ks completion zsh)`
+)
+
+// bashCompletionFunc completes environment names for the commands that take
+// one as their first argument. It is called by the generated bash completion
+// when there is nothing else to complete.
+const bashCompletionFunc = `__ks_get_env_names()
+{
+    local ks_out
+    local ks_args=()
+    if [[ -z "${BASH_VERSION}" || "${BASH_VERSINFO[0]}" -gt 3 ]]; then
+        local ks_dir="${flaghash[--dir]:-${flaghash[--dir=]}}"
+        local ks_app_name="${flaghash[--app-name]:-${flaghash[--app-name=]}}"
+        if [[ -n "${ks_dir}" ]]; then
+            ks_args+=(--dir "${ks_dir}")
+        fi
+        if [[ -n "${ks_app_name}" ]]; then
+            ks_args+=(--app-name "${ks_app_name}")
+        fi
+    fi
+    if ks_out=$(ks __complete-env-names "${ks_args[@]}" -- "${cur}" 2>/dev/null); then
+        COMPREPLY=( $( compgen -W "${ks_out}" -- "${cur}" ) )
+        if [[ ${#COMPREPLY[@]} -eq 1 && "${COMPREPLY[0]}" == */ ]] && [[ $(type -t compopt) = "builtin" ]]; then
+            compopt -o nospace
+        fi
+    fi
+}
+
+# __ks_has_args succeeds if the command already has an argument. The generated
+# completion only knows the values of flags with a shorthand, so the values of
+# other flags are found in flaghash and skipped.
+__ks_has_args()
+{
+    local noun value
+    for noun in "${nouns[@]}"; do
+        if [[ -z "${BASH_VERSION}" || "${BASH_VERSINFO[0]}" -gt 3 ]]; then
+            for value in "${flaghash[@]}"; do
+                if [[ "${noun}" == "${value}" ]]; then
+                    continue 2
+                fi
+            done
+        fi
+        return 0
+    done
+    return 1
+}
+
+__custom_func() {
+    if __ks_has_args; then
+        return
+    fi
+    case ${last_command} in
+        ks_apply | ks_delete | ks_show | ks_validate | ks_verify)
+            __ks_get_env_names
+            ;;
+        ks_env_describe | ks_env_exec | ks_env_ping | ks_env_rm | ks_env_set | \
+        ks_env_show-diff-lib | ks_env_update | ks_env_upgrade | ks_env_verify-lib)
+            __ks_get_env_names
+            ;;
+    esac
+}
+`
+
+// zshCompletionShim lets zsh run the bash completion with bashcompinit. It
+// stands in for the parts of bash and bash-completion that zsh doesn't have.
+const zshCompletionShim = `#compdef ks
+
+autoload -U +X compinit && compinit
+autoload -U +X bashcompinit && bashcompinit
+
+__ks_bash_source() {
+    alias shopt=':'
+    emulate -L sh
+    setopt kshglob noshglob braceexpand
+    source "$@"
+}
+
+__ks_type() {
+    # -t is the only option used by the bash completion.
+    if [ "$1" = "-t" ]; then
+        shift
+    fi
+    local t
+    t=$(whence -w "$@" 2>/dev/null) || return 1
+    echo "${t##*: }"
+}
+
+__ks_compgen() {
+    local completions w
+    completions=( $(compgen "$@") ) || return $?
+
+    # compgen in zsh doesn't filter by the word being completed.
+    while [[ "$1" = -* && "$1" != -- ]]; do
+        shift
+        shift
+    done
+    if [[ "$1" == -- ]]; then
+        shift
+    fi
+    for w in "${completions[@]}"; do
+        if [[ "${w}" = "$1"* ]]; then
+            echo "${w}"
+        fi
+    done
+}
+
+__ks_compopt() {
+    true # don't do anything. Not supported by bashcompinit in zsh
+}
+
+__ks_get_comp_words_by_ref() {
+    cur="${COMP_WORDS[COMP_CWORD]}"
+    prev="${COMP_WORDS[${COMP_CWORD}-1]}"
+    words=("${COMP_WORDS[@]}")
+    cword=("${COMP_CWORD[@]}")
+}
+
+__ks_bash_source <(cat <<'BASH_COMPLETION_EOF'
+`
+
+// zshCompletionReplacer rewrites the bash completion to use the functions of
+// zshCompletionShim.
+var zshCompletionReplacer = strings.NewReplacer(
+	"declare -F", "whence -w",
+	"$(type -t", "$(__ks_type -t",
+	"_get_comp_words_by_ref \"$@\"", "__ks_get_comp_words_by_ref \"$@\"",
+	"$(compgen", "$(__ks_compgen",
+	"$( compgen", "$( __ks_compgen",
+	"compopt -o", "__ks_compopt -o",
+	"compopt +o", "__ks_compopt +o",
+)
+
+var completionShells = map[string]func(cmd *cobra.Command, w io.Writer) error{
+	"bash": func(cmd *cobra.Command, w io.Writer) error {
+		return cmd.Root().GenBashCompletion(w)
+	},
+	"zsh": genZshCompletion,
+}
+
+func newCompletionCmd() *cobra.Command {
+	completionCmd := &cobra.Command{
+		Use:     "completion <bash|zsh>",
+		Short:   completionShortDesc,
+		Long:    completionLong,
+		Example: completionExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("'completion' takes a single argument: bash or zsh")
+			}
+
+			gen, ok := completionShells[args[0]]
+			if !ok {
+				return errors.Errorf("unsupported shell %q: use bash or zsh", args[0])
+			}
+
+			return gen(cmd, cmd.OutOrStdout())
+		},
+	}
+
+	return completionCmd
+}
+
+func genZshCompletion(cmd *cobra.Command, w io.Writer) error {
+	var buf bytes.Buffer
+	if err := cmd.Root().GenBashCompletion(&buf); err != nil {
+		return err
+	}
+
+	if _, err := io.WriteString(w, zshCompletionShim); err != nil {
+		return err
+	}
+
+	if _, err := io.WriteString(w, zshCompletionReplacer.Replace(buf.String())); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "BASH_COMPLETION_EOF\n)\n")
+	return err
+}
+
+// newCompleteEnvNamesCmd is used by the shell completion to list the
+// environment names that complete a partial name. It is hidden from help.
+func newCompleteEnvNamesCmd() *cobra.Command {
+	completeEnvNamesCmd := &cobra.Command{
+		Use:    "__complete-env-names [partial]",
+		Short:  "List the environment names that complete a partial name",
+		Hidden: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 1 {
+				return fmt.Errorf("'__complete-env-names' takes at most one argument")
+			}
+
+			var partial string
+			if len(args) == 1 {
+				partial = args[0]
+			}
+
+			m := map[string]interface{}{
+				actions.OptionEnvName:          partial,
+				actions.OptionSkipCheckUpgrade: true,
+			}
+			addGlobalOptions(m)
+
+			return runAction(actionCompleteEnvNames, m)
+		},
+	}
+
+	return completeEnvNamesCmd
+}
@@ -0,0 +1,108 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package clicmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/actions"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_completeEnvNamesCmd(t *testing.T) {
+	cases := []cmdTestCase{
+		{
+			name:   "in general",
+			args:   []string{"__complete-env-names", "us-w"},
+			action: actionCompleteEnvNames,
+			expected: map[string]interface{}{
+				actions.OptionApp:              nil,
+				actions.OptionEnvName:          "us-w",
+				actions.OptionSkipCheckUpgrade: true,
+			},
+		},
+		{
+			name:   "no partial name",
+			args:   []string{"__complete-env-names"},
+			action: actionCompleteEnvNames,
+			expected: map[string]interface{}{
+				actions.OptionApp:              nil,
+				actions.OptionEnvName:          "",
+				actions.OptionSkipCheckUpgrade: true,
+			},
+		},
+		{
+			name:  "too many arguments",
+			args:  []string{"__complete-env-names", "us-w", "prod"},
+			isErr: true,
+		},
+	}
+
+	runTestCmd(t, cases)
+}
+
+func Test_completionCmd(t *testing.T) {
+	cases := []struct {
+		name     string
+		args     []string
+		contains []string
+		isErr    bool
+	}{
+		{
+			name:     "bash",
+			args:     []string{"completion", "bash"},
+			contains: []string{"__start_ks", "__ks_get_env_names"},
+		},
+		{
+			name:     "zsh",
+			args:     []string{"completion", "zsh"},
+			contains: []string{"#compdef ks", "bashcompinit", "__ks_get_env_names", "$( __ks_compgen -W"},
+		},
+		{
+			name:  "unsupported shell",
+			args:  []string{"completion", "fish"},
+			isErr: true,
+		},
+		{
+			name:  "no shell",
+			args:  []string{"completion"},
+			isErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd, err := NewRoot(afero.NewMemMapFs(), "/", tc.args)
+			require.NoError(t, err)
+
+			var buf bytes.Buffer
+			cmd.SetOutput(&buf)
+
+			err = cmd.Execute()
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			for _, s := range tc.contains {
+				require.Contains(t, buf.String(), s)
+			}
+		})
+	}
+}
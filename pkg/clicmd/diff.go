@@ -29,6 +29,12 @@ import (
 
 const (
 	vDiffComponentNames = "diff-component-names"
+	vDiffOutput         = "diff-output"
+	vDiffSummaryOnly    = "diff-summary-only"
+	vDiffFix            = "diff-fix"
+	vDiffDryRun         = "diff-dry-run"
+	vDiffFailOn         = "diff-fail-on"
+	vDiffThreeWay       = "diff-three-way"
 
 	diffShortDesc = "Compare manifests, based on environment or location (local or remote)"
 )
@@ -55,6 +61,41 @@ the files in the ` + "`components/`" + ` directory.
 When a component IS specified via the ` + "`-c`" + ` flag, this command only checks
 the manifest for that particular component.
 
+With ` + "`--output=patch`" + `, the differences are written as a JSON array with one JSON
+merge patch per changed object, for use by review and deployment tooling. Each
+patch contains the fields that ` + "`ks apply`" + ` would change on the server. Objects
+that don't exist yet are included with the ` + "`create`" + ` operation and the whole
+object as the patch. The patches can be applied later with ` + "`ks apply --from-patch`" + `.
+
+With ` + "`--summary-only`" + `, only the number of objects that would be created, modified
+and deleted is printed. Add ` + "`--output=json`" + ` to get the summary as JSON, for
+dashboards that track drift across environments.
+
+With ` + "`--fix`" + `, objects whose local manifests differ from the cluster, or that are
+missing from the cluster, are applied after the diff, so a scheduled job can
+reconcile drift. Only those objects are touched, and nothing is garbage
+collected. ` + "`--fix`" + ` only compares the local manifests of an environment with
+its own cluster, and can be combined with ` + "`--dry-run`" + `. If drift is fixed, the
+command succeeds.
+
+By default, the command fails if there are any differences. To only fail on some
+kinds of differences, such as objects being added or removed unexpectedly in CI,
+pass the categories with ` + "`--fail-on`" + `: ` + "`added`" + `, ` + "`removed`" + ` or ` + "`changed`" + `.
+
+By default, remote objects are compared as ksonnet last applied them. With
+` + "`--three-way`" + `, the objects running on the server are compared with what they would
+become after ` + "`ks apply`" + `, using the last applied configuration, the live object
+and the local manifest like ` + "`kubectl diff`" + `. Fields set by other controllers that
+ksonnet would leave alone aren't reported. Objects without a last applied
+configuration are compared two-way. It only applies when comparing a local and a
+remote location.
+
+Remote locations only include the objects labeled
+` + "`app.kubernetes.io/deploy-manager=ksonnet`" + `, which ` + "`ks apply`" + ` sets on every
+object it creates or updates. Objects created by other tools are never reported
+as changed or removed, even if their names match your components. To keep
+` + "`ks apply`" + ` from updating such objects, use ` + "`ks apply --fail-on-unmanaged`" + `.
+
 ### Related Commands
 
 * ` + "`ks param diff` " + `— ` + paramShortDesc["diff"] + `
@@ -82,6 +123,25 @@ ks diff local:us-west/dev remote:us-west/prod
 # Show diff between what's in the local manifest and what's actually running in the
 # 'dev' environment, but for the Redis component ONLY
 ks diff dev -c redis
+
+# Write the patches that would be sent to the server to bring the 'dev'
+# environment up to date with the local manifests
+ks diff dev --output=patch > patches.json
+
+# Count the objects that differ between the local manifests and the 'prod'
+# environment, as JSON
+ks diff prod --summary-only --output=json
+
+# Apply the objects that drifted from the local manifests of the 'prod'
+# environment
+ks diff prod --fix
+
+# Show what applying the local manifests would change on the objects running in
+# the 'prod' environment
+ks diff prod --three-way
+
+# Only fail if objects would be added to or removed from the 'prod' environment
+ks diff prod --fail-on=added,removed
 `
 )
 
@@ -105,6 +165,12 @@ func newDiffCmd(fs afero.Fs) *cobra.Command {
 				actions.OptionClientConfig:   diffClientConfig,
 				actions.OptionSrc1:           args[0],
 				actions.OptionComponentNames: viper.GetStringSlice(vDiffComponentNames),
+				actions.OptionOutput:         viper.GetString(vDiffOutput),
+				actions.OptionSummaryOnly:    viper.GetBool(vDiffSummaryOnly),
+				actions.OptionFix:            viper.GetBool(vDiffFix),
+				actions.OptionDryRun:         viper.GetBool(vDiffDryRun),
+				actions.OptionFailOn:         viper.GetStringSlice(vDiffFailOn),
+				actions.OptionThreeWay:       viper.GetBool(vDiffThreeWay),
 			}
 			addGlobalOptions(m)
 
@@ -126,5 +192,23 @@ func newDiffCmd(fs afero.Fs) *cobra.Command {
 	diffCmd.Flags().StringSliceP(flagComponent, shortComponent, nil, "Name of a specific component")
 	viper.BindPFlag(vDiffComponentNames, diffCmd.Flags().Lookup(flagComponent))
 
+	diffCmd.Flags().StringP(flagOutput, shortOutput, "", "Output format. Valid options: patch, or json with --summary-only")
+	viper.BindPFlag(vDiffOutput, diffCmd.Flags().Lookup(flagOutput))
+
+	diffCmd.Flags().Bool(flagSummaryOnly, false, "Only print the number of created, modified and deleted objects")
+	viper.BindPFlag(vDiffSummaryOnly, diffCmd.Flags().Lookup(flagSummaryOnly))
+
+	diffCmd.Flags().Bool(flagFix, false, "Apply the objects that drifted from the local manifests")
+	viper.BindPFlag(vDiffFix, diffCmd.Flags().Lookup(flagFix))
+
+	diffCmd.Flags().Bool(flagDryRun, false, "With --fix, show which objects would be applied without changing the cluster")
+	viper.BindPFlag(vDiffDryRun, diffCmd.Flags().Lookup(flagDryRun))
+
+	diffCmd.Flags().StringSlice(flagFailOn, nil, "Only fail on these kinds of differences: added, removed, changed (default: any)")
+	viper.BindPFlag(vDiffFailOn, diffCmd.Flags().Lookup(flagFailOn))
+
+	diffCmd.Flags().Bool(flagThreeWay, false, "Compare live objects with the result of applying the local manifests, using the last applied configuration")
+	viper.BindPFlag(vDiffThreeWay, diffCmd.Flags().Lookup(flagThreeWay))
+
 	return diffCmd
 }
@@ -33,6 +33,97 @@ func Test_diffCmd(t *testing.T) {
 				actions.OptionSrc1:           "env1",
 				actions.OptionSrc2:           "env2",
 				actions.OptionComponentNames: []string{},
+				actions.OptionOutput:         "",
+				actions.OptionSummaryOnly:    false,
+				actions.OptionFix:            false,
+				actions.OptionDryRun:         false,
+				actions.OptionFailOn:         []string{},
+				actions.OptionThreeWay:       false,
+			},
+		},
+		{
+			name:   "patch output",
+			args:   []string{"diff", "env1", "-o", "patch"},
+			action: actionDiff,
+			expected: map[string]interface{}{
+				actions.OptionApp:            nil,
+				actions.OptionClientConfig:   nil,
+				actions.OptionSrc1:           "env1",
+				actions.OptionComponentNames: []string{},
+				actions.OptionOutput:         "patch",
+				actions.OptionSummaryOnly:    false,
+				actions.OptionFix:            false,
+				actions.OptionDryRun:         false,
+				actions.OptionFailOn:         []string{},
+				actions.OptionThreeWay:       false,
+			},
+		},
+		{
+			name:   "summary only",
+			args:   []string{"diff", "env1", "--summary-only", "-o", "json"},
+			action: actionDiff,
+			expected: map[string]interface{}{
+				actions.OptionApp:            nil,
+				actions.OptionClientConfig:   nil,
+				actions.OptionSrc1:           "env1",
+				actions.OptionComponentNames: []string{},
+				actions.OptionOutput:         "json",
+				actions.OptionSummaryOnly:    true,
+				actions.OptionFix:            false,
+				actions.OptionDryRun:         false,
+				actions.OptionFailOn:         []string{},
+				actions.OptionThreeWay:       false,
+			},
+		},
+		{
+			name:   "fix",
+			args:   []string{"diff", "env1", "--fix", "--dry-run"},
+			action: actionDiff,
+			expected: map[string]interface{}{
+				actions.OptionApp:            nil,
+				actions.OptionClientConfig:   nil,
+				actions.OptionSrc1:           "env1",
+				actions.OptionComponentNames: []string{},
+				actions.OptionOutput:         "",
+				actions.OptionSummaryOnly:    false,
+				actions.OptionFix:            true,
+				actions.OptionDryRun:         true,
+				actions.OptionFailOn:         []string{},
+				actions.OptionThreeWay:       false,
+			},
+		},
+		{
+			name:   "fail on",
+			args:   []string{"diff", "env1", "--fail-on", "added,removed"},
+			action: actionDiff,
+			expected: map[string]interface{}{
+				actions.OptionApp:            nil,
+				actions.OptionClientConfig:   nil,
+				actions.OptionSrc1:           "env1",
+				actions.OptionComponentNames: []string{},
+				actions.OptionOutput:         "",
+				actions.OptionSummaryOnly:    false,
+				actions.OptionFix:            false,
+				actions.OptionDryRun:         false,
+				actions.OptionFailOn:         []string{"added", "removed"},
+				actions.OptionThreeWay:       false,
+			},
+		},
+		{
+			name:   "three-way",
+			args:   []string{"diff", "env1", "--three-way"},
+			action: actionDiff,
+			expected: map[string]interface{}{
+				actions.OptionApp:            nil,
+				actions.OptionClientConfig:   nil,
+				actions.OptionSrc1:           "env1",
+				actions.OptionComponentNames: []string{},
+				actions.OptionOutput:         "",
+				actions.OptionSummaryOnly:    false,
+				actions.OptionFix:            false,
+				actions.OptionDryRun:         false,
+				actions.OptionFailOn:         []string{},
+				actions.OptionThreeWay:       true,
 			},
 		},
 		{
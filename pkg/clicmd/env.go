@@ -17,11 +17,17 @@ package clicmd
 
 import (
 	"fmt"
+	"path"
+	"path/filepath"
+	"sort"
 	"strings"
 
+	"github.com/ksonnet/ksonnet/pkg/app"
 	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
 )
 
 const (
@@ -33,13 +39,26 @@ const (
 
 var (
 	envShortDesc = map[string]string{
-		"add":     "Add a new environment to a ksonnet application",
-		"current": "Sets the current environment",
-		"list":    "List all environments in a ksonnet application",
-		"rm":      "Delete an environment from a ksonnet application",
-		"set":     "Set environment-specific fields (name, namespace, server)",
-		"targets": "Set target modules for an environment",
-		"update":  "Updates the libs for an environment",
+		"add":               "Add a new environment to a ksonnet application",
+		"check-contexts":    "List environments whose kubeconfig context no longer exists",
+		"clone":             "Copy an environment within an app or from another app",
+		"current":           "Sets the current environment",
+		"dedupe-libs":       "Find environments on the same cluster that can share a generated ksonnet-lib",
+		"diff":              "Compare the configuration of two environments",
+		"exec":              "Run a command against the cluster of an environment",
+		"init-from-scratch": "Add an environment that doesn't need a cluster, for local development",
+		"list":              "List all environments in a ksonnet application",
+		"ping":              "Check that the clusters of environments are healthy",
+		"prune-empty":       "Remove environment directories left behind by failed creations",
+		"restore":           "Restore an environment removed with `env rm` from the trash",
+		"rm":                "Delete an environment from a ksonnet application",
+		"set":               "Set environment-specific fields (name, namespace, server)",
+		"show-diff-lib":     "Show how the generated ksonnet-lib of an environment differs from a fresh generation",
+		"targets":           "Set target modules for an environment",
+		"update":            "Updates the libs for an environment",
+		"upgrade":           "Regenerate the ksonnet-lib of an environment from the OpenAPI spec of its cluster",
+		"validate-all":      "Validate all environments and write a report for CI",
+		"verify-lib":        "Check that the generated ksonnet-lib of an environment is unmodified",
 	}
 
 	envLong = `
@@ -68,11 +87,19 @@ represented as a hierarchy in the ` + "`environments/`" + ` directory of a ksonn
 │           ├── main.jsonnet         // Main file that imports all components (expanded on apply, delete, etc). Add environment-specific logic here.
 │           └── params.libsonnet     // Customize components *per-environment* here.
 ` + "```" + `
+
+Inside the ` + "`environments/`" + ` directory, ` + "`env add`, `env set`, `env rm` and `env list`" + `
+accept environment names relative to the current directory. In
+` + "`environments/us-west`, `./staging`" + ` is 'us-west/staging'. For ` + "`env set`, `env rm`" + `
+and ` + "`env list`" + `, a bare name such as ` + "`staging`" + ` is also matched against the
+environments below the current directory; if more than one matches, the
+command fails and lists them.
+
 ----
 `
 )
 
-func newEnvCmd() *cobra.Command {
+func newEnvCmd(fs afero.Fs) *cobra.Command {
 	envCmd := &cobra.Command{
 		Use:   "env",
 		Short: `Manage ksonnet environments`,
@@ -85,19 +112,121 @@ func newEnvCmd() *cobra.Command {
 		},
 	}
 
-	envCmd.AddCommand(newEnvAddCmd())
+	envCmd.AddCommand(newEnvAddCmd(fs))
+	envCmd.AddCommand(newEnvCheckContextsCmd())
+	envCmd.AddCommand(newEnvCloneCmd())
 	envCmd.AddCommand(newEnvCurrentCmd())
+	envCmd.AddCommand(newEnvDedupeLibsCmd())
 	envCmd.AddCommand(newEnvDescribeCmd())
-	envCmd.AddCommand(newEnvListCmd())
-	envCmd.AddCommand(newEnvRmCmd())
-	envCmd.AddCommand(newEnvSetCmd())
+	envCmd.AddCommand(newEnvDiffCmd(fs))
+	envCmd.AddCommand(newEnvExecCmd())
+	envCmd.AddCommand(newEnvInitFromScratchCmd())
+	envCmd.AddCommand(newEnvListCmd(fs))
+	envCmd.AddCommand(newEnvPingCmd())
+	envCmd.AddCommand(newEnvPruneEmptyCmd())
+	envCmd.AddCommand(newEnvRestoreCmd(fs))
+	envCmd.AddCommand(newEnvRmCmd(fs))
+	envCmd.AddCommand(newEnvSetCmd(fs))
+	envCmd.AddCommand(newEnvShowDiffLibCmd())
 	envCmd.AddCommand(newEnvTargetsCmd())
 	envCmd.AddCommand(newEnvUpdateCmd())
+	envCmd.AddCommand(newEnvUpgradeCmd())
+	envCmd.AddCommand(newEnvValidateAllCmd())
+	envCmd.AddCommand(newEnvVerifyLibCmd())
 
 	return envCmd
 
 }
 
+// resolveEnvName resolves an environment name given relative to the working
+// directory wd, when it is in the environments directory of the app. A name
+// starting with `./` or `../` is joined to wd's path in the environments
+// directory. If matchLeaf is true, a name without a slash that isn't an
+// environment below wd is matched against the last path element of the
+// environments below it; it is an error if more than one environment matches.
+// Other names are returned as they are.
+func resolveEnvName(fs afero.Fs, wd, name string, matchLeaf bool) (string, error) {
+	root, err := findAppRoot(fs, wd)
+	if err != nil {
+		// Not in an app: the action reports it.
+		return name, nil
+	}
+
+	envDir := filepath.Join(root, app.EnvironmentDirName)
+	rel, err := filepath.Rel(envDir, wd)
+	inEnvDir := err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+	rel = filepath.ToSlash(rel)
+
+	if strings.HasPrefix(name, "./") || strings.HasPrefix(name, "../") {
+		if !inEnvDir {
+			return "", fmt.Errorf("relative environment name %q can only be used in the %s directory of the app", name, app.EnvironmentDirName)
+		}
+
+		resolved := path.Join(rel, name)
+		if resolved == "." || resolved == ".." || strings.HasPrefix(resolved, "../") {
+			return "", fmt.Errorf("relative environment name %q is not below the %s directory of the app", name, app.EnvironmentDirName)
+		}
+
+		return resolved, nil
+	}
+
+	if !matchLeaf || !inEnvDir || name == "" || strings.Contains(name, "/") {
+		return name, nil
+	}
+
+	a, err := app.Load(fs, nil, root)
+	if err != nil {
+		return name, nil
+	}
+
+	environments, err := a.Environments()
+	if err != nil {
+		return name, nil
+	}
+
+	if rel == "." {
+		rel = ""
+	}
+
+	if _, ok := environments[path.Join(rel, name)]; ok {
+		return path.Join(rel, name), nil
+	}
+
+	if _, ok := environments[name]; ok {
+		return name, nil
+	}
+
+	var candidates []string
+	for envName := range environments {
+		if rel != "" && !strings.HasPrefix(envName, rel+"/") {
+			continue
+		}
+
+		if path.Base(envName) == name {
+			candidates = append(candidates, envName)
+		}
+	}
+
+	switch len(candidates) {
+	case 0:
+		return name, nil
+	case 1:
+		return candidates[0], nil
+	default:
+		sort.Strings(candidates)
+		return "", fmt.Errorf("environment name %q is ambiguous; it matches %s", name, strings.Join(candidates, ", "))
+	}
+}
+
+// findAppRoot finds the root of the app in wd, or of the app named by
+// --app-name.
+func findAppRoot(fs afero.Fs, wd string) (string, error) {
+	if appName := viper.GetString(flagAppName); appName != "" {
+		return app.FindNamedRoot(fs, wd, appName)
+	}
+	return app.FindRoot(fs, wd)
+}
+
 func commonEnvFlags(flags *pflag.FlagSet) (server, namespace, context string, err error) {
 	server, err = flags.GetString(flagEnvServer)
 	if err != nil {
@@ -122,29 +251,58 @@ func commonEnvFlags(flags *pflag.FlagSet) (server, namespace, context string, er
 	return server, namespace, context, nil
 }
 
-func resolveEnvFlags(flags *pflag.FlagSet, config *client.Config) (string, string, error) {
+// resolveEnvFlags returns the server and namespace of an environment, and the
+// name of the kubeconfig context they were resolved from. The context name is
+// empty if the server was provided explicitly. If strict is set, ambiguous
+// kubeconfig contexts and a missing namespace are errors instead of falling
+// back to defaults.
+// resolveEnvFlags resolves the server, namespace and context of an
+// environment from the flags and the kubeconfig. The namespace of --namespace
+// is used over the namespace of the context, unless preferContextNs is true.
+func resolveEnvFlags(flags *pflag.FlagSet, config *client.Config, strict, preferContextNs bool) (string, string, string, error) {
 	defaultNamespace := "default"
 
 	server, envNs, context, err := commonEnvFlags(flags)
 	if err != nil {
-		return "", "", err
+		return "", "", "", err
+	}
+
+	if preferContextNs && server != "" {
+		return "", "", "", fmt.Errorf("flag '%s' requires the server to be taken from a context, but '%s' was given",
+			flagPreferContextNs, flagEnvServer)
 	}
 
 	var ctxNs string
 	if server == "" {
 		// server is not provided -- use the context.
+		if strict {
+			if err = config.CheckContext(context); err != nil {
+				return "", "", "", err
+			}
+		}
+
 		server, ctxNs, err = config.ResolveContext(context)
 		if err != nil {
-			return "", "", err
+			return "", "", "", err
+		}
+
+		if context == "" {
+			if _, context, err = config.Contexts(); err != nil {
+				return "", "", "", err
+			}
 		}
 	}
 
 	ns := defaultNamespace
-	if envNs != "" {
+	if preferContextNs && ctxNs != "" {
+		ns = ctxNs
+	} else if envNs != "" {
 		ns = envNs
 	} else if ctxNs != "" {
 		ns = ctxNs
+	} else if strict {
+		return "", "", "", fmt.Errorf("no namespace was given with '--%s' and the kubeconfig context doesn't set one", flagEnvNamespace)
 	}
 
-	return server, ns, nil
+	return server, ns, context, nil
 }
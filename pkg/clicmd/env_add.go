@@ -17,18 +17,57 @@ package clicmd
 
 import (
 	"fmt"
+	"strings"
 
+	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 
 	"github.com/ksonnet/ksonnet/pkg/actions"
+	"github.com/ksonnet/ksonnet/pkg/app"
 	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/ksonnet/ksonnet/pkg/env"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 )
 
 const (
-	vEnvAddOverride = "env-add-override"
+	vEnvAddOverride            = "env-add-override"
+	vEnvAddPostApplyComponents = "env-add-post-apply-components"
+	vEnvAddRecord              = "env-add-record"
+	vEnvAddAsUser              = "env-add-as-user"
+	vEnvAddGenerateGitignore   = "env-add-generate-gitignore"
+	vEnvAddMergeKubeconfigs    = "env-add-merge-kubeconfigs"
+	vEnvAddStrict              = "env-add-strict"
+	vEnvAddFromHelmValues      = "env-add-from-helm-values"
+	vEnvAddCheckReachability   = "env-add-check-reachability"
+	vEnvAddPreferContextNs     = "env-add-prefer-context-namespace"
+	vEnvAddDryRun              = "env-add-dry-run"
+	vEnvAddInherit             = "env-add-inherit"
+	vEnvAddServerCert          = "env-add-server-cert"
+	vEnvAddInCluster           = "env-add-in-cluster"
+	vEnvAddSkipLib             = "env-add-skip-lib"
+	vEnvAddSkipNsCheck         = "env-add-skip-ns-check"
+	vEnvAddFromFile            = "env-add-from-file"
+	vEnvAddFailFast            = "env-add-fail-fast"
 )
 
+// envAddFromFileConflicts are flags of `env add` that can't be combined with
+// --from-file, because the file sets them for each environment or they only
+// make sense for a single environment.
+var envAddFromFileConflicts = []string{
+	flagEnvServer, flagEnvContext, flagEnvNamespace, flagAPISpec, flagServerCert,
+	flagInCluster, flagPreferContextNs, flagInherit, flagFromHelmValues, flagDryRun,
+	flagRecord, flagPostApplyComponent, flagGenerateGitignore, flagCheckReachability,
+	flagStrict,
+}
+
+// redactedFlags are flags whose values are not recorded in an environment's
+// provenance.
+var redactedFlags = map[string]bool{
+	"password": true,
+	"token":    true,
+}
+
 var (
 	envAddLong = `
 The ` + "`add`" + ` command creates a new environment (specifically for the ksonnet app
@@ -40,13 +79,196 @@ info:
 3. **Namespace**  — A Kubernetes namespace. *Must already exist on the cluster.*
 4. **Kubernetes API Version**  — Used to generate a library with compatible type defs.
 
-(1) is mandatory. (2) and (3) can be inferred from $KUBECONFIG, *or* from the
+(1) is mandatory. Names are paths below the ` + "`environments/`" + ` directory, such
+as ` + "`us-west/staging`" + `. They can't begin or end with a slash, have empty
+segments such as ` + "`us-west//staging`" + `, use ` + "`.`" + ` or ` + "`..`" + ` as a segment, contain
+spaces or punctuation, or use names ksonnet keeps in the directory
+(` + "`.metadata`" + ` and ` + "`base.libsonnet`" + `). An invalid name is rejected with the
+rule it breaks.
+
+(2) and (3) can be inferred from $KUBECONFIG, *or* from the
 ` + "`--kubeconfig`" + ` or ` + "`--context`" + ` flags. Otherwise, (2), (3), and (4) can all be
-specified by individual flags. Unless otherwise specified, (4) defaults to the
-latest Kubernetes version that ksonnet supports.
+specified by individual flags. Unless ` + "`--api-spec`" + ` is given, (4) is the
+` + "`defaultAPISpec`" + ` of ` + "`app.yaml`" + `, so a team can set its baseline Kubernetes
+version once, e.g. ` + "`defaultAPISpec: version:v1.10.3`" + `. If the app doesn't set
+one, (4) is read from the cluster, falling back to the latest Kubernetes version
+that ksonnet supports. When (2) is inferred from a
+context, the name of the context is recorded, so ` + "`ks env check-contexts`" + ` can
+report the environment if the context is later renamed or removed.
+
+A server given with ` + "`--server`" + ` must be an http or https URL without a path,
+query or fragment; a trailing slash is removed. A server given as only a host and
+port, such as ` + "`example.com:6443`" + `, is assumed to use https, and a warning is
+printed. A server taken from a context is saved as the kubeconfig has it.
+
+Contexts are read from the file given with ` + "`--kubeconfig`" + `, which takes precedence
+over $KUBECONFIG, so ` + "`--context`" + ` can name a context in a kubeconfig file that isn't
+otherwise used. If the context doesn't exist, the command fails and lists the
+contexts of the files that were read, and the current context.
+
+If the cluster details are split across several kubeconfig files, pass them to
+` + "`--merge-kubeconfigs`" + `. The files are merged, like the files listed in $KUBECONFIG,
+before the context is resolved. When files define the same cluster, context, or
+user, or a current context, the file listed last wins. ` + "`--merge-kubeconfigs`" + `
+can't be combined with ` + "`--kubeconfig`" + `.
+
+By default, missing details are filled in: the current context is used when no
+context is given, the namespace defaults to ` + "`default`" + `, and a default Kubernetes
+version is used if it can't be read from the cluster.
+
+The namespace is taken from the first of these that is set:
+
+1. ` + "`--namespace`" + `
+2. The namespace of the context, when the server is taken from a context
+3. ` + "`default`" + `
+
+With ` + "`--prefer-context-namespace`" + `, the namespace of the context comes first, so
+scripts can pass a fallback with ` + "`--namespace`" + ` that is only used for contexts
+without a namespace. It can't be combined with ` + "`--server`" + `, since the context
+isn't used then.
+
+For reproducible automation, ` + "`--strict`" + ` turns each of these fallbacks into an
+error, and also fails if the kubeconfig has no current context or the cluster of
+the context has no server.
+
+To catch a mistyped server before it is saved, ` + "`--check-reachability`" + ` requests the
+server's ` + "`/version`" + `. A server that is a kubeconfig cluster is asked with the TLS and
+authentication settings of the client flags, such as ` + "`--certificate-authority`" + ` and
+` + "`--token`" + `. Any other server is asked anonymously, so no credentials are sent to
+it, and is verified with ` + "`--server-cert`" + ` if it is given. If the server can't be
+reached or doesn't respond successfully, a warning is printed and the
+environment is added anyway; with ` + "`--strict`" + `, the command fails instead.
+
+To catch a mistyped namespace, the namespace is looked up on the server with the
+same client settings. If it doesn't exist, a warning naming the namespace and
+the server is printed and the environment is added anyway; with ` + "`--strict`" + `, the
+command fails instead. If the server can't be reached, the check is skipped. It
+is also skipped, with a message saying so, for a server that isn't a kubeconfig
+cluster unless ` + "`--server-cert`" + ` is given. When
+scaffolding environments offline, ` + "`--skip-ns-check`" + ` skips the check, so the
+command doesn't wait for the server to time out.
+
+To preview a new environment, ` + "`--dry-run`" + ` lists the files and directories that
+would be created or updated, and then exits without changing the app. The
+server is still checked with ` + "`--check-reachability`" + `.
+
+For ` + "`version:`" + ` API specs, the OpenAPI spec of the Kubernetes version is downloaded
+from GitHub. Behind an HTTP proxy, the download uses the proxy in $HTTPS_PROXY
+or $HTTP_PROXY, or the one passed with ` + "`--proxy`" + `, which takes precedence. Hosts
+listed in $NO_PROXY, as names, domains or CIDR blocks, are reached without the
+proxy. Requests to the cluster, such as ` + "`--check-reachability`" + `, use the proxy
+environment variables, including $NO_PROXY, but not ` + "`--proxy`" + `.
+
+ksonnet-lib is generated once for each Kubernetes version, in
+` + "`lib/ksonnet-lib/<version>`" + `, and shared by all the environments of the app for
+that version, so adding another environment for a version doesn't download its
+spec again. A ` + "`file:`" + ` or ` + "`url:`" + ` spec whose version already has a library
+generated from a different spec is an error, since that library is used by the
+other environments of the version.
+
+Generated libraries are also cached in ` + "`.ksonnet/cache/ksonnet-lib`" + `, keyed by the
+version of a released spec or the sha256 of a ` + "`file:`" + ` or ` + "`url:`" + ` spec, so a
+library is copied rather than generated again once it has been removed from
+` + "`lib/`" + `. The files are written atomically, so a library that is being generated
+by another ` + "`ks`" + ` process is never mistaken for a complete one, and ` + "`app.yaml`" + ` is
+locked while an environment is recorded in it, so environments can be added by
+parallel ` + "`ks`" + ` processes. With ` + "`--no-cache`" + `, the cache isn't used, and the library
+of another patch release with the same type definitions isn't reused.
+
+Generating ksonnet-lib for a Kubernetes version that the app doesn't have a
+library for yet can take a while. When scripting the creation of many
+environments, ` + "`--skip-lib`" + ` creates the environment files and records its API
+spec without generating the library. It is generated the first time a command
+needs it, such as ` + "`ks apply`" + ` or ` + "`ks show`" + `, or with ` + "`ks env upgrade`" + `. Until then,
+the environment has no Kubernetes version and ` + "`ks env verify-lib`" + ` fails.
+
+To add several environments at once, list them in a YAML file and pass it with
+` + "`--from-file`" + ` instead of a name:
+
+` + "```" + `
+environments:
+- name: us-west/staging
+  context: staging
+  namespace: web
+- name: us-west/prod
+  server: https://ksonnet-1.us-west.elb.amazonaws.com
+  namespace: web
+  spec: version:v1.10.3
+` + "```" + `
+
+Each environment is added as if its fields were passed to ` + "`env add`" + ` as ` + "`--server`" + `,
+` + "`--namespace`" + `, ` + "`--context`" + ` and ` + "`--api-spec`" + `; without a server, the context, or the
+current context, is used. The file is checked before anything is added, and it
+is an error if it names an environment more than once. Then the result of each
+environment is reported, and environments that can't be added are skipped; with
+` + "`--fail-fast`" + `, the command stops at the first one instead. ` + "`--override`" + `,
+` + "`--skip-lib`" + `, ` + "`--skip-ns-check`" + ` and the kubeconfig flags apply to every environment;
+flags that set up a single environment can't be combined with ` + "`--from-file`" + `.
 
 Note that an environment *DOES NOT* contain user-specific data such as private keys.
 
+When the server uses a private certificate authority, pass its PEM-encoded
+certificate with ` + "`--server-cert`" + `. The certificate is saved with the server and
+used to verify it when the environment's cluster isn't in the kubeconfig. When the
+server is resolved from a context, the certificate authority of the context's
+cluster is saved unless ` + "`--server-cert`" + ` is given. Without one, the server isn't
+verified.
+
+In a CI runner that runs as a Kubernetes pod, ` + "`--in-cluster`" + ` adds an environment
+for the pod's own cluster without a kubeconfig. The server, certificate authority
+and namespace are read from the service account mounted into the pod, with
+client-go's in-cluster config loader; ` + "`--namespace`" + ` takes precedence over the
+service account's namespace. Outside of a pod, the command fails rather than
+falling back to the current context.
+
+If the cluster is in one context but you need to authenticate as a different
+kubeconfig user to fetch its API version, select the user with ` + "`--user`" + `. The server
+is still taken from the context, and the user is not stored in the environment.
+
+Baseline components that every environment should have, such as a monitoring
+agent, can be generated from prototypes with ` + "`--post-apply-component`" + `. Components
+are shared by all environments, so a component is only generated if it doesn't
+exist yet.
+
+With ` + "`--record`" + `, the user who created the environment, the time, the command
+line, and the context or server the destination was resolved from are saved with
+the environment and shown by ` + "`ks env describe`" + `. The user defaults to the
+current OS user and can be set with ` + "`--as-user`" + `. Credentials passed as flags
+are not recorded.
+
+To keep credentials out of version control, ` + "`--generate-gitignore`" + ` makes sure the
+environment directory has a ` + "`.gitignore`" + ` that excludes files which commonly hold
+secrets, such as ` + "`*.key`" + ` and ` + "`*-credentials*`" + `. Patterns are appended to an
+existing ` + "`.gitignore`" + ` only if they are missing.
+
+Teams migrating from Helm can seed the environment's parameters from a chart's
+` + "`values.yaml`" + ` with ` + "`--from-helm-values`" + `. Mapping is best-effort: values under a
+key named after a component become that component's parameters, values under a
+key named after a module are mapped to the module's components, and values under
+` + "`global`" + ` become environment globals. Values that can't be mapped, such as keys
+that match no component, nulls, or strings that would be read as numbers or
+booleans, are listed in a comment at the top of the environment's
+` + "`params.libsonnet`" + ` and reported as warnings.
+
+Environments that differ only slightly can share parameters with ` + "`--inherit`" + `.
+The new environment inherits the parameters of the environment it names, and its
+own ` + "`params.libsonnet`" + ` only needs the parameters that differ. The parent may
+itself inherit from another environment, but environments can't inherit from
+each other.
+
+The ` + "`main.jsonnet`" + ` of a new environment is rendered from
+` + "`templates/environment.jsonnet`" + ` in the app's root directory, if the file exists,
+so a team can give every environment the same boilerplate. The file is a Go
+text/template with these variables:
+
+* ` + "`{{.Name}}`" + ` — the name of the environment, such as ` + "`us-west/staging`" + `
+* ` + "`{{.Namespace}}`" + ` — the namespace of the environment
+* ` + "`{{.Server}}`" + ` — the address of the Kubernetes API server
+
+Without the file, the built-in ` + "`main.jsonnet`" + ` is used. A template that can't be
+rendered, for example because it uses an unknown variable, is an error, even
+with ` + "`--dry-run`" + `.
+
 ### Related Commands
 
 * ` + "`ks env list` " + `— ` + envShortDesc["list"] + `
@@ -54,6 +276,7 @@ Note that an environment *DOES NOT* contain user-specific data such as private k
 * ` + "`ks env set` " + `— ` + envShortDesc["set"] + `
 * ` + "`ks param set` " + `— ` + envShortDesc["set"] + `
 * ` + "`ks apply` " + `— ` + applyShortDesc + `
+* ` + "`ks generate` " + `— ` + protoShortDesc["use"] + `
 
 ### Syntax
 `
@@ -71,16 +294,89 @@ ks env add us-west/staging
 # files here are saved in "<ksonnet-app-root>/environments/us-west/staging".
 ks env add us-west/staging --api-spec=version:v1.7.1 --namespace=staging
 
+# Initialize a new environment "prod" with the OpenAPI spec served at a URL, such
+# as a mirror of the cluster's spec behind a proxy. The document must be a
+# swagger 2.0 spec with type definitions; its info.version is used as the
+# Kubernetes version.
+ks env add prod --context=prod --api-spec=url:https://proxy.example.com/openapi/v2
+
 # Initialize a new environment "my-env" using the "dev" context in your current
 # kubeconfig file ($KUBECONFIG).
 ks env add my-env --context=dev
 
+# Initialize a new environment "my-env" using the "dev" context, which is defined
+# across two kubeconfig files. Where the files overlap, "dev.yaml" wins.
+ks env add my-env --context=dev --merge-kubeconfigs=base.yaml,dev.yaml
+
+# Initialize a new environment "my-env" using the cluster of the "dev" context,
+# but authenticating as the kubeconfig user "dev-admin".
+ks env add my-env --context=dev --user=dev-admin
+
+# Initialize a new environment "staging" using the "foo" context of a kubeconfig
+# file other than the one in $KUBECONFIG.
+ks env add staging --context=foo --kubeconfig=/path/to/other/kubeconfig
+
 # Initialize a new environment "prod" using the address of a cluster's Kubernetes
 # API server.
-ks env add prod --server=https://ksonnet-1.us-west.elb.amazonaws.com`
+ks env add prod --server=https://ksonnet-1.us-west.elb.amazonaws.com
+
+# Initialize a new environment "prod" for a cluster whose server certificate is
+# signed by a private certificate authority.
+ks env add prod --server=https://ksonnet-1.example.com --server-cert=/path/to/ca.pem
+
+# Initialize a new environment "ci" for the cluster the pod running ks is in,
+# from the pod's service account.
+ks env add ci --in-cluster
+
+# Initialize a new environment "prod" and make sure the app has a component
+# named "monitoring-agent" generated from the prototype of the same name, and a
+# component named "logging" generated from the "io.ksonnet.pkg.logging-agent"
+# prototype.
+ks env add prod --post-apply-component=io.ksonnet.pkg.monitoring-agent \
+  --post-apply-component=io.ksonnet.pkg.logging-agent:logging
+
+# Initialize a new environment "staging" with parameters taken from the values
+# of a Helm chart. Run this after generating the components.
+ks env add staging --context=staging --from-helm-values=chart/values.yaml
+
+# Initialize a new environment "prod" in CI, failing if the namespace or the
+# Kubernetes version of the cluster can't be determined.
+ks env add prod --context=prod --namespace=web --strict
+
+# Initialize a new environment "dev" in the namespace of the "dev" context, or
+# in the "sandbox" namespace if the context doesn't set one.
+ks env add dev --context=dev --namespace=sandbox --prefer-context-namespace
+
+# Initialize a new environment "prod", failing if its server doesn't respond.
+ks env add prod --server=https://ksonnet-1.us-west.elb.amazonaws.com \
+  --check-reachability --strict
+
+# Initialize a new environment "prod" whose component parameters default to
+# those of the "base" environment.
+ks env add prod --context=prod --inherit=base
+
+# List the files that adding the environment "prod" would create, without
+# creating them.
+ks env add prod --context=prod --dry-run
+
+# Initialize a new environment "prod" for a cluster that isn't reachable yet,
+# without checking that its namespace exists.
+ks env add prod --server=https://ksonnet-1.example.com --namespace=web --skip-ns-check
+
+# Initialize a throwaway environment "scratch" without generating ksonnet-lib
+# until it is used.
+ks env add scratch --server=https://ksonnet-1.example.com --skip-lib
+
+# Initialize the environments listed in envs.yaml, stopping at the first one
+# that can't be added.
+ks env add --from-file=envs.yaml --fail-fast
+
+# Initialize a new environment "prod" and record that the "deploy-bot" user
+# created it.
+ks env add prod --context=prod --record --as-user=deploy-bot`
 )
 
-func newEnvAddCmd() *cobra.Command {
+func newEnvAddCmd(fs afero.Fs) *cobra.Command {
 	envClientConfig := client.NewDefaultClientConfig()
 
 	envAddCmd := &cobra.Command{
@@ -90,17 +386,54 @@ func newEnvAddCmd() *cobra.Command {
 		Example: envAddExample,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			flags := cmd.Flags()
+			if path := viper.GetString(vEnvAddFromFile); path != "" {
+				return runEnvAddFromFile(flags, args, envClientConfig, path)
+			}
+
+			if viper.GetBool(vEnvAddFailFast) {
+				return fmt.Errorf("flag '%s' can only be used with '%s'", flagFailFast, flagFromFile)
+			}
+
 			if len(args) != 1 {
 				return fmt.Errorf("'env add' takes exactly one argument, which is the name of the environment")
 			}
 
-			name := args[0]
-
-			server, namespace, err := resolveEnvFlags(flags, envClientConfig)
+			name, err := resolveEnvName(fs, viper.GetString(flagDir), args[0], false)
 			if err != nil {
 				return err
 			}
 
+			if err := env.ValidateName(name); err != nil {
+				return err
+			}
+
+			strict := viper.GetBool(vEnvAddStrict)
+
+			var server, namespace, context, serverCert string
+			if viper.GetBool(vEnvAddInCluster) {
+				if server, namespace, serverCert, err = envInCluster(flags, envClientConfig, strict); err != nil {
+					return err
+				}
+			} else {
+				if err := envClientConfig.MergeKubeconfigs(viper.GetStringSlice(vEnvAddMergeKubeconfigs)); err != nil {
+					return err
+				}
+
+				if err := envClientConfig.CheckUser(); err != nil {
+					return err
+				}
+
+				server, namespace, context, err = resolveEnvFlags(flags, envClientConfig, strict, viper.GetBool(vEnvAddPreferContextNs))
+				if err != nil {
+					return err
+				}
+
+				serverCert, err = envServerCert(envClientConfig, viper.GetString(vEnvAddServerCert), context)
+				if err != nil {
+					return err
+				}
+			}
+
 			// TODO: pass envClientConfig to the action so it can pull out the
 			// spec flag if it is empty.
 			specFlag, err := flags.GetString(flagAPISpec)
@@ -108,17 +441,41 @@ func newEnvAddCmd() *cobra.Command {
 				return err
 			}
 			if specFlag == "" {
+				if specFlag, err = appDefaultAPISpec(fs, viper.GetString(flagDir)); err != nil {
+					return err
+				}
+			}
+			if specFlag == "" && strict {
+				if specFlag, err = envClientConfig.APISpec(); err != nil {
+					return fmt.Errorf("unable to determine the Kubernetes version of the cluster, set it with '--%s': %v", flagAPISpec, err)
+				}
+			} else if specFlag == "" {
 				specFlag = envClientConfig.GetAPISpec()
 			}
 
 			isOverride := viper.GetBool(vEnvAddOverride)
 
 			m := map[string]interface{}{
-				actions.OptionEnvName:  name,
-				actions.OptionServer:   server,
-				actions.OptionModule:   namespace,
-				actions.OptionSpecFlag: specFlag,
-				actions.OptionOverride: isOverride,
+				actions.OptionEnvName:             name,
+				actions.OptionServer:              server,
+				actions.OptionServerCert:          serverCert,
+				actions.OptionContext:             context,
+				actions.OptionModule:              namespace,
+				actions.OptionSpecFlag:            specFlag,
+				actions.OptionOverride:            isOverride,
+				actions.OptionPostApplyComponents: viper.GetStringSlice(vEnvAddPostApplyComponents),
+				actions.OptionRecord:              viper.GetBool(vEnvAddRecord),
+				actions.OptionUser:                viper.GetString(vEnvAddAsUser),
+				actions.OptionCommandLine:         commandLine(cmd, args),
+				actions.OptionGenerateGitignore:   viper.GetBool(vEnvAddGenerateGitignore),
+				actions.OptionFromHelmValues:      viper.GetString(vEnvAddFromHelmValues),
+				actions.OptionCheckReachability:   viper.GetBool(vEnvAddCheckReachability),
+				actions.OptionDryRun:              viper.GetBool(vEnvAddDryRun),
+				actions.OptionSkipLib:             viper.GetBool(vEnvAddSkipLib),
+				actions.OptionSkipNsCheck:         viper.GetBool(vEnvAddSkipNsCheck),
+				actions.OptionInherits:            viper.GetString(vEnvAddInherit),
+				actions.OptionStrict:              strict,
+				actions.OptionClientConfig:        envClientConfig,
 			}
 			addGlobalOptions(m)
 
@@ -130,10 +487,201 @@ func newEnvAddCmd() *cobra.Command {
 
 	// TODO: We need to make this default to checking the `kubeconfig` file.
 	envAddCmd.PersistentFlags().String(flagAPISpec, "",
-		"Manually specify API version from OpenAPI schema, cluster, or Kubernetes version")
+		"Manually specify API version from OpenAPI schema, cluster, or Kubernetes version, as version:<version>, file:<path> or url:<url>")
 
 	envAddCmd.Flags().BoolP(flagOverride, shortOverride, false, "Add environment as override")
 	viper.BindPFlag(vEnvAddOverride, envAddCmd.Flags().Lookup(flagOverride))
 
+	envAddCmd.Flags().StringSlice(flagPostApplyComponent, nil,
+		"Generate a component from a prototype, as <prototype>[:<component-name>], if it doesn't exist (can be repeated)")
+	viper.BindPFlag(vEnvAddPostApplyComponents, envAddCmd.Flags().Lookup(flagPostApplyComponent))
+
+	envAddCmd.Flags().Bool(flagRecord, false, "Record who created the environment, when, and how")
+	viper.BindPFlag(vEnvAddRecord, envAddCmd.Flags().Lookup(flagRecord))
+
+	envAddCmd.Flags().String(flagAsUser, "", "User recorded as the creator of the environment with --record (default: current OS user)")
+	viper.BindPFlag(vEnvAddAsUser, envAddCmd.Flags().Lookup(flagAsUser))
+
+	envAddCmd.Flags().Bool(flagGenerateGitignore, false, "Add a .gitignore excluding files that commonly hold secrets to the environment directory")
+	viper.BindPFlag(vEnvAddGenerateGitignore, envAddCmd.Flags().Lookup(flagGenerateGitignore))
+
+	envAddCmd.Flags().StringSlice(flagMergeKubeconfigs, nil,
+		"Merge these kubeconfig files, with later files taking precedence, instead of using $KUBECONFIG or --kubeconfig")
+	viper.BindPFlag(vEnvAddMergeKubeconfigs, envAddCmd.Flags().Lookup(flagMergeKubeconfigs))
+
+	envAddCmd.Flags().Bool(flagStrict, false, "Fail instead of falling back to defaults when the context, server, namespace or Kubernetes version is ambiguous")
+	viper.BindPFlag(vEnvAddStrict, envAddCmd.Flags().Lookup(flagStrict))
+
+	envAddCmd.Flags().String(flagFromHelmValues, "", "Seed the environment's parameters from a Helm values.yaml")
+	viper.BindPFlag(vEnvAddFromHelmValues, envAddCmd.Flags().Lookup(flagFromHelmValues))
+
+	envAddCmd.Flags().Bool(flagPreferContextNs, false,
+		"Use the namespace of the context even if --namespace is given; --namespace is only used if the context doesn't set one")
+	viper.BindPFlag(vEnvAddPreferContextNs, envAddCmd.Flags().Lookup(flagPreferContextNs))
+
+	envAddCmd.Flags().Bool(flagCheckReachability, false, "Check that the server responds before adding the environment; Fails instead of warning with --strict")
+	viper.BindPFlag(vEnvAddCheckReachability, envAddCmd.Flags().Lookup(flagCheckReachability))
+
+	envAddCmd.Flags().Bool(flagDryRun, false, "List the files which would be created without creating them")
+	viper.BindPFlag(vEnvAddDryRun, envAddCmd.Flags().Lookup(flagDryRun))
+
+	envAddCmd.Flags().String(flagInherit, "", "Name of an environment whose parameters the environment inherits")
+	viper.BindPFlag(vEnvAddInherit, envAddCmd.Flags().Lookup(flagInherit))
+
+	envAddCmd.Flags().String(flagServerCert, "",
+		"Path to the PEM-encoded certificate authority of the server; Defaults to the certificate authority of the context's cluster")
+	viper.BindPFlag(vEnvAddServerCert, envAddCmd.Flags().Lookup(flagServerCert))
+
+	envAddCmd.Flags().Bool(flagInCluster, false,
+		"Use the server, certificate authority and namespace of the service account mounted into the pod ks runs in, instead of a kubeconfig")
+	viper.BindPFlag(vEnvAddInCluster, envAddCmd.Flags().Lookup(flagInCluster))
+
+	envAddCmd.Flags().Bool(flagSkipLib, false,
+		"Don't generate ksonnet-lib now; It is generated the first time a command needs it")
+	viper.BindPFlag(vEnvAddSkipLib, envAddCmd.Flags().Lookup(flagSkipLib))
+
+	envAddCmd.Flags().Bool(flagSkipNsCheck, false,
+		"Don't check that the namespace exists on the server, e.g. when the server isn't reachable")
+	viper.BindPFlag(vEnvAddSkipNsCheck, envAddCmd.Flags().Lookup(flagSkipNsCheck))
+
+	envAddCmd.Flags().String(flagFromFile, "", "Add the environments listed in a YAML file")
+	viper.BindPFlag(vEnvAddFromFile, envAddCmd.Flags().Lookup(flagFromFile))
+
+	envAddCmd.Flags().Bool(flagFailFast, false, "With --from-file, stop at the first environment that can't be added")
+	viper.BindPFlag(vEnvAddFailFast, envAddCmd.Flags().Lookup(flagFailFast))
+
 	return envAddCmd
 }
+
+// runEnvAddFromFile adds the environments listed in a file. The file sets the
+// destination of each environment, so the flags that set it for a single
+// environment can't be used.
+func runEnvAddFromFile(flags *pflag.FlagSet, args []string, config *client.Config, path string) error {
+	if len(args) != 0 {
+		return fmt.Errorf("'env add' takes no arguments with '%s', as the file names the environments", flagFromFile)
+	}
+
+	for _, name := range envAddFromFileConflicts {
+		if flags.Changed(name) {
+			return fmt.Errorf("flags '%s' and '%s' are mutually exclusive, because '%s' sets up each environment from the file",
+				flagFromFile, name, flagFromFile)
+		}
+	}
+
+	if err := config.MergeKubeconfigs(viper.GetStringSlice(vEnvAddMergeKubeconfigs)); err != nil {
+		return err
+	}
+
+	if err := config.CheckUser(); err != nil {
+		return err
+	}
+
+	m := map[string]interface{}{
+		actions.OptionFromFile:     path,
+		actions.OptionFailFast:     viper.GetBool(vEnvAddFailFast),
+		actions.OptionOverride:     viper.GetBool(vEnvAddOverride),
+		actions.OptionSkipLib:      viper.GetBool(vEnvAddSkipLib),
+		actions.OptionSkipNsCheck:  viper.GetBool(vEnvAddSkipNsCheck),
+		actions.OptionClientConfig: config,
+	}
+	addGlobalOptions(m)
+
+	return runAction(actionEnvAddFromFile, m)
+}
+
+// envServerCert returns the certificate authority that a new environment's
+// server is pinned to: the one in the file given with --server-cert or, if the
+// server was resolved from a context, the one of the context's cluster.
+func envServerCert(config *client.Config, path, context string) (string, error) {
+	if path != "" {
+		data, err := client.ReadServerCert(path)
+		return string(data), err
+	}
+
+	if context == "" {
+		return "", nil
+	}
+
+	data, err := config.ContextServerCert(context)
+	return string(data), err
+}
+
+// envInCluster returns the server, namespace and certificate authority of a
+// new environment for the cluster ks runs in, read from the pod's service
+// account. The namespace of the service account is used unless --namespace is
+// given.
+func envInCluster(flags *pflag.FlagSet, config *client.Config, strict bool) (server, namespace, serverCert string, err error) {
+	for _, name := range []string{flagEnvServer, flagEnvContext, flagServerCert, flagMergeKubeconfigs, flagPreferContextNs} {
+		if flags.Changed(name) {
+			return "", "", "", fmt.Errorf("flags '%s' and '%s' are mutually exclusive, because '%s' reads the cluster from the pod's service account",
+				flagInCluster, name, flagInCluster)
+		}
+	}
+
+	inCluster, err := config.UseInCluster()
+	if err != nil {
+		return "", "", "", fmt.Errorf("unable to use the cluster ks is running in with '--%s': %v", flagInCluster, err)
+	}
+
+	namespace, err = flags.GetString(flagEnvNamespace)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	if namespace == "" {
+		namespace = inCluster.Namespace
+	}
+
+	if namespace == "" {
+		if strict {
+			return "", "", "", fmt.Errorf("no namespace was given with '--%s' and the service account doesn't record one", flagEnvNamespace)
+		}
+		namespace = "default"
+	}
+
+	return inCluster.Server, namespace, string(inCluster.ServerCert), nil
+}
+
+// commandLine reconstructs the command line of cmd from the flags that were
+// set and its arguments. Values of credential flags are redacted.
+func commandLine(cmd *cobra.Command, args []string) string {
+	parts := []string{cmd.CommandPath()}
+	parts = append(parts, args...)
+
+	flags := cmd.Flags()
+	flags.Visit(func(f *pflag.Flag) {
+		values := []string{f.Value.String()}
+		switch f.Value.Type() {
+		case "stringSlice":
+			values, _ = flags.GetStringSlice(f.Name)
+		case "stringArray":
+			values, _ = flags.GetStringArray(f.Name)
+		}
+
+		for _, value := range values {
+			if redactedFlags[f.Name] {
+				value = "REDACTED"
+			}
+			parts = append(parts, fmt.Sprintf("--%s=%s", f.Name, value))
+		}
+	})
+
+	return strings.Join(parts, " ")
+}
+
+// appDefaultAPISpec returns the API spec that the app in wd sets for new
+// environments, or an empty string if it doesn't set one. The action reports
+// a missing app.
+func appDefaultAPISpec(fs afero.Fs, wd string) (string, error) {
+	root, err := findAppRoot(fs, wd)
+	if err != nil {
+		return "", nil
+	}
+
+	a, err := app.Load(fs, nil, root)
+	if err != nil {
+		return "", nil
+	}
+
+	return a.DefaultAPISpec()
+}
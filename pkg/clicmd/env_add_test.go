@@ -16,9 +16,12 @@
 package clicmd
 
 import (
+	"io/ioutil"
+	"os"
 	"testing"
 
 	"github.com/ksonnet/ksonnet/pkg/actions"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_envAddCmd(t *testing.T) {
@@ -28,12 +31,139 @@ func Test_envAddCmd(t *testing.T) {
 			args:   []string{"env", "add", "prod", "--server", "http://example.com", "--api-spec", "version:v1.9.5"},
 			action: actionEnvAdd,
 			expected: map[string]interface{}{
-				actions.OptionApp:      nil,
-				actions.OptionEnvName:  "prod",
-				actions.OptionModule:   "default",
-				actions.OptionOverride: false,
-				actions.OptionServer:   "http://example.com",
-				actions.OptionSpecFlag: "version:v1.9.5",
+				actions.OptionApp:                 nil,
+				actions.OptionEnvName:             "prod",
+				actions.OptionModule:              "default",
+				actions.OptionOverride:            false,
+				actions.OptionServer:              "http://example.com",
+				actions.OptionServerCert:          "",
+				actions.OptionContext:             "",
+				actions.OptionSpecFlag:            "version:v1.9.5",
+				actions.OptionPostApplyComponents: []string{},
+				actions.OptionRecord:              false,
+				actions.OptionUser:                "",
+				actions.OptionCommandLine:         "ks env add prod --api-spec=version:v1.9.5 --server=http://example.com",
+				actions.OptionGenerateGitignore:   false,
+				actions.OptionFromHelmValues:      "",
+				actions.OptionCheckReachability:   false,
+				actions.OptionDryRun:              false,
+				actions.OptionSkipLib:             false,
+				actions.OptionSkipNsCheck:         false,
+				actions.OptionInherits:            "",
+				actions.OptionStrict:              false,
+				actions.OptionClientConfig:        nil,
+			},
+		},
+		{
+			name:   "dry run",
+			args:   []string{"env", "add", "prod", "--server", "http://example.com", "--api-spec", "version:v1.9.5", "--dry-run"},
+			action: actionEnvAdd,
+			expected: map[string]interface{}{
+				actions.OptionApp:                 nil,
+				actions.OptionEnvName:             "prod",
+				actions.OptionModule:              "default",
+				actions.OptionOverride:            false,
+				actions.OptionServer:              "http://example.com",
+				actions.OptionServerCert:          "",
+				actions.OptionContext:             "",
+				actions.OptionSpecFlag:            "version:v1.9.5",
+				actions.OptionPostApplyComponents: []string{},
+				actions.OptionRecord:              false,
+				actions.OptionUser:                "",
+				actions.OptionCommandLine:         "ks env add prod --api-spec=version:v1.9.5 --dry-run=true --server=http://example.com",
+				actions.OptionGenerateGitignore:   false,
+				actions.OptionFromHelmValues:      "",
+				actions.OptionCheckReachability:   false,
+				actions.OptionDryRun:              true,
+				actions.OptionSkipLib:             false,
+				actions.OptionSkipNsCheck:         false,
+				actions.OptionInherits:            "",
+				actions.OptionStrict:              false,
+				actions.OptionClientConfig:        nil,
+			},
+		},
+		{
+			name:   "skip lib",
+			args:   []string{"env", "add", "prod", "--server", "http://example.com", "--api-spec", "version:v1.9.5", "--skip-lib"},
+			action: actionEnvAdd,
+			expected: map[string]interface{}{
+				actions.OptionApp:                 nil,
+				actions.OptionEnvName:             "prod",
+				actions.OptionModule:              "default",
+				actions.OptionOverride:            false,
+				actions.OptionServer:              "http://example.com",
+				actions.OptionServerCert:          "",
+				actions.OptionContext:             "",
+				actions.OptionSpecFlag:            "version:v1.9.5",
+				actions.OptionPostApplyComponents: []string{},
+				actions.OptionRecord:              false,
+				actions.OptionUser:                "",
+				actions.OptionCommandLine:         "ks env add prod --api-spec=version:v1.9.5 --server=http://example.com --skip-lib=true",
+				actions.OptionGenerateGitignore:   false,
+				actions.OptionFromHelmValues:      "",
+				actions.OptionCheckReachability:   false,
+				actions.OptionDryRun:              false,
+				actions.OptionSkipLib:             true,
+				actions.OptionSkipNsCheck:         false,
+				actions.OptionInherits:            "",
+				actions.OptionStrict:              false,
+				actions.OptionClientConfig:        nil,
+			},
+		},
+		{
+			name:   "skip ns check",
+			args:   []string{"env", "add", "prod", "--server", "http://example.com", "--api-spec", "version:v1.9.5", "--skip-ns-check"},
+			action: actionEnvAdd,
+			expected: map[string]interface{}{
+				actions.OptionApp:                 nil,
+				actions.OptionEnvName:             "prod",
+				actions.OptionModule:              "default",
+				actions.OptionOverride:            false,
+				actions.OptionServer:              "http://example.com",
+				actions.OptionServerCert:          "",
+				actions.OptionContext:             "",
+				actions.OptionSpecFlag:            "version:v1.9.5",
+				actions.OptionPostApplyComponents: []string{},
+				actions.OptionRecord:              false,
+				actions.OptionUser:                "",
+				actions.OptionCommandLine:         "ks env add prod --api-spec=version:v1.9.5 --server=http://example.com --skip-ns-check=true",
+				actions.OptionGenerateGitignore:   false,
+				actions.OptionFromHelmValues:      "",
+				actions.OptionCheckReachability:   false,
+				actions.OptionDryRun:              false,
+				actions.OptionSkipLib:             false,
+				actions.OptionSkipNsCheck:         true,
+				actions.OptionInherits:            "",
+				actions.OptionStrict:              false,
+				actions.OptionClientConfig:        nil,
+			},
+		},
+		{
+			name:   "inherit",
+			args:   []string{"env", "add", "prod", "--server", "http://example.com", "--api-spec", "version:v1.9.5", "--inherit", "base"},
+			action: actionEnvAdd,
+			expected: map[string]interface{}{
+				actions.OptionApp:                 nil,
+				actions.OptionEnvName:             "prod",
+				actions.OptionModule:              "default",
+				actions.OptionOverride:            false,
+				actions.OptionServer:              "http://example.com",
+				actions.OptionServerCert:          "",
+				actions.OptionContext:             "",
+				actions.OptionSpecFlag:            "version:v1.9.5",
+				actions.OptionPostApplyComponents: []string{},
+				actions.OptionRecord:              false,
+				actions.OptionUser:                "",
+				actions.OptionCommandLine:         "ks env add prod --api-spec=version:v1.9.5 --inherit=base --server=http://example.com",
+				actions.OptionGenerateGitignore:   false,
+				actions.OptionFromHelmValues:      "",
+				actions.OptionCheckReachability:   false,
+				actions.OptionDryRun:              false,
+				actions.OptionSkipLib:             false,
+				actions.OptionSkipNsCheck:         false,
+				actions.OptionInherits:            "base",
+				actions.OptionStrict:              false,
+				actions.OptionClientConfig:        nil,
 			},
 		},
 		{
@@ -41,12 +171,27 @@ func Test_envAddCmd(t *testing.T) {
 			args:   []string{"env", "add", "prod", "--server", "http://example.com", "--api-spec", "version:v1.9.5", "-o"},
 			action: actionEnvAdd,
 			expected: map[string]interface{}{
-				actions.OptionApp:      nil,
-				actions.OptionEnvName:  "prod",
-				actions.OptionModule:   "default",
-				actions.OptionOverride: true,
-				actions.OptionServer:   "http://example.com",
-				actions.OptionSpecFlag: "version:v1.9.5",
+				actions.OptionApp:                 nil,
+				actions.OptionEnvName:             "prod",
+				actions.OptionModule:              "default",
+				actions.OptionOverride:            true,
+				actions.OptionServer:              "http://example.com",
+				actions.OptionServerCert:          "",
+				actions.OptionContext:             "",
+				actions.OptionSpecFlag:            "version:v1.9.5",
+				actions.OptionPostApplyComponents: []string{},
+				actions.OptionRecord:              false,
+				actions.OptionUser:                "",
+				actions.OptionCommandLine:         "ks env add prod --api-spec=version:v1.9.5 --override=true --server=http://example.com",
+				actions.OptionGenerateGitignore:   false,
+				actions.OptionFromHelmValues:      "",
+				actions.OptionCheckReachability:   false,
+				actions.OptionDryRun:              false,
+				actions.OptionSkipLib:             false,
+				actions.OptionSkipNsCheck:         false,
+				actions.OptionInherits:            "",
+				actions.OptionStrict:              false,
+				actions.OptionClientConfig:        nil,
 			},
 		},
 		{
@@ -54,12 +199,56 @@ func Test_envAddCmd(t *testing.T) {
 			args:   []string{"env", "add", "prod", "--server", "http://example.com", "--api-spec", "version:v1.9.5", "--override"},
 			action: actionEnvAdd,
 			expected: map[string]interface{}{
-				actions.OptionApp:      nil,
-				actions.OptionEnvName:  "prod",
-				actions.OptionModule:   "default",
-				actions.OptionOverride: true,
-				actions.OptionServer:   "http://example.com",
-				actions.OptionSpecFlag: "version:v1.9.5",
+				actions.OptionApp:                 nil,
+				actions.OptionEnvName:             "prod",
+				actions.OptionModule:              "default",
+				actions.OptionOverride:            true,
+				actions.OptionServer:              "http://example.com",
+				actions.OptionServerCert:          "",
+				actions.OptionContext:             "",
+				actions.OptionSpecFlag:            "version:v1.9.5",
+				actions.OptionPostApplyComponents: []string{},
+				actions.OptionRecord:              false,
+				actions.OptionUser:                "",
+				actions.OptionCommandLine:         "ks env add prod --api-spec=version:v1.9.5 --override=true --server=http://example.com",
+				actions.OptionGenerateGitignore:   false,
+				actions.OptionFromHelmValues:      "",
+				actions.OptionCheckReachability:   false,
+				actions.OptionDryRun:              false,
+				actions.OptionSkipLib:             false,
+				actions.OptionSkipNsCheck:         false,
+				actions.OptionInherits:            "",
+				actions.OptionStrict:              false,
+				actions.OptionClientConfig:        nil,
+			},
+		},
+		{
+			name: "post apply components",
+			args: []string{"env", "add", "prod", "--server", "http://example.com", "--api-spec", "version:v1.9.5",
+				"--post-apply-component", "io.ksonnet.pkg.monitoring-agent", "--post-apply-component", "io.ksonnet.pkg.logging-agent:logging"},
+			action: actionEnvAdd,
+			expected: map[string]interface{}{
+				actions.OptionApp:                 nil,
+				actions.OptionEnvName:             "prod",
+				actions.OptionModule:              "default",
+				actions.OptionOverride:            false,
+				actions.OptionServer:              "http://example.com",
+				actions.OptionServerCert:          "",
+				actions.OptionContext:             "",
+				actions.OptionSpecFlag:            "version:v1.9.5",
+				actions.OptionPostApplyComponents: []string{"io.ksonnet.pkg.monitoring-agent", "io.ksonnet.pkg.logging-agent:logging"},
+				actions.OptionRecord:              false,
+				actions.OptionUser:                "",
+				actions.OptionCommandLine:         "ks env add prod --api-spec=version:v1.9.5 --post-apply-component=io.ksonnet.pkg.monitoring-agent --post-apply-component=io.ksonnet.pkg.logging-agent:logging --server=http://example.com",
+				actions.OptionGenerateGitignore:   false,
+				actions.OptionFromHelmValues:      "",
+				actions.OptionCheckReachability:   false,
+				actions.OptionDryRun:              false,
+				actions.OptionSkipLib:             false,
+				actions.OptionSkipNsCheck:         false,
+				actions.OptionInherits:            "",
+				actions.OptionStrict:              false,
+				actions.OptionClientConfig:        nil,
 			},
 		},
 		{
@@ -67,6 +256,291 @@ func Test_envAddCmd(t *testing.T) {
 			args:  []string{"env", "add"},
 			isErr: true,
 		},
+		{
+			name:   "from file",
+			args:   []string{"env", "add", "--from-file", "envs.yaml", "--fail-fast", "--skip-lib"},
+			action: actionEnvAddFromFile,
+			expected: map[string]interface{}{
+				actions.OptionApp:          nil,
+				actions.OptionFromFile:     "envs.yaml",
+				actions.OptionFailFast:     true,
+				actions.OptionOverride:     false,
+				actions.OptionSkipLib:      true,
+				actions.OptionSkipNsCheck:  false,
+				actions.OptionClientConfig: nil,
+			},
+		},
+		{
+			name:  "from file with a name",
+			args:  []string{"env", "add", "prod", "--from-file", "envs.yaml"},
+			isErr: true,
+		},
+		{
+			name:  "from file with a server",
+			args:  []string{"env", "add", "--from-file", "envs.yaml", "--server", "http://example.com"},
+			isErr: true,
+		},
+		{
+			name:  "fail fast without a file",
+			args:  []string{"env", "add", "prod", "--server", "http://example.com", "--api-spec", "version:v1.9.5", "--fail-fast"},
+			isErr: true,
+		},
+		{
+			name:  "invalid name",
+			args:  []string{"env", "add", "us-west//prod", "--server", "http://example.com", "--api-spec", "version:v1.9.5"},
+			isErr: true,
+		},
+		{
+			name:  "merge kubeconfigs with missing file",
+			args:  []string{"env", "add", "prod", "--server", "http://example.com", "--api-spec", "version:v1.9.5", "--merge-kubeconfigs", "/missing/a.yaml,/missing/b.yaml"},
+			isErr: true,
+		},
+		{
+			name: "record",
+			args: []string{"env", "add", "prod", "--server", "http://example.com", "--api-spec", "version:v1.9.5",
+				"--record", "--as-user", "deploy-bot", "--token", "secret"},
+			action: actionEnvAdd,
+			expected: map[string]interface{}{
+				actions.OptionApp:                 nil,
+				actions.OptionEnvName:             "prod",
+				actions.OptionModule:              "default",
+				actions.OptionOverride:            false,
+				actions.OptionServer:              "http://example.com",
+				actions.OptionServerCert:          "",
+				actions.OptionContext:             "",
+				actions.OptionSpecFlag:            "version:v1.9.5",
+				actions.OptionPostApplyComponents: []string{},
+				actions.OptionRecord:              true,
+				actions.OptionUser:                "deploy-bot",
+				actions.OptionCommandLine:         "ks env add prod --api-spec=version:v1.9.5 --as-user=deploy-bot --record=true --server=http://example.com --token=REDACTED",
+				actions.OptionGenerateGitignore:   false,
+				actions.OptionFromHelmValues:      "",
+				actions.OptionCheckReachability:   false,
+				actions.OptionDryRun:              false,
+				actions.OptionSkipLib:             false,
+				actions.OptionSkipNsCheck:         false,
+				actions.OptionInherits:            "",
+				actions.OptionStrict:              false,
+				actions.OptionClientConfig:        nil,
+			},
+		},
+		{
+			name:   "strict",
+			args:   []string{"env", "add", "prod", "--server", "http://example.com", "--namespace", "web", "--api-spec", "version:v1.9.5", "--strict", "--check-reachability"},
+			action: actionEnvAdd,
+			expected: map[string]interface{}{
+				actions.OptionApp:                 nil,
+				actions.OptionEnvName:             "prod",
+				actions.OptionModule:              "web",
+				actions.OptionOverride:            false,
+				actions.OptionServer:              "http://example.com",
+				actions.OptionServerCert:          "",
+				actions.OptionContext:             "",
+				actions.OptionSpecFlag:            "version:v1.9.5",
+				actions.OptionPostApplyComponents: []string{},
+				actions.OptionRecord:              false,
+				actions.OptionUser:                "",
+				actions.OptionCommandLine:         "ks env add prod --api-spec=version:v1.9.5 --check-reachability=true --namespace=web --server=http://example.com --strict=true",
+				actions.OptionGenerateGitignore:   false,
+				actions.OptionFromHelmValues:      "",
+				actions.OptionCheckReachability:   true,
+				actions.OptionDryRun:              false,
+				actions.OptionSkipLib:             false,
+				actions.OptionSkipNsCheck:         false,
+				actions.OptionInherits:            "",
+				actions.OptionStrict:              true,
+				actions.OptionClientConfig:        nil,
+			},
+		},
+		{
+			name:  "strict without namespace",
+			args:  []string{"env", "add", "prod", "--server", "http://example.com", "--api-spec", "version:v1.9.5", "--strict"},
+			isErr: true,
+		},
+		{
+			name:   "generate gitignore",
+			args:   []string{"env", "add", "prod", "--server", "http://example.com", "--api-spec", "version:v1.9.5", "--generate-gitignore"},
+			action: actionEnvAdd,
+			expected: map[string]interface{}{
+				actions.OptionApp:                 nil,
+				actions.OptionEnvName:             "prod",
+				actions.OptionModule:              "default",
+				actions.OptionOverride:            false,
+				actions.OptionServer:              "http://example.com",
+				actions.OptionServerCert:          "",
+				actions.OptionContext:             "",
+				actions.OptionSpecFlag:            "version:v1.9.5",
+				actions.OptionPostApplyComponents: []string{},
+				actions.OptionRecord:              false,
+				actions.OptionUser:                "",
+				actions.OptionCommandLine:         "ks env add prod --api-spec=version:v1.9.5 --generate-gitignore=true --server=http://example.com",
+				actions.OptionGenerateGitignore:   true,
+				actions.OptionFromHelmValues:      "",
+				actions.OptionCheckReachability:   false,
+				actions.OptionDryRun:              false,
+				actions.OptionSkipLib:             false,
+				actions.OptionSkipNsCheck:         false,
+				actions.OptionInherits:            "",
+				actions.OptionStrict:              false,
+				actions.OptionClientConfig:        nil,
+			},
+		},
+		{
+			name:   "from helm values",
+			args:   []string{"env", "add", "prod", "--server", "http://example.com", "--api-spec", "version:v1.9.5", "--from-helm-values", "values.yaml"},
+			action: actionEnvAdd,
+			expected: map[string]interface{}{
+				actions.OptionApp:                 nil,
+				actions.OptionEnvName:             "prod",
+				actions.OptionModule:              "default",
+				actions.OptionOverride:            false,
+				actions.OptionServer:              "http://example.com",
+				actions.OptionServerCert:          "",
+				actions.OptionContext:             "",
+				actions.OptionSpecFlag:            "version:v1.9.5",
+				actions.OptionPostApplyComponents: []string{},
+				actions.OptionRecord:              false,
+				actions.OptionUser:                "",
+				actions.OptionCommandLine:         "ks env add prod --api-spec=version:v1.9.5 --from-helm-values=values.yaml --server=http://example.com",
+				actions.OptionGenerateGitignore:   false,
+				actions.OptionFromHelmValues:      "values.yaml",
+				actions.OptionCheckReachability:   false,
+				actions.OptionDryRun:              false,
+				actions.OptionSkipLib:             false,
+				actions.OptionSkipNsCheck:         false,
+				actions.OptionInherits:            "",
+				actions.OptionStrict:              false,
+				actions.OptionClientConfig:        nil,
+			},
+		},
+	}
+
+	runTestCmd(t, cases)
+}
+
+func Test_envAddCmd_server_cert(t *testing.T) {
+	cert, err := ioutil.ReadFile("testdata/ca.pem")
+	require.NoError(t, err)
+
+	cases := []cmdTestCase{
+		{
+			name:   "server cert",
+			args:   []string{"env", "add", "prod", "--server", "https://example.com", "--api-spec", "version:v1.9.5", "--server-cert", "testdata/ca.pem"},
+			action: actionEnvAdd,
+			expected: map[string]interface{}{
+				actions.OptionApp:                 nil,
+				actions.OptionEnvName:             "prod",
+				actions.OptionModule:              "default",
+				actions.OptionOverride:            false,
+				actions.OptionServer:              "https://example.com",
+				actions.OptionServerCert:          string(cert),
+				actions.OptionContext:             "",
+				actions.OptionSpecFlag:            "version:v1.9.5",
+				actions.OptionPostApplyComponents: []string{},
+				actions.OptionRecord:              false,
+				actions.OptionUser:                "",
+				actions.OptionCommandLine:         "ks env add prod --api-spec=version:v1.9.5 --server=https://example.com --server-cert=testdata/ca.pem",
+				actions.OptionGenerateGitignore:   false,
+				actions.OptionFromHelmValues:      "",
+				actions.OptionCheckReachability:   false,
+				actions.OptionDryRun:              false,
+				actions.OptionSkipLib:             false,
+				actions.OptionSkipNsCheck:         false,
+				actions.OptionInherits:            "",
+				actions.OptionStrict:              false,
+				actions.OptionClientConfig:        nil,
+			},
+		},
+		{
+			name:   "invalid server cert",
+			args:   []string{"env", "add", "prod", "--server", "https://example.com", "--api-spec", "version:v1.9.5", "--server-cert", "testdata/app.yaml"},
+			action: actionEnvAdd,
+			isErr:  true,
+		},
+		{
+			name:   "missing server cert",
+			args:   []string{"env", "add", "prod", "--server", "https://example.com", "--api-spec", "version:v1.9.5", "--server-cert", "testdata/missing.pem"},
+			action: actionEnvAdd,
+			isErr:  true,
+		},
+	}
+
+	runTestCmd(t, cases)
+}
+
+func Test_envAddCmd_in_cluster(t *testing.T) {
+	// Outside of a pod, the in-cluster config can't be loaded.
+	host := os.Getenv("KUBERNETES_SERVICE_HOST")
+	defer os.Setenv("KUBERNETES_SERVICE_HOST", host)
+	os.Setenv("KUBERNETES_SERVICE_HOST", "")
+
+	cases := []cmdTestCase{
+		{
+			name:   "not in a cluster",
+			args:   []string{"env", "add", "ci", "--in-cluster", "--api-spec", "version:v1.9.5"},
+			action: actionEnvAdd,
+			isErr:  true,
+		},
+		{
+			name:   "with context",
+			args:   []string{"env", "add", "ci", "--in-cluster", "--context", "dev", "--api-spec", "version:v1.9.5"},
+			action: actionEnvAdd,
+			isErr:  true,
+		},
+	}
+
+	runTestCmd(t, cases)
+}
+
+func Test_envAddCmd_default_api_spec(t *testing.T) {
+	expected := func(specFlag, commandLine string, strict bool) map[string]interface{} {
+		return map[string]interface{}{
+			actions.OptionApp:                 nil,
+			actions.OptionEnvName:             "prod",
+			actions.OptionModule:              "default",
+			actions.OptionOverride:            false,
+			actions.OptionServer:              "http://example.com",
+			actions.OptionServerCert:          "",
+			actions.OptionContext:             "",
+			actions.OptionSpecFlag:            specFlag,
+			actions.OptionPostApplyComponents: []string{},
+			actions.OptionRecord:              false,
+			actions.OptionUser:                "",
+			actions.OptionCommandLine:         commandLine,
+			actions.OptionGenerateGitignore:   false,
+			actions.OptionFromHelmValues:      "",
+			actions.OptionCheckReachability:   false,
+			actions.OptionDryRun:              false,
+			actions.OptionSkipLib:             false,
+			actions.OptionSkipNsCheck:         false,
+			actions.OptionInherits:            "",
+			actions.OptionStrict:              strict,
+			actions.OptionClientConfig:        nil,
+		}
+	}
+
+	cases := []cmdTestCase{
+		{
+			name:     "from the app",
+			args:     []string{"env", "add", "prod", "--server", "http://example.com"},
+			action:   actionEnvAdd,
+			appFile:  "app-default-api-spec.yaml",
+			expected: expected("version:v1.11.2", "ks env add prod --server=http://example.com", false),
+		},
+		{
+			name:     "from the app with strict",
+			args:     []string{"env", "add", "prod", "--server", "http://example.com", "--namespace", "default", "--strict"},
+			action:   actionEnvAdd,
+			appFile:  "app-default-api-spec.yaml",
+			expected: expected("version:v1.11.2", "ks env add prod --namespace=default --server=http://example.com --strict=true", true),
+		},
+		{
+			name:     "flag overrides the app",
+			args:     []string{"env", "add", "prod", "--server", "http://example.com", "--api-spec", "version:v1.9.5"},
+			action:   actionEnvAdd,
+			appFile:  "app-default-api-spec.yaml",
+			expected: expected("version:v1.9.5", "ks env add prod --api-spec=version:v1.9.5 --server=http://example.com", false),
+		},
 	}
 
 	runTestCmd(t, cases)
@@ -0,0 +1,76 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package clicmd
+
+import (
+	"github.com/ksonnet/ksonnet/pkg/actions"
+	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/spf13/cobra"
+)
+
+var (
+	envCheckContextsLong = `
+The ` + "`check-contexts`" + ` command lists environments whose originating kubeconfig
+context no longer exists, e.g. because it was renamed or removed. Environments
+record the context they were created from when ` + "`ks env add`" + ` or
+` + "`ks env set --context`" + ` resolves their server from a context. Environments
+whose server was set explicitly are not checked.
+
+For each environment that is listed, run ` + "`ks env set <env-name> --context`" + `
+with an existing context to update its server and recorded context.
+
+The command exits with a non-zero status if any environment references a missing
+context.
+
+### Related Commands
+
+* ` + "`ks env list` " + `— ` + envShortDesc["list"] + `
+* ` + "`ks env set` " + `— ` + envShortDesc["set"] + `
+
+### Syntax
+`
+	envCheckContextsExample = `
+# List environments whose context is missing from the current kubeconfig file
+# ($KUBECONFIG)
+ks env check-contexts
+
+# List environments whose context is missing from another kubeconfig file
+ks env check-contexts --kubeconfig=/path/to/kubeconfig`
+)
+
+func newEnvCheckContextsCmd() *cobra.Command {
+	envClientConfig := client.NewDefaultClientConfig()
+
+	envCheckContextsCmd := &cobra.Command{
+		Use:     "check-contexts",
+		Short:   envShortDesc["check-contexts"],
+		Long:    envCheckContextsLong,
+		Example: envCheckContextsExample,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m := map[string]interface{}{
+				actions.OptionClientConfig: envClientConfig,
+			}
+			addGlobalOptions(m)
+
+			return runAction(actionEnvCheckContexts, m)
+		},
+	}
+
+	envClientConfig.BindClientGoFlags(envCheckContextsCmd)
+
+	return envCheckContextsCmd
+}
@@ -0,0 +1,98 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package clicmd
+
+import (
+	"fmt"
+
+	"github.com/ksonnet/ksonnet/pkg/actions"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	vEnvCloneAcrossApps = "env-clone-across-apps"
+)
+
+var (
+	envCloneLong = `
+The ` + "`clone`" + ` command copies an environment to a new one. With ` + "`--across-apps`" + `, the
+environment is copied from the ksonnet app in another directory into the current
+app, so a proven environment can be reused by a new project.
+
+The environment's configuration in ` + "`app.yaml`" + ` is copied along with its directory
+in ` + "`environments/`" + `. The kubeconfig context and provenance of the environment are
+not copied, because they belong to whoever created it. The ksonnet-lib generated
+for the environment's Kubernetes version and the vendored packages of its
+libraries are copied too, unless the current app already has them.
+
+When cloning across apps, a warning is shown if the environment uses a
+Kubernetes version none of the current app's environments use, or libraries
+from registries the current app doesn't have, or at versions other than the
+current app's.
+
+### Related Commands
+
+* ` + "`ks env list` " + `— ` + envShortDesc["list"] + `
+* ` + "`ks env add` " + `— ` + envShortDesc["add"] + `
+
+### Syntax
+`
+	envCloneExample = `
+# Copy the 'prod' environment of the current app to a new 'prod-canary'
+# environment
+ks env clone prod prod-canary
+
+# Copy the 'prod' environment of the app in '../storefront' into the current app
+ks env clone prod --across-apps=../storefront
+
+# Copy the 'prod' environment of the app in '../storefront' into the current app
+# as 'storefront-prod'
+ks env clone prod storefront-prod --across-apps=../storefront`
+)
+
+func newEnvCloneCmd() *cobra.Command {
+	envCloneCmd := &cobra.Command{
+		Use:     "clone <env-name> [<new-env-name>] [--across-apps <app-dir>]",
+		Short:   envShortDesc["clone"],
+		Long:    envCloneLong,
+		Example: envCloneExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 1 || len(args) > 2 {
+				return fmt.Errorf("'env clone' takes the name of the environment, and optionally the name of the new environment")
+			}
+
+			var newName string
+			if len(args) == 2 {
+				newName = args[1]
+			}
+
+			m := map[string]interface{}{
+				actions.OptionEnvName:    args[0],
+				actions.OptionNewEnvName: newName,
+				actions.OptionAcrossApps: viper.GetString(vEnvCloneAcrossApps),
+			}
+			addGlobalOptions(m)
+
+			return runAction(actionEnvClone, m)
+		},
+	}
+
+	envCloneCmd.Flags().String(flagAcrossApps, "", "Directory of the ksonnet app to clone the environment from")
+	viper.BindPFlag(vEnvCloneAcrossApps, envCloneCmd.Flags().Lookup(flagAcrossApps))
+
+	return envCloneCmd
+}
@@ -0,0 +1,61 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package clicmd
+
+import (
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/actions"
+)
+
+func Test_envCloneCmd(t *testing.T) {
+	cases := []cmdTestCase{
+		{
+			name:   "within the app",
+			args:   []string{"env", "clone", "prod", "prod-canary"},
+			action: actionEnvClone,
+			expected: map[string]interface{}{
+				actions.OptionApp:        nil,
+				actions.OptionEnvName:    "prod",
+				actions.OptionNewEnvName: "prod-canary",
+				actions.OptionAcrossApps: "",
+			},
+		},
+		{
+			name:   "across apps",
+			args:   []string{"env", "clone", "prod", "--across-apps", "../storefront"},
+			action: actionEnvClone,
+			expected: map[string]interface{}{
+				actions.OptionApp:        nil,
+				actions.OptionEnvName:    "prod",
+				actions.OptionNewEnvName: "",
+				actions.OptionAcrossApps: "../storefront",
+			},
+		},
+		{
+			name:  "without arguments",
+			args:  []string{"env", "clone"},
+			isErr: true,
+		},
+		{
+			name:  "with too many arguments",
+			args:  []string{"env", "clone", "a", "b", "c"},
+			isErr: true,
+		},
+	}
+
+	runTestCmd(t, cases)
+}
@@ -0,0 +1,90 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package clicmd
+
+import (
+	"fmt"
+
+	"github.com/ksonnet/ksonnet/pkg/actions"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	vEnvDedupeLibsApply = "env-dedupe-libs-apply"
+)
+
+var (
+	envDedupeLibsLong = `
+The ` + "`dedupe-libs`" + ` command finds environments whose servers are the same cluster,
+once their URIs are normalized, and lists the ksonnet-lib each of them uses.
+ksonnet-lib is generated once for each Kubernetes version in
+` + "`lib/ksonnet-lib/<version>`" + `, so environments on one cluster that record different
+versions, e.g. because they were added before and after a patch upgrade, each
+need their own.
+
+An environment can share the ksonnet-lib of the newest Kubernetes version
+generated for its cluster if both were generated from the same type
+definitions, so the objects it generates don't change. Environments whose
+version is detected from the cluster, or whose ksonnet-lib differs or hasn't
+been generated, keep the one they use.
+
+By default, nothing is changed. With ` + "`--apply`" + `, the environments are updated to
+use the shared ksonnet-lib, as with ` + "`ks env set --api-spec`" + `, and ksonnet-lib that
+no environment uses any more is removed.
+
+### Related Commands
+
+* ` + "`ks env list` " + `— ` + envShortDesc["list"] + `
+* ` + "`ks env set` " + `— ` + envShortDesc["set"] + `
+* ` + "`ks env verify-lib` " + `— ` + envShortDesc["verify-lib"] + `
+
+### Syntax
+`
+	envDedupeLibsExample = `
+# List the environments on each cluster, and the ksonnet-lib they can share
+ks env dedupe-libs
+
+# Make the environments use the shared ksonnet-lib, and remove ksonnet-lib which
+# is no longer used
+ks env dedupe-libs --apply`
+)
+
+func newEnvDedupeLibsCmd() *cobra.Command {
+	envDedupeLibsCmd := &cobra.Command{
+		Use:     "dedupe-libs [--apply]",
+		Short:   envShortDesc["dedupe-libs"],
+		Long:    envDedupeLibsLong,
+		Example: envDedupeLibsExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				return fmt.Errorf("'env dedupe-libs' takes no arguments")
+			}
+
+			m := map[string]interface{}{
+				actions.OptionApply: viper.GetBool(vEnvDedupeLibsApply),
+			}
+			addGlobalOptions(m)
+
+			return runAction(actionEnvDedupeLibs, m)
+		},
+	}
+
+	envDedupeLibsCmd.Flags().Bool(flagApply, false, "Make the environments use the shared ksonnet-lib and remove ksonnet-lib which is no longer used")
+	viper.BindPFlag(vEnvDedupeLibsApply, envDedupeLibsCmd.Flags().Lookup(flagApply))
+
+	return envDedupeLibsCmd
+}
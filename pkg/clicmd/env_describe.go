@@ -19,13 +19,41 @@ import (
 	"github.com/ksonnet/ksonnet/pkg/actions"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	vEnvDescribeSchema = "env-describe-schema"
+)
+
+var (
+	envDescribeLong = `
+The ` + "`describe`" + ` command prints the configuration of an environment, as it is
+saved in ` + "`app.yaml`" + ` and its overrides.
+
+For auditing, ` + "`--schema`" + ` prints the effective configuration of the environment
+as JSON instead: its server, namespace, the SHA-256 fingerprint of its server
+certificate, the environments it inherits parameters from, and its Kubernetes
+version. The fields are always in the same order, so the output can be checked
+into source control and diffed across commits.
+
+### Syntax
+`
+	envDescribeExample = `
+# Describe the environment "us-west/staging"
+ks env describe us-west/staging
+
+# Save the effective configuration of the environment "us-west/staging" for
+# auditing
+ks env describe us-west/staging --schema > audit/us-west-staging.json`
 )
 
 func newEnvDescribeCmd() *cobra.Command {
 	envDescribeCmd := &cobra.Command{
-		Use:   "describe <env>",
-		Short: "Describe an environment",
-		Long:  `describe`,
+		Use:     "describe <env>",
+		Short:   "Describe an environment",
+		Long:    envDescribeLong,
+		Example: envDescribeExample,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) != 1 {
 				return errors.New("env describe <environment>")
@@ -33,6 +61,7 @@ func newEnvDescribeCmd() *cobra.Command {
 
 			m := map[string]interface{}{
 				actions.OptionEnvName: args[0],
+				actions.OptionSchema:  viper.GetBool(vEnvDescribeSchema),
 			}
 			addGlobalOptions(m)
 
@@ -40,6 +69,9 @@ func newEnvDescribeCmd() *cobra.Command {
 		},
 	}
 
+	envDescribeCmd.Flags().Bool(flagSchema, false, "Print the effective configuration of the environment as JSON with a stable field order")
+	viper.BindPFlag(vEnvDescribeSchema, envDescribeCmd.Flags().Lookup(flagSchema))
+
 	return envDescribeCmd
 
 }
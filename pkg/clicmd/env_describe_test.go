@@ -30,6 +30,17 @@ func Test_envDescribeCmd(t *testing.T) {
 			expected: map[string]interface{}{
 				actions.OptionApp:     nil,
 				actions.OptionEnvName: "prod",
+				actions.OptionSchema:  false,
+			},
+		},
+		{
+			name:   "schema",
+			args:   []string{"env", "describe", "prod", "--schema"},
+			action: actionEnvDescribe,
+			expected: map[string]interface{}{
+				actions.OptionApp:     nil,
+				actions.OptionEnvName: "prod",
+				actions.OptionSchema:  true,
 			},
 		},
 		{
@@ -0,0 +1,95 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package clicmd
+
+import (
+	"fmt"
+
+	"github.com/ksonnet/ksonnet/pkg/actions"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	vEnvDiffShowSame = "env-diff-show-same"
+)
+
+var (
+	envDiffLong = `
+The ` + "`diff`" + ` command compares the configuration of two environments, such as
+before promoting a change from ` + "`staging`" + ` to ` + "`prod`" + `. It compares their server,
+namespace, kubeconfig context, API spec, Kubernetes version of the generated
+ksonnet-lib, and targets, as they are configured in ` + "`app.yaml`" + ` and its
+overrides.
+
+Fields that differ are printed like the output of ` + "`ks diff`" + `: the value of the
+first environment prefixed with ` + "`-`" + `, followed by the value of the second
+prefixed with ` + "`+`" + `. Fields that are the same are left out, unless
+` + "`--show-same`" + ` is given. It is an error if either environment doesn't exist.
+
+### Related Commands
+
+* ` + "`ks env describe` " + `— Describe an environment
+* ` + "`ks param diff` " + `— ` + paramShortDesc["diff"] + `
+
+### Syntax
+`
+	envDiffExample = `
+# Show how the environments 'staging' and 'prod' differ
+ks env diff staging prod
+
+# Show all the compared fields of 'staging' and 'prod', including the ones
+# that are the same
+ks env diff staging prod --show-same`
+)
+
+func newEnvDiffCmd(fs afero.Fs) *cobra.Command {
+	envDiffCmd := &cobra.Command{
+		Use:     "diff <env1> <env2>",
+		Short:   envShortDesc["diff"],
+		Long:    envDiffLong,
+		Example: envDiffExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return fmt.Errorf("'env diff' takes exactly two arguments: the respective names of the environments being compared")
+			}
+
+			var names [2]string
+			for i, arg := range args {
+				name, err := resolveEnvName(fs, viper.GetString(flagDir), arg, true)
+				if err != nil {
+					return err
+				}
+				names[i] = name
+			}
+
+			m := map[string]interface{}{
+				actions.OptionEnvName1: names[0],
+				actions.OptionEnvName2: names[1],
+				actions.OptionShowSame: viper.GetBool(vEnvDiffShowSame),
+			}
+			addGlobalOptions(m)
+
+			return runAction(actionEnvDiff, m)
+		},
+	}
+
+	envDiffCmd.Flags().Bool(flagShowSame, false, "Also print the fields that are the same in both environments")
+	viper.BindPFlag(vEnvDiffShowSame, envDiffCmd.Flags().Lookup(flagShowSame))
+
+	return envDiffCmd
+}
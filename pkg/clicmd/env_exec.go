@@ -0,0 +1,81 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package clicmd
+
+import (
+	"github.com/ksonnet/ksonnet/pkg/actions"
+	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	envExecLong = `
+The ` + "`exec`" + ` command runs an arbitrary command (typically ` + "`kubectl`" + ` or
+` + "`helm`" + `) against the cluster of a ksonnet environment. A temporary kubeconfig
+file is generated with a context that points at the environment's server and
+namespace, and ` + "`$KUBECONFIG`" + ` is set to it for the duration of the command.
+Credentials are taken from your kubeconfig file, using the user of your current
+context unless ` + "`--user`" + ` is specified. They are only used if a cluster in your
+kubeconfig file has the environment's server; other servers are accessed
+anonymously.
+
+The command's environment also contains ` + "`$KS_ENV`" + `, ` + "`$KS_ENV_SERVER`" + `, and
+` + "`$KS_ENV_NAMESPACE`" + `.
+
+### Related Commands
+
+* ` + "`ks env list` " + `— ` + envShortDesc["list"] + `
+* ` + "`ks env current` " + `— ` + envShortDesc["current"] + `
+
+### Syntax
+`
+	envExecExample = `
+# List the pods in the "prod" environment's namespace
+ks env exec prod -- kubectl get pods
+
+# Install a chart into the "us-west/staging" environment
+ks env exec us-west/staging -- helm install stable/redis`
+)
+
+func newEnvExecCmd() *cobra.Command {
+	envClientConfig := client.NewDefaultClientConfig()
+
+	envExecCmd := &cobra.Command{
+		Use:     "exec <env> -- <command> [args...]",
+		Short:   envShortDesc["exec"],
+		Long:    envExecLong,
+		Example: envExecExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 2 {
+				return errors.New("env exec <env> -- <command> [args...]")
+			}
+
+			m := map[string]interface{}{
+				actions.OptionEnvName:      args[0],
+				actions.OptionArguments:    args[1:],
+				actions.OptionClientConfig: envClientConfig,
+			}
+			addGlobalOptions(m)
+
+			return runAction(actionEnvExec, m)
+		},
+	}
+
+	envClientConfig.BindClientGoFlags(envExecCmd)
+
+	return envExecCmd
+}
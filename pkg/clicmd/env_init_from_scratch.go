@@ -0,0 +1,82 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package clicmd
+
+import (
+	"github.com/ksonnet/ksonnet/pkg/actions"
+	"github.com/ksonnet/ksonnet/pkg/env"
+	"github.com/ksonnet/ksonnet/pkg/lib"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	vEnvInitFromScratchNamespace = "env-init-from-scratch-namespace"
+)
+
+var (
+	envInitFromScratchLong = `
+The ` + "`init-from-scratch`" + ` command adds an environment that doesn't need access to a
+cluster, so components can be developed and inspected with ` + "`ks show`" + ` locally.
+
+The environment's server is the placeholder ` + "`" + env.OfflineServer + "`" + `, and its
+ksonnet-lib is generated from the OpenAPI spec of Kubernetes ` + lib.OfflineKubernetesVersion + `, which
+is bundled with ksonnet. The environment is marked as offline in ` + "`app.yaml`" + `, and
+` + "`ks apply`" + ` refuses to apply it until it is pointed at a real cluster with
+` + "`ks env set <env-name> --server`" + ` or ` + "`--context`" + `.
+
+### Related Commands
+
+* ` + "`ks env add` " + `— ` + envShortDesc["add"] + `
+* ` + "`ks env set` " + `— ` + envShortDesc["set"] + `
+* ` + "`ks show` " + `— ` + showShortDesc + `
+
+### Syntax
+`
+	envInitFromScratchExample = `
+# Add an environment "local" that doesn't need a cluster
+ks env init-from-scratch local
+
+# Add an environment "local" whose objects are in the "dev" namespace
+ks env init-from-scratch local --namespace=dev
+
+# Point the "local" environment at a real cluster later
+ks env set local --context=minikube`
+)
+
+func newEnvInitFromScratchCmd() *cobra.Command {
+	envInitFromScratchCmd := &cobra.Command{
+		Use:     "init-from-scratch <env-name>",
+		Short:   envShortDesc["init-from-scratch"],
+		Long:    envInitFromScratchLong,
+		Example: envInitFromScratchExample,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m := map[string]interface{}{
+				actions.OptionEnvName:   args[0],
+				actions.OptionNamespace: viper.GetString(vEnvInitFromScratchNamespace),
+			}
+			addGlobalOptions(m)
+
+			return runAction(actionEnvInitFromScratch, m)
+		},
+	}
+
+	envInitFromScratchCmd.Flags().String(flagEnvNamespace, "", "Namespace of the environment (default: default)")
+	viper.BindPFlag(vEnvInitFromScratchNamespace, envInitFromScratchCmd.Flags().Lookup(flagEnvNamespace))
+
+	return envInitFromScratchCmd
+}
@@ -19,12 +19,20 @@ import (
 	"fmt"
 
 	"github.com/ksonnet/ksonnet/pkg/actions"
+	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
 const (
-	vEnvListOutput = "env-list-output"
+	vEnvListAll            = "env-list-all"
+	vEnvListOutput         = "env-list-output"
+	vEnvListStaleContexts  = "env-list-stale-contexts"
+	vEnvListClusterVersion = "env-list-with-cluster-version"
+	vEnvListUnreachable    = "env-list-unreachable"
+	vEnvListName           = "env-list-name"
+	vEnvListTree           = "env-list-tree"
 )
 
 var (
@@ -33,28 +41,135 @@ The ` + "`list`" + ` command lists all of the available environments for the
 current ksonnet app. Specifically, this will display the (1) *name*,
 (2) *server*, and (3) *namespace* of each environment.
 
+With ` + "`--stale-contexts`" + `, only environments that were created from a kubeconfig
+context and need fixing are listed, along with the problem:
+
+* **context missing** — The context no longer exists in the kubeconfig.
+* **server mismatch** — The server of the context's cluster is no longer the
+  server of the environment.
+
+Either can be fixed by pointing the environment at a current context with
+` + "`ks env set <env-name> --context`" + `.
+
+With ` + "`--with-cluster-version`" + `, the cluster of each environment is asked for its
+live Kubernetes version, which is shown in an extra column. Clusters are probed
+concurrently with a short timeout; environments whose cluster can't be reached
+show ` + "`unknown`" + `.
+
+With ` + "`--all`" + `, two columns show whether the ksonnet-lib generated for each
+environment's Kubernetes version is stale: **spec-version** is the version
+recorded in the swagger.json it was generated from, and **up-to-date** is
+` + "`yes`" + ` if that is the environment's Kubernetes version. Environments whose
+ksonnet-lib hasn't been generated show ` + "`not generated`" + `. An environment's
+ksonnet-lib can be regenerated with ` + "`ks env set <env-name> --reset-metadata`" + `.
+
+With ` + "`--unreachable`" + `, only environments whose cluster can't be used are listed,
+along with the problem, to find environments that point at decommissioned
+clusters:
+
+* **no server** — The environment has no server configured.
+* **unreachable** — The server didn't respond to a health check within a short
+  timeout. Clusters are checked concurrently.
+
+With ` + "`--filter <key>=<value>`" + `, only environments with that tag are listed. The
+flag can be repeated to list the environments that have all of the tags. Tags
+are set with ` + "`ks env set <env-name> --tag`" + `.
+
+With ` + "`--tree`" + `, environments are listed as a tree that follows the layout of the
+` + "`environments/`" + ` directory, so the nesting of names such as ` + "`us-west/staging`" + ` is
+kept. Each environment is annotated with its namespace and server; directories
+that only group environments are listed without an annotation. ` + "`--name`" + ` and
+` + "`--filter`" + ` select the environments in the tree, but ` + "`--tree`" + ` can't be combined
+with ` + "`--output`" + ` or the flags that add columns or list problems.
+
+Environments that are no longer needed can be removed with ` + "`ks env rm`" + `.
+
+For scripting, ` + "`--output=json`" + ` and ` + "`--output=yaml`" + ` write the rows as a list of
+objects keyed by column name. Every column is present in each object, with an
+empty string when the environment has no value, so the fields are the same for
+every environment. ` + "`--output=env`" + ` writes the name, server, namespace and Kubernetes
+version of an environment as shell variables (` + "`KS_ENV_NAME`" + `, ` + "`KS_ENV_URI`" + `,
+` + "`KS_ENV_NAMESPACE`" + ` and ` + "`KS_ENV_KUBERNETES_VERSION`" + `), quoted so they can be
+passed to ` + "`eval`" + `. It requires a single environment, selected with ` + "`--name`" + `
+unless the app has only one. With ` + "`--all`" + `, ` + "`KS_ENV_SPEC_VERSION`" + ` and
+` + "`KS_ENV_SPEC_UP_TO_DATE`" + ` are written as well.
+
 ### Related Commands
 
 * ` + "`ks env add` " + `— ` + envShortDesc["add"] + `
+* ` + "`ks env check-contexts` " + `— ` + envShortDesc["check-contexts"] + `
 * ` + "`ks env set` " + `— ` + envShortDesc["set"] + `
 * ` + "`ks env rm` " + `— ` + envShortDesc["rm"] + `
 
 ### Syntax
 `
+	envListExample = `
+# List all environments
+ks env list
+
+# List environments whose kubeconfig context is missing or points at a
+# different server
+ks env list --stale-contexts
+
+# List all environments along with the Kubernetes version their cluster is
+# running
+ks env list --with-cluster-version
+
+# List all environments along with whether their generated ksonnet-lib is up to
+# date
+ks env list --all
+
+# List the production environments in the us-west region
+ks env list --filter tier=prod --filter region=us-west
+
+# List environments as a tree of their names, e.g. to see the regions and
+# stages of a large app at a glance
+ks env list --tree
+
+# List environments without a server, or whose cluster doesn't respond
+ks env list --unreachable
+
+# Set KS_ENV_URI, KS_ENV_NAMESPACE, etc. in a shell script to the details of the
+# "us-west/staging" environment
+eval "$(ks env list --output=env --name=us-west/staging)"
+
+# List all environments as YAML
+ks env list -o yaml`
 )
 
-func newEnvListCmd() *cobra.Command {
+func newEnvListCmd(fs afero.Fs) *cobra.Command {
+	envClientConfig := client.NewDefaultClientConfig()
+
 	envListCmd := &cobra.Command{
-		Use:   "list",
-		Short: envShortDesc["list"],
-		Long:  envListLong,
+		Use:     "list",
+		Short:   envShortDesc["list"],
+		Long:    envListLong,
+		Example: envListExample,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) != 0 {
 				return fmt.Errorf("'env list' takes zero arguments")
 			}
 
+			name, err := resolveEnvName(fs, viper.GetString(flagDir), viper.GetString(vEnvListName), true)
+			if err != nil {
+				return err
+			}
+
+			filter, err := cmd.Flags().GetStringArray(flagFilter)
+			if err != nil {
+				return err
+			}
+
 			m := map[string]interface{}{
-				actions.OptionOutput: viper.GetString(vEnvListOutput),
+				actions.OptionClientConfig:       envClientConfig,
+				actions.OptionAll:                viper.GetBool(vEnvListAll),
+				actions.OptionOutput:             viper.GetString(vEnvListOutput),
+				actions.OptionStaleContexts:      viper.GetBool(vEnvListStaleContexts),
+				actions.OptionWithClusterVersion: viper.GetBool(vEnvListClusterVersion),
+				actions.OptionUnreachable:        viper.GetBool(vEnvListUnreachable),
+				actions.OptionEnvName:            name,
+				actions.OptionFilter:             filter,
+				actions.OptionTree:               viper.GetBool(vEnvListTree),
 			}
 			addGlobalOptions(m)
 
@@ -62,7 +177,35 @@ func newEnvListCmd() *cobra.Command {
 		},
 	}
 
-	addCmdOutput(envListCmd, vEnvListOutput)
+	envListCmd.Flags().StringP(flagOutput, shortOutput, "", "Output format. Valid options: table|json|yaml|env")
+	viper.BindPFlag(vEnvListOutput, envListCmd.Flags().Lookup(flagOutput))
+	envClientConfig.BindClientGoFlags(envListCmd)
+
+	envListCmd.Flags().Bool(flagStaleContexts, false,
+		"List only environments whose kubeconfig context is missing or points at a different server")
+	viper.BindPFlag(vEnvListStaleContexts, envListCmd.Flags().Lookup(flagStaleContexts))
+
+	envListCmd.Flags().Bool(flagWithClusterVersion, false,
+		"Show the live Kubernetes version of each environment's cluster")
+	viper.BindPFlag(vEnvListClusterVersion, envListCmd.Flags().Lookup(flagWithClusterVersion))
+
+	envListCmd.Flags().Bool(flagUnreachable, false,
+		"List only environments without a server, or whose cluster doesn't respond")
+	viper.BindPFlag(vEnvListUnreachable, envListCmd.Flags().Lookup(flagUnreachable))
+
+	envListCmd.Flags().Bool(flagAll, false,
+		"Show the version of each environment's generated ksonnet-lib, and whether it is up to date")
+	viper.BindPFlag(vEnvListAll, envListCmd.Flags().Lookup(flagAll))
+
+	envListCmd.Flags().String(flagEnvName, "", "List only the environment with this name")
+	viper.BindPFlag(vEnvListName, envListCmd.Flags().Lookup(flagEnvName))
+
+	envListCmd.Flags().StringArray(flagFilter, nil,
+		"List only environments with this tag, as <key>=<value> (can be repeated; environments must have all of them)")
+
+	envListCmd.Flags().Bool(flagTree, false,
+		"List environments as a tree of their names, like the environments directory")
+	viper.BindPFlag(vEnvListTree, envListCmd.Flags().Lookup(flagTree))
 
 	return envListCmd
 }
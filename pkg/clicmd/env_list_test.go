@@ -28,8 +28,33 @@ func Test_envListCmd(t *testing.T) {
 			args:   []string{"env", "list"},
 			action: actionEnvList,
 			expected: map[string]interface{}{
-				actions.OptionApp:    nil,
-				actions.OptionOutput: "",
+				actions.OptionAll:                false,
+				actions.OptionApp:                nil,
+				actions.OptionClientConfig:       nil,
+				actions.OptionOutput:             "",
+				actions.OptionStaleContexts:      false,
+				actions.OptionWithClusterVersion: false,
+				actions.OptionUnreachable:        false,
+				actions.OptionEnvName:            "",
+				actions.OptionFilter:             make([]string, 0),
+				actions.OptionTree:               false,
+			},
+		},
+		{
+			name:   "with filter",
+			args:   []string{"env", "list", "--filter", "tier=prod", "--filter", "region=us-west"},
+			action: actionEnvList,
+			expected: map[string]interface{}{
+				actions.OptionAll:                false,
+				actions.OptionApp:                nil,
+				actions.OptionClientConfig:       nil,
+				actions.OptionOutput:             "",
+				actions.OptionStaleContexts:      false,
+				actions.OptionWithClusterVersion: false,
+				actions.OptionUnreachable:        false,
+				actions.OptionEnvName:            "",
+				actions.OptionFilter:             []string{"tier=prod", "region=us-west"},
+				actions.OptionTree:               false,
 			},
 		},
 		{
@@ -37,8 +62,136 @@ func Test_envListCmd(t *testing.T) {
 			args:   []string{"env", "list", "-o", "json"},
 			action: actionEnvList,
 			expected: map[string]interface{}{
-				actions.OptionApp:    nil,
-				actions.OptionOutput: "json",
+				actions.OptionAll:                false,
+				actions.OptionApp:                nil,
+				actions.OptionClientConfig:       nil,
+				actions.OptionOutput:             "json",
+				actions.OptionStaleContexts:      false,
+				actions.OptionWithClusterVersion: false,
+				actions.OptionUnreachable:        false,
+				actions.OptionEnvName:            "",
+				actions.OptionFilter:             make([]string, 0),
+				actions.OptionTree:               false,
+			},
+		},
+		{
+			name:   "with stale contexts",
+			args:   []string{"env", "list", "--stale-contexts"},
+			action: actionEnvList,
+			expected: map[string]interface{}{
+				actions.OptionAll:                false,
+				actions.OptionApp:                nil,
+				actions.OptionClientConfig:       nil,
+				actions.OptionOutput:             "",
+				actions.OptionStaleContexts:      true,
+				actions.OptionWithClusterVersion: false,
+				actions.OptionUnreachable:        false,
+				actions.OptionEnvName:            "",
+				actions.OptionFilter:             make([]string, 0),
+				actions.OptionTree:               false,
+			},
+		},
+		{
+			name:   "with cluster version",
+			args:   []string{"env", "list", "--with-cluster-version"},
+			action: actionEnvList,
+			expected: map[string]interface{}{
+				actions.OptionAll:                false,
+				actions.OptionApp:                nil,
+				actions.OptionClientConfig:       nil,
+				actions.OptionOutput:             "",
+				actions.OptionStaleContexts:      false,
+				actions.OptionWithClusterVersion: true,
+				actions.OptionUnreachable:        false,
+				actions.OptionEnvName:            "",
+				actions.OptionFilter:             make([]string, 0),
+				actions.OptionTree:               false,
+			},
+		},
+		{
+			name:   "with unreachable",
+			args:   []string{"env", "list", "--unreachable"},
+			action: actionEnvList,
+			expected: map[string]interface{}{
+				actions.OptionAll:                false,
+				actions.OptionApp:                nil,
+				actions.OptionClientConfig:       nil,
+				actions.OptionOutput:             "",
+				actions.OptionStaleContexts:      false,
+				actions.OptionWithClusterVersion: false,
+				actions.OptionUnreachable:        true,
+				actions.OptionEnvName:            "",
+				actions.OptionFilter:             make([]string, 0),
+				actions.OptionTree:               false,
+			},
+		},
+		{
+			name:   "with app name",
+			args:   []string{"env", "list", "--app-name", "other"},
+			action: actionEnvList,
+			expected: map[string]interface{}{
+				actions.OptionAll:                false,
+				actions.OptionApp:                nil,
+				actions.OptionAppName:            "other",
+				actions.OptionClientConfig:       nil,
+				actions.OptionOutput:             "",
+				actions.OptionStaleContexts:      false,
+				actions.OptionWithClusterVersion: false,
+				actions.OptionUnreachable:        false,
+				actions.OptionEnvName:            "",
+				actions.OptionFilter:             make([]string, 0),
+				actions.OptionTree:               false,
+			},
+		},
+		{
+			name:   "with env output",
+			args:   []string{"env", "list", "--output", "env", "--name", "us-west/staging"},
+			action: actionEnvList,
+			expected: map[string]interface{}{
+				actions.OptionAll:                false,
+				actions.OptionApp:                nil,
+				actions.OptionClientConfig:       nil,
+				actions.OptionOutput:             "env",
+				actions.OptionStaleContexts:      false,
+				actions.OptionWithClusterVersion: false,
+				actions.OptionUnreachable:        false,
+				actions.OptionEnvName:            "us-west/staging",
+				actions.OptionFilter:             make([]string, 0),
+				actions.OptionTree:               false,
+			},
+		},
+		{
+			name:   "with all",
+			args:   []string{"env", "list", "--all"},
+			action: actionEnvList,
+			expected: map[string]interface{}{
+				actions.OptionAll:                true,
+				actions.OptionApp:                nil,
+				actions.OptionClientConfig:       nil,
+				actions.OptionOutput:             "",
+				actions.OptionStaleContexts:      false,
+				actions.OptionWithClusterVersion: false,
+				actions.OptionUnreachable:        false,
+				actions.OptionEnvName:            "",
+				actions.OptionFilter:             make([]string, 0),
+				actions.OptionTree:               false,
+			},
+		},
+		{
+			name:   "with tree",
+			args:   []string{"env", "list", "--tree"},
+			action: actionEnvList,
+			expected: map[string]interface{}{
+				actions.OptionAll:                false,
+				actions.OptionApp:                nil,
+				actions.OptionClientConfig:       nil,
+				actions.OptionOutput:             "",
+				actions.OptionStaleContexts:      false,
+				actions.OptionWithClusterVersion: false,
+				actions.OptionUnreachable:        false,
+				actions.OptionEnvName:            "",
+				actions.OptionFilter:             make([]string, 0),
+				actions.OptionTree:               true,
 			},
 		},
 		{
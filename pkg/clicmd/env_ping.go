@@ -0,0 +1,86 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package clicmd
+
+import (
+	"time"
+
+	"github.com/ksonnet/ksonnet/pkg/actions"
+	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	vEnvPingTimeout = "env-ping-timeout"
+)
+
+var (
+	envPingLong = `
+The ` + "`ping`" + ` command checks that the cluster of one or more environments is
+reachable and healthy by making a single request to the ` + "`/healthz`" + ` endpoint of
+each environment's server.
+
+The command exits with a zero status only if every environment is healthy, which
+makes it suitable for use in shell conditionals before running heavier commands
+such as ` + "`ks apply`" + `.
+
+### Related Commands
+
+* ` + "`ks env list` " + `— ` + envShortDesc["list"] + `
+* ` + "`ks env validate-all` " + `— ` + envShortDesc["validate-all"] + `
+
+### Syntax
+`
+	envPingExample = `
+# Check that the cluster of the 'default' environment is healthy
+ks env ping default
+
+# Apply only if the cluster of the 'prod' environment responds within 2 seconds
+ks env ping prod --timeout=2s && ks apply prod
+
+# Check several environments at once
+ks env ping dev us-west/staging prod`
+)
+
+func newEnvPingCmd() *cobra.Command {
+	envClientConfig := client.NewDefaultClientConfig()
+
+	envPingCmd := &cobra.Command{
+		Use:     "ping <env> [<env>...]",
+		Short:   envShortDesc["ping"],
+		Long:    envPingLong,
+		Example: envPingExample,
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m := map[string]interface{}{
+				actions.OptionEnvNames:     args,
+				actions.OptionClientConfig: envClientConfig,
+				actions.OptionTimeout:      viper.GetDuration(vEnvPingTimeout),
+			}
+			addGlobalOptions(m)
+
+			return runAction(actionEnvPing, m)
+		},
+	}
+
+	envClientConfig.BindClientGoFlags(envPingCmd)
+
+	envPingCmd.Flags().Duration(flagTimeout, 5*time.Second, "Time to wait for each environment's server to respond")
+	viper.BindPFlag(vEnvPingTimeout, envPingCmd.Flags().Lookup(flagTimeout))
+
+	return envPingCmd
+}
@@ -0,0 +1,57 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package clicmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ksonnet/ksonnet/pkg/actions"
+)
+
+func Test_envPingCmd(t *testing.T) {
+	cases := []cmdTestCase{
+		{
+			name:   "in general",
+			args:   []string{"env", "ping", "default"},
+			action: actionEnvPing,
+			expected: map[string]interface{}{
+				actions.OptionApp:          nil,
+				actions.OptionEnvNames:     []string{"default"},
+				actions.OptionClientConfig: nil,
+				actions.OptionTimeout:      5 * time.Second,
+			},
+		},
+		{
+			name:   "multiple environments with timeout",
+			args:   []string{"env", "ping", "default", "prod", "--timeout", "2s"},
+			action: actionEnvPing,
+			expected: map[string]interface{}{
+				actions.OptionApp:          nil,
+				actions.OptionEnvNames:     []string{"default", "prod"},
+				actions.OptionClientConfig: nil,
+				actions.OptionTimeout:      2 * time.Second,
+			},
+		},
+		{
+			name:  "no environment",
+			args:  []string{"env", "ping"},
+			isErr: true,
+		},
+	}
+
+	runTestCmd(t, cases)
+}
@@ -0,0 +1,85 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package clicmd
+
+import (
+	"fmt"
+
+	"github.com/ksonnet/ksonnet/pkg/actions"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	vEnvPruneEmptyDryRun = "env-prune-empty-dry-run"
+	vEnvPruneEmptyForce  = "env-prune-empty-force"
+)
+
+var (
+	envPruneEmptyLong = `
+The ` + "`prune-empty`" + ` command removes directories in ` + "`environments/`" + ` that look like
+environments, but have no configuration in ` + "`app.yaml`" + `. These are left behind
+when creating an environment fails. Empty parent directories are also removed.
+
+A directory is only removed if it contains nothing but environment files
+(` + "`main.jsonnet`" + `, ` + "`params.libsonnet`" + ` and ` + "`globals.libsonnet`" + `), so shared files kept in
+the environments tree are never touched. The directories are listed, and you are
+asked to confirm before anything is removed.
+
+### Related Commands
+
+* ` + "`ks env list` " + `— ` + envShortDesc["list"] + `
+* ` + "`ks env rm` " + `— ` + envShortDesc["rm"] + `
+
+### Syntax
+`
+	envPruneEmptyExample = `
+# List the environment directories which would be removed
+ks env prune-empty --dry-run
+
+# Remove the environment directories without asking for confirmation
+ks env prune-empty --force`
+)
+
+func newEnvPruneEmptyCmd() *cobra.Command {
+	envPruneEmptyCmd := &cobra.Command{
+		Use:     "prune-empty [--dry-run] [--force]",
+		Short:   envShortDesc["prune-empty"],
+		Long:    envPruneEmptyLong,
+		Example: envPruneEmptyExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				return fmt.Errorf("'env prune-empty' takes no arguments")
+			}
+
+			m := map[string]interface{}{
+				actions.OptionDryRun: viper.GetBool(vEnvPruneEmptyDryRun),
+				actions.OptionForce:  viper.GetBool(vEnvPruneEmptyForce),
+			}
+			addGlobalOptions(m)
+
+			return runAction(actionEnvPruneEmpty, m)
+		},
+	}
+
+	envPruneEmptyCmd.Flags().Bool(flagDryRun, false, "List the directories which would be removed without removing them")
+	viper.BindPFlag(vEnvPruneEmptyDryRun, envPruneEmptyCmd.Flags().Lookup(flagDryRun))
+
+	envPruneEmptyCmd.Flags().Bool(flagForce, false, "Remove the directories without asking for confirmation")
+	viper.BindPFlag(vEnvPruneEmptyForce, envPruneEmptyCmd.Flags().Lookup(flagForce))
+
+	return envPruneEmptyCmd
+}
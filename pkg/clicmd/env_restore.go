@@ -0,0 +1,75 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package clicmd
+
+import (
+	"fmt"
+
+	"github.com/ksonnet/ksonnet/pkg/actions"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	envRestoreLong = `
+The ` + "`restore`" + ` command moves an environment removed with ` + "`ks env rm`" + ` back from
+the ` + "`.trash/`" + ` directory of the app. Its configuration is added to ` + "`app.yaml`" + `
+again, and its files are moved back to the ` + "`<env-name>`" + ` environment directory.
+
+The environment can't be restored if an environment with the same name, or one
+whose directory would contain or be contained by it, was added since it was
+removed. Environments removed with ` + "`ks env rm --purge`" + ` can't be restored.
+
+### Related Commands
+
+* ` + "`ks env rm` " + `— ` + envShortDesc["rm"] + `
+* ` + "`ks env list` " + `— ` + envShortDesc["list"] + `
+
+### Syntax
+`
+	envRestoreExample = `
+# Restore the environment 'us-west/staging' removed with 'ks env rm'
+ks env restore us-west/staging`
+)
+
+func newEnvRestoreCmd(fs afero.Fs) *cobra.Command {
+	envRestoreCmd := &cobra.Command{
+		Use:     "restore <env-name>",
+		Short:   envShortDesc["restore"],
+		Long:    envRestoreLong,
+		Example: envRestoreExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("'env restore' takes a single argument, that is the name of the environment")
+			}
+
+			name, err := resolveEnvName(fs, viper.GetString(flagDir), args[0], false)
+			if err != nil {
+				return err
+			}
+
+			m := map[string]interface{}{
+				actions.OptionEnvName: name,
+			}
+			addGlobalOptions(m)
+
+			return runAction(actionEnvRestore, m)
+		},
+	}
+
+	return envRestoreCmd
+}
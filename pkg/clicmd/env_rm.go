@@ -19,19 +19,44 @@ import (
 	"fmt"
 
 	"github.com/ksonnet/ksonnet/pkg/actions"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
 const (
+	vEnvRmDryRun   = "env-rm-dry-run"
+	vEnvRmForce    = "env-rm-force"
 	vEnvRmOverride = "env-rm-override"
+	vEnvRmPurge    = "env-rm-purge"
+	vEnvRmYes      = "env-rm-yes"
 )
 
 var (
 	envRmLong = `
-The ` + "`rm`" + ` command deletes an environment from a ksonnet application. This is
-the same as removing the ` + "`<env-name>`" + ` environment directory and all files
-contained. All empty parent directories are also subsequently deleted.
+The ` + "`rm`" + ` command deletes an environment from a ksonnet application. Its
+configuration and the files of the ` + "`<env-name>`" + ` environment directory are moved
+to the ` + "`.trash/`" + ` directory of the app, and all empty parent directories are
+subsequently deleted. ` + "`ks env restore <env-name>`" + ` moves the environment back to
+where it was. Removing an environment again replaces the copy in the trash. You
+may want to add ` + "`.trash/`" + ` to the ` + "`.gitignore`" + ` of the app.
+
+To delete the environment outright without keeping it in the trash, use
+` + "`--purge`" + `.
+
+To remove several environments at once, pass a glob pattern instead of a name,
+e.g. ` + "`us-west/*`" + `. As in a shell, ` + "`*`" + ` doesn't match the ` + "`/`" + ` between levels of an
+environment's name. If the pattern matches more than one environment, ` + "`--yes`" + ` is
+required to confirm removing all of them.
+
+To check what would be lost, ` + "`--dry-run`" + ` lists every file that would be moved
+or removed, and the empty parent directories that would be cleaned up, without
+removing anything. A dry run doesn't need ` + "`--yes`" + `.
+
+Before an environment is removed, the files in ` + "`components/`" + ` and ` + "`environments/`" + `
+are searched for its quoted name, e.g. ` + "`'us-west/staging'`" + `, and a warning lists
+the files that refer to it, as they would refer to an environment that no
+longer exists. ` + "`--force`" + ` skips the search.
 
 NOTE: This does *NOT* delete the components running in ` + "`<env-name>`" + `. To do that, you
 need to use the ` + "`ks delete`" + ` command.
@@ -41,19 +66,34 @@ need to use the ` + "`ks delete`" + ` command.
 * ` + "`ks env list` " + `— ` + envShortDesc["list"] + `
 * ` + "`ks env add` " + `— ` + envShortDesc["add"] + `
 * ` + "`ks env set` " + `— ` + envShortDesc["set"] + `
+* ` + "`ks env restore` " + `— ` + envShortDesc["restore"] + `
 * ` + "`ks delete` " + `— ` + `Delete all the app components running in an environment (cluster)` + `
 
 ### Syntax
 `
 	envRmExample = `
-# Remove the directory 'environments/us-west/staging' and all of its contents.
-# This will also remove the parent directory 'us-west' if it is empty.
-ks env rm us-west/staging`
+# Move the directory 'environments/us-west/staging' and all of its contents to
+# the trash. This will also remove the parent directory 'us-west' if it is empty.
+ks env rm us-west/staging
+
+# Restore the environment removed above.
+ks env restore us-west/staging
+
+# Delete the environment 'dev' without keeping it in the trash.
+ks env rm dev --purge
+
+# Remove every environment directly under 'environments/us-west'. Quote the
+# pattern so the shell doesn't expand it.
+ks env rm 'us-west/*' --yes
+
+# List the files which removing every environment under 'environments/us-west'
+# would delete.
+ks env rm 'us-west/*' --dry-run`
 )
 
-func newEnvRmCmd() *cobra.Command {
+func newEnvRmCmd(fs afero.Fs) *cobra.Command {
 	envRmCmd := &cobra.Command{
-		Use:     "rm <env-name>",
+		Use:     "rm <env-name|pattern>",
 		Short:   envShortDesc["rm"],
 		Long:    envRmLong,
 		Example: envRmExample,
@@ -62,9 +102,18 @@ func newEnvRmCmd() *cobra.Command {
 				return fmt.Errorf("'env rm' takes a single argument, that is the name of the environment")
 			}
 
+			name, err := resolveEnvName(fs, viper.GetString(flagDir), args[0], true)
+			if err != nil {
+				return err
+			}
+
 			m := map[string]interface{}{
-				actions.OptionEnvName:  args[0],
+				actions.OptionEnvName:  name,
+				actions.OptionDryRun:   viper.GetBool(vEnvRmDryRun),
+				actions.OptionForce:    viper.GetBool(vEnvRmForce),
 				actions.OptionOverride: viper.GetBool(vEnvRmOverride),
+				actions.OptionPurge:    viper.GetBool(vEnvRmPurge),
+				actions.OptionYes:      viper.GetBool(vEnvRmYes),
 			}
 			addGlobalOptions(m)
 
@@ -75,6 +124,18 @@ func newEnvRmCmd() *cobra.Command {
 	envRmCmd.Flags().BoolP(flagOverride, shortOverride, false, "Remove the overridden environment")
 	viper.BindPFlag(vEnvRmOverride, envRmCmd.Flags().Lookup(flagOverride))
 
+	envRmCmd.Flags().Bool(flagYes, false, "Confirm removing every environment matched by a pattern")
+	viper.BindPFlag(vEnvRmYes, envRmCmd.Flags().Lookup(flagYes))
+
+	envRmCmd.Flags().Bool(flagDryRun, false, "List the files which would be removed without removing them")
+	viper.BindPFlag(vEnvRmDryRun, envRmCmd.Flags().Lookup(flagDryRun))
+
+	envRmCmd.Flags().Bool(flagForce, false, "Don't warn about files that refer to the environment")
+	viper.BindPFlag(vEnvRmForce, envRmCmd.Flags().Lookup(flagForce))
+
+	envRmCmd.Flags().Bool(flagPurge, false, "Delete the environment instead of moving it to the trash")
+	viper.BindPFlag(vEnvRmPurge, envRmCmd.Flags().Lookup(flagPurge))
+
 	return envRmCmd
 
 }
@@ -31,6 +31,66 @@ func Test_envRmCmd(t *testing.T) {
 				actions.OptionApp:      nil,
 				actions.OptionEnvName:  "prod",
 				actions.OptionOverride: false,
+				actions.OptionYes:      false,
+				actions.OptionDryRun:   false,
+				actions.OptionForce:    false,
+				actions.OptionPurge:    false,
+			},
+		},
+		{
+			name:   "dry run",
+			args:   []string{"env", "rm", "prod", "--dry-run"},
+			action: actionEnvRm,
+			expected: map[string]interface{}{
+				actions.OptionApp:      nil,
+				actions.OptionEnvName:  "prod",
+				actions.OptionOverride: false,
+				actions.OptionYes:      false,
+				actions.OptionDryRun:   true,
+				actions.OptionForce:    false,
+				actions.OptionPurge:    false,
+			},
+		},
+		{
+			name:   "pattern",
+			args:   []string{"env", "rm", "us-west/*", "--yes"},
+			action: actionEnvRm,
+			expected: map[string]interface{}{
+				actions.OptionApp:      nil,
+				actions.OptionEnvName:  "us-west/*",
+				actions.OptionOverride: false,
+				actions.OptionYes:      true,
+				actions.OptionDryRun:   false,
+				actions.OptionForce:    false,
+				actions.OptionPurge:    false,
+			},
+		},
+		{
+			name:   "force",
+			args:   []string{"env", "rm", "prod", "--force"},
+			action: actionEnvRm,
+			expected: map[string]interface{}{
+				actions.OptionApp:      nil,
+				actions.OptionEnvName:  "prod",
+				actions.OptionOverride: false,
+				actions.OptionYes:      false,
+				actions.OptionDryRun:   false,
+				actions.OptionForce:    true,
+				actions.OptionPurge:    false,
+			},
+		},
+		{
+			name:   "purge",
+			args:   []string{"env", "rm", "prod", "--purge"},
+			action: actionEnvRm,
+			expected: map[string]interface{}{
+				actions.OptionApp:      nil,
+				actions.OptionEnvName:  "prod",
+				actions.OptionOverride: false,
+				actions.OptionYes:      false,
+				actions.OptionDryRun:   false,
+				actions.OptionForce:    false,
+				actions.OptionPurge:    true,
 			},
 		},
 		{
@@ -17,9 +17,16 @@ package clicmd
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/ksonnet/ksonnet/pkg/actions"
+	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/ksonnet/ksonnet/pkg/env"
+	str "github.com/ksonnet/ksonnet/pkg/util/strings"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 )
 
@@ -27,17 +34,82 @@ const (
 	vEnvSetName      = "env-set-name"
 	vEnvSetNamespace = "env-set-namespace"
 	vEnvSetServer    = "env-set-server"
+	vEnvSetContext   = "env-set-context"
+	vEnvSetKeepURI   = "env-set-keep-uri"
 	vEnvSetAPISpec   = "env-set-spec-flag"
 	vEnvSetOverride  = "env-set-override-flag"
+	vEnvSetFullRegen = "env-set-full-regen"
+	vEnvSetPrefix    = "env-set-name-prefix"
+	vEnvSetResetMeta = "env-set-reset-metadata"
+	vEnvSetReplicas  = "env-set-default-replicas"
+	vEnvSetHPARange  = "env-set-hpa-range"
+	vEnvSetPDB       = "env-set-default-pdb"
+	vEnvSetSA        = "env-set-service-account"
+	vEnvSetIgnore    = "env-set-ignore-annotation"
+	vEnvSetIgnoreObj = "env-set-ignore-object"
+	vEnvSetIgnoreSel = "env-set-ignore-selector"
+	vEnvSetInclude   = "env-set-include-component"
+	vEnvSetExclude   = "env-set-exclude-component"
+	vEnvSetUnset     = "env-set-unset"
+	vEnvSetBreakage  = "env-set-report-breakage"
+	vEnvSetWait      = "env-set-wait-reachable"
+	vEnvSetTimeout   = "env-set-timeout"
+	vEnvSetDryRun    = "env-set-dry-run"
+	vEnvSetInherit   = "env-set-inherit"
 )
 
 var (
 	envSetLong = `
 The ` + "`set`" + ` command lets you change the fields of an existing environment.
-You can currently only update your environment's name.
+Fields are changed with their flags, or with ` + "`--set`" + `, and removed with ` + "`--unset`" + `.
 
 Note that changing the name of an environment will also update the corresponding
-directory structure in ` + "`environments/`" + `.
+directory structure in ` + "`environments/`" + `. Because the name is a path, the new
+name can't be the name of another environment, nor be inside or contain another
+environment's directory; e.g., ` + "`us-east/staging`" + ` can't be used if ` + "`us-east`" + ` is an
+environment. Nothing is moved if it is.
+
+All of the changes of a command are validated before the environment is renamed
+or saved, and the environment is saved once, so an invalid change leaves the
+environment as it was. If renaming or saving fails part way, app.yaml and the
+environment's directory are restored to how they were before the command.
+` + "`--set <field>=<value>`" + ` can be repeated, but a field can only be changed once
+per command.
+
+A server given with ` + "`--server`" + ` must be an http or https URL without a path,
+query or fragment; a trailing slash is removed. A server given as only a host and
+port, such as ` + "`example.com:6443`" + `, is assumed to use https, and a warning is
+printed. A server taken from a context with ` + "`--context`" + ` is saved as the
+kubeconfig has it.
+
+An environment can be limited to some of the app's components with
+` + "`--include-component`" + `, and components can be kept out of it with
+` + "`--exclude-component`" + `. Commands such as ` + "`apply`" + `, ` + "`diff`" + ` and ` + "`show`" + ` only use the
+components in the environment's scope. A component that is both included and
+excluded is excluded. Components selected with ` + "`--component`" + ` narrow the scope
+further, but can't add components outside of it.
+
+Environments can be grouped with tags, such as ` + "`region=us-west`" + ` or ` + "`tier=prod`" + `.
+` + "`--tag <key>=<value>`" + ` sets a tag and ` + "`--tag <key>-`" + ` removes it, like
+` + "`kubectl label`" + `; keys and values follow the rules of Kubernetes labels.
+` + "`ks env list --filter`" + ` lists the environments with the given tags.
+
+With ` + "`--inherit`" + `, the environment inherits the parameters of another environment,
+and its own parameters override them. The change is refused if the environments
+would inherit from each other. ` + "`--unset=inherits`" + ` stops inheriting.
+
+Parameters that every component of the environment should see, such as a
+replica count, can be set with ` + "`--param <key>=<value>`" + ` and removed with
+` + "`--param-delete <key>`" + `, without editing the environment's files by hand. They are
+saved in the environment's ` + "`globals.libsonnet`" + `, like ` + "`ks param set <key> <value> --env`" + `,
+and override the defaults of the components when the environment is rendered.
+Integers, floats and booleans are saved as such, as are JSON arrays and objects;
+other values are strings. Quote a value, e.g. ` + "`--param 'tag=\"5\"'`" + `, to save it
+as a string. Nested parameters are set with ` + "`ks param set`" + `.
+
+To preview a change, ` + "`--dry-run`" + ` validates it and lists the directories that
+would be moved and the files that would be updated or generated, without changing
+the app. It can't be combined with ` + "`--report-breakage`" + `.
 
 ### Related Commands
 
@@ -49,15 +121,109 @@ directory structure in ` + "`environments/`" + `.
 # Updating the name will update the directory structure in 'environments/'.
 ks env set us-west/staging --name=us-east/staging
 
-# Setting k8s API version for an environment
+# Setting k8s API version for an environment. If the environment already uses
+# that version, its ksonnet-lib is up to date and isn't regenerated.
 ks env set us-west/staging --api-spec=version:v1.8.0
 
+# Detecting the k8s API version of an environment from its cluster each time it
+# is applied or shown. ksonnet-lib is regenerated when the version changes. If
+# the cluster is unreachable, the last detected version is used.
+ks env set us-west/staging --api-spec=auto
+
+# Setting k8s API version for an environment, generating ksonnet-lib from
+# scratch even if the type definitions are unchanged from a cached version
+ks env set us-west/staging --api-spec=version:v1.8.1 --full-regen
+
+# Changing the k8s API version of an environment, and listing the objects of
+# its components whose kinds or fields were removed or changed in the new
+# version. The environment is updated either way.
+ks env set us-west/staging --api-spec=version:v1.16.0 --report-breakage
+
+# Regenerating a deleted or corrupted ksonnet-lib for the Kubernetes version
+# already recorded for the environment
+ks env set us-west/staging --reset-metadata
+
+# Listing what renaming the environment 'us-west/staging' would move, without
+# moving it
+ks env set us-west/staging --name=us-east/staging --dry-run
+
 # Updating the server
 ks env set us-west/staging --server=https://192.168.99.100:8443
+
+# Updating the server only if it responds as a Kubernetes API server within 30
+# seconds. Otherwise the environment is left unchanged.
+ks env set us-west/staging --server=https://192.168.99.100:8443 --wait-reachable --timeout=30s
+
+# Updating the server to the cluster of the "dev" context in your current
+# kubeconfig file ($KUBECONFIG), and the namespace to the namespace of the
+# context, if it sets one. The context is recorded, so that
+# 'ks env check-contexts' can report it if it is later renamed or removed.
+ks env set us-west/staging --context=dev
+
+# Updating the server to the cluster of the "dev" context, and the namespace to
+# "api" rather than the namespace of the context.
+ks env set us-west/staging --context=dev --namespace=api
+
+# Updating only the namespace to the namespace of the "dev" context, keeping the
+# server of the environment. A namespace given with --namespace takes precedence
+# over the namespace of the context.
+ks env set us-west/staging --context=dev --keep-uri
+
+# Prefixing the names of all objects in the environment, so a second instance of
+# the app can run in the same namespace. References between the app's objects
+# are updated to use the prefixed names.
+ks env set us-west/staging --name-prefix=staging-
+
+# Sizing workloads and horizontal pod autoscalers in the environment. The values
+# are only used for objects whose components don't set them. Workloads scaled by
+# an autoscaler keep the replica count the autoscaler gives them.
+ks env set prod --default-replicas=3 --hpa-range=3:10
+
+# Generating a pod disruption budget for each deployment and stateful set in the
+# environment that doesn't have one. The value is minAvailable=<value> or
+# maxUnavailable=<value>, with a count or a percentage.
+ks env set prod --default-pdb=minAvailable=1
+
+# Running pods in the environment under the "app-sa" service account. Pods whose
+# components set a service account keep theirs.
+ks env set prod --service-account=app-sa
+
+# Annotating the objects of the environment that match the "tier=frontend"
+# label selector, so a GitOps controller such as Argo CD doesn't prune them.
+# Objects are matched before the environment's name prefix is applied. Setting
+# the same annotation for the same objects again replaces its value.
+ks env set prod --ignore-annotation='argocd.argoproj.io/sync-options=Prune=false' \
+  --ignore-selector=tier=frontend
+
+# Annotating a single object of the environment, or all objects of a kind
+ks env set prod --ignore-annotation='fluxcd.io/ignore=true' --ignore-object=ConfigMap/settings
+ks env set prod --ignore-annotation='fluxcd.io/ignore=true' --ignore-object=Secret
+
+# Removing the name prefix of an environment and resetting its namespace to
+# "default". The name and server of an environment are required and can't be
+# unset. Unsetting ignore-annotations removes all of them.
+ks env set us-west/staging --unset=name-prefix --unset=namespace
+
+# Deploying only the "web" component to an environment, and never deploying the
+# "debug" component, without passing --component to every command
+ks env set my-env --include-component=web --exclude-component=debug
+
+# Tagging the environment 'us-west/staging', and removing its "team" tag
+ks env set us-west/staging --tag region=us-west --tag tier=staging --tag team-
+
+# Making the "prod" environment inherit the parameters of the "base" environment
+ks env set prod --inherit=base
+
+# Moving an environment to a new cluster and namespace and sizing it for the new
+# cluster in one change. Nothing is saved unless every field is valid.
+ks env set prod --set server=https://prod-2.example.com --set namespace=web \
+  --set default-replicas=5 --set hpa-range=5:20
 `
 )
 
-func newEnvSetCmd() *cobra.Command {
+func newEnvSetCmd(fs afero.Fs) *cobra.Command {
+	envClientConfig := client.NewDefaultClientConfig()
+
 	envSetCmd := &cobra.Command{
 		Use:     "set <env-name>",
 		Short:   envShortDesc["set"],
@@ -68,13 +234,102 @@ func newEnvSetCmd() *cobra.Command {
 				return fmt.Errorf("'env set' takes a single argument, that is the name of the environment")
 			}
 
+			name, err := resolveEnvName(fs, viper.GetString(flagDir), args[0], true)
+			if err != nil {
+				return err
+			}
+
+			newName, err := resolveEnvName(fs, viper.GetString(flagDir), viper.GetString(vEnvSetName), false)
+			if err != nil {
+				return err
+			}
+
+			if newName != "" {
+				if err := env.ValidateName(newName); err != nil {
+					return err
+				}
+			}
+
+			fields, err := cmd.Flags().GetStringArray(flagSet)
+			if err != nil {
+				return err
+			}
+			if err := setEnvFields(cmd.Flags(), fields); err != nil {
+				return err
+			}
+
+			tags, err := cmd.Flags().GetStringArray(flagTag)
+			if err != nil {
+				return err
+			}
+
+			params, err := cmd.Flags().GetStringArray(flagParam)
+			if err != nil {
+				return err
+			}
+
+			paramDeletes, err := cmd.Flags().GetStringArray(flagParamDelete)
+			if err != nil {
+				return err
+			}
+
+			server := viper.GetString(vEnvSetServer)
+			namespace := viper.GetString(vEnvSetNamespace)
+			context := viper.GetString(vEnvSetContext)
+			keepURI := viper.GetBool(vEnvSetKeepURI)
+			if keepURI && context == "" {
+				return fmt.Errorf("flag '%s' requires '%s'", flagKeepURI, flagEnvContext)
+			}
+
+			if context != "" {
+				if server != "" {
+					return fmt.Errorf("flags '%s' and '%s' are mutually exclusive, because '%s' has a server",
+						flagEnvContext, flagServer, flagEnvContext)
+				}
+
+				var err error
+				server, namespace, err = resolveEnvSetContext(envClientConfig, context, namespace, keepURI)
+				if err != nil {
+					return err
+				}
+
+				if keepURI {
+					// The server isn't taken from the context, so the context
+					// isn't recorded.
+					context = ""
+				}
+			}
+
 			m := map[string]interface{}{
-				actions.OptionEnvName:    args[0],
-				actions.OptionNewEnvName: viper.GetString(vEnvSetName),
-				actions.OptionNamespace:  viper.GetString(vEnvSetNamespace),
-				actions.OptionServer:     viper.GetString(vEnvSetServer),
-				actions.OptionSpecFlag:   viper.GetString(vEnvSetAPISpec),
-				actions.OptionOverride:   viper.GetBool(vEnvSetOverride),
+				actions.OptionEnvName:           name,
+				actions.OptionNewEnvName:        newName,
+				actions.OptionNamespace:         namespace,
+				actions.OptionServer:            server,
+				actions.OptionContext:           context,
+				actions.OptionSpecFlag:          viper.GetString(vEnvSetAPISpec),
+				actions.OptionOverride:          viper.GetBool(vEnvSetOverride),
+				actions.OptionFullRegen:         viper.GetBool(vEnvSetFullRegen),
+				actions.OptionNamePrefix:        viper.GetString(vEnvSetPrefix),
+				actions.OptionResetMetadata:     viper.GetBool(vEnvSetResetMeta),
+				actions.OptionDefaultReplicas:   viper.GetInt(vEnvSetReplicas),
+				actions.OptionHPARange:          viper.GetString(vEnvSetHPARange),
+				actions.OptionDefaultPDB:        viper.GetString(vEnvSetPDB),
+				actions.OptionServiceAccount:    viper.GetString(vEnvSetSA),
+				actions.OptionIgnoreAnnotation:  viper.GetString(vEnvSetIgnore),
+				actions.OptionIgnoreObject:      viper.GetString(vEnvSetIgnoreObj),
+				actions.OptionIgnoreSelector:    viper.GetString(vEnvSetIgnoreSel),
+				actions.OptionIncludeComponents: viper.GetStringSlice(vEnvSetInclude),
+				actions.OptionExcludeComponents: viper.GetStringSlice(vEnvSetExclude),
+				actions.OptionUnsetFields:       viper.GetStringSlice(vEnvSetUnset),
+				actions.OptionReportBreakage:    viper.GetBool(vEnvSetBreakage),
+				actions.OptionWaitReachable:     viper.GetBool(vEnvSetWait),
+				actions.OptionTimeout:           viper.GetDuration(vEnvSetTimeout),
+				actions.OptionDryRun:            viper.GetBool(vEnvSetDryRun),
+				actions.OptionInherits:          viper.GetString(vEnvSetInherit),
+				actions.OptionTags:              tags,
+				actions.OptionParams:            params,
+				actions.OptionParamDeletes:      paramDeletes,
+				actions.OptionClientConfig:      envClientConfig,
 			}
 			addGlobalOptions(m)
 
@@ -83,7 +338,7 @@ func newEnvSetCmd() *cobra.Command {
 	}
 
 	envSetCmd.Flags().String(flagEnvName, "",
-		"Name used to uniquely identify the environment. Must not already exist within the ksonnet app")
+		"Name used to uniquely identify the environment. Must not already exist, or be inside or contain another environment, within the ksonnet app")
 	viper.BindPFlag(vEnvSetName, envSetCmd.Flags().Lookup(flagName))
 
 	envSetCmd.Flags().String(flagNamespace, "",
@@ -94,12 +349,174 @@ func newEnvSetCmd() *cobra.Command {
 		"Cluster server for environment")
 	viper.BindPFlag(vEnvSetServer, envSetCmd.Flags().Lookup(flagServer))
 
+	envSetCmd.Flags().String(flagEnvContext, "",
+		"Name of a kubeconfig context whose cluster server and namespace are used for environment; --namespace takes precedence")
+	viper.BindPFlag(vEnvSetContext, envSetCmd.Flags().Lookup(flagEnvContext))
+
+	envSetCmd.Flags().Bool(flagKeepURI, false,
+		"With --context, only take the namespace from the context and keep the environment's server")
+	viper.BindPFlag(vEnvSetKeepURI, envSetCmd.Flags().Lookup(flagKeepURI))
+
 	envSetCmd.Flags().String(flagAPISpec, "",
-		"Kubernetes version for environment")
+		"Kubernetes version for environment, or 'auto' to detect it from the cluster when applying or showing")
 	viper.BindPFlag(vEnvSetAPISpec, envSetCmd.Flags().Lookup(flagAPISpec))
 
+	envSetCmd.Flags().String(flagNamePrefix, "",
+		"Prefix for the names of all objects in the environment")
+	viper.BindPFlag(vEnvSetPrefix, envSetCmd.Flags().Lookup(flagNamePrefix))
+
+	envSetCmd.Flags().Int(flagDefaultReplicas, 0,
+		"Replica count of workloads whose components don't set one")
+	viper.BindPFlag(vEnvSetReplicas, envSetCmd.Flags().Lookup(flagDefaultReplicas))
+
+	envSetCmd.Flags().String(flagHPARange, "",
+		"Minimum and maximum replicas, as <min>:<max>, of horizontal pod autoscalers whose components don't set them")
+	viper.BindPFlag(vEnvSetHPARange, envSetCmd.Flags().Lookup(flagHPARange))
+
+	envSetCmd.Flags().String(flagDefaultPDB, "",
+		"Pod disruption budget, as minAvailable=<value> or maxUnavailable=<value>, of deployments and stateful sets that don't have one")
+	viper.BindPFlag(vEnvSetPDB, envSetCmd.Flags().Lookup(flagDefaultPDB))
+
+	envSetCmd.Flags().String(flagServiceAccount, "",
+		"Service account of pods whose components don't set one")
+	viper.BindPFlag(vEnvSetSA, envSetCmd.Flags().Lookup(flagServiceAccount))
+
+	envSetCmd.Flags().String(flagIgnoreAnnotation, "",
+		"Annotation, as <key>=<value>, set on objects of the environment so external controllers ignore them")
+	viper.BindPFlag(vEnvSetIgnore, envSetCmd.Flags().Lookup(flagIgnoreAnnotation))
+
+	envSetCmd.Flags().String(flagIgnoreObject, "",
+		"Limit --ignore-annotation to objects of a kind, as <Kind>[/<name>]")
+	viper.BindPFlag(vEnvSetIgnoreObj, envSetCmd.Flags().Lookup(flagIgnoreObject))
+
+	envSetCmd.Flags().String(flagIgnoreSelector, "",
+		"Limit --ignore-annotation to objects matching a label selector")
+	viper.BindPFlag(vEnvSetIgnoreSel, envSetCmd.Flags().Lookup(flagIgnoreSelector))
+
+	envSetCmd.Flags().StringSlice(flagIncludeComponent, nil,
+		"Limit the components deployed to the environment to this component (can be repeated)")
+	viper.BindPFlag(vEnvSetInclude, envSetCmd.Flags().Lookup(flagIncludeComponent))
+
+	envSetCmd.Flags().StringSlice(flagExcludeComponent, nil,
+		"Never deploy this component to the environment, even if it is included (can be repeated)")
+	viper.BindPFlag(vEnvSetExclude, envSetCmd.Flags().Lookup(flagExcludeComponent))
+
+	envSetCmd.Flags().StringArray(flagSet, nil,
+		fmt.Sprintf("Set a field, as <field>=<value>: %s (can be repeated)", strings.Join(settableEnvFields, ", ")))
+
+	envSetCmd.Flags().StringSlice(flagUnset, nil,
+		"Remove an optional field: api-spec, context, default-pdb, default-replicas, exclude-components, hpa-range, ignore-annotations, include-components, inherits, name-prefix, namespace, or service-account (can be repeated)")
+	viper.BindPFlag(vEnvSetUnset, envSetCmd.Flags().Lookup(flagUnset))
+
+	envSetCmd.Flags().StringArray(flagParam, nil,
+		"Set a parameter of the environment, as <key>=<value> (can be repeated)")
+
+	envSetCmd.Flags().StringArray(flagParamDelete, nil,
+		"Remove a parameter of the environment (can be repeated)")
+
+	envSetCmd.Flags().Bool(flagFullRegen, false,
+		"Generate ksonnet-lib from scratch instead of reusing a version with identical type definitions")
+	viper.BindPFlag(vEnvSetFullRegen, envSetCmd.Flags().Lookup(flagFullRegen))
+
+	envSetCmd.Flags().Bool(flagResetMetadata, false,
+		"Regenerate ksonnet-lib for the environment's current Kubernetes version, making no other changes")
+	viper.BindPFlag(vEnvSetResetMeta, envSetCmd.Flags().Lookup(flagResetMetadata))
+
+	envSetCmd.Flags().Bool(flagReportBreakage, false,
+		"With --api-spec, report objects using kinds or fields that were removed or changed in the new Kubernetes version")
+	viper.BindPFlag(vEnvSetBreakage, envSetCmd.Flags().Lookup(flagReportBreakage))
+
+	envSetCmd.Flags().Bool(flagWaitReachable, false,
+		"With --server or --context, only save the environment once the new server responds as a Kubernetes API server")
+	viper.BindPFlag(vEnvSetWait, envSetCmd.Flags().Lookup(flagWaitReachable))
+
+	envSetCmd.Flags().Duration(flagTimeout, 30*time.Second,
+		"Time to wait for the new server to respond with --wait-reachable")
+	viper.BindPFlag(vEnvSetTimeout, envSetCmd.Flags().Lookup(flagTimeout))
+
+	envSetCmd.Flags().Bool(flagDryRun, false,
+		"List the changes which would be made without making them")
+	viper.BindPFlag(vEnvSetDryRun, envSetCmd.Flags().Lookup(flagDryRun))
+
+	envSetCmd.Flags().String(flagInherit, "",
+		"Name of an environment whose parameters the environment inherits")
+	viper.BindPFlag(vEnvSetInherit, envSetCmd.Flags().Lookup(flagInherit))
+
+	envSetCmd.Flags().StringArray(flagTag, nil,
+		"Set a tag, as <key>=<value>, or remove it, as <key>- (can be repeated)")
+
 	envSetCmd.Flags().BoolP(flagOverride, shortOverride, false, "Set fields in environment as override")
 	viper.BindPFlag(vEnvSetOverride, envSetCmd.Flags().Lookup(flagOverride))
 
 	return envSetCmd
 }
+
+// resolveEnvSetContext returns the server and namespace an environment is
+// updated to from a kubeconfig context. The namespace is taken from the
+// context unless one was given explicitly, as with `env add`; it is empty, so
+// the environment keeps its own, if the context doesn't set one. If keepURI is
+// set, the server is empty so the environment keeps its own, and the context
+// must set a namespace if none was given.
+func resolveEnvSetContext(config *client.Config, context, namespace string, keepURI bool) (string, string, error) {
+	server, ctxNs, err := config.ResolveContext(context)
+	if err != nil {
+		return "", "", err
+	}
+
+	if keepURI {
+		server = ""
+	}
+
+	if namespace != "" {
+		return server, namespace, nil
+	}
+
+	if ctxNs == "" && keepURI {
+		return "", "", fmt.Errorf("context %q doesn't set a namespace", context)
+	}
+
+	return server, ctxNs, nil
+}
+
+// settableEnvFields are the fields that can be set with `env set --set`. Each
+// field is also a flag of `env set`.
+var settableEnvFields = []string{
+	flagAPISpec,
+	flagEnvContext,
+	flagDefaultPDB,
+	flagDefaultReplicas,
+	flagHPARange,
+	flagEnvName,
+	flagNamePrefix,
+	flagNamespace,
+	flagServer,
+	flagServiceAccount,
+}
+
+// setEnvFields sets the flags of the fields given as `<field>=<value>` with
+// --set, so they are handled like their flags. A field can't be set both with
+// --set and with its flag, or more than once.
+func setEnvFields(flags *pflag.FlagSet, fields []string) error {
+	for _, field := range fields {
+		parts := strings.SplitN(field, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return fmt.Errorf("field %q is not in the form <field>=<value>", field)
+		}
+		name, value := parts[0], parts[1]
+
+		if !str.InSlice(name, settableEnvFields) {
+			return fmt.Errorf("unknown field %q; fields that can be set are: %s",
+				name, strings.Join(settableEnvFields, ", "))
+		}
+
+		if flags.Changed(name) {
+			return fmt.Errorf("field %q is set more than once", name)
+		}
+
+		if err := flags.Set(name, value); err != nil {
+			return fmt.Errorf("invalid value %q for field %q: %v", value, name, err)
+		}
+	}
+
+	return nil
+}
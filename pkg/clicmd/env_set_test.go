@@ -17,8 +17,13 @@ package clicmd
 
 import (
 	"testing"
+	"time"
 
 	"github.com/ksonnet/ksonnet/pkg/actions"
+	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 )
 
 func Test_envSetCmd(t *testing.T) {
@@ -28,13 +33,184 @@ func Test_envSetCmd(t *testing.T) {
 			args:   []string{"env", "set", "default", "--name", "new-name", "--namespace", "new-namespace", "--server", "new-server", "--api-spec", "new-api-spec"},
 			action: actionEnvSet,
 			expected: map[string]interface{}{
-				actions.OptionApp:        nil,
-				actions.OptionEnvName:    "default",
-				actions.OptionNewEnvName: "new-name",
-				actions.OptionNamespace:  "new-namespace",
-				actions.OptionServer:     "new-server",
-				actions.OptionSpecFlag:   "new-api-spec",
-				actions.OptionOverride:   false,
+				actions.OptionApp:               nil,
+				actions.OptionEnvName:           "default",
+				actions.OptionNewEnvName:        "new-name",
+				actions.OptionNamespace:         "new-namespace",
+				actions.OptionServer:            "new-server",
+				actions.OptionContext:           "",
+				actions.OptionSpecFlag:          "new-api-spec",
+				actions.OptionOverride:          false,
+				actions.OptionFullRegen:         false,
+				actions.OptionNamePrefix:        "",
+				actions.OptionResetMetadata:     false,
+				actions.OptionDefaultReplicas:   0,
+				actions.OptionHPARange:          "",
+				actions.OptionDefaultPDB:        "",
+				actions.OptionServiceAccount:    "",
+				actions.OptionUnsetFields:       make([]string, 0),
+				actions.OptionIgnoreAnnotation:  "",
+				actions.OptionIgnoreObject:      "",
+				actions.OptionIgnoreSelector:    "",
+				actions.OptionIncludeComponents: make([]string, 0),
+				actions.OptionExcludeComponents: make([]string, 0),
+				actions.OptionReportBreakage:    false,
+				actions.OptionWaitReachable:     false,
+				actions.OptionTimeout:           30 * time.Second,
+				actions.OptionDryRun:            false,
+				actions.OptionInherits:          "",
+				actions.OptionTags:              make([]string, 0),
+				actions.OptionParams:            make([]string, 0),
+				actions.OptionParamDeletes:      make([]string, 0),
+				actions.OptionClientConfig:      nil,
+			},
+		},
+		{
+			name:   "with tags",
+			args:   []string{"env", "set", "default", "--tag", "region=us-west", "--tag", "tier-"},
+			action: actionEnvSet,
+			expected: map[string]interface{}{
+				actions.OptionApp:               nil,
+				actions.OptionEnvName:           "default",
+				actions.OptionNewEnvName:        "",
+				actions.OptionNamespace:         "",
+				actions.OptionServer:            "",
+				actions.OptionContext:           "",
+				actions.OptionSpecFlag:          "",
+				actions.OptionOverride:          false,
+				actions.OptionFullRegen:         false,
+				actions.OptionNamePrefix:        "",
+				actions.OptionResetMetadata:     false,
+				actions.OptionDefaultReplicas:   0,
+				actions.OptionHPARange:          "",
+				actions.OptionDefaultPDB:        "",
+				actions.OptionServiceAccount:    "",
+				actions.OptionUnsetFields:       make([]string, 0),
+				actions.OptionIgnoreAnnotation:  "",
+				actions.OptionIgnoreObject:      "",
+				actions.OptionIgnoreSelector:    "",
+				actions.OptionIncludeComponents: make([]string, 0),
+				actions.OptionExcludeComponents: make([]string, 0),
+				actions.OptionReportBreakage:    false,
+				actions.OptionWaitReachable:     false,
+				actions.OptionTimeout:           30 * time.Second,
+				actions.OptionDryRun:            false,
+				actions.OptionInherits:          "",
+				actions.OptionTags:              []string{"region=us-west", "tier-"},
+				actions.OptionParams:            make([]string, 0),
+				actions.OptionParamDeletes:      make([]string, 0),
+				actions.OptionClientConfig:      nil,
+			},
+		},
+		{
+			name:   "params",
+			args:   []string{"env", "set", "default", "--param", "replicas=5", "--param", "debug=true", "--param-delete", "tier"},
+			action: actionEnvSet,
+			expected: map[string]interface{}{
+				actions.OptionApp:               nil,
+				actions.OptionEnvName:           "default",
+				actions.OptionNewEnvName:        "",
+				actions.OptionNamespace:         "",
+				actions.OptionServer:            "",
+				actions.OptionContext:           "",
+				actions.OptionSpecFlag:          "",
+				actions.OptionOverride:          false,
+				actions.OptionFullRegen:         false,
+				actions.OptionNamePrefix:        "",
+				actions.OptionResetMetadata:     false,
+				actions.OptionDefaultReplicas:   0,
+				actions.OptionHPARange:          "",
+				actions.OptionDefaultPDB:        "",
+				actions.OptionServiceAccount:    "",
+				actions.OptionUnsetFields:       make([]string, 0),
+				actions.OptionIgnoreAnnotation:  "",
+				actions.OptionIgnoreObject:      "",
+				actions.OptionIgnoreSelector:    "",
+				actions.OptionIncludeComponents: make([]string, 0),
+				actions.OptionExcludeComponents: make([]string, 0),
+				actions.OptionReportBreakage:    false,
+				actions.OptionWaitReachable:     false,
+				actions.OptionTimeout:           30 * time.Second,
+				actions.OptionDryRun:            false,
+				actions.OptionInherits:          "",
+				actions.OptionTags:              make([]string, 0),
+				actions.OptionParams:            []string{"replicas=5", "debug=true"},
+				actions.OptionParamDeletes:      []string{"tier"},
+				actions.OptionClientConfig:      nil,
+			},
+		},
+		{
+			name:   "dry run",
+			args:   []string{"env", "set", "default", "--name", "new-name", "--namespace", "new-namespace", "--server", "new-server", "--api-spec", "new-api-spec", "--dry-run"},
+			action: actionEnvSet,
+			expected: map[string]interface{}{
+				actions.OptionApp:               nil,
+				actions.OptionEnvName:           "default",
+				actions.OptionNewEnvName:        "new-name",
+				actions.OptionNamespace:         "new-namespace",
+				actions.OptionServer:            "new-server",
+				actions.OptionContext:           "",
+				actions.OptionSpecFlag:          "new-api-spec",
+				actions.OptionOverride:          false,
+				actions.OptionFullRegen:         false,
+				actions.OptionNamePrefix:        "",
+				actions.OptionResetMetadata:     false,
+				actions.OptionDefaultReplicas:   0,
+				actions.OptionHPARange:          "",
+				actions.OptionDefaultPDB:        "",
+				actions.OptionServiceAccount:    "",
+				actions.OptionUnsetFields:       make([]string, 0),
+				actions.OptionIgnoreAnnotation:  "",
+				actions.OptionIgnoreObject:      "",
+				actions.OptionIgnoreSelector:    "",
+				actions.OptionIncludeComponents: make([]string, 0),
+				actions.OptionExcludeComponents: make([]string, 0),
+				actions.OptionReportBreakage:    false,
+				actions.OptionWaitReachable:     false,
+				actions.OptionTimeout:           30 * time.Second,
+				actions.OptionDryRun:            true,
+				actions.OptionInherits:          "",
+				actions.OptionTags:              make([]string, 0),
+				actions.OptionParams:            make([]string, 0),
+				actions.OptionParamDeletes:      make([]string, 0),
+				actions.OptionClientConfig:      nil,
+			},
+		},
+		{
+			name:   "inherit",
+			args:   []string{"env", "set", "default", "--name", "new-name", "--namespace", "new-namespace", "--server", "new-server", "--api-spec", "new-api-spec", "--inherit", "base"},
+			action: actionEnvSet,
+			expected: map[string]interface{}{
+				actions.OptionApp:               nil,
+				actions.OptionEnvName:           "default",
+				actions.OptionNewEnvName:        "new-name",
+				actions.OptionNamespace:         "new-namespace",
+				actions.OptionServer:            "new-server",
+				actions.OptionContext:           "",
+				actions.OptionSpecFlag:          "new-api-spec",
+				actions.OptionOverride:          false,
+				actions.OptionFullRegen:         false,
+				actions.OptionNamePrefix:        "",
+				actions.OptionResetMetadata:     false,
+				actions.OptionDefaultReplicas:   0,
+				actions.OptionHPARange:          "",
+				actions.OptionDefaultPDB:        "",
+				actions.OptionServiceAccount:    "",
+				actions.OptionUnsetFields:       make([]string, 0),
+				actions.OptionIgnoreAnnotation:  "",
+				actions.OptionIgnoreObject:      "",
+				actions.OptionIgnoreSelector:    "",
+				actions.OptionIncludeComponents: make([]string, 0),
+				actions.OptionExcludeComponents: make([]string, 0),
+				actions.OptionReportBreakage:    false,
+				actions.OptionWaitReachable:     false,
+				actions.OptionTimeout:           30 * time.Second,
+				actions.OptionDryRun:            false,
+				actions.OptionInherits:          "base",
+				actions.OptionTags:              make([]string, 0),
+				actions.OptionParams:            make([]string, 0),
+				actions.OptionParamDeletes:      make([]string, 0),
+				actions.OptionClientConfig:      nil,
 			},
 		},
 		{
@@ -42,13 +218,36 @@ func Test_envSetCmd(t *testing.T) {
 			args:   []string{"env", "set", "default", "--name", "new-name", "--namespace", "new-namespace", "--server", "new-server", "--api-spec", "new-api-spec", "-o"},
 			action: actionEnvSet,
 			expected: map[string]interface{}{
-				actions.OptionApp:        nil,
-				actions.OptionEnvName:    "default",
-				actions.OptionNewEnvName: "new-name",
-				actions.OptionNamespace:  "new-namespace",
-				actions.OptionServer:     "new-server",
-				actions.OptionSpecFlag:   "new-api-spec",
-				actions.OptionOverride:   true,
+				actions.OptionApp:               nil,
+				actions.OptionEnvName:           "default",
+				actions.OptionNewEnvName:        "new-name",
+				actions.OptionNamespace:         "new-namespace",
+				actions.OptionServer:            "new-server",
+				actions.OptionContext:           "",
+				actions.OptionSpecFlag:          "new-api-spec",
+				actions.OptionOverride:          true,
+				actions.OptionFullRegen:         false,
+				actions.OptionNamePrefix:        "",
+				actions.OptionResetMetadata:     false,
+				actions.OptionDefaultReplicas:   0,
+				actions.OptionHPARange:          "",
+				actions.OptionDefaultPDB:        "",
+				actions.OptionServiceAccount:    "",
+				actions.OptionUnsetFields:       make([]string, 0),
+				actions.OptionIgnoreAnnotation:  "",
+				actions.OptionIgnoreObject:      "",
+				actions.OptionIgnoreSelector:    "",
+				actions.OptionIncludeComponents: make([]string, 0),
+				actions.OptionExcludeComponents: make([]string, 0),
+				actions.OptionReportBreakage:    false,
+				actions.OptionWaitReachable:     false,
+				actions.OptionTimeout:           30 * time.Second,
+				actions.OptionDryRun:            false,
+				actions.OptionInherits:          "",
+				actions.OptionTags:              make([]string, 0),
+				actions.OptionParams:            make([]string, 0),
+				actions.OptionParamDeletes:      make([]string, 0),
+				actions.OptionClientConfig:      nil,
 			},
 		},
 		{
@@ -56,13 +255,184 @@ func Test_envSetCmd(t *testing.T) {
 			args:   []string{"env", "set", "default", "--name", "new-name", "--namespace", "new-namespace", "--server", "new-server", "--api-spec", "new-api-spec", "--override"},
 			action: actionEnvSet,
 			expected: map[string]interface{}{
-				actions.OptionApp:        nil,
-				actions.OptionEnvName:    "default",
-				actions.OptionNewEnvName: "new-name",
-				actions.OptionNamespace:  "new-namespace",
-				actions.OptionServer:     "new-server",
-				actions.OptionSpecFlag:   "new-api-spec",
-				actions.OptionOverride:   true,
+				actions.OptionApp:               nil,
+				actions.OptionEnvName:           "default",
+				actions.OptionNewEnvName:        "new-name",
+				actions.OptionNamespace:         "new-namespace",
+				actions.OptionServer:            "new-server",
+				actions.OptionContext:           "",
+				actions.OptionSpecFlag:          "new-api-spec",
+				actions.OptionOverride:          true,
+				actions.OptionFullRegen:         false,
+				actions.OptionNamePrefix:        "",
+				actions.OptionResetMetadata:     false,
+				actions.OptionDefaultReplicas:   0,
+				actions.OptionHPARange:          "",
+				actions.OptionDefaultPDB:        "",
+				actions.OptionServiceAccount:    "",
+				actions.OptionUnsetFields:       make([]string, 0),
+				actions.OptionIgnoreAnnotation:  "",
+				actions.OptionIgnoreObject:      "",
+				actions.OptionIgnoreSelector:    "",
+				actions.OptionIncludeComponents: make([]string, 0),
+				actions.OptionExcludeComponents: make([]string, 0),
+				actions.OptionReportBreakage:    false,
+				actions.OptionWaitReachable:     false,
+				actions.OptionTimeout:           30 * time.Second,
+				actions.OptionDryRun:            false,
+				actions.OptionInherits:          "",
+				actions.OptionTags:              make([]string, 0),
+				actions.OptionParams:            make([]string, 0),
+				actions.OptionParamDeletes:      make([]string, 0),
+				actions.OptionClientConfig:      nil,
+			},
+		},
+		{
+			name:   "full regeneration",
+			args:   []string{"env", "set", "default", "--api-spec", "new-api-spec", "--full-regen"},
+			action: actionEnvSet,
+			expected: map[string]interface{}{
+				actions.OptionApp:               nil,
+				actions.OptionEnvName:           "default",
+				actions.OptionNewEnvName:        "",
+				actions.OptionNamespace:         "",
+				actions.OptionServer:            "",
+				actions.OptionContext:           "",
+				actions.OptionSpecFlag:          "new-api-spec",
+				actions.OptionOverride:          false,
+				actions.OptionFullRegen:         true,
+				actions.OptionNamePrefix:        "",
+				actions.OptionResetMetadata:     false,
+				actions.OptionDefaultReplicas:   0,
+				actions.OptionHPARange:          "",
+				actions.OptionDefaultPDB:        "",
+				actions.OptionServiceAccount:    "",
+				actions.OptionUnsetFields:       make([]string, 0),
+				actions.OptionIgnoreAnnotation:  "",
+				actions.OptionIgnoreObject:      "",
+				actions.OptionIgnoreSelector:    "",
+				actions.OptionIncludeComponents: make([]string, 0),
+				actions.OptionExcludeComponents: make([]string, 0),
+				actions.OptionReportBreakage:    false,
+				actions.OptionWaitReachable:     false,
+				actions.OptionTimeout:           30 * time.Second,
+				actions.OptionDryRun:            false,
+				actions.OptionInherits:          "",
+				actions.OptionTags:              make([]string, 0),
+				actions.OptionParams:            make([]string, 0),
+				actions.OptionParamDeletes:      make([]string, 0),
+				actions.OptionClientConfig:      nil,
+			},
+		},
+		{
+			name:   "name prefix",
+			args:   []string{"env", "set", "default", "--name-prefix", "staging-"},
+			action: actionEnvSet,
+			expected: map[string]interface{}{
+				actions.OptionApp:               nil,
+				actions.OptionEnvName:           "default",
+				actions.OptionNewEnvName:        "",
+				actions.OptionNamespace:         "",
+				actions.OptionServer:            "",
+				actions.OptionContext:           "",
+				actions.OptionSpecFlag:          "",
+				actions.OptionOverride:          false,
+				actions.OptionFullRegen:         false,
+				actions.OptionNamePrefix:        "staging-",
+				actions.OptionResetMetadata:     false,
+				actions.OptionDefaultReplicas:   0,
+				actions.OptionHPARange:          "",
+				actions.OptionDefaultPDB:        "",
+				actions.OptionServiceAccount:    "",
+				actions.OptionUnsetFields:       make([]string, 0),
+				actions.OptionIgnoreAnnotation:  "",
+				actions.OptionIgnoreObject:      "",
+				actions.OptionIgnoreSelector:    "",
+				actions.OptionIncludeComponents: make([]string, 0),
+				actions.OptionExcludeComponents: make([]string, 0),
+				actions.OptionReportBreakage:    false,
+				actions.OptionWaitReachable:     false,
+				actions.OptionTimeout:           30 * time.Second,
+				actions.OptionDryRun:            false,
+				actions.OptionInherits:          "",
+				actions.OptionTags:              make([]string, 0),
+				actions.OptionParams:            make([]string, 0),
+				actions.OptionParamDeletes:      make([]string, 0),
+				actions.OptionClientConfig:      nil,
+			},
+		},
+		{
+			name:   "replica and hpa defaults",
+			args:   []string{"env", "set", "default", "--default-replicas", "3", "--hpa-range", "3:10"},
+			action: actionEnvSet,
+			expected: map[string]interface{}{
+				actions.OptionApp:               nil,
+				actions.OptionEnvName:           "default",
+				actions.OptionNewEnvName:        "",
+				actions.OptionNamespace:         "",
+				actions.OptionServer:            "",
+				actions.OptionContext:           "",
+				actions.OptionSpecFlag:          "",
+				actions.OptionOverride:          false,
+				actions.OptionFullRegen:         false,
+				actions.OptionNamePrefix:        "",
+				actions.OptionResetMetadata:     false,
+				actions.OptionDefaultReplicas:   3,
+				actions.OptionHPARange:          "3:10",
+				actions.OptionDefaultPDB:        "",
+				actions.OptionServiceAccount:    "",
+				actions.OptionUnsetFields:       make([]string, 0),
+				actions.OptionIgnoreAnnotation:  "",
+				actions.OptionIgnoreObject:      "",
+				actions.OptionIgnoreSelector:    "",
+				actions.OptionIncludeComponents: make([]string, 0),
+				actions.OptionExcludeComponents: make([]string, 0),
+				actions.OptionReportBreakage:    false,
+				actions.OptionWaitReachable:     false,
+				actions.OptionTimeout:           30 * time.Second,
+				actions.OptionDryRun:            false,
+				actions.OptionInherits:          "",
+				actions.OptionTags:              make([]string, 0),
+				actions.OptionParams:            make([]string, 0),
+				actions.OptionParamDeletes:      make([]string, 0),
+				actions.OptionClientConfig:      nil,
+			},
+		},
+		{
+			name:   "pdb default",
+			args:   []string{"env", "set", "default", "--default-pdb", "maxUnavailable=25%"},
+			action: actionEnvSet,
+			expected: map[string]interface{}{
+				actions.OptionApp:               nil,
+				actions.OptionEnvName:           "default",
+				actions.OptionNewEnvName:        "",
+				actions.OptionNamespace:         "",
+				actions.OptionServer:            "",
+				actions.OptionContext:           "",
+				actions.OptionSpecFlag:          "",
+				actions.OptionOverride:          false,
+				actions.OptionFullRegen:         false,
+				actions.OptionNamePrefix:        "",
+				actions.OptionResetMetadata:     false,
+				actions.OptionDefaultReplicas:   0,
+				actions.OptionHPARange:          "",
+				actions.OptionDefaultPDB:        "maxUnavailable=25%",
+				actions.OptionServiceAccount:    "",
+				actions.OptionUnsetFields:       make([]string, 0),
+				actions.OptionIgnoreAnnotation:  "",
+				actions.OptionIgnoreObject:      "",
+				actions.OptionIgnoreSelector:    "",
+				actions.OptionIncludeComponents: make([]string, 0),
+				actions.OptionExcludeComponents: make([]string, 0),
+				actions.OptionReportBreakage:    false,
+				actions.OptionWaitReachable:     false,
+				actions.OptionTimeout:           30 * time.Second,
+				actions.OptionDryRun:            false,
+				actions.OptionInherits:          "",
+				actions.OptionTags:              make([]string, 0),
+				actions.OptionParams:            make([]string, 0),
+				actions.OptionParamDeletes:      make([]string, 0),
+				actions.OptionClientConfig:      nil,
 			},
 		},
 		{
@@ -70,7 +440,387 @@ func Test_envSetCmd(t *testing.T) {
 			args:  []string{"env", "set"},
 			isErr: true,
 		},
+		{
+			name:  "invalid new name",
+			args:  []string{"env", "set", "default", "--name", "foo/../bar"},
+			isErr: true,
+		},
+		{
+			name:   "service account",
+			args:   []string{"env", "set", "default", "--service-account", "app-sa"},
+			action: actionEnvSet,
+			expected: map[string]interface{}{
+				actions.OptionApp:               nil,
+				actions.OptionEnvName:           "default",
+				actions.OptionNewEnvName:        "",
+				actions.OptionNamespace:         "",
+				actions.OptionServer:            "",
+				actions.OptionContext:           "",
+				actions.OptionSpecFlag:          "",
+				actions.OptionOverride:          false,
+				actions.OptionFullRegen:         false,
+				actions.OptionNamePrefix:        "",
+				actions.OptionResetMetadata:     false,
+				actions.OptionDefaultReplicas:   0,
+				actions.OptionHPARange:          "",
+				actions.OptionDefaultPDB:        "",
+				actions.OptionServiceAccount:    "app-sa",
+				actions.OptionUnsetFields:       make([]string, 0),
+				actions.OptionIgnoreAnnotation:  "",
+				actions.OptionIgnoreObject:      "",
+				actions.OptionIgnoreSelector:    "",
+				actions.OptionIncludeComponents: make([]string, 0),
+				actions.OptionExcludeComponents: make([]string, 0),
+				actions.OptionReportBreakage:    false,
+				actions.OptionWaitReachable:     false,
+				actions.OptionTimeout:           30 * time.Second,
+				actions.OptionDryRun:            false,
+				actions.OptionInherits:          "",
+				actions.OptionTags:              make([]string, 0),
+				actions.OptionParams:            make([]string, 0),
+				actions.OptionParamDeletes:      make([]string, 0),
+				actions.OptionClientConfig:      nil,
+			},
+		},
+		{
+			name:   "unset fields",
+			args:   []string{"env", "set", "default", "--unset", "name-prefix", "--unset", "namespace"},
+			action: actionEnvSet,
+			expected: map[string]interface{}{
+				actions.OptionApp:               nil,
+				actions.OptionEnvName:           "default",
+				actions.OptionNewEnvName:        "",
+				actions.OptionNamespace:         "",
+				actions.OptionServer:            "",
+				actions.OptionContext:           "",
+				actions.OptionSpecFlag:          "",
+				actions.OptionOverride:          false,
+				actions.OptionFullRegen:         false,
+				actions.OptionNamePrefix:        "",
+				actions.OptionResetMetadata:     false,
+				actions.OptionDefaultReplicas:   0,
+				actions.OptionHPARange:          "",
+				actions.OptionDefaultPDB:        "",
+				actions.OptionServiceAccount:    "",
+				actions.OptionUnsetFields:       []string{"name-prefix", "namespace"},
+				actions.OptionIgnoreAnnotation:  "",
+				actions.OptionIgnoreObject:      "",
+				actions.OptionIgnoreSelector:    "",
+				actions.OptionIncludeComponents: make([]string, 0),
+				actions.OptionExcludeComponents: make([]string, 0),
+				actions.OptionReportBreakage:    false,
+				actions.OptionWaitReachable:     false,
+				actions.OptionTimeout:           30 * time.Second,
+				actions.OptionDryRun:            false,
+				actions.OptionInherits:          "",
+				actions.OptionTags:              make([]string, 0),
+				actions.OptionParams:            make([]string, 0),
+				actions.OptionParamDeletes:      make([]string, 0),
+				actions.OptionClientConfig:      nil,
+			},
+		},
+		{
+			name:   "ignore annotation",
+			args:   []string{"env", "set", "default", "--ignore-annotation", "fluxcd.io/ignore=true", "--ignore-object", "ConfigMap/settings", "--ignore-selector", "tier=frontend"},
+			action: actionEnvSet,
+			expected: map[string]interface{}{
+				actions.OptionApp:               nil,
+				actions.OptionEnvName:           "default",
+				actions.OptionNewEnvName:        "",
+				actions.OptionNamespace:         "",
+				actions.OptionServer:            "",
+				actions.OptionContext:           "",
+				actions.OptionSpecFlag:          "",
+				actions.OptionOverride:          false,
+				actions.OptionFullRegen:         false,
+				actions.OptionNamePrefix:        "",
+				actions.OptionResetMetadata:     false,
+				actions.OptionDefaultReplicas:   0,
+				actions.OptionHPARange:          "",
+				actions.OptionDefaultPDB:        "",
+				actions.OptionServiceAccount:    "",
+				actions.OptionUnsetFields:       make([]string, 0),
+				actions.OptionIgnoreAnnotation:  "fluxcd.io/ignore=true",
+				actions.OptionIgnoreObject:      "ConfigMap/settings",
+				actions.OptionIgnoreSelector:    "tier=frontend",
+				actions.OptionIncludeComponents: make([]string, 0),
+				actions.OptionExcludeComponents: make([]string, 0),
+				actions.OptionReportBreakage:    false,
+				actions.OptionWaitReachable:     false,
+				actions.OptionTimeout:           30 * time.Second,
+				actions.OptionDryRun:            false,
+				actions.OptionInherits:          "",
+				actions.OptionTags:              make([]string, 0),
+				actions.OptionParams:            make([]string, 0),
+				actions.OptionParamDeletes:      make([]string, 0),
+				actions.OptionClientConfig:      nil,
+			},
+		},
+		{
+			name:   "report breakage",
+			args:   []string{"env", "set", "default", "--api-spec", "version:v1.16.0", "--report-breakage"},
+			action: actionEnvSet,
+			expected: map[string]interface{}{
+				actions.OptionApp:               nil,
+				actions.OptionEnvName:           "default",
+				actions.OptionNewEnvName:        "",
+				actions.OptionNamespace:         "",
+				actions.OptionServer:            "",
+				actions.OptionContext:           "",
+				actions.OptionSpecFlag:          "version:v1.16.0",
+				actions.OptionOverride:          false,
+				actions.OptionFullRegen:         false,
+				actions.OptionNamePrefix:        "",
+				actions.OptionResetMetadata:     false,
+				actions.OptionDefaultReplicas:   0,
+				actions.OptionHPARange:          "",
+				actions.OptionDefaultPDB:        "",
+				actions.OptionServiceAccount:    "",
+				actions.OptionUnsetFields:       make([]string, 0),
+				actions.OptionIgnoreAnnotation:  "",
+				actions.OptionIgnoreObject:      "",
+				actions.OptionIgnoreSelector:    "",
+				actions.OptionIncludeComponents: make([]string, 0),
+				actions.OptionExcludeComponents: make([]string, 0),
+				actions.OptionReportBreakage:    true,
+				actions.OptionWaitReachable:     false,
+				actions.OptionTimeout:           30 * time.Second,
+				actions.OptionDryRun:            false,
+				actions.OptionInherits:          "",
+				actions.OptionTags:              make([]string, 0),
+				actions.OptionParams:            make([]string, 0),
+				actions.OptionParamDeletes:      make([]string, 0),
+				actions.OptionClientConfig:      nil,
+			},
+		},
+		{
+			name:   "wait reachable",
+			args:   []string{"env", "set", "default", "--server", "https://new.example.com", "--wait-reachable", "--timeout", "10s"},
+			action: actionEnvSet,
+			expected: map[string]interface{}{
+				actions.OptionApp:               nil,
+				actions.OptionEnvName:           "default",
+				actions.OptionNewEnvName:        "",
+				actions.OptionNamespace:         "",
+				actions.OptionServer:            "https://new.example.com",
+				actions.OptionContext:           "",
+				actions.OptionSpecFlag:          "",
+				actions.OptionOverride:          false,
+				actions.OptionFullRegen:         false,
+				actions.OptionNamePrefix:        "",
+				actions.OptionResetMetadata:     false,
+				actions.OptionDefaultReplicas:   0,
+				actions.OptionHPARange:          "",
+				actions.OptionDefaultPDB:        "",
+				actions.OptionServiceAccount:    "",
+				actions.OptionUnsetFields:       make([]string, 0),
+				actions.OptionIgnoreAnnotation:  "",
+				actions.OptionIgnoreObject:      "",
+				actions.OptionIgnoreSelector:    "",
+				actions.OptionIncludeComponents: make([]string, 0),
+				actions.OptionExcludeComponents: make([]string, 0),
+				actions.OptionReportBreakage:    false,
+				actions.OptionWaitReachable:     true,
+				actions.OptionTimeout:           10 * time.Second,
+				actions.OptionDryRun:            false,
+				actions.OptionInherits:          "",
+				actions.OptionTags:              make([]string, 0),
+				actions.OptionParams:            make([]string, 0),
+				actions.OptionParamDeletes:      make([]string, 0),
+				actions.OptionClientConfig:      nil,
+			},
+		},
+		{
+			name:  "keep uri without context",
+			args:  []string{"env", "set", "default", "--keep-uri"},
+			isErr: true,
+		},
+		{
+			name:   "set fields",
+			args:   []string{"env", "set", "default", "--set", "namespace=web", "--set", "default-replicas=3", "--set", "hpa-range=3:10", "--unset", "name-prefix"},
+			action: actionEnvSet,
+			expected: map[string]interface{}{
+				actions.OptionApp:               nil,
+				actions.OptionEnvName:           "default",
+				actions.OptionNewEnvName:        "",
+				actions.OptionNamespace:         "web",
+				actions.OptionServer:            "",
+				actions.OptionContext:           "",
+				actions.OptionSpecFlag:          "",
+				actions.OptionOverride:          false,
+				actions.OptionFullRegen:         false,
+				actions.OptionNamePrefix:        "",
+				actions.OptionResetMetadata:     false,
+				actions.OptionDefaultReplicas:   3,
+				actions.OptionHPARange:          "3:10",
+				actions.OptionDefaultPDB:        "",
+				actions.OptionServiceAccount:    "",
+				actions.OptionUnsetFields:       []string{"name-prefix"},
+				actions.OptionIgnoreAnnotation:  "",
+				actions.OptionIgnoreObject:      "",
+				actions.OptionIgnoreSelector:    "",
+				actions.OptionIncludeComponents: make([]string, 0),
+				actions.OptionExcludeComponents: make([]string, 0),
+				actions.OptionReportBreakage:    false,
+				actions.OptionWaitReachable:     false,
+				actions.OptionTimeout:           30 * time.Second,
+				actions.OptionDryRun:            false,
+				actions.OptionInherits:          "",
+				actions.OptionTags:              make([]string, 0),
+				actions.OptionParams:            make([]string, 0),
+				actions.OptionParamDeletes:      make([]string, 0),
+				actions.OptionClientConfig:      nil,
+			},
+		},
+		{
+			name:   "component scope",
+			args:   []string{"env", "set", "default", "--include-component", "web", "--include-component", "worker", "--exclude-component", "debug"},
+			action: actionEnvSet,
+			expected: map[string]interface{}{
+				actions.OptionApp:               nil,
+				actions.OptionEnvName:           "default",
+				actions.OptionNewEnvName:        "",
+				actions.OptionNamespace:         "",
+				actions.OptionServer:            "",
+				actions.OptionContext:           "",
+				actions.OptionSpecFlag:          "",
+				actions.OptionOverride:          false,
+				actions.OptionFullRegen:         false,
+				actions.OptionNamePrefix:        "",
+				actions.OptionResetMetadata:     false,
+				actions.OptionDefaultReplicas:   0,
+				actions.OptionHPARange:          "",
+				actions.OptionDefaultPDB:        "",
+				actions.OptionServiceAccount:    "",
+				actions.OptionUnsetFields:       make([]string, 0),
+				actions.OptionIgnoreAnnotation:  "",
+				actions.OptionIgnoreObject:      "",
+				actions.OptionIgnoreSelector:    "",
+				actions.OptionIncludeComponents: []string{"web", "worker"},
+				actions.OptionExcludeComponents: []string{"debug"},
+				actions.OptionReportBreakage:    false,
+				actions.OptionWaitReachable:     false,
+				actions.OptionTimeout:           30 * time.Second,
+				actions.OptionDryRun:            false,
+				actions.OptionInherits:          "",
+				actions.OptionTags:              make([]string, 0),
+				actions.OptionParams:            make([]string, 0),
+				actions.OptionParamDeletes:      make([]string, 0),
+				actions.OptionClientConfig:      nil,
+			},
+		},
+		{
+			name:  "set unknown field",
+			args:  []string{"env", "set", "default", "--set", "replicas=3"},
+			isErr: true,
+		},
+		{
+			name:  "set field without value",
+			args:  []string{"env", "set", "default", "--set", "namespace"},
+			isErr: true,
+		},
+		{
+			name:  "set field with invalid value",
+			args:  []string{"env", "set", "default", "--set", "default-replicas=many"},
+			isErr: true,
+		},
+		{
+			name:  "set field also given by flag",
+			args:  []string{"env", "set", "default", "--namespace", "web", "--set", "namespace=api"},
+			isErr: true,
+		},
+		{
+			name:  "set field twice",
+			args:  []string{"env", "set", "default", "--set", "namespace=web", "--set", "namespace=api"},
+			isErr: true,
+		},
 	}
 
 	runTestCmd(t, cases)
 }
+
+func Test_resolveEnvSetContext(t *testing.T) {
+	kubeConfig := clientcmdapi.Config{
+		Clusters: map[string]*clientcmdapi.Cluster{
+			"dev": {Server: "https://dev.example.com"},
+		},
+		Contexts: map[string]*clientcmdapi.Context{
+			"dev":          {Cluster: "dev", Namespace: "web"},
+			"no-namespace": {Cluster: "dev"},
+		},
+	}
+
+	cases := []struct {
+		name      string
+		context   string
+		namespace string
+		keepURI   bool
+		expServer string
+		expNs     string
+		isErr     bool
+	}{
+		{
+			name:      "server from context",
+			context:   "dev",
+			expServer: "https://dev.example.com",
+			expNs:     "web",
+		},
+		{
+			name:      "server from context without namespace",
+			context:   "no-namespace",
+			expServer: "https://dev.example.com",
+		},
+		{
+			name:      "server from context with namespace",
+			context:   "dev",
+			namespace: "api",
+			expServer: "https://dev.example.com",
+			expNs:     "api",
+		},
+		{
+			name:    "keep uri",
+			context: "dev",
+			keepURI: true,
+			expNs:   "web",
+		},
+		{
+			name:      "keep uri with namespace",
+			context:   "dev",
+			namespace: "api",
+			keepURI:   true,
+			expNs:     "api",
+		},
+		{
+			name:    "keep uri with context without namespace",
+			context: "no-namespace",
+			keepURI: true,
+			isErr:   true,
+		},
+		{
+			name:    "missing context",
+			context: "missing",
+			keepURI: true,
+			isErr:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			overrides := &clientcmd.ConfigOverrides{}
+			c := &client.Config{
+				Overrides: overrides,
+				Config:    clientcmd.NewDefaultClientConfig(kubeConfig, overrides),
+			}
+
+			server, namespace, err := resolveEnvSetContext(c, tc.context, tc.namespace, tc.keepURI)
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expServer, server)
+			require.Equal(t, tc.expNs, namespace)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package clicmd
+
+import (
+	"fmt"
+
+	"github.com/ksonnet/ksonnet/pkg/actions"
+	"github.com/spf13/cobra"
+)
+
+var (
+	envShowDiffLibLong = `
+The ` + "`show-diff-lib`" + ` command shows the differences between the ksonnet-lib
+generated for an environment's Kubernetes version (in ` + "`lib/`" + `) and what a fresh
+generation from the same API spec would produce, as a unified diff. Lines removed
+by the diff are in the generated ksonnet-lib, lines added would be generated now.
+
+Differences mean the ksonnet-lib was edited by hand, or was generated by another
+version of ksonnet. Unlike ` + "`ks env verify-lib`" + `, which only detects changes since
+generation, this also detects changes to the generator. Downloading the API spec
+requires network access.
+
+The command doesn't modify any files. It exits with a non-zero status if there
+are differences. Run ` + "`ks env set <env-name> --reset-metadata`" + ` to regenerate the
+ksonnet-lib.
+
+### Related Commands
+
+* ` + "`ks env verify-lib` " + `— ` + envShortDesc["verify-lib"] + `
+* ` + "`ks env set` " + `— ` + envShortDesc["set"] + `
+
+### Syntax
+`
+	envShowDiffLibExample = `
+# Compare the generated ksonnet-lib of the 'us-west/staging' environment with a
+# fresh generation
+ks env show-diff-lib us-west/staging`
+)
+
+func newEnvShowDiffLibCmd() *cobra.Command {
+	envShowDiffLibCmd := &cobra.Command{
+		Use:     "show-diff-lib <env-name>",
+		Short:   envShortDesc["show-diff-lib"],
+		Long:    envShowDiffLibLong,
+		Example: envShowDiffLibExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("'env show-diff-lib' takes a single argument, that is the name of the environment")
+			}
+
+			m := map[string]interface{}{
+				actions.OptionEnvName: args[0],
+			}
+			addGlobalOptions(m)
+
+			return runAction(actionEnvShowDiffLib, m)
+		},
+	}
+
+	return envShowDiffLibCmd
+}
@@ -17,6 +17,13 @@ package clicmd
 
 import (
 	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/spf13/afero"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 )
 
 func Test_envCmd(t *testing.T) {
@@ -35,3 +42,217 @@ func Test_envCmd(t *testing.T) {
 
 	runTestCmd(t, cases)
 }
+
+func Test_resolveEnvFlags(t *testing.T) {
+	kubeConfig := clientcmdapi.Config{
+		Clusters: map[string]*clientcmdapi.Cluster{
+			"dev": {Server: "https://dev.example.com"},
+		},
+		Contexts: map[string]*clientcmdapi.Context{
+			"dev":          {Cluster: "dev", Namespace: "web"},
+			"no-namespace": {Cluster: "dev"},
+		},
+		CurrentContext: "dev",
+	}
+
+	cases := []struct {
+		name            string
+		args            []string
+		preferContextNs bool
+		expServer       string
+		expNs           string
+		isErr           bool
+	}{
+		{
+			name:      "namespace from context",
+			args:      []string{"--context", "dev"},
+			expServer: "https://dev.example.com",
+			expNs:     "web",
+		},
+		{
+			name:      "namespace flag over context",
+			args:      []string{"--context", "dev", "--namespace", "api"},
+			expServer: "https://dev.example.com",
+			expNs:     "api",
+		},
+		{
+			name:            "context over namespace flag",
+			args:            []string{"--context", "dev", "--namespace", "api"},
+			preferContextNs: true,
+			expServer:       "https://dev.example.com",
+			expNs:           "web",
+		},
+		{
+			name:            "namespace flag when the context has no namespace",
+			args:            []string{"--context", "no-namespace", "--namespace", "api"},
+			preferContextNs: true,
+			expServer:       "https://dev.example.com",
+			expNs:           "api",
+		},
+		{
+			name:            "default namespace",
+			args:            []string{"--context", "no-namespace"},
+			preferContextNs: true,
+			expServer:       "https://dev.example.com",
+			expNs:           "default",
+		},
+		{
+			name:            "prefer context namespace with server",
+			args:            []string{"--server", "https://prod.example.com", "--namespace", "api"},
+			preferContextNs: true,
+			isErr:           true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			flags := pflag.NewFlagSet("env", pflag.ContinueOnError)
+			flags.String(flagEnvServer, "", "")
+			flags.String(flagEnvNamespace, "", "")
+			flags.String(flagEnvContext, "", "")
+			require.NoError(t, flags.Parse(tc.args))
+
+			overrides := &clientcmd.ConfigOverrides{}
+			c := &client.Config{
+				Overrides: overrides,
+				Config:    clientcmd.NewDefaultClientConfig(kubeConfig, overrides),
+			}
+
+			server, namespace, _, err := resolveEnvFlags(flags, c, false, tc.preferContextNs)
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expServer, server)
+			require.Equal(t, tc.expNs, namespace)
+		})
+	}
+}
+
+func Test_resolveEnvName(t *testing.T) {
+	appYAML := `apiVersion: 0.3.0
+environments:
+  default:
+    destination:
+      namespace: default
+      server: https://localhost:6443
+    path: default
+  staging:
+    destination:
+      namespace: default
+      server: https://localhost:6443
+    path: staging
+  us-west/staging:
+    destination:
+      namespace: default
+      server: https://localhost:6443
+    path: us-west/staging
+  us-west/prod:
+    destination:
+      namespace: default
+      server: https://localhost:6443
+    path: us-west/prod
+  us-east/prod:
+    destination:
+      namespace: default
+      server: https://localhost:6443
+    path: us-east/prod
+kind: ksonnet.io/app
+name: test-app
+version: 0.0.1
+`
+
+	cases := []struct {
+		name      string
+		dir       string
+		envName   string
+		matchLeaf bool
+		expected  string
+		isErr     bool
+	}{
+		{
+			name:      "app root",
+			dir:       "/app",
+			envName:   "prod",
+			matchLeaf: true,
+			expected:  "prod",
+		},
+		{
+			name:     "dot slash",
+			dir:      "/app/environments/us-west",
+			envName:  "./qa",
+			expected: "us-west/qa",
+		},
+		{
+			name:     "dot dot slash",
+			dir:      "/app/environments/us-west/prod",
+			envName:  "../staging",
+			expected: "us-west/staging",
+		},
+		{
+			name:    "outside of the environments directory",
+			dir:     "/app",
+			envName: "./prod",
+			isErr:   true,
+		},
+		{
+			name:    "escapes the environments directory",
+			dir:     "/app/environments/us-west",
+			envName: "../../prod",
+			isErr:   true,
+		},
+		{
+			name:      "leaf below the current directory",
+			dir:       "/app/environments/us-west",
+			envName:   "staging",
+			matchLeaf: true,
+			expected:  "us-west/staging",
+		},
+		{
+			name:      "leaf with a single match",
+			dir:       "/app/environments/us-east",
+			envName:   "prod",
+			matchLeaf: true,
+			expected:  "us-east/prod",
+		},
+		{
+			name:      "existing environment",
+			dir:       "/app/environments",
+			envName:   "staging",
+			matchLeaf: true,
+			expected:  "staging",
+		},
+		{
+			name:      "ambiguous leaf",
+			dir:       "/app/environments",
+			envName:   "prod",
+			matchLeaf: true,
+			isErr:     true,
+		},
+		{
+			name:     "leaf not matched",
+			dir:      "/app/environments",
+			envName:  "prod",
+			expected: "prod",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			require.NoError(t, afero.WriteFile(fs, "/app/app.yaml", []byte(appYAML), 0644))
+			require.NoError(t, fs.MkdirAll(tc.dir, 0755))
+
+			got, err := resolveEnvName(fs, tc.dir, tc.envName, tc.matchLeaf)
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
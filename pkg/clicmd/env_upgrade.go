@@ -0,0 +1,94 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package clicmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ksonnet/ksonnet/pkg/actions"
+	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	vEnvUpgradeTimeout = "env-upgrade-timeout"
+)
+
+var (
+	envUpgradeLong = `
+The ` + "`upgrade`" + ` command regenerates the ksonnet-lib of an environment after its
+cluster was upgraded to a new version of Kubernetes. The OpenAPI spec is
+retrieved from the environment's server, using its recorded address and
+certificate, and ` + "`swagger.json`, `k8s.libsonnet` and `k.libsonnet`" + ` are generated
+from it. The Kubernetes version of the spec is recorded for the environment in
+` + "`app.yaml`" + `.
+
+Unlike ` + "`ks env set --api-spec`" + `, which takes the spec of a released version of
+Kubernetes, this uses the spec the cluster actually serves.
+
+If the server can't be reached, or ksonnet-lib can't be generated, the command
+fails and leaves the environment and its existing ksonnet-lib as they were.
+
+### Related Commands
+
+* ` + "`ks env set` " + `— ` + envShortDesc["set"] + `
+* ` + "`ks env update` " + `— ` + envShortDesc["update"] + `
+* ` + "`ks env verify-lib` " + `— ` + envShortDesc["verify-lib"] + `
+
+### Syntax
+`
+	envUpgradeExample = `
+# Regenerate the ksonnet-lib of 'us-west/staging' from the OpenAPI spec of its
+# cluster.
+ks env upgrade us-west/staging
+
+# Allow a minute for a slow server to serve its OpenAPI spec.
+ks env upgrade prod --timeout=1m`
+)
+
+func newEnvUpgradeCmd() *cobra.Command {
+	envClientConfig := client.NewDefaultClientConfig()
+
+	envUpgradeCmd := &cobra.Command{
+		Use:     "upgrade <env-name>",
+		Short:   envShortDesc["upgrade"],
+		Long:    envUpgradeLong,
+		Example: envUpgradeExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("'env upgrade' takes a single argument, that is the name of the environment")
+			}
+
+			m := map[string]interface{}{
+				actions.OptionEnvName:      args[0],
+				actions.OptionClientConfig: envClientConfig,
+				actions.OptionTimeout:      viper.GetDuration(vEnvUpgradeTimeout),
+			}
+			addGlobalOptions(m)
+
+			return runAction(actionEnvUpgrade, m)
+		},
+	}
+
+	envClientConfig.BindClientGoFlags(envUpgradeCmd)
+
+	envUpgradeCmd.Flags().Duration(flagTimeout, 30*time.Second, "Time to wait for the environment's server to serve its OpenAPI spec")
+	viper.BindPFlag(vEnvUpgradeTimeout, envUpgradeCmd.Flags().Lookup(flagTimeout))
+
+	return envUpgradeCmd
+}
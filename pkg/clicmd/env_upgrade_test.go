@@ -0,0 +1,57 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package clicmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ksonnet/ksonnet/pkg/actions"
+)
+
+func Test_envUpgradeCmd(t *testing.T) {
+	cases := []cmdTestCase{
+		{
+			name:   "in general",
+			args:   []string{"env", "upgrade", "us-west/staging"},
+			action: actionEnvUpgrade,
+			expected: map[string]interface{}{
+				actions.OptionApp:          nil,
+				actions.OptionEnvName:      "us-west/staging",
+				actions.OptionClientConfig: nil,
+				actions.OptionTimeout:      30 * time.Second,
+			},
+		},
+		{
+			name:   "with timeout",
+			args:   []string{"env", "upgrade", "prod", "--timeout", "1m"},
+			action: actionEnvUpgrade,
+			expected: map[string]interface{}{
+				actions.OptionApp:          nil,
+				actions.OptionEnvName:      "prod",
+				actions.OptionClientConfig: nil,
+				actions.OptionTimeout:      time.Minute,
+			},
+		},
+		{
+			name:  "no environment",
+			args:  []string{"env", "upgrade"},
+			isErr: true,
+		},
+	}
+
+	runTestCmd(t, cases)
+}
@@ -0,0 +1,95 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package clicmd
+
+import (
+	"fmt"
+
+	"github.com/ksonnet/ksonnet/pkg/actions"
+	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	vEnvValidateAllOutput            = "env-validate-all-output"
+	vEnvValidateAllCheckReachability = "env-validate-all-check-reachability"
+)
+
+var (
+	envValidateAllLong = `
+The ` + "`validate-all`" + ` command runs every available check against all of the
+environments in a ksonnet application and writes a single report, which makes it
+suitable for use in CI. The following checks are run for each environment:
+
+1. **spec** — The environment has a valid server and a namespace.
+2. **lib** — ksonnet-lib has been generated for the environment's Kubernetes version.
+3. **jsonnet** — The environment's components evaluate without errors.
+4. **reachability** — The environment's server responds (only with ` + "`--check-reachability`" + `).
+
+The report is written as JSON or JUnit XML. The command exits with a non-zero
+status if any check fails for any environment.
+
+### Related Commands
+
+* ` + "`ks env list` " + `— ` + envShortDesc["list"] + `
+* ` + "`ks validate` " + `— ` + valShortDesc + `
+
+### Syntax
+`
+	envValidateAllExample = `
+# Validate all environments and write a JSON report
+ks env validate-all
+
+# Validate all environments, including server reachability, and write a JUnit
+# report for the CI system
+ks env validate-all --check-reachability -o junit > report.xml`
+)
+
+func newEnvValidateAllCmd() *cobra.Command {
+	envClientConfig := client.NewDefaultClientConfig()
+
+	envValidateAllCmd := &cobra.Command{
+		Use:     "validate-all",
+		Short:   envShortDesc["validate-all"],
+		Long:    envValidateAllLong,
+		Example: envValidateAllExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				return fmt.Errorf("'env validate-all' takes no arguments")
+			}
+
+			m := map[string]interface{}{
+				actions.OptionClientConfig:      envClientConfig,
+				actions.OptionFormat:            viper.GetString(vEnvValidateAllOutput),
+				actions.OptionCheckReachability: viper.GetBool(vEnvValidateAllCheckReachability),
+			}
+			addGlobalOptions(m)
+
+			return runAction(actionEnvValidateAll, m)
+		},
+	}
+
+	envClientConfig.BindClientGoFlags(envValidateAllCmd)
+
+	envValidateAllCmd.Flags().StringP(flagOutput, shortOutput, actions.ValidateAllFormatJSON, "Report format. Valid options: json|junit")
+	viper.BindPFlag(vEnvValidateAllOutput, envValidateAllCmd.Flags().Lookup(flagOutput))
+
+	envValidateAllCmd.Flags().Bool(flagCheckReachability, false, "Check that the server of each environment is reachable")
+	viper.BindPFlag(vEnvValidateAllCheckReachability, envValidateAllCmd.Flags().Lookup(flagCheckReachability))
+
+	return envValidateAllCmd
+}
@@ -0,0 +1,71 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package clicmd
+
+import (
+	"fmt"
+
+	"github.com/ksonnet/ksonnet/pkg/actions"
+	"github.com/spf13/cobra"
+)
+
+var (
+	envVerifyLibLong = `
+The ` + "`verify-lib`" + ` command checks that the ksonnet-lib generated for an
+environment's Kubernetes version (in ` + "`lib/`" + `) hasn't been modified since it was
+generated. When ksonnet generates a ksonnet-lib, it records a checksum of each
+generated file in the ` + "`.metadata`" + ` directory next to it. This command
+recomputes the checksums and lists every file that was modified or is missing.
+
+The command only reads files. It exits with a non-zero status if any file changed,
+or if the ksonnet-lib was generated by a version of ksonnet that did not record
+checksums. In both cases, run ` + "`ks env set <env-name> --reset-metadata`" + ` to
+regenerate it.
+
+### Related Commands
+
+* ` + "`ks env set` " + `— ` + envShortDesc["set"] + `
+* ` + "`ks env update` " + `— ` + envShortDesc["update"] + `
+
+### Syntax
+`
+	envVerifyLibExample = `
+# Check the generated ksonnet-lib of the 'us-west/staging' environment
+ks env verify-lib us-west/staging`
+)
+
+func newEnvVerifyLibCmd() *cobra.Command {
+	envVerifyLibCmd := &cobra.Command{
+		Use:     "verify-lib <env-name>",
+		Short:   envShortDesc["verify-lib"],
+		Long:    envVerifyLibLong,
+		Example: envVerifyLibExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("'env verify-lib' takes a single argument, that is the name of the environment")
+			}
+
+			m := map[string]interface{}{
+				actions.OptionEnvName: args[0],
+			}
+			addGlobalOptions(m)
+
+			return runAction(actionEnvVerifyLib, m)
+		},
+	}
+
+	return envVerifyLibCmd
+}
@@ -23,38 +23,106 @@ import (
 const (
 	// For use in the commands (e.g., diff, apply, delete) that require either an
 	// environment or the -f flag.
+	flagAcrossApps            = "across-apps"
+	flagAll                   = "all"
+	flagAllowUnresolved       = "allow-unresolved"
 	flagAPISpec               = "api-spec"
+	flagAppName               = "app-name"
+	flagApply                 = "apply"
 	flagAsString              = "as-string"
+	flagAsUser                = "as-user"
+	flagAuditLog              = "audit-log"
+	flagCheckReachability     = "check-reachability"
 	flagComponent             = "component"
 	flagCreate                = "create"
+	flagDefaultPDB            = "default-pdb"
+	flagDefaultReplicas       = "default-replicas"
 	flagDir                   = "dir"
 	flagDryRun                = "dry-run"
 	flagEnv                   = "env"
+	flagEnvColumn             = "env-column"
+	flagExcludeComponent      = "exclude-component"
 	flagExtVar                = "ext-str"
 	flagExtVarFile            = "ext-str-file"
+	flagFailFast              = "fail-fast"
+	flagFailOn                = "fail-on"
+	flagFailOnUnmanaged       = "fail-on-unmanaged"
+	flagFilter                = "filter"
 	flagFilename              = "filename"
+	flagFilenameTemplate      = "filename-template"
+	flagFix                   = "fix"
 	flagForce                 = "force"
 	flagFormat                = "format"
+	flagFromCSV               = "from-csv"
+	flagFromFile              = "from-file"
+	flagFromHelmValues        = "from-helm-values"
+	flagFromPatch             = "from-patch"
+	flagFullRegen             = "full-regen"
 	flagGcTag                 = "gc-tag"
+	flagGenerateGitignore     = "generate-gitignore"
 	flagGracePeriod           = "grace-period"
+	flagHPARange              = "hpa-range"
+	flagIgnoreAnnotation      = "ignore-annotation"
+	flagIgnoreObject          = "ignore-object"
+	flagIgnoreSelector        = "ignore-selector"
+	flagInCluster             = "in-cluster"
+	flagIncludeComponent      = "include-component"
+	flagInherit               = "inherit"
 	flagInstalled             = "installed"
 	flagJpath                 = "jpath"
+	flagKeepURI               = "keep-uri"
+	flagMergeKubeconfigs      = "merge-kubeconfigs"
 	flagModule                = "module"
+	flagNoCache               = "no-cache"
+	flagNamePrefix            = "name-prefix"
 	flagNamespace             = "namespace"
+	flagParam                 = "param"
+	flagParamDelete           = "param-delete"
+	flagPostApplyComponent    = "post-apply-component"
+	flagPreferContextNs       = "prefer-context-namespace"
+	flagProxy                 = "proxy"
+	flagPurge                 = "purge"
+	flagReadOnly              = "read-only"
+	flagRecord                = "record"
+	flagReportBreakage        = "report-breakage"
+	flagResetMetadata         = "reset-metadata"
 	flagResolveImage          = "resolve-image"
+	flagResolveImages         = "resolve-images"
+	flagSchema                = "schema"
+	flagSelector              = "selector"
 	flagServer                = "server"
+	flagServerCert            = "server-cert"
+	flagServiceAccount        = "service-account"
 	flagSet                   = "set"
+	flagShowSame              = "show-same"
 	flagSkipDefaultRegistries = "skip-default-registries"
 	flagSkipGc                = "skip-gc"
+	flagSkipLib               = "skip-lib"
+	flagSkipNsCheck           = "skip-ns-check"
+	flagStaleContexts         = "stale-contexts"
+	flagStrict                = "strict"
+	flagSummaryOnly           = "summary-only"
+	flagTag                   = "tag"
+	flagThreeWay              = "three-way"
+	flagTimeout               = "timeout"
 	flagTlaVar                = "tla-str"
 	flagTlaVarFile            = "tla-str-file"
 	flagTLSSkipVerify         = "tls-skip-verify"
+	flagTree                  = "tree"
+	flagUnreachable           = "unreachable"
+	flagObject                = "object"
+	flagObjectParallelism     = "object-parallelism"
 	flagOutput                = "output"
+	flagOutputDir             = "output-dir"
 	flagOverride              = "override"
 	flagUnset                 = "unset"
 	flagVerbose               = "verbose"
 	flagVersion               = "version"
+	flagWait                  = "wait"
+	flagWaitReachable         = "wait-reachable"
+	flagWithClusterVersion    = "with-cluster-version"
 	flagWithoutModules        = "without-modules"
+	flagYes                   = "yes"
 
 	shortComponent = "c"
 	shortFilename  = "f"
@@ -66,6 +134,6 @@ const (
 // addCmdOutput adds an output flag to a command. `name` is the name
 // of the viper assignment.
 func addCmdOutput(cmd *cobra.Command, name string) {
-	cmd.Flags().StringP(flagOutput, shortOutput, "", "Output format. Valid options: table|json")
+	cmd.Flags().StringP(flagOutput, shortOutput, "", "Output format. Valid options: table|json|yaml")
 	viper.BindPFlag(name, cmd.Flags().Lookup(flagOutput))
 }
@@ -47,6 +47,9 @@ type cmdTestCase struct {
 	action   initName
 	isErr    bool
 	expected map[string]interface{}
+	// appFile is the file in testdata staged as the app.yaml of the app.
+	// It defaults to app.yaml.
+	appFile string
 }
 
 type stubCmdOverride struct {
@@ -69,7 +72,11 @@ func runTestCmd(t *testing.T, cases []cmdTestCase) {
 				wd := "/"
 				if tc.action != actionInit {
 					wd = "/app"
-					test.StageFile(t, fs, "app.yaml", "/app/app.yaml")
+					appFile := "app.yaml"
+					if tc.appFile != "" {
+						appFile = tc.appFile
+					}
+					test.StageFile(t, fs, appFile, "/app/app.yaml")
 				}
 
 				root, err := NewRoot(fs, wd, tc.args)
@@ -94,7 +101,7 @@ func runTestCmd(t *testing.T, cases []cmdTestCase) {
 					case actions.OptionFs:
 						var expected *afero.MemMapFs
 						assert.IsType(t, expected, v)
-					case actions.OptionAppRoot, actions.OptionTLSSkipVerify:
+					case actions.OptionAppRoot, actions.OptionAppName, actions.OptionTLSSkipVerify, actions.OptionReadOnly, actions.OptionNoCache, actions.OptionProxy:
 						if tc.expected[k] != nil {
 							assert.Equal(t, tc.expected[k], v, "unexpected value for %q", k)
 						}
@@ -130,7 +130,7 @@ func newInitCmd(fs afero.Fs, wd string) *cobra.Command {
 
 			clientConfig := client.NewDefaultClientConfig()
 
-			server, namespace, err := resolveEnvFlags(flags, clientConfig)
+			server, namespace, _, err := resolveEnvFlags(flags, clientConfig, false, false)
 			if err != nil {
 				return err
 			}
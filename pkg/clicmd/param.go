@@ -22,6 +22,7 @@ import (
 var (
 	paramShortDesc = map[string]string{
 		"delete": "Delete component or environment parameters",
+		"import": "Set environment parameters in bulk from a CSV file",
 		"set":    "Change component or environment parameters (e.g. replica count, name)",
 		"list":   "List known component parameters",
 		"diff":   "Display differences between the component parameters of two environments",
@@ -66,6 +67,7 @@ func newParamCmd() *cobra.Command {
 
 	paramCmd.AddCommand(newParamDeleteCmd())
 	paramCmd.AddCommand(newParamDiffCmd())
+	paramCmd.AddCommand(newParamImportCmd())
 	paramCmd.AddCommand(newParamListCmd())
 	paramCmd.AddCommand(newParamSetCmd())
 
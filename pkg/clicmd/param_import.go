@@ -0,0 +1,102 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package clicmd
+
+import (
+	"github.com/ksonnet/ksonnet/pkg/actions"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	vParamImportFromCSV   = "param-import-from-csv"
+	vParamImportEnvColumn = "param-import-env-column"
+	vParamImportDryRun    = "param-import-dry-run"
+)
+
+var (
+	paramImportLong = `
+The ` + "`import`" + ` command sets environment parameters in bulk from a CSV file, such
+as a spreadsheet exported by the people who maintain per-environment values.
+
+The first row of the file is a header. Its first two columns are ` + "`component`" + ` and
+` + "`param`" + `, and each remaining column is named after an environment. Every other row
+sets a parameter of a component in each environment whose cell is not empty:
+
+` + "```" + `
+component,param,dev,prod
+guestbook,replicas,1,3
+guestbook,image,,"gcr.io/heptio-images/ks-guestbook-demo:0.2"
+` + "```" + `
+
+The whole file is validated before anything is written. Unknown environments,
+components, and parameters are reported, and no parameters are changed if any
+are found.
+
+### Related Commands
+
+* ` + "`ks param set` " + `— ` + paramShortDesc["set"] + `
+* ` + "`ks param diff` " + `— ` + paramShortDesc["diff"] + `
+
+### Syntax
+`
+	paramImportExample = `
+# Set the environment parameters listed in values.csv
+ks param import --from-csv=values.csv
+
+# Preview the parameters that would be set for the 'prod' environment only
+ks param import --from-csv=values.csv --env-column=prod --dry-run`
+)
+
+func newParamImportCmd() *cobra.Command {
+	paramImportCmd := &cobra.Command{
+		Use:     "import --from-csv=<file>",
+		Short:   paramShortDesc["import"],
+		Long:    paramImportLong,
+		Example: paramImportExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				return errors.New("'param import' takes no arguments")
+			}
+
+			filename := viper.GetString(vParamImportFromCSV)
+			if filename == "" {
+				return errors.Errorf("--%s is required", flagFromCSV)
+			}
+
+			m := map[string]interface{}{
+				actions.OptionFilename: filename,
+				actions.OptionEnvNames: viper.GetStringSlice(vParamImportEnvColumn),
+				actions.OptionDryRun:   viper.GetBool(vParamImportDryRun),
+			}
+			addGlobalOptions(m)
+
+			return runAction(actionParamImport, m)
+		},
+	}
+
+	paramImportCmd.Flags().String(flagFromCSV, "", "CSV file with a row per parameter and a column per environment")
+	viper.BindPFlag(vParamImportFromCSV, paramImportCmd.Flags().Lookup(flagFromCSV))
+
+	paramImportCmd.Flags().StringSlice(flagEnvColumn, nil, "Only import the column of this environment (can be repeated)")
+	viper.BindPFlag(vParamImportEnvColumn, paramImportCmd.Flags().Lookup(flagEnvColumn))
+
+	paramImportCmd.Flags().Bool(flagDryRun, false, "Show the parameters that would be set without changing them")
+	viper.BindPFlag(vParamImportDryRun, paramImportCmd.Flags().Lookup(flagDryRun))
+
+	return paramImportCmd
+}
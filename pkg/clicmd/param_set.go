@@ -39,6 +39,15 @@ your ksonnet application's ` + " `components/params.libsonnet` " + `file directl
 for greater customization of environment parameters, we suggest modifying the
 ` + " `environments/:name/params.libsonnet` " + `file.)*
 
+Nested parameters are addressed with a dotted key. A dot that is part of a key
+name is escaped with a backslash, e.g. ` + "`data.nginx\\.conf`" + `.
+
+Values of ConfigMap ` + "`data`" + ` and ` + "`binaryData`" + ` fields, and Secret ` + "`stringData`" + ` and ` + "`data`" + `
+fields, that start with ` + "`@./`" + ` or ` + "`@../`" + ` are replaced with the contents of the
+referenced file when the environment is rendered. Paths are relative to the
+environment's directory (` + "`environments/:name/`" + `), and contents are base64 encoded
+for ` + "`binaryData`" + ` and Secret ` + "`data`" + ` fields.
+
 ### Related Commands
 
 * ` + "`ks param diff` " + `— ` + paramShortDesc["diff"] + `
@@ -52,7 +61,11 @@ ks param set guestbook replicas 4
 
 # Update the replica count of the 'guestbook' component to 2, but only for the
 # 'dev' environment
-ks param set guestbook replicas 2 --env=dev`
+ks param set guestbook replicas 2 --env=dev
+
+# Set the 'nginx.conf' key of the 'nginx-config' ConfigMap to the contents of
+# 'environments/prod/nginx.conf' when the 'prod' environment is rendered.
+ks param set nginx-config 'data.nginx\.conf' @./nginx.conf`
 )
 
 func newParamSetCmd() *cobra.Command {
@@ -31,10 +31,40 @@ import (
 )
 
 const (
+	// envReadOnly is the environment variable that enables read-only mode.
+	envReadOnly = "KS_READ_ONLY"
+	// envAuditLog is the environment variable that sets the audit log sink.
+	envAuditLog = "KS_AUDIT_LOG"
+
 	rootLong = `
 You can use the ` + "`ks`" + ` commands to write, share, and deploy your Kubernetes
 application configuration to remote clusters.
 
+In repositories that contain several ksonnet apps, ` + "`--app-name`" + ` selects the app
+with that name in ` + "`app.yaml`" + `, instead of the nearest app containing the current
+directory. The app is searched for in and below ` + "`--dir`" + `, and the command fails
+if no app, or more than one app, has the name. To select an app by path, use
+` + "`--dir`" + `.
+
+When troubleshooting stale results, ` + "`--no-cache`" + ` makes a command recompute what
+it would otherwise reuse. Commands are slower but their results are unchanged. It
+affects the following:
+
+* **ksonnet-lib reuse** — When ksonnet-lib is generated for a Kubernetes version,
+  it is generated from the Open API spec instead of being copied from the
+  app's cache in ` + "`.ksonnet/cache/ksonnet-lib`" + `, or from a previously generated
+  version with identical type definitions.
+
+The ksonnet-lib already generated for an environment's Kubernetes version is part
+of the app, not a cache, and is still used. Regenerate it with
+` + "`ks env set <env-name> --reset-metadata`" + `.
+
+To keep a record of changes, ` + "`--audit-log`" + ` (or ` + "`$KS_AUDIT_LOG`" + `) writes one JSON
+event for every command that modifies the app or a cluster. Each event holds the
+time, user, command, environment, options, affected Kubernetes objects, and error.
+Use ` + "`stdout`" + ` to write events to standard output, or ` + "`file:<path>`" + ` to append
+them to a file. Dry runs and read-only commands are not recorded.
+
 ----
 	`
 )
@@ -126,6 +156,7 @@ func NewRoot(appFs afero.Fs, wd string, args []string) (*cobra.Command, error) {
 	}
 
 	rootCmd.SetArgs(args)
+	rootCmd.BashCompletionFunction = bashCompletionFunc
 
 	rootCmd.PersistentFlags().CountP(flagVerbose, "v", "Increase verbosity. May be given multiple times.")
 	rootCmd.PersistentFlags().Set("logtostderr", "true")
@@ -134,11 +165,35 @@ func NewRoot(appFs afero.Fs, wd string, args []string) (*cobra.Command, error) {
 	viper.BindPFlag(flagTLSSkipVerify, rootCmd.PersistentFlags().Lookup(flagTLSSkipVerify))
 	viper.BindPFlag(flagDir, rootCmd.PersistentFlags().Lookup(flagDir))
 
+	rootCmd.PersistentFlags().String(flagProxy, "",
+		"URL of the HTTP proxy used to download API specs and packages; Defaults to $HTTPS_PROXY or $HTTP_PROXY. Hosts in $NO_PROXY are reached directly")
+	viper.BindPFlag(flagProxy, rootCmd.PersistentFlags().Lookup(flagProxy))
+
+	rootCmd.PersistentFlags().String(flagAppName, "",
+		"Name of the ksonnet application to use, for repositories with several; Searched for in and below --dir")
+	viper.BindPFlag(flagAppName, rootCmd.PersistentFlags().Lookup(flagAppName))
+
+	rootCmd.PersistentFlags().Bool(flagReadOnly, false,
+		fmt.Sprintf("Refuse to run commands that can change a cluster, such as apply and delete. Can also be enabled with $%s", envReadOnly))
+	viper.BindPFlag(flagReadOnly, rootCmd.PersistentFlags().Lookup(flagReadOnly))
+	viper.BindEnv(flagReadOnly, envReadOnly)
+
+	rootCmd.PersistentFlags().String(flagAuditLog, "",
+		fmt.Sprintf("Record the changes made by commands such as env add, apply and delete in an audit log: 'stdout' or 'file:<path>'. Can also be set with $%s", envAuditLog))
+	viper.BindPFlag(flagAuditLog, rootCmd.PersistentFlags().Lookup(flagAuditLog))
+	viper.BindEnv(flagAuditLog, envAuditLog)
+
+	rootCmd.PersistentFlags().Bool(flagNoCache, false,
+		"Recompute cached data, such as reused ksonnet-lib, instead of reusing it")
+	viper.BindPFlag(flagNoCache, rootCmd.PersistentFlags().Lookup(flagNoCache))
+
 	rootCmd.AddCommand(newApplyCmd(appFs))
+	rootCmd.AddCommand(newCompleteEnvNamesCmd())
+	rootCmd.AddCommand(newCompletionCmd())
 	rootCmd.AddCommand(newComponentCmd())
 	rootCmd.AddCommand(newDeleteCmd(appFs))
 	rootCmd.AddCommand(newDiffCmd(appFs))
-	rootCmd.AddCommand(newEnvCmd())
+	rootCmd.AddCommand(newEnvCmd(appFs))
 	rootCmd.AddCommand(newGenerateCmd(appFs))
 	rootCmd.AddCommand(newImportCmd())
 	rootCmd.AddCommand(newInitCmd(appFs, wd))
@@ -149,6 +204,7 @@ func NewRoot(appFs afero.Fs, wd string, args []string) (*cobra.Command, error) {
 	rootCmd.AddCommand(newRegistryCmd())
 	rootCmd.AddCommand(newShowCmd(appFs))
 	rootCmd.AddCommand(newValidateCmd(appFs))
+	rootCmd.AddCommand(newVerifyCmd(appFs))
 	rootCmd.AddCommand(newUpgradeCmd())
 	rootCmd.AddCommand(newVersionCmd())
 
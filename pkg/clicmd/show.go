@@ -17,6 +17,7 @@ package clicmd
 
 import (
 	"github.com/ksonnet/ksonnet/pkg/actions"
+	"github.com/ksonnet/ksonnet/pkg/client"
 	"github.com/pkg/errors"
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
@@ -24,9 +25,14 @@ import (
 )
 
 const (
-	showShortDesc  = "Show expanded manifests for a specific environment."
-	vShowComponent = "show-components"
-	vShowFormat    = "show-format"
+	showShortDesc         = "Show expanded manifests for a specific environment."
+	vShowComponent        = "show-components"
+	vShowFormat           = "show-format"
+	vShowObject           = "show-object"
+	vShowOutputDir        = "show-output-dir"
+	vShowFilenameTemplate = "show-filename-template"
+	vShowResolveImages    = "show-resolve-images"
+	vShowAllowUnresolved  = "show-allow-unresolved"
 )
 
 var (
@@ -44,6 +50,25 @@ This is the YAML version of what gets deployed to your cluster with
 When a component IS specified via the ` + "`-c`" + ` flag, this command only expands the
 manifest for that particular component.
 
+To debug a single resource, ` + "`--object=<Kind>/<name>`" + ` prints only the object
+with that exact kind and name. If no object matches, the available objects are
+listed.
+
+With ` + "`--output-dir`" + `, each object is written to its own file in a directory
+instead of standard output, e.g. to commit the manifests to a repository watched
+by a GitOps tool. Files are named ` + "`<kind>-<name>.<format>`" + ` by default.
+` + "`--filename-template`" + ` changes the path of each file, relative to the output
+directory, with a Go template. The fields ` + "`.APIVersion`" + `, ` + "`.Kind`" + `, ` + "`.Name`" + `,
+and ` + "`.Namespace`" + ` are available, and ` + "`lower`" + ` converts a value to lower case.
+Characters of the fields that aren't valid in a path segment are replaced with
+underscores, and empty path segments, like the namespace of cluster scoped
+objects, are dropped. Nothing is written if two objects would be written to the
+same file.
+
+If the environment's api spec was set to ` + "`auto`" + ` with ` + "`ks env set`" + `, the
+Kubernetes version of its cluster is detected first, and ksonnet-lib is
+regenerated if the version changed.
+
 ### Related Commands
 
 * ` + "`ks validate` " + `— ` + valShortDesc + `
@@ -61,10 +86,27 @@ ks show prod -c redis -o json
 
 # Show multiple components from the 'dev' environment, in YAML
 ks show dev -c redis -c nginx-server
+
+# Show only the Deployment named 'web' from the 'dev' environment
+ks show dev --object=Deployment/web
+
+# Write each object of the 'prod' environment to its own file in the 'manifests'
+# directory, grouped by namespace and kind
+ks show prod --output-dir=manifests \
+  --filename-template='{{.Namespace}}/{{.Kind}}/{{.Name}}.yaml'
+
+# Show all of the components for the 'prod' environment, with the image tags of
+# their containers pinned to the digests the tags currently point to, e.g.
+# 'nginx:1.15' becomes 'nginx@sha256:...'. Registries are logged into with the
+# credentials in your docker config file ($DOCKER_CONFIG/config.json or
+# ~/.docker/config.json). Images that can't be resolved keep their tags.
+ks show prod --resolve-images --allow-unresolved
 `
 )
 
 func newShowCmd(fs afero.Fs) *cobra.Command {
+	showClientConfig := client.NewDefaultClientConfig()
+
 	showCmd := &cobra.Command{
 		Use:     "show <env> [-c <component-filename>]",
 		Short:   showShortDesc,
@@ -77,9 +119,15 @@ func newShowCmd(fs afero.Fs) *cobra.Command {
 			}
 
 			m := map[string]interface{}{
-				actions.OptionComponentNames: viper.GetStringSlice(vShowComponent),
-				actions.OptionEnvName:        envName,
-				actions.OptionFormat:         viper.GetString(vShowFormat),
+				actions.OptionClientConfig:     showClientConfig,
+				actions.OptionComponentNames:   viper.GetStringSlice(vShowComponent),
+				actions.OptionEnvName:          envName,
+				actions.OptionFormat:           viper.GetString(vShowFormat),
+				actions.OptionObject:           viper.GetString(vShowObject),
+				actions.OptionOutputDir:        viper.GetString(vShowOutputDir),
+				actions.OptionFilenameTemplate: viper.GetString(vShowFilenameTemplate),
+				actions.OptionResolveImages:    viper.GetBool(vShowResolveImages),
+				actions.OptionAllowUnresolved:  viper.GetBool(vShowAllowUnresolved),
 			}
 
 			if err := extractJsonnetFlags(fs, "show"); err != nil {
@@ -90,6 +138,7 @@ func newShowCmd(fs afero.Fs) *cobra.Command {
 		},
 	}
 	bindJsonnetFlags(showCmd, "show")
+	showClientConfig.BindClientGoFlags(showCmd)
 
 	showCmd.Flags().StringSliceP(flagComponent, shortComponent, nil, "Name of a specific component (multiple -c flags accepted, allows YAML, JSON, and Jsonnet)")
 	viper.BindPFlag(vShowComponent, showCmd.Flags().Lookup(flagComponent))
@@ -97,5 +146,20 @@ func newShowCmd(fs afero.Fs) *cobra.Command {
 	showCmd.Flags().StringP(flagFormat, shortFormat, "yaml", "Output format.  Supported values are: json, yaml")
 	viper.BindPFlag(vShowFormat, showCmd.Flags().Lookup(flagFormat))
 
+	showCmd.Flags().String(flagObject, "", "Show only the object with this kind and name, as <Kind>/<name>")
+	viper.BindPFlag(vShowObject, showCmd.Flags().Lookup(flagObject))
+
+	showCmd.Flags().String(flagOutputDir, "", "Write each object to its own file in this directory")
+	viper.BindPFlag(vShowOutputDir, showCmd.Flags().Lookup(flagOutputDir))
+
+	showCmd.Flags().String(flagFilenameTemplate, "", "Go template of the path of each object's file in --output-dir (default: {{lower .Kind}}-{{.Name}}.<format>)")
+	viper.BindPFlag(vShowFilenameTemplate, showCmd.Flags().Lookup(flagFilenameTemplate))
+
+	showCmd.Flags().Bool(flagResolveImages, false, "Pin the image tags of containers to the digests they currently point to in their registries")
+	viper.BindPFlag(vShowResolveImages, showCmd.Flags().Lookup(flagResolveImages))
+
+	showCmd.Flags().Bool(flagAllowUnresolved, false, "With --resolve-images, keep the tags of images whose digests can't be resolved instead of failing")
+	viper.BindPFlag(vShowAllowUnresolved, showCmd.Flags().Lookup(flagAllowUnresolved))
+
 	return showCmd
 }
@@ -28,10 +28,67 @@ func Test_showCmd(t *testing.T) {
 			args:   []string{"show", "default"},
 			action: actionShow,
 			expected: map[string]interface{}{
-				actions.OptionApp:            nil,
-				actions.OptionEnvName:        "default",
-				actions.OptionComponentNames: make([]string, 0),
-				actions.OptionFormat:         "yaml",
+				actions.OptionApp:              nil,
+				actions.OptionClientConfig:     nil,
+				actions.OptionEnvName:          "default",
+				actions.OptionComponentNames:   make([]string, 0),
+				actions.OptionFormat:           "yaml",
+				actions.OptionObject:           "",
+				actions.OptionOutputDir:        "",
+				actions.OptionFilenameTemplate: "",
+				actions.OptionResolveImages:    false,
+				actions.OptionAllowUnresolved:  false,
+			},
+		},
+		{
+			name:   "with object",
+			args:   []string{"show", "default", "--object", "Deployment/web"},
+			action: actionShow,
+			expected: map[string]interface{}{
+				actions.OptionApp:              nil,
+				actions.OptionClientConfig:     nil,
+				actions.OptionEnvName:          "default",
+				actions.OptionComponentNames:   make([]string, 0),
+				actions.OptionFormat:           "yaml",
+				actions.OptionObject:           "Deployment/web",
+				actions.OptionOutputDir:        "",
+				actions.OptionFilenameTemplate: "",
+				actions.OptionResolveImages:    false,
+				actions.OptionAllowUnresolved:  false,
+			},
+		},
+		{
+			name:   "with output dir",
+			args:   []string{"show", "default", "--output-dir", "manifests", "--filename-template", "{{.Kind}}/{{.Name}}.yaml"},
+			action: actionShow,
+			expected: map[string]interface{}{
+				actions.OptionApp:              nil,
+				actions.OptionClientConfig:     nil,
+				actions.OptionEnvName:          "default",
+				actions.OptionComponentNames:   make([]string, 0),
+				actions.OptionFormat:           "yaml",
+				actions.OptionObject:           "",
+				actions.OptionOutputDir:        "manifests",
+				actions.OptionFilenameTemplate: "{{.Kind}}/{{.Name}}.yaml",
+				actions.OptionResolveImages:    false,
+				actions.OptionAllowUnresolved:  false,
+			},
+		},
+		{
+			name:   "resolve images",
+			args:   []string{"show", "default", "--resolve-images"},
+			action: actionShow,
+			expected: map[string]interface{}{
+				actions.OptionApp:              nil,
+				actions.OptionClientConfig:     nil,
+				actions.OptionEnvName:          "default",
+				actions.OptionComponentNames:   make([]string, 0),
+				actions.OptionFormat:           "yaml",
+				actions.OptionObject:           "",
+				actions.OptionOutputDir:        "",
+				actions.OptionFilenameTemplate: "",
+				actions.OptionResolveImages:    true,
+				actions.OptionAllowUnresolved:  false,
 			},
 		},
 		{
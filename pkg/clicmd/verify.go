@@ -0,0 +1,111 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package clicmd
+
+import (
+	"fmt"
+
+	"github.com/ksonnet/ksonnet/pkg/actions"
+	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	vVerifyComponent = "verify-component"
+	vVerifyOutput    = "verify-output"
+	verifyShortDesc  = "Report objects of an environment that drifted from its cluster"
+)
+
+var (
+	verifyLong = `
+The ` + "`verify`" + ` command checks that the cluster of an environment matches the app.
+It renders the environment's components, like ` + "`ks show`" + `, and compares the objects
+with the objects managed by ksonnet that are live in the cluster. Each object that
+drifted is listed with its state:
+
+* **missing** — The object is generated by a component, but doesn't exist in the
+  cluster.
+* **out of sync** — Applying the object would change its counterpart in the
+  cluster.
+* **unexpected** — The object is in the cluster and labeled as belonging to the
+  app, but no component generates it anymore.
+
+The cluster is reached with the server, namespace and certificate authority
+saved with the environment, and the client flags, such as ` + "`--token`" + `.
+
+The command exits with status 10 if any object drifted, and 0 if the cluster is in
+sync, so it can gate CI pipelines. Other errors exit with status 1.
+
+### Related Commands
+
+* ` + "`ks diff` " + `— ` + diffShortDesc + `
+* ` + "`ks apply` " + `— ` + applyShortDesc + `
+
+### Syntax
+`
+	verifyExample = `
+# List the objects of the 'prod' environment that drifted from its cluster
+ks verify prod
+
+# Only verify the objects of the 'redis' component
+ks verify prod -c redis
+
+# Report the drift as JSON
+ks verify prod -o json`
+)
+
+func newVerifyCmd(fs afero.Fs) *cobra.Command {
+	verifyClientConfig := client.NewDefaultClientConfig()
+
+	verifyCmd := &cobra.Command{
+		Use:     "verify <env-name> [-c <component-name>]",
+		Short:   verifyShortDesc,
+		Long:    verifyLong,
+		Example: verifyExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("'verify' takes exactly one argument, which is the name of the environment")
+			}
+
+			m := map[string]interface{}{
+				actions.OptionEnvName:        args[0],
+				actions.OptionComponentNames: viper.GetStringSlice(vVerifyComponent),
+				actions.OptionOutput:         viper.GetString(vVerifyOutput),
+				actions.OptionClientConfig:   verifyClientConfig,
+			}
+			addGlobalOptions(m)
+
+			if err := extractJsonnetFlags(fs, "verify"); err != nil {
+				return errors.Wrap(err, "handle jsonnet flags")
+			}
+
+			return runAction(actionVerify, m)
+		},
+	}
+
+	verifyClientConfig.BindClientGoFlags(verifyCmd)
+	bindJsonnetFlags(verifyCmd, "verify")
+
+	verifyCmd.Flags().StringSliceP(flagComponent, shortComponent, nil, "Name of a specific component (can be repeated)")
+	viper.BindPFlag(vVerifyComponent, verifyCmd.Flags().Lookup(flagComponent))
+
+	addCmdOutput(verifyCmd, vVerifyOutput)
+
+	return verifyCmd
+}
@@ -93,21 +93,34 @@ func InitClient(a app.App, env string) (dynamic.ClientPool, discovery.DiscoveryI
 // GetAPISpec reads the kubernetes API version from this client's Open API schema.
 // If there is an error retrieving the schema, return the default version.
 func (c *Config) GetAPISpec() string {
-	dc, err := c.discoveryClient()
+	spec, err := c.APISpec()
 	if err != nil {
-		log.WithError(err).Debug("Failed to create discovery client")
+		log.WithError(err).Debug("Failed to determine kubernetes API version")
 		return defaultSpec
 	}
 
+	return spec
+}
+
+// APISpec reads the kubernetes API version from this client's Open API schema.
+// It returns an error if the version can't be determined.
+func (c *Config) APISpec() (string, error) {
+	dc, err := c.discoveryClient()
+	if err != nil {
+		return "", errors.Wrap(err, "create discovery client")
+	}
+
 	serverVersion, err := dc.ServerVersion()
 	if err != nil {
-		log.WithError(err).Debug("Failed to retrieve kubernetes server version")
-		return defaultSpec
+		return "", errors.Wrap(err, "retrieve kubernetes server version")
 	}
 
 	k8sVersion := versionPattern.FindString(fmt.Sprint(serverVersion))
-	k8sAPISpec := fmt.Sprintf("version:%s", k8sVersion)
-	return k8sAPISpec
+	if k8sVersion == "" {
+		return "", errors.Errorf("unrecognized kubernetes server version %q", serverVersion)
+	}
+
+	return fmt.Sprintf("version:%s", k8sVersion), nil
 }
 
 // Namespace returns the namespace for the provided ClientConfig.
@@ -177,7 +190,7 @@ func (c *Config) ResolveContext(context string) (server, namespace string, err e
 
 	ctx := rawConfig.Contexts[context]
 	if ctx == nil {
-		return "", "", errors.Errorf("context '%s' does not exist in the kubeconfig file", context)
+		return "", "", c.missingContextError(rawConfig, context)
 	}
 
 	log.Infof("Using context %q from kubeconfig file %q", context, ctx.LocationOfOrigin)
@@ -250,6 +263,10 @@ func (c *Config) overrideCluster(a app.App, envName string) error {
 
 	c.Overrides.Context.Namespace = destination.Namespace
 	c.Overrides.ClusterInfo.Server = server
+	if destination.ServerCert != "" {
+		c.Overrides.ClusterInfo.CertificateAuthorityData = []byte(destination.ServerCert)
+		return nil
+	}
 	// NOTE: ignore TLS verify since we don't have a CA cert to verify with.
 	c.Overrides.ClusterInfo.InsecureSkipTLSVerify = true
 	return nil
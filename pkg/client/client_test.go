@@ -80,6 +80,55 @@ func TestConfig_GetAPISpec(t *testing.T) {
 
 }
 
+func TestConfig_APISpec(t *testing.T) {
+	cases := []struct {
+		name      string
+		version   string
+		disc      discovery.DiscoveryInterface
+		createErr error
+		isErr     bool
+	}{
+		{
+			name:    "in general",
+			version: "version:v1.9.3",
+			disc:    &fakeDiscovery{withVersion: "v1.9.3"},
+		},
+		{
+			name:      "unable to create discovery client",
+			createErr: errors.New("failed"),
+			isErr:     true,
+		},
+		{
+			name:  "retrieve server version error",
+			disc:  &fakeDiscovery{withError: true},
+			isErr: true,
+		},
+		{
+			name:  "unrecognized version",
+			disc:  &fakeDiscovery{withVersion: "latest"},
+			isErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := Config{
+				Config: &clientConfig{},
+				discoveryClient: func() (discovery.DiscoveryInterface, error) {
+					return tc.disc, tc.createErr
+				},
+			}
+			got, err := c.APISpec()
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.version, got)
+		})
+	}
+}
+
 type clientConfig struct {
 }
 
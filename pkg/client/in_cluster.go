@@ -0,0 +1,83 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package client
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/rest"
+)
+
+var (
+	// serviceAccountDir is where Kubernetes mounts the service account of a
+	// pod.
+	serviceAccountDir = "/var/run/secrets/kubernetes.io/serviceaccount"
+
+	// inClusterConfig loads the config of the cluster a pod runs in from its
+	// service account.
+	inClusterConfig = rest.InClusterConfig
+)
+
+// InCluster is the connection info of the cluster ks is running in.
+type InCluster struct {
+	// Server is the address of the cluster's API server.
+	Server string
+	// Namespace is the namespace of the pod's service account. It is empty if
+	// the service account doesn't record one.
+	Namespace string
+	// ServerCert is the PEM-encoded certificate authority of the server.
+	ServerCert []byte
+}
+
+// UseInCluster reads the connection info of the cluster ks is running in from
+// the service account mounted into its pod, with client-go's in-cluster config
+// loader, and points the client config at the cluster. It returns an error if
+// ks isn't running in a pod.
+func (c *Config) UseInCluster() (*InCluster, error) {
+	restConfig, err := inClusterConfig()
+	if err != nil {
+		return nil, errors.Wrap(err, "not running in a Kubernetes pod")
+	}
+
+	if restConfig.TLSClientConfig.CAFile == "" {
+		return nil, errors.Errorf("the service account has no valid certificate authority at %q",
+			filepath.Join(serviceAccountDir, "ca.crt"))
+	}
+
+	serverCert, err := ReadServerCert(restConfig.TLSClientConfig.CAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace, err := ioutil.ReadFile(filepath.Join(serviceAccountDir, "namespace"))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, errors.Wrap(err, "read service account namespace")
+	}
+
+	c.Overrides.ClusterInfo.Server = restConfig.Host
+	c.Overrides.ClusterInfo.CertificateAuthorityData = serverCert
+	c.Overrides.AuthInfo.Token = restConfig.BearerToken
+
+	return &InCluster{
+		Server:     restConfig.Host,
+		Namespace:  strings.TrimSpace(string(namespace)),
+		ServerCert: serverCert,
+	}, nil
+}
@@ -0,0 +1,133 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package client
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+func withServiceAccount(t *testing.T, fn func(dir string)) {
+	dir, err := ioutil.TempDir("", "serviceaccount")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ogDir, ogConfig := serviceAccountDir, inClusterConfig
+	defer func() {
+		serviceAccountDir, inClusterConfig = ogDir, ogConfig
+	}()
+	serviceAccountDir = dir
+
+	fn(dir)
+}
+
+func TestConfig_UseInCluster(t *testing.T) {
+	withServiceAccount(t, func(dir string) {
+		ts, cert := newTLSVersionServer(t)
+		defer ts.Close()
+
+		caFile := filepath.Join(dir, "ca.crt")
+		require.NoError(t, ioutil.WriteFile(caFile, cert, 0644))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "namespace"), []byte("ci\n"), 0644))
+
+		inClusterConfig = func() (*rest.Config, error) {
+			return &rest.Config{
+				Host:            ts.URL,
+				BearerToken:     "token",
+				TLSClientConfig: rest.TLSClientConfig{CAFile: caFile},
+			}, nil
+		}
+
+		c := NewClientConfig(clientcmd.ConfigOverrides{}, clientcmd.ClientConfigLoadingRules{})
+
+		inCluster, err := c.UseInCluster()
+		require.NoError(t, err)
+
+		expected := &InCluster{
+			Server:     ts.URL,
+			Namespace:  "ci",
+			ServerCert: cert,
+		}
+		assert.Equal(t, expected, inCluster)
+
+		// The client config connects to the cluster, trusting its certificate
+		// authority.
+		spec, err := c.APISpec()
+		require.NoError(t, err)
+		assert.Equal(t, "version:v1.10.3", spec)
+	})
+}
+
+func TestConfig_UseInCluster_no_namespace(t *testing.T) {
+	withServiceAccount(t, func(dir string) {
+		caFile := filepath.Join(dir, "ca.crt")
+		require.NoError(t, ioutil.WriteFile(caFile, newSelfSignedCert(t), 0644))
+
+		inClusterConfig = func() (*rest.Config, error) {
+			return &rest.Config{
+				Host:            "https://10.0.0.1:443",
+				TLSClientConfig: rest.TLSClientConfig{CAFile: caFile},
+			}, nil
+		}
+
+		c := NewClientConfig(clientcmd.ConfigOverrides{}, clientcmd.ClientConfigLoadingRules{})
+
+		inCluster, err := c.UseInCluster()
+		require.NoError(t, err)
+		assert.Empty(t, inCluster.Namespace)
+	})
+}
+
+func TestConfig_UseInCluster_errors(t *testing.T) {
+	cases := []struct {
+		name            string
+		inClusterConfig func() (*rest.Config, error)
+	}{
+		{
+			name: "not in a cluster",
+			inClusterConfig: func() (*rest.Config, error) {
+				return nil, errors.New("unable to load in-cluster configuration, KUBERNETES_SERVICE_HOST and KUBERNETES_SERVICE_PORT must be defined")
+			},
+		},
+		{
+			name: "no certificate authority",
+			inClusterConfig: func() (*rest.Config, error) {
+				return &rest.Config{Host: "https://10.0.0.1:443"}, nil
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withServiceAccount(t, func(dir string) {
+				inClusterConfig = tc.inClusterConfig
+
+				c := NewClientConfig(clientcmd.ConfigOverrides{}, clientcmd.ClientConfigLoadingRules{})
+
+				_, err := c.UseInCluster()
+				require.Error(t, err)
+			})
+		})
+	}
+}
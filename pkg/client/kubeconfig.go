@@ -0,0 +1,317 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package client
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	str "github.com/ksonnet/ksonnet/pkg/util/strings"
+	"github.com/pkg/errors"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// EnvironmentContextName returns the name of the kubeconfig context generated
+// for an environment.
+func EnvironmentContextName(envName string) string {
+	return "ksonnet-" + strings.Replace(envName, "/", "-", -1)
+}
+
+// MergeKubeconfigs loads the user's kubeconfig by merging the provided
+// kubeconfig files instead of reading $KUBECONFIG or --kubeconfig. When files
+// define the same cluster, context or user, or a current context, the file
+// listed last wins. All files must exist.
+func (c *Config) MergeKubeconfigs(paths []string) error {
+	if len(paths) == 0 {
+		return nil
+	}
+
+	if c.LoadingRules.ExplicitPath != "" {
+		return errors.New("kubeconfig files to merge can't be combined with --kubeconfig")
+	}
+
+	// client-go merges files so the first file to set a value wins, so
+	// reverse the list to let later files win.
+	precedence := make([]string, 0, len(paths))
+	for i := len(paths) - 1; i >= 0; i-- {
+		if _, err := os.Stat(paths[i]); err != nil {
+			return errors.Wrapf(err, "kubeconfig file %q", paths[i])
+		}
+
+		precedence = append(precedence, paths[i])
+	}
+
+	c.LoadingRules.Precedence = precedence
+
+	return nil
+}
+
+// Contexts returns the sorted names of the contexts in the user's kubeconfig
+// and the name of its current context.
+func (c *Config) Contexts() (names []string, current string, err error) {
+	rawConfig, err := c.Config.RawConfig()
+	if err != nil {
+		return nil, "", errors.Wrap(err, "load kubeconfig")
+	}
+
+	for name := range rawConfig.Contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names, rawConfig.CurrentContext, nil
+}
+
+// ContextServers returns the servers of the clusters of the contexts in the
+// user's kubeconfig, keyed by context name. The server of a context whose
+// cluster doesn't exist is empty.
+func (c *Config) ContextServers() (map[string]string, error) {
+	rawConfig, err := c.Config.RawConfig()
+	if err != nil {
+		return nil, errors.Wrap(err, "load kubeconfig")
+	}
+
+	servers := make(map[string]string)
+	for name, ctx := range rawConfig.Contexts {
+		var server string
+		if cluster, ok := rawConfig.Clusters[ctx.Cluster]; ok {
+			server = cluster.Server
+		}
+
+		servers[name] = server
+	}
+
+	return servers, nil
+}
+
+// EnvironmentKubeConfig returns a copy of the user's kubeconfig with an
+// additional context that targets the server and namespace of an environment.
+// The generated context is set as the current context. If a kubeconfig cluster
+// has the environment's server, credentials are taken from the user selected
+// with --user, or the user of the current context. Other servers get no
+// credentials.
+func (c *Config) EnvironmentKubeConfig(a app.App, envName string) (*clientcmdapi.Config, error) {
+	rawConfig, err := c.Config.RawConfig()
+	if err != nil {
+		return nil, errors.Wrap(err, "load kubeconfig")
+	}
+
+	env, err := a.Environment(envName)
+	if err != nil {
+		return nil, err
+	}
+
+	if env.Destination == nil || env.Destination.Server == "" {
+		return nil, errors.Errorf("environment %q does not have a server", envName)
+	}
+
+	server, err := str.NormalizeURL(env.Destination.Server)
+	if err != nil {
+		return nil, err
+	}
+
+	if rawConfig.Clusters == nil {
+		rawConfig.Clusters = make(map[string]*clientcmdapi.Cluster)
+	}
+	if rawConfig.Contexts == nil {
+		rawConfig.Contexts = make(map[string]*clientcmdapi.Context)
+	}
+
+	contextName := EnvironmentContextName(envName)
+
+	clusterName, err := c.clusterAt(server)
+	if err != nil {
+		return nil, err
+	}
+
+	var authInfo string
+	if clusterName == "" {
+		// The environment's server isn't a kubeconfig cluster, so it isn't
+		// trusted with the user's credentials. Its certificate is verified
+		// with the environment's server certificate, or the system's
+		// certificate authorities without one.
+		clusterName = contextName
+		rawConfig.Clusters[clusterName] = &clientcmdapi.Cluster{
+			Server:                   env.Destination.Server,
+			CertificateAuthorityData: []byte(env.Destination.ServerCert),
+		}
+	} else {
+		authInfo = c.Overrides.Context.AuthInfo
+		if authInfo == "" {
+			currentContext := rawConfig.CurrentContext
+			if c.Overrides.CurrentContext != "" {
+				currentContext = c.Overrides.CurrentContext
+			}
+
+			if ctx, ok := rawConfig.Contexts[currentContext]; ok {
+				authInfo = ctx.AuthInfo
+			}
+		}
+	}
+
+	rawConfig.Contexts[contextName] = &clientcmdapi.Context{
+		Cluster:   clusterName,
+		AuthInfo:  authInfo,
+		Namespace: env.Destination.Namespace,
+	}
+	rawConfig.CurrentContext = contextName
+
+	return &rawConfig, nil
+}
+
+// ContextServerCert returns the PEM-encoded certificate authority of the
+// cluster of a kubeconfig context, or nil if the cluster doesn't have one. If
+// the context is empty, the current context is used.
+func (c *Config) ContextServerCert(context string) ([]byte, error) {
+	rawConfig, err := c.Config.RawConfig()
+	if err != nil {
+		return nil, errors.Wrap(err, "load kubeconfig")
+	}
+
+	if context == "" {
+		context = rawConfig.CurrentContext
+	}
+
+	ctx, ok := rawConfig.Contexts[context]
+	if !ok {
+		return nil, c.missingContextError(rawConfig, context)
+	}
+
+	cluster, ok := rawConfig.Clusters[ctx.Cluster]
+	if !ok {
+		return nil, errors.Errorf("No cluster with name '%s' exists", ctx.Cluster)
+	}
+
+	data := cluster.CertificateAuthorityData
+	if len(data) == 0 && cluster.CertificateAuthority != "" {
+		if data, err = ioutil.ReadFile(cluster.CertificateAuthority); err != nil {
+			return nil, errors.Wrapf(err, "read certificate authority of cluster %q", ctx.Cluster)
+		}
+	}
+
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	if err := ValidateServerCert(data); err != nil {
+		return nil, errors.Wrapf(err, "certificate authority of cluster %q is invalid", ctx.Cluster)
+	}
+
+	return data, nil
+}
+
+// CheckUser returns an error if the user selected with --user doesn't exist
+// in the user's kubeconfig.
+func (c *Config) CheckUser() error {
+	user := c.Overrides.Context.AuthInfo
+	if user == "" {
+		return nil
+	}
+
+	rawConfig, err := c.Config.RawConfig()
+	if err != nil {
+		return errors.Wrap(err, "load kubeconfig")
+	}
+
+	if _, ok := rawConfig.AuthInfos[user]; !ok {
+		return errors.Errorf("user %q does not exist in the kubeconfig file", user)
+	}
+
+	return nil
+}
+
+// CheckContext returns an error if the context, or the current context if
+// context is empty, can't be resolved unambiguously to a server. Unlike
+// ResolveContext, it doesn't accept a kubeconfig without a current context
+// or a cluster without a server.
+func (c *Config) CheckContext(context string) error {
+	rawConfig, err := c.Config.RawConfig()
+	if err != nil {
+		return errors.Wrap(err, "load kubeconfig")
+	}
+
+	if context == "" {
+		if rawConfig.CurrentContext == "" {
+			return errors.New("no context was given with --context and the kubeconfig file has no current context")
+		}
+		context = rawConfig.CurrentContext
+	}
+
+	ctx, ok := rawConfig.Contexts[context]
+	if !ok {
+		return c.missingContextError(rawConfig, context)
+	}
+
+	cluster, ok := rawConfig.Clusters[ctx.Cluster]
+	if !ok {
+		return errors.Errorf("cluster %q of context %q does not exist in the kubeconfig file", ctx.Cluster, context)
+	}
+
+	if cluster.Server == "" {
+		return errors.Errorf("cluster %q of context %q has no server", ctx.Cluster, context)
+	}
+
+	return nil
+}
+
+// missingContextError returns the error for a context that doesn't exist in
+// the loaded kubeconfig, naming the files it was loaded from, the contexts
+// they define and the current context, so a renamed context is easy to spot.
+func (c *Config) missingContextError(rawConfig clientcmdapi.Config, context string) error {
+	var names []string
+	for name := range rawConfig.Contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	available := "it has no contexts"
+	if len(names) > 0 {
+		available = "available contexts: " + strings.Join(names, ", ")
+	}
+
+	if rawConfig.CurrentContext != "" {
+		available += fmt.Sprintf("; current context: %s", rawConfig.CurrentContext)
+	}
+
+	return errors.Errorf("context %q does not exist in %s; %s", context, c.kubeconfigFiles(), available)
+}
+
+// kubeconfigFiles describes the kubeconfig files that are loaded: the file
+// given with --kubeconfig, which takes precedence over $KUBECONFIG, or else
+// the files of $KUBECONFIG, --merge-kubeconfigs or the default location.
+func (c *Config) kubeconfigFiles() string {
+	if c.LoadingRules == nil {
+		return "the kubeconfig file"
+	}
+
+	if path := c.LoadingRules.ExplicitPath; path != "" {
+		return fmt.Sprintf("kubeconfig file %q", path)
+	}
+
+	files := c.LoadingRules.GetLoadingPrecedence()
+	switch len(files) {
+	case 0:
+		return "the kubeconfig file"
+	case 1:
+		return fmt.Sprintf("kubeconfig file %q", files[0])
+	default:
+		return fmt.Sprintf("kubeconfig files %s", strings.Join(files, ", "))
+	}
+}
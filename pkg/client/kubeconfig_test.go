@@ -0,0 +1,468 @@
+// Copyright 2017 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package client
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	amocks "github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestConfig_CheckUser(t *testing.T) {
+	kubeConfig := clientcmdapi.Config{
+		AuthInfos: map[string]*clientcmdapi.AuthInfo{
+			"admin": {},
+		},
+	}
+
+	cases := []struct {
+		name  string
+		user  string
+		isErr bool
+	}{
+		{
+			name: "no user selected",
+		},
+		{
+			name: "user exists",
+			user: "admin",
+		},
+		{
+			name:  "user does not exist",
+			user:  "missing",
+			isErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			overrides := &clientcmd.ConfigOverrides{}
+			overrides.Context.AuthInfo = tc.user
+
+			c := Config{
+				Overrides: overrides,
+				Config:    clientcmd.NewDefaultClientConfig(kubeConfig, overrides),
+			}
+
+			err := c.CheckUser()
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestConfig_EnvironmentKubeConfig(t *testing.T) {
+	// The returned kubeconfig shares its maps with the loaded one, so each
+	// case loads its own.
+	newKubeConfig := func() clientcmdapi.Config {
+		return clientcmdapi.Config{
+			Clusters: map[string]*clientcmdapi.Cluster{
+				"prod": {Server: "https://prod.example.com"},
+			},
+			AuthInfos: map[string]*clientcmdapi.AuthInfo{
+				"admin":  {Token: "admin-token"},
+				"deploy": {Token: "deploy-token"},
+			},
+			Contexts: map[string]*clientcmdapi.Context{
+				"admin@prod": {Cluster: "prod", AuthInfo: "admin"},
+			},
+			CurrentContext: "admin@prod",
+		}
+	}
+
+	cases := []struct {
+		name       string
+		server     string
+		serverCert string
+		user       string
+		cluster    string
+		authInfo   string
+	}{
+		{
+			name:     "kubeconfig cluster",
+			server:   "https://prod.example.com/",
+			cluster:  "prod",
+			authInfo: "admin",
+		},
+		{
+			name:     "kubeconfig cluster with user",
+			server:   "https://prod.example.com",
+			user:     "deploy",
+			cluster:  "prod",
+			authInfo: "deploy",
+		},
+		{
+			name:    "server not in kubeconfig",
+			server:  "https://attacker.example.com",
+			user:    "deploy",
+			cluster: "ksonnet-prod",
+		},
+		{
+			name:       "server not in kubeconfig with server cert",
+			server:     "https://attacker.example.com",
+			serverCert: "cert",
+			cluster:    "ksonnet-prod",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			appMock := &amocks.App{}
+			appMock.On("Environment", "prod").Return(&app.EnvironmentConfig{
+				Name: "prod",
+				Destination: &app.EnvironmentDestinationSpec{
+					Server:     tc.server,
+					Namespace:  "default",
+					ServerCert: tc.serverCert,
+				},
+			}, nil)
+
+			overrides := &clientcmd.ConfigOverrides{}
+			overrides.Context.AuthInfo = tc.user
+
+			c := Config{
+				Overrides: overrides,
+				Config:    clientcmd.NewDefaultClientConfig(newKubeConfig(), overrides),
+			}
+
+			got, err := c.EnvironmentKubeConfig(appMock, "prod")
+			require.NoError(t, err)
+
+			ctx := got.Contexts[got.CurrentContext]
+			require.NotNil(t, ctx)
+			assert.Equal(t, tc.cluster, ctx.Cluster)
+			assert.Equal(t, tc.authInfo, ctx.AuthInfo)
+			assert.Equal(t, "default", ctx.Namespace)
+
+			cluster := got.Clusters[ctx.Cluster]
+			require.NotNil(t, cluster)
+			assert.False(t, cluster.InsecureSkipTLSVerify)
+			if tc.serverCert != "" {
+				assert.Equal(t, tc.serverCert, string(cluster.CertificateAuthorityData))
+			}
+		})
+	}
+}
+
+func TestConfig_CheckContext(t *testing.T) {
+	clusters := map[string]*clientcmdapi.Cluster{
+		"prod":     {Server: "https://prod.example.com"},
+		"noserver": {},
+	}
+	contexts := map[string]*clientcmdapi.Context{
+		"prod":     {Cluster: "prod"},
+		"noserver": {Cluster: "noserver"},
+		"orphan":   {Cluster: "missing"},
+	}
+
+	cases := []struct {
+		name           string
+		context        string
+		currentContext string
+		isErr          bool
+	}{
+		{
+			name:    "context",
+			context: "prod",
+		},
+		{
+			name:           "current context",
+			currentContext: "prod",
+		},
+		{
+			name:  "no context and no current context",
+			isErr: true,
+		},
+		{
+			name:    "context does not exist",
+			context: "missing",
+			isErr:   true,
+		},
+		{
+			name:    "cluster does not exist",
+			context: "orphan",
+			isErr:   true,
+		},
+		{
+			name:           "cluster without server",
+			currentContext: "noserver",
+			isErr:          true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			kubeConfig := clientcmdapi.Config{
+				Clusters:       clusters,
+				Contexts:       contexts,
+				CurrentContext: tc.currentContext,
+			}
+			overrides := &clientcmd.ConfigOverrides{}
+
+			c := Config{
+				Overrides: overrides,
+				Config:    clientcmd.NewDefaultClientConfig(kubeConfig, overrides),
+			}
+
+			err := c.CheckContext(tc.context)
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestConfig_MergeKubeconfigs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kubeconfigs")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	base := filepath.Join(dir, "base.yaml")
+	writeKubeConfig(t, base, clientcmdapi.Config{
+		Clusters: map[string]*clientcmdapi.Cluster{
+			"shared": {Server: "https://base.example.com"},
+			"base":   {Server: "https://base-only.example.com"},
+		},
+		Contexts: map[string]*clientcmdapi.Context{
+			"dev":  {Cluster: "shared", Namespace: "base"},
+			"base": {Cluster: "base", Namespace: "base-only"},
+		},
+		CurrentContext: "base",
+	})
+
+	dev := filepath.Join(dir, "dev.yaml")
+	writeKubeConfig(t, dev, clientcmdapi.Config{
+		Clusters: map[string]*clientcmdapi.Cluster{
+			"shared": {Server: "https://dev.example.com"},
+		},
+		Contexts: map[string]*clientcmdapi.Context{
+			"dev": {Cluster: "shared", Namespace: "dev"},
+		},
+		CurrentContext: "dev",
+	})
+
+	cases := []struct {
+		name         string
+		paths        []string
+		explicitPath string
+		context      string
+		expServer    string
+		expNamespace string
+		isErr        bool
+		isResolveErr bool
+	}{
+		{
+			name:         "later file wins",
+			paths:        []string{base, dev},
+			context:      "dev",
+			expServer:    "https://dev.example.com",
+			expNamespace: "dev",
+		},
+		{
+			name:         "later file wins in reverse order",
+			paths:        []string{dev, base},
+			context:      "dev",
+			expServer:    "https://base.example.com",
+			expNamespace: "base",
+		},
+		{
+			name:         "context only in earlier file",
+			paths:        []string{base, dev},
+			context:      "base",
+			expServer:    "https://base-only.example.com",
+			expNamespace: "base-only",
+		},
+		{
+			name:         "current context of later file",
+			paths:        []string{base, dev},
+			expServer:    "https://dev.example.com",
+			expNamespace: "dev",
+		},
+		{
+			name:         "missing context",
+			paths:        []string{dev},
+			context:      "base",
+			isResolveErr: true,
+		},
+		{
+			name:  "missing file",
+			paths: []string{base, filepath.Join(dir, "missing.yaml")},
+			isErr: true,
+		},
+		{
+			name:         "explicit kubeconfig",
+			paths:        []string{base, dev},
+			explicitPath: base,
+			isErr:        true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			overrides := clientcmd.ConfigOverrides{}
+			loadingRules := clientcmd.ClientConfigLoadingRules{ExplicitPath: tc.explicitPath}
+			c := NewClientConfig(overrides, loadingRules)
+
+			err := c.MergeKubeconfigs(tc.paths)
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			server, namespace, err := c.ResolveContext(tc.context)
+			if tc.isResolveErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expServer, server)
+			require.Equal(t, tc.expNamespace, namespace)
+		})
+	}
+}
+
+func TestConfig_ResolveContext_explicit_kubeconfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kubeconfigs")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	env := filepath.Join(dir, "env.yaml")
+	writeKubeConfig(t, env, clientcmdapi.Config{
+		Clusters: map[string]*clientcmdapi.Cluster{
+			"shared": {Server: "https://env.example.com"},
+		},
+		Contexts: map[string]*clientcmdapi.Context{
+			"foo":      {Cluster: "shared", Namespace: "env"},
+			"env-only": {Cluster: "shared"},
+		},
+	})
+
+	other := filepath.Join(dir, "other.yaml")
+	writeKubeConfig(t, other, clientcmdapi.Config{
+		Clusters: map[string]*clientcmdapi.Cluster{
+			"shared": {Server: "https://other.example.com"},
+		},
+		Contexts: map[string]*clientcmdapi.Context{
+			"foo": {Cluster: "shared", Namespace: "other"},
+		},
+	})
+
+	current, isSet := os.LookupEnv("KUBECONFIG")
+	require.NoError(t, os.Setenv("KUBECONFIG", env))
+	defer func() {
+		if isSet {
+			os.Setenv("KUBECONFIG", current)
+		} else {
+			os.Unsetenv("KUBECONFIG")
+		}
+	}()
+
+	cases := []struct {
+		name         string
+		explicitPath string
+		context      string
+		expServer    string
+		expNamespace string
+		expErr       string
+	}{
+		{
+			name:         "KUBECONFIG",
+			context:      "foo",
+			expServer:    "https://env.example.com",
+			expNamespace: "env",
+		},
+		{
+			name:         "--kubeconfig wins over KUBECONFIG",
+			explicitPath: other,
+			context:      "foo",
+			expServer:    "https://other.example.com",
+			expNamespace: "other",
+		},
+		{
+			name:         "context missing from --kubeconfig",
+			explicitPath: other,
+			context:      "env-only",
+			expErr:       `context "env-only" does not exist in kubeconfig file "` + other + `"; available contexts: foo`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := NewDefaultClientConfig()
+			c.LoadingRules.ExplicitPath = tc.explicitPath
+
+			server, namespace, err := c.ResolveContext(tc.context)
+			if tc.expErr != "" {
+				require.EqualError(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expServer, server)
+			require.Equal(t, tc.expNamespace, namespace)
+		})
+	}
+}
+
+func TestConfig_ResolveContext_missing(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kubeconfigs")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "config.yaml")
+	writeKubeConfig(t, path, clientcmdapi.Config{
+		Clusters: map[string]*clientcmdapi.Cluster{
+			"us-west": {Server: "https://us-west.example.com"},
+			"us-east": {Server: "https://us-east.example.com"},
+		},
+		Contexts: map[string]*clientcmdapi.Context{
+			"us-west-staging": {Cluster: "us-west", Namespace: "staging"},
+			"us-west-prod":    {Cluster: "us-west", Namespace: "prod"},
+			"us-east-prod":    {Cluster: "us-east", Namespace: "prod"},
+		},
+		CurrentContext: "us-west-staging",
+	})
+
+	c := NewDefaultClientConfig()
+	c.LoadingRules.ExplicitPath = path
+
+	_, _, err = c.ResolveContext("staging")
+	require.EqualError(t, err, `context "staging" does not exist in kubeconfig file "`+path+
+		`"; available contexts: us-east-prod, us-west-prod, us-west-staging; current context: us-west-staging`)
+
+	err = c.CheckContext("staging")
+	require.EqualError(t, err, `context "staging" does not exist in kubeconfig file "`+path+
+		`"; available contexts: us-east-prod, us-west-prod, us-west-staging; current context: us-west-staging`)
+}
+
+func writeKubeConfig(t *testing.T, path string, config clientcmdapi.Config) {
+	require.NoError(t, clientcmd.WriteToFile(config, path))
+}
@@ -0,0 +1,59 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package client
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+)
+
+// ReadServerCert reads a PEM-encoded server certificate authority from a file.
+// It returns an error naming the file if it doesn't hold an X.509
+// certificate.
+func ReadServerCert(path string) ([]byte, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "read server certificate %q", path)
+	}
+
+	if err := ValidateServerCert(data); err != nil {
+		return nil, errors.Wrapf(err, "server certificate %q is invalid", path)
+	}
+
+	return data, nil
+}
+
+// ValidateServerCert returns an error if data doesn't start with a
+// PEM-encoded X.509 certificate.
+func ValidateServerCert(data []byte) error {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return errors.New("no PEM data found")
+	}
+
+	if block.Type != "CERTIFICATE" {
+		return errors.Errorf("PEM block is a %q, not a CERTIFICATE", block.Type)
+	}
+
+	if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+		return errors.Wrap(err, "parse X.509 certificate")
+	}
+
+	return nil
+}
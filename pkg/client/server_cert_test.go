@@ -0,0 +1,274 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package client
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	amocks "github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// newTLSVersionServer starts a TLS server that serves the version of a
+// Kubernetes API server, and returns it with its PEM-encoded certificate.
+func newTLSVersionServer(t *testing.T) (*httptest.Server, []byte) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"major":"1","minor":"10","gitVersion":"v1.10.3"}`)
+	}))
+
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})
+	return ts, cert
+}
+
+// newSelfSignedCert returns a PEM-encoded self-signed certificate authority.
+// Unlike the certificate of httptest servers, it is different every time.
+func newSelfSignedCert(t *testing.T) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "other-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func TestValidateServerCert(t *testing.T) {
+	ts, cert := newTLSVersionServer(t)
+	defer ts.Close()
+
+	cases := []struct {
+		name     string
+		data     []byte
+		expected string
+	}{
+		{
+			name: "certificate",
+			data: cert,
+		},
+		{
+			name:     "not PEM",
+			data:     []byte("certificate"),
+			expected: "no PEM data found",
+		},
+		{
+			name:     "not a certificate",
+			data:     pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: []byte("key")}),
+			expected: `PEM block is a "RSA PRIVATE KEY", not a CERTIFICATE`,
+		},
+		{
+			name: "invalid certificate",
+			data: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("certificate")}),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateServerCert(tc.data)
+			if tc.name == "certificate" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			if tc.expected != "" {
+				assert.Equal(t, tc.expected, err.Error())
+			}
+		})
+	}
+}
+
+func TestReadServerCert(t *testing.T) {
+	ts, cert := newTLSVersionServer(t)
+	defer ts.Close()
+
+	dir, err := ioutil.TempDir("", "server-cert")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	valid := filepath.Join(dir, "ca.pem")
+	require.NoError(t, ioutil.WriteFile(valid, cert, 0644))
+
+	data, err := ReadServerCert(valid)
+	require.NoError(t, err)
+	assert.Equal(t, cert, data)
+
+	invalid := filepath.Join(dir, "invalid.pem")
+	require.NoError(t, ioutil.WriteFile(invalid, []byte("certificate"), 0644))
+
+	_, err = ReadServerCert(invalid)
+	require.Error(t, err)
+	assert.Equal(t, fmt.Sprintf("server certificate %q is invalid: no PEM data found", invalid), err.Error())
+
+	_, err = ReadServerCert(filepath.Join(dir, "missing.pem"))
+	require.Error(t, err)
+}
+
+func TestConfig_ContextServerCert(t *testing.T) {
+	ts, cert := newTLSVersionServer(t)
+	defer ts.Close()
+
+	dir, err := ioutil.TempDir("", "server-cert")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	caFile := filepath.Join(dir, "ca.pem")
+	require.NoError(t, ioutil.WriteFile(caFile, cert, 0644))
+
+	kubeConfig := clientcmdapi.Config{
+		Clusters: map[string]*clientcmdapi.Cluster{
+			"data":    {Server: "https://data.example.com", CertificateAuthorityData: cert},
+			"file":    {Server: "https://file.example.com", CertificateAuthority: caFile},
+			"none":    {Server: "https://none.example.com"},
+			"invalid": {Server: "https://invalid.example.com", CertificateAuthorityData: []byte("certificate")},
+		},
+		Contexts: map[string]*clientcmdapi.Context{
+			"data":    {Cluster: "data"},
+			"file":    {Cluster: "file"},
+			"none":    {Cluster: "none"},
+			"invalid": {Cluster: "invalid"},
+		},
+		CurrentContext: "data",
+	}
+
+	cases := []struct {
+		name     string
+		context  string
+		expected []byte
+		isErr    bool
+	}{
+		{
+			name:     "current context",
+			expected: cert,
+		},
+		{
+			name:     "certificate authority file",
+			context:  "file",
+			expected: cert,
+		},
+		{
+			name:    "no certificate authority",
+			context: "none",
+		},
+		{
+			name:    "invalid certificate authority",
+			context: "invalid",
+			isErr:   true,
+		},
+		{
+			name:    "missing context",
+			context: "missing",
+			isErr:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			overrides := &clientcmd.ConfigOverrides{}
+			c := Config{
+				Overrides: overrides,
+				Config:    clientcmd.NewDefaultClientConfig(kubeConfig, overrides),
+			}
+
+			data, err := c.ContextServerCert(tc.context)
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, data)
+		})
+	}
+}
+
+func TestConfig_EnvironmentServerVersion_server_cert(t *testing.T) {
+	ts, cert := newTLSVersionServer(t)
+	defer ts.Close()
+
+	otherCert := newSelfSignedCert(t)
+
+	cases := []struct {
+		name       string
+		serverCert string
+		isErr      bool
+	}{
+		{
+			name:       "pinned certificate authority",
+			serverCert: string(cert),
+		},
+		{
+			name:       "other certificate authority",
+			serverCert: string(otherCert),
+			isErr:      true,
+		},
+		{
+			name: "no certificate authority",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			appMock := &amocks.App{}
+			appMock.On("Environment", "prod").Return(&app.EnvironmentConfig{
+				Name: "prod",
+				Destination: &app.EnvironmentDestinationSpec{
+					Server:     ts.URL,
+					Namespace:  "default",
+					ServerCert: tc.serverCert,
+				},
+			}, nil)
+
+			// A token keeps the client from prompting for credentials.
+			overrides := clientcmd.ConfigOverrides{AuthInfo: clientcmdapi.AuthInfo{Token: "token"}}
+			c := NewClientConfig(overrides, clientcmd.ClientConfigLoadingRules{})
+
+			info, err := c.EnvironmentServerVersion(appMock, "prod", time.Second)
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "v1.10.3", info.GitVersion)
+		})
+	}
+}
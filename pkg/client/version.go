@@ -0,0 +1,246 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package client
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	str "github.com/ksonnet/ksonnet/pkg/util/strings"
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/discovery"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+)
+
+// Copy returns a copy of the client config. Overrides applied to the copy,
+// e.g. when targeting an environment, do not affect the original.
+func (c *Config) Copy() *Config {
+	var overrides = *c.Overrides
+	var loadingRules = *c.LoadingRules
+	return NewClientConfig(overrides, loadingRules)
+}
+
+// EnvironmentServerVersion returns the version of the Kubernetes API server
+// of an environment. A timeout of zero means no timeout.
+func (c *Config) EnvironmentServerVersion(a app.App, envName string, timeout time.Duration) (*version.Info, error) {
+	dc, err := c.environmentDiscoveryClient(a, envName, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return dc.ServerVersion()
+}
+
+// EnvironmentAPISpec returns the api spec, e.g. `version:v1.10.3`, matching
+// the Kubernetes API server of an environment. A timeout of zero means no
+// timeout.
+func (c *Config) EnvironmentAPISpec(a app.App, envName string, timeout time.Duration) (string, error) {
+	serverVersion, err := c.EnvironmentServerVersion(a, envName, timeout)
+	if err != nil {
+		return "", err
+	}
+
+	k8sVersion := versionPattern.FindString(serverVersion.GitVersion)
+	if k8sVersion == "" {
+		return "", errors.Errorf("unable to parse server version %q", serverVersion.GitVersion)
+	}
+
+	return fmt.Sprintf("version:%s", k8sVersion), nil
+}
+
+// EnvironmentHealthz requests the /healthz endpoint of the Kubernetes API
+// server of an environment. It returns an error if the server can't be
+// reached or doesn't report itself as healthy. A timeout of zero means no
+// timeout.
+func (c *Config) EnvironmentHealthz(a app.App, envName string, timeout time.Duration) error {
+	dc, err := c.environmentDiscoveryClient(a, envName, timeout)
+	if err != nil {
+		return err
+	}
+
+	b, err := dc.RESTClient().Get().AbsPath("/healthz").DoRaw()
+	if err != nil {
+		return errors.Wrap(err, "request healthz")
+	}
+
+	if status := strings.TrimSpace(string(b)); status != "ok" {
+		return errors.Errorf("server is not healthy: %s", status)
+	}
+
+	return nil
+}
+
+// EnvironmentOpenAPI returns the OpenAPI spec served by the Kubernetes API
+// server of an environment. Servers older than Kubernetes 1.10 serve it at
+// /swagger.json instead of /openapi/v2. A timeout of zero means no timeout.
+func (c *Config) EnvironmentOpenAPI(a app.App, envName string, timeout time.Duration) ([]byte, error) {
+	dc, err := c.environmentDiscoveryClient(a, envName, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := dc.RESTClient().Get().AbsPath("/openapi/v2").DoRaw()
+	if err == nil {
+		return b, nil
+	}
+	if !kerrors.IsNotFound(err) {
+		return nil, errors.Wrap(err, "request OpenAPI spec")
+	}
+
+	b, err = dc.RESTClient().Get().AbsPath("/swagger.json").DoRaw()
+	if err != nil {
+		return nil, errors.Wrap(err, "request OpenAPI spec")
+	}
+
+	return b, nil
+}
+
+// ServerVersionAt returns the version of the Kubernetes API server at an
+// address that isn't recorded in an environment yet. If a kubeconfig cluster
+// has the address, its certificates and credentials are used. Otherwise the
+// server is asked anonymously, and verified with serverCert if it is given. A
+// timeout of zero means no timeout.
+func (c *Config) ServerVersionAt(server, serverCert string, timeout time.Duration) (*version.Info, error) {
+	conf, err := c.restConfigAt(server, serverCert, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	dc, err := discovery.NewDiscoveryClientForConfig(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	return dc.ServerVersion()
+}
+
+// NamespaceExistsAt reports whether a namespace exists on the Kubernetes API
+// server at an address that isn't recorded in an environment yet. Credentials
+// are chosen as in ServerVersionAt. An error is returned if the server can't
+// be asked, e.g. because it is unreachable. A timeout of zero means no
+// timeout.
+func (c *Config) NamespaceExistsAt(server, serverCert, namespace string, timeout time.Duration) (bool, error) {
+	conf, err := c.restConfigAt(server, serverCert, timeout)
+	if err != nil {
+		return false, err
+	}
+
+	cc, err := corev1.NewForConfig(conf)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = cc.Namespaces().Get(namespace, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrapf(err, "get namespace %q", namespace)
+	}
+
+	return true, nil
+}
+
+// restConfigAt returns the client config for the Kubernetes API server at an
+// address. If a kubeconfig cluster has the address, its certificates and
+// credentials are used. The credentials of the current context are never sent
+// to a server kubeconfig doesn't know: such a server gets an anonymous config
+// that trusts serverCert, or skips TLS verification without one.
+func (c *Config) restConfigAt(server, serverCert string, timeout time.Duration) (*rest.Config, error) {
+	clusterName, err := c.clusterAt(server)
+	if err != nil {
+		return nil, err
+	}
+
+	if clusterName == "" {
+		conf := &rest.Config{
+			Host:    server,
+			Timeout: timeout,
+		}
+		if serverCert != "" {
+			conf.TLSClientConfig.CAData = []byte(serverCert)
+		} else {
+			// NOTE: ignore TLS verify since we don't have a CA cert to verify with.
+			conf.TLSClientConfig.Insecure = true
+		}
+
+		return conf, nil
+	}
+
+	serverConfig := c.Copy()
+	serverConfig.Overrides.Context.Cluster = clusterName
+
+	conf, err := serverConfig.Config.ClientConfig()
+	if err != nil {
+		return nil, errors.Wrap(err, "retrieve client config")
+	}
+	conf.Timeout = timeout
+
+	return conf, nil
+}
+
+// HasServer reports whether a kubeconfig cluster has a server address.
+func (c *Config) HasServer(server string) (bool, error) {
+	name, err := c.clusterAt(server)
+	if err != nil {
+		return false, err
+	}
+
+	return name != "", nil
+}
+
+// clusterAt returns the name of the kubeconfig cluster with a server address,
+// or an empty string if there is none.
+func (c *Config) clusterAt(server string) (string, error) {
+	normalized, err := str.NormalizeURL(server)
+	if err != nil {
+		return "", err
+	}
+
+	rawConfig, err := c.Config.RawConfig()
+	if err != nil {
+		return "", errors.Wrap(err, "load kubeconfig")
+	}
+
+	for name, cluster := range rawConfig.Clusters {
+		if clusterServer, err := str.NormalizeURL(cluster.Server); err == nil && clusterServer == normalized {
+			return name, nil
+		}
+	}
+
+	return "", nil
+}
+
+func (c *Config) environmentDiscoveryClient(a app.App, envName string, timeout time.Duration) (*discovery.DiscoveryClient, error) {
+	envConfig := c.Copy()
+	if err := envConfig.overrideCluster(a, envName); err != nil {
+		return nil, err
+	}
+
+	conf, err := envConfig.Config.ClientConfig()
+	if err != nil {
+		return nil, errors.Wrap(err, "retrieve client config")
+	}
+	conf.Timeout = timeout
+
+	return discovery.NewDiscoveryClientForConfig(conf)
+}
@@ -0,0 +1,220 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package client
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	amocks "github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestConfig_ServerVersionAt(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/version" {
+			http.NotFound(w, r)
+			return
+		}
+
+		fmt.Fprint(w, `{"major":"1","minor":"10","gitVersion":"v1.10.3"}`)
+	}))
+	defer ts.Close()
+
+	c := NewClientConfig(clientcmd.ConfigOverrides{}, clientcmd.ClientConfigLoadingRules{})
+
+	info, err := c.ServerVersionAt(ts.URL, "", time.Second)
+	require.NoError(t, err)
+	require.Equal(t, "v1.10.3", info.GitVersion)
+
+	require.Empty(t, c.Overrides.ClusterInfo.Server, "the original config should not be changed")
+}
+
+func TestConfig_ServerVersionAt_not_an_api_server(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.NotFound(w, r)
+	}))
+	defer ts.Close()
+
+	c := NewClientConfig(clientcmd.ConfigOverrides{}, clientcmd.ClientConfigLoadingRules{})
+
+	_, err := c.ServerVersionAt(ts.URL, "", time.Second)
+	require.Error(t, err)
+}
+
+func TestConfig_ServerVersionAt_credentials(t *testing.T) {
+	var authorization string
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		fmt.Fprint(w, `{"major":"1","minor":"10","gitVersion":"v1.10.3"}`)
+	}))
+	defer ts.Close()
+
+	dir, err := ioutil.TempDir("", "kubeconfigs")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "config.yaml")
+	kubeconfig := `apiVersion: v1
+kind: Config
+clusters:
+- name: known
+  cluster:
+    server: ` + ts.URL + `
+    insecure-skip-tls-verify: true
+- name: other
+  cluster:
+    server: https://other.example.com
+users:
+- name: admin
+  user:
+    token: secret
+contexts:
+- name: other
+  context:
+    cluster: other
+    user: admin
+current-context: other
+`
+	require.NoError(t, ioutil.WriteFile(path, []byte(kubeconfig), 0600))
+
+	c := NewDefaultClientConfig()
+	c.LoadingRules.ExplicitPath = path
+
+	unknown := strings.Replace(ts.URL, "127.0.0.1", "localhost", 1)
+
+	known, err := c.HasServer(ts.URL)
+	require.NoError(t, err)
+	require.True(t, known)
+
+	known, err = c.HasServer(unknown)
+	require.NoError(t, err)
+	require.False(t, known)
+
+	_, err = c.ServerVersionAt(ts.URL, "", time.Second)
+	require.NoError(t, err)
+	require.Equal(t, "Bearer secret", authorization, "a kubeconfig cluster is asked with kubeconfig credentials")
+
+	_, err = c.ServerVersionAt(unknown, "", time.Second)
+	require.NoError(t, err)
+	require.Empty(t, authorization, "an unknown server is asked anonymously")
+}
+
+func TestConfig_NamespaceExistsAt(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/namespaces/web":
+			fmt.Fprint(w, `{"apiVersion":"v1","kind":"Namespace","metadata":{"name":"web"}}`)
+		case "/api/v1/namespaces/broken":
+			http.Error(w, "broken", http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"apiVersion":"v1","kind":"Status","status":"Failure","reason":"NotFound","code":404}`)
+		}
+	}))
+	defer ts.Close()
+
+	c := NewClientConfig(clientcmd.ConfigOverrides{}, clientcmd.ClientConfigLoadingRules{})
+
+	exists, err := c.NamespaceExistsAt(ts.URL, "", "web", time.Second)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	exists, err = c.NamespaceExistsAt(ts.URL, "", "stating", time.Second)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	_, err = c.NamespaceExistsAt(ts.URL, "", "broken", time.Second)
+	require.Error(t, err)
+
+	require.Empty(t, c.Overrides.ClusterInfo.Server, "the original config should not be changed")
+}
+
+func TestConfig_EnvironmentOpenAPI(t *testing.T) {
+	cases := []struct {
+		name     string
+		paths    map[string]string
+		expected string
+		isErr    bool
+	}{
+		{
+			name: "openapi endpoint",
+			paths: map[string]string{
+				"/openapi/v2":   `{"swagger":"2.0","info":{"version":"v1.10.3"}}`,
+				"/swagger.json": `{"swagger":"2.0","info":{"version":"v1.10.3-old"}}`,
+			},
+			expected: `{"swagger":"2.0","info":{"version":"v1.10.3"}}`,
+		},
+		{
+			name: "swagger endpoint of older servers",
+			paths: map[string]string{
+				"/swagger.json": `{"swagger":"2.0","info":{"version":"v1.8.4"}}`,
+			},
+			expected: `{"swagger":"2.0","info":{"version":"v1.8.4"}}`,
+		},
+		{
+			name:  "no spec",
+			isErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, ok := tc.paths[r.URL.Path]
+				if !ok {
+					http.NotFound(w, r)
+					return
+				}
+
+				fmt.Fprint(w, body)
+			}))
+			defer ts.Close()
+
+			appMock := &amocks.App{}
+			appMock.On("Environment", "prod").Return(&app.EnvironmentConfig{
+				Name: "prod",
+				Destination: &app.EnvironmentDestinationSpec{
+					Server:    ts.URL,
+					Namespace: "default",
+				},
+			}, nil)
+
+			// A token keeps the client from prompting for credentials.
+			overrides := clientcmd.ConfigOverrides{AuthInfo: clientcmdapi.AuthInfo{Token: "token"}}
+			c := NewClientConfig(overrides, clientcmd.ClientConfigLoadingRules{})
+
+			b, err := c.EnvironmentOpenAPI(appMock, "prod", time.Second)
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, string(b))
+		})
+	}
+}
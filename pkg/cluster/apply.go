@@ -18,11 +18,14 @@ package cluster
 import (
 	"fmt"
 	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/ksonnet/ksonnet/pkg/app"
 	"github.com/ksonnet/ksonnet/pkg/client"
 	"github.com/ksonnet/ksonnet/pkg/metadata"
+	"github.com/ksonnet/ksonnet/pkg/util/dockerregistry"
 	"github.com/ksonnet/ksonnet/utils"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
@@ -30,6 +33,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
@@ -60,6 +64,35 @@ type ApplyConfig struct {
 	EnvName        string
 	GcTag          string
 	SkipGc         bool
+
+	// ObjectParallelism is the number of objects that are applied at once.
+	// Objects are applied one at a time if it is less than two.
+	ObjectParallelism int
+
+	// Objects are applied instead of the objects of the environment's
+	// components, if set.
+	Objects []*unstructured.Unstructured
+
+	// Selector limits the objects that are applied to the ones whose labels
+	// match, if set. Objects that don't match are left alone, so garbage
+	// collection is skipped.
+	Selector labels.Selector
+
+	// ResolveImages rewrites the image of every container to the digest its
+	// tag currently points to before applying, if set.
+	ResolveImages bool
+	// AllowUnresolvedImages leaves images that can't be resolved as they are,
+	// instead of failing.
+	AllowUnresolvedImages bool
+
+	// FailOnUnmanaged refuses to update objects that exist on the cluster
+	// without the label ksonnet sets on the objects it applies, if set.
+	FailOnUnmanaged bool
+
+	// Wait waits, for up to WaitTimeout, for the Deployments, StatefulSets
+	// and Pods that were applied to be ready, if set.
+	Wait        bool
+	WaitTimeout time.Duration
 }
 
 // ApplyOpts are options for configuring Apply.
@@ -71,12 +104,14 @@ type Apply struct {
 
 	// these make it easier to test Apply.
 	findObjectsFn         findObjectsFn
+	resolveImageFn        resolveImageFn
 	resourceClientFactory resourceClientFactoryFn
 	clientOpts            *Clients
 	objectInfo            ObjectInfo
 	ksonnetObjectFactory  func() ksonnetObject
 	upserterFactory       func() Upserter
 	conflictTimeout       time.Duration
+	waitForReadyFn        func(objects []*unstructured.Unstructured, get ObjectGetter, timeout time.Duration) error
 }
 
 // RunApply runs apply against a cluster given a configuration.
@@ -87,7 +122,8 @@ func RunApply(config ApplyConfig, opts ...ApplyOpts) error {
 
 	a := &Apply{
 		ApplyConfig:           config,
-		findObjectsFn:         findObjects,
+		findObjectsFn:         Render,
+		resolveImageFn:        dockerregistry.ResolveImage,
 		resourceClientFactory: resourceClientFactory,
 		objectInfo:            &objectInfo{},
 		ksonnetObjectFactory: func() ksonnetObject {
@@ -95,6 +131,7 @@ func RunApply(config ApplyConfig, opts ...ApplyOpts) error {
 			return newDefaultKsonnetObject(factory)
 		},
 		conflictTimeout: 1 * time.Second,
+		waitForReadyFn:  WaitForReady,
 	}
 
 	for _, opt := range opts {
@@ -125,36 +162,127 @@ func RunApply(config ApplyConfig, opts ...ApplyOpts) error {
 
 // Apply applies against a cluster.
 func (a *Apply) Apply() error {
-	apiObjects, err := a.findObjectsFn(a.App, a.EnvName, a.ComponentNames)
-	if err != nil {
-		return errors.Wrap(err, "find objects")
+	var err error
+
+	apiObjects := a.Objects
+	if apiObjects == nil {
+		apiObjects, err = a.findObjectsFn(a.App, a.EnvName, a.ComponentNames)
+		if err != nil {
+			return errors.Wrap(err, "find objects")
+		}
+	}
+
+	if a.Selector != nil {
+		apiObjects = selectObjects(apiObjects, a.Selector)
+		if len(apiObjects) == 0 {
+			log.Warnf("no objects match selector %q", a.Selector.String())
+			return nil
+		}
+	}
+
+	if a.ResolveImages {
+		if err = resolveImages(apiObjects, a.resolveImageFn, a.AllowUnresolvedImages); err != nil {
+			return err
+		}
 	}
 
 	sort.Sort(utils.DependencyOrder(apiObjects))
 
 	seenUids := sets.NewString()
 
-	for _, obj := range apiObjects {
-		var uid string
-		uid, err = a.handleObject(obj)
-		if err != nil {
-			return errors.Wrap(err, "handle object")
+	if a.ObjectParallelism > 1 {
+		if err = a.applyConcurrently(apiObjects, seenUids); err != nil {
+			return err
 		}
+	} else {
+		for _, obj := range apiObjects {
+			var uid string
+			uid, err = a.handleObject(obj)
+			if err != nil {
+				return errors.Wrap(err, "handle object")
+			}
 
-		// Some objects appear under multiple kinds
-		// (eg: Deployment is both extensions/v1beta1
-		// and apps/v1beta1).  UID is the only stable
-		// identifier that links these two views of
-		// the same object.
-		seenUids.Insert(uid)
+			// Some objects appear under multiple kinds
+			// (eg: Deployment is both extensions/v1beta1
+			// and apps/v1beta1).  UID is the only stable
+			// identifier that links these two views of
+			// the same object.
+			seenUids.Insert(uid)
+		}
 	}
 
-	if a.GcTag != "" && !a.SkipGc {
+	if a.GcTag != "" && !a.SkipGc && a.Selector == nil {
 		if err = a.runGc(seenUids); err != nil {
 			return errors.Wrap(err, "run gc")
 		}
 	}
 
+	if a.Wait && !a.DryRun {
+		return a.waitForReadyFn(apiObjects, a.getUpdatedObject, a.WaitTimeout)
+	}
+
+	return nil
+}
+
+// selectObjects returns the objects whose labels match selector.
+func selectObjects(objects []*unstructured.Unstructured, selector labels.Selector) []*unstructured.Unstructured {
+	var selected []*unstructured.Unstructured
+	for _, obj := range objects {
+		if selector.Matches(labels.Set(obj.GetLabels())) {
+			selected = append(selected, obj)
+		}
+	}
+
+	return selected
+}
+
+// applyConcurrently applies objects one dependency tier at a time, applying up
+// to ObjectParallelism objects of a tier at once. If any object of a tier
+// can't be applied, the rest of the tier is still applied, but later tiers
+// aren't.
+func (a *Apply) applyConcurrently(apiObjects []*unstructured.Unstructured, seenUids sets.String) error {
+	for _, tier := range utils.DependencyTiers(apiObjects) {
+		var (
+			mu       sync.Mutex
+			wg       sync.WaitGroup
+			failures []string
+		)
+
+		sem := make(chan struct{}, a.ObjectParallelism)
+
+		for _, obj := range tier {
+			obj := obj
+
+			wg.Add(1)
+			sem <- struct{}{}
+
+			go func() {
+				defer wg.Done()
+				defer func() { <-sem }()
+
+				uid, err := a.handleObject(obj)
+
+				mu.Lock()
+				defer mu.Unlock()
+
+				if err != nil {
+					failures = append(failures, fmt.Sprintf("%s %s: %v", obj.GetKind(), utils.FqName(obj), err))
+					return
+				}
+
+				seenUids.Insert(uid)
+			}()
+		}
+
+		wg.Wait()
+
+		if len(failures) > 0 {
+			sort.Strings(failures)
+			return errors.Errorf("handle objects: %d object(s) failed:\n%s",
+				len(failures), strings.Join(failures, "\n"))
+		}
+	}
+
 	return nil
 }
 
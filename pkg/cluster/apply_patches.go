@@ -0,0 +1,247 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package cluster
+
+import (
+	"fmt"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/ksonnet/ksonnet/pkg/audit"
+	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// ObjectPatch is a previously generated change to an object in the cluster.
+type ObjectPatch struct {
+	APIVersion string
+	Kind       string
+	Namespace  string
+	Name       string
+
+	// Create is true if the object didn't exist when the patch was
+	// generated. Patch is then the object to create, otherwise it is a JSON
+	// merge patch for the existing object.
+	Create bool
+	Patch  []byte
+}
+
+func (p ObjectPatch) String() string {
+	if p.Namespace == "" {
+		return fmt.Sprintf("%s %s", p.Kind, p.Name)
+	}
+
+	return fmt.Sprintf("%s %s/%s", p.Kind, p.Namespace, p.Name)
+}
+
+// ApplyPatchesConfig is configuration for ApplyPatches.
+type ApplyPatchesConfig struct {
+	App          app.App
+	ClientConfig *client.Config
+	Create       bool
+	DryRun       bool
+	EnvName      string
+	Patches      []ObjectPatch
+}
+
+// ApplyPatchesOpts are options for configuring ApplyPatches.
+type ApplyPatchesOpts func(a *ApplyPatches)
+
+// ApplyPatches applies previously generated patches to a cluster, instead of
+// rendering the objects of an environment.
+type ApplyPatches struct {
+	ApplyPatchesConfig
+
+	// these make it easier to test ApplyPatches.
+	clientOpts            *Clients
+	resourceClientFactory resourceClientFactoryFn
+}
+
+// RunApplyPatches applies patches to a cluster given a configuration.
+func RunApplyPatches(config ApplyPatchesConfig, opts ...ApplyPatchesOpts) error {
+	if config.ClientConfig == nil {
+		return errors.New("ksonnet client config is required")
+	}
+
+	a := &ApplyPatches{
+		ApplyPatchesConfig:    config,
+		resourceClientFactory: resourceClientFactory,
+	}
+
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	if a.clientOpts == nil {
+		co, err := GenClients(a.App, a.ClientConfig, a.EnvName)
+		if err != nil {
+			return err
+		}
+
+		a.clientOpts = &co
+	}
+
+	return a.Apply()
+}
+
+// pendingPatch is a patch which was validated against the cluster.
+type pendingPatch struct {
+	ObjectPatch
+	obj *unstructured.Unstructured
+	rc  ResourceClient
+}
+
+// Apply applies the patches. Every patch is validated against the current
+// state of the cluster before any of them is applied: objects to create must
+// not exist, and objects to patch must exist. Patches which were already
+// applied are skipped.
+func (a *ApplyPatches) Apply() error {
+	var pending []pendingPatch
+	for _, p := range a.Patches {
+		pp, err := a.validate(p)
+		if err != nil {
+			return errors.Wrapf(err, "validating patch for %s", p)
+		}
+
+		if pp != nil {
+			pending = append(pending, *pp)
+		}
+	}
+
+	for _, pp := range pending {
+		if err := a.apply(pp); err != nil {
+			return errors.Wrapf(err, "applying patch for %s", pp.ObjectPatch)
+		}
+	}
+
+	return nil
+}
+
+// validate checks a patch against the object in the cluster. It returns nil
+// if the patch doesn't change the object.
+func (a *ApplyPatches) validate(p ObjectPatch) (*pendingPatch, error) {
+	obj := &unstructured.Unstructured{}
+	if p.Create {
+		if err := obj.UnmarshalJSON(p.Patch); err != nil {
+			return nil, errors.Wrap(err, "decoding object to create")
+		}
+
+		if obj.GetAPIVersion() != p.APIVersion || obj.GetKind() != p.Kind || obj.GetName() != p.Name {
+			return nil, errors.Errorf("object to create is %s %s %s, not %s %s %s",
+				obj.GetAPIVersion(), obj.GetKind(), obj.GetName(), p.APIVersion, p.Kind, p.Name)
+		}
+	} else {
+		obj.SetAPIVersion(p.APIVersion)
+		obj.SetKind(p.Kind)
+		obj.SetName(p.Name)
+	}
+
+	if p.Namespace != "" {
+		obj.SetNamespace(p.Namespace)
+	}
+
+	rc, err := a.resourceClientFactory(*a.clientOpts, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := rc.Get(metav1.GetOptions{})
+	if err != nil && !kerrors.IsNotFound(err) {
+		return nil, errors.Wrap(err, "fetching object from cluster")
+	}
+
+	exists := err == nil
+
+	if p.Create {
+		if exists {
+			return nil, errors.New("object already exists, so the patches are out of date")
+		}
+
+		if !a.Create {
+			return nil, errors.New("not creating non-existent object")
+		}
+
+		return &pendingPatch{ObjectPatch: p, obj: obj, rc: rc}, nil
+	}
+
+	if !exists {
+		return nil, errors.New("object doesn't exist, so the patches are out of date")
+	}
+
+	currentJSON, err := current.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	patched, err := jsonpatch.MergePatch(currentJSON, p.Patch)
+	if err != nil {
+		return nil, errors.Wrap(err, "patching object from cluster")
+	}
+
+	if jsonpatch.Equal(currentJSON, patched) {
+		log.Infof("%s is already up to date", p)
+		return nil, nil
+	}
+
+	return &pendingPatch{ObjectPatch: p, obj: obj, rc: rc}, nil
+}
+
+func (a *ApplyPatches) apply(pp pendingPatch) error {
+	if pp.Create {
+		log.Info("Creating non-existent ", pp.ObjectPatch, a.dryRunText())
+		if a.DryRun {
+			return nil
+		}
+
+		aa := newDefaultAnnotationApplier()
+		if err := aa.SetOriginalConfiguration(pp.obj); err != nil {
+			return errors.Wrap(err, "tagging ksonnet managed object")
+		}
+
+		if _, err := pp.rc.Create(); err != nil {
+			return err
+		}
+
+		recordObject(audit.ActionCreated, pp.obj)
+		return nil
+	}
+
+	log.Info("Patching ", pp.ObjectPatch, a.dryRunText())
+	if a.DryRun {
+		return nil
+	}
+
+	if _, err := pp.rc.Patch(types.MergePatchType, pp.Patch); err != nil {
+		return err
+	}
+
+	recordObject(audit.ActionUpdated, pp.obj)
+	return nil
+}
+
+func (a *ApplyPatches) dryRunText() string {
+	text := ""
+	if a.DryRun {
+		text = " (dry-run)"
+	}
+
+	return text
+}
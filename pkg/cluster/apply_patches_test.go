@@ -0,0 +1,173 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package cluster
+
+import (
+	"testing"
+
+	amocks "github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/ksonnet/ksonnet/pkg/cluster/mocks"
+	"github.com/ksonnet/ksonnet/pkg/util/test"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func Test_ApplyPatches(t *testing.T) {
+	existing := &unstructured.Unstructured{Object: genObject()}
+
+	createPatch := ObjectPatch{
+		APIVersion: "v1",
+		Kind:       "ConfigMap",
+		Name:       "new",
+		Create:     true,
+		Patch:      []byte(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"new"}}`),
+	}
+
+	updatePatch := ObjectPatch{
+		APIVersion: "apps/v1beta1",
+		Kind:       "Deployment",
+		Name:       "guiroot",
+		Patch:      []byte(`{"spec":{"replicas":3}}`),
+	}
+
+	cases := []struct {
+		name     string
+		patches  []ObjectPatch
+		create   bool
+		dryRun   bool
+		existing map[string]bool
+		created  []string
+		patched  []string
+		isErr    bool
+	}{
+		{
+			name:     "create and patch",
+			patches:  []ObjectPatch{createPatch, updatePatch},
+			create:   true,
+			existing: map[string]bool{"guiroot": true},
+			created:  []string{"new"},
+			patched:  []string{"guiroot"},
+		},
+		{
+			name:     "dry run",
+			patches:  []ObjectPatch{createPatch, updatePatch},
+			create:   true,
+			dryRun:   true,
+			existing: map[string]bool{"guiroot": true},
+		},
+		{
+			name: "already applied",
+			patches: []ObjectPatch{
+				{
+					APIVersion: "apps/v1beta1",
+					Kind:       "Deployment",
+					Name:       "guiroot",
+					Patch:      []byte(`{"spec":{"replicas":1}}`),
+				},
+			},
+			existing: map[string]bool{"guiroot": true},
+		},
+		{
+			name:     "object to create exists",
+			patches:  []ObjectPatch{updatePatch, createPatch},
+			create:   true,
+			existing: map[string]bool{"guiroot": true, "new": true},
+			isErr:    true,
+		},
+		{
+			name:    "object to patch doesn't exist",
+			patches: []ObjectPatch{createPatch, updatePatch},
+			create:  true,
+			isErr:   true,
+		},
+		{
+			name:     "creation disabled",
+			patches:  []ObjectPatch{createPatch},
+			existing: map[string]bool{},
+			isErr:    true,
+		},
+		{
+			name: "object to create doesn't match the patch",
+			patches: []ObjectPatch{
+				{
+					APIVersion: "v1",
+					Kind:       "ConfigMap",
+					Name:       "other",
+					Create:     true,
+					Patch:      createPatch.Patch,
+				},
+			},
+			create: true,
+			isErr:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			test.WithApp(t, "/app", func(a *amocks.App, fs afero.Fs) {
+				config := ApplyPatchesConfig{
+					App:          a,
+					ClientConfig: &client.Config{},
+					Create:       tc.create,
+					DryRun:       tc.dryRun,
+					Patches:      tc.patches,
+				}
+
+				var created, patched []string
+
+				setup := func(ap *ApplyPatches) {
+					ap.clientOpts = &Clients{}
+					ap.resourceClientFactory = func(opts Clients, object runtime.Object) (ResourceClient, error) {
+						obj := object.(*unstructured.Unstructured)
+						name := obj.GetName()
+
+						rc := &mocks.ResourceClient{}
+						if tc.existing[name] {
+							rc.On("Get", mock.Anything).Return(existing, nil)
+						} else {
+							rc.On("Get", mock.Anything).Return(nil, &notFoundError{})
+						}
+
+						rc.On("Create").Return(obj, nil).Run(func(mock.Arguments) {
+							created = append(created, name)
+						})
+						rc.On("Patch", types.MergePatchType, mock.Anything).Return(obj, nil).Run(func(mock.Arguments) {
+							patched = append(patched, name)
+						})
+
+						return rc, nil
+					}
+				}
+
+				err := RunApplyPatches(config, setup)
+				if tc.isErr {
+					require.Error(t, err)
+				} else {
+					require.NoError(t, err)
+				}
+
+				assert.Equal(t, tc.created, created)
+				assert.Equal(t, tc.patched, patched)
+			})
+		})
+	}
+}
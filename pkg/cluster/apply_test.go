@@ -16,10 +16,14 @@
 package cluster
 
 import (
+	"sort"
+	"sync"
 	"testing"
+	"time"
 
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/ksonnet/ksonnet/pkg/app"
@@ -166,6 +170,217 @@ func Test_Apply_retry_on_conflict(t *testing.T) {
 	})
 }
 
+func Test_Apply_object_parallelism(t *testing.T) {
+	newObj := func(apiVersion, kind, name string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{Object: genObject()}
+		obj.SetAPIVersion(apiVersion)
+		obj.SetKind(kind)
+		obj.SetName(name)
+		return obj
+	}
+
+	cases := []struct {
+		name     string
+		failName string
+		expected []string
+		isErr    bool
+	}{
+		{
+			name:     "all objects applied",
+			expected: []string{"ns", "config", "service", "deploy"},
+		},
+		{
+			name:     "failure stops later tiers",
+			failName: "config",
+			expected: []string{"ns", "service"},
+			isErr:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			test.WithApp(t, "/app", func(a *amocks.App, fs afero.Fs) {
+				applyConfig := ApplyConfig{
+					App:               a,
+					ClientConfig:      &client.Config{},
+					ObjectParallelism: 2,
+				}
+
+				upserter := &recordingUpserter{failName: tc.failName}
+
+				setupApp := func(apply *Apply) {
+					apply.clientOpts = &Clients{}
+
+					apply.findObjectsFn = func(a app.App, envName string, componentNames []string) ([]*unstructured.Unstructured, error) {
+						return []*unstructured.Unstructured{
+							newObj("extensions/v1beta1", "Deployment", "deploy"),
+							newObj("v1", "ConfigMap", "config"),
+							newObj("v1", "Namespace", "ns"),
+							newObj("v1", "Service", "service"),
+						}, nil
+					}
+
+					apply.ksonnetObjectFactory = func() ksonnetObject {
+						return &passthroughKsonnetObject{}
+					}
+
+					apply.upserterFactory = func() Upserter {
+						return upserter
+					}
+				}
+
+				err := RunApply(applyConfig, setupApp)
+				if tc.isErr {
+					require.Error(t, err)
+				} else {
+					require.NoError(t, err)
+				}
+
+				// Objects within a tier are applied in any order.
+				require.Equal(t, "ns", upserter.applied[0])
+
+				sort.Strings(tc.expected)
+				sort.Strings(upserter.applied)
+				require.Equal(t, tc.expected, upserter.applied)
+			})
+		})
+	}
+}
+
+func Test_Apply_objects(t *testing.T) {
+	test.WithApp(t, "/app", func(a *amocks.App, fs afero.Fs) {
+		obj := &unstructured.Unstructured{Object: genObject()}
+
+		applyConfig := ApplyConfig{
+			App:          a,
+			ClientConfig: &client.Config{},
+			Objects:      []*unstructured.Unstructured{obj},
+		}
+
+		upserter := &recordingUpserter{}
+
+		setupApp := func(apply *Apply) {
+			apply.clientOpts = &Clients{}
+
+			apply.findObjectsFn = func(a app.App, envName string, componentNames []string) ([]*unstructured.Unstructured, error) {
+				return nil, errors.New("components should not be rendered")
+			}
+
+			apply.ksonnetObjectFactory = func() ksonnetObject {
+				return &passthroughKsonnetObject{}
+			}
+
+			apply.upserterFactory = func() Upserter {
+				return upserter
+			}
+		}
+
+		err := RunApply(applyConfig, setupApp)
+		require.NoError(t, err)
+
+		require.Equal(t, []string{"guiroot"}, upserter.applied)
+	})
+}
+
+func Test_Apply_selector(t *testing.T) {
+	cases := []struct {
+		name     string
+		selector string
+		expected []string
+	}{
+		{
+			name:     "matching objects",
+			selector: "tier=frontend",
+			expected: []string{"frontend"},
+		},
+		{
+			name:     "no matching objects",
+			selector: "tier=database",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			test.WithApp(t, "/app", func(a *amocks.App, fs afero.Fs) {
+				newObject := func(name, tier string) *unstructured.Unstructured {
+					obj := &unstructured.Unstructured{Object: genObject()}
+					obj.SetName(name)
+					obj.SetLabels(map[string]string{"tier": tier})
+					return obj
+				}
+
+				selector, err := labels.Parse(tc.selector)
+				require.NoError(t, err)
+
+				applyConfig := ApplyConfig{
+					App:          a,
+					ClientConfig: &client.Config{},
+					GcTag:        "gc-tag",
+					Objects: []*unstructured.Unstructured{
+						newObject("backend", "backend"),
+						newObject("frontend", "frontend"),
+					},
+					Selector: selector,
+				}
+
+				upserter := &recordingUpserter{}
+
+				setupApp := func(apply *Apply) {
+					apply.clientOpts = &Clients{}
+
+					apply.ksonnetObjectFactory = func() ksonnetObject {
+						return &passthroughKsonnetObject{}
+					}
+
+					apply.upserterFactory = func() Upserter {
+						return upserter
+					}
+				}
+
+				// Garbage collection would need a discovery client, so this
+				// fails if objects that don't match are collected.
+				err = RunApply(applyConfig, setupApp)
+				require.NoError(t, err)
+
+				require.Equal(t, tc.expected, upserter.applied)
+			})
+		})
+	}
+}
+
+// recordingUpserter records the names of the objects it upserts, and fails
+// to upsert the object named failName.
+type recordingUpserter struct {
+	failName string
+
+	mu      sync.Mutex
+	applied []string
+}
+
+var _ Upserter = (*recordingUpserter)(nil)
+
+func (u *recordingUpserter) Upsert(obj *unstructured.Unstructured) (string, error) {
+	if obj.GetName() == u.failName {
+		return "", errors.New("upsert failed")
+	}
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	u.applied = append(u.applied, obj.GetName())
+	return obj.GetName(), nil
+}
+
+// passthroughKsonnetObject returns objects as they are, without merging
+// them with the cluster.
+type passthroughKsonnetObject struct{}
+
+var _ ksonnetObject = (*passthroughKsonnetObject)(nil)
+
+func (ko *passthroughKsonnetObject) MergeFromCluster(co Clients, obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	return obj, nil
+}
+
 func genObject() map[string]interface{} {
 	return map[string]interface{}{
 		"apiVersion": "apps/v1beta1",
@@ -201,3 +416,69 @@ func genObject() map[string]interface{} {
 		},
 	}
 }
+
+func Test_Apply_wait(t *testing.T) {
+	cases := []struct {
+		name   string
+		dryRun bool
+		waited bool
+	}{
+		{
+			name:   "wait",
+			waited: true,
+		},
+		{
+			name:   "dry run",
+			dryRun: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			test.WithApp(t, "/app", func(a *amocks.App, fs afero.Fs) {
+				applyConfig := ApplyConfig{
+					App:          a,
+					ClientConfig: &client.Config{},
+					DryRun:       tc.dryRun,
+					Wait:         true,
+					WaitTimeout:  time.Minute,
+				}
+
+				obj := &unstructured.Unstructured{Object: genObject()}
+				waited := false
+
+				setupApp := func(apply *Apply) {
+					apply.clientOpts = &Clients{}
+
+					apply.findObjectsFn = func(a app.App, envName string, componentNames []string) ([]*unstructured.Unstructured, error) {
+						return []*unstructured.Unstructured{obj}, nil
+					}
+
+					apply.ksonnetObjectFactory = func() ksonnetObject {
+						return &fakeKsonnetObject{
+							obj: obj,
+						}
+					}
+
+					apply.upserterFactory = func() Upserter {
+						return &fakeUpserter{
+							upsertID: "12345",
+						}
+					}
+
+					apply.waitForReadyFn = func(objects []*unstructured.Unstructured, get ObjectGetter, timeout time.Duration) error {
+						waited = true
+						require.Equal(t, []*unstructured.Unstructured{obj}, objects)
+						require.Equal(t, time.Minute, timeout)
+						return nil
+					}
+				}
+
+				err := RunApply(applyConfig, setupApp)
+				require.NoError(t, err)
+
+				require.Equal(t, tc.waited, waited)
+			})
+		})
+	}
+}
@@ -19,9 +19,9 @@ import (
 	"fmt"
 
 	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/ksonnet/ksonnet/pkg/audit"
 	"github.com/ksonnet/ksonnet/pkg/client"
 	"github.com/ksonnet/ksonnet/pkg/metadata"
-	"github.com/ksonnet/ksonnet/pkg/pipeline"
 	"github.com/ksonnet/ksonnet/utils"
 	log "github.com/sirupsen/logrus"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
@@ -48,11 +48,6 @@ type validateObjectFn func(d discovery.DiscoveryInterface,
 type findObjectsFn func(a app.App, envName string,
 	componentNames []string) ([]*unstructured.Unstructured, error)
 
-func findObjects(a app.App, envName string, componentNames []string) ([]*unstructured.Unstructured, error) {
-	p := pipeline.New(a, envName)
-	return p.Objects(componentNames)
-}
-
 func stringListContains(list []string, value string) bool {
 	for _, item := range list {
 		if item == value {
@@ -100,9 +95,17 @@ func gcDelete(options Clients, rcFactory resourceClientFactoryFn, version *utils
 		return fmt.Errorf("Error deleting %s: %s", desc, err)
 	}
 
+	gvk := o.GetObjectKind().GroupVersionKind()
+	audit.RecordObject(audit.ActionGarbageCollected, gvk.GroupVersion().String(), gvk.Kind, obj.GetNamespace(), obj.GetName())
+
 	return nil
 }
 
+// recordObject records a change to an object in the audit trail.
+func recordObject(action string, obj *unstructured.Unstructured) {
+	audit.RecordObject(action, obj.GetAPIVersion(), obj.GetKind(), obj.GetNamespace(), obj.GetName())
+}
+
 func walkObjects(co Clients, listopts metav1.ListOptions, callback func(runtime.Object) error) error {
 	rsrclists, err := co.discovery.ServerResources()
 	if err != nil {
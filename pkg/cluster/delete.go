@@ -20,6 +20,7 @@ import (
 	"sort"
 
 	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/ksonnet/ksonnet/pkg/audit"
 	"github.com/ksonnet/ksonnet/pkg/client"
 	"github.com/ksonnet/ksonnet/utils"
 	"github.com/pkg/errors"
@@ -55,7 +56,7 @@ type Delete struct {
 func RunDelete(config DeleteConfig, opts ...DeleteOpts) error {
 	d := &Delete{
 		DeleteConfig:          config,
-		findObjectsFn:         findObjects,
+		findObjectsFn:         Render,
 		genClientOptsFn:       GenClients,
 		resourceClientFactory: resourceClientFactory,
 		objectInfo:            &objectInfo{},
@@ -114,6 +115,10 @@ func (d *Delete) Delete() error {
 			return fmt.Errorf("Error deleting %s: %s", desc, err)
 		}
 
+		if err == nil {
+			recordObject(audit.ActionDeleted, obj)
+		}
+
 		log.Debugf("Deleted object: ", obj)
 	}
 
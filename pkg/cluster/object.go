@@ -168,6 +168,23 @@ func RebuildObject(m map[string]interface{}) (map[string]interface{}, error) {
 	return mm.Decode()
 }
 
+// OriginalObject returns the object as ksonnet last applied it, which is
+// recorded in the managed annotation of an object on the cluster. It returns
+// nil if the object doesn't have the annotation.
+func OriginalObject(obj *unstructured.Unstructured) (map[string]interface{}, error) {
+	descriptor, ok := obj.GetAnnotations()[clustermetadata.AnnotationManaged]
+	if !ok {
+		return nil, nil
+	}
+
+	var mm managedAnnotation
+	if err := json.Unmarshal([]byte(descriptor), &mm); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return mm.Decode()
+}
+
 // filterManagedObjects filters out any non-managed objects according to their labels
 func filterManagedObjects(objects []*unstructured.Unstructured) []*unstructured.Unstructured {
 	// see Filtering without allocating - https://github.com/golang/go/wiki/SliceTricks#filtering-without-allocating
@@ -185,13 +202,10 @@ func filterManagedObjects(objects []*unstructured.Unstructured) []*unstructured.
 	return filtered
 }
 
-// CollectObjects collects objects in a cluster namespace.
+// CollectObjects collects objects in a cluster namespace, as ksonnet last
+// applied them.
 func CollectObjects(namespace string, clients Clients, components []string) ([]*unstructured.Unstructured, error) {
-	objects, err := fetchManagedObjects(namespace, clients, components)
-	if err != nil {
-		return nil, err
-	}
-	objects = filterManagedObjects(objects)
+	objects, err := CollectLiveObjects(namespace, clients, components)
 	if err != nil {
 		return nil, err
 	}
@@ -207,3 +221,14 @@ func CollectObjects(namespace string, clients Clients, components []string) ([]*
 
 	return objects, nil
 }
+
+// CollectLiveObjects collects objects managed by ksonnet in a cluster
+// namespace, as they currently are on the cluster.
+func CollectLiveObjects(namespace string, clients Clients, components []string) ([]*unstructured.Unstructured, error) {
+	objects, err := fetchManagedObjects(namespace, clients, components)
+	if err != nil {
+		return nil, err
+	}
+
+	return filterManagedObjects(objects), nil
+}
@@ -212,6 +212,27 @@ func TestRebuildObject(t *testing.T) {
 	require.Equal(t, expected, got)
 }
 
+func TestOriginalObject(t *testing.T) {
+	b, err := ioutil.ReadFile(filepath.ToSlash("testdata/deployment.json"))
+	require.NoError(t, err)
+
+	obj := &unstructured.Unstructured{}
+	require.NoError(t, obj.UnmarshalJSON(b))
+
+	got, err := OriginalObject(obj)
+	require.NoError(t, err)
+
+	expected, err := RebuildObject(obj.Object)
+	require.NoError(t, err)
+	require.Equal(t, expected, got)
+
+	obj.SetAnnotations(nil)
+
+	got, err = OriginalObject(obj)
+	require.NoError(t, err)
+	require.Nil(t, got)
+}
+
 func Test_fetchManagedObjects_Fail(t *testing.T) {
 	fakeClients := Clients{}
 	_, err := fetchManagedObjects("default", fakeClients, []string{})
@@ -0,0 +1,31 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package cluster
+
+import (
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/ksonnet/ksonnet/pkg/pipeline"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Render renders the objects of an environment in memory, exactly as `ks show`
+// and `ks apply` see them, without writing them anywhere. If no component
+// names are given, all components of the environment are rendered. It is
+// meant for tools that embed ksonnet and consume the objects directly.
+func Render(a app.App, envName string, componentNames []string) ([]*unstructured.Unstructured, error) {
+	p := pipeline.New(a, envName)
+	return p.Objects(componentNames)
+}
@@ -0,0 +1,93 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package cluster
+
+import (
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// containerFields are the fields of pod specs that hold containers.
+var containerFields = map[string]bool{
+	"containers":     true,
+	"initContainers": true,
+}
+
+type resolveImageFn func(image string) (string, error)
+
+// resolveImages rewrites the image of every container in objects to the
+// digest reference its tag currently points to, so the exact images are
+// deployed. Images that can't be resolved are an error, unless
+// allowUnresolved is set, in which case they are left as they are.
+func resolveImages(objects []*unstructured.Unstructured, fn resolveImageFn, allowUnresolved bool) error {
+	resolved := make(map[string]string)
+
+	for _, obj := range objects {
+		var images []map[string]interface{}
+		findContainers(obj.Object, &images)
+
+		for _, container := range images {
+			image, ok := container["image"].(string)
+			if !ok || image == "" {
+				continue
+			}
+
+			digest, ok := resolved[image]
+			if !ok {
+				var err error
+				digest, err = fn(image)
+				if err != nil {
+					if !allowUnresolved {
+						return errors.Wrapf(err, "resolving image %q of %s %s", image, obj.GetKind(), obj.GetName())
+					}
+
+					log.Warnf("leaving image %q of %s %s unresolved: %v", image, obj.GetKind(), obj.GetName(), err)
+					digest = image
+				}
+				resolved[image] = digest
+			}
+
+			container["image"] = digest
+		}
+	}
+
+	return nil
+}
+
+// findContainers appends the containers found anywhere in v, so pods,
+// workloads and pod templates nested in other objects are all covered.
+func findContainers(v interface{}, containers *[]map[string]interface{}) {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, child := range t {
+			if list, ok := child.([]interface{}); ok && containerFields[k] {
+				for _, item := range list {
+					if container, ok := item.(map[string]interface{}); ok {
+						*containers = append(*containers, container)
+					}
+				}
+				continue
+			}
+
+			findContainers(child, containers)
+		}
+	case []interface{}:
+		for _, child := range t {
+			findContainers(child, containers)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package cluster
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func Test_resolveImages(t *testing.T) {
+	newObjects := func() []*unstructured.Unstructured {
+		return []*unstructured.Unstructured{
+			{Object: map[string]interface{}{
+				"kind":     "Deployment",
+				"metadata": map[string]interface{}{"name": "web"},
+				"spec": map[string]interface{}{
+					"template": map[string]interface{}{
+						"spec": map[string]interface{}{
+							"initContainers": []interface{}{
+								map[string]interface{}{"name": "init", "image": "busybox:1.28"},
+							},
+							"containers": []interface{}{
+								map[string]interface{}{"name": "web", "image": "nginx:1.15"},
+								map[string]interface{}{"name": "proxy", "image": "envoy:latest"},
+							},
+						},
+					},
+				},
+			}},
+			{Object: map[string]interface{}{
+				"kind":     "CronJob",
+				"metadata": map[string]interface{}{"name": "backup"},
+				"spec": map[string]interface{}{
+					"jobTemplate": map[string]interface{}{
+						"spec": map[string]interface{}{
+							"template": map[string]interface{}{
+								"spec": map[string]interface{}{
+									"containers": []interface{}{
+										map[string]interface{}{"name": "backup", "image": "nginx:1.15"},
+									},
+								},
+							},
+						},
+					},
+				},
+			}},
+		}
+	}
+
+	images := func(objects []*unstructured.Unstructured) []string {
+		var ret []string
+		for _, obj := range objects {
+			var containers []map[string]interface{}
+			findContainers(obj.Object, &containers)
+			for _, c := range containers {
+				ret = append(ret, c["image"].(string))
+			}
+		}
+		return ret
+	}
+
+	cases := []struct {
+		name            string
+		allowUnresolved bool
+		expected        []string
+		isErr           bool
+	}{
+		{
+			name:  "unresolvable image",
+			isErr: true,
+		},
+		{
+			name:            "allow unresolved",
+			allowUnresolved: true,
+			expected: []string{
+				"busybox@sha256:1", "envoy:latest", "nginx@sha256:2", "nginx@sha256:2",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			calls := make(map[string]int)
+			fn := func(image string) (string, error) {
+				calls[image]++
+				switch image {
+				case "busybox:1.28":
+					return "busybox@sha256:1", nil
+				case "nginx:1.15":
+					return "nginx@sha256:2", nil
+				default:
+					return "", errors.Errorf("image %s not found", image)
+				}
+			}
+
+			objects := newObjects()
+			err := resolveImages(objects, fn, tc.allowUnresolved)
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+
+			got := images(objects)
+			sort.Strings(got)
+			assert.Equal(t, tc.expected, got)
+			assert.Equal(t, 1, calls["nginx:1.15"], "images are only resolved once")
+		})
+	}
+}
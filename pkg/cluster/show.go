@@ -19,9 +19,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/ghodss/yaml"
 	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/ksonnet/ksonnet/pkg/util/dockerregistry"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
@@ -32,7 +34,21 @@ type ShowConfig struct {
 	ComponentNames []string
 	EnvName        string
 	Format         string
-	Out            io.Writer
+	// Object limits the output to the object named Kind/name, if set.
+	Object string
+	Out    io.Writer
+	// OutputDir is a directory each object is written to as its own file
+	// instead of Out, if set.
+	OutputDir string
+	// FilenameTemplate is a Go template of the path of each object's file
+	// in OutputDir. DefaultFilenameTemplate is used if it is empty.
+	FilenameTemplate string
+	// ResolveImages rewrites the image of every container to the digest its
+	// tag currently points to, if set.
+	ResolveImages bool
+	// AllowUnresolvedImages leaves images that can't be resolved as they are,
+	// instead of failing.
+	AllowUnresolvedImages bool
 }
 
 // ShowOpts is an option for configuring Show.
@@ -43,14 +59,16 @@ type Show struct {
 	ShowConfig
 
 	// these make it easier to test Show.
-	findObjectsFn findObjectsFn
+	findObjectsFn  findObjectsFn
+	resolveImageFn resolveImageFn
 }
 
 // RunShow shows objects for a given configuration.
 func RunShow(config ShowConfig, opts ...ShowOpts) error {
 	s := &Show{
-		ShowConfig:    config,
-		findObjectsFn: findObjects,
+		ShowConfig:     config,
+		findObjectsFn:  Render,
+		resolveImageFn: dockerregistry.ResolveImage,
 	}
 
 	for _, opt := range opts {
@@ -71,6 +89,24 @@ func (s *Show) Show() error {
 	copy(sorted, apiObjects)
 	UnstructuredSlice(sorted).Sort()
 
+	if s.Object != "" {
+		obj, err := selectObject(sorted, s.Object)
+		if err != nil {
+			return err
+		}
+		sorted = []*unstructured.Unstructured{obj}
+	}
+
+	if s.ResolveImages {
+		if err := resolveImages(sorted, s.resolveImageFn, s.AllowUnresolvedImages); err != nil {
+			return err
+		}
+	}
+
+	if s.OutputDir != "" {
+		return s.showDir(sorted)
+	}
+
 	switch s.Format {
 	case "yaml":
 		return s.showYAML(sorted)
@@ -81,6 +117,30 @@ func (s *Show) Show() error {
 	}
 }
 
+// selectObject returns the object named selector, which is in the form
+// Kind/name. Kind and name must match exactly.
+func selectObject(objects []*unstructured.Unstructured, selector string) (*unstructured.Unstructured, error) {
+	parts := strings.SplitN(selector, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, errors.Errorf("object %q is not in the form Kind/name", selector)
+	}
+
+	var available []string
+	for _, obj := range objects {
+		if obj.GetKind() == parts[0] && obj.GetName() == parts[1] {
+			return obj, nil
+		}
+		available = append(available, fmt.Sprintf("%s/%s", obj.GetKind(), obj.GetName()))
+	}
+
+	if len(available) == 0 {
+		return nil, errors.Errorf("object %q was not found; no objects were rendered", selector)
+	}
+
+	return nil, errors.Errorf("object %q was not found; available objects:\n  %s",
+		selector, strings.Join(available, "\n  "))
+}
+
 func (s *Show) showYAML(apiObjects []*unstructured.Unstructured) error {
 	return ShowYAML(s.Out, apiObjects)
 }
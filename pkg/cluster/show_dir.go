@@ -0,0 +1,175 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package cluster
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/ghodss/yaml"
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// DefaultFilenameTemplate is the template of the file names objects are
+// written to in an output directory, without the extension of the format.
+const DefaultFilenameTemplate = "{{lower .Kind}}-{{.Name}}"
+
+var (
+	invalidPathSegmentChars = regexp.MustCompile(`[^A-Za-z0-9._-]`)
+
+	filenameTemplateFuncs = template.FuncMap{
+		"lower": strings.ToLower,
+	}
+)
+
+// filenameData is the data a filename template is rendered with. Values are
+// sanitized so each of them is a valid path segment.
+type filenameData struct {
+	APIVersion string
+	Kind       string
+	Name       string
+	Namespace  string
+}
+
+// showDir writes each object to its own file in OutputDir. The path of the
+// file is rendered from FilenameTemplate. Nothing is written if any path is
+// invalid, or if two objects would be written to the same file.
+func (s *Show) showDir(apiObjects []*unstructured.Unstructured) error {
+	filenameTemplate := s.FilenameTemplate
+	if filenameTemplate == "" {
+		filenameTemplate = DefaultFilenameTemplate + "." + s.Format
+	}
+
+	tmpl, err := template.New("filename").Funcs(filenameTemplateFuncs).Parse(filenameTemplate)
+	if err != nil {
+		return errors.Wrapf(err, "parse filename template %q", filenameTemplate)
+	}
+
+	paths := make([]string, len(apiObjects))
+	owners := make(map[string]string)
+
+	for i, obj := range apiObjects {
+		id := fmt.Sprintf("%s/%s", obj.GetKind(), obj.GetName())
+
+		path, err := renderFilename(tmpl, obj)
+		if err != nil {
+			return errors.Wrapf(err, "render filename template %q for %s", filenameTemplate, id)
+		}
+
+		if owner, ok := owners[path]; ok {
+			return errors.Errorf("objects %s and %s would both be written to %q; use a filename template that tells them apart",
+				owner, id, path)
+		}
+		owners[path] = id
+		paths[i] = path
+	}
+
+	fs := s.App.Fs()
+
+	for i, obj := range apiObjects {
+		data, err := s.marshalObject(obj)
+		if err != nil {
+			return err
+		}
+
+		path := filepath.Join(s.OutputDir, paths[i])
+		if err := fs.MkdirAll(filepath.Dir(path), app.DefaultFolderPermissions); err != nil {
+			return err
+		}
+
+		if err := afero.WriteFile(fs, path, data, app.DefaultFilePermissions); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Show) marshalObject(obj *unstructured.Unstructured) ([]byte, error) {
+	switch s.Format {
+	case "yaml":
+		return yaml.Marshal(obj)
+	case "json":
+		data, err := json.MarshalIndent(obj.Object, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return append(data, '\n'), nil
+	default:
+		return nil, fmt.Errorf("Unknown --format: %s", s.Format)
+	}
+}
+
+// renderFilename renders the path of an object's file, relative to the output
+// directory. Empty path segments, e.g. from the namespace of a cluster scoped
+// object, are dropped.
+func renderFilename(tmpl *template.Template, obj *unstructured.Unstructured) (string, error) {
+	data := filenameData{
+		APIVersion: sanitizePathSegment(obj.GetAPIVersion()),
+		Kind:       sanitizePathSegment(obj.GetKind()),
+		Name:       sanitizePathSegment(obj.GetName()),
+		Namespace:  sanitizePathSegment(obj.GetNamespace()),
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+
+	rendered := strings.TrimSpace(buf.String())
+	if rendered == "" {
+		return "", errors.New("rendered path is empty")
+	}
+
+	var segments []string
+	for _, segment := range strings.Split(filepath.ToSlash(rendered), "/") {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+
+	path := filepath.Clean(filepath.Join(segments...))
+
+	switch {
+	case filepath.IsAbs(path):
+		return "", errors.Errorf("rendered path %q is absolute", rendered)
+	case path == ".." || strings.HasPrefix(path, ".."+string(filepath.Separator)):
+		return "", errors.Errorf("rendered path %q is outside of the output directory", rendered)
+	case strings.HasSuffix(rendered, "/") || path == ".":
+		return "", errors.Errorf("rendered path %q is not a file", rendered)
+	}
+
+	return path, nil
+}
+
+// sanitizePathSegment replaces the characters of s that aren't safe in a path
+// segment, including path separators, with underscores.
+func sanitizePathSegment(s string) string {
+	s = invalidPathSegmentChars.ReplaceAllString(s, "_")
+	if s == "." || s == ".." {
+		return strings.Repeat("_", len(s))
+	}
+
+	return s
+}
@@ -0,0 +1,176 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package cluster
+
+import (
+	"os"
+	"sort"
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/ksonnet/ksonnet/pkg/util/test"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestShow_output_dir(t *testing.T) {
+	newObject := func(kind, namespace, name string) *unstructured.Unstructured {
+		metadata := map[string]interface{}{"name": name}
+		if namespace != "" {
+			metadata["namespace"] = namespace
+		}
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       kind,
+			"metadata":   metadata,
+		}}
+	}
+
+	objects := []*unstructured.Unstructured{
+		newObject("Namespace", "", "web"),
+		newObject("Service", "web", "web"),
+		newObject("ConfigMap", "web", "web:config"),
+	}
+
+	cases := []struct {
+		name             string
+		format           string
+		filenameTemplate string
+		objects          []*unstructured.Unstructured
+		expected         map[string]string
+		errMsg           string
+	}{
+		{
+			name:   "default template",
+			format: "yaml",
+			expected: map[string]string{
+				"/out/configmap-web_config.yaml": "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: web:config\n  namespace: web\n",
+				"/out/namespace-web.yaml":        "apiVersion: v1\nkind: Namespace\nmetadata:\n  name: web\n",
+				"/out/service-web.yaml":          "apiVersion: v1\nkind: Service\nmetadata:\n  name: web\n  namespace: web\n",
+			},
+		},
+		{
+			name:             "directories",
+			format:           "json",
+			filenameTemplate: "{{.Namespace}}/{{.Kind}}/{{.Name}}.json",
+			objects:          objects[:2],
+			expected: map[string]string{
+				"/out/Namespace/web.json":   "{\n  \"apiVersion\": \"v1\",\n  \"kind\": \"Namespace\",\n  \"metadata\": {\n    \"name\": \"web\"\n  }\n}\n",
+				"/out/web/Service/web.json": "{\n  \"apiVersion\": \"v1\",\n  \"kind\": \"Service\",\n  \"metadata\": {\n    \"name\": \"web\",\n    \"namespace\": \"web\"\n  }\n}\n",
+			},
+		},
+		{
+			name:             "collision",
+			format:           "yaml",
+			filenameTemplate: "{{.Name}}.yaml",
+			errMsg:           "objects Namespace/web and Service/web would both be written to \"web.yaml\"; use a filename template that tells them apart",
+		},
+		{
+			name:             "invalid template",
+			format:           "yaml",
+			filenameTemplate: "{{.Name",
+			errMsg:           "parse filename template \"{{.Name\": template: filename:1: unclosed action",
+		},
+		{
+			name:             "unknown field",
+			format:           "yaml",
+			filenameTemplate: "{{.Cluster}}.yaml",
+			errMsg:           "render filename template \"{{.Cluster}}.yaml\" for Namespace/web: template: filename:1:2: executing \"filename\" at <.Cluster>: can't evaluate field Cluster in type cluster.filenameData",
+		},
+		{
+			name:             "outside of output directory",
+			format:           "yaml",
+			filenameTemplate: "../{{.Name}}.yaml",
+			errMsg:           "render filename template \"../{{.Name}}.yaml\" for Namespace/web: rendered path \"../web.yaml\" is outside of the output directory",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			test.WithApp(t, "/", func(appMock *mocks.App, fs afero.Fs) {
+				config := ShowConfig{
+					App:              appMock,
+					EnvName:          "default",
+					Format:           tc.format,
+					OutputDir:        "/out",
+					FilenameTemplate: tc.filenameTemplate,
+				}
+
+				findOpt := func(s *Show) {
+					s.findObjectsFn = func(a app.App, envName string, componentNames []string) ([]*unstructured.Unstructured, error) {
+						if tc.objects != nil {
+							return tc.objects, nil
+						}
+						return objects, nil
+					}
+				}
+
+				err := RunShow(config, findOpt)
+				if tc.errMsg != "" {
+					require.EqualError(t, err, tc.errMsg)
+
+					exists, err := afero.DirExists(fs, "/out")
+					require.NoError(t, err)
+					require.False(t, exists, "nothing should be written")
+					return
+				}
+				require.NoError(t, err)
+
+				var written []string
+				err = afero.Walk(fs, "/out", func(path string, fi os.FileInfo, err error) error {
+					if err == nil && !fi.IsDir() {
+						written = append(written, path)
+					}
+					return err
+				})
+				require.NoError(t, err)
+
+				var expected []string
+				for path, contents := range tc.expected {
+					expected = append(expected, path)
+					b, err := afero.ReadFile(fs, path)
+					require.NoError(t, err)
+					require.Equal(t, contents, string(b))
+				}
+				sort.Strings(expected)
+				require.Equal(t, expected, written)
+			})
+		})
+	}
+}
+
+func Test_sanitizePathSegment(t *testing.T) {
+	cases := []struct {
+		in       string
+		expected string
+	}{
+		{in: "web", expected: "web"},
+		{in: "web-1.v2_x", expected: "web-1.v2_x"},
+		{in: "apps/v1", expected: "apps_v1"},
+		{in: "web:config", expected: "web_config"},
+		{in: "..", expected: "__"},
+		{in: ".", expected: "_"},
+		{in: "", expected: ""},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.in, func(t *testing.T) {
+			require.Equal(t, tc.expected, sanitizePathSegment(tc.in))
+		})
+	}
+}
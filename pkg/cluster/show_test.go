@@ -29,6 +29,68 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
+func TestShow_object(t *testing.T) {
+	objects := []*unstructured.Unstructured{
+		{Object: map[string]interface{}{"kind": "Service", "metadata": map[string]interface{}{"name": "web"}}},
+		{Object: map[string]interface{}{"kind": "Deployment", "metadata": map[string]interface{}{"name": "web"}}},
+		{Object: map[string]interface{}{"kind": "Deployment", "metadata": map[string]interface{}{"name": "worker"}}},
+	}
+
+	cases := []struct {
+		name     string
+		object   string
+		expected string
+		errMsg   string
+	}{
+		{
+			name:     "match",
+			object:   "Deployment/web",
+			expected: "---\nkind: Deployment\nmetadata:\n  name: web\n",
+		},
+		{
+			name:   "kind must match exactly",
+			object: "deployment/web",
+			errMsg: "object \"deployment/web\" was not found; available objects:\n  Service/web\n  Deployment/web\n  Deployment/worker",
+		},
+		{
+			name:   "invalid selector",
+			object: "web",
+			errMsg: "object \"web\" is not in the form Kind/name",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			test.WithApp(t, "/", func(appMock *mocks.App, fs afero.Fs) {
+				var buf bytes.Buffer
+
+				config := ShowConfig{
+					App:     appMock,
+					EnvName: "default",
+					Out:     &buf,
+					Format:  "yaml",
+					Object:  tc.object,
+				}
+
+				findOpt := func(s *Show) {
+					s.findObjectsFn = func(a app.App, envName string, componentNames []string) ([]*unstructured.Unstructured, error) {
+						return objects, nil
+					}
+				}
+
+				err := RunShow(config, findOpt)
+				if tc.errMsg != "" {
+					require.EqualError(t, err, tc.errMsg)
+					return
+				}
+
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, buf.String())
+			})
+		})
+	}
+}
+
 func TestShow(t *testing.T) {
 	dummyObjects := func() ([]*unstructured.Unstructured, error) {
 		return []*unstructured.Unstructured{
@@ -18,9 +18,12 @@ package cluster
 import (
 	"encoding/json"
 
+	"github.com/ksonnet/ksonnet/pkg/audit"
+	"github.com/ksonnet/ksonnet/pkg/metadata"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 	kdiff "k8s.io/apimachinery/pkg/util/diff"
@@ -77,9 +80,18 @@ func (u *defaultUpserter) Upsert(obj *unstructured.Unstructured) (string, error)
 		return "", err
 	}
 
+	if u.FailOnUnmanaged {
+		if err := u.checkManaged(rc, obj); err != nil {
+			return "", err
+		}
+	}
+
 	patchedObject, err := u.updateObject(rc, obj)
 	if err == nil {
 		log.Debug("Updated object: ", kdiff.ObjectDiff(obj, patchedObject))
+		if !u.DryRun {
+			recordObject(audit.ActionUpdated, obj)
+		}
 		return string(patchedObject.GetUID()), nil
 	} else if !kerrors.IsNotFound(err) {
 		return "", errors.Wrap(err, "patching existing object")
@@ -96,9 +108,28 @@ func (u *defaultUpserter) Upsert(obj *unstructured.Unstructured) (string, error)
 	}
 
 	log.Debug("Created object: ", kdiff.ObjectDiff(obj, newObj))
+	recordObject(audit.ActionCreated, obj)
 	return string(newObj.GetUID()), nil
 }
 
+// checkManaged returns an error if obj exists in the cluster without the
+// deploy manager label, i.e. it was created by something other than ksonnet.
+func (u *defaultUpserter) checkManaged(rc ResourceClient, obj *unstructured.Unstructured) error {
+	current, err := rc.Get(metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "getting existing object")
+	}
+
+	if current.GetLabels()[metadata.LabelDeployManager] != appKsonnet {
+		return errors.Errorf("refusing to update %s, which exists but is not labeled %s=%s",
+			u.objectDescriber.Describe(obj), metadata.LabelDeployManager, appKsonnet)
+	}
+
+	return nil
+}
+
 // updateObject attempts to update an object in the cluster.
 func (u *defaultUpserter) updateObject(rc ResourceClient, obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
 	objectData, err := json.Marshal(obj)
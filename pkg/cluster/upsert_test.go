@@ -21,10 +21,13 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/mock"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 
+	"github.com/ksonnet/ksonnet/pkg/audit"
 	"github.com/ksonnet/ksonnet/pkg/cluster/mocks"
+	"github.com/ksonnet/ksonnet/pkg/metadata"
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -37,6 +40,7 @@ func Test_defaultUpserter_Upsert(t *testing.T) {
 		initResourceClient func(*testing.T, *unstructured.Unstructured) *mocks.ResourceClient
 		isErr              bool
 		expectedID         string
+		expectedAudit      []string
 	}{
 		{
 			name: "patch existing object",
@@ -53,7 +57,8 @@ func Test_defaultUpserter_Upsert(t *testing.T) {
 
 				return rc
 			},
-			expectedID: "12345",
+			expectedID:    "12345",
+			expectedAudit: []string{audit.ActionUpdated},
 		},
 		{
 			name: "create new object",
@@ -73,7 +78,8 @@ func Test_defaultUpserter_Upsert(t *testing.T) {
 
 				return rc
 			},
-			expectedID: "12345",
+			expectedID:    "12345",
+			expectedAudit: []string{audit.ActionCreated},
 		},
 		{
 			name: "dry run create",
@@ -117,6 +123,67 @@ func Test_defaultUpserter_Upsert(t *testing.T) {
 			},
 			isErr: true,
 		},
+		{
+			name: "fail on unmanaged with managed object",
+			applyConfig: ApplyConfig{
+				Create:          true,
+				FailOnUnmanaged: true,
+			},
+			initResourceClient: func(t *testing.T, obj *unstructured.Unstructured) *mocks.ResourceClient {
+				rc := &mocks.ResourceClient{}
+
+				current := &unstructured.Unstructured{Object: genObject()}
+				current.SetLabels(map[string]string{metadata.LabelDeployManager: "ksonnet"})
+				rc.On("Get", metav1.GetOptions{}).Return(current, nil)
+
+				newObject := *obj
+				newObject.SetUID(types.UID("12345"))
+
+				rc.On("Patch", types.MergePatchType, mock.AnythingOfType("[]uint8")).Return(&newObject, nil)
+
+				return rc
+			},
+			expectedID:    "12345",
+			expectedAudit: []string{audit.ActionUpdated},
+		},
+		{
+			name: "fail on unmanaged with unmanaged object",
+			applyConfig: ApplyConfig{
+				Create:          true,
+				FailOnUnmanaged: true,
+			},
+			initResourceClient: func(t *testing.T, obj *unstructured.Unstructured) *mocks.ResourceClient {
+				rc := &mocks.ResourceClient{}
+
+				current := &unstructured.Unstructured{Object: genObject()}
+				rc.On("Get", metav1.GetOptions{}).Return(current, nil)
+
+				return rc
+			},
+			isErr: true,
+		},
+		{
+			name: "fail on unmanaged with new object",
+			applyConfig: ApplyConfig{
+				Create:          true,
+				FailOnUnmanaged: true,
+			},
+			initResourceClient: func(t *testing.T, obj *unstructured.Unstructured) *mocks.ResourceClient {
+				rc := &mocks.ResourceClient{}
+
+				rc.On("Get", metav1.GetOptions{}).Return(nil, &notFoundError{})
+				rc.On("Patch", types.MergePatchType, mock.AnythingOfType("[]uint8")).Return(nil, &notFoundError{})
+
+				newObject := *obj
+				newObject.SetUID(types.UID("12345"))
+
+				rc.On("Create").Return(&newObject, nil)
+
+				return rc
+			},
+			expectedID:    "12345",
+			expectedAudit: []string{audit.ActionCreated},
+		},
 		{
 			name: "create failed",
 			applyConfig: ApplyConfig{
@@ -160,7 +227,17 @@ func Test_defaultUpserter_Upsert(t *testing.T) {
 			u, err := newDefaultUpserter(tc.applyConfig, oi, co, rfc)
 			require.NoError(t, err)
 
+			sink := &auditSink{}
+			op := audit.Begin(sink, "apply", "default", nil)
+
 			id, err := u.Upsert(obj)
+			require.NoError(t, op.Finish(err))
+
+			var actions []string
+			for _, o := range sink.events[0].Objects {
+				actions = append(actions, o.Action)
+			}
+			require.Equal(t, tc.expectedAudit, actions)
 
 			if tc.isErr {
 				require.Error(t, err)
@@ -184,3 +261,12 @@ var _ Upserter = (*fakeUpserter)(nil)
 func (u *fakeUpserter) Upsert(*unstructured.Unstructured) (string, error) {
 	return u.upsertID, u.upsertErr
 }
+
+type auditSink struct {
+	events []audit.Event
+}
+
+func (s *auditSink) Write(e audit.Event) error {
+	s.events = append(s.events, e)
+	return nil
+}
@@ -0,0 +1,298 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package cluster
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ksonnet/ksonnet/utils"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// readinessInterval is how long to wait between checks of the objects
+	// that aren't ready yet.
+	readinessInterval = 2 * time.Second
+)
+
+// ObjectGetter returns the current state of an object on the cluster.
+type ObjectGetter func(obj *unstructured.Unstructured) (*unstructured.Unstructured, error)
+
+// WaitForReady polls the Deployments, StatefulSets and Pods in objects until
+// all of them are ready, as reported by IsReady. Objects of other kinds are
+// ready once they exist. It returns an error naming the objects that aren't
+// ready if the timeout elapses first, or as soon as a Pod has failed, since
+// it will never become ready.
+func WaitForReady(objects []*unstructured.Unstructured, get ObjectGetter, timeout time.Duration) error {
+	w := &readinessWaiter{
+		get:      get,
+		interval: readinessInterval,
+		nowFn:    time.Now,
+		sleepFn:  time.Sleep,
+	}
+
+	return w.wait(objects, timeout)
+}
+
+// readinessWaiter polls objects for WaitForReady.
+type readinessWaiter struct {
+	get      ObjectGetter
+	interval time.Duration
+	nowFn    func() time.Time
+	sleepFn  func(time.Duration)
+}
+
+func (w *readinessWaiter) wait(objects []*unstructured.Unstructured, timeout time.Duration) error {
+	if timeout <= 0 {
+		return errors.New("waiting for objects to be ready requires a positive timeout")
+	}
+
+	pending := make([]*unstructured.Unstructured, 0, len(objects))
+	for _, obj := range objects {
+		if isReadinessChecked(obj) {
+			pending = append(pending, obj)
+		}
+	}
+
+	deadline := w.nowFn().Add(timeout)
+	reasons := make(map[string]string)
+
+	for {
+		var notReady []*unstructured.Unstructured
+
+		for _, obj := range pending {
+			desc := fmt.Sprintf("%s %s", obj.GetKind(), utils.FqName(obj))
+
+			current, err := w.get(obj)
+			if err != nil {
+				reasons[desc] = err.Error()
+				notReady = append(notReady, obj)
+				continue
+			}
+
+			if failed, reason := isFailed(current); failed {
+				return errors.Errorf("%s: %s", desc, reason)
+			}
+
+			ready, reason := IsReady(current)
+			if !ready {
+				reasons[desc] = reason
+				notReady = append(notReady, obj)
+				continue
+			}
+
+			delete(reasons, desc)
+			log.Infof("%s is ready", desc)
+		}
+
+		if len(notReady) == 0 {
+			return nil
+		}
+
+		pending = notReady
+
+		if !w.nowFn().Add(w.interval).Before(deadline) {
+			var lines []string
+			for desc, reason := range reasons {
+				lines = append(lines, fmt.Sprintf("%s: %s", desc, reason))
+			}
+			sort.Strings(lines)
+
+			return errors.Errorf("%d object(s) were not ready within %s:\n%s",
+				len(lines), timeout, strings.Join(lines, "\n"))
+		}
+
+		w.sleepFn(w.interval)
+	}
+}
+
+// isReadinessChecked returns true if IsReady knows how to check the kind of
+// obj.
+func isReadinessChecked(obj *unstructured.Unstructured) bool {
+	switch obj.GetKind() {
+	case "Deployment", "StatefulSet", "Pod":
+		return true
+	default:
+		return false
+	}
+}
+
+// IsReady returns whether obj, as it is on the cluster, is ready. If it isn't,
+// the reason describes what it is waiting for. Deployments are ready once
+// their rollout is complete, StatefulSets once all of their replicas are ready
+// and updated, or only ready with the OnDelete update strategy, and Pods once
+// they are ready or have succeeded. Objects of other kinds are always ready.
+func IsReady(obj *unstructured.Unstructured) (bool, string) {
+	switch obj.GetKind() {
+	case "Deployment":
+		return isDeploymentReady(obj)
+	case "StatefulSet":
+		return isStatefulSetReady(obj)
+	case "Pod":
+		return isPodReady(obj)
+	default:
+		return true, ""
+	}
+}
+
+func isDeploymentReady(obj *unstructured.Unstructured) (bool, string) {
+	if observed := nestedInt(obj.Object, "status", "observedGeneration"); observed < obj.GetGeneration() {
+		return false, "waiting for the deployment spec update to be observed"
+	}
+
+	replicas := specReplicas(obj)
+	updated := nestedInt(obj.Object, "status", "updatedReplicas")
+	total := nestedInt(obj.Object, "status", "replicas")
+	available := nestedInt(obj.Object, "status", "availableReplicas")
+
+	switch {
+	case updated < replicas:
+		return false, fmt.Sprintf("%d of %d replicas updated", updated, replicas)
+	case total > updated:
+		return false, fmt.Sprintf("%d old replicas pending termination", total-updated)
+	case available < updated:
+		return false, fmt.Sprintf("%d of %d updated replicas available", available, updated)
+	}
+
+	return true, ""
+}
+
+func isStatefulSetReady(obj *unstructured.Unstructured) (bool, string) {
+	if observed := nestedInt(obj.Object, "status", "observedGeneration"); observed < obj.GetGeneration() {
+		return false, "waiting for the statefulset spec update to be observed"
+	}
+
+	replicas := specReplicas(obj)
+	if ready := nestedInt(obj.Object, "status", "readyReplicas"); ready < replicas {
+		return false, fmt.Sprintf("%d of %d replicas ready", ready, replicas)
+	}
+
+	// Replicas of a statefulset with the OnDelete strategy are only updated
+	// once they are deleted, so they may never reach the update revision.
+	strategy, _, _ := unstructured.NestedString(obj.Object, "spec", "updateStrategy", "type")
+	if strategy == "OnDelete" {
+		return true, ""
+	}
+
+	current, _, _ := unstructured.NestedString(obj.Object, "status", "currentRevision")
+	update, _, _ := unstructured.NestedString(obj.Object, "status", "updateRevision")
+	if update != "" && current != update {
+		return false, fmt.Sprintf("waiting for replicas to be updated to revision %s", update)
+	}
+
+	return true, ""
+}
+
+func isPodReady(obj *unstructured.Unstructured) (bool, string) {
+	phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+	switch phase {
+	case "Succeeded":
+		return true, ""
+	case "Failed":
+		return false, "pod failed"
+	}
+
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if condition["type"] == "Ready" && condition["status"] == "True" {
+			return true, ""
+		}
+	}
+
+	if phase == "" {
+		phase = "Unknown"
+	}
+
+	return false, fmt.Sprintf("pod is %s and not ready", phase)
+}
+
+// isFailed returns whether obj, as it is on the cluster, has failed and won't
+// become ready, and describes why.
+func isFailed(obj *unstructured.Unstructured) (bool, string) {
+	if obj.GetKind() != "Pod" {
+		return false, ""
+	}
+
+	phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+	if phase != "Failed" {
+		return false, ""
+	}
+
+	if message, _, _ := unstructured.NestedString(obj.Object, "status", "message"); message != "" {
+		return true, fmt.Sprintf("pod failed: %s", message)
+	}
+
+	return true, "pod failed"
+}
+
+// specReplicas returns the desired number of replicas of obj. Kubernetes
+// defaults it to one.
+func specReplicas(obj *unstructured.Unstructured) int64 {
+	if _, ok := nestedField(obj.Object, "spec", "replicas"); !ok {
+		return 1
+	}
+
+	return nestedInt(obj.Object, "spec", "replicas")
+}
+
+// nestedInt returns the integer at fields in m, or zero if it isn't set.
+// Numbers decoded from JSON may be floats.
+func nestedInt(m map[string]interface{}, fields ...string) int64 {
+	v, ok := nestedField(m, fields...)
+	if !ok {
+		return 0
+	}
+
+	switch n := v.(type) {
+	case int:
+		return int64(n)
+	case int32:
+		return int64(n)
+	case int64:
+		return n
+	case float64:
+		return int64(n)
+	default:
+		return 0
+	}
+}
+
+// nestedField returns the value at fields in m, without copying it.
+func nestedField(m map[string]interface{}, fields ...string) (interface{}, bool) {
+	var v interface{} = m
+	for _, field := range fields {
+		parent, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+
+		if v, ok = parent[field]; !ok {
+			return nil, false
+		}
+	}
+
+	return v, true
+}
@@ -0,0 +1,324 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package cluster
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func readinessObject(kind string, spec, status map[string]interface{}) *unstructured.Unstructured {
+	obj := map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       kind,
+		"metadata": map[string]interface{}{
+			"name":       "web",
+			"namespace":  "default",
+			"generation": int64(2),
+		},
+	}
+	if spec != nil {
+		obj["spec"] = spec
+	}
+	if status != nil {
+		obj["status"] = status
+	}
+
+	return &unstructured.Unstructured{Object: obj}
+}
+
+func TestIsReady(t *testing.T) {
+	cases := []struct {
+		name   string
+		obj    *unstructured.Unstructured
+		ready  bool
+		reason string
+	}{
+		{
+			name: "deployment rolled out",
+			obj: readinessObject("Deployment", map[string]interface{}{"replicas": int64(3)}, map[string]interface{}{
+				"observedGeneration": int64(2),
+				"replicas":           int64(3),
+				"updatedReplicas":    int64(3),
+				"availableReplicas":  int64(3),
+			}),
+			ready: true,
+		},
+		{
+			name: "deployment generation not observed",
+			obj: readinessObject("Deployment", map[string]interface{}{"replicas": int64(3)}, map[string]interface{}{
+				"observedGeneration": int64(1),
+			}),
+			reason: "waiting for the deployment spec update to be observed",
+		},
+		{
+			name: "deployment updating",
+			obj: readinessObject("Deployment", map[string]interface{}{"replicas": int64(3)}, map[string]interface{}{
+				"observedGeneration": int64(2),
+				"replicas":           int64(3),
+				"updatedReplicas":    int64(1),
+			}),
+			reason: "1 of 3 replicas updated",
+		},
+		{
+			name: "deployment terminating old replicas",
+			obj: readinessObject("Deployment", map[string]interface{}{"replicas": int64(3)}, map[string]interface{}{
+				"observedGeneration": int64(2),
+				"replicas":           int64(4),
+				"updatedReplicas":    int64(3),
+			}),
+			reason: "1 old replicas pending termination",
+		},
+		{
+			name: "deployment unavailable",
+			obj: readinessObject("Deployment", nil, map[string]interface{}{
+				"observedGeneration": float64(2),
+				"replicas":           float64(1),
+				"updatedReplicas":    float64(1),
+			}),
+			reason: "0 of 1 updated replicas available",
+		},
+		{
+			name: "statefulset ready",
+			obj: readinessObject("StatefulSet", map[string]interface{}{"replicas": int64(2)}, map[string]interface{}{
+				"observedGeneration": int64(2),
+				"readyReplicas":      int64(2),
+				"currentRevision":    "web-1",
+				"updateRevision":     "web-1",
+			}),
+			ready: true,
+		},
+		{
+			name: "statefulset not ready",
+			obj: readinessObject("StatefulSet", map[string]interface{}{"replicas": int64(2)}, map[string]interface{}{
+				"observedGeneration": int64(2),
+				"readyReplicas":      int64(1),
+			}),
+			reason: "1 of 2 replicas ready",
+		},
+		{
+			name: "statefulset updating",
+			obj: readinessObject("StatefulSet", map[string]interface{}{"replicas": int64(2)}, map[string]interface{}{
+				"observedGeneration": int64(2),
+				"readyReplicas":      int64(2),
+				"currentRevision":    "web-1",
+				"updateRevision":     "web-2",
+			}),
+			reason: "waiting for replicas to be updated to revision web-2",
+		},
+		{
+			name: "statefulset updated on delete",
+			obj: readinessObject("StatefulSet", map[string]interface{}{
+				"replicas":       int64(2),
+				"updateStrategy": map[string]interface{}{"type": "OnDelete"},
+			}, map[string]interface{}{
+				"observedGeneration": int64(2),
+				"readyReplicas":      int64(2),
+				"currentRevision":    "web-1",
+				"updateRevision":     "web-2",
+			}),
+			ready: true,
+		},
+		{
+			name: "pod ready",
+			obj: readinessObject("Pod", nil, map[string]interface{}{
+				"phase": "Running",
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Ready", "status": "True"},
+				},
+			}),
+			ready: true,
+		},
+		{
+			name: "pod succeeded",
+			obj: readinessObject("Pod", nil, map[string]interface{}{
+				"phase": "Succeeded",
+			}),
+			ready: true,
+		},
+		{
+			name: "pod pending",
+			obj: readinessObject("Pod", nil, map[string]interface{}{
+				"phase": "Pending",
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Ready", "status": "False"},
+				},
+			}),
+			reason: "pod is Pending and not ready",
+		},
+		{
+			name: "pod failed",
+			obj: readinessObject("Pod", nil, map[string]interface{}{
+				"phase": "Failed",
+			}),
+			reason: "pod failed",
+		},
+		{
+			name:  "other kind",
+			obj:   readinessObject("ConfigMap", nil, nil),
+			ready: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ready, reason := IsReady(tc.obj)
+			assert.Equal(t, tc.ready, ready)
+			assert.Equal(t, tc.reason, reason)
+		})
+	}
+}
+
+func Test_readinessWaiter(t *testing.T) {
+	notReady := readinessObject("Deployment", map[string]interface{}{"replicas": int64(1)}, map[string]interface{}{
+		"observedGeneration": int64(2),
+		"replicas":           int64(1),
+		"updatedReplicas":    int64(1),
+	})
+	ready := readinessObject("Deployment", map[string]interface{}{"replicas": int64(1)}, map[string]interface{}{
+		"observedGeneration": int64(2),
+		"replicas":           int64(1),
+		"updatedReplicas":    int64(1),
+		"availableReplicas":  int64(1),
+	})
+	configMap := readinessObject("ConfigMap", nil, nil)
+
+	cases := []struct {
+		name    string
+		timeout time.Duration
+		readyAt int
+		getErr  error
+		gets    int
+		err     string
+	}{
+		{
+			name:    "ready",
+			timeout: time.Minute,
+			readyAt: 1,
+			gets:    1,
+		},
+		{
+			name:    "ready after polling",
+			timeout: time.Minute,
+			readyAt: 3,
+			gets:    3,
+		},
+		{
+			name:    "timed out",
+			timeout: 5 * time.Second,
+			gets:    3,
+			err:     "1 object(s) were not ready within 5s:\nDeployment default.web: 0 of 1 updated replicas available",
+		},
+		{
+			name:    "get fails",
+			timeout: 3 * time.Second,
+			getErr:  errors.New("connection refused"),
+			gets:    2,
+			err:     "1 object(s) were not ready within 3s:\nDeployment default.web: connection refused",
+		},
+		{
+			name: "no timeout",
+			err:  "waiting for objects to be ready requires a positive timeout",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			now := time.Date(2018, 6, 1, 0, 0, 0, 0, time.UTC)
+
+			gets := 0
+			w := &readinessWaiter{
+				get: func(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+					require.Equal(t, "Deployment", obj.GetKind(), "only checked kinds should be polled")
+
+					gets++
+					if tc.getErr != nil {
+						return nil, tc.getErr
+					}
+					if gets == tc.readyAt {
+						return ready, nil
+					}
+					return notReady, nil
+				},
+				interval: 2 * time.Second,
+				nowFn:    func() time.Time { return now },
+				sleepFn:  func(d time.Duration) { now = now.Add(d) },
+			}
+
+			err := w.wait([]*unstructured.Unstructured{configMap, notReady}, tc.timeout)
+			if tc.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, tc.err, err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tc.gets, gets)
+		})
+	}
+}
+
+func Test_readinessWaiter_failed(t *testing.T) {
+	pod := readinessObject("Pod", nil, nil)
+
+	cases := []struct {
+		name   string
+		status map[string]interface{}
+		err    string
+	}{
+		{
+			name:   "failed",
+			status: map[string]interface{}{"phase": "Failed"},
+			err:    "Pod default.web: pod failed",
+		},
+		{
+			name: "failed with message",
+			status: map[string]interface{}{
+				"phase":   "Failed",
+				"message": "Pod ephemeral local storage usage exceeds the total limit",
+			},
+			err: "Pod default.web: pod failed: Pod ephemeral local storage usage exceeds the total limit",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			now := time.Date(2018, 6, 1, 0, 0, 0, 0, time.UTC)
+
+			gets := 0
+			w := &readinessWaiter{
+				get: func(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+					gets++
+					return readinessObject("Pod", nil, tc.status), nil
+				},
+				interval: 2 * time.Second,
+				nowFn:    func() time.Time { return now },
+				sleepFn:  func(d time.Duration) { now = now.Add(d) },
+			}
+
+			err := w.wait([]*unstructured.Unstructured{pod}, time.Minute)
+			require.Error(t, err)
+			assert.Equal(t, tc.err, err.Error())
+
+			assert.Equal(t, 1, gets, "failed pods shouldn't be polled again")
+		})
+	}
+}
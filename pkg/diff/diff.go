@@ -22,7 +22,6 @@ import (
 	"github.com/ksonnet/ksonnet/pkg/app"
 	"github.com/ksonnet/ksonnet/pkg/client"
 	"github.com/ksonnet/ksonnet/pkg/cluster"
-	"github.com/ksonnet/ksonnet/pkg/pipeline"
 	"github.com/pkg/errors"
 	godiff "github.com/shazow/go-diff"
 	"github.com/sirupsen/logrus"
@@ -37,6 +36,8 @@ type Differ struct {
 
 	localGen  yamlGenerator
 	remoteGen yamlGenerator
+
+	liveObjectsFn func(*Location, []string) ([]*unstructured.Unstructured, error)
 }
 
 // DefaultDiff runs diff with default options.
@@ -56,6 +57,8 @@ func New(a app.App, config *client.Config, components []string) *Differ {
 		Components: components,
 		localGen:   yl,
 		remoteGen:  yr,
+
+		liveObjectsFn: yr.LiveObjects,
 	}
 
 	return d
@@ -87,6 +90,15 @@ func (d *Differ) Diff(location1, location2 *Location) (io.Reader, error) {
 }
 
 func (d *Differ) toYAML(location *Location) (io.ReadSeeker, error) {
+	gen, err := d.generator(location)
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.Generate(location, d.Components)
+}
+
+func (d *Differ) generator(location *Location) (yamlGenerator, error) {
 	if err := location.Err(); err != nil {
 		return nil, err
 	}
@@ -95,14 +107,15 @@ func (d *Differ) toYAML(location *Location) (io.ReadSeeker, error) {
 	default:
 		return nil, errors.Errorf("unknown destation %q", location.Destination())
 	case "local":
-		return d.localGen.Generate(location, d.Components)
+		return d.localGen, nil
 	case "remote":
-		return d.remoteGen.Generate(location, d.Components)
+		return d.remoteGen, nil
 	}
 }
 
 type yamlGenerator interface {
 	Generate(*Location, []string) (io.ReadSeeker, error)
+	Objects(*Location, []string) ([]*unstructured.Unstructured, error)
 }
 
 type yamlLocal struct {
@@ -114,27 +127,19 @@ type yamlLocal struct {
 func newYamlLocal(a app.App) *yamlLocal {
 	return &yamlLocal{
 		app:              a,
-		collectObjectsFn: localCollectObjects,
+		collectObjectsFn: cluster.Render,
 		showFn:           cluster.ShowYAML,
 	}
 }
 
-func localCollectObjects(a app.App, envName string, componentNames []string) ([]*unstructured.Unstructured, error) {
-	p := pipeline.New(a, envName)
-	return p.Objects(componentNames)
-}
-
 func (yl *yamlLocal) Generate(location *Location, components []string) (io.ReadSeeker, error) {
 	var buf bytes.Buffer
 
-	objects, err := yl.collectObjectsFn(yl.app, location.EnvName(), components)
+	objects, err := yl.Objects(location, components)
 	if err != nil {
 		return nil, err
-
 	}
 
-	cluster.UnstructuredSlice(objects).Sort()
-
 	if err := yl.showFn(&buf, objects); err != nil {
 		return nil, err
 	}
@@ -148,6 +153,8 @@ type yamlRemote struct {
 	genClientsFn     func(a app.App, clientConfig *client.Config, envName string) (cluster.Clients, error)
 	collectObjectsFn func(string, cluster.Clients, []string) ([]*unstructured.Unstructured, error)
 	showFn           func(io.Writer, []*unstructured.Unstructured) error
+
+	collectLiveObjectsFn func(string, cluster.Clients, []string) ([]*unstructured.Unstructured, error)
 }
 
 func newYamlRemote(a app.App, config *client.Config) *yamlRemote {
@@ -157,12 +164,50 @@ func newYamlRemote(a app.App, config *client.Config) *yamlRemote {
 		genClientsFn:     cluster.GenClients,
 		collectObjectsFn: cluster.CollectObjects,
 		showFn:           cluster.ShowYAML,
+
+		collectLiveObjectsFn: cluster.CollectLiveObjects,
+	}
+}
+
+func (yl *yamlLocal) Objects(location *Location, components []string) ([]*unstructured.Unstructured, error) {
+	objects, err := yl.collectObjectsFn(yl.app, location.EnvName(), components)
+	if err != nil {
+		return nil, err
+
 	}
+
+	cluster.UnstructuredSlice(objects).Sort()
+
+	return objects, nil
 }
 
 func (yr *yamlRemote) Generate(location *Location, components []string) (io.ReadSeeker, error) {
 	var buf bytes.Buffer
 
+	objects, err := yr.Objects(location, components)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := yr.showFn(&buf, objects); err != nil {
+		return nil, err
+	}
+
+	return bytes.NewReader(buf.Bytes()), nil
+}
+
+func (yr *yamlRemote) Objects(location *Location, components []string) ([]*unstructured.Unstructured, error) {
+	return yr.collect(location, components, yr.collectObjectsFn)
+}
+
+// LiveObjects returns the objects as they currently are in the cluster,
+// rather than as ksonnet last applied them.
+func (yr *yamlRemote) LiveObjects(location *Location, components []string) ([]*unstructured.Unstructured, error) {
+	return yr.collect(location, components, yr.collectLiveObjectsFn)
+}
+
+func (yr *yamlRemote) collect(location *Location, components []string,
+	collectFn func(string, cluster.Clients, []string) ([]*unstructured.Unstructured, error)) ([]*unstructured.Unstructured, error) {
 	environment, err := yr.app.Environment(location.EnvName())
 	if err != nil {
 		return nil, err
@@ -174,16 +219,12 @@ func (yr *yamlRemote) Generate(location *Location, components []string) (io.Read
 		return nil, errors.Wrapf(err, "creating client for environment: %s", location.EnvName())
 	}
 
-	objects, err := yr.collectObjectsFn(environment.Destination.Namespace, clients, components)
+	objects, err := collectFn(environment.Destination.Namespace, clients, components)
 	if err != nil {
 		return nil, err
 	}
 
 	cluster.UnstructuredSlice(objects).Sort()
 
-	if err := yr.showFn(&buf, objects); err != nil {
-		return nil, err
-	}
-
-	return bytes.NewReader(buf.Bytes()), nil
+	return objects, nil
 }
@@ -35,8 +35,13 @@ import (
 )
 
 type fakeYamlGenerator struct {
-	b   []byte
-	err error
+	b       []byte
+	objects []*unstructured.Unstructured
+	err     error
+}
+
+func (fyg *fakeYamlGenerator) Objects(l *Location, components []string) ([]*unstructured.Unstructured, error) {
+	return fyg.objects, fyg.err
 }
 
 func (fyg *fakeYamlGenerator) Generate(l *Location, components []string) (io.ReadSeeker, error) {
@@ -0,0 +1,136 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package diff
+
+import (
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// DefaultDrifted finds drifted objects with default options.
+func DefaultDrifted(a app.App, config *client.Config, components []string, l1 *Location, l2 *Location) ([]*unstructured.Unstructured, error) {
+	differ := New(a, config, components)
+	return differ.Drifted(l2, l1)
+}
+
+// Drifted returns the objects in location2 that don't exist in location1, or
+// that differ from their counterparts in location1. Applying these objects
+// to location1 removes the drift.
+func (d *Differ) Drifted(location1, location2 *Location) ([]*unstructured.Unstructured, error) {
+	current, err := d.objects(location1)
+	if err != nil {
+		return nil, err
+	}
+
+	desired, err := d.objects(location2)
+	if err != nil {
+		return nil, err
+	}
+
+	var drifted []*unstructured.Unstructured
+	for _, obj := range desired {
+		p, err := createPatch(findObject(current, obj), obj)
+		if err != nil {
+			return nil, errors.Wrapf(err, "creating patch for %s %s", obj.GetKind(), obj.GetName())
+		}
+
+		if p != nil {
+			drifted = append(drifted, obj)
+		}
+	}
+
+	return drifted, nil
+}
+
+// States of objects that drifted, as reported by Verify.
+const (
+	// DriftMissing is the state of objects that don't exist in the cluster.
+	DriftMissing = "missing"
+	// DriftOutOfSync is the state of objects that differ from their
+	// counterparts in the cluster.
+	DriftOutOfSync = "out of sync"
+	// DriftUnexpected is the state of objects in the cluster that are managed
+	// by ksonnet, but aren't generated by the components anymore.
+	DriftUnexpected = "unexpected"
+)
+
+// ObjectDrift is an object that drifted between an environment's components
+// and its cluster.
+type ObjectDrift struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+	State      string `json:"state"`
+}
+
+// DefaultVerify compares the objects of an environment's components with the
+// objects in its cluster with default options.
+func DefaultVerify(a app.App, config *client.Config, components []string, envName string) ([]ObjectDrift, error) {
+	differ := New(a, config, components)
+	return differ.Verify(NewLocation("remote:"+envName), NewLocation("local:"+envName))
+}
+
+// Verify returns the objects in location2 that don't exist in location1 or
+// that differ from their counterparts in location1, followed by the objects
+// in location1 that don't exist in location2.
+func (d *Differ) Verify(location1, location2 *Location) ([]ObjectDrift, error) {
+	current, err := d.objects(location1)
+	if err != nil {
+		return nil, err
+	}
+
+	desired, err := d.objects(location2)
+	if err != nil {
+		return nil, err
+	}
+
+	var drifts []ObjectDrift
+	for _, obj := range desired {
+		p, err := createPatch(findObject(current, obj), obj)
+		if err != nil {
+			return nil, errors.Wrapf(err, "creating patch for %s %s", obj.GetKind(), obj.GetName())
+		}
+
+		switch {
+		case p == nil:
+		case p.Operation == PatchOperationCreate:
+			drifts = append(drifts, newObjectDrift(obj, DriftMissing))
+		default:
+			drifts = append(drifts, newObjectDrift(obj, DriftOutOfSync))
+		}
+	}
+
+	for _, obj := range current {
+		if findObject(desired, obj) == nil {
+			drifts = append(drifts, newObjectDrift(obj, DriftUnexpected))
+		}
+	}
+
+	return drifts, nil
+}
+
+func newObjectDrift(obj *unstructured.Unstructured, state string) ObjectDrift {
+	return ObjectDrift{
+		APIVersion: obj.GetAPIVersion(),
+		Kind:       obj.GetKind(),
+		Namespace:  obj.GetNamespace(),
+		Name:       obj.GetName(),
+		State:      state,
+	}
+}
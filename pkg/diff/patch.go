@@ -0,0 +1,188 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package diff
+
+import (
+	"encoding/json"
+	"io"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// PatchOperationCreate is the operation for objects that don't exist yet.
+	PatchOperationCreate = "create"
+	// PatchOperationPatch is the operation for objects that exist and differ.
+	PatchOperationPatch = "patch"
+)
+
+// Patch is the change needed to turn an object into its desired state. The
+// patch is a JSON merge patch containing the fields of the desired object
+// that differ. Fields that are only present in the existing object are kept,
+// as they are by `ks apply`. For objects that don't exist yet, the patch is
+// the desired object.
+type Patch struct {
+	APIVersion string          `json:"apiVersion"`
+	Kind       string          `json:"kind"`
+	Namespace  string          `json:"namespace,omitempty"`
+	Name       string          `json:"name"`
+	Operation  string          `json:"operation"`
+	Patch      json.RawMessage `json:"patch"`
+}
+
+// DefaultPatches generates patches with default options.
+func DefaultPatches(a app.App, config *client.Config, components []string, l1 *Location, l2 *Location) ([]Patch, error) {
+	differ := New(a, config, components)
+	return differ.Patches(l2, l1)
+}
+
+// Patches generates the patches that turn the objects in location1 into the
+// objects in location2. Objects that are unchanged have no patch.
+func (d *Differ) Patches(location1, location2 *Location) ([]Patch, error) {
+	current, err := d.objects(location1)
+	if err != nil {
+		return nil, err
+	}
+
+	desired, err := d.objects(location2)
+	if err != nil {
+		return nil, err
+	}
+
+	var patches []Patch
+	for _, obj := range desired {
+		p, err := createPatch(findObject(current, obj), obj)
+		if err != nil {
+			return nil, errors.Wrapf(err, "creating patch for %s %s", obj.GetKind(), obj.GetName())
+		}
+
+		if p != nil {
+			patches = append(patches, *p)
+		}
+	}
+
+	return patches, nil
+}
+
+// ReadPatches reads patches written by `ks diff --output=patch`. Every patch
+// must identify its object and have a known operation.
+func ReadPatches(r io.Reader) ([]Patch, error) {
+	var patches []Patch
+	if err := json.NewDecoder(r).Decode(&patches); err != nil {
+		return nil, errors.Wrap(err, "decoding patches")
+	}
+
+	for i, p := range patches {
+		if p.APIVersion == "" || p.Kind == "" || p.Name == "" {
+			return nil, errors.Errorf("patch %d doesn't identify the apiVersion, kind and name of its object", i)
+		}
+
+		switch p.Operation {
+		case PatchOperationCreate, PatchOperationPatch:
+		default:
+			return nil, errors.Errorf("patch %d for %s %s has unknown operation %q", i, p.Kind, p.Name, p.Operation)
+		}
+
+		if len(p.Patch) == 0 {
+			return nil, errors.Errorf("patch %d for %s %s is empty", i, p.Kind, p.Name)
+		}
+	}
+
+	return patches, nil
+}
+
+func (d *Differ) objects(location *Location) ([]*unstructured.Unstructured, error) {
+	gen, err := d.generator(location)
+	if err != nil {
+		return nil, err
+	}
+
+	return gen.Objects(location, d.Components)
+}
+
+// findObject finds the object with the same group, kind and name as obj. The
+// namespace is only compared if both objects have one, because it is set
+// when the object is applied.
+func findObject(objects []*unstructured.Unstructured, obj *unstructured.Unstructured) *unstructured.Unstructured {
+	gk := obj.GroupVersionKind().GroupKind()
+	for _, candidate := range objects {
+		if candidate.GroupVersionKind().GroupKind() != gk || candidate.GetName() != obj.GetName() {
+			continue
+		}
+
+		if ns := obj.GetNamespace(); ns != "" && candidate.GetNamespace() != "" && candidate.GetNamespace() != ns {
+			continue
+		}
+
+		return candidate
+	}
+
+	return nil
+}
+
+func createPatch(current, desired *unstructured.Unstructured) (*Patch, error) {
+	desiredJSON, err := desired.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Patch{
+		APIVersion: desired.GetAPIVersion(),
+		Kind:       desired.GetKind(),
+		Namespace:  desired.GetNamespace(),
+		Name:       desired.GetName(),
+	}
+
+	if current == nil {
+		p.Operation = PatchOperationCreate
+		p.Patch = desiredJSON
+		return p, nil
+	}
+
+	if p.Namespace == "" {
+		p.Namespace = current.GetNamespace()
+	}
+
+	currentJSON, err := current.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	// Applying the desired object as a merge patch is what `ks apply` does.
+	// The difference between the result and the current object is the
+	// smallest patch with the same effect.
+	merged, err := jsonpatch.MergePatch(currentJSON, desiredJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	patch, err := jsonpatch.CreateMergePatch(currentJSON, merged)
+	if err != nil {
+		return nil, err
+	}
+
+	if string(patch) == "{}" {
+		return nil, nil
+	}
+
+	p.Operation = PatchOperationPatch
+	p.Patch = patch
+	return p, nil
+}
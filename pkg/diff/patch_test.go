@@ -0,0 +1,167 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package diff
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/ksonnet/ksonnet/pkg/util/test"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestDiffer_Patches(t *testing.T) {
+	deployment := func(namespace string, replicas int64, withStatus bool) *unstructured.Unstructured {
+		obj := map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"name": "guestbook",
+			},
+			"spec": map[string]interface{}{
+				"replicas": replicas,
+			},
+		}
+		u := &unstructured.Unstructured{Object: obj}
+		if namespace != "" {
+			u.SetNamespace(namespace)
+		}
+		if withStatus {
+			obj["status"] = map[string]interface{}{"readyReplicas": int64(1)}
+		}
+		return u
+	}
+
+	service := func(namespace string) *unstructured.Unstructured {
+		u := &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Service",
+				"metadata": map[string]interface{}{
+					"name": "guestbook",
+				},
+			},
+		}
+		if namespace != "" {
+			u.SetNamespace(namespace)
+		}
+		return u
+	}
+
+	configMap := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]interface{}{
+				"name": "settings",
+			},
+		},
+	}
+
+	test.WithApp(t, "/", func(appMock *mocks.App, fs afero.Fs) {
+		differ := New(appMock, &client.Config{}, []string{})
+
+		differ.localGen = &fakeYamlGenerator{
+			objects: []*unstructured.Unstructured{
+				deployment("", 3, false),
+				service(""),
+				configMap,
+			},
+		}
+		differ.remoteGen = &fakeYamlGenerator{
+			objects: []*unstructured.Unstructured{
+				deployment("default", 1, true),
+				service("default"),
+			},
+		}
+
+		patches, err := differ.Patches(NewLocation("remote:default"), NewLocation("local:default"))
+		require.NoError(t, err)
+
+		require.Len(t, patches, 2)
+
+		assert.Equal(t, "Deployment", patches[0].Kind)
+		assert.Equal(t, "default", patches[0].Namespace)
+		assert.Equal(t, PatchOperationPatch, patches[0].Operation)
+		assert.JSONEq(t, `{"spec":{"replicas":3}}`, string(patches[0].Patch))
+
+		assert.Equal(t, "ConfigMap", patches[1].Kind)
+		assert.Equal(t, PatchOperationCreate, patches[1].Operation)
+		assert.JSONEq(t, `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"settings"}}`, string(patches[1].Patch))
+	})
+}
+
+func TestReadPatches(t *testing.T) {
+	cases := []struct {
+		name     string
+		in       string
+		expected []Patch
+		isErr    bool
+	}{
+		{
+			name: "valid",
+			in:   `[{"apiVersion":"v1","kind":"ConfigMap","namespace":"default","name":"cm","operation":"patch","patch":{"data":{"a":"b"}}}]`,
+			expected: []Patch{
+				{
+					APIVersion: "v1",
+					Kind:       "ConfigMap",
+					Namespace:  "default",
+					Name:       "cm",
+					Operation:  PatchOperationPatch,
+					Patch:      []byte(`{"data":{"a":"b"}}`),
+				},
+			},
+		},
+		{
+			name:  "invalid json",
+			in:    `{`,
+			isErr: true,
+		},
+		{
+			name:  "missing name",
+			in:    `[{"apiVersion":"v1","kind":"ConfigMap","operation":"create","patch":{}}]`,
+			isErr: true,
+		},
+		{
+			name:  "unknown operation",
+			in:    `[{"apiVersion":"v1","kind":"ConfigMap","name":"cm","operation":"delete","patch":{}}]`,
+			isErr: true,
+		},
+		{
+			name:  "missing patch",
+			in:    `[{"apiVersion":"v1","kind":"ConfigMap","name":"cm","operation":"patch"}]`,
+			isErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ReadPatches(strings.NewReader(tc.in))
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package diff
+
+import (
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/pkg/errors"
+)
+
+// Summary counts the objects that differ between two locations.
+type Summary struct {
+	Created  int `json:"created"`
+	Modified int `json:"modified"`
+	Deleted  int `json:"deleted"`
+}
+
+// Changed returns true if any object differs.
+func (s Summary) Changed() bool {
+	return s.Created+s.Modified+s.Deleted > 0
+}
+
+// DefaultSummary summarizes differences with default options.
+func DefaultSummary(a app.App, config *client.Config, components []string, l1 *Location, l2 *Location) (Summary, error) {
+	differ := New(a, config, components)
+	return differ.Summarize(l2, l1)
+}
+
+// Summarize counts the objects that have to be created, modified or deleted
+// to turn the objects in location1 into the objects in location2.
+func (d *Differ) Summarize(location1, location2 *Location) (Summary, error) {
+	var s Summary
+
+	current, err := d.objects(location1)
+	if err != nil {
+		return s, err
+	}
+
+	desired, err := d.objects(location2)
+	if err != nil {
+		return s, err
+	}
+
+	for _, obj := range desired {
+		p, err := createPatch(findObject(current, obj), obj)
+		if err != nil {
+			return s, errors.Wrapf(err, "creating patch for %s %s", obj.GetKind(), obj.GetName())
+		}
+
+		switch {
+		case p == nil:
+		case p.Operation == PatchOperationCreate:
+			s.Created++
+		default:
+			s.Modified++
+		}
+	}
+
+	for _, obj := range current {
+		if findObject(desired, obj) == nil {
+			s.Deleted++
+		}
+	}
+
+	return s, nil
+}
@@ -0,0 +1,71 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package diff
+
+import (
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/ksonnet/ksonnet/pkg/util/test"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestDiffer_Summarize(t *testing.T) {
+	object := func(kind, name string, replicas int64) *unstructured.Unstructured {
+		return &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       kind,
+				"metadata": map[string]interface{}{
+					"name": name,
+				},
+				"spec": map[string]interface{}{
+					"replicas": replicas,
+				},
+			},
+		}
+	}
+
+	test.WithApp(t, "/", func(appMock *mocks.App, fs afero.Fs) {
+		differ := New(appMock, &client.Config{}, []string{})
+
+		differ.localGen = &fakeYamlGenerator{
+			objects: []*unstructured.Unstructured{
+				object("ReplicationController", "changed", 3),
+				object("ReplicationController", "same", 1),
+				object("ConfigMap", "new", 0),
+			},
+		}
+		differ.remoteGen = &fakeYamlGenerator{
+			objects: []*unstructured.Unstructured{
+				object("ReplicationController", "changed", 1),
+				object("ReplicationController", "same", 1),
+				object("Service", "old", 0),
+			},
+		}
+
+		summary, err := differ.Summarize(NewLocation("remote:default"), NewLocation("local:default"))
+		require.NoError(t, err)
+
+		assert.Equal(t, Summary{Created: 1, Modified: 1, Deleted: 1}, summary)
+		assert.True(t, summary.Changed())
+		assert.False(t, Summary{}.Changed())
+	})
+}
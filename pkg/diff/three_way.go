@@ -0,0 +1,200 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package diff
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/ksonnet/ksonnet/pkg/cluster"
+	"github.com/pkg/errors"
+	godiff "github.com/shazow/go-diff"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/jsonmergepatch"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/kubernetes/pkg/kubectl/scheme"
+)
+
+// DefaultThreeWayDiff diffs two locations using a three-way merge against the
+// configuration ksonnet last applied.
+func DefaultThreeWayDiff(a app.App, config *client.Config, components []string, l1 *Location, l2 *Location) (io.Reader, error) {
+	differ := New(a, config, components)
+	return differ.ThreeWayDiff(l2, l1)
+}
+
+// ThreeWayDiff diffs the live objects in a remote location against the
+// objects they would become if a local location was applied. Like
+// `kubectl diff`, fields which were set outside of ksonnet and which ksonnet
+// would not change aren't reported. Objects without a last applied
+// configuration are compared two-way. If the locations aren't one local and
+// one remote location, it is the same as Diff.
+func (d *Differ) ThreeWayDiff(location1, location2 *Location) (io.Reader, error) {
+	if location1.Err() != nil || location2.Err() != nil ||
+		location1.Destination() == location2.Destination() {
+		return d.Diff(location1, location2)
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"src1": location1.String(),
+		"src2": location2.String(),
+	}).Debug("generating three-way diff")
+
+	remote, local := location1, location2
+	if remote.Destination() != "remote" {
+		remote, local = local, remote
+	}
+
+	current, err := d.liveObjectsFn(remote, d.Components)
+	if err != nil {
+		return nil, err
+	}
+
+	desired, err := d.objects(local)
+	if err != nil {
+		return nil, err
+	}
+
+	var projected []*unstructured.Unstructured
+	for _, obj := range desired {
+		p, err := projectObject(findObject(current, obj), obj)
+		if err != nil {
+			return nil, errors.Wrapf(err, "merging %s %s", obj.GetKind(), obj.GetName())
+		}
+
+		projected = append(projected, p)
+	}
+
+	// Objects which only exist in the cluster are left as they are on the
+	// local side, so they are reported as removed.
+	for _, obj := range current {
+		if findObject(desired, obj) == nil {
+			projected = append(projected, obj)
+		}
+	}
+
+	cluster.UnstructuredSlice(current).Sort()
+	cluster.UnstructuredSlice(projected).Sort()
+
+	r1, err := showObjects(current)
+	if err != nil {
+		return nil, err
+	}
+
+	r2, err := showObjects(projected)
+	if err != nil {
+		return nil, err
+	}
+
+	if remote != location1 {
+		r1, r2 = r2, r1
+	}
+
+	var buf bytes.Buffer
+	if err := godiff.DefaultDiffer().Diff(&buf, r1, r2); err != nil {
+		return nil, err
+	}
+
+	return &buf, nil
+}
+
+func showObjects(objects []*unstructured.Unstructured) (io.ReadSeeker, error) {
+	var buf bytes.Buffer
+	if err := cluster.ShowYAML(&buf, objects); err != nil {
+		return nil, err
+	}
+
+	return bytes.NewReader(buf.Bytes()), nil
+}
+
+// projectObject returns the current object as it would be after applying the
+// desired object.
+func projectObject(current, desired *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	if current == nil {
+		return desired, nil
+	}
+
+	original, err := cluster.OriginalObject(current)
+	if err != nil {
+		return nil, err
+	}
+
+	if original == nil {
+		return desired, nil
+	}
+
+	originalJSON, err := json.Marshal(original)
+	if err != nil {
+		return nil, err
+	}
+
+	desiredJSON, err := desired.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	currentJSON, err := current.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	var projectedJSON []byte
+
+	// This mirrors how `ks apply` patches existing objects: strategic merge
+	// for built-in types and JSON merge for everything else.
+	versionedObject, err := scheme.Scheme.New(current.GroupVersionKind())
+	switch {
+	case runtime.IsNotRegisteredError(err):
+		patch, err := jsonmergepatch.CreateThreeWayJSONMergePatch(originalJSON, desiredJSON, currentJSON)
+		if err != nil {
+			return nil, err
+		}
+
+		projectedJSON, err = jsonpatch.MergePatch(currentJSON, patch)
+		if err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	default:
+		lookupPatchMeta, err := strategicpatch.NewPatchMetaFromStruct(versionedObject)
+		if err != nil {
+			return nil, err
+		}
+
+		patch, err := strategicpatch.CreateThreeWayMergePatch(originalJSON, desiredJSON, currentJSON, lookupPatchMeta, true)
+		if err != nil {
+			return nil, err
+		}
+
+		projectedJSON, err = strategicpatch.StrategicMergePatch(currentJSON, patch, versionedObject)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	projected := &unstructured.Unstructured{}
+	if err := projected.UnmarshalJSON(projectedJSON); err != nil {
+		return nil, err
+	}
+
+	return projected, nil
+}
@@ -0,0 +1,190 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package diff
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/ksonnet/ksonnet/pkg/client"
+	"github.com/ksonnet/ksonnet/pkg/util/test"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestDiffer_ThreeWayDiff(t *testing.T) {
+	deployment := func(image string, replicas int64) *unstructured.Unstructured {
+		spec := map[string]interface{}{
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{
+							"name":  "guestbook",
+							"image": image,
+						},
+					},
+				},
+			},
+		}
+		if replicas > 0 {
+			spec["replicas"] = replicas
+		}
+
+		return &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata": map[string]interface{}{
+					"name":      "guestbook",
+					"namespace": "default",
+				},
+				"spec": spec,
+			},
+		}
+	}
+
+	widget := func(size string, color string) *unstructured.Unstructured {
+		spec := map[string]interface{}{
+			"size": size,
+		}
+		if color != "" {
+			spec["color"] = color
+		}
+
+		return &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "example.com/v1",
+				"kind":       "Widget",
+				"metadata": map[string]interface{}{
+					"name":      "widget",
+					"namespace": "default",
+				},
+				"spec": spec,
+			},
+		}
+	}
+
+	configMap := func(value string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata": map[string]interface{}{
+					"name":      "settings",
+					"namespace": "default",
+				},
+				"data": map[string]interface{}{
+					"key": value,
+				},
+			},
+		}
+	}
+
+	// The replicas of the deployment and the color of the widget were set
+	// outside of ksonnet, so they aren't part of the last applied
+	// configuration. The config map has no last applied configuration.
+	live := []*unstructured.Unstructured{
+		withOriginal(t, deployment("nginx:1", 5), deployment("nginx:1", 0)),
+		withOriginal(t, widget("small", "blue"), widget("small", "")),
+		configMap("old"),
+	}
+
+	desired := []*unstructured.Unstructured{
+		deployment("nginx:2", 0),
+		widget("large", ""),
+		configMap("new"),
+	}
+
+	test.WithApp(t, "/", func(appMock *mocks.App, fs afero.Fs) {
+		differ := New(appMock, &client.Config{}, []string{})
+		differ.localGen = &fakeYamlGenerator{objects: desired}
+		differ.liveObjectsFn = func(*Location, []string) ([]*unstructured.Unstructured, error) {
+			return live, nil
+		}
+
+		r, err := differ.ThreeWayDiff(NewLocation("remote:default"), NewLocation("local:default"))
+		require.NoError(t, err)
+
+		b, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+
+		var changes []string
+		for _, line := range strings.Split(string(b), "\n") {
+			if strings.HasPrefix(line, "+") || strings.HasPrefix(line, "-") {
+				changes = append(changes, strings.Join(strings.Fields(line), " "))
+			}
+		}
+
+		for _, change := range changes {
+			assert.NotContains(t, change, "replicas")
+			assert.NotContains(t, change, "color")
+		}
+
+		assert.Contains(t, changes, "- key: old")
+		assert.Contains(t, changes, "+ key: new")
+		assert.Contains(t, changes, "- - image: nginx:1")
+		assert.Contains(t, changes, "+ - image: nginx:2")
+		assert.Contains(t, changes, "- size: small")
+		assert.Contains(t, changes, "+ size: large")
+	})
+}
+
+func TestDiffer_ThreeWayDiff_same_destination(t *testing.T) {
+	test.WithApp(t, "/", func(appMock *mocks.App, fs afero.Fs) {
+		differ := New(appMock, &client.Config{}, []string{})
+		differ.localGen = &fakeYamlGenerator{b: []byte("a: 1\n")}
+		differ.liveObjectsFn = func(*Location, []string) ([]*unstructured.Unstructured, error) {
+			t.Fatal("live objects should not be collected")
+			return nil, nil
+		}
+
+		r, err := differ.ThreeWayDiff(NewLocation("local:default"), NewLocation("local:other"))
+		require.NoError(t, err)
+
+		b, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+		assert.Empty(t, string(b))
+	})
+}
+
+// withOriginal records original as the configuration ksonnet last applied
+// to obj.
+func withOriginal(t *testing.T, obj, original *unstructured.Unstructured) *unstructured.Unstructured {
+	b, err := json.Marshal(original.Object)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err = gz.Write(b)
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+
+	annotation, err := json.Marshal(map[string]string{
+		"pristine": base64.StdEncoding.EncodeToString(buf.Bytes()),
+	})
+	require.NoError(t, err)
+
+	obj.SetAnnotations(map[string]string{"ksonnet.io/managed": string(annotation)})
+	return obj
+}
@@ -0,0 +1,277 @@
+// Copyright 2018 The kubecfg authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package env
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/ksonnet/ksonnet/pkg/pkg"
+	utilio "github.com/ksonnet/ksonnet/pkg/util/io"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/afero"
+)
+
+// Clone copies environment srcName of app src to app dst as dstName, along
+// with its directory, the ksonnet-lib generated for its Kubernetes version
+// and the vendored packages of its libraries, unless dst already has them.
+// The kubeconfig context and provenance of the environment are not copied,
+// because they belong to whoever created it. Both apps must be on the same
+// file system.
+func Clone(src app.App, srcName string, dst app.App, dstName string) error {
+	srcEnv, err := src.Environment(srcName)
+	if err != nil {
+		return err
+	}
+
+	if err := ValidateName(dstName); err != nil {
+		return err
+	}
+
+	if _, err = dst.Environment(dstName); err == nil {
+		return errors.Errorf("environment %q already exists", dstName)
+	}
+
+	fs := dst.Fs()
+	dstDir := filepath.Join(dst.Root(), envRootName, filepath.FromSlash(dstName))
+	if exists, _ := afero.Exists(fs, dstDir); exists {
+		return errors.Errorf("environment directory %s already exists", dstDir)
+	}
+
+	log.Infof("Cloning environment %q of %s to %q", srcName, src.Root(), dstName)
+
+	if err = copyEnvDir(src, srcEnv, fs, dstDir); err != nil {
+		fs.RemoveAll(dstDir)
+		return err
+	}
+
+	if err = copyKsonnetLib(src, dst, srcEnv.KubernetesVersion); err != nil {
+		fs.RemoveAll(dstDir)
+		return err
+	}
+
+	for _, name := range sortedLibraryNames(srcEnv.Libraries) {
+		if err = copyVendoredLibrary(src, dst, srcEnv.Libraries[name]); err != nil {
+			fs.RemoveAll(dstDir)
+			return err
+		}
+	}
+
+	spec := *srcEnv
+	spec.Name = dstName
+	spec.Path = dstName
+	spec.Context = ""
+	spec.Provenance = nil
+	if srcEnv.Destination != nil {
+		destination := *srcEnv.Destination
+		spec.Destination = &destination
+	}
+
+	if err = dst.AddEnvironment(&spec, "", false); err != nil {
+		fs.RemoveAll(dstDir)
+		return err
+	}
+
+	return nil
+}
+
+// CloneWarnings returns the ways environment srcName of app src may not be
+// compatible with app dst: a Kubernetes version none of the environments of
+// dst use, and libraries whose registries dst doesn't have or that dst uses
+// at other versions.
+func CloneWarnings(src app.App, srcName string, dst app.App) ([]string, error) {
+	srcEnv, err := src.Environment(srcName)
+	if err != nil {
+		return nil, err
+	}
+
+	var warnings []string
+
+	dstEnvs, err := dst.Environments()
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make(map[string]bool)
+	for _, e := range dstEnvs {
+		if e.KubernetesVersion != "" {
+			versions[e.KubernetesVersion] = true
+		}
+	}
+
+	if len(versions) > 0 && srcEnv.KubernetesVersion != "" && !versions[srcEnv.KubernetesVersion] {
+		var used []string
+		for v := range versions {
+			used = append(used, v)
+		}
+		sort.Strings(used)
+
+		warnings = append(warnings, fmt.Sprintf("environment %q uses Kubernetes %s, but the environments of %s use %s",
+			srcName, srcEnv.KubernetesVersion, dst.Root(), strings.Join(used, ", ")))
+	}
+
+	registries, err := dst.Registries()
+	if err != nil {
+		return nil, err
+	}
+
+	libraries, err := dst.Libraries()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range sortedLibraryNames(srcEnv.Libraries) {
+		l := srcEnv.Libraries[name]
+
+		if _, ok := registries[l.Registry]; !ok {
+			warnings = append(warnings, fmt.Sprintf("library %q of environment %q comes from registry %q, which %s doesn't have",
+				name, srcName, l.Registry, dst.Root()))
+		}
+
+		if dl, ok := libraries[name]; ok && dl.Version != l.Version {
+			warnings = append(warnings, fmt.Sprintf("library %q of environment %q is version %q, but %s uses version %q",
+				name, srcName, l.Version, dst.Root(), dl.Version))
+		}
+	}
+
+	return warnings, nil
+}
+
+// copyEnvDir copies the directory of an environment, without the directories
+// of other environments nested in it.
+func copyEnvDir(src app.App, srcEnv *app.EnvironmentConfig, fs afero.Fs, dstDir string) error {
+	envs, err := src.Environments()
+	if err != nil {
+		return err
+	}
+
+	srcDir := srcEnv.MakePath(src.Root())
+
+	var nested []string
+	for _, e := range envs {
+		p := e.MakePath(src.Root())
+		if strings.HasPrefix(p, srcDir+string(filepath.Separator)) {
+			nested = append(nested, p)
+		}
+	}
+
+	return afero.Walk(fs, srcDir, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		for _, n := range nested {
+			if path == n {
+				return filepath.SkipDir
+			}
+		}
+
+		rel, err := filepath.Rel(srcDir, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dstDir, rel)
+
+		if fi.IsDir() {
+			return fs.MkdirAll(target, app.DefaultFolderPermissions)
+		}
+
+		data, err := afero.ReadFile(fs, path)
+		if err != nil {
+			return err
+		}
+
+		return afero.WriteFile(fs, target, data, app.DefaultFilePermissions)
+	})
+}
+
+// ksonnetLibDirs are the directories, relative to an app's lib directory,
+// ksonnet-lib can be generated in for a Kubernetes version.
+func ksonnetLibDirs(k8sVersion string) []string {
+	return []string{
+		filepath.Join("ksonnet-lib", k8sVersion),
+		k8sVersion,
+	}
+}
+
+// copyKsonnetLib copies the ksonnet-lib generated for a Kubernetes version
+// from src to dst, if dst doesn't have it. If src doesn't have it either, it
+// is generated when the environment is first used.
+func copyKsonnetLib(src, dst app.App, k8sVersion string) error {
+	if k8sVersion == "" {
+		return nil
+	}
+
+	fs := dst.Fs()
+
+	for _, dir := range ksonnetLibDirs(k8sVersion) {
+		if exists, _ := afero.DirExists(fs, filepath.Join(dst.Root(), app.LibDirName, dir)); exists {
+			return nil
+		}
+	}
+
+	for _, dir := range ksonnetLibDirs(k8sVersion) {
+		srcDir := filepath.Join(src.Root(), app.LibDirName, dir)
+		if exists, _ := afero.DirExists(fs, srcDir); !exists {
+			continue
+		}
+
+		dstDir := filepath.Join(dst.Root(), app.LibDirName, dir)
+		log.Debugf("Copying ksonnet-lib for Kubernetes %s from %s", k8sVersion, srcDir)
+		return utilio.CopyRecursive(fs, dstDir, srcDir, app.DefaultFilePermissions, app.DefaultFolderPermissions)
+	}
+
+	log.Debugf("ksonnet-lib for Kubernetes %s isn't generated in %s", k8sVersion, src.Root())
+	return nil
+}
+
+// copyVendoredLibrary copies the vendored package of a library from src to
+// dst, if dst doesn't have it.
+func copyVendoredLibrary(src, dst app.App, l *app.LibraryConfig) error {
+	d := pkg.Descriptor{Registry: l.Registry, Name: l.Name, Version: l.Version}
+
+	srcDir := pkg.LocalVendorPath(src, d)
+	dstDir := pkg.LocalVendorPath(dst, d)
+	if srcDir == "" || dstDir == "" {
+		return nil
+	}
+
+	fs := dst.Fs()
+	if exists, _ := afero.DirExists(fs, dstDir); exists {
+		return nil
+	}
+
+	if exists, _ := afero.DirExists(fs, srcDir); !exists {
+		log.Debugf("Package %s isn't vendored in %s", d, src.Root())
+		return nil
+	}
+
+	return utilio.CopyRecursive(fs, dstDir, srcDir, app.DefaultFilePermissions, app.DefaultFolderPermissions)
+}
+
+func sortedLibraryNames(libraries app.LibraryConfigs) []string {
+	var names []string
+	for name := range libraries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
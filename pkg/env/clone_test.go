@@ -0,0 +1,148 @@
+// Copyright 2018 The kubecfg authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package env
+
+import (
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func withCloneSource(t *testing.T, fn func(src *mocks.App, dst *mocks.App, fs afero.Fs)) {
+	withEnv(t, func(src *mocks.App, fs afero.Fs) {
+		srcEnv := &app.EnvironmentConfig{
+			Name:              "env1",
+			Path:              "env1",
+			KubernetesVersion: "v1.10.0",
+			Destination:       &app.EnvironmentDestinationSpec{Server: "https://prod", Namespace: "web"},
+			Context:           "prod-admin",
+			Provenance:        &app.EnvironmentProvenance{User: "someone"},
+			NamePrefix:        "prod-",
+			Libraries: app.LibraryConfigs{
+				"redis": &app.LibraryConfig{Name: "redis", Registry: "incubator", Version: "1.0.0"},
+			},
+		}
+		envs := app.EnvironmentConfigs{
+			"env1":       srcEnv,
+			"env1/child": &app.EnvironmentConfig{Path: "env1/child"},
+		}
+		// replace the environment staged by withEnv
+		src.ExpectedCalls = nil
+		src.On("Fs").Return(fs)
+		src.On("Root").Return("/")
+		src.On("Environment", "env1").Return(srcEnv, nil)
+		src.On("Environments").Return(envs, nil)
+		src.On("VendorPath").Return("/vendor")
+
+		stageFile(t, fs, "main.jsonnet", "/environments/env1/child/main.jsonnet")
+		stageFile(t, fs, "main.jsonnet", "/lib/ksonnet-lib/v1.10.0/k8s.libsonnet")
+		stageFile(t, fs, "main.jsonnet", "/vendor/incubator/redis@1.0.0/redis.libsonnet")
+
+		dst := &mocks.App{}
+		dst.On("Fs").Return(fs)
+		dst.On("Root").Return("/dst")
+		dst.On("VendorPath").Return("/dst/vendor")
+
+		fn(src, dst, fs)
+	})
+}
+
+func TestClone(t *testing.T) {
+	withCloneSource(t, func(src *mocks.App, dst *mocks.App, fs afero.Fs) {
+		dst.On("Environment", "prod").Return(nil, errors.New("not found"))
+
+		var added *app.EnvironmentConfig
+		dst.On("AddEnvironment", mock.Anything, "", false).Return(nil).Run(func(args mock.Arguments) {
+			added = args.Get(0).(*app.EnvironmentConfig)
+		})
+
+		err := Clone(src, "env1", dst, "prod")
+		require.NoError(t, err)
+
+		checkExists(t, fs, "/dst/environments/prod/main.jsonnet")
+		checkExists(t, fs, "/dst/environments/prod/params.libsonnet")
+		checkExists(t, fs, "/dst/environments/prod/globals.libsonnet")
+		checkNotExists(t, fs, "/dst/environments/prod/child")
+		checkExists(t, fs, "/dst/lib/ksonnet-lib/v1.10.0/k8s.libsonnet")
+		checkExists(t, fs, "/dst/vendor/incubator/redis@1.0.0/redis.libsonnet")
+
+		require.NotNil(t, added)
+		assert.Equal(t, "prod", added.Name)
+		assert.Equal(t, "prod", added.Path)
+		assert.Equal(t, "v1.10.0", added.KubernetesVersion)
+		assert.Equal(t, "prod-", added.NamePrefix)
+		assert.Equal(t, "https://prod", added.Destination.Server)
+		assert.Empty(t, added.Context)
+		assert.Nil(t, added.Provenance)
+		assert.Len(t, added.Libraries, 1)
+	})
+}
+
+func TestClone_existing_environment(t *testing.T) {
+	withCloneSource(t, func(src *mocks.App, dst *mocks.App, fs afero.Fs) {
+		dst.On("Environment", "prod").Return(&app.EnvironmentConfig{}, nil)
+
+		err := Clone(src, "env1", dst, "prod")
+		require.Error(t, err)
+
+		checkNotExists(t, fs, "/dst/environments/prod")
+	})
+}
+
+func TestCloneWarnings(t *testing.T) {
+	withCloneSource(t, func(src *mocks.App, dst *mocks.App, fs afero.Fs) {
+		dst.On("Environments").Return(app.EnvironmentConfigs{
+			"staging": &app.EnvironmentConfig{KubernetesVersion: "v1.9.0"},
+		}, nil)
+		dst.On("Registries").Return(app.RegistryConfigs{
+			"incubator": &app.RegistryConfig{Name: "incubator"},
+		}, nil)
+		dst.On("Libraries").Return(app.LibraryConfigs{
+			"redis": &app.LibraryConfig{Name: "redis", Registry: "incubator", Version: "0.9.0"},
+		}, nil)
+
+		got, err := CloneWarnings(src, "env1", dst)
+		require.NoError(t, err)
+
+		expected := []string{
+			`environment "env1" uses Kubernetes v1.10.0, but the environments of /dst use v1.9.0`,
+			`library "redis" of environment "env1" is version "1.0.0", but /dst uses version "0.9.0"`,
+		}
+		assert.Equal(t, expected, got)
+	})
+}
+
+func TestCloneWarnings_compatible(t *testing.T) {
+	withCloneSource(t, func(src *mocks.App, dst *mocks.App, fs afero.Fs) {
+		dst.On("Environments").Return(app.EnvironmentConfigs{
+			"staging": &app.EnvironmentConfig{KubernetesVersion: "v1.10.0"},
+		}, nil)
+		dst.On("Registries").Return(app.RegistryConfigs{
+			"incubator": &app.RegistryConfig{Name: "incubator"},
+		}, nil)
+		dst.On("Libraries").Return(app.LibraryConfigs{}, nil)
+
+		got, err := CloneWarnings(src, "env1", dst)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+}
@@ -16,11 +16,8 @@
 package env
 
 import (
-	"fmt"
 	"path"
 	"path/filepath"
-	"regexp"
-	"strings"
 
 	"github.com/ksonnet/ksonnet/pkg/app"
 	"github.com/pkg/errors"
@@ -65,13 +62,8 @@ func newCreator(a app.App, d Destination, name, k8sSpecFlag string, overrideData
 }
 
 func (c *creator) Create() error {
-	if c.environmentExists() {
-		return errors.Errorf("environment %q already exists", c.name)
-	}
-
-	// ensure environment name does not contain punctuation
-	if !isValidName(c.name) {
-		return fmt.Errorf("environment name %q is not valid; must not contain punctuation, spaces, or begin or end with a slash", c.name)
+	if err := c.validate(); err != nil {
+		return err
 	}
 
 	log.Infof("Creating environment %q with namespace %q, pointing to %q cluster at address %q",
@@ -118,14 +110,25 @@ func (c *creator) Create() error {
 		Name: c.name,
 		Path: c.name,
 		Destination: &app.EnvironmentDestinationSpec{
-			Server:    c.d.Server(),
-			Namespace: c.d.Namespace(),
+			Server:     c.d.Server(),
+			Namespace:  c.d.Namespace(),
+			ServerCert: c.d.ServerCert(),
 		},
+		Context: c.d.Context(),
 	}, c.k8sSpecFlag, c.isOverride)
 
 	return err
 }
 
+// validate checks that the environment can be created.
+func (c *creator) validate() error {
+	if c.environmentExists() {
+		return errors.Errorf("environment %q already exists", c.name)
+	}
+
+	return ValidateName(c.name)
+}
+
 func (c *creator) environmentExists() bool {
 	if c.isOverride {
 		return false
@@ -138,20 +141,3 @@ func (c *creator) environmentExists() bool {
 
 	return true
 }
-
-// isValidName returns true if a name (e.g., for an environment) is valid.
-// Broadly, this means it does not contain punctuation, whitespace, leading or
-// trailing slashes.
-func isValidName(name string) bool {
-	// No unicode whitespace is allowed. `Fields` doesn't handle trailing or
-	// leading whitespace.
-	fields := strings.Fields(name)
-	if len(fields) > 1 || len(strings.TrimSpace(name)) != len(name) {
-		return false
-	}
-
-	hasPunctuation := regexp.MustCompile(`[\\,;':!()?"{}\[\]*&%@$]+`).MatchString
-	hasTrailingSlashes := regexp.MustCompile(`/+$`).MatchString
-	hasLeadingSlashes := regexp.MustCompile(`^/+`).MatchString
-	return len(name) != 0 && !hasPunctuation(name) && !hasTrailingSlashes(name) && !hasLeadingSlashes(name)
-}
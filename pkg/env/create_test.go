@@ -22,6 +22,7 @@ import (
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/ksonnet/ksonnet/pkg/app"
 	"github.com/ksonnet/ksonnet/pkg/app/mocks"
 	"github.com/stretchr/testify/require"
 )
@@ -45,3 +46,48 @@ func TestCreate(t *testing.T) {
 		checkExists(t, fs, "/environments/newenv/params.libsonnet")
 	})
 }
+
+func TestCreate_context(t *testing.T) {
+	withEnv(t, func(appMock *mocks.App, fs afero.Fs) {
+		appMock.On("Environment", "newenv").Return(nil, errors.New("it does not exist"))
+
+		expected := &app.EnvironmentConfig{
+			Name: "newenv",
+			Path: "newenv",
+			Destination: &app.EnvironmentDestinationSpec{
+				Server:    "http://example.com",
+				Namespace: "default",
+			},
+			Context: "dev",
+		}
+		appMock.On("AddEnvironment", expected, "version:v1.8.7", false).Return(nil)
+
+		d := NewContextDestination("http://example.com", "default", "dev")
+		var od, pd []byte
+		err := Create(appMock, d, "newenv", "version:v1.8.7", od, pd, false)
+		require.NoError(t, err)
+	})
+}
+
+func TestCreate_server_cert(t *testing.T) {
+	withEnv(t, func(appMock *mocks.App, fs afero.Fs) {
+		appMock.On("Environment", "newenv").Return(nil, errors.New("it does not exist"))
+
+		expected := &app.EnvironmentConfig{
+			Name: "newenv",
+			Path: "newenv",
+			Destination: &app.EnvironmentDestinationSpec{
+				Server:     "http://example.com",
+				Namespace:  "default",
+				ServerCert: "cert",
+			},
+		}
+		appMock.On("AddEnvironment", expected, "version:v1.8.7", false).Return(nil)
+
+		d := NewDestination("http://example.com", "default")
+		d.SetServerCert("cert")
+		var od, pd []byte
+		err := Create(appMock, d, "newenv", "version:v1.8.7", od, pd, false)
+		require.NoError(t, err)
+	})
+}
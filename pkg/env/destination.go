@@ -20,12 +20,18 @@ import "encoding/json"
 const (
 	// destDefaultNamespace is the default namespace name.
 	destDefaultNamespace = "default"
+
+	// OfflineServer is the placeholder server of environments created
+	// without a cluster.
+	OfflineServer = "https://offline.invalid"
 )
 
 // Destination contains destination information for a cluster.
 type Destination struct {
-	server    string
-	namespace string
+	server     string
+	namespace  string
+	context    string
+	serverCert string
 }
 
 // NewDestination creates an instance of Destination.
@@ -36,6 +42,20 @@ func NewDestination(server, namespace string) Destination {
 	}
 }
 
+// NewContextDestination creates an instance of Destination that was resolved
+// from a kubeconfig context.
+func NewContextDestination(server, namespace, context string) Destination {
+	d := NewDestination(server, namespace)
+	d.context = context
+	return d
+}
+
+// SetServerCert sets the PEM-encoded certificate authority used to verify the
+// server.
+func (d *Destination) SetServerCert(cert string) {
+	d.serverCert = cert
+}
+
 // MarshalJSON marshals a Destination to JSON.
 func (d *Destination) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&struct {
@@ -61,3 +81,15 @@ func (d *Destination) Namespace() string {
 
 	return d.namespace
 }
+
+// Context is the name of the kubeconfig context the destination was resolved
+// from. It is empty if the destination was not resolved from a context.
+func (d *Destination) Context() string {
+	return d.context
+}
+
+// ServerCert is the PEM-encoded certificate authority of the server. It is
+// empty if the server isn't pinned to a certificate authority.
+func (d *Destination) ServerCert() string {
+	return d.serverCert
+}
@@ -0,0 +1,81 @@
+// Copyright 2018 The kubecfg authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package env
+
+import (
+	"bytes"
+	"os"
+	"strings"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+)
+
+const gitignoreFileName = ".gitignore"
+
+// SecretPatterns are file patterns that commonly hold secrets. They are added
+// to an environment's .gitignore by EnsureGitignore.
+var SecretPatterns = []string{
+	"*.key",
+	"*.pem",
+	"*.p12",
+	"*.pfx",
+	"*-credentials*",
+	"*.kubeconfig",
+	".env",
+}
+
+// EnsureGitignore makes sure the .gitignore in an environment's directory
+// excludes SecretPatterns. An existing .gitignore is kept, and only patterns
+// it doesn't contain yet are appended.
+func EnsureGitignore(a app.App, envName string) error {
+	gitignorePath, err := Path(a, envName, gitignoreFileName)
+	if err != nil {
+		return err
+	}
+
+	existing, err := afero.ReadFile(a.Fs(), gitignorePath)
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "read %s", gitignorePath)
+	}
+
+	present := make(map[string]bool)
+	for _, line := range strings.Split(string(existing), "\n") {
+		present[strings.TrimSpace(line)] = true
+	}
+
+	var buf bytes.Buffer
+	buf.Write(existing)
+	if len(existing) > 0 && !bytes.HasSuffix(existing, []byte("\n")) {
+		buf.WriteString("\n")
+	}
+
+	added := 0
+	for _, pattern := range SecretPatterns {
+		if present[pattern] {
+			continue
+		}
+		buf.WriteString(pattern + "\n")
+		added++
+	}
+
+	if added == 0 {
+		return nil
+	}
+
+	return afero.WriteFile(a.Fs(), gitignorePath, buf.Bytes(), app.DefaultFilePermissions)
+}
@@ -0,0 +1,67 @@
+// Copyright 2018 The kubecfg authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package env
+
+import (
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnsureGitignore(t *testing.T) {
+	cases := []struct {
+		name     string
+		existing string
+		expected string
+	}{
+		{
+			name:     "no .gitignore",
+			expected: "*.key\n*.pem\n*.p12\n*.pfx\n*-credentials*\n*.kubeconfig\n.env\n",
+		},
+		{
+			name:     "append missing patterns",
+			existing: "build/\n*.pem\n*.key",
+			expected: "build/\n*.pem\n*.key\n*.p12\n*.pfx\n*-credentials*\n*.kubeconfig\n.env\n",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withEnv(t, func(appMock *mocks.App, fs afero.Fs) {
+				path := "/environments/env1/.gitignore"
+				if tc.existing != "" {
+					require.NoError(t, afero.WriteFile(fs, path, []byte(tc.existing), 0644))
+				}
+
+				require.NoError(t, EnsureGitignore(appMock, "env1"))
+
+				b, err := afero.ReadFile(fs, path)
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, string(b))
+
+				// A second run doesn't change anything.
+				require.NoError(t, EnsureGitignore(appMock, "env1"))
+
+				b, err = afero.ReadFile(fs, path)
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, string(b))
+			})
+		})
+	}
+}
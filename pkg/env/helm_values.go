@@ -0,0 +1,242 @@
+// Copyright 2018 The kubecfg authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package env
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	nm "github.com/ksonnet/ksonnet-lib/ksonnet-gen/nodemaker"
+	param "github.com/ksonnet/ksonnet/metadata/params"
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/ksonnet/ksonnet/pkg/params"
+	"github.com/ksonnet/ksonnet/pkg/util/jsonnet"
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+)
+
+const (
+	// helmGlobalKey is the Helm values key shared by a chart and its subcharts.
+	helmGlobalKey = "global"
+	// unmappedHelmValuesHeader introduces the Helm values that could not be
+	// mapped in an environment's params file.
+	unmappedHelmValuesHeader = "// Helm values that could not be mapped to parameters:\n"
+)
+
+// ImportHelmValues maps the values in a Helm values.yaml onto the parameters
+// of an environment. Values under a key named after a component become that
+// component's parameters, values under a key named after a module are mapped
+// to the module's components, and values under `global` become environment
+// globals. Values that can't be mapped are listed in a comment at the top of
+// the environment's params file, and their paths are returned.
+func ImportHelmValues(a app.App, envName string, data []byte, componentNames []string) ([]string, error) {
+	var values map[string]interface{}
+	if err := yaml.Unmarshal(data, &values); err != nil {
+		return nil, errors.Wrap(err, "parse Helm values")
+	}
+
+	if err := ensureEnvExists(a, envName); err != nil {
+		return nil, err
+	}
+
+	paramsPath, err := Path(a, envName, paramsFileName)
+	if err != nil {
+		return nil, err
+	}
+
+	paramsText, err := afero.ReadFile(a.Fs(), paramsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	globalsPath, err := Path(a, envName, globalsFileName)
+	if err != nil {
+		return nil, err
+	}
+
+	globalsText, err := afero.ReadFile(a.Fs(), globalsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	hm := &helmMapper{
+		components: make(map[string]bool),
+		params:     string(paramsText),
+		globals:    string(globalsText),
+	}
+	for _, name := range componentNames {
+		hm.components[name] = true
+	}
+
+	if err = hm.mapValues(nil, values); err != nil {
+		return nil, err
+	}
+
+	if len(hm.unmapped) > 0 {
+		var header strings.Builder
+		header.WriteString(unmappedHelmValuesHeader)
+		for _, path := range hm.unmapped {
+			header.WriteString("//   " + path + "\n")
+		}
+		hm.params = header.String() + hm.params
+	}
+
+	if err = afero.WriteFile(a.Fs(), paramsPath, []byte(hm.params), app.DefaultFilePermissions); err != nil {
+		return nil, err
+	}
+
+	if err = afero.WriteFile(a.Fs(), globalsPath, []byte(hm.globals), app.DefaultFilePermissions); err != nil {
+		return nil, err
+	}
+
+	return hm.unmapped, nil
+}
+
+// helmMapper maps Helm values onto the text of environment params and globals.
+type helmMapper struct {
+	components map[string]bool
+	params     string
+	globals    string
+	unmapped   []string
+}
+
+func (hm *helmMapper) mapValues(prefix []string, values map[string]interface{}) error {
+	for _, key := range sortedValueKeys(values) {
+		path := append(append([]string{}, prefix...), key)
+		name := strings.Join(path, ".")
+
+		m, isMap := values[key].(map[string]interface{})
+
+		var err error
+		switch {
+		case isMap && hm.components[name]:
+			err = hm.mapComponent(name, m)
+		case isMap && prefix == nil && key == helmGlobalKey:
+			err = hm.mapGlobals(m)
+		case isMap && hm.isModule(name):
+			err = hm.mapValues(path, m)
+		default:
+			hm.unmapped = append(hm.unmapped, name)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (hm *helmMapper) mapComponent(componentName string, values map[string]interface{}) error {
+	// Params are set one at a time, in order, so the generated file is stable.
+	for _, key := range sortedValueKeys(values) {
+		s, ok := helmParamValue(values[key])
+		if !ok {
+			hm.unmapped = append(hm.unmapped, componentName+"."+key)
+			continue
+		}
+
+		updated, err := params.NewEnvParamSet().Set(componentName, hm.params, param.Params{key: s})
+		if err != nil {
+			return errors.Wrapf(err, "set param %q for component %q", key, componentName)
+		}
+
+		hm.params = updated
+	}
+
+	return nil
+}
+
+func (hm *helmMapper) mapGlobals(values map[string]interface{}) error {
+	for _, key := range sortedValueKeys(values) {
+		v := values[key]
+		if v == nil {
+			hm.unmapped = append(hm.unmapped, helmGlobalKey+"."+key)
+			continue
+		}
+
+		if _, err := nm.ValueToNoder(v); err != nil {
+			hm.unmapped = append(hm.unmapped, helmGlobalKey+"."+key)
+			continue
+		}
+
+		updated, err := params.NewEnvGlobalsSet().Set(hm.globals, param.Params{key: v})
+		if err != nil {
+			return errors.Wrapf(err, "set global %q", key)
+		}
+
+		hm.globals = updated
+	}
+
+	return nil
+}
+
+// isModule returns true if name is the module of a known component.
+func (hm *helmMapper) isModule(name string) bool {
+	for componentName := range hm.components {
+		if strings.HasPrefix(componentName, name+".") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// helmParamValue converts a Helm value to the string form used to set
+// environment params. It returns false if the value can't be represented as
+// a param, e.g. a null, or a string that would be read as a number.
+func helmParamValue(v interface{}) (string, bool) {
+	var s string
+	switch t := v.(type) {
+	case nil:
+		return "", false
+	case string:
+		s = t
+	default:
+		b, err := json.Marshal(t)
+		if err != nil {
+			return "", false
+		}
+		s = string(b)
+	}
+
+	decoded, err := jsonnet.DecodeValue(s)
+	if err != nil {
+		return "", false
+	}
+
+	if _, isString := v.(string); isString && decoded != v {
+		return "", false
+	}
+
+	if _, err := nm.ValueToNoder(decoded); err != nil {
+		return "", false
+	}
+
+	return s, true
+}
+
+func sortedValueKeys(values map[string]interface{}) []string {
+	var keys []string
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
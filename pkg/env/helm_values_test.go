@@ -0,0 +1,85 @@
+// Copyright 2018 The kubecfg authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package env
+
+import (
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportHelmValues(t *testing.T) {
+	withEnv(t, func(appMock *mocks.App, fs afero.Fs) {
+		values := []byte(`
+global:
+  domain: example.com
+  unset: null
+component1:
+  replicas: 3
+  image: nginx:1.15
+  tag: "3"
+  labels:
+    tier: web
+nested:
+  component2:
+    enabled: true
+ingress:
+  enabled: false
+`)
+
+		unmapped, err := ImportHelmValues(appMock, "env1", values, []string{"component1", "nested.component2"})
+		require.NoError(t, err)
+
+		expected := []string{"component1.tag", "global.unset", "ingress"}
+		require.Equal(t, expected, unmapped)
+
+		compareOutput(t, fs, "helm-params.libsonnet", "/environments/env1/params.libsonnet")
+		compareOutput(t, fs, "helm-globals.libsonnet", "/environments/env1/globals.libsonnet")
+	})
+}
+
+func TestImportHelmValues_invalid_yaml(t *testing.T) {
+	withEnv(t, func(appMock *mocks.App, fs afero.Fs) {
+		_, err := ImportHelmValues(appMock, "env1", []byte("component1: [}"), nil)
+		require.Error(t, err)
+	})
+}
+
+func Test_helmParamValue(t *testing.T) {
+	cases := []struct {
+		name     string
+		value    interface{}
+		expected string
+		ok       bool
+	}{
+		{name: "string", value: "nginx", expected: "nginx", ok: true},
+		{name: "number", value: float64(3), expected: "3", ok: true},
+		{name: "bool", value: true, expected: "true", ok: true},
+		{name: "object", value: map[string]interface{}{"tier": "web"}, expected: `{"tier":"web"}`, ok: true},
+		{name: "string read as a number", value: "3"},
+		{name: "null"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, ok := helmParamValue(tc.value)
+			require.Equal(t, tc.ok, ok)
+			require.Equal(t, tc.expected, s)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package env
+
+import (
+	"sort"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+)
+
+// CheckInheritance returns an error if an environment can't inherit
+// parameters from parent, either because parent doesn't exist or because the
+// environments would inherit from each other. The environment itself doesn't
+// need to exist yet.
+func CheckInheritance(a app.App, name, parent string) error {
+	environments, err := a.Environments()
+	if err != nil {
+		return err
+	}
+
+	candidates := app.EnvironmentConfigs{}
+	for k, v := range environments {
+		candidates[k] = v
+	}
+	candidates[name] = &app.EnvironmentConfig{Name: name, Inherits: parent}
+
+	_, err = candidates.InheritanceChain(name)
+	return err
+}
+
+// Inheritors returns the sorted names of the environments that inherit
+// parameters directly from an environment.
+func Inheritors(a app.App, name string) ([]string, error) {
+	environments, err := a.Environments()
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for envName, e := range environments {
+		if e.Inherits == name {
+			names = append(names, envName)
+		}
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
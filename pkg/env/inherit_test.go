@@ -0,0 +1,100 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package env
+
+import (
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckInheritance(t *testing.T) {
+	environments := app.EnvironmentConfigs{
+		"base":    &app.EnvironmentConfig{Name: "base"},
+		"staging": &app.EnvironmentConfig{Name: "staging", Inherits: "base"},
+		"prod":    &app.EnvironmentConfig{Name: "prod", Inherits: "staging"},
+	}
+
+	cases := []struct {
+		name     string
+		envName  string
+		parent   string
+		expected string
+	}{
+		{
+			name:    "new environment",
+			envName: "dev",
+			parent:  "staging",
+		},
+		{
+			name:     "missing parent",
+			envName:  "dev",
+			parent:   "qa",
+			expected: `environment "dev" inherits from "qa", which does not exist`,
+		},
+		{
+			name:     "cycle",
+			envName:  "base",
+			parent:   "prod",
+			expected: "environments inherit from each other: base -> prod -> staging -> base",
+		},
+		{
+			name:     "itself",
+			envName:  "base",
+			parent:   "base",
+			expected: "environments inherit from each other: base -> base",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			appMock := &mocks.App{}
+			appMock.On("Environments").Return(environments, nil)
+
+			err := CheckInheritance(appMock, tc.envName, tc.parent)
+			if tc.expected != "" {
+				require.Error(t, err)
+				assert.Equal(t, tc.expected, err.Error())
+				return
+			}
+			require.NoError(t, err)
+
+			// The app's environments are left as they were.
+			assert.Empty(t, environments["base"].Inherits)
+		})
+	}
+}
+
+func TestInheritors(t *testing.T) {
+	appMock := &mocks.App{}
+	appMock.On("Environments").Return(app.EnvironmentConfigs{
+		"base":    &app.EnvironmentConfig{Name: "base"},
+		"staging": &app.EnvironmentConfig{Name: "staging", Inherits: "base"},
+		"dev":     &app.EnvironmentConfig{Name: "dev", Inherits: "base"},
+		"prod":    &app.EnvironmentConfig{Name: "prod", Inherits: "staging"},
+	}, nil)
+
+	names, err := Inheritors(appMock, "base")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"dev", "staging"}, names)
+
+	names, err = Inheritors(appMock, "prod")
+	require.NoError(t, err)
+	assert.Empty(t, names)
+}
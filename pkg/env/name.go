@@ -0,0 +1,83 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package env
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// reservedNames are the names which can't be used as a segment of an
+	// environment name, as ksonnet keeps files with them in the environments
+	// directory.
+	reservedNames = []string{".metadata", "base.libsonnet"}
+
+	namePunctuation = regexp.MustCompile(`[\\,;':!()?"{}\[\]*&%@$]`)
+)
+
+// ValidateName returns an error which explains why name can't be used as the
+// name of an environment. Names are paths below the environments directory,
+// so every segment of the path must be valid.
+func ValidateName(name string) error {
+	if name == "" {
+		return errors.New("environment name can't be empty")
+	}
+
+	if strings.TrimSpace(name) != name || len(strings.Fields(name)) > 1 {
+		return errors.Errorf("environment name %q is not valid; it can't contain spaces", name)
+	}
+
+	if strings.HasPrefix(name, "/") {
+		return errors.Errorf("environment name %q is not valid; it can't begin with a slash, as names are paths relative to the environments directory", name)
+	}
+
+	if strings.HasSuffix(name, "/") {
+		return errors.Errorf("environment name %q is not valid; it can't end with a slash, try %q", name, strings.TrimRight(name, "/"))
+	}
+
+	for _, segment := range strings.Split(name, "/") {
+		switch segment {
+		case "":
+			return errors.Errorf("environment name %q is not valid; it has an empty segment between two slashes, try %q", name, cleanSlashes(name))
+		case ".", "..":
+			return errors.Errorf("environment name %q is not valid; segment %q refers to a relative directory, and environments must be below the environments directory", name, segment)
+		}
+
+		for _, reserved := range reservedNames {
+			if segment == reserved {
+				return errors.Errorf("environment name %q is not valid; segment %q is reserved for ksonnet's own files", name, segment)
+			}
+		}
+
+		if p := namePunctuation.FindString(segment); p != "" {
+			return errors.Errorf("environment name %q is not valid; segment %q contains %q, and names can't contain punctuation", name, segment, p)
+		}
+	}
+
+	return nil
+}
+
+// cleanSlashes replaces repeated slashes in name with a single slash.
+func cleanSlashes(name string) string {
+	for strings.Contains(name, "//") {
+		name = strings.Replace(name, "//", "/", -1)
+	}
+
+	return name
+}
@@ -0,0 +1,91 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package env
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateName(t *testing.T) {
+	cases := []struct {
+		name     string
+		expected string
+	}{
+		{name: "default"},
+		{name: "us-west/staging"},
+		{name: "us_west.prod"},
+		{
+			name:     "",
+			expected: "environment name can't be empty",
+		},
+		{
+			name:     "foo/../bar",
+			expected: `environment name "foo/../bar" is not valid; segment ".." refers to a relative directory, and environments must be below the environments directory`,
+		},
+		{
+			name:     "./foo",
+			expected: `environment name "./foo" is not valid; segment "." refers to a relative directory, and environments must be below the environments directory`,
+		},
+		{
+			name:     "/abs",
+			expected: `environment name "/abs" is not valid; it can't begin with a slash, as names are paths relative to the environments directory`,
+		},
+		{
+			name:     "trailing/",
+			expected: `environment name "trailing/" is not valid; it can't end with a slash, try "trailing"`,
+		},
+		{
+			name:     "a//b",
+			expected: `environment name "a//b" is not valid; it has an empty segment between two slashes, try "a/b"`,
+		},
+		{
+			name:     "prod/.metadata",
+			expected: `environment name "prod/.metadata" is not valid; segment ".metadata" is reserved for ksonnet's own files`,
+		},
+		{
+			name:     "base.libsonnet",
+			expected: `environment name "base.libsonnet" is not valid; segment "base.libsonnet" is reserved for ksonnet's own files`,
+		},
+		{
+			name:     "us-west/prod!",
+			expected: `environment name "us-west/prod!" is not valid; segment "prod!" contains "!", and names can't contain punctuation`,
+		},
+		{
+			name:     "us west",
+			expected: `environment name "us west" is not valid; it can't contain spaces`,
+		},
+		{
+			name:     " prod",
+			expected: `environment name " prod" is not valid; it can't contain spaces`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateName(tc.name)
+			if tc.expected == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			assert.Equal(t, tc.expected, err.Error())
+		})
+	}
+}
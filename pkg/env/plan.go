@@ -0,0 +1,264 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package env
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+)
+
+// Actions of an Operation.
+const (
+	OpCreate   = "create"
+	OpGenerate = "generate"
+	OpMove     = "move"
+	OpRemove   = "remove"
+	OpUpdate   = "update"
+)
+
+const (
+	// appYAML and overrideYAML are the files environments are saved to.
+	appYAML      = "app.yaml"
+	overrideYAML = "app.override.yaml"
+)
+
+// Operation is a change to the files of an app made by an environment command.
+// Dry runs list operations instead of making them.
+type Operation struct {
+	// Action is what is done to the path.
+	Action string
+	// Path is relative to the root of the app.
+	Path string
+	// To is the new path of a moved path.
+	To string
+	// Detail describes the operation further.
+	Detail string
+}
+
+func (o Operation) String() string {
+	s := fmt.Sprintf("%-8s %s", o.Action, o.Path)
+	if o.To != "" {
+		s += " -> " + o.To
+	}
+	if o.Detail != "" {
+		s += " (" + o.Detail + ")"
+	}
+
+	return s
+}
+
+// WriteOperations writes operations, one per line.
+func WriteOperations(w io.Writer, ops []Operation) error {
+	for _, op := range ops {
+		if _, err := fmt.Fprintf(w, "  %s\n", op); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ConfigOperation returns the operation that saves an environment to the app
+// configuration, or to the overrides.
+func ConfigOperation(isOverride bool) Operation {
+	if isOverride {
+		return Operation{Action: OpUpdate, Path: overrideYAML}
+	}
+
+	return Operation{Action: OpUpdate, Path: appYAML}
+}
+
+// GlobalsOperation returns the operation that updates the parameters of the
+// environment at envPath, relative to the environments directory.
+func GlobalsOperation(envPath, detail string) Operation {
+	return Operation{Action: OpUpdate, Path: path.Join(envRootName, envPath, globalsFileName), Detail: detail}
+}
+
+// CreateOperations returns the operations Create would make, once it has
+// checked that the environment can be created.
+func CreateOperations(a app.App, name string, isOverride bool) ([]Operation, error) {
+	c := &creator{app: a, name: name, isOverride: isOverride}
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+
+	dir := path.Join(envRootName, name)
+	return []Operation{
+		{Action: OpCreate, Path: path.Join(dir, envFileName)},
+		{Action: OpCreate, Path: path.Join(dir, paramsFileName)},
+		{Action: OpCreate, Path: path.Join(dir, globalsFileName)},
+		ConfigOperation(isOverride),
+	}, nil
+}
+
+// RenameOperations returns the operations Rename would make, once it has
+// checked that the environment can be renamed.
+func RenameOperations(a app.App, from, to string, override bool) ([]Operation, error) {
+	r, err := newRenamer(a, from, to, override)
+	if err != nil {
+		return nil, err
+	}
+
+	r.to = path.Clean(r.to)
+	if r.from == r.to || to == "" {
+		return nil, nil
+	}
+
+	if err := r.preflight(); err != nil {
+		return nil, err
+	}
+
+	fs := a.Fs()
+	fromDir := filepath.Join(a.Root(), envRootName, r.from)
+
+	fis, err := afero.ReadDir(fs, fromDir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "read environment %q", r.from)
+	}
+
+	// Nested environments stay where they are, as in moveEnvironment.
+	moved := make(map[string]bool)
+	var ops []Operation
+	for _, fi := range fis {
+		if fi.IsDir() && fi.Name() != ".metadata" {
+			continue
+		}
+
+		moved[filepath.Join(fromDir, fi.Name())] = true
+		ops = append(ops, Operation{
+			Action: OpMove,
+			Path:   path.Join(envRootName, r.from, fi.Name()),
+			To:     path.Join(envRootName, r.to, fi.Name()),
+		})
+	}
+
+	ops = append(ops, ConfigOperation(override))
+
+	cleaned, err := cleanedDirOperations(a, func(p string) bool { return moved[p] })
+	if err != nil {
+		return nil, err
+	}
+
+	return append(ops, cleaned...), nil
+}
+
+// DeleteOperations returns the operations Delete would make: every file of
+// the environment's directory, including nested environments, is removed,
+// and then the directories left empty.
+func DeleteOperations(a app.App, name string, override bool) ([]Operation, error) {
+	if _, err := a.Environment(name); err != nil {
+		return nil, err
+	}
+
+	fs := a.Fs()
+	envDir := filepath.Join(a.Root(), envRootName, name)
+
+	var ops []Operation
+	err := afero.Walk(fs, envDir, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if fi.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(a.Root(), p)
+		if err != nil {
+			return err
+		}
+
+		ops = append(ops, Operation{Action: OpRemove, Path: filepath.ToSlash(rel)})
+		return nil
+	})
+	if err != nil && !os.IsNotExist(err) {
+		return nil, errors.Wrapf(err, "read environment %q", name)
+	}
+
+	ops = append(ops,
+		Operation{Action: OpRemove, Path: path.Join(envRootName, name) + "/"},
+		ConfigOperation(override))
+
+	cleaned, err := cleanedDirOperations(a, func(p string) bool { return p == envDir })
+	if err != nil {
+		return nil, err
+	}
+
+	return append(ops, cleaned...), nil
+}
+
+// cleanedDirOperations returns the directories cleanEmptyDirs would remove
+// once the paths that are gone have been moved or removed. Like
+// cleanEmptyDirs, it only removes directories that are empty when they are
+// reached from their parent, so a parent whose only child is an empty
+// directory is kept.
+func cleanedDirOperations(a app.App, gone func(p string) bool) ([]Operation, error) {
+	root := filepath.Join(a.Root(), envRootName)
+
+	var dirs []string
+	var walk func(dir string) error
+	walk = func(dir string) error {
+		fis, err := afero.ReadDir(a.Fs(), dir)
+		if err != nil {
+			return err
+		}
+
+		var remaining []os.FileInfo
+		for _, fi := range fis {
+			if !gone(filepath.Join(dir, fi.Name())) {
+				remaining = append(remaining, fi)
+			}
+		}
+
+		if len(remaining) == 0 {
+			dirs = append(dirs, dir)
+			return nil
+		}
+
+		for _, fi := range remaining {
+			if fi.IsDir() {
+				if err := walk(filepath.Join(dir, fi.Name())); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
+
+	if err := walk(root); err != nil {
+		return nil, errors.Wrap(err, "find empty environment directories")
+	}
+
+	var ops []Operation
+	for _, dir := range dirs {
+		rel, err := filepath.Rel(a.Root(), dir)
+		if err != nil {
+			return nil, err
+		}
+
+		ops = append(ops, Operation{Action: OpRemove, Path: filepath.ToSlash(rel) + "/", Detail: "empty directory"})
+	}
+
+	return ops, nil
+}
@@ -0,0 +1,102 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package env
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/ksonnet/ksonnet/pkg/app/mocks"
+)
+
+func TestCreateOperations(t *testing.T) {
+	withEnv(t, func(appMock *mocks.App, fs afero.Fs) {
+		appMock.On("Environment", "new/env").Return(nil, errors.New("not found"))
+
+		before := listFiles(t, fs)
+
+		ops, err := CreateOperations(appMock, "new/env", false)
+		require.NoError(t, err)
+
+		expected := []Operation{
+			{Action: OpCreate, Path: "environments/new/env/main.jsonnet"},
+			{Action: OpCreate, Path: "environments/new/env/params.libsonnet"},
+			{Action: OpCreate, Path: "environments/new/env/globals.libsonnet"},
+			{Action: OpUpdate, Path: "app.yaml"},
+		}
+		require.Equal(t, expected, ops)
+		require.Equal(t, before, listFiles(t, fs))
+
+		_, err = CreateOperations(appMock, "env1", false)
+		require.Error(t, err)
+	})
+}
+
+func TestRenameOperations(t *testing.T) {
+	withEnv(t, func(appMock *mocks.App, fs afero.Fs) {
+		appMock.On("Environments").Return(renameTestEnvironments, nil)
+		appMock.On("Environment", mock.Anything).Return(nil, errors.New("not found"))
+
+		before := listFiles(t, fs)
+
+		ops, err := RenameOperations(appMock, "env1", "renamed", true)
+		require.NoError(t, err)
+
+		expected := []Operation{
+			{Action: OpMove, Path: "environments/env1/globals.libsonnet", To: "environments/renamed/globals.libsonnet"},
+			{Action: OpMove, Path: "environments/env1/main.jsonnet", To: "environments/renamed/main.jsonnet"},
+			{Action: OpMove, Path: "environments/env1/params.libsonnet", To: "environments/renamed/params.libsonnet"},
+			{Action: OpUpdate, Path: "app.override.yaml"},
+			{Action: OpRemove, Path: "environments/env1/", Detail: "empty directory"},
+		}
+		require.Equal(t, expected, ops)
+		require.Equal(t, before, listFiles(t, fs))
+
+		_, err = RenameOperations(appMock, "env1", "env2/staging", false)
+		require.Error(t, err)
+	})
+}
+
+func TestDeleteOperations(t *testing.T) {
+	withEnv(t, func(appMock *mocks.App, fs afero.Fs) {
+		appMock.On("Environment", "nest/env3").Return(&app.EnvironmentConfig{Path: "nest/env3"}, nil)
+		appMock.On("Environment", "missing").Return(nil, errors.New("not found"))
+
+		before := listFiles(t, fs)
+
+		ops, err := DeleteOperations(appMock, "nest/env3", false)
+		require.NoError(t, err)
+
+		expected := []Operation{
+			{Action: OpRemove, Path: "environments/nest/env3/globals.libsonnet"},
+			{Action: OpRemove, Path: "environments/nest/env3/main.jsonnet"},
+			{Action: OpRemove, Path: "environments/nest/env3/params.libsonnet"},
+			{Action: OpRemove, Path: "environments/nest/env3/"},
+			{Action: OpUpdate, Path: "app.yaml"},
+			{Action: OpRemove, Path: "environments/nest/", Detail: "empty directory"},
+		}
+		require.Equal(t, expected, ops)
+		require.Equal(t, before, listFiles(t, fs))
+
+		_, err = DeleteOperations(appMock, "missing", false)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,171 @@
+// Copyright 2018 The kubecfg authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package env
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/afero"
+)
+
+// Skeletons returns the directories in the environments tree that look like
+// environments, but have no configuration in app.yaml. These are left behind
+// by failed environment creations. A directory is only a skeleton if it holds
+// nothing but environment files. Paths are relative to the environments
+// directory, and only the top most skeleton of a tree is returned.
+func Skeletons(a app.App) ([]string, error) {
+	envs, err := a.Environments()
+	if err != nil {
+		return nil, err
+	}
+
+	var envPaths []string
+	for _, e := range envs {
+		envPaths = append(envPaths, filepath.Clean(e.Path))
+	}
+
+	fs := a.Fs()
+	root := filepath.Join(a.Root(), envRootName)
+
+	var skeletons []string
+	err = afero.Walk(fs, root, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !fi.IsDir() || path == root {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+
+		switch envRelation(rel, envPaths) {
+		case envAncestor:
+			return nil
+		case envMember:
+			return filepath.SkipDir
+		}
+
+		ok, err := onlyEnvFiles(fs, path)
+		if err != nil {
+			return err
+		}
+
+		if ok {
+			skeletons = append(skeletons, rel)
+		} else {
+			log.Debugf("Skipping %q because it contains files which aren't environment files", path)
+		}
+
+		return filepath.SkipDir
+	})
+
+	if err != nil {
+		return nil, errors.Wrap(err, "finding environment skeletons")
+	}
+
+	sort.Strings(skeletons)
+	return skeletons, nil
+}
+
+// PruneSkeletons removes skeleton directories, as returned by Skeletons, and
+// the empty parent directories they leave behind.
+func PruneSkeletons(a app.App, skeletons []string) error {
+	root := filepath.Join(a.Root(), envRootName)
+
+	for _, skeleton := range skeletons {
+		log.Infof("Removing environment skeleton %q", skeleton)
+		if err := a.Fs().RemoveAll(filepath.Join(root, skeleton)); err != nil {
+			return err
+		}
+
+		for dir := filepath.Dir(skeleton); dir != "."; dir = filepath.Dir(dir) {
+			path := filepath.Join(root, dir)
+			isEmpty, err := afero.IsEmpty(a.Fs(), path)
+			if err != nil {
+				return err
+			}
+
+			if !isEmpty {
+				break
+			}
+
+			log.Debugf("Removing empty environment directory %q", path)
+			if err := a.Fs().Remove(path); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+type envRelationType int
+
+const (
+	envUnrelated envRelationType = iota
+	envAncestor
+	envMember
+)
+
+// envRelation describes how a directory relates to the environment paths.
+func envRelation(dir string, envPaths []string) envRelationType {
+	relation := envUnrelated
+	for _, envPath := range envPaths {
+		if dir == envPath || strings.HasPrefix(dir, envPath+string(filepath.Separator)) {
+			return envMember
+		}
+
+		if strings.HasPrefix(envPath, dir+string(filepath.Separator)) {
+			relation = envAncestor
+		}
+	}
+
+	return relation
+}
+
+// onlyEnvFiles returns true if the files below dir are all environment files.
+func onlyEnvFiles(fs afero.Fs, dir string) (bool, error) {
+	ok := true
+	err := afero.Walk(fs, dir, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if fi.IsDir() {
+			return nil
+		}
+
+		switch fi.Name() {
+		case envFileName, paramsFileName, globalsFileName:
+			return nil
+		}
+
+		ok = false
+		return nil
+	})
+
+	return ok, err
+}
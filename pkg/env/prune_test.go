@@ -0,0 +1,80 @@
+// Copyright 2018 The kubecfg authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package env
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func withSkeletons(t *testing.T, fn func(*mocks.App, afero.Fs)) {
+	withEnv(t, func(appMock *mocks.App, fs afero.Fs) {
+		envs := app.EnvironmentConfigs{
+			"env1":        &app.EnvironmentConfig{Path: "env1"},
+			"env2":        &app.EnvironmentConfig{Path: "env2"},
+			"nested/env3": &app.EnvironmentConfig{Path: "nest/env3"},
+		}
+		appMock.On("Environments").Return(envs, nil)
+
+		// skeletons of failed creations
+		stageFile(t, fs, "main.jsonnet", "/environments/failed/main.jsonnet")
+		stageFile(t, fs, "main.jsonnet", "/environments/nest/failed/main.jsonnet")
+		stageFile(t, fs, "params.libsonnet", "/environments/deep/er/params.libsonnet")
+		require.NoError(t, fs.MkdirAll("/environments/empty", app.DefaultFolderPermissions))
+
+		// not a skeleton, because it contains other files
+		stageFile(t, fs, "main.jsonnet", "/environments/shared/main.jsonnet")
+		stageFile(t, fs, "value.txt", "/environments/shared/value.txt")
+
+		fn(appMock, fs)
+	})
+}
+
+func TestSkeletons(t *testing.T) {
+	withSkeletons(t, func(appMock *mocks.App, fs afero.Fs) {
+		got, err := Skeletons(appMock)
+		require.NoError(t, err)
+
+		expected := []string{
+			"deep",
+			"empty",
+			"failed",
+			filepath.Join("nest", "failed"),
+		}
+		require.Equal(t, expected, got)
+	})
+}
+
+func TestPruneSkeletons(t *testing.T) {
+	withSkeletons(t, func(appMock *mocks.App, fs afero.Fs) {
+		stageFile(t, fs, "main.jsonnet", "/environments/lonely/skeleton/main.jsonnet")
+
+		skeletons := []string{"failed", filepath.Join("lonely", "skeleton")}
+		err := PruneSkeletons(appMock, skeletons)
+		require.NoError(t, err)
+
+		checkNotExists(t, fs, "/environments/failed")
+		checkNotExists(t, fs, "/environments/lonely")
+		checkExists(t, fs, "/environments/nest/env3/main.jsonnet")
+		checkExists(t, fs, "/environments/nest/failed")
+		checkExists(t, fs, "/environments/env1/main.jsonnet")
+	})
+}
@@ -0,0 +1,94 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package env
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+)
+
+// componentsDirName is the name of the directory of an app's components and
+// their parameters.
+const componentsDirName = "components"
+
+// References returns the sorted paths, relative to the app root, of the
+// component, parameter and environment files that refer to an environment by
+// its quoted name. The environment's own files aren't included, and neither
+// is generated ksonnet-lib.
+func References(a app.App, name string) ([]string, error) {
+	fs := a.Fs()
+	envPath := filepath.Join(a.Root(), envRootName, name)
+	quoted := [][]byte{
+		[]byte(`"` + name + `"`),
+		[]byte(`'` + name + `'`),
+	}
+
+	var paths []string
+	for _, dir := range []string{componentsDirName, envRootName} {
+		root := filepath.Join(a.Root(), dir)
+
+		exists, err := afero.DirExists(fs, root)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			continue
+		}
+
+		err = afero.Walk(fs, root, func(path string, fi os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if fi.IsDir() {
+				if path == envPath || fi.Name() == ".metadata" {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+
+			data, err := afero.ReadFile(fs, path)
+			if err != nil {
+				return err
+			}
+
+			for _, q := range quoted {
+				if bytes.Contains(data, q) {
+					rel, err := filepath.Rel(a.Root(), path)
+					if err != nil {
+						return err
+					}
+					paths = append(paths, filepath.ToSlash(rel))
+					break
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "searching %s for references to environment %q", dir, name)
+		}
+	}
+
+	sort.Strings(paths)
+	return paths, nil
+}
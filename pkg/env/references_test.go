@@ -0,0 +1,55 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package env
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReferences(t *testing.T) {
+	withEnv(t, func(appMock *mocks.App, fs afero.Fs) {
+		files := map[string]string{
+			"/components/params.libsonnet":                 `{ components: { app: { replicas: if env == "env1" then 3 else 1 } } }`,
+			"/components/deployment.jsonnet":               `local env = std.extVar("__ksonnet/environments");`,
+			"/components/scripts/promote.jsonnet":          `{ from: 'env1', to: 'env2' }`,
+			"/components/unquoted.jsonnet":                 `// env1 and env10 are mentioned here`,
+			"/environments/env2/params.libsonnet":          `{ source: "env1" }`,
+			"/environments/env1/params.libsonnet":          `{ name: "env1" }`,
+			"/environments/env2/.metadata/k8s.libsonnet":   `{ generatedFor: "env1" }`,
+			"/environments/nest/env3/components.libsonnet": `{ other: "env10" }`,
+			"/lib/v1.8.7/k8s.libsonnet":                    `{ generatedFor: "env1" }`,
+		}
+		for path, content := range files {
+			require.NoError(t, fs.MkdirAll(filepath.Dir(path), 0755))
+			require.NoError(t, afero.WriteFile(fs, path, []byte(content), 0644))
+		}
+
+		paths, err := References(appMock, "env1")
+		require.NoError(t, err)
+
+		expected := []string{
+			"components/params.libsonnet",
+			"components/scripts/promote.jsonnet",
+			"environments/env2/params.libsonnet",
+		}
+		require.Equal(t, expected, paths)
+	})
+}
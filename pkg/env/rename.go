@@ -18,7 +18,10 @@ package env
 import (
 	"fmt"
 	"os"
+	"path"
 	"path/filepath"
+	"sort"
+	"strings"
 
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
@@ -57,6 +60,10 @@ func (r *renamer) Rename() error {
 		return nil
 	}
 
+	// Names are paths below environments/, so names that only differ in
+	// redundant slashes or dots refer to the same directory.
+	r.to = path.Clean(r.to)
+
 	if err := r.preflight(); err != nil {
 		return err
 	}
@@ -76,9 +83,8 @@ func (r *renamer) Rename() error {
 }
 
 func (r *renamer) preflight() error {
-	if !isValidName(r.to) {
-		return fmt.Errorf("Environment name %q is not valid; must not contain punctuation, spaces, or begin or end with a slash",
-			r.to)
+	if err := ValidateName(r.to); err != nil {
+		return err
 	}
 
 	exists, err := envExists(r.app, r.to)
@@ -90,7 +96,47 @@ func (r *renamer) preflight() error {
 		return fmt.Errorf("Failed to update %q; environment %q exists", r.from, r.to)
 	}
 
-	return nil
+	return r.checkCollisions()
+}
+
+// checkCollisions returns an error if the directory of the renamed
+// environment would be, contain, or be inside the directory of another
+// environment. Nothing has been moved when it is called.
+func (r *renamer) checkCollisions() error {
+	environments, err := r.app.Environments()
+	if err != nil {
+		return errors.Wrap(err, "retrieving environments")
+	}
+
+	var conflicts []string
+	for name, env := range environments {
+		if name == r.from {
+			continue
+		}
+
+		envPath := env.Path
+		if envPath == "" {
+			envPath = name
+		}
+		envPath = path.Clean(envPath)
+
+		if envPath == r.to || isSubPath(r.to, envPath) || isSubPath(envPath, r.to) {
+			conflicts = append(conflicts, path.Join(envRootName, envPath))
+		}
+	}
+
+	if len(conflicts) == 0 {
+		return nil
+	}
+
+	sort.Strings(conflicts)
+	return errors.Errorf("Failed to update %q; %q collides with the environments at: %s",
+		r.from, path.Join(envRootName, r.to), strings.Join(conflicts, ", "))
+}
+
+// isSubPath returns true if child is a path below parent.
+func isSubPath(child, parent string) bool {
+	return strings.HasPrefix(child, parent+"/")
 }
 
 func envExists(ksApp app.App, name string) (bool, error) {
@@ -16,9 +16,12 @@
 package env
 
 import (
+	"os"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/afero"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/ksonnet/ksonnet/pkg/app"
@@ -28,6 +31,7 @@ import (
 func TestRename(t *testing.T) {
 	withEnv(t, func(appMock *mocks.App, fs afero.Fs) {
 		appMock.On("RenameEnvironment", "env1", "env1-updated", false).Return(nil)
+		appMock.On("Environments").Return(renameTestEnvironments, nil)
 
 		envSpec := &app.EnvironmentConfig{Path: "env1-updated"}
 		appMock.On("Environment", "env1-updated").Return(envSpec, nil)
@@ -36,3 +40,66 @@ func TestRename(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+var renameTestEnvironments = app.EnvironmentConfigs{
+	"env1":      {Path: "env1"},
+	"env2":      {Path: "env2"},
+	"nest/env3": {Path: "nest/env3"},
+}
+
+func TestRename_collision(t *testing.T) {
+	cases := []struct {
+		name     string
+		to       string
+		conflict string
+	}{
+		{
+			name:     "inside an environment",
+			to:       "env2/staging",
+			conflict: "environments/env2",
+		},
+		{
+			name:     "containing an environment",
+			to:       "nest",
+			conflict: "environments/nest/env3",
+		},
+		{
+			name:     "same path once normalized",
+			to:       "nest//env3/.",
+			conflict: "environments/nest/env3",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withEnv(t, func(appMock *mocks.App, fs afero.Fs) {
+				appMock.On("Environments").Return(renameTestEnvironments, nil)
+				appMock.On("Environment", mock.Anything).Return(nil, errors.New("not found"))
+
+				before := listFiles(t, fs)
+
+				err := Rename(appMock, "env1", tc.to, false)
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.conflict)
+
+				require.Equal(t, before, listFiles(t, fs))
+				appMock.AssertNotCalled(t, "RenameEnvironment", mock.Anything, mock.Anything, mock.Anything)
+			})
+		})
+	}
+}
+
+func listFiles(t *testing.T, fs afero.Fs) []string {
+	var files []string
+	err := afero.Walk(fs, "/", func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		files = append(files, path)
+		return nil
+	})
+	require.NoError(t, err)
+
+	return files
+}
@@ -0,0 +1,67 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package env
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// NormalizeServer returns server in the form it is saved as the server of an
+// environment. A server given as only a host and port is assumed to use https,
+// and assumed is true. A trailing slash is removed. The scheme must be http or
+// https, and the server can't have a path, query or fragment, as client-go
+// would misread them.
+func NormalizeServer(server string) (normalized string, assumed bool, err error) {
+	if server == "" {
+		return "", false, errors.New("server can't be empty")
+	}
+
+	s := server
+	if !strings.Contains(s, "://") {
+		s = "https://" + s
+		assumed = true
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return "", false, errors.Wrapf(err, "server %q is not a valid URL", server)
+	}
+
+	invalid := func(reason string) error {
+		return errors.Errorf("server %q is not valid; %s (scheme %q, host %q, path %q, query %q, fragment %q)",
+			server, reason, u.Scheme, u.Host, u.Path, u.RawQuery, u.Fragment)
+	}
+
+	switch {
+	case u.Scheme != "http" && u.Scheme != "https":
+		return "", false, invalid("the scheme must be http or https")
+	case u.Host == "":
+		return "", false, invalid("it has no host")
+	case u.User != nil:
+		return "", false, invalid("it can't contain credentials")
+	case u.Path != "" && u.Path != "/":
+		return "", false, invalid("it can't have a path")
+	case u.RawQuery != "" || u.ForceQuery:
+		return "", false, invalid("it can't have a query")
+	case u.Fragment != "" || strings.Contains(s, "#"):
+		return "", false, invalid("it can't have a fragment")
+	}
+
+	return u.Scheme + "://" + u.Host, assumed, nil
+}
@@ -0,0 +1,106 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package env
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeServer(t *testing.T) {
+	cases := []struct {
+		name     string
+		server   string
+		expected string
+		assumed  bool
+		err      string
+	}{
+		{
+			name:     "https",
+			server:   "https://example.com:6443",
+			expected: "https://example.com:6443",
+		},
+		{
+			name:     "http",
+			server:   "http://127.0.0.1:8080",
+			expected: "http://127.0.0.1:8080",
+		},
+		{
+			name:     "schemeless",
+			server:   "example.com:6443",
+			expected: "https://example.com:6443",
+			assumed:  true,
+		},
+		{
+			name:     "schemeless ip",
+			server:   "192.168.99.100:8443",
+			expected: "https://192.168.99.100:8443",
+			assumed:  true,
+		},
+		{
+			name:     "trailing slash",
+			server:   "https://example.com:6443/",
+			expected: "https://example.com:6443",
+		},
+		{
+			name:   "query string",
+			server: "https://example.com:6443?timeout=5s",
+			err:    `server "https://example.com:6443?timeout=5s" is not valid; it can't have a query (scheme "https", host "example.com:6443", path "", query "timeout=5s", fragment "")`,
+		},
+		{
+			name:   "path",
+			server: "https://example.com/api",
+			err:    `server "https://example.com/api" is not valid; it can't have a path (scheme "https", host "example.com", path "/api", query "", fragment "")`,
+		},
+		{
+			name:   "fragment",
+			server: "https://example.com#prod",
+			err:    `server "https://example.com#prod" is not valid; it can't have a fragment (scheme "https", host "example.com", path "", query "", fragment "prod")`,
+		},
+		{
+			name:   "scheme",
+			server: "tcp://example.com:6443",
+			err:    `server "tcp://example.com:6443" is not valid; the scheme must be http or https (scheme "tcp", host "example.com:6443", path "", query "", fragment "")`,
+		},
+		{
+			name:   "no host",
+			server: "https://",
+			err:    `server "https://" is not valid; it has no host (scheme "https", host "", path "", query "", fragment "")`,
+		},
+		{
+			name:   "empty",
+			server: "",
+			err:    "server can't be empty",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, assumed, err := NormalizeServer(tc.server)
+			if tc.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, tc.err, err.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+			assert.Equal(t, tc.assumed, assumed)
+		})
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package env
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+)
+
+// Snapshot is a copy of the app configuration and of the directory of an
+// environment, taken before they are changed, so a change which fails part way
+// can be rolled back.
+type Snapshot struct {
+	fs afero.Fs
+	// files are the contents of the saved files. A nil file didn't exist.
+	files map[string]*snapshotFile
+	// newDir is the directory the environment is moved to, if any.
+	newDir string
+	// createdDir is the top most directory moving the environment creates.
+	createdDir string
+}
+
+type snapshotFile struct {
+	data []byte
+	mode os.FileMode
+}
+
+// TakeSnapshot saves app.yaml, app.override.yaml and the files of the
+// environment directory at envPath. If the environment is being moved to
+// newPath, the files there are saved as well. Paths are relative to the
+// environments directory.
+func TakeSnapshot(a app.App, envPath, newPath string) (*Snapshot, error) {
+	s := &Snapshot{
+		fs:    a.Fs(),
+		files: make(map[string]*snapshotFile),
+	}
+
+	for _, name := range []string{appYAML, overrideYAML} {
+		if err := s.saveFile(filepath.Join(a.Root(), name)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.saveDir(filepath.Join(a.Root(), envRootName, envPath)); err != nil {
+		return nil, err
+	}
+
+	if newPath == "" {
+		return s, nil
+	}
+
+	s.newDir = filepath.Join(a.Root(), envRootName, newPath)
+	if err := s.saveDir(s.newDir); err != nil {
+		return nil, err
+	}
+
+	for dir := s.newDir; ; dir = filepath.Dir(dir) {
+		exists, err := afero.Exists(s.fs, dir)
+		if err != nil {
+			return nil, err
+		}
+
+		if exists || dir == filepath.Dir(dir) {
+			break
+		}
+		s.createdDir = dir
+	}
+
+	return s, nil
+}
+
+func (s *Snapshot) saveFile(path string) error {
+	fi, err := s.fs.Stat(path)
+	if os.IsNotExist(err) {
+		s.files[path] = nil
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	data, err := afero.ReadFile(s.fs, path)
+	if err != nil {
+		return errors.Wrapf(err, "saving %s", path)
+	}
+
+	s.files[path] = &snapshotFile{data: data, mode: fi.Mode()}
+	return nil
+}
+
+func (s *Snapshot) saveDir(dir string) error {
+	exists, err := afero.DirExists(s.fs, dir)
+	if err != nil || !exists {
+		return err
+	}
+
+	return afero.Walk(s.fs, dir, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if fi.IsDir() {
+			return nil
+		}
+
+		return s.saveFile(path)
+	})
+}
+
+// Restore puts the saved files back as they were when the snapshot was taken.
+// Files which have since been created in the directory the environment was
+// moved to are removed.
+func (s *Snapshot) Restore() error {
+	if s.createdDir != "" {
+		if err := s.fs.RemoveAll(s.createdDir); err != nil {
+			return err
+		}
+	} else if s.newDir != "" {
+		if err := s.removeNewFiles(s.newDir); err != nil {
+			return err
+		}
+	}
+
+	for path, f := range s.files {
+		if f == nil {
+			if err := s.fs.Remove(path); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+			continue
+		}
+
+		if err := s.fs.MkdirAll(filepath.Dir(path), app.DefaultFolderPermissions); err != nil {
+			return err
+		}
+
+		if err := afero.WriteFile(s.fs, path, f.data, f.mode); err != nil {
+			return errors.Wrapf(err, "restoring %s", path)
+		}
+	}
+
+	return nil
+}
+
+// removeNewFiles removes the files below dir which weren't saved.
+func (s *Snapshot) removeNewFiles(dir string) error {
+	var paths []string
+	err := afero.Walk(s.fs, dir, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+
+		if _, ok := s.files[path]; !ok && !fi.IsDir() {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, path := range paths {
+		if err := s.fs.Remove(path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
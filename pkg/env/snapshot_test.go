@@ -0,0 +1,93 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package env
+
+import (
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshot_Restore(t *testing.T) {
+	cases := []struct {
+		name    string
+		newPath string
+		change  func(t *testing.T, fs afero.Fs)
+		removed []string
+	}{
+		{
+			name:    "partly moved environment",
+			newPath: "moved/env1",
+			change: func(t *testing.T, fs afero.Fs) {
+				require.NoError(t, fs.MkdirAll("/environments/moved/env1", 0755))
+				require.NoError(t, fs.Rename("/environments/env1/main.jsonnet", "/environments/moved/env1/main.jsonnet"))
+				require.NoError(t, afero.WriteFile(fs, "/app.yaml", []byte("changed"), 0644))
+			},
+			removed: []string{"/environments/moved"},
+		},
+		{
+			name:    "moved into an existing directory",
+			newPath: "nest/env1",
+			change: func(t *testing.T, fs afero.Fs) {
+				require.NoError(t, fs.MkdirAll("/environments/nest/env1", 0755))
+				require.NoError(t, fs.Rename("/environments/env1/params.libsonnet", "/environments/nest/env1/params.libsonnet"))
+			},
+			removed: []string{"/environments/nest/env1"},
+		},
+		{
+			name: "changed config",
+			change: func(t *testing.T, fs afero.Fs) {
+				require.NoError(t, afero.WriteFile(fs, "/app.yaml", []byte("changed"), 0644))
+				require.NoError(t, afero.WriteFile(fs, "/app.override.yaml", []byte("created"), 0644))
+			},
+			removed: []string{"/app.override.yaml"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withEnv(t, func(appMock *mocks.App, fs afero.Fs) {
+				before := map[string]string{}
+				for _, path := range []string{"/app.yaml", "/environments/env1/main.jsonnet", "/environments/env1/params.libsonnet", "/environments/nest/env3/main.jsonnet"} {
+					b, err := afero.ReadFile(fs, path)
+					require.NoError(t, err)
+					before[path] = string(b)
+				}
+
+				s, err := TakeSnapshot(appMock, "env1", tc.newPath)
+				require.NoError(t, err)
+
+				tc.change(t, fs)
+
+				require.NoError(t, s.Restore())
+
+				for path, expected := range before {
+					b, err := afero.ReadFile(fs, path)
+					require.NoError(t, err)
+					require.Equal(t, expected, string(b), "contents of %s", path)
+				}
+
+				for _, path := range tc.removed {
+					exists, err := afero.Exists(fs, path)
+					require.NoError(t, err)
+					require.False(t, exists, "%s exists", path)
+				}
+			})
+		})
+	}
+}
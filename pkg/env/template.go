@@ -0,0 +1,73 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package env
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"text/template"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+)
+
+const (
+	// TemplateDirName is the directory of an app with the templates of new
+	// environments.
+	TemplateDirName = "templates"
+
+	overrideTemplateName = "environment.jsonnet"
+)
+
+// TemplateData are the values available to the template of a new
+// environment's `main.jsonnet`.
+type TemplateData struct {
+	// Name is the name of the environment, e.g. `us-west/staging`.
+	Name string
+	// Namespace is the namespace of the environment.
+	Namespace string
+	// Server is the address of the Kubernetes API server of the environment.
+	Server string
+}
+
+// OverrideData returns the contents of a new environment's `main.jsonnet`. If
+// the app has a `templates/environment.jsonnet`, it is rendered as a Go
+// template with data. Otherwise, DefaultOverrideData is returned.
+func OverrideData(a app.App, data TemplateData) ([]byte, error) {
+	rel := filepath.Join(TemplateDirName, overrideTemplateName)
+
+	src, err := afero.ReadFile(a.Fs(), filepath.Join(a.Root(), rel))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return DefaultOverrideData, nil
+		}
+		return nil, err
+	}
+
+	t, err := template.New(overrideTemplateName).Parse(string(src))
+	if err != nil {
+		return nil, errors.Wrapf(err, "parse environment template %s", rel)
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return nil, errors.Wrapf(err, "render environment template %s", rel)
+	}
+
+	return buf.Bytes(), nil
+}
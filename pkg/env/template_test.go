@@ -0,0 +1,81 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package env
+
+import (
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOverrideData(t *testing.T) {
+	data := TemplateData{
+		Name:      "us-west/staging",
+		Namespace: "web",
+		Server:    "https://staging.example.com",
+	}
+
+	cases := []struct {
+		name     string
+		template string
+		expected string
+		isErr    bool
+	}{
+		{
+			name:     "no template",
+			expected: string(DefaultOverrideData),
+		},
+		{
+			name:     "template",
+			template: "local base = import \"base.libsonnet\";\n// {{.Name}} in {{.Namespace}} on {{.Server}}\nbase + {}\n",
+			expected: "local base = import \"base.libsonnet\";\n// us-west/staging in web on https://staging.example.com\nbase + {}\n",
+		},
+		{
+			name:     "invalid template",
+			template: "base + {{.Name}\n",
+			isErr:    true,
+		},
+		{
+			name:     "unknown variable",
+			template: "base + {{.Cluster}}\n",
+			isErr:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withEnv(t, func(appMock *mocks.App, fs afero.Fs) {
+				if tc.template != "" {
+					require.NoError(t, fs.MkdirAll("/templates", app.DefaultFolderPermissions))
+					require.NoError(t, afero.WriteFile(fs, "/templates/environment.jsonnet", []byte(tc.template), 0644))
+				}
+
+				b, err := OverrideData(appMock, data)
+				if tc.isErr {
+					require.Error(t, err)
+					return
+				}
+
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, string(b))
+			})
+		})
+	}
+}
@@ -0,0 +1,4 @@
+{
+  foo: 'bar',
+  domain: 'example.com',
+}
\ No newline at end of file
@@ -0,0 +1,21 @@
+// Helm values that could not be mapped to parameters:
+//   component1.tag
+//   global.unset
+//   ingress
+local params = import '../../components/params.libsonnet';
+
+params + {
+  components+: {
+    component1+: {
+      foo: 'bar',
+      image: 'nginx:1.15',
+      labels: {
+        tier: 'web',
+      },
+      replicas: 3,
+    },
+    "nested.component2"+: {
+      enabled: true,
+    },
+  },
+}
\ No newline at end of file
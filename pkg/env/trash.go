@@ -0,0 +1,317 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package env
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/ghodss/yaml"
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/afero"
+)
+
+const (
+	// TrashDirName is the directory in the root of an app where removed
+	// environments are kept until they are restored.
+	TrashDirName = ".trash"
+
+	// trashEntryDir is the directory of a removed environment in the trash,
+	// below the path of its name. Environment names can't have a segment with
+	// this name, so trashed environments whose names are nested don't collide.
+	trashEntryDir   = ".metadata"
+	trashConfigFile = "environment.yaml"
+	trashFilesDir   = "files"
+)
+
+// trashedEnvironment is what is kept about a removed environment, so it can be
+// restored with the same name and configuration.
+type trashedEnvironment struct {
+	Name        string                 `json:"name"`
+	Override    bool                   `json:"override,omitempty"`
+	Environment *app.EnvironmentConfig `json:"environment"`
+}
+
+// Trash removes an environment, moving the files of its directory to the trash
+// of the app so it can be restored. An environment of the same name that was
+// removed before is replaced.
+func Trash(a app.App, name string, override bool) error {
+	config, err := a.Environment(name)
+	if err != nil {
+		return err
+	}
+
+	fs := a.Fs()
+	envPath := filepath.Join(a.Root(), envRootName, name)
+	entryPath := trashEntryPath(a, name)
+
+	exists, err := afero.DirExists(fs, entryPath)
+	if err != nil {
+		return err
+	}
+	if exists {
+		log.Infof("Replacing environment %q removed before in the trash", name)
+		if err = fs.RemoveAll(entryPath); err != nil {
+			return err
+		}
+	}
+
+	data, err := yaml.Marshal(&trashedEnvironment{
+		Name:        name,
+		Override:    override,
+		Environment: config,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err = fs.MkdirAll(entryPath, app.DefaultFolderPermissions); err != nil {
+		return err
+	}
+
+	if err = afero.WriteFile(fs, filepath.Join(entryPath, trashConfigFile), data, app.DefaultFilePermissions); err != nil {
+		return err
+	}
+
+	filesPath := filepath.Join(entryPath, trashFilesDir)
+	if err = moveEnvFiles(fs, envPath, filesPath); err != nil {
+		undoTrash(a, filesPath, envPath, entryPath)
+		return errors.Wrapf(err, "move environment %q to the trash", name)
+	}
+
+	// The files are moved back if the environment can't be removed, so it
+	// isn't left without them.
+	if err = a.RemoveEnvironment(name, override); err != nil {
+		undoTrash(a, filesPath, envPath, entryPath)
+		return err
+	}
+
+	if err = cleanEmptyDirs(a); err != nil {
+		return err
+	}
+
+	log.Infof("Moved environment %q to %s; restore it with `ks env restore %s`",
+		name, path.Join(TrashDirName, envRootName, name), name)
+	return nil
+}
+
+// Restore restores an environment that was moved to the trash of the app.
+func Restore(a app.App, name string) error {
+	fs := a.Fs()
+	entryPath := trashEntryPath(a, name)
+
+	data, err := afero.ReadFile(fs, filepath.Join(entryPath, trashConfigFile))
+	if os.IsNotExist(err) {
+		return errors.Errorf("environment %q is not in the trash", name)
+	}
+	if err != nil {
+		return err
+	}
+
+	var trashed trashedEnvironment
+	if err = yaml.Unmarshal(data, &trashed); err != nil {
+		return errors.Wrapf(err, "read environment %q from the trash", name)
+	}
+	if trashed.Environment == nil {
+		return errors.Errorf("environment %q in the trash has no configuration", name)
+	}
+
+	environments, err := a.Environments()
+	if err != nil {
+		return err
+	}
+
+	for envName := range environments {
+		if envName == name || isSubPath(name, envName) || isSubPath(envName, name) {
+			return errors.Errorf("environment %q can't be restored, because it collides with environment %q", name, envName)
+		}
+	}
+
+	envPath := filepath.Join(a.Root(), envRootName, name)
+	filesPath := filepath.Join(entryPath, trashFilesDir)
+	if err = moveEnvFiles(fs, filesPath, envPath); err != nil {
+		return errors.Wrapf(err, "restore environment %q from the trash", name)
+	}
+
+	// The files are moved back to the trash if the environment can't be
+	// added, so restoring it can be retried.
+	trashed.Environment.Name = name
+	if err = a.AddEnvironment(trashed.Environment, "", trashed.Override); err != nil {
+		undoRestore(a, envPath, filesPath)
+		return err
+	}
+
+	if err = fs.RemoveAll(entryPath); err != nil {
+		return err
+	}
+
+	if err = cleanEmptyTrashDirs(a); err != nil {
+		return err
+	}
+
+	log.Infof("Restored environment %q", name)
+	return nil
+}
+
+// undoTrash moves the files of an environment that couldn't be moved to the
+// trash back to its directory, and removes its trash entry.
+func undoTrash(a app.App, filesPath, envPath, entryPath string) {
+	fs := a.Fs()
+	if err := moveEnvFiles(fs, filesPath, envPath); err != nil && !os.IsNotExist(err) {
+		log.Warnf("Unable to move the files of the environment back from %s: %v", filesPath, err)
+		return
+	}
+
+	if err := fs.RemoveAll(entryPath); err != nil {
+		log.Warnf("Unable to remove %s: %v", entryPath, err)
+		return
+	}
+
+	if err := cleanEmptyTrashDirs(a); err != nil {
+		log.Warnf("Unable to clean up the trash: %v", err)
+	}
+}
+
+// undoRestore moves the files of an environment that couldn't be restored
+// back to the trash.
+func undoRestore(a app.App, envPath, filesPath string) {
+	fs := a.Fs()
+	if err := moveEnvFiles(fs, envPath, filesPath); err != nil {
+		log.Warnf("Unable to move the files of the environment back to %s: %v", filesPath, err)
+		return
+	}
+
+	if err := cleanEmptyDirs(a); err != nil {
+		log.Warnf("Unable to clean up empty environment directories: %v", err)
+	}
+}
+
+// TrashOperations returns the operations Trash would make.
+func TrashOperations(a app.App, name string, override bool) ([]Operation, error) {
+	if _, err := a.Environment(name); err != nil {
+		return nil, err
+	}
+
+	fs := a.Fs()
+	envDir := filepath.Join(a.Root(), envRootName, name)
+	to := path.Join(TrashDirName, envRootName, name, trashEntryDir)
+
+	fis, err := afero.ReadDir(fs, envDir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, errors.Wrapf(err, "read environment %q", name)
+	}
+
+	moved := make(map[string]bool)
+	var ops []Operation
+	for _, fi := range fis {
+		if fi.IsDir() && fi.Name() != ".metadata" {
+			continue
+		}
+
+		moved[filepath.Join(envDir, fi.Name())] = true
+		ops = append(ops, Operation{
+			Action: OpMove,
+			Path:   path.Join(envRootName, name, fi.Name()),
+			To:     path.Join(to, trashFilesDir, fi.Name()),
+		})
+	}
+
+	ops = append(ops,
+		Operation{Action: OpCreate, Path: path.Join(to, trashConfigFile)},
+		ConfigOperation(override))
+
+	cleaned, err := cleanedDirOperations(a, func(p string) bool { return moved[p] })
+	if err != nil {
+		return nil, err
+	}
+
+	return append(ops, cleaned...), nil
+}
+
+// moveEnvFiles moves the files of an environment's directory, and its
+// .metadata directory, to another directory. Nested environments stay where
+// they are, as when an environment is renamed.
+func moveEnvFiles(fs afero.Fs, from, to string) error {
+	fis, err := afero.ReadDir(fs, from)
+	if err != nil {
+		return err
+	}
+
+	if err = fs.MkdirAll(to, app.DefaultFolderPermissions); err != nil {
+		return err
+	}
+
+	for _, fi := range fis {
+		if fi.IsDir() && fi.Name() != ".metadata" {
+			continue
+		}
+
+		if err = fs.Rename(filepath.Join(from, fi.Name()), filepath.Join(to, fi.Name())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// trashEntryPath returns the path of an environment in the trash.
+func trashEntryPath(a app.App, name string) string {
+	return filepath.Join(a.Root(), TrashDirName, envRootName, filepath.FromSlash(name), trashEntryDir)
+}
+
+// cleanEmptyTrashDirs removes the directories left empty in the trash, and
+// the trash itself if it is empty.
+func cleanEmptyTrashDirs(a app.App) error {
+	fs := a.Fs()
+	trashPath := filepath.Join(a.Root(), TrashDirName)
+
+	var dirs []string
+	err := afero.Walk(fs, trashPath, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if fi.IsDir() {
+			dirs = append(dirs, p)
+		}
+		return nil
+	})
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	// Remove the deepest directories first, so their parents can become empty.
+	for i := len(dirs) - 1; i >= 0; i-- {
+		isEmpty, err := afero.IsEmpty(fs, dirs[i])
+		if err != nil {
+			return err
+		}
+
+		if isEmpty {
+			if err := fs.Remove(dirs[i]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package env
+
+import (
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrash_restore(t *testing.T) {
+	withEnv(t, func(appMock *mocks.App, fs afero.Fs) {
+		appMock.On("RemoveEnvironment", "env1", true).Return(nil)
+
+		err := Trash(appMock, "env1", true)
+		require.NoError(t, err)
+
+		checkNotExists(t, fs, "/environments/env1")
+		checkExists(t, fs, "/.trash/environments/env1/.metadata/files/main.jsonnet")
+		checkExists(t, fs, "/.trash/environments/env1/.metadata/environment.yaml")
+
+		appMock.On("Environments").Return(app.EnvironmentConfigs{
+			"env2":      &app.EnvironmentConfig{Path: "env2"},
+			"nest/env3": &app.EnvironmentConfig{Path: "nest/env3"},
+		}, nil)
+		appMock.On("AddEnvironment", mock.MatchedBy(func(e *app.EnvironmentConfig) bool {
+			return e.Name == "env1" && e.Path == "env1"
+		}), "", true).Return(nil)
+
+		err = Restore(appMock, "env1")
+		require.NoError(t, err)
+
+		checkExists(t, fs, "/environments/env1/main.jsonnet")
+		checkExists(t, fs, "/environments/env1/params.libsonnet")
+		checkExists(t, fs, "/environments/env1/globals.libsonnet")
+		checkNotExists(t, fs, "/.trash")
+		appMock.AssertExpectations(t)
+	})
+}
+
+func TestRestore_not_in_trash(t *testing.T) {
+	withEnv(t, func(appMock *mocks.App, fs afero.Fs) {
+		err := Restore(appMock, "env1")
+		require.Error(t, err)
+	})
+}
+
+func TestRestore_collision(t *testing.T) {
+	withEnv(t, func(appMock *mocks.App, fs afero.Fs) {
+		appMock.On("RemoveEnvironment", "env1", false).Return(nil)
+
+		err := Trash(appMock, "env1", false)
+		require.NoError(t, err)
+
+		appMock.On("Environments").Return(app.EnvironmentConfigs{
+			"env1/staging": &app.EnvironmentConfig{Path: "env1/staging"},
+		}, nil)
+
+		err = Restore(appMock, "env1")
+		require.Error(t, err)
+
+		checkExists(t, fs, "/.trash/environments/env1/.metadata/files/main.jsonnet")
+		appMock.AssertNotCalled(t, "AddEnvironment", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestTrash_remove_fails(t *testing.T) {
+	withEnv(t, func(appMock *mocks.App, fs afero.Fs) {
+		appMock.On("RemoveEnvironment", "env1", false).Return(errors.New("failed"))
+
+		err := Trash(appMock, "env1", false)
+		require.Error(t, err)
+
+		checkExists(t, fs, "/environments/env1/main.jsonnet")
+		checkExists(t, fs, "/environments/env1/params.libsonnet")
+		checkNotExists(t, fs, "/.trash")
+	})
+}
+
+func TestRestore_add_fails(t *testing.T) {
+	withEnv(t, func(appMock *mocks.App, fs afero.Fs) {
+		appMock.On("RemoveEnvironment", "env1", false).Return(nil)
+
+		err := Trash(appMock, "env1", false)
+		require.NoError(t, err)
+
+		appMock.On("Environments").Return(app.EnvironmentConfigs{}, nil)
+		appMock.On("AddEnvironment", mock.Anything, "", false).Return(errors.New("failed")).Once()
+
+		err = Restore(appMock, "env1")
+		require.Error(t, err)
+
+		checkNotExists(t, fs, "/environments/env1")
+		checkExists(t, fs, "/.trash/environments/env1/.metadata/files/main.jsonnet")
+		checkExists(t, fs, "/.trash/environments/env1/.metadata/environment.yaml")
+
+		// Restoring can be retried.
+		appMock.On("AddEnvironment", mock.Anything, "", false).Return(nil)
+
+		err = Restore(appMock, "env1")
+		require.NoError(t, err)
+
+		checkExists(t, fs, "/environments/env1/main.jsonnet")
+		checkNotExists(t, fs, "/.trash")
+	})
+}
+
+func TestTrashOperations(t *testing.T) {
+	withEnv(t, func(appMock *mocks.App, fs afero.Fs) {
+		before := listFiles(t, fs)
+
+		ops, err := TrashOperations(appMock, "env1", false)
+		require.NoError(t, err)
+
+		expected := []Operation{
+			{Action: OpMove, Path: "environments/env1/globals.libsonnet", To: ".trash/environments/env1/.metadata/files/globals.libsonnet"},
+			{Action: OpMove, Path: "environments/env1/main.jsonnet", To: ".trash/environments/env1/.metadata/files/main.jsonnet"},
+			{Action: OpMove, Path: "environments/env1/params.libsonnet", To: ".trash/environments/env1/.metadata/files/params.libsonnet"},
+			{Action: OpCreate, Path: ".trash/environments/env1/.metadata/environment.yaml"},
+			{Action: OpUpdate, Path: "app.yaml"},
+			{Action: OpRemove, Path: "environments/env1/", Detail: "empty directory"},
+		}
+		require.Equal(t, expected, ops)
+		require.Equal(t, before, listFiles(t, fs))
+	})
+}
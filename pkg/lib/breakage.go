@@ -0,0 +1,266 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package lib
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ksonnet/ksonnet/pkg/metadata"
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Breakage is a part of an object that the types of a Kubernetes version
+// may no longer accept.
+type Breakage struct {
+	// Component is the component that generated the object.
+	Component  string
+	APIVersion string
+	Kind       string
+	Name       string
+	// Field is the path of the field that changed, or empty if the kind
+	// was removed.
+	Field string
+	// Problem describes the change.
+	Problem string
+}
+
+// GeneratedSwagger returns the Open API spec ksonnet-lib was generated from
+// for K8sVersion.
+func (m *Manager) GeneratedSwagger() ([]byte, error) {
+	path := filepath.Join(m.ksLibDir(), m.K8sVersion, schemaFilename)
+
+	ok, err := afero.Exists(m.fs, path)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.Errorf("ksonnet-lib for %s has not been generated", m.K8sVersion)
+	}
+
+	return afero.ReadFile(m.fs, path)
+}
+
+// FindBreakages checks objects built with the types of oldSwagger against the
+// types of newSwagger. It reports objects whose kind was removed, and fields
+// that were removed or changed type. Objects of kinds oldSwagger doesn't
+// define, e.g. custom resources, aren't checked.
+func FindBreakages(oldSwagger, newSwagger []byte, objects []*unstructured.Unstructured) ([]Breakage, error) {
+	oldTypes, err := parseAPITypes(oldSwagger)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing previous api spec")
+	}
+
+	newTypes, err := parseAPITypes(newSwagger)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing new api spec")
+	}
+
+	var breakages []Breakage
+	for _, obj := range objects {
+		gvk := obj.GroupVersionKind()
+
+		oldDef, ok := oldTypes.kinds[gvk]
+		if !ok {
+			continue
+		}
+
+		b := Breakage{
+			Component:  obj.GetLabels()[metadata.LabelComponent],
+			APIVersion: obj.GetAPIVersion(),
+			Kind:       obj.GetKind(),
+			Name:       obj.GetName(),
+		}
+
+		newDef, ok := newTypes.kinds[gvk]
+		if !ok {
+			b.Problem = "kind removed"
+			if alternatives := newTypes.versionsOf(gvk.GroupKind()); len(alternatives) > 0 {
+				b.Problem = fmt.Sprintf("kind removed; available in %s", strings.Join(alternatives, ", "))
+			}
+			breakages = append(breakages, b)
+			continue
+		}
+
+		c := &typeComparison{old: oldTypes, new: newTypes}
+		c.compare(obj.Object, oldTypes.resolve(oldDef), newTypes.resolve(newDef), "")
+
+		for _, fc := range c.changes {
+			fb := b
+			fb.Field = fc.field
+			fb.Problem = fc.problem
+			breakages = append(breakages, fb)
+		}
+	}
+
+	sort.SliceStable(breakages, func(i, j int) bool {
+		a, b := breakages[i], breakages[j]
+		if a.Component != b.Component {
+			return a.Component < b.Component
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Field < b.Field
+	})
+
+	return breakages, nil
+}
+
+// apiSchema is the part of an Open API schema needed to compare types.
+type apiSchema struct {
+	Ref        string                `json:"$ref"`
+	Type       string                `json:"type"`
+	Properties map[string]*apiSchema `json:"properties"`
+	Items      *apiSchema            `json:"items"`
+	GVKs       []struct {
+		Group   string `json:"group"`
+		Version string `json:"version"`
+		Kind    string `json:"kind"`
+	} `json:"x-kubernetes-group-version-kind"`
+}
+
+// apiTypes are the type definitions of an Open API spec.
+type apiTypes struct {
+	definitions map[string]*apiSchema
+	kinds       map[schema.GroupVersionKind]*apiSchema
+}
+
+func parseAPITypes(swaggerData []byte) (*apiTypes, error) {
+	var doc struct {
+		Definitions map[string]*apiSchema `json:"definitions"`
+	}
+
+	if err := json.Unmarshal(swaggerData, &doc); err != nil {
+		return nil, err
+	}
+
+	t := &apiTypes{
+		definitions: doc.Definitions,
+		kinds:       make(map[schema.GroupVersionKind]*apiSchema),
+	}
+
+	for _, def := range doc.Definitions {
+		for _, gvk := range def.GVKs {
+			t.kinds[schema.GroupVersionKind{Group: gvk.Group, Version: gvk.Version, Kind: gvk.Kind}] = def
+		}
+	}
+
+	return t, nil
+}
+
+// resolve follows a schema's reference to its definition.
+func (t *apiTypes) resolve(s *apiSchema) *apiSchema {
+	for i := 0; s != nil && s.Ref != "" && i < 10; i++ {
+		s = t.definitions[strings.TrimPrefix(s.Ref, "#/definitions/")]
+	}
+
+	return s
+}
+
+// versionsOf returns the API versions that define a kind.
+func (t *apiTypes) versionsOf(gk schema.GroupKind) []string {
+	var versions []string
+	for gvk := range t.kinds {
+		if gvk.GroupKind() == gk {
+			versions = append(versions, gvk.GroupVersion().String())
+		}
+	}
+
+	sort.Strings(versions)
+	return versions
+}
+
+type fieldChange struct {
+	field   string
+	problem string
+}
+
+// typeComparison compares the fields of an object under two versions of its
+// type.
+type typeComparison struct {
+	old, new *apiTypes
+	changes  []fieldChange
+	seen     map[string]bool
+}
+
+func (c *typeComparison) compare(value interface{}, oldSchema, newSchema *apiSchema, path string) {
+	if oldSchema == nil || newSchema == nil {
+		return
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for name, fieldValue := range v {
+			oldField, ok := oldSchema.Properties[name]
+			if !ok {
+				// The field isn't typed, e.g. it is a key of a map.
+				continue
+			}
+
+			field := name
+			if path != "" {
+				field = path + "." + name
+			}
+
+			newField, ok := newSchema.Properties[name]
+			if !ok {
+				c.add(field, "field removed")
+				continue
+			}
+
+			oldField, newField = c.old.resolve(oldField), c.new.resolve(newField)
+			if oldField == nil || newField == nil {
+				continue
+			}
+
+			if oldField.Type != "" && newField.Type != "" && oldField.Type != newField.Type {
+				c.add(field, fmt.Sprintf("type changed from %s to %s", oldField.Type, newField.Type))
+				continue
+			}
+
+			c.compare(fieldValue, oldField, newField, field)
+		}
+	case []interface{}:
+		oldItems, newItems := c.old.resolve(oldSchema.Items), c.new.resolve(newSchema.Items)
+		for _, item := range v {
+			c.compare(item, oldItems, newItems, path+"[]")
+		}
+	}
+}
+
+// add records a change once per field.
+func (c *typeComparison) add(field, problem string) {
+	if c.seen == nil {
+		c.seen = make(map[string]bool)
+	}
+
+	if c.seen[field] {
+		return
+	}
+
+	c.seen[field] = true
+	c.changes = append(c.changes, fieldChange{field: field, problem: problem})
+}
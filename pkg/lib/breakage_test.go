@@ -0,0 +1,221 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package lib
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/metadata"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func testSwagger(t *testing.T, definitions map[string]interface{}) []byte {
+	b, err := json.Marshal(map[string]interface{}{"definitions": definitions})
+	require.NoError(t, err)
+	return b
+}
+
+func kindSchema(group, version, kind string, properties map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"properties": properties,
+		"x-kubernetes-group-version-kind": []interface{}{
+			map[string]interface{}{"group": group, "version": version, "kind": kind},
+		},
+	}
+}
+
+func ref(name string) map[string]interface{} {
+	return map[string]interface{}{"$ref": "#/definitions/" + name}
+}
+
+func typed(t string) map[string]interface{} {
+	return map[string]interface{}{"type": t}
+}
+
+func TestFindBreakages(t *testing.T) {
+	common := map[string]interface{}{
+		"ObjectMeta": map[string]interface{}{
+			"properties": map[string]interface{}{
+				"name":   typed("string"),
+				"labels": typed("object"),
+			},
+		},
+		"PodTemplateSpec": map[string]interface{}{
+			"properties": map[string]interface{}{
+				"spec": ref("PodSpec"),
+			},
+		},
+		"PodSpec": map[string]interface{}{
+			"properties": map[string]interface{}{
+				"containers": map[string]interface{}{
+					"type":  "array",
+					"items": ref("Container"),
+				},
+			},
+		},
+		"ConfigMap": kindSchema("", "v1", "ConfigMap", map[string]interface{}{
+			"metadata": ref("ObjectMeta"),
+			"data":     typed("object"),
+		}),
+	}
+
+	definitions := func(extra map[string]interface{}) map[string]interface{} {
+		defs := make(map[string]interface{})
+		for k, v := range common {
+			defs[k] = v
+		}
+		for k, v := range extra {
+			defs[k] = v
+		}
+		return defs
+	}
+
+	deployment := func(version string) map[string]interface{} {
+		return kindSchema("apps", version, "Deployment", map[string]interface{}{
+			"metadata": ref("ObjectMeta"),
+			"spec":     ref("DeploymentSpec." + version),
+		})
+	}
+
+	oldSwagger := testSwagger(t, definitions(map[string]interface{}{
+		"Deployment.v1beta1": deployment("v1beta1"),
+		"Deployment.v1":      deployment("v1"),
+		"DeploymentSpec.v1beta1": map[string]interface{}{
+			"properties": map[string]interface{}{
+				"template": ref("PodTemplateSpec"),
+			},
+		},
+		"DeploymentSpec.v1": map[string]interface{}{
+			"properties": map[string]interface{}{
+				"replicas": typed("integer"),
+				"template": ref("PodTemplateSpec"),
+			},
+		},
+		"Container": map[string]interface{}{
+			"properties": map[string]interface{}{
+				"name":     typed("string"),
+				"oldField": typed("string"),
+			},
+		},
+	}))
+
+	newSwagger := testSwagger(t, definitions(map[string]interface{}{
+		"Deployment.v1": deployment("v1"),
+		"DeploymentSpec.v1": map[string]interface{}{
+			"properties": map[string]interface{}{
+				"replicas": typed("string"),
+				"template": ref("PodTemplateSpec"),
+			},
+		},
+		"Container": map[string]interface{}{
+			"properties": map[string]interface{}{
+				"name": typed("string"),
+			},
+		},
+	}))
+
+	newObject := func(component, apiVersion, kind, name string, spec map[string]interface{}) *unstructured.Unstructured {
+		obj := map[string]interface{}{
+			"apiVersion": apiVersion,
+			"kind":       kind,
+			"metadata": map[string]interface{}{
+				"name": name,
+				"labels": map[string]interface{}{
+					metadata.LabelComponent: component,
+				},
+			},
+		}
+		for k, v := range spec {
+			obj[k] = v
+		}
+		return &unstructured.Unstructured{Object: obj}
+	}
+
+	containers := []interface{}{
+		map[string]interface{}{"name": "a", "oldField": "x"},
+		map[string]interface{}{"name": "b", "oldField": "y"},
+	}
+
+	objects := []*unstructured.Unstructured{
+		newObject("web", "apps/v1beta1", "Deployment", "web", nil),
+		newObject("api", "apps/v1", "Deployment", "api", map[string]interface{}{
+			"spec": map[string]interface{}{
+				"replicas": int64(2),
+				"template": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"containers": containers,
+					},
+				},
+			},
+		}),
+		newObject("config", "v1", "ConfigMap", "config", map[string]interface{}{
+			"data": map[string]interface{}{"oldField": "value"},
+		}),
+		newObject("widget", "example.com/v1", "Widget", "widget", nil),
+	}
+
+	got, err := FindBreakages(oldSwagger, newSwagger, objects)
+	require.NoError(t, err)
+
+	expected := []Breakage{
+		{
+			Component:  "api",
+			APIVersion: "apps/v1",
+			Kind:       "Deployment",
+			Name:       "api",
+			Field:      "spec.replicas",
+			Problem:    "type changed from integer to string",
+		},
+		{
+			Component:  "api",
+			APIVersion: "apps/v1",
+			Kind:       "Deployment",
+			Name:       "api",
+			Field:      "spec.template.spec.containers[].oldField",
+			Problem:    "field removed",
+		},
+		{
+			Component:  "web",
+			APIVersion: "apps/v1beta1",
+			Kind:       "Deployment",
+			Name:       "web",
+			Problem:    "kind removed; available in apps/v1",
+		},
+	}
+
+	require.Equal(t, expected, got)
+}
+
+func TestManager_GeneratedSwagger(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	libPath := "lib"
+
+	m := &Manager{K8sVersion: "v1.8.0", fs: fs, libPath: libPath}
+
+	_, err := m.GeneratedSwagger()
+	require.Error(t, err)
+
+	path := filepath.Join(libPath, KsonnetLibHome, "v1.8.0", schemaFilename)
+	require.NoError(t, afero.WriteFile(fs, path, []byte("{}"), 0644))
+
+	got, err := m.GeneratedSwagger()
+	require.NoError(t, err)
+	require.Equal(t, "{}", string(got))
+}
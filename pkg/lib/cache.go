@@ -0,0 +1,127 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package lib
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/afero"
+
+	utilio "github.com/ksonnet/ksonnet/pkg/util/io"
+	"github.com/ksonnet/ksonnet/pkg/util/kslib"
+)
+
+// cacheKey returns the key of the Manager's spec in the ksonnet-lib cache.
+// The spec of a released Kubernetes version never changes, so it is keyed by
+// the version and doesn't have to be downloaded to be looked up. Other specs,
+// such as files and URLs, are keyed by the sha256 of their contents.
+func (m *Manager) cacheKey() (string, error) {
+	switch spec := m.spec.(type) {
+	case *clusterSpecVersion:
+		return fmt.Sprintf("%x", sha256.Sum256([]byte("version:"+spec.k8sVersion))), nil
+	case *clusterSpecOffline:
+		return fmt.Sprintf("%x", sha256.Sum256([]byte("version:"+spec.k8sVersion))), nil
+	}
+
+	data, err := m.spec.OpenAPI()
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%x", sha256.Sum256(data)), nil
+}
+
+// cachedLib returns the ksonnet-lib cached under a key, or nil if the cache
+// has no complete entry for it.
+func (m *Manager) cachedLib(key string) (*kslib.KsonnetLib, error) {
+	entryPath := filepath.Join(m.CacheDir, key)
+
+	ok, err := m.libGenerated(entryPath)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	kl := &kslib.KsonnetLib{Version: m.K8sVersion}
+	for name, data := range map[string]*[]byte{
+		schemaFilename:        &kl.Swagger,
+		k8sLibFilename:        &kl.K8s,
+		ExtensionsLibFilename: &kl.K,
+	} {
+		if *data, err = afero.ReadFile(m.fs, filepath.Join(entryPath, name)); err != nil {
+			return nil, err
+		}
+	}
+
+	log.Infof("Using cached ksonnet-lib for '%s'", m.spec.Resource())
+	return kl, nil
+}
+
+// cacheLib stores a generated ksonnet-lib under a key. Each file is written
+// atomically, so processes caching the same spec in parallel don't corrupt
+// the entry, and an entry missing files isn't used.
+func (m *Manager) cacheLib(key string, kl *kslib.KsonnetLib) error {
+	entryPath := filepath.Join(m.CacheDir, key)
+	if err := m.fs.MkdirAll(entryPath, os.FileMode(0755)); err != nil {
+		return err
+	}
+
+	for name, data := range map[string][]byte{
+		schemaFilename:        kl.Swagger,
+		k8sLibFilename:        kl.K8s,
+		ExtensionsLibFilename: kl.K,
+	} {
+		if err := utilio.WriteFileAtomic(m.fs, filepath.Join(entryPath, name), data, os.FileMode(0644)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkGeneratedSpec checks that the ksonnet-lib already generated in genPath
+// was generated from the Manager's spec. Environments for a Kubernetes version
+// share its ksonnet-lib, so a file or URL spec with the same version as
+// another spec can't be used without replacing the library of the other
+// environments. Specs of released versions aren't downloaded to be checked.
+func (m *Manager) checkGeneratedSpec(genPath string) error {
+	switch m.spec.(type) {
+	case nil, *clusterSpecVersion, *clusterSpecOffline:
+		return nil
+	}
+
+	data, err := m.spec.OpenAPI()
+	if err != nil {
+		return err
+	}
+
+	generated, err := afero.ReadFile(m.fs, filepath.Join(genPath, schemaFilename))
+	if err != nil {
+		return err
+	}
+
+	if sha256.Sum256(data) != sha256.Sum256(generated) {
+		return errors.Errorf("ksonnet-lib for Kubernetes %s in '%s' was generated from a different Open API spec than '%s'; "+
+			"environments for a version share its ksonnet-lib, so replace it with 'ks env set <env-name> --api-spec=<spec> --full-regen'",
+			m.K8sVersion, genPath, m.spec.Resource())
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package lib
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/util/kslib"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateLibData_cache(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, swaggerLocation, []byte(blankSwaggerData), 0644))
+
+	libManager, err := NewManager(fmt.Sprintf("file:%s", swaggerLocation), fs, "lib", nil)
+	require.NoError(t, err)
+
+	libManager.CacheDir = "cache"
+	libManager.generator = &fakeKsLibGenerator{
+		ksonnetLib: &kslib.KsonnetLib{K8s: []byte("generated"), K: []byte("k")},
+	}
+
+	err = libManager.GenerateLibData()
+	require.NoError(t, err)
+
+	entryPath := filepath.Join("cache", fmt.Sprintf("%x", sha256.Sum256([]byte(blankSwaggerData))))
+	checkKsLib(t, fs, entryPath)
+
+	// Once the library of the version is removed, it is copied from the cache.
+	genPath := filepath.Join("lib", KsonnetLibHome, "v1.7.0")
+	require.NoError(t, fs.RemoveAll(genPath))
+	libManager.generator = &fakeKsLibGenerator{err: fmt.Errorf("ksonnet-lib was generated again")}
+
+	err = libManager.GenerateLibData()
+	require.NoError(t, err)
+
+	checkKsLib(t, fs, genPath)
+	b, err := afero.ReadFile(fs, filepath.Join(genPath, "k8s.libsonnet"))
+	require.NoError(t, err)
+	require.Equal(t, "generated", string(b))
+
+	// NoCache skips the cache.
+	require.NoError(t, fs.RemoveAll(genPath))
+	libManager.NoCache = true
+
+	err = libManager.GenerateLibData()
+	require.Error(t, err)
+}
+
+func TestGenerateLibData_cache_version(t *testing.T) {
+	fs := afero.NewMemMapFs()
+
+	// No http client is given, so the spec can't be downloaded.
+	libManager, err := NewManager("version:v1.7.0", fs, "lib", nil)
+	require.NoError(t, err)
+
+	libManager.CacheDir = "cache"
+	libManager.generator = &fakeKsLibGenerator{err: fmt.Errorf("ksonnet-lib was generated")}
+
+	err = libManager.GenerateLibData()
+	require.Error(t, err)
+
+	entryPath := filepath.Join("cache", fmt.Sprintf("%x", sha256.Sum256([]byte("version:v1.7.0"))))
+	files := map[string]string{
+		"swagger.json":  blankSwaggerData,
+		"k8s.libsonnet": "cached",
+		"k.libsonnet":   "k",
+	}
+	for name, data := range files {
+		require.NoError(t, afero.WriteFile(fs, filepath.Join(entryPath, name), []byte(data), 0644))
+	}
+
+	err = libManager.GenerateLibData()
+	require.NoError(t, err)
+
+	genPath := filepath.Join("lib", KsonnetLibHome, "v1.7.0")
+	checkKsLib(t, fs, genPath)
+	b, err := afero.ReadFile(fs, filepath.Join(genPath, "k8s.libsonnet"))
+	require.NoError(t, err)
+	require.Equal(t, "cached", string(b))
+}
+
+func TestGenerateLibData_different_spec(t *testing.T) {
+	cases := []struct {
+		name        string
+		swaggerData string
+		isErr       bool
+	}{
+		{
+			name:        "same spec",
+			swaggerData: blankSwaggerData,
+		},
+		{
+			name:        "different spec for the same version",
+			swaggerData: strings.Replace(blankSwaggerData, `"title": "Kubernetes"`, `"title": "Custom"`, 1),
+			isErr:       true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+
+			genPath := filepath.Join("lib", KsonnetLibHome, "v1.7.0")
+			files := map[string]string{
+				"swagger.json":  blankSwaggerData,
+				"k8s.libsonnet": "k8s",
+				"k.libsonnet":   "k",
+			}
+			for name, data := range files {
+				require.NoError(t, afero.WriteFile(fs, filepath.Join(genPath, name), []byte(data), 0644))
+			}
+
+			require.NoError(t, afero.WriteFile(fs, swaggerLocation, []byte(tc.swaggerData), 0644))
+
+			libManager, err := NewManager(fmt.Sprintf("file:%s", swaggerLocation), fs, "lib", nil)
+			require.NoError(t, err)
+
+			libManager.generator = &fakeKsLibGenerator{err: fmt.Errorf("ksonnet-lib was generated again")}
+
+			err = libManager.GenerateLibData()
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package lib
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+
+	utilio "github.com/ksonnet/ksonnet/pkg/util/io"
+)
+
+const (
+	// metadataDirName is the directory in a generated ksonnet-lib that holds
+	// data about the generated files.
+	metadataDirName = ".metadata"
+	// checksumsFilename is the checksum manifest of the generated files.
+	checksumsFilename = "checksums.json"
+
+	// LibFileModified is the problem of a generated file whose contents
+	// changed since it was generated.
+	LibFileModified = "modified"
+	// LibFileMissing is the problem of a generated file that no longer exists.
+	LibFileMissing = "missing"
+)
+
+// ErrNoChecksums is returned when a generated ksonnet-lib has no checksum
+// manifest, e.g. because it was generated by an older version of ksonnet.
+var ErrNoChecksums = errors.New("ksonnet-lib has no checksum manifest")
+
+// checksumManifest maps the names of generated files to the sha256 checksums
+// of their contents.
+type checksumManifest struct {
+	Files map[string]string `json:"files"`
+}
+
+// LibFileProblem is a generated ksonnet-lib file that doesn't match its
+// checksum.
+type LibFileProblem struct {
+	Path    string
+	Problem string
+}
+
+// writeChecksums writes the checksum manifest of the files generated in
+// genPath.
+func writeChecksums(fs afero.Fs, genPath string, files map[string][]byte) error {
+	manifest := checksumManifest{Files: make(map[string]string)}
+	for name, data := range files {
+		manifest.Files[name] = fmt.Sprintf("%x", sha256.Sum256(data))
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Join(genPath, metadataDirName)
+	if err = fs.MkdirAll(dir, os.FileMode(0755)); err != nil {
+		return err
+	}
+
+	return utilio.WriteFileAtomic(fs, filepath.Join(dir, checksumsFilename), data, os.FileMode(0644))
+}
+
+// Verify recomputes the checksums of the generated ksonnet-lib files and
+// compares them with the checksum manifest written when they were generated.
+// It returns the files that were modified or are missing, sorted by path.
+func (m *Manager) Verify() ([]LibFileProblem, error) {
+	genPath := filepath.Join(m.ksLibDir(), m.K8sVersion)
+
+	ok, err := afero.DirExists(m.fs, genPath)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.Errorf("ksonnet-lib for %s has not been generated", m.K8sVersion)
+	}
+
+	data, err := afero.ReadFile(m.fs, filepath.Join(genPath, metadataDirName, checksumsFilename))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrNoChecksums
+		}
+		return nil, err
+	}
+
+	var manifest checksumManifest
+	if err = json.Unmarshal(data, &manifest); err != nil {
+		return nil, errors.Wrap(err, "parse checksum manifest")
+	}
+
+	var problems []LibFileProblem
+	for name, checksum := range manifest.Files {
+		path := filepath.Join(genPath, name)
+
+		contents, err := afero.ReadFile(m.fs, path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				problems = append(problems, LibFileProblem{Path: path, Problem: LibFileMissing})
+				continue
+			}
+			return nil, err
+		}
+
+		if fmt.Sprintf("%x", sha256.Sum256(contents)) != checksum {
+			problems = append(problems, LibFileProblem{Path: path, Problem: LibFileModified})
+		}
+	}
+
+	sort.Slice(problems, func(i, j int) bool {
+		return problems[i].Path < problems[j].Path
+	})
+
+	return problems, nil
+}
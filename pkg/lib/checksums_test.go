@@ -0,0 +1,103 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package lib
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/util/kslib"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManager_Verify(t *testing.T) {
+	genPath := filepath.Join("lib", KsonnetLibHome, "v1.7.0")
+
+	cases := []struct {
+		name     string
+		change   func(fs afero.Fs) error
+		expected []LibFileProblem
+		err      error
+	}{
+		{
+			name:   "unchanged",
+			change: func(fs afero.Fs) error { return nil },
+		},
+		{
+			name: "modified and missing files",
+			change: func(fs afero.Fs) error {
+				if err := afero.WriteFile(fs, filepath.Join(genPath, k8sLibFilename), []byte("edited"), 0644); err != nil {
+					return err
+				}
+				return fs.Remove(filepath.Join(genPath, ExtensionsLibFilename))
+			},
+			expected: []LibFileProblem{
+				{Path: filepath.Join(genPath, ExtensionsLibFilename), Problem: LibFileMissing},
+				{Path: filepath.Join(genPath, k8sLibFilename), Problem: LibFileModified},
+			},
+		},
+		{
+			name: "no checksum manifest",
+			change: func(fs afero.Fs) error {
+				return fs.RemoveAll(filepath.Join(genPath, metadataDirName))
+			},
+			err: ErrNoChecksums,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			afero.WriteFile(fs, swaggerLocation, []byte(blankSwaggerData), os.ModePerm)
+
+			libManager, err := NewManager(fmt.Sprintf("file:%s", swaggerLocation), fs, "lib", nil)
+			require.NoError(t, err)
+
+			libManager.generator = &fakeKsLibGenerator{
+				ksonnetLib: &kslib.KsonnetLib{
+					Swagger: []byte(blankSwaggerData),
+					K8s:     []byte("k8s"),
+					K:       []byte("k"),
+				},
+			}
+
+			err = libManager.GenerateLibData()
+			require.NoError(t, err)
+
+			require.NoError(t, tc.change(fs))
+
+			problems, err := libManager.Verify()
+			if tc.err != nil {
+				require.Equal(t, tc.err, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expected, problems)
+		})
+	}
+}
+
+func TestManager_Verify_not_generated(t *testing.T) {
+	libManager, err := NewManager("version:v1.7.0", afero.NewMemMapFs(), "lib", nil)
+	require.NoError(t, err)
+
+	_, err = libManager.Verify()
+	require.Error(t, err)
+}
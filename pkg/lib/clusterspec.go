@@ -16,6 +16,8 @@
 package lib
 
 import (
+	"bytes"
+	"compress/gzip"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -24,11 +26,19 @@ import (
 	"path/filepath"
 	"strings"
 
+	rice "github.com/GeertJohan/go.rice"
 	"github.com/spf13/afero"
 )
 
+//go:generate rice embed-go
+
 const (
 	k8sVersionURLTemplate = "https://raw.githubusercontent.com/kubernetes/kubernetes/%s/api/openapi-spec/swagger.json"
+
+	// OfflineKubernetesVersion is the Kubernetes version whose OpenAPI spec
+	// is bundled with ksonnet, so ksonnet-lib can be generated for it without
+	// network access.
+	OfflineKubernetesVersion = "v1.10.3"
 )
 
 // ClusterSpec represents the API supported by some cluster. There are several
@@ -61,7 +71,9 @@ func ParseClusterSpec(specFlag string, fs afero.Fs, httpClient *http.Client) (Cl
 		}
 		return &clusterSpecFile{specPath: p, fs: fs}, nil
 	case "url":
-		return &clusterSpecLive{apiServerURL: split[1]}, nil
+		return &clusterSpecLive{apiServerURL: split[1], httpClient: httpClient}, nil
+	case "offline":
+		return &clusterSpecOffline{k8sVersion: split[1]}, nil
 	default:
 		return nil, fmt.Errorf("Could not parse cluster spec '%s'", specFlag)
 	}
@@ -106,12 +118,48 @@ func (cs *clusterSpecFile) Version() (string, error) {
 	return spec.Info.Version, nil
 }
 
+// clusterSpecLive is an OpenAPI spec served at a URL, such as the
+// `/openapi/v2` endpoint of an API server, or a mirror of it behind a proxy.
 type clusterSpecLive struct {
 	apiServerURL string
+	httpClient   *http.Client
+
+	// data is the spec, once it has been fetched.
+	data []byte
 }
 
 func (cs *clusterSpecLive) OpenAPI() ([]byte, error) {
-	return nil, fmt.Errorf("Initializing from OpenAPI spec in live cluster is not implemented")
+	if cs.data != nil {
+		return cs.data, nil
+	}
+
+	if cs.httpClient == nil {
+		return nil, errors.New("nil httpClient")
+	}
+
+	resp, err := cs.httpClient.Get(cs.apiServerURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf(
+			"Received status code '%d' when trying to retrieve OpenAPI schema from URL '%s'",
+			resp.StatusCode, cs.apiServerURL)
+	}
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := swaggerVersion(data); err != nil {
+		return nil, fmt.Errorf("URL '%s' did not return a usable OpenAPI spec: %v", cs.apiServerURL, err)
+	}
+
+	cs.data = data
+	return data, nil
 }
 
 func (cs *clusterSpecLive) Resource() string {
@@ -119,7 +167,57 @@ func (cs *clusterSpecLive) Resource() string {
 }
 
 func (cs *clusterSpecLive) Version() (string, error) {
-	return "", fmt.Errorf("Retrieving version spec in live cluster is not implemented")
+	data, err := cs.OpenAPI()
+	if err != nil {
+		return "", err
+	}
+
+	return swaggerVersion(data)
+}
+
+// clusterSpecData is an OpenAPI spec that was already retrieved.
+type clusterSpecData struct {
+	source string
+	data   []byte
+}
+
+func (cs *clusterSpecData) OpenAPI() ([]byte, error) {
+	return cs.data, nil
+}
+
+func (cs *clusterSpecData) Resource() string {
+	return cs.source
+}
+
+func (cs *clusterSpecData) Version() (string, error) {
+	return swaggerVersion(cs.data)
+}
+
+// swaggerVersion returns the Kubernetes version of a swagger 2.0 spec. It
+// fails if the spec isn't one that ksonnet-lib can be generated from.
+func swaggerVersion(data []byte) (string, error) {
+	var spec struct {
+		Swagger string `json:"swagger"`
+		Info    struct {
+			Version string `json:"version"`
+		} `json:"info"`
+		Definitions map[string]json.RawMessage `json:"definitions"`
+	}
+
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return "", fmt.Errorf("Parsing spec: %v", err)
+	}
+
+	switch {
+	case spec.Swagger != "2.0":
+		return "", fmt.Errorf("Expected a swagger 2.0 spec, got swagger version '%s'", spec.Swagger)
+	case spec.Info.Version == "":
+		return "", errors.New("Spec has no info.version")
+	case len(spec.Definitions) == 0:
+		return "", errors.New("Spec has no definitions")
+	}
+
+	return spec.Info.Version, nil
 }
 
 type clusterSpecVersion struct {
@@ -154,3 +252,38 @@ func (cs *clusterSpecVersion) Resource() string {
 func (cs *clusterSpecVersion) Version() (string, error) {
 	return string(cs.k8sVersion), nil
 }
+
+// clusterSpecOffline is the OpenAPI spec bundled with ksonnet for a
+// Kubernetes version.
+type clusterSpecOffline struct {
+	k8sVersion string
+}
+
+func (cs *clusterSpecOffline) OpenAPI() ([]byte, error) {
+	box, err := rice.FindBox("offline")
+	if err != nil {
+		return nil, fmt.Errorf("Finding bundled OpenAPI specs: %v", err)
+	}
+
+	data, err := box.Bytes(cs.k8sVersion + ".json.gz")
+	if err != nil {
+		return nil, fmt.Errorf("No OpenAPI spec is bundled for cluster version '%s'; the bundled version is '%s'",
+			cs.k8sVersion, OfflineKubernetesVersion)
+	}
+
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return ioutil.ReadAll(r)
+}
+
+func (cs *clusterSpecOffline) Resource() string {
+	return string(cs.k8sVersion)
+}
+
+func (cs *clusterSpecOffline) Version() (string, error) {
+	return string(cs.k8sVersion), nil
+}
@@ -16,6 +16,9 @@
 package lib
 
 import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"testing"
@@ -35,7 +38,8 @@ func TestClusterSpecParsingSuccess(t *testing.T) {
 	var successTests = []parseSuccess{
 		{"version:v1.7.1", &clusterSpecVersion{k8sVersion: "v1.7.1"}},
 		{"file:swagger.json", &clusterSpecFile{"swagger.json", testFS}},
-		{"url:file:///some_file", &clusterSpecLive{"file:///some_file"}},
+		{"url:file:///some_file", &clusterSpecLive{apiServerURL: "file:///some_file"}},
+		{"offline:v1.10.3", &clusterSpecOffline{k8sVersion: "v1.10.3"}},
 	}
 
 	for _, test := range successTests {
@@ -49,7 +53,7 @@ func TestClusterSpecParsingSuccess(t *testing.T) {
 
 		switch pt := parsed.(type) {
 		case *clusterSpecLive:
-		case *clusterSpecVersion:
+		case *clusterSpecVersion, *clusterSpecOffline:
 			if parsedResource != targetResource {
 				t.Errorf("Expected version '%v', got '%v'", parsedResource, targetResource)
 			}
@@ -83,6 +87,7 @@ var failureTests = []parseFailure{
 	{"version:", "Invalid API specification 'version:'"},
 	{"file:", "Invalid API specification 'file:'"},
 	{"url:", "Invalid API specification 'url:'"},
+	{"offline:", "Invalid API specification 'offline:'"},
 }
 
 func TestClusterSpecParsingFailure(t *testing.T) {
@@ -98,3 +103,87 @@ func TestClusterSpecParsingFailure(t *testing.T) {
 		}
 	}
 }
+
+func TestClusterSpecOffline(t *testing.T) {
+	spec := &clusterSpecOffline{k8sVersion: OfflineKubernetesVersion}
+
+	data, err := spec.OpenAPI()
+	if err != nil {
+		t.Fatalf("Failed to read bundled OpenAPI spec: %v", err)
+	}
+
+	fileSpec := &clusterSpecFile{specPath: "swagger.json", fs: afero.NewMemMapFs()}
+	afero.WriteFile(fileSpec.fs, "swagger.json", data, os.ModePerm)
+
+	version, err := fileSpec.Version()
+	if err != nil {
+		t.Fatalf("Failed to parse bundled OpenAPI spec: %v", err)
+	}
+
+	if version != OfflineKubernetesVersion {
+		t.Errorf("Expected bundled OpenAPI spec for '%s', got '%s'", OfflineKubernetesVersion, version)
+	}
+
+	missing := &clusterSpecOffline{k8sVersion: "v1.7.0"}
+	if _, err := missing.OpenAPI(); err == nil {
+		t.Errorf("Reading an OpenAPI spec that isn't bundled should have failed, but succeeded")
+	}
+}
+
+func TestClusterSpecURL(t *testing.T) {
+	const usableSpec = `{
+  "swagger": "2.0",
+  "info": {"title": "Kubernetes", "version": "v1.10.3"},
+  "definitions": {"io.k8s.api.core.v1.Pod": {}}
+}`
+
+	var requests int
+	mux := http.NewServeMux()
+	mux.HandleFunc("/openapi/v2", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, usableSpec)
+	})
+	mux.HandleFunc("/not-swagger", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"kind": "Status"}`)
+	})
+	mux.HandleFunc("/no-definitions", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, blankSwaggerData)
+	})
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	spec, err := ParseClusterSpec("url:"+ts.URL+"/openapi/v2", afero.NewMemMapFs(), ts.Client())
+	if err != nil {
+		t.Fatalf("Failed to parse spec: %v", err)
+	}
+
+	version, err := spec.Version()
+	if err != nil {
+		t.Fatalf("Failed to read version of spec served at URL: %v", err)
+	}
+	if version != "v1.10.3" {
+		t.Errorf("Expected version 'v1.10.3', got '%s'", version)
+	}
+
+	data, err := spec.OpenAPI()
+	if err != nil {
+		t.Fatalf("Failed to read spec served at URL: %v", err)
+	}
+	if string(data) != usableSpec {
+		t.Errorf("Expected spec served at URL, got '%s'", data)
+	}
+	if requests != 1 {
+		t.Errorf("Expected the spec to be fetched once, was fetched %d times", requests)
+	}
+
+	for _, path := range []string{"/not-swagger", "/no-definitions", "/missing"} {
+		spec, err := ParseClusterSpec("url:"+ts.URL+path, afero.NewMemMapFs(), ts.Client())
+		if err != nil {
+			t.Fatalf("Failed to parse spec: %v", err)
+		}
+
+		if _, err := spec.Version(); err == nil {
+			t.Errorf("Reading the spec at '%s' should have failed, but succeeded", path)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package lib
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	godiff "github.com/shazow/go-diff"
+	"github.com/spf13/afero"
+)
+
+// DiffGenerated writes a unified diff between the generated ksonnet-lib files
+// for K8sVersion and the files a fresh generation from the Open API spec would
+// produce. Nothing is written to the lib directory. It returns true if any
+// file differs.
+func (m *Manager) DiffGenerated(w io.Writer) (bool, error) {
+	genPath := filepath.Join(m.ksLibDir(), m.K8sVersion)
+
+	ok, err := afero.DirExists(m.fs, genPath)
+	if err != nil {
+		return false, err
+	}
+	if !ok {
+		return false, errors.Errorf("ksonnet-lib for %s has not been generated", m.K8sVersion)
+	}
+
+	if m.spec == nil {
+		return false, errors.Errorf("uninitialized ClusterSpec")
+	}
+
+	swaggerData, err := m.spec.OpenAPI()
+	if err != nil {
+		return false, err
+	}
+
+	kl, err := m.generator.Generate(swaggerData)
+	if err != nil {
+		return false, err
+	}
+
+	files := []struct {
+		name string
+		data []byte
+	}{
+		{k8sLibFilename, kl.K8s},
+		{ExtensionsLibFilename, kl.K},
+	}
+
+	differs := false
+	for _, file := range files {
+		cached, err := afero.ReadFile(m.fs, filepath.Join(genPath, file.name))
+		if err != nil && !os.IsNotExist(err) {
+			return false, err
+		}
+
+		var buf bytes.Buffer
+		if err := godiff.DefaultDiffer().Diff(&buf, bytes.NewReader(cached), bytes.NewReader(file.data)); err != nil {
+			return false, err
+		}
+
+		if buf.Len() == 0 {
+			continue
+		}
+
+		differs = true
+		fmt.Fprintf(w, "--- cached/%s\n+++ generated/%s\n", file.name, file.name)
+		if _, err := buf.WriteTo(w); err != nil {
+			return false, err
+		}
+	}
+
+	return differs, nil
+}
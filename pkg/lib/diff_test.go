@@ -0,0 +1,93 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package lib
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/util/kslib"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManager_DiffGenerated(t *testing.T) {
+	genPath := filepath.Join("lib", KsonnetLibHome, "v1.7.0")
+
+	cases := []struct {
+		name     string
+		change   func(fs afero.Fs) error
+		expected string
+	}{
+		{
+			name:   "unchanged",
+			change: func(fs afero.Fs) error { return nil },
+		},
+		{
+			name: "edited file",
+			change: func(fs afero.Fs) error {
+				return afero.WriteFile(fs, filepath.Join(genPath, k8sLibFilename), []byte("k8s\nedited\n"), 0644)
+			},
+			expected: "--- cached/k8s.libsonnet\n+++ generated/k8s.libsonnet\n",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			afero.WriteFile(fs, swaggerLocation, []byte(blankSwaggerData), os.ModePerm)
+
+			libManager, err := NewManager(fmt.Sprintf("file:%s", swaggerLocation), fs, "lib", nil)
+			require.NoError(t, err)
+
+			libManager.generator = &fakeKsLibGenerator{
+				ksonnetLib: &kslib.KsonnetLib{
+					Swagger: []byte(blankSwaggerData),
+					K8s:     []byte("k8s\n"),
+					K:       []byte("k\n"),
+				},
+			}
+
+			require.NoError(t, libManager.GenerateLibData())
+			require.NoError(t, tc.change(fs))
+
+			var buf bytes.Buffer
+			differs, err := libManager.DiffGenerated(&buf)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expected != "", differs)
+			if tc.expected == "" {
+				require.Empty(t, buf.String())
+				return
+			}
+
+			require.Contains(t, buf.String(), tc.expected)
+			require.Contains(t, buf.String(), "-edited")
+		})
+	}
+}
+
+func TestManager_DiffGenerated_not_generated(t *testing.T) {
+	libManager, err := NewManager("version:v1.7.0", afero.NewMemMapFs(), "lib", nil)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	_, err = libManager.DiffGenerated(&buf)
+	require.Error(t, err)
+}
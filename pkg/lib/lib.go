@@ -16,15 +16,22 @@
 package lib
 
 import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"os"
 	"path"
 	"path/filepath"
+	"reflect"
+	"regexp"
 
+	"github.com/blang/semver"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/afero"
 
+	utilio "github.com/ksonnet/ksonnet/pkg/util/io"
 	"github.com/ksonnet/ksonnet/pkg/util/kslib"
 )
 
@@ -40,26 +47,20 @@ const (
 	KsonnetLibHome = "ksonnet-lib"
 )
 
+var (
+	libChecksumPattern = regexp.MustCompile(`checksum: "[0-9a-f]*"`)
+	libVersionPattern  = regexp.MustCompile(`kubernetesVersion: "[^"]*"`)
+)
+
 // KsLibGenerator generates ksonnet-lib.
 type KsLibGenerator interface {
-	Generate() (*kslib.KsonnetLib, error)
-}
-
-type defaultKsLibGenerator struct {
-	spec ClusterSpec
+	Generate(swaggerData []byte) (*kslib.KsonnetLib, error)
 }
 
-func (g *defaultKsLibGenerator) Generate() (*kslib.KsonnetLib, error) {
-	if g.spec == nil {
-		return nil, errors.Errorf("uninitialized ClusterSpec")
-	}
+type defaultKsLibGenerator struct{}
 
-	b, err := g.spec.OpenAPI()
-	if err != nil {
-		return nil, err
-	}
-
-	return kslib.Ksonnet(b)
+func (g *defaultKsLibGenerator) Generate(swaggerData []byte) (*kslib.KsonnetLib, error) {
+	return kslib.Ksonnet(swaggerData)
 }
 
 // Manager operates on the files in the lib directory of a ksonnet project.
@@ -68,9 +69,23 @@ func (g *defaultKsLibGenerator) Generate() (*kslib.KsonnetLib, error) {
 type Manager struct {
 	// K8sVersion is the Kubernetes version of the Open API spec.
 	K8sVersion string
+	// FullRegen forces ksonnet-lib to be generated from the Open API spec,
+	// even if it was already generated or a previously generated version has
+	// identical type definitions.
+	FullRegen bool
+	// NoCache forces ksonnet-lib to be generated from the Open API spec
+	// instead of being copied from a previously generated version with
+	// identical type definitions. An already generated ksonnet-lib for
+	// K8sVersion is still used.
+	NoCache bool
+	// CacheDir is the directory of a cache of generated ksonnet-libs, keyed
+	// by their Open API spec, that is used before generating ksonnet-lib and
+	// filled after. An empty CacheDir, or NoCache, disables the cache.
+	CacheDir string
 
 	libPath string
 	fs      afero.Fs
+	spec    ClusterSpec
 
 	generator KsLibGenerator
 }
@@ -94,29 +109,94 @@ func NewManager(k8sSpecFlag string, fs afero.Fs, libPath string, httpClient *htt
 		K8sVersion: version,
 		fs:         fs,
 		libPath:    libPath,
-		generator:  &defaultKsLibGenerator{spec: spec},
+		spec:       spec,
+		generator:  &defaultKsLibGenerator{},
+	}, nil
+}
+
+// NewManagerFromOpenAPI creates an instance of lib.Manager for an OpenAPI spec
+// that was already retrieved, e.g. from the API server of an environment.
+// source describes where the spec came from.
+func NewManagerFromOpenAPI(source string, data []byte, fs afero.Fs, libPath string) (*Manager, error) {
+	spec := &clusterSpecData{source: source, data: data}
+
+	version, err := spec.Version()
+	if err != nil {
+		return nil, errors.Wrapf(err, "OpenAPI spec from %s", source)
+	}
+
+	return &Manager{
+		K8sVersion: version,
+		fs:         fs,
+		libPath:    libPath,
+		spec:       spec,
+		generator:  &defaultKsLibGenerator{},
 	}, nil
 }
 
 // GenerateLibData will generate the swagger and ksonnet-lib files in the lib
 // directory of a ksonnet project. The swagger and ksonnet-lib files are
 // unique to each Kubernetes API version. If the files already exist for a
-// specific Kubernetes API version, they won't be re-generated here.
+// specific Kubernetes API version, they won't be re-generated here unless
+// FullRegen is set. Existing files are only overwritten once the new ones are
+// generated; use ReplaceLibData to replace them all at once. Existing files
+// generated from a different file or URL spec for the same version are an
+// error, as they are shared by the environments for that version.
+//
+// With CacheDir set, ksonnet-lib is copied from the cache entry of the spec
+// if there is one, and the spec of a released version isn't downloaded. A
+// generated ksonnet-lib is stored in the cache.
+//
+// Each file is written to a temporary file and renamed into place, so
+// environments for the same version can be added in parallel: a directory
+// missing some of the files is being written by another process, or was left
+// behind by a failed generation, and is generated again.
+//
+// Patch releases of Kubernetes rarely change the API types, so if a
+// previously generated version has the same type definitions, its
+// ksonnet-lib is copied instead of being generated again, unless FullRegen
+// or NoCache is set. If any type definition differs, ksonnet-lib is
+// generated in full; changed definitions aren't rewritten individually.
 func (m *Manager) GenerateLibData() error {
-	kl, err := m.generator.Generate()
-	if err != nil {
-		return err
-	}
-
 	genPath := filepath.Join(m.ksLibDir(), m.K8sVersion)
 
-	ok, err := afero.DirExists(m.fs, genPath)
+	ok, err := m.libGenerated(genPath)
 	if err != nil {
 		return err
 	}
-	if ok {
+	if ok && !m.FullRegen {
 		// Already have lib data for this k8s api version
-		return nil
+		return m.checkGeneratedSpec(genPath)
+	}
+
+	if m.spec == nil {
+		return errors.Errorf("uninitialized ClusterSpec")
+	}
+
+	var cacheKey string
+	if m.CacheDir != "" && !m.NoCache {
+		if cacheKey, err = m.cacheKey(); err != nil {
+			return err
+		}
+	}
+
+	var kl *kslib.KsonnetLib
+	if cacheKey != "" && !m.FullRegen {
+		if kl, err = m.cachedLib(cacheKey); err != nil {
+			log.WithError(err).Debugf("Unable to read cached ksonnet-lib '%s'", cacheKey)
+		}
+	}
+
+	if kl == nil {
+		if kl, err = m.buildLib(); err != nil {
+			return err
+		}
+
+		if cacheKey != "" {
+			if err = m.cacheLib(cacheKey, kl); err != nil {
+				log.WithError(err).Warnf("Unable to cache ksonnet-lib for '%s'", m.spec.Resource())
+			}
+		}
 	}
 
 	err = m.fs.MkdirAll(genPath, os.FileMode(0755))
@@ -147,17 +227,189 @@ func (m *Manager) GenerateLibData() error {
 
 	log.Infof("Generating ksonnet-lib data at path '%s'", genPath)
 
+	checksums := make(map[string][]byte)
 	for _, a := range files {
 		fileName := path.Base(string(a.path))
-		if err = afero.WriteFile(m.fs, string(a.path), a.data, os.FileMode(0644)); err != nil {
+		if err = utilio.WriteFileAtomic(m.fs, string(a.path), a.data, os.FileMode(0644)); err != nil {
 			log.Debugf("Failed to write '%s'", fileName)
 			return err
 		}
+		checksums[fileName] = a.data
+	}
+
+	return writeChecksums(m.fs, genPath, checksums)
+}
+
+// buildLib derives ksonnet-lib from a previously generated version with the
+// same type definitions, or generates it from the Open API spec.
+func (m *Manager) buildLib() (*kslib.KsonnetLib, error) {
+	swaggerData, err := m.spec.OpenAPI()
+	if err != nil {
+		return nil, err
+	}
+
+	if !m.FullRegen && !m.NoCache {
+		kl, err := m.deriveLib(swaggerData)
+		if err != nil || kl != nil {
+			return kl, err
+		}
+	}
+
+	return m.generator.Generate(swaggerData)
+}
+
+// libGenerated reports whether all the files of a generated ksonnet-lib are in
+// genPath.
+func (m *Manager) libGenerated(genPath string) (bool, error) {
+	for _, name := range []string{schemaFilename, k8sLibFilename, ExtensionsLibFilename} {
+		ok, err := afero.Exists(m.fs, filepath.Join(genPath, name))
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// ReplaceLibData generates the swagger and ksonnet-lib files from the Open API
+// spec, like GenerateLibData with FullRegen set, but in a temporary directory
+// first. The files of a previous generation for the same version are only
+// replaced once generating succeeded, so they are left intact on failure.
+func (m *Manager) ReplaceLibData() error {
+	genPath := filepath.Join(m.ksLibDir(), m.K8sVersion)
+	parent := filepath.Dir(genPath)
+
+	if err := m.fs.MkdirAll(parent, os.FileMode(0755)); err != nil {
+		return err
+	}
+
+	tmpDir, err := afero.TempDir(m.fs, parent, ".regen-")
+	if err != nil {
+		return err
+	}
+	defer m.fs.RemoveAll(tmpDir)
+
+	staged := &Manager{
+		K8sVersion: m.K8sVersion,
+		FullRegen:  true,
+		NoCache:    m.NoCache,
+		CacheDir:   m.CacheDir,
+		libPath:    tmpDir,
+		fs:         m.fs,
+		spec:       m.spec,
+		generator:  m.generator,
+	}
+
+	if err = staged.GenerateLibData(); err != nil {
+		return err
+	}
+
+	stagedPath := filepath.Join(staged.ksLibDir(), m.K8sVersion)
+	fis, err := afero.ReadDir(m.fs, stagedPath)
+	if err != nil {
+		return err
+	}
+
+	if err = m.fs.RemoveAll(genPath); err != nil {
+		return err
+	}
+
+	if err = m.fs.MkdirAll(genPath, os.FileMode(0755)); err != nil {
+		return err
+	}
+
+	// The files are moved one at a time, as not every afero.Fs can rename a
+	// directory with its contents.
+	for _, fi := range fis {
+		if err = m.fs.Rename(filepath.Join(stagedPath, fi.Name()), filepath.Join(genPath, fi.Name())); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
+// deriveLib looks for a previously generated ksonnet-lib whose swagger has the
+// same type definitions as swaggerData. If one is found, a copy of it with an
+// updated version header is returned. If none is found, nil is returned.
+func (m *Manager) deriveLib(swaggerData []byte) (*kslib.KsonnetLib, error) {
+	definitions, version, err := swaggerDefinitions(swaggerData)
+	if err != nil {
+		return nil, err
+	}
+
+	libDir := m.ksLibDir()
+	fis, err := afero.ReadDir(m.fs, libDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	for _, fi := range fis {
+		if !fi.IsDir() || fi.Name() == m.K8sVersion {
+			continue
+		}
+
+		cachedPath := filepath.Join(libDir, fi.Name())
+		cachedSwagger, err := afero.ReadFile(m.fs, filepath.Join(cachedPath, schemaFilename))
+		if err != nil {
+			log.WithError(err).Debugf("Unable to read swagger in '%s'", cachedPath)
+			continue
+		}
+
+		cachedDefinitions, _, err := swaggerDefinitions(cachedSwagger)
+		if err != nil || !reflect.DeepEqual(definitions, cachedDefinitions) {
+			continue
+		}
+
+		k8s, err := afero.ReadFile(m.fs, filepath.Join(cachedPath, k8sLibFilename))
+		if err != nil {
+			continue
+		}
+
+		k, err := afero.ReadFile(m.fs, filepath.Join(cachedPath, ExtensionsLibFilename))
+		if err != nil {
+			continue
+		}
+
+		log.Infof("Type definitions are unchanged from '%s'; reusing its ksonnet-lib", fi.Name())
+
+		checksum := fmt.Sprintf("%x", sha256.Sum256(swaggerData))
+		k8s = libChecksumPattern.ReplaceAll(k8s, []byte(fmt.Sprintf("checksum: %q", checksum)))
+		if v, err := semver.ParseTolerant(version); err == nil {
+			k8s = libVersionPattern.ReplaceAll(k8s, []byte(fmt.Sprintf("kubernetesVersion: %q", v.String())))
+		}
+
+		return &kslib.KsonnetLib{
+			K:       k,
+			K8s:     k8s,
+			Swagger: swaggerData,
+			Version: version,
+		}, nil
+	}
+
+	return nil, nil
+}
+
+// swaggerDefinitions returns the type definitions and version of a swagger
+// document.
+func swaggerDefinitions(swaggerData []byte) (map[string]interface{}, string, error) {
+	var doc struct {
+		Info struct {
+			Version string `json:"version"`
+		} `json:"info"`
+		Definitions map[string]interface{} `json:"definitions"`
+	}
+
+	if err := json.Unmarshal(swaggerData, &doc); err != nil {
+		return nil, "", errors.Wrap(err, "parse swagger")
+	}
+
+	return doc.Definitions, doc.Info.Version, nil
+}
+
 // GetLibPath returns the absolute path pointing to the directory with the
 // metadata files for the provided k8sVersion.
 func (m *Manager) GetLibPath() (string, error) {
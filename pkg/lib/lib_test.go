@@ -15,10 +15,12 @@
 package lib
 
 import (
+	"crypto/sha256"
 	"fmt"
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/ksonnet/ksonnet/pkg/util/kslib"
@@ -84,6 +86,208 @@ func TestGenerateLibData(t *testing.T) {
 	}
 }
 
+func TestGenerateLibData_incomplete(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	afero.WriteFile(fs, swaggerLocation, []byte(blankSwaggerData), os.ModePerm)
+
+	// A generation in another process has only written the swagger so far.
+	genPath := filepath.Join("lib", KsonnetLibHome, "v1.7.0")
+	require.NoError(t, afero.WriteFile(fs, filepath.Join(genPath, "swagger.json"), []byte(blankSwaggerData), 0644))
+
+	libManager, err := NewManager(fmt.Sprintf("file:%s", swaggerLocation), fs, "lib", nil)
+	require.NoError(t, err)
+
+	generator := &fakeKsLibGenerator{
+		ksonnetLib: &kslib.KsonnetLib{K8s: []byte("k8s"), K: []byte("k")},
+	}
+	libManager.generator = generator
+
+	err = libManager.GenerateLibData()
+	require.NoError(t, err)
+
+	checkKsLib(t, fs, genPath)
+
+	fis, err := afero.ReadDir(fs, genPath)
+	require.NoError(t, err)
+	for _, fi := range fis {
+		assert.False(t, strings.Contains(fi.Name(), ".tmp-"), "temporary file %q was left behind", fi.Name())
+	}
+
+	// Once all the files are there, they are reused.
+	generator.err = fmt.Errorf("ksonnet-lib was generated again")
+	err = libManager.GenerateLibData()
+	require.NoError(t, err)
+}
+
+func TestGenerateLibData_reuses_unchanged_definitions(t *testing.T) {
+	cases := []struct {
+		name      string
+		fullRegen bool
+		noCache   bool
+		expected  string
+	}{
+		{
+			name:     "unchanged definitions",
+			expected: "{\n  \"__ksonnet\": {\n    checksum: \"%s\",\n    kubernetesVersion: \"1.7.1\",\n  },\n}\n",
+		},
+		{
+			name:      "full regeneration",
+			fullRegen: true,
+			expected:  "generated",
+		},
+		{
+			name:     "no cache",
+			noCache:  true,
+			expected: "generated",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+
+			cachedPath := filepath.Join("lib", KsonnetLibHome, "v1.7.0")
+			require.NoError(t, fs.MkdirAll(cachedPath, 0755))
+			cachedLib := "{\n  \"__ksonnet\": {\n    checksum: \"abc123\",\n    kubernetesVersion: \"1.7.0\",\n  },\n}\n"
+			files := map[string]string{
+				"swagger.json":  blankSwaggerData,
+				"k8s.libsonnet": cachedLib,
+				"k.libsonnet":   "k",
+			}
+			for name, data := range files {
+				require.NoError(t, afero.WriteFile(fs, filepath.Join(cachedPath, name), []byte(data), 0644))
+			}
+
+			swaggerData := []byte(strings.Replace(blankSwaggerData, "v1.7.0", "v1.7.1", 1))
+			require.NoError(t, afero.WriteFile(fs, swaggerLocation, swaggerData, 0644))
+
+			libManager, err := NewManager(fmt.Sprintf("file:%s", swaggerLocation), fs, "lib", nil)
+			require.NoError(t, err)
+
+			libManager.FullRegen = tc.fullRegen
+			libManager.NoCache = tc.noCache
+			libManager.generator = &fakeKsLibGenerator{
+				ksonnetLib: &kslib.KsonnetLib{K8s: []byte("generated")},
+			}
+
+			err = libManager.GenerateLibData()
+			require.NoError(t, err)
+
+			genPath := filepath.Join("lib", KsonnetLibHome, "v1.7.1")
+			checkKsLib(t, fs, genPath)
+
+			expected := tc.expected
+			if !tc.fullRegen && !tc.noCache {
+				expected = fmt.Sprintf(expected, fmt.Sprintf("%x", sha256.Sum256(swaggerData)))
+			}
+
+			b, err := afero.ReadFile(fs, filepath.Join(genPath, "k8s.libsonnet"))
+			require.NoError(t, err)
+			require.Equal(t, expected, string(b))
+		})
+	}
+}
+
+func TestGenerateLibData_full_regen_failure(t *testing.T) {
+	fs := afero.NewMemMapFs()
+
+	genPath := filepath.Join("lib", KsonnetLibHome, "v1.7.0")
+	require.NoError(t, fs.MkdirAll(genPath, 0755))
+	files := map[string]string{
+		"swagger.json":  blankSwaggerData,
+		"k8s.libsonnet": "cached",
+		"k.libsonnet":   "k",
+	}
+	for name, data := range files {
+		require.NoError(t, afero.WriteFile(fs, filepath.Join(genPath, name), []byte(data), 0644))
+	}
+
+	require.NoError(t, afero.WriteFile(fs, swaggerLocation, []byte(blankSwaggerData), 0644))
+
+	libManager, err := NewManager(fmt.Sprintf("file:%s", swaggerLocation), fs, "lib", nil)
+	require.NoError(t, err)
+
+	libManager.FullRegen = true
+	libManager.generator = &fakeKsLibGenerator{err: fmt.Errorf("generate failed")}
+
+	err = libManager.GenerateLibData()
+	require.Error(t, err)
+
+	checkKsLib(t, fs, genPath)
+
+	b, err := afero.ReadFile(fs, filepath.Join(genPath, "k8s.libsonnet"))
+	require.NoError(t, err)
+	require.Equal(t, "cached", string(b))
+}
+
+func TestManager_ReplaceLibData(t *testing.T) {
+	cases := []struct {
+		name      string
+		generator *fakeKsLibGenerator
+		expected  string
+		isErr     bool
+	}{
+		{
+			name:      "generated",
+			generator: &fakeKsLibGenerator{ksonnetLib: &kslib.KsonnetLib{K8s: []byte("generated")}},
+			expected:  "generated",
+		},
+		{
+			name:      "generation fails",
+			generator: &fakeKsLibGenerator{err: fmt.Errorf("generate failed")},
+			expected:  "cached",
+			isErr:     true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+
+			genPath := filepath.Join("lib", KsonnetLibHome, "v1.7.0")
+			require.NoError(t, fs.MkdirAll(genPath, 0755))
+			files := map[string]string{
+				"swagger.json":  blankSwaggerData,
+				"k8s.libsonnet": "cached",
+				"k.libsonnet":   "k",
+			}
+			for name, data := range files {
+				require.NoError(t, afero.WriteFile(fs, filepath.Join(genPath, name), []byte(data), 0644))
+			}
+
+			swaggerData := `{"swagger":"2.0","info":{"version":"v1.7.0"},"definitions":{"io.k8s.api.core.v1.Pod":{}}}`
+			libManager, err := NewManagerFromOpenAPI("cluster", []byte(swaggerData), fs, "lib")
+			require.NoError(t, err)
+			require.Equal(t, "v1.7.0", libManager.K8sVersion)
+
+			libManager.generator = tc.generator
+
+			err = libManager.ReplaceLibData()
+			if tc.isErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			checkKsLib(t, fs, genPath)
+
+			b, err := afero.ReadFile(fs, filepath.Join(genPath, "k8s.libsonnet"))
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, string(b))
+
+			// The temporary directory is removed.
+			fis, err := afero.ReadDir(fs, filepath.Join("lib", KsonnetLibHome))
+			require.NoError(t, err)
+			require.Len(t, fis, 1)
+		})
+	}
+}
+
+func TestNewManagerFromOpenAPI_invalid_spec(t *testing.T) {
+	_, err := NewManagerFromOpenAPI("cluster", []byte(`{"swagger":"1.2"}`), afero.NewMemMapFs(), "lib")
+	require.Error(t, err)
+}
+
 func checkKsLib(t *testing.T, fs afero.Fs, path string) {
 	files := []string{"swagger.json", "k.libsonnet", "k8s.libsonnet"}
 	for _, f := range files {
@@ -190,6 +394,16 @@ type fakeKsLibGenerator struct {
 
 var _ (KsLibGenerator) = (*fakeKsLibGenerator)(nil)
 
-func (g *fakeKsLibGenerator) Generate() (*kslib.KsonnetLib, error) {
-	return g.ksonnetLib, g.err
+func (g *fakeKsLibGenerator) Generate(swaggerData []byte) (*kslib.KsonnetLib, error) {
+	if g.err != nil {
+		return nil, g.err
+	}
+
+	// Like kslib.Ksonnet, the generated library includes its swagger.
+	kl := *g.ksonnetLib
+	if kl.Swagger == nil {
+		kl.Swagger = swaggerData
+	}
+
+	return &kl, nil
 }
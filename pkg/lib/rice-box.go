@@ -0,0 +1,56 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package lib
+
+import (
+	"github.com/GeertJohan/go.rice/embedded"
+	"time"
+)
+
+func init() {
+
+	// define files
+	file2 := &embedded.EmbeddedFile{
+		Filename:    "v1.10.3.json.gz",
+		FileModTime: time.Unix(1792047619, 0),
+		Content:     string("\x1f\x8b\b\x00\x00\x00\x00\x00\x02\x03\xec\xbd{\x93\xdbH\x92\xe0\xf9U`\xba3˙\xedLf\xabf\xcd\xfaNecg*=\xbak\xb7\xa6\x95#U\xab\xed\xec\xd4\xd6\v\x92\xc8L\x8cH\x82\r\x90R\xe5\xb6\xe5w?\x8f\b<I\x80\x0f\xbc\x01\xfe\xfe\xe8)M\x92\x8c\xa7G\x84\xfb/\xdc=\xfe\xf9\"\xf8n?<8\xfe\x8bW/~\x98\xfc\xfe\xc5\xf5\vwu\xef\xbdx\xf5\xcf\x17\x1bw\xb3p\xe4\xaf\xffs;u\xfc\x95\xb3q\x02\xf9\xf0\x9b\xe3\a\xae\xb7\x92?\x7f{9y\xf9\xfbɿ\xbdx\xbe~\xb1\xb67\x8f\x81\xfaɭ\xbdvo\xd5?\x1e\x9c\x8d\xfa\xcf\xdc\tf\xbe\xbbޘ_\xc8\x1f-\xfb\x9b\xed.\xec\xe9±^\xdf\xfdl\x85\xa5\xa9\x82g\xf2\x9f\xedR\xeax\xf5\xff\xbd\xb0\xd7\xeb\x85;\xb3\xd5\xcfn\xff+\x90\xdf^g\xfe\xf4d/\x17;\x7f\xfa\xb6\x9aO\xbe\xc6휬}o\xe3M\xb7\xf7/\xfe&\x8d\xf3\xbd\xf9v\xd6@\xc1\xc1\xec\xd1\t\x1b\xfc\xb8٬\x03\xf5\xb7\x8d\xfd\xa0\xff0\xf3|G\xfd\xff\xde\xda\xf1uA?\xcf\xcd\x00\xbc\x91\x0f\xa4矓\x8e\xfbN\xb0\x96\x7f9z\x00\x7f\xf8\xfd\xef\xf7\xc7\xed\xc3\xff|\x11\xd6f\xab\x0f\xffO߹\x97\xbf\xfe\x1f\xb7s\xe7\xde]\xb9\xeaK\xc1\xad\xebM\xbe\xfe_\xc1D\xc6\x7fi\xcf\x1eݕ\xe3?M\xd6_\x1f\xd4\x1f\x82\xc9\xd2\xd9\xd8\x13\x99\xaft\xc5\xcf2o\xff\xfd\xf7/\xf7k\xfb\xcb\xca\xden\x1e=\xdf\xfd\xdf\xce\\\xbe\xa5\xbe\xa7\xa7\xf5\xdb\xcbSgVz\xe4m\xfd\x993\xcei\xfd\xfb\xb7\x97\x853\xfb\xf9\xa5\f\xf1\xc7T\xf7\u06ddܨ\xe6_\xdc`Sj\x82g\xdeR\x9a\xeb\xac6\xc1\xc6\xdel\xc3f\xe7\xce\xf8B\xaa\xb0\xbc\xe9\x7f9\xb3M`y\xf7\xd6Ww5\xb7\xdeD?\xff\xa4\x7f\xbe3\xfd\xff\xed\xf6\xbf57q\xd7{e\xfd\x18l|\xc7^\xfe\xfbw{3{<\xad\x94\xecO\xcaʂ\x1a\x1a#\f\xfb\xe3Q\xb7<LT+\xd4\xec\xefTu\x96\x04\\\xbf\xf8\xed&\x19\x8d\x1b{\x96\x9a\xe3\x17;\x1f>\xf8\xdev}\x13\xee\xdb7jֵ\x88\xa8\xbf\xca/\xe4\xdb\xe6O/\xf6\xbb\x9e>9t\xa5k۷Ex\xe5\xcf2\x9c\xff|\xb1]\xb9\xff\xd8:?o\x9c\xa5\xfc\xff\x1b\x7f\xeb\xc8P?\xad\xd5\x01$\xb3\xe2\xae\x1e\xa4\x88l/~}t,\x91\xaf\x8d\xbb\xda:\x96\xa7\xffj\x05\x8f\xdev1\xb7\xa6\x8e\x15Ȗ\xf4\xfd\xd1Y\xc9n$?w\xbeI\t\xd6R\x06K\xedNۅ\b\xed\xbd\xef-\xadͣ\xfa\xa6/m\x9bX\x9f\xdc\xd5̑\xbf\xb8\x81\xf5\xcd^H\x99\xf2\x0f\xf3\x99\xa5\aߙ_[\xb3\x85\xabV\x87\xb5\xb4\x9f,o\xb5x\xb2d\x91\xe8B↘\x9f\xea\xc2mk\xedK\xc5\xde6\xb0\xa4g\xfeSX\xb5\xf5\xdd\xdd<Z\xee\\\n\x12y\\\x84\x9f%\xa3a\xfd\x8b\xf3\xdb\xccYo\xac{\xcf\xd7e\x9b\"e\x91E\x95\xfc\xabe\xcbjKڮ\x9b\xe3;j1J\xa5;Mq7\xd6\xdcs\x02k\xe5m\xe4;3\xefa%\xd3>\xb1~\xbe7\x05\xac\x9d\x99{\xef:\U000fd7e9_X\vo%\xaa\x81\xfa\x93;W\xc3)?\x91ѐ\x8f7\x9e\xe5\xfc\xb6v\x8d\xd8[\xff\xf2\xe0Ȧd/d@\xee\xddo\xfa\xd3{\xf7~\xe3\xc8\xf0/\xa5L\x11\x9c\x7f\xb5\xa43\xbam\xf7\xee\xc36\xfc\xd9\xecі\xe2e \xd3}I\xfd\xf3\xbb\xbbXXf\xc9\xccͨ\xd9\xd6\x7f\x7f\xf9{+\xda\xe7ީ\x16H\xd3\x1dߗ\xd2E\xf0\xf4\xfa\x96\x89\xd63\xa2\xa7\xcaZn\x03\xd5o\xd9\xd0\xfc\x8d\xfa\xbb\xeb[z\xefR\xc5y\xdbMv\xf2d \x16\xf3\x89\xf5\xab\x92\x01\xfdo3\f\x1b+خמ\xbfq\xe6F\xa6\xf4\x06\xa1>Sr:\xb1ޤ\xa4\xc2Td\x87_\x89\x85la\x9bf\xe8v\x87\x87p8\xd2\"\xa0[Y]\"\xb5O\xe9Ϋ)VU/\xdd \x90\xff\xe7I\xa4w.3ev\xb0`\"\xcba%\x02#\xeb j\xbdV\xdd\xe4\xff\xd7\xf2$jY\x89%\xf5Z*_\x88\x14)\xc1\xf3\xf4\xa8\xf9\xeě\x91\xd9\xf0\xef\x93\xd6F\x9b\xbfi\xb5\f\xab\x1e\xb0`b\xbdu\xeem\xbd\u0094\x8cHO\x9edI\xad\x1eR\r\xd6_\xfc\x14VtN\xab\xa7\x9e\xb7p\xec\xd5^\xb3\x95,\xab\xef\xa9E\xb4q\xb5\x18\xea\xbdR\xa4Vv\xb8D\x1f\xb1lY\xff\xb2\xca\x17۹\xfc\xd95b\x17mɩ\x06\x86_\xf9\xcb*UJף+\xaa\x95\xb38et\xf5\x17ˌ\xae\xbb\xda8\xca\x0e\xb8\xde;ꗲ\x87\x88\xb0\xdb\"\u0ff9\xcb\xed\xd2Zm\x97r\"\x98\x06\x87\a\x9a\xe9\x92j\xbd\u07b7l\xd3%\xd9\xdf\x16z\xaf\xd1[\xaf\xabj\x95}C>\xb9\xde[\xe7j\xc3V\x7f\xfb_\x91<\xff\xafp\t\x86ۃ.O);s{c\xab\xda\xecp\xfdl\x1emU\xd1J\xed\xfa\xb2\x1d\x87;\x85.^F$\x92\x84p\x9b5\xadT\a\x82ٷW\xceo\x1b]\xb5\xe9\x8b\x1aY9\t\x9c\x8d\xdeFT'T\xdf\xcd\xf6j\xfa\xe6|\xd7{\x94\x1d\t\x8f\x14\x1b\xa8}\xc1^zە.\xc6\xf4\xf2_\xb6kU\xd9\xffv|\xcf\xfc\xe5_#\x81\x93\xaa勶\xdeڢ_Gӭ\x04\xf4\xde]\xc81\xa0\xfe(ۓ\xda\x05\xa2C'<\xdc2玜0\x81\xa3\xce-\xef>g+S-\x98\xabCEva'\u07bf3Ǡ\xaa1V\xddU\xd7Ռ\x98\xadl\xf6\xe8yR\x8dڄ\xa4\x9cp\v\f\xe7B\r\x8b\xed?\x88n\xb72\x8d\f\xf7j=H\xaaoa{2V\x81\x19]\x91\x86X\xa4\x92\xe3':\xd1v: \xdfq\x96\xeb\xcdS\xf6\xe8\xb5\x03\xa5U\x9a\x99\x97S\xeaP\x87\x0e\xed\xe5\xee\xfd\xceN\xfee\xf5e\xf5k\"\x97\x0f[9\x94eU(\xe9VU\xa9\xf6E3\x15\xafT} l\x03%/q\xdb\xf5`\x888&\x87\xbc\f\xa0\xec\xe3[#U\xea\xcb\v'Y!\xf1a\x14\t܍\xa9\xcf\r\xaeU\xf7\xa2*g\xa2\x9cn\x94\x16bN\x02\xf5/O\xe9&\vG\v\xa0\x9c\xb7\xe6\xf4\xbcw}}\xd8h\xe1R}S5;\xf3\xa4U\xa9\x1dP\x1d,\xc1v\x1a\xa8/\xcbLF=\x98G?\x0f\xc2\x11Ts\xe5\xc9\xeasE\x1d\x96\xcf\xee\xe5\xc8u\xb4xف9\xd6\x03\xe9\x8c*!X\xd9k\x11TY\xe0jF\x1dQ\xff\xfdh\xf8\xec\xe8L\x0e;\x17\x8e\x9a\xe9\xb2^\x983Gi\x0f\xc1R\xc6D\xfa2{ܮ\xbej\xeb\xc2V\xa4\xe0I\xf6@\xff!\x9ah\xbd\xe2M\xf1\xaa\xd3\xd2\v\xc7т\xb7\xf6\x82\xc0U\x02\x17\x0e\x9b\x16\xb8\xf4\xfaڮ\xe7j\x18E\x8d\xf1\xcdt\xe8z\xe4\x0fz>\xb4\xc2\x15\x9eϡ\f\x9b\x1f\x9bV\x7f\x97\xfe\x9a5'\xfd1\xdfVc\x92\x1aw]J\xa4\xe9ɷe\x82gۅ\xae\xd1M\xc4&\xbda\xab\xfe\xd7p\xbcH7\xaf\xd4\xf7\xae\xf4\xdej\xf6\x1a\x11\xaa\xf5V\x8f\xb54z\xb3\x115\xd3W\xdb|\xbaz\xf3A\r\xf5\xffUU\x9a,\xe8P_3\vLI\xf9\xb5\xda\xc0\xbe\a\xa1\xd6\x17ʄ7\x9bm\xfdXv\xe5\x0f\xfa\bW\x03槧\xc1\x8e\x0f\xf1\xec\t\x18\x95\x15\xebYS\xe7\xc1]\xadԼʯDp\xe5\f|\x9aX;MS\a\x94\x9a\xa6W\xb2\xd0d\x0f\xd8\xca\xf9\xb5I\rZ8y\xa9\xd92\xe5\xfb\xce\xd2ۈxJ\x99\xb6\xc8\xe1\xd4V\x87\x8d\xb4\xef\x1f[\xcf\xdf.odu\xca\x17\x17\xf6Ï\xa6Xws\x15X\xbf\x0f\xcb\xfd.\xbfs\x97\xebE|\x90|\xd7\xd2$\xfb\xddVV\xd2j#\x1f<\xda\xdf\xd4ڔ\xb1\x12\xebO/\xfcx\xff\tK\xd4G\xa4'\x9f\xac\xf4\xb9\x92\xdbd)Ut$\x11A[\x8d\x8a\x13<\xaa\x7f<Ȳ\x12K\xe8[j\xdawt\xd1:\x14\x85_e!\xa8],\xb2[\xd4\x10\xdf&\xf3\x1f\xee$Z\xdc\x03\xfd\x85yd\bD\x87\x97\x96\x12\xdfy\xb0\xfd\xf9\xc2\t\xccҗ\rJY\xa4\xdf\\\x91^\xad\xe5G\xff_\xaa3\x1bS\xf3'1oV\xf3\xa0\x0e\x95\xf2\xafF}\xf7\xfcD\\=\xd3f\xfd\xb5iV\xad\\ͣi\x95\xaf,͞hX\x82\xee\xc2\\vj\xb3\xeb\\\x87\xdf]z\xdf\xf4\xc1\x9aR\xe8\xadOZ>\x9fv\xad\x84T7#\x94\x91\xee\xdd\xdf\x0e\xe1\x9b\xdb\x7f\xaa_>\x17R\x1c-\xb2Y3\xb0C\x86S\x16\xb8\xa8^t\r\\*\xc3\x165?\xfde-J\x8c\xa2U\xba_](\x9e\xfa?\xa1t\xaa+\x01=\xfc\xff\xd8*\x13\xdd\xd4\xd2\xf9\xb1\x96],\nE,E[9\x029\xfd\xf0\fۧ\x9d\xaa\x80\xff\xb0\xd7p\xceh$\xde{\xfe\xeb\xc5\xe2\xcf2\x03\xc1\xdan\x86\x80\xa7\x16`Xi\x0fXg\"\bPN('\x94\x13\xca\t\xe5\x84rB9\xa1\x9cPN('\x94\x13\xca\t\xe5\x84rB9k\xa1\x9c\xcej\xbe\xf6dv\xcar\x9bw\xf1\xef/\x9d\xdb\xc4#\xd1&\xb7\x89+\xed\x9cۤ\x05\x01n\x03\xb7\x81\xdb\xc0m\xe06p\x1b\xb8\r\xdc\x06n\x03\xb7\x81\xdb\xc0m\xe06p\x1b\xb8M=\xdcFi\x16\xa5\xa1\x8d\xfa1\xc0F\x8dB\xab\xb0FU\xd8=\xa8\t'\x1fH\x03\xa4\x01\xd2\x00i\x804@\x1a \r\x90\x06H\x03\xa4\x01\xd2\x00i\x804@\x1a M-\x90F\x0f\xad\xaf\x9b[\x92\xd4\xfc\xa2J\xf8\xa8J\xb8x\\\x93\fE\x9b\xcc&\xa9\xb5sp\x93\x91\x05\xe8\r\xf4\x06z\x03\xbd\x81\xde@o\xa07\xd0\x1b\xe8\r\xf4\x06z\x03\xbd\x81\xde@o\xa07\xb5ЛU\x02\x18\xca\xc1\x9b\x98P\\<\xbbI\x8f\x04\xa0\x02P\x01\xa8\x00T\x00*\x00\x15\x80\n@\x05\xa0\x02P\x01\xa8\x00\x14\x00\n\x00\x05\x03\x02\x05\r\xfa\x1c\xc4\xd6r\xe7.\ai\xbb}\xdf\xe3\xc0\vr\x90\x889\xadd\":\xa1\x1feQ\x85i\xf5QX\x11\n\xc4ԛǈ7\xfcw67s\xa5Y\x97\xc1mE\xba\xf4$\xfe\x90'Yo\x8c\xc6QK\xf1?\xec\x17\xffz\xa68G-\xe5WY\x18Z~\x1bY\x18\x15\tWSI\xba\x13\xa0iR\xd9\xeb\x7f?\xdfN\x15AY=hA;\xb2\xa6\x7f2_\x1d슞'\x1dhqeG\x956\xbb\xae\x93Z\x1aX\xd5\xd9\xc2\xeb^\xd3\xe9һZщdԾ\x9eCCB\xa7\xfdWz\x88\xea\x8b\x15\xccD^E7\xdfδ\xaa\xa8\x957Q\\\x8cV#ү\r\x97\xecs\x00ц3\x907\x01\n\xb6\x1b\x9e\n\xa8\xffZe\x9e\x1e\x13.X\xb8`ႅ\v\x16.X\xb8`ႅ\v\x16.X\xb8`ႅ\v\x16.X\xb8`\xe1\x82eH\xaf\xdd\x1d\x86\xb1\x9dp\x90zq\xec\x01l\xd1$\x90M\xaa}nGΚ\x81\xb2\xbb\xc5\u05cde\xb3\xe5w\x05f\x0f.\x11\xa3\xa4\xed\xb7\xcb\xfc]\xf4\xa7\x85\xb2\xd7\u00adxP\v\xc6\xf4 z\x124\xea\x06\xc4\x0f\xe2\a\xf1\x83\xf8A\xfc ~\x10?\x88\x1f\xc4\x0f\xe2\a\xf1\x83\xf8A\xfc ~\x10\xbf=\x94\xb1\x14\x01\x94\xf3Z\x16\xce\xfa\xeb\x83\xfaC0Qz\x8f\x82\x1b\x9f6\xf6f\x1bT&\x1b\xe6\xccH8C3 \x10\a\xb4F\x1c\xd0̟\x9f\v\xfd\xd0\xf4>\x98\xb5\xed\aŐT\xfbk\xf1\x14+\xda[>\x19=Z\xeb\xe2\xbfi\x9dv\xaa\xfe%\xabcbY\xef\xd4\x7f\xa3\xbf/m\x99>\xf9\x9f\x1c\x9a\x8b\xad\xe87\xfeM8\xa6\xb3\xd0ڕ\xdd\xf4\xabc]\xc5\r\xbdJʹ.\xb1\x8e\xbd0noL\xa6\xa6Q\xcbE\xb4T\x93uc\x95\x9c\xae\x83\xa8a\xa1\xc6%v\x82\xafU%\xad\xf9\x9a\x1d.\xd3F\xf5\xd3..1*\xefaJ\xf6\x1bٶ\xb6\xb9kj\xbd\xb0gΰ\x97\x95\xee\x02\x97\x19\xcd]fT\xbal\xd8vr\xd7`\x0f\xf7~\xa1+\x19>\xa0\x9e\xbd\xd5\xcd\xfb\xa0\xc7Xki\xa5\xd4\xf8\xb4f.\xf6H`\xb4j\xd9\xf4\xef=c\xecF\x16ir\xdb\x11B\x80\x89\xf5Y\x9f\x0f\x1azO\x95\x86\xbb\xbaY\x89:\xbfq\xb5i\xa3\xebSf@\x04\xf9\r\x9d2\xdc\\\xf4\xe8P&\xdc\xe5ҙ\xbb\xf2\x97\xc5SxO\x90\xbe\x12Y\xb9\x8b\xebP\x13\xd7v\xa0\xf5\u0addQ\xa6\xc9\xf5\xe6\xb1\xed\x91l\x92\xaa\xb71zP|.kA\xda\xea\x97Q\x87\xc2J\xeeS\xa7\x95\xab~\xa0۹\x94\xb31\xb7\x8d\xc9q\xa6\x9br\xa7[R\xa3-\xf2\xd6\x11\xa5M\x8d\xd0\xfc\x95\xb5VƝ\x13\xe3\xb7;\xdf[\xdb\x0fz\xaa\xee<\x91\xfa\xa7k3Z\x068E\xbd\x9e\xc7\x05\xa8\xf9|9\xf9\x83\x18\x1b\x89\x0e\"\x9f:+\x05\x8a\x12\xe8+3\xec\xaf\xc5\xeeQ}\x0f\xe1\xf6\xed\xbd\xbd\b\x1c3\xf2_^\x98\x8f\xbf\xbc\x90\x8aV\x9aO\xfbqeb\xedh2sk\xac\x9cyb\x9b\x9b⯂\xe4G\x81\xb6\x11'\xd6;W\x83\xc1T\xd3e\x1a\xf7\xfa\xa6ћ\xb9Z\xbb\xb6\xa6ۍ\x9e\xa5\xa9\xb7yL̀i\xd8ۨKA=\\C\xb7Ni\xf8\x8f\xdewK\xacө\xb2\xffSפQ\xdfE\x94D\x00\x97j\xd8r\xbb\xf4a\xa7u\xc5=ҋ$\x92\xf0\xb5龫\xa4o\xe6Γ\xab\x1a\x8d\xb2\x15\xecH\xa6A1\x82\x10\xf4F\xb0z\x92\x1a\xef\bsFFf\xa2Pf+\x9bX\xe6\x12\\\xa3S\xbd,4\xbcze]\x99>\\Y7\xa1\x8cde(\xf8Ѻ\xfaɞ}U\xa7\xc4j\xae\xbe%6\xbd\f\x9a\xfa\xd2\xce\xc0\x99k\x88p=e\v\x89z0\x8dK\x92b\xdf\xcb\xee\x93*V\xb4\xca`f\xab\xe8\xa3h|\xb4\xcai\xca\v4\x8a\xdb/\xf0>.#c\x12\xed\b\xdaxL\xec\x86\fk\x85!\xf6\x1a\x96\\~\x19\xd6q\xa2\xb2\xba{\xa8\xdf\xe8\xe2\x7f\x97s\xbe/\x1d\xff\xc1)\xfeX\x96\xaf\xd4\xfa\xe0\xcen\xf6\xbe\xd8;=B\xb7\xad\xb7jĝ\x9e\xe0\xe7\x81\x18b됊\xf5\x8f it\x14\xb2\xcdtE)8T?\x17\x02[\xa5\xb1\x95\xec\xffkϭ\xf0\xe8k\xfc{\xc2&\xa3}*=&8Q\xe1D\x85\x13\x15NT8Q\xe1D\x85\x13\x15NT8Q\xe1D\x85\x13\x15NT8Q\xe1DEؤ\x02]\xb1\xb5\xdcy\xd8d\xdan?/l\xb2\x13\nRo\xd0\xe4\x01hѤ\x9fAR\xeds;R\u058c\x9f\xc1n\xf1u\aMf\xcb\xef*h\xf2\xe0\x029+hrP\v\xe6X\xd0$\xbc\x0f\xde\a\xef\x83\xf7\xc1\xfb\xe0}\xf0>x\x1f\xbc\x0f\xde\a\xef\x83\xf7\xc1\xfb\xe0}\xf0\xbe\xc1\x05M\x1eƀ\x04M6\xe1}V\"frP\b)/f\xb2\x146\"f\xb2\x7f1\x93\xf5\xc1\xd9\n1\x93\x87w\xad\xd3c&\a\xb6\xacrc&\xb9˨\xef.\xa3\xa3\x98\xc9*W\r\x83\xbd\\\xe8J\x82\x89\x98$b\x92\x88I\"&\x89\x98$b\xb2\xa9\x88\xc9#Vu\x99\x88ɢs\x9e\x88\xc9ީ\x11mDL֨\xb7V\x89\x98l\x14\x1f\xa5#&\xd3\x15\x111\xd9\x1e\xb3\xfa\xe6T\b\x97T?&T2٠\xc2\xf1\xc0m\n\xb7)ܦp\x9b\xc2m\n\xb7)ܦp\x9b\xc2m\n\xb7)ܦp\x9b\xc2m\n\xb7)\xc2$5\xddR\x9aB\xf7!\x92\xa1\xbd~櫒\xab\xf6\xc1G\xcdё\xf9\x9c\xa2Qo\x02]\xe5s\xf3BՐ\x17A\xaa\xe8ڣ!\xe3\xb2;\x8b\x84,Z\a\xe7EA\x0eeQ\x1c\x8d\x80\x04\xe3\x81\xf1\xc0x`<0\x1e\x18\x0f\x8c\a\xc6\x03\xe3\x81\xf1\xc0x`<0\x1e\x18\x0f\x8c7\xa8\xe8\xc7B\xc2G\xe4c\xed^de\xc2\x1e\x87\u008crC\x1e\xcf\xe5D\x84;\xf60ܱ\x16\xfaZ%Աp\x8b:#\xccq8\xcb(?đ\v\x89n.\r\xb6\xad\xdf\x19\f\xe9\xf6\xac \x9a\xb15a%\x92\x91HF\"\x19\x89d$\x92\x91H\xc6\xc6\"\x19\x8b-\xe4RQ\x8c9g;\x11\x8c\xbdR\x1dZ\x89^\xacG=\xad\x14\xb9\xd8\x14\xfa\xc9D-\x86\x95\x10\xb1\xd8\x1ak\xd2x\xd8\xd7ȵd\xd8\xe2/\xaa\x84\x8f\xaa\x04b\x17\xe3\xad)3(x>\xe1\xf9\x84\xe7\x13\x9eOx>\xe1\xf9\x84\xe7\x13\x9eOx>\xe1\xf9\x84\xe7\x13\x9eOx>\xe1\xf9D\x00\xa3\x02\\\x89\xb9\xdcy\x14c\xc6r?3\x94\xb1#\x16Ro8\xe3!tѤ\vA\xaa\xde疄\xad\x19g\x82\xbd\xf2\xeb\x0eqܩ\xa0\xab8\xc7\xc3+\xe5\xac`\xc7a\xad\x9bc\x11\x8f\xc0?\xe0\x1f\xf0\x0f\xf8\a\xfc\x03\xfe\x01\xff\x80\x7f\xc0?\xe0\x1f\xf0\x0f\xf8\a\xfc\x03\xfe\x01\xff\x86\x17\xf6x\x04\t\x12\xfb،?Z\x89\x00\xc8aq\xa4\xbc(\xc8r\xec\x88P\xc8\xfe\x85B\xd6Hi+\xc4C\x1eٻN\x0f\x8a\x1c\xda\xd2ʍ\x8c\xe4n\xa3\u07bb\x8d\x8e\xa2%+\xdd<\xd8\x03\xbem\xe8L\x92\x89\x9d$v\x92\xd8Ib'\x89\x9d$v\xb2\xa9\xd8\xc9cfv\x99\x00\xca£\x9e(\xca\xfei\x12m\x84R֩\xc0V\x89\xa7l\x96)\xa5\x83*35\x11Y\xd9\x1a\xc9Z\xabIշE\u07fc\x85l;\xb3\x85\xed.\xcb\x06Y\xdeŅ}օ\xbdQ\x85\x11o\x19obE\xe3\x83\xf7\x15\xdeWx_\xe1}\x85\xf7\x15\xdeWx_\xe1}\x85\xf7\x15\xdeWx_\xe1}\x85\xf7\x15\xdeW\x84^j\xe8\x96g9w\x1e\x85Ydϟ\x1b\x90\xd997\xa976\xf3D\xccѤ+C~\x13\x9eۗ\xd1f\x1c\x1c\x0eUUw\x1cgq]]\x85t\x9e\xbc\xecΊ\xee\x1c\xec\"<\x16\xe8\tu\x84:B\x1d\xa1\x8ePG\xa8#\xd4\x11\xea\bu\x84:B\x1d\xa1\x8ePG\xa8#\xd4q\xd01\x9f\xa7\x03H\xc2?\x1bw\x9a+\x11\t:X\xe6\x94\x17\x14Z\x993\x11\x1fڿ\xf8\xd0f\xe8o\x85P\xd1ӷ\xbcӣF\a\xbc\fs\x03H\xb9\x80\xe9\xee\x02\xa6\xa3`Ӻ\xeeD\xecq܃\xf4a-\x10\x8dJ4*ѨD\xa3\x12\x8dJ4jSѨg\x00\x802\x81\xa9\xa7\xe8\x02Ĩ\xf6Z\xd5h#\\\xb5!m\xb8J\xe4jkd,\x1d\xc4ZT)\xf1\xac\xfd@s\xb2\xc5\xe8=\xfb \xa13߉\xa6\xf4\x92X]x\xa2A\x93ΥI#\x14\x99ӹR,5\xd0%\xe8ҩjf\x95\x15\x83\xc2ٗ\xf5\x88ډډک\xd5No^:i\x8a7'EJ\xb2\xa5\xe9\xd1 4\x81\xd0\x04B\x13\bM 4\x81\xd0\x04B\x13\bM 4\x81\xd0\x04B\x13\bM 4\x81\xd0\x04\x12\xa2h\xf6\xe5ͻO\x7f\xa2m\xf5\xb3\x93\x9d\xb4\xcb;jNm\x92\x87'\x1a\xbd\xe9\xf0\xe6MSTo\xde\xd0-F\\p\xed)I\u0092;K@\x92/\xf8\xe7\xa5\x1b\x19\xc628\x9a\\\x04^\a\xaf\x83\xd7\xc1\xeb\xe0u\xf0:x\x1d\xbc\x0e^\a\xaf\x83\xd7\xc1\xeb\xe0u\xf0:x݀R\x89\x14\xc0<\x12\x87\xd4\xec&V&M\xc80XQn\xa0\xc1y|\x88\x14 =L\x01R\x03m\xad\x12\xa2Q\xb01\x9d\x91\xdec(\xcb'?\xe8\x82+\x87v/\x06\xb6-\xdf\v\xd8C\xbc\vh_>I\xa7A:\r\xd2i\x90N\x83t\x1a\xa4\xd3h,\x9dF\x91\x11\\*y\xc6ީN\xe4b\x8f\x94\x86V\"\x14\xeb\xd0G+\xc5#6Cu2ч\xba\nb\r\xbb\x80H\xb7\xf6fc\x9b\xad)\x97%\xc9\xe6\xb3R#\xf7\xc7w\xbfƷ@Z\xfb\xd3?;\xd9\r)\xfcS9?:\xd3\x04\xb3\x01\xfc\xd1\xd9d\xf6\x80צ\xf9%V`vF\xaa\xae\xb1\xb0\x91\xed8B\x86sr\xf7\xe1S?&\xe5N\x9ayI\xb3R\x93\xaf\x9a\xad\xce\x15\xa5`}\x7fteڴ\x17\x953\xdbn\"'\xb2\xe5R6\xa2\xacѥ\xaf\xfbS\xbf\xd5\x1b\xa8\xaf\xcd;\xfd\x91\xb7\xda)Z\xbb\x05x\xf3\x1d\x0f!\xfdq\r\x1a\xfe\x85\xee\xe2\x85\xccv3w|3+\xbe2\x87c\xfb\\+\xd8A\xf8\xa9\x9eȩ\xb2$\xe6R\xe1l\xe3$\xc6w\xb8\x80\xcd\x05]z\u07b5CB\xd2\tST-\x98y#\x86@\xd4\xe2\xeb\xb8M\xe6J0\xd8\xc8\x18ھ2~\xd5Q\x94\\\xa2\x85R\x15\xb6\xdb\rrZ\xac\xad\xdel\x93\xddUM-V\xf7\x9aŃ\xec\x99S\xb3\x8eA\xf6\xb6\xb5\xb0\xfc_\x7f\xfd\x7f\x8b\xdak[\xeah\x8f\x11\x80\x98|\x067\xe466r\xbeXہ\xba\xed\xde<ʎ\xf5\xf0\x18{ʘE\xefoW\xda\t!0W\xa4\xaax7H\x95\x1czP}\xf7\xfc\xaf\xf2\xed\x957w\"\xa3x\xaf\x8c\xc33ZY\xf5\x98\xaa\xb1\x90e.{\xefA\x8f\xff\xf0{\xc6\xf5`\xe8\xce\xff?\x85\x9dn\x15\xc8G\x956k\xab$\xb54\x00泅\xd7\x1d\x0f\x90.\xbd\xab\x98\x80D2\x1a\xb5\xb6\x92j\xb0\xb8:\xb1\xb8\x9con(.G\xf6\xbd\xe8\x8b\xe3\xd8\xf8\xdeE\xddne\xe7\va췗Sgc\xbf\x9cĕ7\xb3\x03\x16\xd7V\xe3Nx\xb8\x92\xbav\xc4C\xb5\xb4\xbb3\xae#\xa4\x1c\xee\x8f)\x01Jo\x90\xba\x9d\xb5\uf4a9\xca\xd8&\xbb\xd9&\xc5\x16>\x1bK\xa9\x1fu\x0f\xa5ީ\xa6\x83\xa4\xba\x9b\x90= 5\xea\x19\xa9\xb8\xed\xbd1\xa0I\xdb\xcc\xfa\x82S;V\x87\xf8)E\xa5&*\xc6\xe3\x9b\xfc\x1f\xdf~\x9aX\x7f\xf66\xd1\aƽ\\\xc5\x0fX\xc1\xa3#\x86j\x1a6\xe9Rj@Mo\x00f\xe3\x03f\x1fC:\x93\xa5M&\x82\xf3,\xda\xd4\x18\x1f\xcboa\x9fxX~\v\xc3C\xba\xe2 vϿ\xf4\xf9q|4+k\x1b\v\xef\xe1\xb0?\xb5|!'\xd9\xf0)\xa7\xda\xc6\xf9ms\xbb^\xd8\xee*'ufo|\xac\x7f\xf1\x1e\xba? \x1bp\xf2\xad\xf5\xaeFIe|\xf6\x84kK\x04#8\xe3\xcci\xe6\xb8)Zy\xef\xbd؉H\t\xf0\xdevpxU\xdd\xeb_\xd7\x11\x8d\xf4\xf3\xbd\x15\x85u\xa5ⅧO\x9b(V؞\xef\xe6`\x88\xdd&u\xc0j\x12ʯ\x17\xa2\xde\xddB \xae\\\xc1\x94\xfb\xd7\xdc\rd\x95=\xe9\b\xc4\xe5Z;Gi\xff-k\xa1\xe2]\xa5:\xf9僔b\xc2~Ra\xbc\xc1\xc2}xTA_:tT\x1d=\xd1\x1ft\xdcS\x1cߛ\xac{\x1d6\xb5\x1b\xb5\xf7\x93\xea\r:B\x8f-В\xe7\xab\x16\x928{G$\x8fa\x96\f\xb3\x92\xf77\x81ݵ\x14\xfd\xbe\x8e\xd5\xf4Z$wa|\x83\xf5UO\xbe\xafq\x18\xcch\xbe\xa2\x17W\xb2u)wL\xd3欛\xb0rwu\xe6N\x90Ĳ\xdaZkP\xb1\xab:\x99\x85\x8e3V\xfb\x9b\xfa\xb5\x8a]\x0ec%ԗL\xb6\x8b\xe8,\x8f\x83[\xf7=\x91#\x9f\u00ad|\xc3\x04W\xea\xd2\xf6\x7f\xf9!R\xd1e9\xe8\xbaB\xe7`\xbdH\xd5\xff\xaf\xa2\x1cc_\xd0\xd8\xed8\xa5ä~\xd4\xdc>\xa66\x98Tث#k$\\\xbf\xba_\xe1\x80\xeb\x81\xc8\xf8G_\x9b\xcfU\x1c\xb2\xfa\xc2*)BS\xd4t0f,iQ\xcf\xf3F\"\xa5\x0f\xd9\xee\xe2\x17ժZ3j\xd8s\x15\x9ao}|\xff\xe6\xdf\xfe\xed\xdf\xfeoUu\xf8\xcf?\xdb2\x87:\x1c|c/\xd7Q,t&\xfc\xd71A\xdb\xc9V\x1co\xe2\a\xf5\xba\xa8̠\x9az\xa7\x02\x9eda|\x17\xd5\xf8l\xa6\x94\xfam\xf7h\xe9.\xd5\x11\bS\xe7ӳ\a\x9a.a~p\xfd,Ӽ\xa2#\xe5\x970ۏ\x92c\xb3\a\x86\xc2\xfc1,<\x9dT\xe4\xaf\xce\xf4\x937\xfb\xea\xa4\x1b\xa7\x7fXq{\xf4\xbdߞ\xce\xdf\x18կz\xb0%\xeaƏu\xb1m\x0f\xed\x85\x7f\xe9ń\xdcm/iB\xce<\x9d:\x9a\x92\xbdsi\xccsR\x14\x1e\x1b\xcd\xca\xdbw\xbf\xbc\xfb\xf5]\x1f\xe6\xc5ę^\xce\xccxaDm\xe1\xd4|\xb8\xfb\xf5\xe7\x0f\x7f\xfeԇ\xb9\t\xa3\x7f/gr\x1e\x1d{^<3\x7fz\xf7\xfam\x1f\xa6\xe5O\xd2\xca\v:^\xf2\x03\x05\xe3\xf3\xe5\xf5\xafo\xfeԋ\x03F\xb5\xf3\x92\xa6\x05\x9b\xa7\xbe\xe6\x89\xf0<F\xee\x06\x7f\xf9\xf8\x8b\xa5JV\xb2\xac\x82\U000e32ff\x88^\x1a\xf9\x8e\xf2\x17j{?\xc3yM\xa3\xaa\xda>\xb7\xffT\x05=\x0f\xda\x04\xfa\xab\xbby\xbc\x8b\xe6\bS\xa8?\xa6\xd0\xe8'f\xc0&\xd1\xd8\xe7f\xf8\xa6\xd1\xd8gh\x14&\xd2\xd8'iȦ\xd2菟a\x9bL\xe3\x9f\x1eL\xa7\xfa\x9a\x17\x99J\xe9TT\xf9\xd6\xd0H͵\xc0dy:\xe8\xabi\xbeS\xd2]\xb3\xb7\x1e\x9aa~+\xf2\xb4\xa6\xf3\xb4\x0e~\xaa\x8b2\xb6ƳM\xde\xd6\xe1\xe6m=5\xed\xdb\xe9RL\x02\xb8n\xd6\bi\xe0P\xeeF\x18m+m[\xab\xe7h\x8a\x15*\xf3\x18\x99\x1f>\x1d\x13%\xba\x95)S\x82\xa1\xe6\xecװ\x886\x8f\xdb\xfd8\x9a\x1fMhÿG\xafr\x9cPJ\xf6'e\xf7&5>9[SjTxڒ\xa7-yڒ\xa7-yڒ\xa7-yڒ\xa7-yڒ\xa7-yڒ\xa7-yڒ\xa7-/\xfai\xcb4݊\xece\x15\rU\x99t)\x81\xa8\x00\xbaR\xb6\xfb\xa9\xbeN&a\xa3\xdd\x15\x0f\xa9=Yc1\xbeh\xf8\xfe!\xae\xf8\xb9-\x89k\xec>b\xa7\x82\xbaS\xd6\xee\xd6\xd0U\xda\xda#\v\xe6\xc8\xd3u\xa9\xb7\xa0\x8cCƐ\x96O\xfa9\xbb7qG\xe0\x80p@8 \x1c\x10\x0e\b\a\x84\x03\xc2\x01\xe1\x80p@8 \x1c\x10\x0e\b\a\x84\x03v\xf8\xa6o\xc2\x1a\x9a\x82\x83\x15-|\xdcӊ\xdc\xd3B\xef\xff\xc3n\xff{\xbe\xb3CbI\x05N\xffe\xf8Q\xe1\xcby\xc9\xd3\xf5\xceoZ\xb7\x9d\xaa\x7f\xc9*\x99X\xd6;\xf5\xdf\xe8\xefK\xdb\xd5\xf9&\xe5\xf0\\lE\xcf\xf1\x93\xd7\u038d\xd5+\xbb\xeaWǺ\x8a\x9bz\x95\x9ao]b-/\xfdE\xed\x8d\t\xd54j\xb9J\x0e*M֍UҺ\x0e\xa2\x86\x85\x9a\x97\xd8\v\xbeV\x99\x92\x84\x9bO\x996\xaa\x9fvs\xc5\xd1q\x88\xc5\xc1\r\xecP\xa8\xc5\xc0\x97WQ\xa0\x05W\x1d5_ut\x17\x82Q\xe1\x1e\xc2\x1e\xf2\xddCw\xf2|@u3\xf1\xfcaĸ\x9a\x82R*~Zk\xcfOs\x1d*M\xc9MH\b\b&\xd6g}fh >U\xda\xef\xeaf%\xaa\xbeN\x9b\x1d֧L\x84\xe8\x02\xc0\x90\xab\xf8u\x8aP.\xdc\xe5ҙ\xbb\xf2\x97\xc5SN\"땻\xb8\x0e\xb5tm#Z\x0f\xbe\xda)e\xa2\\/y\xc3\"\xd94Uoc,\xa1\xd8]ֺ\xb4\xd5/\xa3\x0e\x85\x95줌\x9e\x98v.\xe5\xbc\xccmcr\xc4\xe9\xa6\xdc\xe9\x96\xd4h\xa7\xbcuT\x8ap\xb5W\xbc\xb2\xd6\xca\xf0sb4w\xe7{k\xfbAO՝~\xba\xedڌ\x96\x81QQ\xaf\xe7q\x01j>_N\xfe \x86H\xa2\x97ȧ\xceJA\xa4\x04\b\xab\x8c\xfck\xb1\x89\xa2L\xe2\xd2\xca[\x9d&ڌ\xfc\x97\x17\xe6\xe3//L\xb6\x7f\xd9`\xfc\xe4=\x91\xf9\\S\x9b[c\x01\xa5\xde\x180\xc5_\x05ɏ\x02m?N\xacw\xae\x86\x86\xa9\xa6\xcb4\xee\xf5-N:\xae,\xf9\xe9v\xa3gi\xeam\x1eS3`\x1a\xf66\xeaRP\x0f\xf3ЭS\xba\xbf\xca\xe1.\x96\xebT\xb1\x81\xd45j\xd4w\x11%\x11\xc0\xa5\x1a\xb6\xdc.}\xd8i]q\x8f\xf4\"\x89$ܼʧ\x84\x7f.\"9O\xaeq4\xe6V $\x99\x06\xc5\x0fB\b\x1c\x81\xecIj\xbc#\x04\x1a\x19\xa0\x89\x92\x99\xadlb\x99\x8br\x8dU\xf5\xb2\xd0`\xeb\x95ue\xfape݄2\x92\x95\xa1\xe0G\xeb\xea'{\xf6U\x9d\x15\xab\xb9\xfa\x96\x1d\xbf\x86\xb13p\xe6\x8a\"\\O\xd9B\xa2\x1eL㒤\xd8\xf7\xb2\xfb\xa4\x8a\x15M3\x98\xd9\xfa\x91\xe2p|\xb4\x1aj\xcaӏ/\xe7\x14x\x1f\x97\x911\x96v\x04m<\xe6wcF\xf7i1\x96\xa7\xaa\xafDX\xf6P\x99h)Ʋ>=\xb6b\xace\x93\x94i'\xe62U\x15\xb1\x97\xad\xc1-e\x8f\x86\x8bY\xddA\xf9\xea\x18\xf2\xcbFa~L\n{\x13\x17F<f\xbc\x91\x15\x8d\x0f\x1eYxdᑅG\x16\x1eYxdᑅG\x16\x1eYxdᑅG\x16\x1eYxd\x11\x99\xa9\x98X\xae\xe5\xdcy\x8cf\x91=\x7fn\xb4f\xe7ܤ\u07b8\xcd\x131G\x93n\r\xf9Mxn_F\x9bqu8TU\xdd\xf1\x9d\xc5uu\x15\xe9y\xf2\xb2;+\xe6s\xb0\x8b\xf0X\xf4'\xd4\x11\xea\bu\x84:B\x1d\xa1\x8ePG\xa8#\xd4\x11\xea\bu\x84:B\x1d\xa1\x8eP\xc7Aǁ\x9e\x0e \x89\bm\xdci\xaeDl\xe8`\x99S^\x94he\xceD\xbch\xff\xe2E\x9b\xa1\xbf\x15\"GO\xdf\xf2N\x8f!\x1d\xf02̍&\xe5\x02\xa6\xbb\v\x98\x8e\xa2N\xeb\xba\x13\xb1\xc7q\x0f҇\xb5@L*1\xa9Ĥ\x12\x93JL*1\xa9MŤ\x9e\x01\x00\xcaD\xa7\x9e\xa2\v\x10\xa7\xdakU\xa3\x8d\x88Ն\xb4\xe1*\xb1\xab\xad\x91\xb1t\x14kQ\xa5ĳ\xf6\x03\xcd\xdd\xcaA\xb4p\x8e\xbcٮ\xbe\xb2\xff\x02\xf2\xf8I\xdd'=6\r\xec\x12\"\x90\x9e\x1aU\xe5&\xa1\xceM]O\xeb\x04)Պd\x97\x88\xfa|\xe6[\xef\xa3\x13\x91\xd3)R4b-\xa0\xa4\x02\xb9\xf9[\x9b\"Z\x1b8jd\x11\x9c͋\xce\\\x04\xa7\xbe\x1a_~=\xa0<\xf6d\xb5u\xa4A6\xb3,\xceW\x1c\xcf]\x18\xf5\xe9\x8cQ%\xe8\x88}\xd1\x11\x8d]\x7fXI\xd4߹H-ь\x0e7\x8eg+\x8d\xe3\x13\x993\xb4\xc6Hj\xb8\x81\xe4\x06\xf2d\xb5\xb2\u008aA\xaf\xec\xcbz\x04M\x82&Q;\x8d\xdai\xae\xf2\xfe\xb1\xf56v\xf9\x14{\xa6\x90\xffT\x85\x90Z/\xb5\xcdeǅ\xe0V\x82[\tn%\xb8\x95\xe0V\x82[\tn%\xb8\x95\xe0V\x82[\tn%\xb8\x95\xe0V\x82[I\xa9g\xc8X\xcab\xeeA*\xbd\xac\xfd~~\n\xbd\x8e\xb8Hݩ\xf3\x0eb\x8cf\xefK\xd2U?\xb7'{Mݏ\xecWQ\x7fj\xbc\xdd:\xbaK\x89wd\xf9\x9c\x99\no`\x8b\xe9x\n<\xe8 t\x10:\b\x1d\x84\x0eB\a\xa1\x83\xd0A\xe8 t\x10:\b\x1d\x84\x0eB\a\xa1\x83\x83L}w\f\x18\x92\xf2\xae1\xe7\xb5R\xa9\xee\x06Ɣ\xf2C\"Jr$R\xdb\xf51\xb5]\x9d\xf4\xb6R\x80ɱ\xad\xec\x9cTv\x83[f\x05a$\\\x844u\x11\xd2Y\x80H\xb5;\n{\xd8\xf7\x12]\xca6\xa9\xe8HEG*:Rё\x8a\x8eTtͥ\xa2;j\x90\x97KAW|\xe6\x13\xe5\xd9K\x95\xa2\x9d\xb8\xceZ\xb5\xdaj\xf1\x9c\r\x93\xa8l\x1cg\xb62\xe27\xbbE`\x15Ӆ\x8c\x8d\x89\xb5\x91\x1ed\x14\xd4fD\"q\x9c\xdft\x93\xfe\x03\x8a\xd3\tũ\x9e\xde\x03\x85\xefL\x85\xef\x92\xd2y\xa0\xf6\xa1\xf6\xb5\xaa\xf6\x05\xceL~Y6_\xc7'\xfdk\x12u\xc4\x1bW< \xf8\xe0ハ\x0f>>\xf8\xf8\xe0ハ\x0f>>\xf8\xf8\xe0ハ\x0f>>\xf8\xf8\xe0\xe3\x83O\x86\x0e}\xad\xafM\xe5\xceSs\xc4\x16\xfb\xb999\xdag\x1f\xf5&\xe3(B\x15M^[\x84u>\xb7 X\xcd\\Tdʮ;\xe1F\xaa\xf0\xae2m\x14\xaf\x86\xb3Rl\fgm\x1c˭\x01\xd0\x03\xe8\x01\xf4\x00z\x00=\x80\x1e@\x0f\xa0\a\xd0\x03\xe8\x01\xf4\x00z\x00=\x80\x1e@oXI5\x0e\xa0>\xb2i\xd4\xefSV\"\x8d\xc6p\xb8Q^\xac\xc0\xf9\xac\x88\xc4\x19\xfdK\x9cQ\x13\x85\xad\x10{q`\x9f:=UƐ\x96Rn\x8c\x05\xf7\x13\x1d^!lۿA\xb0\azkЉĒ\xf2\x82\x94\x17\xa4\xbc \xe5\x05)/Hy\xd1TʋC\xe6r\x99\\\x17\xb9\xc7;1\x8f\xfd\xd2\x1e\xda\bs\xacKI\xad\x12\xdf\xd8\x1c\vJ\a6Ƶ\x10\xd1\xd8\"}\xf2\xbf\xb93Ǟ\xcd\xd4\x15g\xf9\xc8F]\xcakS\n\x11\x8e\xa9mjg`p\x8c\xc21\n\xc7(\x1c\xa3p\x8c\xc21\n\xc7(\x1c\xa3p\x8c\xc21\n\xc7(\x1c\xa3p\x8c\xc21\x8aHGû\xd2&s\x0f\"\x1ew,\xf8\xf3#\x1f\xbbb#uG@\x1eF\x19\xcdz\x1ad\xea~nQ\x00\x9b\xf2<ȩ\xa3\xfe\bɽJ\xba\x8b\x94<\xb6\x8aΌ\x98\x1cښ:\x1e9\t(\x04\x14\x02\n\x01\x85\x80B@!\xa0\x10P\b(\x04\x14\x02\n\x01\x85\x80B@!\xa0p\x98\x11\x94G\xd1!\x91\x94\xcd\xf9\xb2\x95\x8a\xa8\x1c\x1aWʏ\xac,˒\x88\xb0\xecc\x84e\xad\x14\xb7R\xa4\xe5\xd1\xfd윈\xcb\xe1-\xb5\x82\xc8K\xeeE\x9a\xbc\x17\xe9,2\xb3⍅=\xf0[\x8aN%\x9c\x88M\"6\x89\xd8$b\x93\x88M\"6\x9b\x8b\xd8<n\x9e\x97\x8b\xdc<p\xec\x13\xc1\xd9O\xad\xa2\x9dH\xcez\x95\xdbj\x11\x9dM\xb3\xa9ld\xe7NmDx\xb6M\xc5*\x86v\x12ӹ\xbbqᣅ\x8f\x16>Z\xf8hᣅ\x8f\x16>Z\xf8hᣅ\x8f\x16>Z\xf8hᣅ\x8f\x16\xc1\x9cY\xe4\u0557(\xce\xf2ᛃ\x8f\xdb\xec\xc41\xa1\x1d\xa0ڨ+B\xb3\xb1\x99}\b\xca\xc41\xb1-\x04[\xde#qஈ\xf8 \x8e\xc2\a\xb1\a·\xf5x\x1d\x0e\xdfݐ㼫\x03wۄ\b\x9f\xeaK8x'B\xbc\a\xf1\x1e\xc4{\x10\xefA\xbc\a\xf1\x1e\x1c\x83\xf7`\xedn\x83\xf8\v\x1e\xf6\x17\xbc G\xc1^x\b\xb6\xe4\x1a\x88O`\x87@\xeaV\xfa\xfa\xdbS!\x96\x92\xfdh\xa5\x06\xef\x8f\xef~\x8d/U\xd5\xc1\xa4\x7f\x95J\xc7v\xdc8\t\xffT\x8en\x9bV\x98m\xe1\x8f\xcefog\xb8ӝ(\xb1,\xb3SSuх\xedl\x8bsD\x93s\xf7\x97\xdeL\xce\xdd\xf6\x12''\xff\x1a)\x9a\x9d\x0f\x9f\xfa3=\xd2\xd2˛\x9f\"\xc2\x12\xcd\xd0\xdbw\xbf\xbc\xfb\xf5]_\xe6\xc8`\x8a˛%/\x043\x85\xd3\xf4\xe1\xeeן?\xfc\xf9S_\xe6)\x04I\x977Q\x8f\x8e=/\x9e\xa5?\xbd{\xfd\xb6/S\xf4'i\xe9\x05\x1eG\xf96h|\x1e\xbd\xfe\xf5͟zs \xa9\xb6^\xe2\x14aE\xd5\xdb<\x11$\xe3\t\xab܊U\x10\x83\x8c\xce_>\xfe\x12^\xab\x86N\xa3Vh\xfcX\xcej\xbe\xf6Ģ\nT\xdb\xef\xef\xddߜ\xc08W\xa5\x02Yda(\x90\x1c1\xf3\xd0\xd5.\\,\x91\x7f\xaar-6eN\xac\xf7\xf2M\xe77{\xb9^\x84$\xf8\xfb\xa3\xb7\x88\xbd\xacUkT\x03\xd5Byu{\xbb\xf0f\xf6\xe2QԱ\x1csM\xc9\xdaM\xf0\x14H\xef\x13s\xcdQA\x18\xee,pl\x7f\xf6x\xb3\xf0\x1e\x1ed\x18n\xffn\xfe\xff\xff\xe7\x1f\xff\xae\xee\x8d_}u\x97\xb3ǧ\x89\x15\x8dF\xfe\xc7)#Ҍ\x7f\x1d&\xe4\xed?Uaϣ\xb0$\xff\xean\x1e\xef\"\xd1Ģ\xec\xa7Ey\x11\x934\x02\xcb\xf2\x12\xe6i<\x16\xe6%\xcc֨,\xcdK\x98\xb01X\x9c\x17q\\\x8d\xc3\U000bc329\xc2\x02\xad\xb7y\xaa\xa4(\xce'r ɷ\xb4\xb0z\xc7`\xf5\x06\xc6\x15\xe5\xa0C\xbf\xf9N\xb4N\xc6\xe6\xda\x1f:\xe3\xe0|\x9e\xe7|>\x8a\xa9?\xe4\x86\x1e\xcf>\xce\xe8\xe3qF?\xd5\x7f\xed<\xe9Ɠ\xad\xbb\xb5\x83?\x1bz\xf0(\xfd\xd9Ί\xa5\xfcs\xaa\xbfCT\xb9\x88\xa2\x1cv\x14e2\x91\x1d\xaa\xb2ii\xaa\x12I9\xb0Ŕ\xa7Ķ\xab\xb6\xa6g\xbf=)\xab]u\xadO\x88+(\xaf\a\x85\xf8h,\xe5\xa0D77\x9a\x92(J\xa2(\x89\xa2$\x8a\x92(J\xa2(\a\x1dEyX\x1b-\x15GYt\xbaßz\xa2<\xb4A\x9cj\xd4R\xab0\xa7#\xe2]\x1fuJW\xd4(wj\r\xec\xdcF\x87\x89\x1aكvi\xf4\xc5}\x10=\x02\x1b\xf5}4\nتc\xb4U\xd9\x03\x0e\xee\x01U\xaeׇN{\x9b\xbfX\x1f8\x8f\x1c\xc9\xc4\xe7\xed\xfa]\\\xab\xb3緶\xe7W\xbb^\xc7\xc09b\xe0\\\xce\xc5:f\xce\xd0T\x1co^\xfa%\xb6?\xcboy\x86\xcd\f\x02/\xaf\xf1\xf2\x1a/\xaf\xf1\xf2\x1a/\xaf\xf1\xf2\x1a/\xaf\xf1\xf2\x1a/\xaf\xf1\xf2\x1a/\xaf\xf1\xf2\x1a/\xaf\xf1\xf2\x1a/\xafi4$\x86r\xe7Ϯ\x85\xd6\xfa\xb9o\xae\xb5M:jyp-\x17L4\n\xecU\x8dύ\vQC\x98>)\xb9\xee\xa7\xd5⢻zW\xadH\xea\x8f8&\xa7\xfc\xfcd\xe3\x19\xca\x1aH\xfb'\xbf\x89{\x00\xa6\x03Ӂ\xe9\xc0t`:0\x1d\x98\x0eL\a\xa6\x03Ӂ\xe9\xc0t`:0\x1d\x98\xae\xbd\xb8\xac\x84)Ԏ\xf0*\x9a\xf6\x8dzX\x95I\x0e2\x10ޒ\xf2\x14?\x93\xb1\x90\x12\xa4\x87)A\xea`\x95U\xbc\xef\x8bV\xf7\x19\x89@\x06\xb3pҞ\xf6\xd0\xfa\xf6\x91\xfa\xb6m\xa2n\x0f\x92\xa2\xb7&\x9b$\xf8 \xc1\a\t>H\xf0A\x82\x0f\x12|4\x96\xe0\xa3\xd0|,\x95\xdbc\xff8\xbf\xf8\xa8\xb7\x1eh\v\xad\x84\xbaբ\x82V\x8ark\x88\x84d\x02\xdcL\x1dÍm\xab\xe9E\xf1\xd3\xf4\xf6Z\x1f\x81\x93\x1a\xc7\xf1\xe4\xe4Y6\xfd\xd1\xc7\xdeZ\x9e\t\xf5\xd2\xdb\xf8g\xa2\xe4\x8bnmυz\xcem\xf4\x93Q\xe5ٶ\x96'$|\xb3m\xf4SR\xedm\xb6\x96'%z\x98m\xf4\xb3R\xf6\x01\xb6\x96\xe7C\xbf\xbe6\xfe#\xa4\xf4+km\x1f\"\xfa\x89\xb5\v\x98\x8fa\x19\x01\xe9G\xc3\xd4\xf3\\\xd1\xc3e'\xbd\xfb\xa54\xfd3\x9e\xd2ڳ\v*?\x13ݡy0\x9aw\x01Gc&\x8c\x7fF\x06h.\x8c~R\x86k6\x8c~j\x06m>\x8c~v\x86hF\x8c\xff\x88\x19\xa69q\x01\xf32,\xb3\xa2\xeb\xf7\x8f\xdb4e*%'\x1e\xa4\xb7i\v)\x89\x87\xe8\x0f9\xfcI\xde\xf1\x8c\xec$\xfd0\xfe\x91\xdbMW^\x16gH0\xfe\x16\xf3KJ0\x8c\xd7EϽ.\xd6j`t\\\xe67o!\x8bt\xb6\xb0\xdde\xd9\x14\xc3wqa\x9fuaoTa\x17\x9fs8wT\xde{\xfe\xeb\xc5\"\x8etiT+\xcam@\xe7ɝ\x8a\x84\xa5\xf6\xa5J\x16\x18\xb2\xc0\x90\x05\x86,0d\x81!\v\fY`\xc8\x02C\x16\x18\xb2\xc0\\r\x16\x98:MI\xb2А\x85\x86,4\x83\xc8Bs\x00\xfa\xd4\xc5{@=\xfb\x03\x02\xbd\x80^@/\xa0\x17\xd0\v\xe8\x05\xf4\x02z\x01\xbd\x80^@/\xa0\a\xd0\x03\xe8\x01OM\xe5\xb9'\xf4\xce3\xa1\xc4\x13T]\x92\x91:\x9e\xa3:\x0e2\x9at\xe6ܫ\xfd\xb9U\x01l\xc6ɳ\xa0\x96\xba\x9f\xafʭ\xa6\xab\xa7\xacNYIg=k5\xc4u\x95\xff\xc4\x15\xa8\x10T\b*\x04\x15\x82\nA\x85\xa0BP!\xa8\x10T\b*\x04\x15\x82\nA\x85\xa0¡>wu\x12:\xec\xe9\xd3W{^a%\x9e\xc1\x1a\"\x9fI\x92\x14Ta2<\x8fտ\xe7\xb1jg\xa1\x15RC\x9c\xb43\x9c\xfel\xd60\x17Z*Q\x047\f\xed\xdc0t\x94R\xa2\x06\xf2o\x0f\x9e\xf6w\"\xe3<\xcbų\\<\xcbų\\<\xcbų\\M=\xcbu\x9a\x99[扮#G\xfe\xa5\xa7\x8f\xea\x9bF\xd1F\x12\xa9\xfa\xd5\xda*\t\xa5\xda <\xe9\xe4R9\xf5\x8d\"\xd1T-y0ǅ\x9b\x9aϏ9* 2.\xa18\x80F\xbaȧ\t \xe9\x01 \xa9\x9a\x7f\x13e\xea,e\xear\xf2r\xa2R\x8dB\xa5\xf2\xe6\xa537xs\x925x\xf3V\xb3pz\xf3\xee#[\xf4\xb4\x93a\x13\xc7s\x1c\xcfq<\xc7\xf1\x1c\xc7s\x1c\xcfq<\xc7\xf1\x1c\xc7s\x1c\xcfɰ\x89\xe3;\x8e\xef8\xbeדaӛK\x17\xd7j\xb3\xa9\x80h~\r\x8b\x00\xd5$c\xd12\xb2\x89\xaa\xed\x03\xbaI\x89\x03\b\a\x84\x03\xc2\x01\xe1\x80p@8 \x1c\x10\x0e\b\a\x84\x03\xc2\x01\xe1\x80p@8 \x9cZ\x10\x8er\xc9\f\xcbS\x1b\xb9\xaf\xa2R\xfc\xb20\xe7cR؛\xb8\xb0\x8b\xc7:\xb9\xa3\xd2&\xe0\xc9m@稧HX\x80>@\x1f\xa0\x0f\xd0\a\xe8\x03\xf4\x01\xfa\x00}\x80>@\x1f\xa0\x0f\xd0\a\xe8\x03\xf4\x01\xfa\xd4\x04}\xcc/E 7vy\xd8c\n\xf9OU\b\x90'=\x1a\xed\u009dT\xc5=\x80:Y\xa1\x00\xe6\x00s\x809\xc0\x1c`\x0e0\a\x98\x03\xcc\x01\xe6\x00s\x809\xc0\x1c`\x0e0\a\x98S\v\xcc\t\x1c9\"6e)\xce'\xfd\xeb\x8b\xc77f\x18\xda\xe46\xa6\xc6\u0381M<\xff\x90\x1aH\r\xa4\x06R\x03\xa9\x81\xd4@j 5\x90\x1aH\r\xa4\x06R\x03\xa9\x81\xd4@jj\"5\xfe7w\xe6س\x99\xd2E\xca\x13\x1b]\xcakS\n\xe4&3\x1c\xed\x12\x9ct\xcd= 9;r\x01с\xe8@t :\x10\x1d\x88\x0eD\a\xa2\x03с\xe8@t :\x10\x1d\x88\x0eD\xa7N\xa2S\x11\xe5\xc0p\xcc8t\x00o\xfaBm\xc05\xe0\x1ap\r\xb8\x06\\\x03\xae\x01׀k\xc05\xe0\x1ap\r\xb8\x06\\\x03\xae\x01\xd7ԅk\xf4\xe7\xb7\xc6\xc6Z\xca6\\\x88m\xc2SO\f\xa6o\xee|+\xc7S\xaa\x93v\xac\x8c\xbe\xd1\x05\xfd\x87\xbd\xbeP\x80\xa3\x7fm\bN<\x14\n\xa8\xb4\xc0q\x96\xb2\a\x88\xca&{\xd7\xfa\xeb\x83\xfaC0Q\xaa\xaf\";Z2\xdf)\x1d\xb22\xd8\xd1\x1d\xacBw\xd2\x12\x02߁\xef\xc0w\xe0;\xf0\x1d\xf8\x0e|\a\xbe\x03߁\xef\xc0w\xe0;\xf0\x1d\xf8\x0e|\xa7F\xbe\xe3\xac\xe6k\xcf=\x14`u\x1a\xdey\x17\x97s\xf1x'\x1e\n\xf0N\x1a\xef\xa4%\x04\xbc\x03\xde\x01\xef\x80w\xc0;\xe0\x1d\xf0\x0ex\a\xbc\x03\xde\x01\xef\x80w\xc0;\xe0\x1d\xf0N\x9dxG\xe9\x17\x95َ\x06\bp\x1d5\f0\x9d\f\xd3\t%\x03\x9e\x03ρ\xe7\xc0s\xe09\xf0\x1cx\x0e<\a\x9e\x03ρ\xe7\xc0s\xe09\xf0\x1cxN\x8d<G\x0f\xb0\xaf\x1b]\x11\xea\xfc\xa2J\xfa\xa8>\x80\xec$c\x01\xdeI㝌\x8c\xc0x`<0\x1e\x18\x0f\x8c\a\xc6\x03\xe3\x81\xf1\xc0x`<0\x1e\x18\x0f\x8c\a\xc6\x03㩑\xf1\xac\x12\xf2P\r\xf1\xc4\b\x03\xc2\x13\x0f\xc5/\x06\x87\\(\xd0IK\x04<\a\x9e\x03ρ\xe7\xc0s\xe09\xf0\x1cx\x0e<\a\x9e\x03ρ\xe7\xc0s\xe09\xf0\x9cFx\xce\xed?\xe3\x7f?\x93Y\xb9I\xcc3\xcf\xe4X&\xa729\x95\x01>\x00\x1f\x80\x0f\xc0\a\xe0\x03\xf0\x01\xf8\x00|\x00>\x00\x9f\x9a\x81O\xd8LU\xaa\x1e{\xa5\xe2[\xc1L\xac\x95k\x99♶\xfc\xb5J)6\x8f1R\xc5\x10\xd2Ò\xb4e\x95\xba\xa1\xd5\xedYۛGm\xbf\xfcc\xabT-\xd3\x12x\x14<\n\x1e\x05\x8fj\x86G\x99??\x1f\xc1Ri\x16\xb5\x8a\xb6\xe8\xe8\xb1v\x98\xd4!&5l\x1e\x05\x8b\x82E\xc1\xa2`Q\xb0(X\x14,\n\x16\x05\x8b\x82E\xc1\xa2zƢ4\x84\n\x9b\x9cV\xbcS\x98\xa9~\xc2\x04\x00\x03\x80\x01\xc0\x00`\xc3\x04`<\x85\xd6 \xfb\xca<\x8a\xc6#h<\x82\x06\x03\x83\x81\xc1\xc0``00\x18\x18\f\f\x06\x06\x03\x83\x81\xe1\x8f\x05\x8e\x02G\x81\xa3\xc0Qi\x1cU\x83;\x16H\xea\x10\x92\xbaLw,P\x14(\n\x14\x05\x8a\x02E\x81\xa2@Q\xa0(P\x14(\n\x14Ղ;VZ\xf1\xc6\x1d\v\xfe\x05\xff\x82\x7f\xc1\xbf\xf6\xf9\x97R\xc5*\xfbb\x85\x0f\xd4\x03\xbd\"\xe8\xa5\x06\xe4\xc2}\xb0B\x99\x00z\x01\xbd\x80^@/\xa0\x17\xd0\v\xe8\x05\xf4\x02z\x01\xbd\x80^\xf8_\xc1\x9f\xe0O\xf0'\xf8\x93\xe6Ou8_\xc1\xa0r\x18ԅ:]\xc1\x9e`O\xb0'\xd8\x13\xec\t\xf6\x04{\x82=\xc1\x9e`O\xb0\xa7\x86\x1d\xaeB\xa5\x1bg+`\x17\xb0\v\xd8\x05\xecڃ]z\xdc}ݗ\x8a\x1eW\xbf\xa8\x92>\xaa\x0f@^\t\xf2JF\xe5\xb2}\xaf2\xd2\x01\x04\x03\x82\x01\xc1\x80`@0 \x18\x10\f\b\x06\x04\x03\x82\x01\xc1p\xc0\x82I\xc1\xa4`R0\xa9\x84I\xd5\xe0\x85\x05\x97:̥.\xd3\x1f\v\x1e\x05\x8f\x82G\xc1\xa3\xe0Q\xf0(x\x14<\n\x1e\x05\x8f\x82G\xb5ᔕѼ\xf1̂\x82A\xc1\xa0`P\xb0=\n\xb6V\xc5\xe9\x83뛷\x10\x95b\xb6\xb0\xddeU'\xad\xbb\xb8\xd0Ϻ\xd07\xaaP\xb8X\xc2\xc5r\a\xe8\xb2]\xb7\x8ad\x06j\x065\x83\x9aA͠fP3\xa8\x19\xd4\fj\x065\x83\x9a\xe1\xc5\x05\xbf\x82_\xc1\xaf\xe0W\xb9\xfc\xaa\x06\x87.\x18V\x19\x86u\x99n^\xb0+\xd8\x15\xec\nv\x05\xbb\x82]\xc1\xae`W\xb0+\xd8\x15\xec\xaae\x8f\xaf\"%\x1c\xe7/\xe0\x19\xf0\fx\x06<ۇg\u07bc\xb2\xab\x977\a\x8a\xa5\xa0\x987\xbfp7.-\x0f\x80/\xc0\x17\xe0\v\xf0\x05\xf8\x02|\x01\xbe\x00_\x80/\xc0\x17\xe0\v\xa7-\xb8\x13\xdc\t\xee\x04w\xf2浸h\xc1\x9ev\xd8Ӆ\xba_\xc1\x9c`N0'\x98\x13\xcc\t\xe6\x04s\x829\xc1\x9c`N0\xa7F\x9d\xad\xb4ʍk\x15\x88\v\xc4\x05\xe2\x02q\xe5!.\xe9\xffZ\xed\xcb5\xb8X\xfd\x1a\x16\x05\xee\xca\xe0\xaehX.\xde\xe5*%\x1f`00\x18\x18\f\f\x06\x06\x03\x83\x81\xc1\xc0``00\x18\x18\f\xd7+\xb8\x14\\\n.\x05\x97Jq\xa9z\\\xb0`S\a\xd9\xd4źd\xc1\xa4`R0)\x98\x14L\n&\x05\x93\x82I\xc1\xa4`R0\xa9V\\\xb3R\xaa7.Z\xa00P\x18(\f\x14\xb6\x87\xc2|'FD\xea\xec\xf3=u\xf2Tu\xd6\xfa\x98\x14\xfa&.\x144\x96\xa0\xb1\xdc\x01\xbal\a\xae\"\x99\x01\x9b\x81\xcd\xc0f`3\xb0\x19\xd8\fl\x066\x03\x9b\x81\xcd\xc0f\xb8r\xc1\xaf\xe0W\xf0+\xf8U.\xbf\xaa\xc1\xa9\v\x86U\x86a]\xa6\xa3\x17\xec\nv\x05\xbb\x82]\xc1\xae`W\xb0+\xd8\x15\xec\nv\x05\xbbj\xd9\xe5\xabH\t\xc7\xf9\vx\x06<\x03\x9e\x01\xcfr\xe0\x99)V\xa4ucWw\xfa2\x85\xfd\xa7*\fP\x96\x06e\xa9\x81\xb9t'\xaf\xac\x8c\x00\xc8\x00d\x002\x00\x19\x80\f@\x06 \x03\x90\x01\xc8\x00d\x002\x9c\xbb\xe0S\xf0)\xf8\x14|*çjq\xea\x82Q\x1dgT\x97\xea\xc4\x05\x9b\x82M\xc1\xa6`S\xb0)\xd8\x14l\n6\x05\x9b\x82M\xc1\xa6Zr\xde\xca*\xdf8m\x01ŀb@1\xa0\xd8\x1e\x14\v\x1c9W7U\xbd\xb5>\xe9R@`\t\x023#r\xd9\xfeY\xb1T\x00\xbf\x80_\xc0/\xe0\x17\xf0\v\xf8\x05\xfc\x02~\x01\xbf\x80_\xc0/\x1c\xb3`P0(\x18\x14\f\xca0\xa8\x1a<\xb2\xe0P\xf9\x1c\xea2}\xb0\xe0O\xf0'\xf8\x13\xfc\t\xfe\x04\x7f\x82?\xc1\x9f\xe0O\xf0'\xf8S\xd3\xceW\xb1֍\xd7\x15\xc4\v\xe2\x05\xf1\x82x\xe5\x10/\xff\x9b;s\xec\xd9Linս\xafti\xafMiЯ4\xfdJ\x8f̥{c\xedH\tT\f*\x06\x15\x83\x8aAŠbP1\xa8\x18T\f*\x06\x15\xc3+\vF\x05\xa3\x82Q\xc1\xa8\xb2\x8c\xaa\x16\xef,8\xd5)\x9c\xeaR\xbd\xb5\xe0S\xf0)\xf8\x14|\n>\x05\x9f\x82O\xc1\xa7\xe0S\xf0)\xf8T[^[;\xda7\xde[\x901\xc8\x18d\f2VD\xc6jrۂ\x83\xedq0\x1c\xb5\x8c\\@\xc0 `\x100\b\x18\x04\f\x02\x06\x01\x83\x80A\xc0 `\x100<\xb4\xe0Pp(8\x14\x1c*\xe4P\xf5\xb9f\xc1\xa2\xf6X\xd4E;c\xc1\xa0`P0(\x18\x14\f\n\x06\x05\x83\x82A\xc1\xa0`P0\xa8ƽ\xb0p\xbf\x02{\x81\xbd\xc0^`\xaf\f\xf6\xaaD\xb8\xfe\x9cگ`\\\xf1P\\ \xdbJw\x1f\xba\x05݂nA\xb7\xa0[\xd0-\xe8\x16t\v\xba\x05݂n5D\xb7Ҋw\xa3|\v\x80\x04@\x02 \x01\x90V\u07bcr\x94ޟ\xa5\f\x90\x91\x1a\x85ˎ\xca\v\xe5\x00`\x040\x02\x18\x01\x8c\x00F\x00#\x80\x11\xc0\b`\x040\x02\x18\xd5\b\x8c`7\xb0\x1b\xd8\r\xecF\xb3\x9b:\xfc~\xe07!\xbf\xb9Po\x1f\xb8\r\xdc\x06n\x03\xb7\x81\xdb\xc0m\xe06p\x1b\xb8\r\xdc\x06nӬ\xa3\x8fѹ\xf1\xf1\x81\x13\xc1\x89\xe0D\x8dr\xa2\xb5\xfa\x9d\xde\xfc\xbfy\v9\x96g\v\xdb]Vu\xfa\xb9\x8b\v\xfd\xac\v}\xa3\n\x85\"\xe5\x0e\x8br\vz\xef\xf9\xaf\x17\x8bؿ3\xb8\\7\xa1\"Ɂ?\xc1\x9f\xe0O\xf0'\xf8\x13\xfc\t\xfe\x04\x7f\x82?\xc1\x9f\xe0O\xf8\r\xc1\x83\xe0A\xf0\xa0\x06yP\xdd(\b\n\xb4;\"\x97\x1d\x17\x96#\x1f\xb0\x1eX\x0f\xac\a\xd6\x03\xeb\x81\xf5\xc0z`=\xb0\x1eX\x0f\xac\a\xd6\x03\xeb\x81\xf5\xc0z\x9ad=5ċ\xc1{\x8e\xf1\x9eˌ#\x83\xf3\xc0y\xe0<p\x1e8\x0f\x9c\a\xce\x03\xe7\x81\xf3\xc0y\xe0<\xedŔ\xe5\xe8\xdfė\xc1\x98`L0\xa6f\x19\x937\xaf\xecB\xe4͡H2\bD\x8ae\x80\x92\x96\n\x18\x12\f\t\x86\x04C\x82!\xc1\x90`H0$\x18\x12\f\t\x86\x84\xaf\x10\x1c\a\x8e\x03ǩ\x97\xe3HG\xd7j˩\x81\xe7\xfc\x1a\x16\x05\xd7I\r\x06|g\x87襤\x04\xce\x03\xe7\x81\xf3\xc0y\xe0<p\x1e8\x0f\x9c\a\xce\x03\xe7\x81\xf3\xc0y\xe0<p\x1e8O\x8d\x9c\xc7wb\x06\xa2\xb6s\xdfS\x9biU\xe2\xf31)\xf4M\\(\xec'wX\xa0@i\nT$9\xf0 x\x10<\b\x1e\x04\x0f\x82\a\xc1\x83\xe0A\xf0 x\x10<\b\x1e\x04\x0f\x82\a\xc1\x83j\xe5A\xe6\xf7\"\x96\x1b\xbb:\a2\x85\xfd\xa7*\f\xfe\x93\x19\x0e\xb8O\x96\xfbd%\x05\xde\x03\xef\x81\xf7\xc0{\xe0=\xf0\x1ex\x0f\xbc\a\xde\x03\xef\x81\xf7\xc0{\xe0=\xf0\x1exO\x8d\xbc'p\xe4\xa0\xd8T\x05=\x9ft)\x10\x1e3\x0e\xa0\x9d4ډe\x03\xa6\x03Ӂ\xe9\xc0t`:0\x1d\x98\x0eL\a\xa6\x03Ӂ\xe9\xc0t`:0\x1d\x98N\xadL\xc7\xff\xe6\xce\x1c{6S\x1aIu\xb6\xa3K{mJ\x83\xf1d\xc7\x03֓e=;\xb2\x02\xf3\x81\xf9\xc0|`>0\x1f\x98\x0f\xcc\a\xe6\x03\xf3\x81\xf9\xc0|`>0\x1f\x98\x0f̧~\xe6S\x13\xec\x81\xf2\x84\x03\x01\xde\xc9\xc1;p\x1d\xb8\x0e\\\a\xae\x03ׁ\xeb\xc0u\xe0:p\x1d\xb8\x0e\\\a\xae\x03ׁ\xeb\xc0u\xea\xe0:\xc1m!Ǒ?\xa6\xce\xe1\xd7w?G++\u06016\xf5\x11\x9a\xc6\xd0\xcf\x11N\xa3\x06b\x1f\xd2\xc8\x00H\xaf?'\x9dn\x11\xc6H\xc5\x7fTTDa\xa1\x93q\xccs<\xa9\xf6\\\xd9\x7f\xf2%Y\x15R\x82键\xd6\xf5\x0eϸ\xbb\x12\xe9]\xc6\xeb˶\f\x9c\x19\u074c\xe7\rP\xbe\b\xe4}3\x9a\x9en\x84\xa2n\x81\xf8\xf6\xd2^\xac\x1f헧\xee\x05\xf1\x16v\x19b\xf1\xf7h|N\x97\x8f\xcf\xe1/d\xc6>\xa6\x06\xab]Y\x89jnb\x0f\x89E&a\x1d~\x86\xd2\x15\xdf\x0f\x18h\xe1\x87*^\xa4b\xcb^\xa3د\xf5sRޛty\xe3\xbe(8W\xee\xd4\x18\x1e\x14\xbc\x03\xc3\b@\a\xa0\x03\xd0\x01\xe8\x00t\x00:\x00\x1d\x80\x0e@\a\xa0\x03\xd0\x01\xd8\x00l\x00\xf6\x80\x00v\xfd4e\x92O<\"+|RdS\x9f\xc5W\x8a\\\xe6\xce\xf7\x96;\xc4g\x12O\xba\x03  \xedZ\x17\x82\x06\xed`\xe7\x059\xd4\xc6\x1c\x822\x8d\xbd 45\xe3\x14ӹ\x9a\x80J(\xb4So\x1e_\x8f\x86\xffVg\xbb2\x9c\xa3\xb5ظd\xca|\xf6h\x9dh\xe9\xfa!o\x8d\xbc1\x1aV\x8b\x8d\xf8a\xbf\x11\xafg\x8a\xfe\xb4؊*ۅ^\xa4\xbd\xd8.\x8c\x1e\xbc\xdf\x11\xf3wQQ\x17\xca$\x0eO\xbb\x11\xee\x1d\xa6\x9f\a\xf7\x8e7\xf1\x18\x80e\xc1\xb2`Y\xb0,X\x16,\v\x96\x05˂e\xc1\xb2`Y\xb0,X\x16,\v\x96=\xdb\xc9\xed\xd3\xc6\xdel\x83\xca0Ŝ\x19\t\xa9\xe8\t\x87\xad\b\x04\xea\f\xec\xf8[]^\x81\xb7\xffT\xd5<\x17:\a\xea]+k\x89\x8f\x90\x19\xa9^6\xee\xbeW\xb4\x97|2z\xb3ֽ\x7f\xd3:\xecT\xfdKV\xc3Ĳީ\xffF\x7f_\xda\"\x1c\xf2?9$\x17[\xd1g\xfc\x9bpVf\xa1u+\xbb\xe7WǺ\x8a3\x16\\\xa5\xe4H\x97X\xc7\xde\x17\xb77&QӨ\xe5\"\xb8\xaaɺ\xb1j\x15\xac\x83\xa8a\xa1\x86%v\x81\xafU#\xad\xe9\x9a\x1d-\xd3F\xf5\xd3\xfe\xde,U\xde\xd9\x1e\x9c\xbe\\*ms\x17\xfbzaϜKXﺣ\\0]\xca\x05S\xa5\xab\x9d\xed0nv\xc6y\x15|\xc2uN\xa7+\xf5\x806\xfcV7\xfd\xc3ڄ_<\x97\xb4\x9a҆\x90\x98\x7f\x811b\xe4̽\xf7\f[\x88\x00@r\xb9\x142\x97\x89\xf5Y\x1f\xcf\xfa\x8ea\xaa\f\x8a\xd5\xcdJ\xac\xa7\x8d\xab-I]\x9f\xb2\xba\xa2;\x15\x03\x03\xcd5\x85\x98-\xa1X\xb9˥3w\xe5/\x8b\xa7\xf0Z&}\x03\xb5r\x17ס\xe1\xa3\xcdn\xeb\xc1W'\x88L\xa1\xeb\xcdcS/9LTocңph\xd6`\xb7\xd5/\xa3\x0e\x85\x95ܧ\x94\x05W\xfd@\xb7s)\xaaIn\x1b\x13mB7\xe5N\xb7\xa4F\xd3\xef\xad#\x1a\xb9\x1a\xa1\xf9+k\xadli'\xa6\x9dw\xbe\xb7\xb6\x1f\xf4T\xddy\xb2P\x9e\xae\xcdh\x19\xbe\x17\xf5z\x1e\x17\xa0\xe6\xf3\xe5\xe4\x0fb\xdb%*\xa0|\xea\xac\x14\x97K\x18\xbb̰/r\xae\xb0\x8c\x15\xde%\xdc\xdeۋ\xc01#\xff\xe5\x85\xf9\xf8\xcb\v\xa9he\xd6A\\\x99\x18\x97\x1a\x84\xdd\x1a\xa3r\x9e\xa0\x10S\xfcU\x90\xfc(\xd0&\xf9\xc4z\xe7j\x0e\x9bj\xbaL\xe3^\xdf4\xe947\x99\xd7\xd6t\xbbѳ4\xf56\x8f\xa9\x190\r{\x1bu)\xa8\a#\xe9\xd6)C\xf9\xd1\xfbn=\xd8\xfeT\xe1\x96Խv\xd4w\x11%\x15;\xa9\x86-\xb7K\x1fvZW\xdc#\xbdH\"\t_\x9b\xee\xbbJ\xfaf\xee<\xb9\x19\xd37\a\x8a-%\xd3\x10\xe8\bN\xfdit70I\x8dwD\x95#\x9b>\xd1糕M,\xe3\f\xa1I\xb5^\x16\x9a\x15\xbe\xb2\xaeL\x1f\xae\xac\x9bPF\xb22\x14\xfch]\xfddϾ\xaa\x93i5W߲\x17\v\x194\xf5\xa5\x9d\x813\xb7>\xe1z\xca\x16\x12\xf5`\x1a\x97$ž\x97\xdd'U\xac(\xf5\xc1̞\xabއ\xe3\xa35~S^\xa0\xc9\xe7~\x81\xf7q\x19\x19{wG\xd0\xc6C4\xfa\xc21\x14&\xda\xebIr9iXT9+`W\x8f\xb8ѵ\xfd.G\xa5X:\xfe\x83S\xfc\xb1\xee\xb0t|v\xb3\xf7š\xa8.\xbaɃ\xd4\\\ued08<\x8f\xca\xf4^\x87tt\x04$Q\tC\x04\xc5\x0f\xd4\x1eʌ\xfeO(32\b\x8f{2\xd3y\x9a\x9asi\xa6I|z~\xa4\xf3i\x99P\x89x>es\xfb^zs\xfb\xc5xV_T&վ\xee$8)⤈\x93\"N\x8a8)⤈\x93\"N\x8a8)\xe2\xa4H\xf2U\x92\xaf\xe2$\x89\x93\xe4%&_\xad\x85?\x1d\xf3\xa9\v\xa73ŞV\xd1\xfeHֽv\x18\u0530\xf9\x13\xec\t\xf6\x04{\x82=\xc1\x9e`O\xb0'\xd8\x13\xec\t\xf6\x04{\x1a:{\x1a\x9bW\a\xfc\v\xfe\x05\xff\x1a\x15\xff\x9a\x8a\xfaƫ$\x85\x9cJ\x0f\xcf9\x8f\x92\xe8\x1f\x8c\xf8M\x12#/\xcb\xedF\x9b\xf5ߝ\xa9h\xa4_ky\x96\xe4?\xc22\xffj\xca\x04\x92\x16\t߁\x97I\xf4/\x8e\f$\x90\x0f\xc8\a\xe4\x03\xf2\x01\xf9\x80|@> \x1f\x90\x0f\xc8\a\xe4\x03p\x01\xb8\x00\\<N\x92\x17Ǫ\xad\xea\xc9!\xb3\xba\xd7\xef\x93\x1c\xe1\x01i_ C\x1c\x8e?Q\xd2\x1bXS/Y9\xf8HI\t\xb6\xd2f\x1a\xa9\xe3B\xdar\xf4\xf7I\rj!\x99\xd4\xc9\xedh\xfa\xc1\x92\x13\x1b\xd2\xc77KJm!g=[2\xce\r\xe5`\xaa+\xfd\x9b\xe4\xe1\x12\xb0-\xd8\x16l\v\xb6\x05ۂm\xc1\xb6`[\xb0-\xd8\x16l\v\xb6\x05ۂm\xc1\xb6c\x7f\xbc\xa4\x1c\xa4\x1d\xe2\xfb%'x\x10\x96x\xc2d\x9c\xfc\xe8\xc0+&\xb5\xba\xfa\xf1\x90I\xff\x1f2i\x01\x1f7\xf4\x96I\xb9\xbd\xed\xf4\xe7Lƺ\xf6\x0f\xbdh\xc2mԨo\xa3z\xf8\xbaI\x13w@\xf6\xc5\xde\xfbt\xbety\xe5\x84WNx\xe5\x84WNx\xe5\x84WN\xbax\xe5\xa4$\xf0(\xf3\xd0\xc9\x19:\xc6%>vR\xa0\xc3\x1cz\xeb\xa4\xf7*L\x17ϝ\xb4\xa1\xda7\x95+\xae\x13\xfc\x98N\x8fq\xa4\x01\xe3{\xf8\xc4`P\xed*T{(\xf5\xe7\xb8T\x82\xa9+\x06S\x1f\x1dJ\xfc\xf2\xf0\xcb\xc3/\x0f\xbf<\xfc\xf2\xf0\xcb\xc3/\x0f\xbf<\xfc\xf2\xf0\xcb\xc3/\x0f\xbf<\xfc\xf2\xf0\xcb#\x9c\xba\x98\x93\x1d6\xac{\x1dP}\x94\t\x94\n\xa9\xee\x0f\xb4i;\xa8\xfa\\\xc6Ҿ#ˑ\x16>\xf7n\xfd\xb4\xe9\xccrRK\xda\t\xae>\xa1)}\f\xaf.\xb9\xa1\x9c\x15`=\xd6\xed\xe5\x9c\x10k`.0\x17\x98\v\xcc\x05\xe6\x02s\x81\xb9\xc0\\`.0\x17\x98\v\xcc\x05\xe6\x02s\x81\xb9\xe3\x0f\xb2.\vn\x87\x1bf}Ŀ\xb0D\xa0\xf5X9ұP\xeb\xfa\xd8\x11\xc1\xd6C\t\xb6n\x18'7\x14n]v\x97;=\xe0z\xbc{\xc0ѐk\xee\xaa\xc6~W\xd5\xc3\xd0\xebf\xee\x87\xec\v\xbe\x13\xea\xc12&\x00\x9b\x00l\x02\xb0\t\xc0&\x00\x9b\x00\xec.\x02\xb0KÐ2!\xd8gi\x1a\x04a\x9f\x1e\x84\xddoE\xa6\xbb0\xec\xa6\x15\xfd\xa6\x02\xb1;B\x94\xe9P\xec\xa3M\x18k0\xb6f\xe9%߶\x0e/mE\a\xfe\xe6η\xf6\"}G`Ǿ\x14\xbcq}\xe2\xbe\xf7\xbdJ\xf2\x89_\x8c\xdfw\x8b\x87\xbd\xbe\x1az\xa7\x9c(*\xef,\xba\xe7\xfdz\n\f\xa7H\x9c\"q\x8a\xc4)\x12\xa7H\x9c\"q\x8a\xc4)\x12\xa7H\x9c\"q\x8a,}\xbc\xd4i\xe1┉S&N\x99\x83pʬ\x9dE\x1ds\xde\vg4šV\xd1\x16\x19%\n\x84G\xb5\xc1\xa3\x86͢\xe0Pp(8\x14\x1c\n\x0e\x05\x87\x82C\xc1\xa1\xe0Pp(8\xd4\b8\xd4\x18\xd3\xef\xc3\xc3\xe0a\xf0\xb0\xd1\xf2\xb0\xb2\xcfe\x9c\xe6\x9dų\x19\xf5\xf1\xb0\x13\xd2{\xe2\xa1\xd5\x1f\xffO\xd8\x18l\f6\x06\x1b\x83\x8d\xc1\xc6`c\xb01\xd8\x18l\f\x1f-\x98\x14L\n&\x05\x93\xaa¤j\xf0҂K\xb5ť\xf0ԂG\xc1\xa3\xe0Q\xf0(x\x14<\n\x1e\x05\x8f\x82G\xc1\xa3\xe0Q\xbd\xf2\xd5\x1aO~\x1e\xc8\x18d\f2602\xb6vE\xad\x92\xcdY\x17\x17\x12\xb1B\xba\xf5\xa0\xd3X\xaad\x9a\xf1\x14ؖ!\x10\x87s\xfeUJ\xc0\xd7Mf\xbf\xf4\xc0\xec\x93)\x19\x89\xd7\xe9o\xbc\xbe\xfb\xf9\x8f\xe18\xb4\x88\x9c\xe2ZO\x05Nχ'>B\xa1\a\x05 \xa3'\x1aa\x1e\xf7\xec\x17\xf3\xc9])\b\xa9\xa4L\xcb\xc7\xd4д+\x10Q\xcdg\xbd\xb9}\xa2\\̶r\x8e-\xa3iO\xb5\xaeP`\x8c\xb5\xec\x87'z\xa4\xdbE(\xfc\x8d./j\xf1۸\xbc\x91C\xf0ӄK\r]\x9et\x1d\x1848-\x9c\x16N\v\xa7\x85\xd3\xc2i\xe1\xb4pZ8-\x9c\x16N\v\x9d\x84NB'/\xfa\xc1\xdbĈ\xbeQ\x94Ø\xa71=\xc92\x8f\xe8͉\";\xfb,\xb0R\xe4\xe1U\"\xe00\a\xcc$\x8e]\a\xa0@\x81K\x97\x17\xe4\xe0\x1as\x1e\xcaT\xf7\x81\xccԃQL\x97\xaa\x81\x94\x06\x9eY\xa9S\"\x9by\x92\xa5\xe6\x16\xd6\xf7(d\x03\r\xfba\xbfa業\x8e[V\xe9Q\x1b\xaf\xe3-\xe6ȃ\x95\xa9\x97\xda\xe4D\x19φ\x13>W\x99\xb7\xe1\xc4=\x86\xe1\xc2pa\xb80\\\x18.\f\x17\x86\vÅ\xe1\xc2pa\xb80\\\x18.\f\x17\x86\xdb\xed\xeb\xdc\t\x97\xe8\x16\xd0V\xe4\x00\r\xbd\xbc[\xca=\xf0XԼޣ\xb2v\xf7xx\x90\xea\\S~|E\xfb\xc4'\xa3\x13k\xbd\xfa7\xad\x9fNտD\xd2'\x96\xf5N\xfd7\xfa\xfb\xd2\x16\t\x90\xff\xc9\x01\xb8\x90\x069\xfeM8\a\xb3\xd0r\x95\x9d\xf1\xabc]\xfdY\x1a\x14\xac\xed\x99s\x95\x12\x16]b\x1d\xfbZ\xdcޘ2M\xa3\x96\x8bt\xaa&\xeb\xc6*Q_\aQ\xc3B\xedIt~_\xab=Z\x8b5\xbbU\xa6\x8d\xea\xa7úb\xaa\xbc\x93\xa9U\xd6\xe9\xe6\xb5\xcd]\xe5\xeb\x85=sF\xbc\xd0u\xff\xb8j⪩\x99\v\x9dm\xaf\xefs\xec˸\xc3\xe9r%\x1fP\x81\xdf\xea\x16\x7fX\x9bp\x8c璦R\xda\xfa\x11\x9b/0\x96\x8b\x1c\xc6\xf7\x9e\x01\n\x91՟\xdc(\x85\xa0e\xa2\x02Y\xe5\xdc\xd6\x17\vSeE\xacnVb2m\\m>\xea\xfa\x94\xa9\x15]\xa4\x18\x02h\xee&\xc4V\te\xc8].\x9d\xb9+\x7fY<\x85w1\xe9k\xa7\x95\xbb\xb8\x0e\xad\x1dmk[\x0f\xbe:Od\xe6\\o\x1e\xdbw\xc9Ѣz\x1b\xe3\x1d\xc5@\xb3V\xba\xad~\x19u(\xac\xe4>\xa5E\xb8\xea\a\xba\x9dK\xd1Yrۘ\xa8\x19\xba)w\xba%5\xda{o\x1d\xd1\xc7\xd5\b\xcd_Yke@;1\xe2\xbc\xf3\xbd\xb5\xfd\xa0\xa7\xeaΓe\xf1tmF\xcb@\xbd\xa8\xd7\xf3\xb8\x005\x9f/'\x7f\x10\x83.\xd1\r\xe5Sg\xa5`\\\x02\xd6e\x86\xfd\xb5ؖ\xaa\xef\xe1\x05\xc2\xed\xbd\xbd\b\x1c3\xf2_^\x98\x8f\xbf\xbc\x90\x8aV\xfa\x0e\xc0\x8f+\x13\x8bRӯ[cI\xce\x13\xfea\x8a\xbf\n\x92\x1f\x05\xda\x0e\x9fX\xef\\\r_SM\x97i\xdc\xeb\x9bƛ\xe6\xfa\xf2ښn7z\x96\xa6\xde\xe615\x03\xa6ao\xa3.\x05\xf5\xb0#\xdd:e\x1d?z߭\a۟*ƒ\xba\xba\x8e\xfa.\xa2\xa4\xe2\"հ\xe5v\xe9\xc3N\xeb\x8a{\xa4\x17I$\xe1k\xd3}WI\xdf̝'\xd7a\xfa\xba@\x01\xa5d\x1a\x02\x1d\x9d\xa9?\x8d.\x04&\xa9\xf1\x8ePrd\x9f%\x8a~\xb6\xb2\x89e\\\x1f4\x9e\xd6\xcbB\x03\xc2W֕\xe9Õu\x13\xcaHV\x86\x82\x1f\xad\xab\x9f\xec\xd9Wu\xfa\xac\xe6\xea[\xf6b!\x83\xa6\xbe\xb43p\xe6\xaa'\\O\xd9B\xa2\x1eL㒤\xd8\xf7\xb2\xfb\xa4\x8a\x15m?\x98\xd9s\xd5\xfbp|\xb4)`\xca\v4\xee\xdc/\xf0>.#c\xed\xee\b\xdax0F\xc7\xf0B!\xa1\xbd\x0e$\x17\x91\x86;\x953\x05v\x95\x85\x1b]\xdb\xefr\xf4\x86\xa5\xe3?8\xc5\x1f\xeb4i\u0383;\xbb\xd9\xfbb\xcf\xf5\x13\xdd\xd2!\xa9'wZ \x9eGox\xafKd\xee\xeb\x177L\xe7&9P\xf9`\xb2\x92\xd4\xc4.e\xb3Л\xb3L\xdcA\xb8a\xbe\x16\r \x98Cw2<\u0600\x1d\xc0\x8e\xa1\xc0\x0e\xb6\xd1RۨI\xa2|~\x9e\x88Ӟ\xf4\"_Dp,Y\xf2\x19\xbb\xf2E>\xdbկ]\x02gm\x9c\xb5q\xd6\xc6Y\x1bgm\x9c\xb5q\xd6\xc6Y\x1bgm\x9c\xb5y\xa8\x8bt\xc48\x8b\xe3,N:\xe2\xf3\xd8R\rOs\xc1\x97\xea\xe4K\x17\xf5\xfc\x16\\\t\xae\x04W\x82+\xc1\x95\xe0Jp%\xb8\x12\\\t\xae\x04W\xea\xe3\x83[c\xf0ƀm\xc1\xb6`[\x83c[y\xcf\xcf\xf3ؖ&P\xe9\xa1)zh)\xfd\x9d!>\xb8\x957\xfd\xdfxmkw\xfa\xff\xfe\xed\xe5)\x12\xf0y\xe8\xefl\x15\x88C\xe8{\xebΜ\xb2\xcfjI\xeb>\x99\x12F\x0f\xae\x8f\xcaM\xf8\x8a֞\xe0$\x03\x04k\x85\xb5\xc2Za\xad\xb0VX+\xac\x15\xd6\nk\x85\xb5\xc2Z\xe1\x8cpF8\xe3\xe5&\\U\xb6\xf9\x8d\xfd\xf0\xa0fA\x04=\x13\a\x9c\x01\x17\x86\x86\x84\xe6twoc\xe5\xc0\x94\xc4\xff*c\xef\xa7=\xaeNx\nk\xd5\x11N\xa9\x81}\xc4O_\x9dN?\xea\x8e\xc8/'F\r\x04ޗnHM\xf1\xf5\x95\xea\xaf\xe3y\xaa\n\rh\xfd\x15\xaa\n\x8b\xf9\xacG\xa7\x06\xbb\xb2\xe3\xfc\x84ٕ\x9d<0\x05\xe1\x84pB8!\x9c\x10N\b'\x84\x13\xc2\t\xe1\x84pB8!\x9c\x10N\b'\x84s@OJU\xe1\x9a}}A\xea\xb8\xe3[\x89\a\xa3\x06\xcbr\xc2\xf7\xa1\xea\xf0P\xe39\xa8~<\a\xd5\x11k-\xf3\xeaS\x95\xfd\xe5\xf4G\x9e\x06\xbc8\xa3d\xc7ܡ\f\xf9\x0e\xa5\xedT\xc4\xcd\xdd`\f\xf9B\xb2\xe0ڢ\xcd\xc5\xc4+J\xbc\xa2\xc4+J\xbc\xa2\xc4+J\xbc\xa2\xd4\xc4+J\x95\xec\xf52\x8f&\x15*\x03#\x7f&騲\x11\xbd\x91\xd4W]\xa3\xa9'\x91\xbaҒK%M\xeb\x10o\xa5sBdj\x1a\xe6\x9b\x1c'C\xb5\xaa/\x19\x8dؖo\xeb\x9d\",\xfa\x8b\xb2\xe8٩N٩Lz\xd7S\xa2\xdfO{,\x88(\xf8\xfdܭE\x1b߅>\x05\xd4\xe1\x9aƧ\x16\x9fZ|j\xf1\xa9ŧ\x16\x9fZ|j\xf1\xa9ŧ\x16\x9fZ^\xfe!;*>\xbd\xf8\xf4\x92\x1d\xf5 \x17\xaa\xe1\xa1\x1f\xd8\xd0\xe9l\xe8\u009e\xf1\x81\t\xc1\x84`B0!\x98\x10L\b&\x04\x13\x82\t\xc1\x84`B\x9d\xbf\xda3L\xbf\aH\x14$\n\x125\x12\x12e^\xa1浖=\xa6T\xf0`tޓ-\xfa\xab\xa3|\xb7\xc5H\a\x8f\xb7\x94G\x93\x05b\x94\xfb\x82K$G$9\x04\xbe\x01߀o\xc07\xe0\x1b\xf0\r\xf8\x06|\x03\xbe\x01\xdfHr\b\x86\x02C\x91\xe4\xf0\xac\x00KmR\x0f\xf8-\x17\x83\x0fF\xf4\xa0K\x01\x0f)x\xd5\xe5(\x11\xe90\x88yW\xb4\xba\x8dd\xceiM\xfb\xe1\xcc\x05\x8dh\xf9\xa5\x97\xdcV\f乗d\xbd\x8f\xf1͗\x82\xc5_\x90AM\x7f\x9b\xd7_\x00\xa3\x80Q\xc0(`\x140\n\x18\x05\x8c\x02F\x01\xa3\x80Q\xc0(`\x140\n\x18\xbd\xa0\xd7_R$tXO\xc0\xec\xf9Ѝ\xf7\x1d\x98\x02\xbe\x93\xfb\x18L\x05g7^\x84\x19܋0\xb53\xd9\xf6\x9e\x85Im<\xa3{\x1b\xa6p\xc1\xe6'\x95\xe5:f\xf8\xd71\xc3H1{\xf2=\xc8\xe0o>\x0f]~\xf0\x86\fo\xc8\xf0\x86\fo\xc8\xf0\x86\fo\xc8\\\xe6\x1b2i\xab\x9f\x87d\xea\xd6=\xf2_\x93\xe9\x99\xeaу'e\xeaW\xa4[\xcc\xe2U\x1f8\xbb\x88'\x1b\x8ap݅\xbc0S\x8d\b\xf4\xf0\xad\x19\xb8\xc08\xb9\x00\x9b\xd9ɛ\x19\x8f\xd04\x1c\xcd\xff\xfd\x14=\x8a\xe7h:Y\xe7x\xfa\xe2鋧/\x9e\xbex\xfa\xe2鋧/\x9e\xbex\xfa\xe2\xe9˛4d\x02\xc5\xd3\x18Oc2\x81\x1egE<L\xd3\x11/\xe2\x89\x1a8\x11\x9c\bN\x04'\x82\x13\xc1\x89\xe0Dp\"8\x11\x9c\bN\xc4;5\xd0)\xe8\x14tj\xa4tj\x1d\x1c~\x8d\xc6]\xa9@\x88x\xc8m\xcb0\x84\xf1=Hc\xfe\xff\x9c\xd7gց\xec\xc7\x7f\f{\xdd\xee;3\xa6\xd62\x0f\xccȴ~\xe3\x9d!=\xad\xb9\x0fV\x873\xfby\xe0\xef\b\x99iVz\x9f\xafbq|E\xac\x02\x1d3X\xee\xe1\xa07qI\x1fÒƎ\x90\v\xe4\xc3<\x18\xa4\x04d\x7fH\xde{\xfe\xeb\xc5\"\xceUЄ\xd8LTÔ\x90\xec\xd7\xdeYJ\xe5u\x90\x00\xe2\\9\xe1-s\x181\x8c\x18F\f#\x86\x11Èa\xc40b\x181\x8c\x18_Bh-\xb4\x16Z[\x17\xad\xfd\xf6\xf2vnK\xf1J\xa4\xcbb\x9e\xb7\xba\x80O:\xa7\xdfeӝx$ڄ:q\xa5\xbd`9ia\x00\xe1\x80p@8 \x1c\x10\x0e\b\a\x84\x03\xc2\x01\xe1\x80p@8 \x1c\x10\x0e\b\a\x84S'\xc2q\xd6\v\xefi\xa93\x1b\x97d8q\t@\x9cx(Z\xa58q\xad\xfd\xc08iy\x80\xe3\xc0q\xe08p\x1c8\x0e\x1c\a\x8e\x03ǁ\xe3\xc0q\xe08p\x1c8\x0e\x1c\a\x8eS#\xc7YŨ\xc1$\xee\xd2\xff~&\"\xabI\xdc\x13\xe3\x9dy\xee\xe0\x80:@\x1d\xa0\x0eP\a\xa8\x03\xd4\x01\xea\x00u\x80:@\x1d\xa0\x0eP\x03\xa8\x01\xd40 \xd4@R\x91\x82\xa4\"^\x90\x03Q\xcc\x19&\xd3\xd3-/)\v7L\xf3\xcb\xe0\x8d\x06\x1e\a> '\r\xbc\xe6y\xb4\u009a\x1e\xec<\xa9\x9e\x1f\xf6\xeb1ϧ\xd7[Q\xa5\xc7?\xbd6VY\xf8\xea\xf8^#\xc3W\xde×\xdf\xc3=~\x98k\xcet%J\xf2\x14\xf5\a\xb8\b\\\x04.\x02\x17\x81\x8b\xc0E\xe0\"p\x11\xb8\b\\\x04.\x02\x17\x81\x8b\xc0E\xe0b\xa9Dן6\xf6f\x1bT\x06\x1f\xe6\xccH\xc8C\xff\xf3\x17\x87{\xb0~\xdaCM\xa1\xea\xa6\x15̼\xb5Li\xb0\x9d\xe9U\xa6\xe5^\xe6\xdc\b\xc4\xda\xf7\xf4\x9e\x9f}\xf7C\xf3\x88~?\xfeQ\xc53\xeeؓ\x97z\x8f\xcc\xda\xfd\xc3$N\xaa#\xf5\xba\xb0\x15\xedD\x9f\x8c֭5\xf7ߴ\x06<U\xff\x92\xb54\xb1\xacw\xea\xbf\xd1ߗ\xb6̯\xfcO\x8e\xd8\xc5V\xb4!\xff&\x1c\xe5Yh\x1b\xcb\xde\xfbձ\xae\xe2\x16_\xa5DA\x97X\xc7\xce\x19\xb77\xe6XӨ\xe5\"{\xaaɺ\xb1J\x90\xd7A\u0530P?\x13\xab\xc2\u05ca\x95֓\xcd~\x98i\xa3\xfai\xa7\xb7+\x95\xb7\xbe\a\xa7\x8d\x8b\x95m\xee\xda[/\xec\x993\x92\xe5\xa7\xfb\xc2-K\xb7\xb7,\x95.?\xb6\xdd\xdf}\xd8#\xb8\xef\xe8\\\xfa\x0f\xa8\x8bou;?\xe8Q\xd7Zc)\xb3\"m)\x88}\x14\x18-_\x8e\x95{\xcf\x18ߑ\x85\x9cܾ\x84Pbb}\xd6'\x90\x86\xf0S\xa5q\xafnVb^l\\mj\xe9\xfa\x94Y\x12]:\x18Zf8\xbe\xe8\xf5\xa1\x94\xb8˥3w\xe5/\x8b\xa7\xf0\xde\"}E\xb3r\x17סe\xa0\xedR\xeb\xc1W\xbb\xac̗\xeb\xcdc[(\xd9pUoc\x14\xa2xa֢\xb5\xd5/\xa3\x0e\x85\x95ܧ\xceCW\xfd@\xb7s)\xa7on\x1b\x93\x03S7\xe5N\xb7\xa4F\xdb\xe8\xad#z\xa3\x1a\xa1\xf9+k\xad\x8cM'Ɓw\xbe\xb7\xb6\x1f\xf4T\xddy\"\xfeO\xd7f\xb4\f\x00\x8bz=\x8f\vP\xf3\xf9r\xf2\a1~\x12-G>uV\n\\%\x10Zf\xd8_\x8b\x1d\xa6\xfa\x1e\xc2\xf6\xdb{{\x118f俼0\x1f\x7fy!\x15\xad4/\xf7\xe3\xca\xc4\xfaҤ\xe8\xd6X]\xf3\x84\x15\x98⯂\xe4G\x81\xb6Y'\xd6;W\x83\xcaT\xd3e\x1a\xf7\xfa\xa6Q\xa0\xb9껶\xa6ۍ\x9e\xa5\xa9\xb7yL̀i\xd8ۨKA=\x9cE\xb7N\x19\x19\x8f\xdewK\xac\xe5\xa9\xe2\x11\xa9\x8bܨ\xef\"J\xea\xc9/5l\xb9]\xfa\xb0Ӻ\xe2\x1e\xe9E\x12I\xf8\xdat\xdfU\xd27s\xe7\xc9ՑF\xeb\n\xbe$\xd3\x10\xe8\x87\xc7\xf4\xa7\x11<\x9f\xa4\xc6;®\x91ћ\xa8\xac\xd9\xca&\x96\xb9\xbc\xd7(W/\v\r\xd3^YW\xa6\x0fW\xd6M(#Y\x19\n~\xb4\xae~\xb2g_\xd5!\xb2\x9a\xaboً\x85\f\x9a\xfa\xd2\xce\xc0\x99k\x91p=e\v\x89z0\x8dK\x92b\xdf\xcb\xee\x93*V\xf4\xd6`f\xcfU\xef\xc3\xf1\xd1J\xad)/\xd0hp\xbf\xc0\xfb\xb8\x8c\x8cU\xb6#h\xe31\xf9\xdb0\xf4\x15#\xd9ker3g@̹Z\xf0\xee\x99\x7f\xa3\xeb\xf9]\xce\xf1\xbft\xfc\a\xa7\xf8cY\xd4R\xfd\x83;\xbb\xd9\xfbb\xef\xd4\fݶ\xfek\x19wzʟ\x87f\t\xaeC\x9a\xd7s\xf2\x95~\xcd6\xb7\xc6F_\xb5\x85\xbb\xeds7r\xc67\x10\x7f\x9a\x1e\x13<\xc3\xf0\f\xc33\f\xcf0<\xc3\xf0\f\xc33\f\xcf0<\xc3\xf0\f\xc33\f\xcf0<\xc3\xf0\f#\xecthϞ\x1d\x0e2턅\xd4\x1b[z\x00]4\xe9\xec\x90T\xfb\u070e\xac5\xe3ڰ[|\xddq\xa3\xd9\xf2\xbb\f\x17=\xb8LΊ\x12\x1dԢ9\x16\x1c\n\xf9\x83\xfcA\xfe \x7f\x90?\xc8\x1f\xe4\x0f\xf2\a\xf9\x83\xfcA\xfe \x7f\x90?\xc8\x1f\xe4o\x901\xa1\x87\x81 \xa1\xa0\r\xba\xa4\x95\x88\x00\x1d\x14M\xca\v\xfc,E\x90\x88\xf7\xec_\xbcg}\xac\xb6b\x98\xe7\xe1\r\xec\xf4\xe8\u0381-\xadܠN\xae7\xea\xbb\xde\xe80`\xb3\xca\xed\x83=\xdc\x1b\x87\xae䘨L\xa22\x89\xca$*\x93\xa8L\xa22\x9b\x8c\xca<bj\x97\t\xc6,:\xeb\x89\xc1\xec\x9d*\xd1F\xe8e\x8d\x1alՈ\xcbF\xb9R:\xd02]\x11\xf1\x95]\xc3,\xd90\xf4n{\x90i\x99\xefD\x138F\xba\x15\x9e9\xf0\x97C\xfce$bp\x98\xc4Ē\x00\x8f\x19'\x8f9Uq;W\xdaQ\xe1\xba\\K(r(r\x17\xa5\xc8\xc91\xe6=-5\xe1)\x99)#.\x81T\x19\xc9֕\x1e\x14<\xe6\xf1\x98\xc7c\x1e\x8fy<\xe6\xf1\x98\xc7c\x1e\x8fy<\xe6\xf1\x98\xc7c\x1e\x8fy<\xe6\xf1\x98'W\x86q\xb7\x8a\xcc\xe5~$\xcbH[\xefgg\xcb脇Ԝ.\xe3\x00\xbeh\xf4\x02#\xa9\xf7\xb9%\x81k\xe8\nc\xb7\xfc\xdaSfd+\xe84g\xc6\xc1\xd5r^ҌA\xad\x9d\xa3Y3\x80\x80@@  \x10\x10\b\b\x04\x04\x02\x02\x01\x81\x80@@  \x10\x10\b\b\x04\x04\x02\x0e3m\xc6a4Hތ&=\xd4\xca$\xce\x18\x14Qʍ-(E\x91H\x9d\xd1\xc3\xd4\x19\xf51۪\xb1\x1b\x87w\xb13\x92g\fly\xe5\xc7lp\xdbQ\xebmG\x97\x11\x1bU\xee\"\xec\x01\xdf?t&\xcd$\xd1 \x89\x06I4H\xa2A\x12\r\x92h4\x9aD\xe3\x88\xe1]*\x8bF\xd1qO\ff\xff\xb4\x89V\xc2/kTd+\xc7_6J\x9a2\x01\x98隈\xc0\xec\x9co\xdd\xcaI\xb2p\x8e\xa4\xd2P_\xc9\t*\x1f\x11\xee\xfa\xa4G\xa1\x89վ\xddxj\xfc\x94'\x81:\xe2t=\xed\x13\x99\xa4\x15ɢ\x8f\xfa|fB\x8dq\b\xc3\x118\x13\x8dM\x1b\x84&_B\xfe֦0\xd6Gi\x9a\x10\xf7\xf39\xcdy\xe2~jr\x8d3%\x1fŮ\xcbuՕv\xd7\xc8\x02(\xa1ߝ\xb9\x04\xea\xd3\xf0\xa2JP\xeez\xa0\xdcUI\x946&\xf5\xae\xf9Tic\xb8o\x1b\x8b(\x1cS\xee\xbaH\x97\xc6\xfd[\xbb\xf7o\x95S\xa6\xa1֝\xa1\xd6]N\xd24\xa8\x1d\x8a]ˊ\x9d:\xd0da\xab\f\xb8%\xf3\xa6}4%\xb4\x9c\xee\xb4\xe7y\xd32\x83B\xc8$!\x93\x84L\x122I\xc8$!\x93\x84L\x122I\xc8$!\x93\x84L\x122I\xc8$!\x93\xe4MS\xe4+1\x97{\x917-c\xbd\x9f\x9b7\xad\x1b\x1eRo\u07b4C\xf8\xa2ɛ\x8cT\xbd\xcf-\t\\37\x19{\xe5ם7m\xa7\x82.\xf3\xa6\x1d^-g\xe5M\x1b\xd6\xda9\x967\r\b\b\x04\x04\x02\x02\x01\x81\x80@@  \x10\x10\b\b\x04\x04\x02\x02\x01\x81\x80@@ \xe00\xf3\xa6\x1dA\x83\xe4Mk\xd2C\xadD\u07b4a\x11\xa5\xbcH\x83r\x14\x89\xbci\xfd˛V#\xb3\xad\x18\xc7qd\x17;=o\xdaЖWn\xf4\x06\xb7\x1d\xf5\xdevt\x18\xb7Q\xe9.\xc2\x1e\xf0\xfdCg\xd2L\xde4\xf2\xa6\x917\x8d\xbci\xe4M#oZ\x93yӎ\x19\xdee\xf2\xa6\x15\x1e\xf7\xc4a\xf6O\x9bh#\x02\xb3NE\xb6j\x04f\xb3\xa4)\x1d\x81\x99\xa9\x89\b\xcc\xce\xf9V\x85\xbcic\xc2]\xe4M\xab\x987mdp\x86\xbci\xe4M;1o\x1a\x8a\xdd\x19\x8a\x1dy\xd3ț\x86rמrW!oڨԻ\xc6\xf3\xa6\x8d\xe2\xbem,\xa2pL\xb9\xeb o\x1a\xf7o-߿U͛\x86Zw\x8eZw1yӠv(vm+vj\x8br\uedcb\n\x89\xd3>\x85E\x909-\xbd\x81eG\x85\xa8I\xa2&\x89\x9a$j\x92\xa8I\xa2&\x89\x9a$j\x92\xa8I\xa2&\x89\x9a$j\x92\xa8I\xa2&I\x9d\x169h\x86\xf6r/r\xa7e\xed\xf7s\x93\xa7u\xc4D\xea͞v\x10a4y\xa1\x91\xae\xf8\xb9-\xa9k\xe6Jc\xbf\x82\xba3\xa8\xed\xd6\xd0e\n\xb5#\x8b\xe6\xac\x1cj\x03[Bǒ\xa8\xc1\x03\xe1\x81\xf0@x <\x10\x1e\b\x0f\x84\a\xc2\x03\xe1\x81\xf0@x <\x10\x1e\b\x0f\x1ch\x16\xb5c\x90\x904j\x8d:\xac\x95ȣ60\xaa\x94\x17zP\x92$\x91I\xad\x7f\x99\xd4\xead\xb7\x15C;\x8eme\xa7\xe7R\x1b\xdc\x12\xcb\r\xe9\xe0\xf2\xa3\xfeˏ\x0e\x03:\xaa\xddL\xd8C\xbe\x8d\xe8N\xa6ɩFN5r\xaa\x91S\x8d\x9cj\xe4Tk2\xa7\xdaQ3\xbcLR\xb5\xe2\x13\x9f(\xcd\x1e*\x14m\x04h֪\xcfV\x8d\xd0l\x98=e\xd2nd\xaa\"F\xb3{\xe4U!\xb5ڸ\b\x18\xc9\xd5*&W\x1b\x1d\xad!\xbd\x1a\xe9\xd5NL\xaf\x86\x8aw\x96\x8aG\x825\x12\xac\xa1㵨\xe3UȰ62-\xaf\xf1\x1ck#\xb9\x89\x1b\x8d8\x1c\xd5\xf2:ȳ\xc6\xcd\\\a7sUs\xad\xa1㝧\xe3]L\xb65`\x1e0\xafIE/\x952\xb7db\xb5nR\xa3\xf62\xafZ2\x14\xef=\xff\xf5b\x11\xef]-%\xde\xedEHz\xb39\x19\t)%\xa4\x94\x90RBJ\t)%\xa4\x94\x90RBJ\t)%\xa4\xf4\x92CJ\xeb\xb4\x0e\ti%\xa4\x95\x90\xd6A\x84\xb4\xeeq\x1c2\xe4\xd7JrRc\xd1&\xca\x19ZzA`\x0e0\a\x98\x03\xcc\x01\xe6\x00s\x809\xc0\x1c`\x0e0\a\x98\x03\xcc\x01\xe6\x00s\x809\xa5a\x8e\xfe֭\xda\xc9}\x15\xda\xed+\x1b4\xd0)(\x8a\xc8Nx\b\x8a\xfd\xf4͝o\xe5\xb4J\xf5َu\xd37q\x89\x1f\xc3\x12/\x14\xf4|O\xfc\v\xf7\xc7D\x91\x97\x16\xa0\xcf\x01\xc7B-\xba\uf512Y\x99\x00\xe9\x9eV\xc5@\xb9r\x03\r\x82\x06A\x83\xa0A\xd0 h\x104\b\x1a\x04\r\x82\x06A\x83\xa0A\xd0 h\x104\xa8v\x1a4\xb7\xa5\x92\xd5A\xf7\x9e\xd3 \xd0[]\xd0\xe5:\xf9\xa4\xd8O<\x14 \x9f]䓖\x12H\x0f\xa4\a\xd2\x03\xe9\x81\xf4@z =\x90\x1eH\x0f\xa4\a\xd2\x03\xe9\x81\xf4@z =\xf5\x93\x1eg\xbd\xf0\x9e\x96\xfa\xb5\x8d\x8a\xa8'.\t֓\x8c\x05\xb0g\x0f\xf6\xa4\xe5\x04\xda\x03\xed\x81\xf6@{\xa0=\xd0\x1eh\x0f\xb4\a\xda\x03\xed\x81\xf6@{\xa0=\xd0\x1ehO\xed\xb4\xa7\xe0\xa5\r\x82\xbfڀBIr\xf9\xfc00¾\b\xfb\x02\x0f\x81\x87\xc0C\xe0!\xf0\x10x\b<\x04\x1e\x02\x0f\x81\x87\x9a\xc4C\x17\xf4\x1c\x11\xf4\nz\x05\xbd\xba\fz\x15>\x17{\x04b\xa5\xc9\xd5*ڱ\xa3\x04\xd5\x10\xac\xf3\bְ\xe9\x15\xe4\nr\x05\xb9\x82\\A\xae W\x90+\xc8\x15\xe4\nr\x05\xb9\xea3\xb9J\xbfߝ\xab\x81\xf3\x8c7\xdc\fn\x067\x83\x9b\x15p3\x92<5I\xc82\xe9\x9eH\xefDz'\xb8\x18\\\f.\x06\x17\x83\x8b\xc1\xc5\xe0bp1\xb8\x18\\\f\x8f.\xc8\x14d\n2\x05\x99* S58rA\xa7\x0eѩ\xcbuۂJA\xa5\xa0RP)\xa8\x14T\n*\x05\x95\x82JA\xa5\xa0R-xk\xa5\x15o\x9c\xb4@a\xa00P\x18(\xac\b\x85\x91\x9f\xbdY\x10\x96\xc9\xd4Nfv2\xb3\x83\xc4@b 1\x90\x18H\f$\x06\x12\x03\x89\x81\xc4@b8jA\xa7\xa0S\xd0)\xe8T!\x9d\xaa\xc3S\vBu\x90P]\xb0\xaf\x16d\n2\x05\x99\x82LA\xa6 S\x90)\xc8\x14d\n2\x05\x99j\xc3Y+\xady\xe3\xad\x05\x0f\x83\x87\xc1\xc3\xe0a\x05<\xccw4)\xaa!\xa7\xd6GS\x12a\x8b\x19\x16\x96\x8c\n\xdeZ\x19\t\x81\x89\xc1\xc4`b01\x98\x18L\f&\x06\x13\x83\x89\xc1\xc4`bxkA\xa7\xa0S\xd0)\xe8\xd4.\x9d\xaa\xc1[\vBu\x98P]\xae\xb7\x16d\n2\x05\x99\x82LA\xa6 S\x90)\xc8\x14d\n2\x05\x99j\xc3[+\xa3y\xe3\xad\x05\x0f\x83\x87\xc1\xc3\xe0a\x05<Ll\xb6\x8ds\xbf]\xd4\xe0\xae\xf5),\n\x1a\x96\xa1a\xa9a\xc1a++#p1\xb8\x18\\\f.\x06\x17\x83\x8b\xc1\xc5\xe0bp1\xb8\x18\\\f\x8f-\b\x15\x84\nB\x05\xa1\xda#T5\xb8lA\xa9\x8eQ\xaa\xcbuڂNA\xa7\xa0S\xd0)\xe8\x14t\n:\x05\x9d\x82NA\xa7\xa0S\xadxmeUoܶ\x80b@1\xa0\x18P\x8clZMp\xafl\x0e\xad\xf7\x9e\xffz\xb1\x88YX@N-\"\x17a`00\x18\x18\f\f\x06\x06\x03\x83\x81\xc1\xc0``0\xb0&\x18\x18\xb4\a\xda\x03\xed\x81\xf6\x18\xdaC4^3\xbcg'\x06\x0f\xe0\x83\xd7\x13\xc4\a\xe2\x03\xf1\x81\xf8@| >\x10\x1f\x88\x0f\xc4\a\xe2\x03\xf1\x81\xf8@| >m\x10\x9f\xa9\xa8H/o\v!\x8f\xfc1{(\x9b>제\xfa\xb8Mc@\xe84z\xa3Gc\x1f\xe1\xc8(\x18\x80\xa3?\x7f}\xf7\xf3\xc7\xd4@\xb4\x88lR5\xeb\x1cN\xa7r\x9b\xe7\xfcYWǷ\xef\xa9\xc3S\x81\a%=ŴϘ\x1c~\xb8AG\ad\x14\xcd\xf8&.\xe9cX\xd2E\xc0\xbe\x02qQc\x95\x92\x97\xfd\xc1i\x01\xfcMT\x13'a\x13'\xfbM8K~\x8a\xb8_U\xe4\x97+6i\xf2g\x06\x18\xfc\a\xfe\x03\xff\x81\xff\xc0\x7f\xe0?\xf0\x1f\xf8\x0f\xfc\a\xfe\x03\xff\x81\xff\xc0\x7f\xe0?\xf0_\r\xf8o\xee\xac\x17ޓ:\x9d\xcb\x02\xa0\xb7q\t\x80\x9f\x18\xfc$\x83\xd2:\xf0I\xaa\xee\x05\xe8Ɉ\a\x80\a\xc0\x03\xe0\x01\xf0\x00x\x00<\x00\x1e\x00\x0f\x80\a\xc0\x03\xe0\x01\xf0\x00x\x00<\x00\x9eF\x00OANs\x1c\x80Z\xe1@Iz\xf3\xdca\x82|@> \x1f\x90\x0f\xc8\a\xe4\x03\xf2\x01\xf9\x80|@> \x1f\x90\a\xc8\x03\xe4a@\xe4\x81\x18\x96\xa31,^\x90\x03V\xcci&\x13\xd5-C\xa9\x04<L\x1fJ#\x8fP\xac\xa6\xde<&\xcfῳ\x0f\x19\xd4(;2!\x1dH\xac\x16\x83\x1f\xf2\xa4\xf5\x8d\xd1i\x1a\xa8\xec\x87\xfd\xca^\xcf\x14Wi\xa0\xb6*\vQ\xaf\x8ev\x16\xa2Q\x15\xf7[j\xfe.Z\xdcBY\x8d\xe1\x810\xe0ei\xfa\x93\tE\x8cz\x06\x93\x84I\xc2$a\x920I\x98$L\x12&\t\x93\x84I\xc2$a\x920I\x98$L\xb2Tv&\x95\xc0z\x1bT\xe6 \xe6\xccH\x18\xc4@\x12\xec\\\xd0s\xa6\x95\x9d\xec\xcc\xe7υ\xbevz\xb7\xcc\x12\x80\x01S(՛\x06\xbc\xe1\x8av\xa7OF\x13\xd7\xda\xfcoZ+\x9e\xaa\x7f\xc9\xfa\x9aX\xd6;\xf5\xdf\xe8\xefK[\xa6[\xfe'\xc7\xeeb+\x1a\x92\x7f\x13\x8e\xf7,\xb4\x97e?\xfe\xeaXWq\x8b\xafR\x92\xa1K\xacc7\x8d\xdb\x1b\xb3\xadi\xd4r\x11E\xd5d\xddX%\xd7\xeb jX\xa8\xb3\x89\xa5\xe1keK\xeb\xcef\x8f̴Q\xfd\xb4\xfb\x8b\x9a\xca{\xe2\x83\xd3\xda\x1d\xcd6w=\xae\x17\xf6\xcc\x19Ӓ\xd4\x1d\xe2¦\x7f\x176\x95\xaeP\xb6=\xb9A\xb1Guk\xd2\xf9\xda8\xa0t\xbeՍ\xfd\xa0\xc7_랥\x8c\x93\xb4\xbd!VV`l\x059\x88\xee=c\xc2Gvvr\x87\x13\xa2\x8d\x89\xf5Y\x9fY\x1a\xe5O\x95\u07be\xbaY\x89\x91\xb2q\xb5\xc1\xa6\xebS\xc6Mtua\x98\x9b\xb9\r\x10\xeb \x94\x17w\xb9t\xe6\xae\xfce\xf1\x14\xde~\xa4/zV\xee\xe2:\xb4/\xb4uk=\xf8j7\x96Is\xbdylQ%\x1b\xb3\xeam\fT\x14u\xcc\xdaŶ\xfaeԡ\xb0\x92\xfb\xd4\t\xea\xaa\x1f\xe8v.\xe5\xbc\xcemcr\xc4\xea\xa6\xdc\xe9\x96\xd4ha\xbduD\xf1T#4\x7fe\xad\x95\xc9\xea\xc4P\xf1\xce\xf7\xd6\xf6\x83\x9e\xaa;O\x16\xc2ӵ\x19-\x83Ѣ^\xcf\xe3\x02\xd4|\xbe\x9c\xfcAL\xa8D/\x92O\x9d\x95\xc2_\tʖ\x19\xf6\xd7bͩ\xbe\x87\xc8\xfe\xf6\xde^\x04\x8e\x19\xf9//\xcc\xc7_^HE+M\xdd\xfd\xb82\xb1\xe14o\xba5\xb6\xdb<!\x0e\xa6\xf8\xab \xf9Q\xa0-߉\xf5\xceո3\xd5t\x99ƽ\xbei\xa0h.\f\xaf\xad\xe9v\xa3gi\xeam\x1eS3`\x1a\xf66\xeaRP\x0f\xadѭSVʣ\xf7\xdd\x12\x9b{\xaa\xa8F\xeab8껈\x92\b\xe0R\r[n\x97>촮\xb8Gz\x91D\x12\xbe6\xddw\x95\xf4\xcd\xdcyr\x01\xa5\x01\xbdB8\xc94(\xf2\x11\xe2\xeb\b\xc1OR\xe3\x1d\xc1\xdb\xc8tN\x94\xdcle\x13˸\x05h \xac\x97\x85Fr\xaf\xac+Ӈ+\xeb&\x94\x91\xac\f\x05?ZW?ٳ\xaf\xeaLY\xcdշ\xec\xc5B\x06M}ig\xe0\xcc\xe5J\xb8\x9e\xb2\x85D=\x98\xc6%I\xb1\xefe\xf7I\x15+\x9an0\xb3\xe7\xaa\xf7\xe1\xf8h5ؔ\x17h\xc0\xb8_\xe0}\\FƬ\xdb\x11\xb4\xf1\x80\x83\xd6p\x81\xc2-{MM.\xf9\f\xd39We\xdeU\x01nt=\xbf\xcb\xd1\x06\x96\x8e\xff\xe0\x14\x7f,+[\xaa\x7fpg7{_\xec\xa7ֱN\x9e\x89\xeb\xbd\xd2q\xa7'\xffy\x90\xa6\xe4:\xe4\x84C`j\x1a\xa6\x85\xfc7\xb7\xda\x147\xab\x1f\x99A\xf4\n\x88\x1e\xe9\xd2\x1a\x0e\x93\xcd\f\x0f\xaeh\xb8\xa2ኆ+\x1a\xaeh\xb8\xa2ኆ+\x1a\xaeh\xb8\xa2ኆ+\x1a\xaeh\xb8\xa2\x11\x1e;\xe4\x8c߇\xc3a\xbba$M\x85\xc1\x1eB\x1a\x8d{S\xa4*\x7fnS\x12\x1b\xf4\x9eث\xa4\x910םZ\xba\fo=aA\x9d\x15\xd6:\xc0\xe5uR8+\xec\x10v\b;\x84\x1d\xc2\x0ea\x87\xb0C\xd8!\xec\x10v\b;\x84\x1d\xc2\x0ea\x87\xb0\xc3a\x86\xb1\xb6\xf0| \xcenǝ\xddJ\x84\xad\x0e\x902\x15\x86\xab\x96#K\x84\xa9\xf64L\xb5F\xb6[1<\xf5\x94\xed\xed\xf4\xb0\xd4A.\xb9\xe2pT.N\x1a\xbd8\xe90ܴ\xfa\x8d\x86=\x8a[\x8c\xced\x9d\xb0R\xc2J\t+%\xac\x94\xb0R\xc2J\x9b\f+=\xc9|/\x13NZx\xfa\x13F\xdag墵\xf0\xd1:uݪa\xa3-0\xact\xb8h\xa6:\xc2D\xfbA\xcenU\x00\xaf:\x8a\x94\x98\x1ct\x7f\x8c\xbeh.F\xc6\xea\n\xf91\x1a\x8e.-\xfb\xb8\x11\xadnH\xa9Z\x9b\xb7\xf4w*k\xd8U2S[?\\&S\x82\xd6\xe6֛\xaa\x96-\xb8'[\xb0\xa8\xfa\v\xe7\xf0\x15\x86\xfeJ4\x9bc\xbf\xcb\xf8\xa4ǣ\xe9\x8d\xcf\xd4\xd21g\x8f\xbaZ\x02\xb1\x8fI$Na\xed\xd1P\xb5},Gr\xf2\xb7\xf6\x04\xb2\x99÷\x1e\x81\xafH؏\b\xfc\x896\xf7\xb9\xb2\x8f\xf1ݏ\x95\xd5\a\v\xbc\xa6eP\xd5\xf8>\xb6\x10\xeaS\xfe\xa2\x9a\xd0\xf7\xfa\xa2\xef\x19\nzX\xe1\xd3߹\x18\x8dό\b\xfe\x15'+\x7fc\x12\x8f\x93\xb4\xbfHB\xf0\xb7\xb8,\x7f\x8b\x93U\xc2sW\x04:aO\xd6\x1b\xd72\\\xcb\\\xb6\x8e\xa8\xf6.\xe7~\xbb\b\x9c\xd2\xe9;?\x85E|r\xc8ߙ\xb7\xb3eǇ |\x82\xf0\t\xc2'\b\x9f |\x82\xf0\t\xc2'\b\x9f |\x82\xf0\t\xc2'\b\x9f |\x82\xf0I\xe0\x99\\\x95&Fs/2xf\x8d\xf8R)<;\xe2$M9\xae\x1e\xc4\x1aͻƤj\x7fnU\x1c\x9bt\x93٫\xa5\x11\xe7\xd4\xddj\xba\xf4J=e]\x9d\x95\xc9s\x88\xab\xec\xa4T\x9e`D0\"\x18\x11\x8c\bF\x04#\x82\x11\xc1\x88`D0\"\x18\x11\x8c\bF\x04#\x82\x11\a\x9a\xcb\xf3$\xacH2\xcf\xe6}\xdfJd\xf3\x1c\"i*\f\x88(I\x97\xc8\xe7\xd9\xd3|\x9eu2ު\x81Ƨ\xecq\xa7g\xf4\x1c\xe6\xb2+\x0e4\xe1\x1e\xa5\xf1{\x94.\x83\x8e\xab\xdfo\xd8\xe3\xb8\xd3\xe8N\xe2\xc9\xecIfO2{\x92̞ٓd\xf6l2\xb3\xe7i\xc6|\x99Ԟ\xc5\n\x00\xa1\xa4\xbd\xd6/\xdaK-R\xa7\xca[9\xc1H\vT+\x93f$S\x1f\x81\xa4=\x81i\x15\xb2ˍ\x95\xad\x91_\xaeB~\xb9\x11\x93\x1f2̑a\xeeH\x869t\xc0\x92: 9\xe6\xc81\x87\xdaצ\xdaW!\xc9\xdch\x15\xbfv\xd2̍\xe9\xdao\\\"r\x9a\x1a\xd8U\xaa9\xae\x01\xbb\xbf\x06\xac\x9an\x0e\x05\xb1\xac\x82xY\t\xe7@\x85\xa0\xc2vtF\x92\xcb5\x94\\.5*\xef=\xff\xf5b\x11\xefk\xad\xaa\x98É\xcc'Z\x96hY\xa2e\x89\x96%Z\x96hY\xa2e\x89\x96%Z\x96hY\xa2eK\x1f/uڎD\xeb\x12\xadK\xb4\xee \xa2u\xf3)\x8f\xfe\xea\xad\xda\xce\xd5;\xcdj\xb7\xfe\xe6\x06:\x9e\xa2\b\xf9\x84'\xa1\x18Q\xdf\xdc\xf9V\x8e\xacT\xc7\xedXA}\x13\x97\xf81,\xf1\x92\t\xd0\xf7\x1d\x92\xbd?:\x8aƴ@\x83\x0e l-\xc9\xef\xbe\xd5\xf1\x94\x89\xeenU4\x94+A\x10\"\b\x11\x84\bB\x04!\x82\x10A\x88 D\x10\"\b\x11\x84\bB\x04!\x82\x10A\x88\x1a$D\xa9\xe7\xc8+\x92\xa1n\x9e\x9a\xee;\x11JF\x05\x12\xd4\xc1ö\x10 \b\x10\x04\b\x02\x04\x01\x82\x00A\x80 @\x10 \b\x10\x04\b\x02\x04\x01\x82\x00A\x804\x01*\xc8!\x80\xebP\xbb\xa0(\t\x82\xcdw\"\xc2i\b\xa7!\x90\x11\xc8\bd\x042\x02\x19\x81\x8c@F #\x90\x11ȨqdtA\x19S Z\x10-\x88\xd6\x05\x11\xadc\x0f\f\x86Ә\xa2Y\xabhێr!A\xb5\xcaR\xada\x13-h\x164\v\x9a\x05͂fA\xb3\xa0Y\xd0,h\x164\v\x9a\xd5{\x9a\x95N;\x9c\xab\x86\x93}\x18\x96\x06K\x83\xa5\xc1\xd2\x0e\xb14\xc2\x06\xdb\xe2f\xd9\x00B\x02\x06\t\x18\x84\x97\xc1\xcb\xe0e\xf02x\x19\xbc\f^\x06/\x83\x97\xc1\xcb\xf0\xfe\x82XA\xac V\x10\xab\x13\x88U\r^_P\xabS\xa9\xd5\xe5zyA\xab\xa0U\xd0*h\x15\xb4\nZ\x05\xad\x82VA\xab\xa0UЪּ\xbb2\xea7^]02\x18\x19\x8c\fFv\x88\x91\x89\xe1\xb6q\xee\xb7\v\x91\xa7\xaan]\x9f¢D\x8f\x84\x90\xe5\x11\xb2\xd4\x00\xe1ؕ\x95\x16X\x19\xac\fV\x06+\x83\x95\xc1\xca`e\xb02X\x19\xac\fV\x86g\x17\xd4\nj\x05\xb5\x82Z\x1d\xa0V5\xb8vA\xaeN'W\x97\xeb\xdc\x05\xb1\x82XA\xac V\x10+\x88\x15\xc4\nb\x05\xb1\x82XA\xac\xda\xf3\xee\xca\xea߸w\x01\xca\x00e\x802@\x99\x06e\xf8q5M\xc3v\xbc\xb7\xde{\xfe\xeb\xc5\"Fd\x01\xde\\\xb01\xd8\x18l\f6\x06\x1b\x83\x8d\xc1\xc6`c\xb01\xd8\x18l\xac96\x06\x05\x82\x02A\x81\xa0@\xa2\"\xfdp[\xc8|\xe4\x8f\xd9C\xd9\xf4a\x87\xecԇq\x1a\xe3C\xa7Ü\x1f\xf6a\x8e\x8cB\x82r~x}\xf7\xf3\xc7\xd4@\xb4\xc8mR5\xeb\x10\xc0S\xe1\xcds\xfe\xac\xe7\xbcoY(\b\xc6\xe4\xf0\xc3\r:: y\xd32O\\\xd4X\xa5\xe4e\x7fpZ\xa0\x7f\x13\xd5\xc4I\xd8\xc4\xc9~\x13Β\x9f\"\xf8W\x95\xfb\x9d\xf4\xac\xe5\x0f\xe0?\xf0\x1f\xf8\x0f\xfc\a\xfe\x03\xff\x81\xff\xc0\x7f\xe0?\xf0\x1f\xf8\x0f\xfc\a\xfe\x03\xff\x81\xff\xea\xc0\x7fs[\xeaX\x1dt\xfe:\xcc\x7f\xde\xea\x02.\xc9\xe5\xeb8\xf6\x89Ǥu\xda\x13\xd7\xdc\vȓ\x96\r\xd8\x0el\a\xb6\x03ہ\xed\xc0v`;\xb0\x1d\xd8\x0el\a\xb6\x03ہ\xed\xc0v`;Ͱ\x9d\xe4\x19òp\xe7\xf2\x9e.<\x81\xeeă\xd2>މ\xab\xee\a\xdf9\xf4j!\x80\a\xc0\x03\xe0\x01\xf0\x00x\x00<\x00\x1e\x00\x0f\x80\a\xc0\x03\xe0\x01\xf0\x00x\x00<\x00\x9eZ\x00OA\x92s\x82\xbbZ\xe1@I\x96\xf3\xdca\x82|@> \x1f\x90\x0f\xc8\a\xe4\x03\xf2\x01\xf9\x80|@> \x1f\x90\a\xc8\x03\xe4a@\xe4\x81\xfc$G\xf3\x93xA\x0eX1\xa7\x99LT\xb7\f\xa5\x12\xf00}(\x8d<B\xb1\x9az\xf3\x98<\x87\xff\xce>eP\xa3\xecȄt \xb1Z\f~ȓ\xd67F\xa7i\xa0\xb2\x1f\xf6+{=S\\\xa5\x81ڪ,D\xbd:\xdaY\x88FU\xdco\xa9\xf9\xbbhq\ve5\x86\a\u0080\x97\xa5\xe9O&\xcdT\xd43\x98$L\x12&\t\x93\x84I\xc2$a\x920I\x98$L\x12&\t\x93\x84I\xc2$a\x92\xa52o\xab\x17ʶAe\x0ebΌ\x84A\f$y\xf2\x05=hZ\xd9\xc9\xce|\xfe\\\xe8k\xa7w\xcb,\x01\x180\x85R\xbdi\xc0\x1b\xaehw\xfad4q\xad\xcd\xff\xa6\xb5\xe2\xa9\xfa\x97\xac\xaf\x89e\xbdS\xff\x8d\xfe\xbe\xb4e\xba\xe5\x7fr\xec.\xb6\xa2!\xf97\xe1x\xcfB{Y\xf6㯎u\x15\xb7\xf8*%\x19\xba\xc4:vӸ\xbd1ۚF-\x17QTM֍Ur\xbd\x0e\xa2\x86\x85:\x9bX\x1a\xbeV\xb6\xb4\xeel\xf6\xc8L\x1b\xd5O\xbb\xbf\xa8\xa9\xbc'>8\xad\xdd\xd1ls\xd7\xe3zaϜ1-I\xdd!.l\xfawaS\xe9\neۓ\x1b\x14{T\xb7&\x9d\xaf\x8d\x03J\xe7[\xdd\xd8\x0fz\xfc\xb5\xeeY\xca8I\xdb\x1bbe\x05\xc6V\x90\x83\xe8\xde3&|dg'w8!ژX\x9f\xf5\x99\xa5Q\xfeT\xe9\xed\xab\x9b\x95\x18)\x1bW\x1bl\xba>e\xdcDW\x17\x86\xb9\x99\xdb\x00\xb1\x0eByq\x97Kg\xee\xca_\x16O\xe1\xedG\xfa\xa2g\xe5.\xaeC\xfbB[\xb7փ\xafvc\x994כ\xc7\x16U\xb21\xab\xde\xc6@EQǬ]l\xab_F\x1d\n+\xb9O\x9d\xa0\xae\xfa\x81n\xe7R\xce\xeb\xdc6&G\xacnʝnI\x8d\x16\xd6[G\x14O5B\xf3W\xd6Z\x99\xacN\f\x15\xef|om?詺\xf3d!<]\x9b\xd12\x18-\xea\xf5<.@\xcd\xe7\xcb\xc9\x1fĄJ\xf4\"\xf9\xd4Y)\xfc\x95\xa0l\x99a\x7f-֜\xea{\x88\xeco\xef\xedE\xe0\x98\x91\xff\xf2\xc2|\xfc\xe5\x85T\xb4\xd2\xd4ݏ+\x13\x1bN\xf3\xa6[c\xbb\xcd\x13\xe2`\x8a\xbf\n\x92\x1f\x05\xda\xf2\x9dX\xef\\\x8d;SM\x97i\xdc\xeb\x9b\x06\x8a\xe6\xc2\xf0ښn7z\x96\xa6\xde\xe615\x03\xa6ao\xa3.\x05\xf5\xd0\x1a\xdd:e\xa5<z\xdf-\xb1\xb9\xa7\x8aj\xa4.\x86\xa3\xbe\x8b(\x89\x00.հ\xe5v\xe9\xc3N\xeb\x8a{\xa4\x17I$\xe1k\xd3}WI\xdf̝'\x17P\x1a\xd0+\x84\x93L\x83\"\x1f!\xbe\x8e\x10\xfc$5\xde\x11\xbc\x8dL\xe7D\xc9\xcdV6\xb1\x8c[\x80\x06\xc2zYh$\xf7ʺ2}\xb8\xb2nB\x19\xc9\xcaP\xf0\xa3u\xf5\x93=\xfb\xaaΔ\xd5\\}\xcb^,d\xd0ԗv\x06\xce\\\xae\x84\xeb)[Hԃi\\\x92\x14\xfb^v\x9fT\xb1\xa2\xe9\x063{\xaez\x1f\x8e\x8fV\x83My\x81\x06\x8c\xfb\x05\xde\xc7ed̺\x1dA\x1b\x0f8h\r\x17(ܲ\xd7\xd4\xe4\x92\xcf0\x9dsU\xe6]\x15\xe0F\xd7\xf3\xbb\x1cm`\xe9\xf8\x0fN\xf1ǲ\xb2\xa5\xfa\awv\xb3\xf7\xc5~j\x1d\xba\x81\x03Q:\xee\xf4\xe4?\x0fҔ\\\x87\x9cp\bLMô\x90\xff\xe6V\x9b\xe2f\xf5#3\x88^\x01\xd1#\x15~\xa3A\xb2\xe9\xd1\xc1\x0f\r?4\xfc\xd0\xf0C\xc3\x0f\r?4\xfc\xd0\xf0C\xc3\x0f\r?4\xfc\xd0\xf0C\xc3\x0f\r?4bc\x87\xfb\x9a\xdb\xe1H\xd8N\xf8HS\x01\xb0\apF\xe3n\x14I\xdd\xcf-\na\x83N\x13\xbbu4\x12ܚ\xad\xa4˘\xd6\xe3+\xe9\xacP\xd6᭫\x93\"X\x01\x86\x00C\x80!\xc0\x10`\b0\x04\x18\x02\f\x01\x86\x00C\x80!\xc0\x10`\b0\x04\x18\x0e2p\xf5\x04\x84H\xbcj\xd3\xdem%\xc2T\x87G\x98\n\xa3SKQ%\x82R{\x1a\x94Z\x1fӭ\x18\x8bz\xc2\xcevz\b\xea\x10\x97[q\xe4)7%\x8dݔt\x18UZ\xf9\x0e\xc3\x1eýEWRN\xe8(\xa1\xa3\x84\x8e\x12:J\xe8(\xa1\xa3M\x86\x8e\x9eb\xb0\x97\x89\x18-:\xfa\t\x14\xed\xb1f\xd1Z|h\x8dJnհ\xd0\xe6\x89U:\x1a4]\x1bA\xa0\xbd\xc0d\xb2\x93\xe8\xbd\xf8 -3߉fq\xdc\xdc,<\x9b ;\xa7\x91\x9d1\x89\xc6\t\x8c'\x96\x0eH\xcf吞Su\xc0s\xd7\x02\xda`?V\x1a:!:\xe1%\xeb\x84r\xf0yOK\x8d\x92Jf\x06\x89K 5Hޞ\x96\x1e\x1e\\\xfdq\xf5\xc7\xd5\x1fW\x7f\\\xfdq\xf5\xc7\xd5\x1fW\x7f\\\xfdq\xf5\xc7\xd5\x1fW\x7f\\\xfdq\xf5'7H\xc2\xc3b\x9b\xb9\x1f\xc9A\xd2&|\xb9\xec \x9d0\x92\xc6҃\x1c@\x1a\xcd߅$\x95?\xb7)\x89Mކ\xecV\xd2L\x8a\x90l-\x9d\xe6\b9\xbe\xa0\xceK\x122\xbc\xe5uZ\x96\x10\xd8!\xec\x10v\b;\x84\x1d\xc2\x0ea\x87\xb0C\xd8!\xec\x10v\b;\x84\x1d\xc2\x0ea\x87\xc3L\x13r\x02L$OH\xe3\xcene\x12\x85\f\x8f2\x15G<\x94\"K\xa4\n\xe9k\xaa\x90\xfa\xd8nՈ\x92\x13\xb6\xb73\x92\x85\fq\xc9\x1d\x88$\xe1\xe2\xa4ɋ\x93.\xe3H*\xdfhأ\xb8\xc5\xe8L\xd6I\x1aB\xd2\x10\x92\x86\x904\x84\xa4!$\ri4i\xc8)\xe6{\xa9\xac!E\xa7?\x81\xa2}V.ڋ\x11\xadQ\u05ed\x1c$\xda<\xc3\xcaD\x89\xa6\xab#L\xb4\x1f\xe4\xecVΙ\x85s$u\x88\xfaJNH\xfc(A\xda'=\x1eMo\x03\xa6\x96\x8e!O\xd4\xd52)CF$\x12\xa7\x80\x9eh\xa8ڦ=\x91\x9c\xfc\xad=\x81l\x86\xf1\xd4#\xf0\x15\xf1\xce\x11\x81?5Eș\xb2\x8f\xe6\u05cf\x95\xd5\a\xf5\xaf\xa6ePU\xf3;\xb6\x10\xeaS\xfa\xa2\x9a\xd0\xf7\xfa\xa2\xefU\xc9\x157N\x8d\xaf\xa5lq\xa3\xb9\xdc\x1b\x95x\x9c\xa4\xfdu\x961\x8e˾N/\xfb*g\x8dC',\xa5\x13^X\xde8\x98 L\xb03\x1dQ\x1d\x81\xb2\xe2U>ᒩ\xe3>\x9a\x12ZN\x14;\x98\xd4q\x99\xe1!\xfc\x93\xf0O\xc2?\t\xff$\xfc\x93\xf0O\xc2?\t\xff$\xfc\x93\xf0O\xc2?\t\xff$\xfc\x93\xf0OR\xc7\xc5H,\xb1\x99{\x91:.c\u0097J\x1d\xd7\r#i*u\xdc!\xa4\xd1\xf8\xa5H\xaa\xf2\xe76%\xb1\xc1K\x91\xbdJ\x1aI\x1d\xb7SK\x97\xa9\xe3NXPg\xa5\x8e\x1b\xe0\xf2:)u\x1c\xec\x10v\b;\x84\x1d\xc2\x0ea\x87\xb0C\xd8!\xec\x10v\b;\x84\x1d\xc2\x0ea\x87\xb0\xc3a\xa6\x8e;\x05&\x92:\xaeqg\xb7\x12\xa9\xe3\x06H\x99\n\xe3\x1fʑ%R\xc7\xf54u\\\x8dl\xb7bt\xc9)\xdb\xdb\xe9\xa9\xe3\x06\xb9\xe4\x8acJ\xb88i\xf4\xe2\xa4\xc3h\x92\xea7\x1a\xf6(n1:\x93uRǑ:\x8e\xd4q\xa4\x8e#u\x1c\xa9\xe3\x9aL\x1dw\x92\xf9^&u\\\xe1\xe9O\xb0h\x9f\x95\x8b\xd6\xc2D\xeb\xd4u\xab\x86\x89\xb6\xc0\xb0\xd2a\xa2\x99\xea\b\x13\xed\a9\xab\x90:n\x9c \x8d\xd4q\x15RǍ\x16\xf4\x90:\x8e\xd4qGRǡ\xf9\x95\xd2\xfcH\x1dG\xea8\xf4\xbd\x16\xf5\xbd\n\xa9\xe3F\xaa\xf1\xb5\x93:n<\x97{\xa3\x12\x8f\x93\xb4\xbf\xaeR\xc7q\xd9\xd7\xede_\xd5\xd4q\xe8\x84\xe5t\xc2\xcbJ\x1d\a\x13\x84\tv\xa7#\xaa\xbd˹\xdf.*\xe4\x8e\xfb\x14\x16A\xf2\xb8\xfc\xe4q\xd9\xf1!\x02\x94\bP\"@\x89\x00%\x02\x94\bP\"@\x89\x00%\x02\x94\bP\"@\x89\x00%\x02\x94\bP\xb2\xc7%W\xa5\x89\xd1܋\xf4qY#\xbeT\xfe\xb8\x8e8IS\t\xe4\x0eb\x8d\xe6]cR\xb5?\xb7*\x8eM\xba\xc9\xec\xd5\xd2H\x12\xb9\xddj\xba\xcc\"wʺ:+\x8d\xdc\x10W\xd9Iy\xe4\xc0\x88`D0\"\x18\x11\x8c\bF\x04#\x82\x11\xc1\x88`D0\"\x18\x11\x8c\bF\x04#\x0e4\x91\xdcIX\x91Lr\xcd\xfb\xbe\x95H%7D\xd2T\x18\x10Q\x92.\x91L\xae\xa7\xc9\xe4\xead\xbcU\x03\x8dO\xd9\xe3NO'7\xcceW\x1ch\xc2=J\xe3\xf7(]\x06\x1dW\xbf߰\xc7q\xa7ѝēV\x8e\xb4r\xa4\x95#\xad\x1ci\xe5H+\xd7dZ\xb9ӌ\xf92y\xe5\x8a\x15\x00BI{\xad_\xb4\x97Z\xa4N\x95\xb7r\x82\x91\x16\xa8V&\xcdH\xa6>\x02I{\x02\xd3*d\x97\x1b+[#\xbf\\\x85\xfcr#&?d\x98#\xc3ܑ\fs\xe8\x80%u@ȓc\x0e\xb5\xafM\xb5\xafB\x92\xb9\xd1*~\xed\xa4\x99\x1bӵ߸D\xe445\xb0\xabTs\\\x03v\x7f\rX5\xdd\x1c\nbY\x05\xf1\xb2\x12\u0381\nA\x85\xed茩\x04\xc4%s\xcbu\x93T\xb6\xe7\xa9\xe5\x92Ay\xef\xf9\xaf\x17\x8bxSk3\x8dq/\xa2\xf2[\xc8WI\xa0,\x81\xb2\x04\xca\x12(K\xa0,\x81\xb2\x04\xca\x12(K\xa0,\x81\xb2\x97\x1c([\xa7\xd9H\xa0.\x81\xba\x04\xea\x0e\"P7\x1f\xf0\xf0z@C\x88'5*\xad3\x9eA\xa6^\x84\xf2@y\xa0<P\x1e(\x0f\x94\a\xca\x03\xe5\x81\xf2@y\xa0<P\x1e(\x0f\x94\a\xcaS\x8d\xf2\xe8\xafު\xed\xdcW\x81\xe8\xbe2D\x03\x9d0\xa3\b\xf9\x84'\xa1\x18Q\xdf\xdc\xf9V\x8e\xacT\xc7\xedXA}\x13\x97\xf81,\xf1\x92\t\xd0\xf7\x1dW\xc5\xfd\xd1Q4\xa6\x05\x1at\xc0GQK\xf2;\xa5sV\xa6B\xba\xbbU\xd1P\xae\x04A\x88 D\x10\"\b\x11\x84\bB\x04!\x82\x10A\x88 D\x10\"\b\x11\x84\bB\x04!j\x90\x10\xcdm\xa9iu\xd0\x17\xe840\xf4V\x17t\xe1\x1eA\xbb<(\x1e\x140\xd0.\x06J\xcb\v\xf4\a\xfa\x03\xfd\x81\xfe@\x7f\xa0?\xd0\x1f\xe8\x0f\xf4\a\xfa\x03\xfd\x81\xfe@\x7f\xa0?П&鏳^xOK\xfd\x86HE\xfc\x13\x97\x04\xffI\xf1\x9fxT\x00@{\x00(-1\x10 \b\x10\x04\b\x02\x04\x01\x82\x00A\x80 @\x10 \b\x10\x04\b\x02\x04\x01\x82\x00A\x80\x1a$@\x05O\x84\x108\xd6.(Jr\xdc燐\x112F\xc8\x18\xc8\bd\x042\x02\x19\x81\x8c@F #\x90\x11\xc8\bd\xd482\xba\xa0\a\x91 Z\x10-\x88\xd6\x05\x11\xad\xf0\xed\xdb#`+M\xb3VѶ\x1de\u0086j\x95\xa5Z\xc3&Z\xd0,h\x164\v\x9a\x05͂fA\xb3\xa0Y\xd0,h\x164\xab\xf74+\xfd\xaax\xae\x1a\xce\xe3\xe2\xb04X\x1a,\r\x96v\x88\xa5\x914\xaa\x1dj\x96I\x1fE\xba(\xd2E\xc1\xca`e\xb02X\x19\xac\fV\x06+\x83\x95\xc1\xca`ex~A\xab\xa0U\xd0*h\xd5QZU\x83\xc3\x17\xc4\xea4bu\xb9\xee]\x90*H\x15\xa4\nR\x05\xa9\x82TA\xaa U\x90*H\x15\xa4\xaa-\xaf\xae\xb4\xf6\x8d3\x17x\f<\x06\x1e\x03\x8f\x1d\xc4c\xe4\x80o\v\x8ee\xb2\xc1\x93\xfd\x9d\xec\xef`20\x19\x98\fL\x06&\x03\x93\x81\xc9\xc0d`20\x19\x0e]\x10+\x88\x15\xc4\nbu\x02\xb1\xaaã\vju\"\xb5\xba`\x9f.h\x15\xb4\nZ\x05\xad\x82VA\xab\xa0U\xd0*h\x15\xb4\nZ՚SWZ\xfdƫ\vF\x06#\x83\x91\xc1\xc8\x0e12\xdf\xd1\xf4\xa8\x86\x1c]\x1fMI\x84<\x16\xf0\xb1d|\xf0\xea\xca\xc8\n\x9c\fN\x06'\x83\x93\xc1\xc9\xe0dp28\x19\x9c\fN\x06'ë\vb\x05\xb1\x82XA\xac\x8a\x89U\r^]P\xabS\xa9\xd5\xe5zuA\xab\xa0U\xd0*h\x15\xb4\nZ\x05\xad\x82VA\xab\xa0UЪּ\xba2\xea7^]02\x18\x19\x8c\fFv\x88\x91\x89\xe1\xb6q\uedcb\x1aܺ>\x85EA\xc8\n\bYj\x80p\xec\xcaJ\v\xac\fV\x06+\x83\x95\xc1\xca`e\xb02X\x19\xac\fV\x06+ó\vj\x05\xb5\x82ZA\xad\x0eP\xab\x1a\\\xbb W\xa7\x93\xab\xcbu\xee\x82XA\xac V\x10+\x88\x15\xc4\nb\x05\xb1\x82XA\xac V\xedywe\xf5oܻ\x00e\x802@\x19\xa0L\x832\xb2s5\xcb²9\xb9\xde{\xfe\xeb\xc5\"\xe6c\x019\xba\x88z\x84\x8b\xc1\xc5\xe0bp1\xb8\x18\\\f.\x06\x17\x83\x8b\xc1\xc5\x1a\xe3b\x10 \b\x10\x04\b\x02\x94\"@D\xf25̀v\xe2\xf7\x80@xGA\x81\xa0@P (\x10\x14\b\n\x04\x05\x82\x02A\x81\xa0@P (\x10\x14\b\n\xd4\x02\x05\xda*q\u0604\x05jh\xe0z\xb7\x85\xf0G\xfe(\x83)=\\\xc6s`[ƚ\xcfR\x9e\xfa\x90Nc\xac\xe8\x18\xd8Ɍ\xcc>ڑ\xa1x\x9d\xf9\xca뻟\xff\x18\x8eD\x8b\x10'\xae\xf5T\x84\xf3|d\ueffd<<\xfd\x19\xe5\xcc\xc8\xf2\xc8\xe7\xfe\xef\xdf^\x9e0\xfd\x9f_\xcaT|L\rI\xbbB\x10լӂ\xd5(\v\x1b\ufaf3RD\xca\xf9\xae;\"\x9aR\x8e`\x18-S\xf6\x82_\xd5\xd7?꯷\xc9}\xabϧ\xe9\xc1\xee\x94f\xbb\xb3\x03\x1f\xc3\rv\xea\xcdcM(\xfcw\xd6A\xfb\x8c9\x9e\xec̃\xccm\xba\t\xcf\xcf\x7f\xab_\xae\x8e\xd6y-\xb5\xe4\xc8\xd2\x1bcY\xd4^\xd5\x0f\xfbU\xbd\x9e)\xb6Y{]U\x88\xb7^\a\xe7\xc1\xee\xbc%\x96\xd0ﬨ\xa5\xe9w-\xe0\xbbNE\xffoG\xb7\r\x05\xec9G\xf6\xf7\x1d=.'\x1d&\xfa\x9bc<Q\x8ch\x8c\xf3X)\x98\xde\xfc\xb3E\x7f\xb9\xfb\x03F7\xa3\x8bS&\xaf\xe2&\x8f\x9a\xa2\xfa\x1a;o\xf2+\x1cȡcD\xb9\xdf'\x8f\x1a/\xec֜M)\x1e\x98£&\xfe\xc6 \xadֽ\x89\xc7hݙ\xf8C6k\xfc\xa5᛬y\x82P\x90\xf0i\xe1\xcd\xecE\xb0\xd5Xޖ\xfd=\bNW?~Q\xbf\xfdd~\xfbZ\xff\xb6\x97\xba\xc8\xe1\xd9O\xb4\x90\x94\x00$\t\x9a\x0e\xf4\xb1%\xb5$\x99L\x91\x8f\xc2\xe64\xa7\x9d\x9cZ\x7f\xcdJ\xca9\xd5֩\xab\x9c^o\xfb*\xcb\xee\xbaN4\x96\x03bZ\xbb\xcd|A1\xfc\x7f;\xba\xad\x06\xce\xe2\xbe\xe4\xf6\xf9I~:\xcaݳ\xb8c]l\x99E\xadii\xc7,\xae\xbe\xc9\r\xf3p\xad\x8d헇\xaa\xed\xd3vY,\xa1\x03\"\x8cG\xb6#\x7f\xbbp\xca\xedF\x1f\xd5/G\xb8\x19e\xfb\xd5\xf1^\x94nL\xfb[Q\xb6\xf6\x96v\xa2\xfdJ\xdb؈vk\xed\xe9>\x94\x15ΡoC%5\xa2QjC\xfdф:Ԃ\xda׀:\xd0~\x86\xa0\xf9\x8cP\xeb\xe1Z5w\x7f:|\xab\x9aڡFq\xa9Z(\x17\x80ϓ\xee`w塯\b\xd4\\\x1fv\xccA\x8f6\xa2\xc1\x93\xed\xa4\xba\x9b:\xe3N\xa8|\x80X\xb4\xbe[]\xd8\xe8\xee\xf6{Q\x80\xf4\xec]\xb6W\xa8\xd4,\xeeny\xe9\xb164\xbe\xb1vCN\x8f\xd7=<|:H_\x99\x9cmk\xec \xb5ʦ\xd59R\xdd[8\xeds\xd5#Mhs\xc7j\x8f\xb0\x1e\xadzp\x98u\xd8\xdb\xd5%\x00\xd7\xf37\xaa\x9eiV]kU\x1diT]iS\xa3\xc0\xb1\x03ؖ\xbc@\xacl\xa9\x11Gc\xb3iE\xe3Q\xc4^\xa3χ\xe8d\x1c\xcf5\xbeř\xb9>\xe0Y\x1c}e\xf0~\xc5\xe9\xb9\xd7_\xf1V\x1b{\xb1\xf6\xe6\xd1gzk*\x90\t\x93\x96\xc9\x0f\x93XDID\xa2\x87b\xff\x14\x17w\xe7\xcd_\xc7ō<I\xe2\x11\xf1QC\x96\x91\x9f\x82Qj!_\xe2$\xd5V%O\x05-9K\xb6\x8a\xce\xd8\x12\t\x13S{n|\xb4\x16\x8bT\xed\xb7\x9d\xa4O$}\"\xe9\x13I\x9fH\xfaD\xd2'\x92>\x91\xf4\x89\xa4O$}\"\xe9\x13I\x9fH\xfaD\xd2'^h\xfa\xc44+*\xf0\xc0\x04!u\x80\x90\x12\xb7\xce\xe2\xf1\x02\x87\x80C\xc0!\xe0\x10p\b8\x04\x1c\x02\x0e\x01\x87\x80C\xc0!\xe0\bp\x048b@8\x02/\x883\xbd \x0e\xfb\n\xf7\x80\xafT\x84!\xb1\xa3p%\x1cҐ\xbf\xf0\tr՜\xb3\xf0i\x95\xd7\xeb)|z\x9d5\xba\t\x9fZi\xeb>\u0095V\xae\xd14\xf7\x1bl\xfe.J\xe0B\x19\x9d\xe1y2\xfcul\xba\x95Y\xc7o\xe2.\x028\x01\x9c\x00N\x00'\x80\x13\xc0\t\xe0\x04p\x028\x01\x9c\x00N\x00'\x80\x13\xc0\t\xe0,\x1fA\xf8ico\xb6Ae8bΌ\x84H\f+\xd0\xebR\xd3{U\xf0\xe63_z.t\xea\xd3{g\x96\a\f\x9fP\xa9N5\xe7vW\xb4s}2Z\xba\xd6\xf4\x7f\xd3\x1a\xf3T\xfdK\xd6\xdeĲީ\xffF\x7f_\xda\"\x03\xf2?9\x92\x17[ў\xfc\x9bp\xf4g\xa1--{\xf5WǺ\x8a\x9b}\x95\x12\x17]b\x1d;m\xdcޘ{M\xa3\x96\x8b|\xaa&\xeb\xc6*a_\aQ\xc3B}N\xac\x10_+bZ\xaf6\xfbg\xa6\x8dꧽ\xb9\x11\xaa\xbcm\xaa\xa5\xd3\xeeN\xb9\xcd]\xab\xeb\x85=sF\xb8\\u\xbf\xb8\x19\x1a\xd6\xcdP\xa5K\x9am\xbf\xeeh\xecq\xde\xcb\xf4c\x15\x1dPk\xdf\xea6\x7fг\xa1\xb5\xdbR\xe6Oڢ\x11;.0ֈ\x1cg\xf7\x9e\x81\x04\x91%\x9f\xdc\x12\x85\xf0db}\xd6'\x9f\xbe,\x98*\xcb`u\xb3\x123h\xe3j\x93Pק̧\xe8r\xc4P=s\xdf \xf6G(=\xeer\xe9\xcc]\xf9\xcb\xe2)\xbc_I_%\xad\xdc\xc5uh\xc1h\xfb\xd9z\xf0\xd5..s\xe7z\xf3\xd8fK6t\xd5\xdb\x18\xd9(\xae\x99\xb5\xbcm\xf5˨Ca%\xf7\xa9s\xd8U?\xd0\xed\\ʩ\x9f\xdb\xc6\xe4\xa0\xd6M\xb9\xd3-\xa9ц{\xeb\x88N\xabFh\xfe\xcaZ+\xa3؉\xb1\xe5\x9d\xef\xad\xed\a=Uw\x9e\xac\x88\xa7k3Z\x06\xd4E\xbd\x9e\xc7\x05\xa8\xf9|9\xf9\x83\x18i\x89v%\x9f:+\x05\xd8\x12X.3\xec\xaf\xc5^T}\x0f/\x05n\xef\xedE\xe0\x98\x91\xff\xf2\xc2|\xfc\xe5\x85T\xb4\xd2\\ߏ+\x13+Q\x13\xad[c\x1d\xce\x13\xa6a\x8a\xbf\n\x92\x1f\x05ڶ\x9eX\xef\\\rTSM\x97i\xdc\xeb\x9bF\x96\xe6J\xf2ښn7z\x96\xa6\xde\xe615\x03\xa6ao\xa3.\x05\xf5\xf0 \xdd:e\x00=z\xdf-\xb1꧊\x9b\xa4.\xa2\xa3\xbe\x8b(\xa9\xb4Pj\xd8r\xbb\xf4a\xa7u\xc5=ҋ$\x92\xf0\xb5龫\xa4o\xe6Γ+.}\x05\xa0 Q2\r\x81NN\xa5?\x8d \xff$5\xde\x11\x1e\x8e\x8c\xf3DU\xceV6\xb1\x8cS\x82F\xcezYh\xe8\xf7ʺ2}\xb8\xb2nB\x19\xc9\xcaP\xf0\xa3u\xf5\x93=\xfb\xaa\x0e\x9a\xd5\\}\xcb^,d\xd0ԗv\x06\xce\\߄\xeb)[Hԃi\\\x92\x14\xfb^v\x9fT\xb1\xa2/˦;W\xbd\x0f\xc7G+Ӧ\xbc@#\xcc\xfd\x02\xef\xe322\x16㎠\x8d\aM\xb4\r$\x14\xd7\xd9kqr\x9bh\xe0Q)\x8d{W/\xb8ѕ\xfd.GEX:\xfe\x83S\xfc\xb1\xacriÃ;\xbb\xd9\xfbb_U\x11\xdd\xc4ai\"wZ\x14\x9e\x87l\xa5\xaeCJ9 \xa2\xa7Q^\b\xa1\x8b\xabM\xa1\xbb\xfa\xa9\x1dP\xb1,T\x94\x9dI\xef\xfc\a٢\xf9N4ǗM\x19Ó\x12\x0eV\x0f\a\x1b\xb3h\x95 b\xb1t\xc1\xc5\xe0buk\xc0\xd5\xd6\x1a\xbap_W2\x1a1\x1a1\x1a\xf1\xbeF\xac=>\xceϑ\x13z\x15\xae\xe6\xee7w\xbe\xb5\x17i'\x16;v\xf6%WN\xce>\xf9}o\x9f<\x10\xdf\xd7B\xce\xe5\x03;\xa3\xf6Qz\xa7\xbcy+\xefP\xbaפ\\&\x04\x87\x10\x1cBp\b\xc1!\x04\x87\x10\x1cBp\b\xc1!\x04\x87\x10\x1cR.\x13\x02D\b\x10!@\x03L\xb9l\xe8Q]\x89\x97\x81J5B\xa5\xa3\xf0\xfd\x17\x03c\xc0I\xe0$p\x128\t\x9c\x04N\x02'\x81\x93\xc0I\xe0$p\x128\xa9\x15\x9ctA\x1e\x18\xd0.h\x17\xb4\xeb\xf2hױ\xc4$\xe1l\xa6H\xd7*ڽyr\xac^\xe25l\xda\x05\xe9\x82tA\xba ]\x90.H\x17\xa4\v\xd2\x05\xe9\x82tA\xba\x86B\xba\bw\x02\xb6\x01ۀm\xc0\xb6\x93`\xdb\x0fS\xd1%_\xde\x16R3\xf9cV{1]9\x12\x8e])6\xba\xfb\xa0k3(\xfb(L\x06#\r\xc2\xcc\xd7^\xdf\xfd\xfc15,-\x82\xafT\xcdg=Q\xf8|P\x14\xce\xf6&4V\x9b\x1f\xee\xe0\x91\x8e\x01S=&Mj\xdc\xf6ũ`\xbcZ\x88O\xcd\xc6Л\u058c\xe6I\xccp\x12 \xae\x10W\x88+\xc4\x15\xe2\nq\x85\xb8B\\!\xae\x10W\x88+\xa1\xaa\xf0Dx\"<\xb1>\x9eXW\xa4*l\xa9N\xb6T\xeb\xabe\xd0\x11\xe8\bt\x04:\x02\x1d\x81\x8e@G\xa0#\xd0\x11\xe8\bt\x04:\x01\x9d\x80NtO'p\x8f(\xed\x1e\xe1\x059 Ɯr\xc3{ֶ\b\x90\x98\xfeԂH\x1a\x7f\x0e簬\xb5\xf1\xa0\xc6\xd1\x164\xf50\xceI\x15\xff\xb0_\xb1y\xba\xb3\xe1\x9a+=$\xe2u\xb2\xb8\x8f\xbcZ\x9dzе\x1f\t\x00\xebX\xea\xfb\xcfX\x9bo\xbe\x89;\v\x17\x85\x8b\xc2E\xe1\xa2pQ\xb8(\\\x14.\n\x17\x85\x8b\xc2E\xe1\xa2pQ\xb8(\\\xb4|\x80b\xf8\xeclUVbΌ\x84M\f0f\x8c\x17P\xcb9\x06\x1eK\xea\xa7w\xd1A?\xd6^D\xadT\xcf\x1a\xf6\xe0+\xda\xcd>\x19\xcd]k\xff\xbfi-z\xaa\xfe%\xebqbY\xef\xd4\x7f\xa3\xbf/m\x11\t\xf9\x9f\x1cӋ\xadhT\xfeM8\x0f\xb3о\x96\xfd\xfb\xabc]\xc5;JI\x8f.\xb1\x8e\xdd7no\xcc¦Q\xcbE\\U\x93uc\x95쯃\xa8a\xa1\x8e'\x96\x89\xaf\x953\xadk\x9b=5\xd3F\xf5\xd3~].U\xdeO\xd5J\xea`\v\xdd\xe6\xae\xdf\xf5\u009e9c]ºs\\2\r\xff\x92\xa9\xd2U϶\x877=\xf6\xd8ow\xfa\xb1\xc6\x0e(\xc7ou\xc3?\xe8y\xd1:r)#*m\x17\x895\x18\x18\x9bF\x0e\xc0{Ϡ\x86\x88\a$wM!\x82\x99X\x9f\xf5Y\xa9\xaf\x1c\xa6ʾXݬĘڸڰ\xd4\xf5)#,\xbab1l\xd0\xdcZ\x88\x15\x13ʑ\xbb\\:sW\xfe\xb2x\noi\xd2\x17R+wq\x1d\xdaA\xda\n\xb7\x1e|\xb5\xdb\xcb\x04\xba\xde<\xb6\xfc\x92\x8d_\xf56\x06?\x8a\x8ef\xedw[\xfd2\xeaPX\xc9}\xea\xe4v\xd5\x0ft;\x97\xa2'\xe4\xb619\xdauS\xeetKj\xb4\x04\xdf:\xa2\x14\xab\x11\x9a\xbf\xb2\xd6ʴvb\xf8y\xe7{k\xfbAO՝'k\xe3\xe9ڌ\x96\xc1}Q\xaf\xe7q\x01j>_N\xfe \xa6^\xa2\x8fɧ\xceJa\xba\x04\xb9\xcb\f\xfbk\xb1:U\xdfë\x85\xdb{{\x118f俼0\x1f\x7fy!\x15\xad\xf4\xed\x80\x1fW&\xb6\xa6\xe6b\xb7\xc6Ɯ'd\xc4\x14\x7f\x15$?\n\xb4\x85>\xb1\u07b9\x1a˦\x9a.Ӹ\xd77\r>\xcd\xc5\xe6\xb55\xddn\xf4,M\xbd\xcdcj\x06L\xc3\xdeF]\n\xea\xa1J\xbauʂz\xf4\xbe[\x0f\xb6?U\xf4%u\xb1\x1d\xf5]DI\x04p\xa9\x86-\xb7K\x1fvZW\xdc#\xbdH\"\t_\x9b\xee\xbbJ\xfaf\xee<\xb9(\xd3\x17\t\n5%Ӡ\bM\x88٣\xab\x82Ij\xbc#\xc8\x1c\x99\xf8\x89r\x9d\xadlb\x19w\a\r\xae\xf5\xb2\xd0\xe8\xf0\x95ue\xfape݄2\x92\x95\xa1\xe0G\xeb\xea'{\xf6U\x9d;\xab\xb9\xfa\x96\xbdXȠ\xa9/\xed\f\x9c\xb9\x04\n\xd7S\xb6\x90\xa8\aӸ$)\xf6\xbd\xec>\xa9bEÖMw\xaez\x1f\x8e\x8fV\xbfMy\x81\x06\xa1\xfb\x05\xde\xc7edL\xce\x1dA\x1b\x0f\xe0\xe8\x04k(D\xb4\xd7\xec\xe4b\xd2p\xa8R\xea\xf9\xae\x9ap\xa3+\xfb]\x8eưt\xfc\a\xa7\xf8cY\xea҆\awv\xb3\xf7\xc5^k&\xeb\xdd\xc4\xf9\x03QL\xee\xb4P<\x0f\xde\xcc]\xb7\x99̿FVH\x06ӡ\xe3Jٲ\xf4\xb9p\x90Z\x9a\xefD\x13\r\xbf4\xdd\fOT\b[݄m\xf4\xe2V\x96\xb5\xc5\x12\aq\x83\xb8\xb5\xa1LW[\x89\xa8\xd5%\xd5\xea\x16\xd79\xca5\xca5\xca\xf5Q\xe5\xda<\xf3wvN\xa0\xd0\tr5w\xbf\xb9\xf3\xad\xbdȼ\xe9\x17\xfb&\x93\x1b\xa8h\x03\xfd\x9e\xbf\x81\x1e\bel!\xf9t\x8b\xef\xfb\x91v\x9a\x00\"\x02\x88\b \"\x80\x88\x00\"\x02\x88\b \"\x80\x88\x00\"\x02\x88H;M\x00\x13\x01L\x040\r:\xed\xb4!Ju%\x9f\x0645\x02\x9a\x8e\x92\xfa_\f\xa0\x011\x81\x98@L &\x10\x13\x88\t\xc4\x04b\x021\x81\x98@L \xa6\xf6\x10\xd3\x05yj@\xc0 `\x10\xb0\v%`ǲ\xac\x84S\x9a\xa2_\xabh\v\xe7)\xb6\xa6(ذ\t\x18\xf4\v\xfa\x05\xfd\x82~A\xbf\xa0_\xd0/\xe8\x17\xf4\v\xfa\x05\xfd\x1a\x14\xfd\"T\n\x00\a\x80\x03\xc0\x01\xe0\x8a\x00\xdcTöBl\xf6\xa0\xd3{\xa9$c\xf1\x98ۖA\x1e\x87c\xba+\x05Xw\x12\xb9=\x8dpY\x96}\xc9\b\xfc\xa4\xf1\xd7\xdd\xcf\x7f\f\xfb\xdd\"ӊk=\x95h=\xef\xcc췗\x87'7\xa3\x8c\x1a\xc9\x1c\xe7\xcc\xfe\xfd\xdb\xcb\xe2\xc9\xfd\xfcR\x06\xfacj\x00ڝ\xe2\xa8\xe6\xb3\xde\xdc̙\xe9\xff\xf2\xa6Ů\xa0ƞ\xf6ó5\xd2\xfe\"\xf8\xfd?\xbc\xe9\xb8Aw\xb1\x10\xa8\x81\t\xa5@F\xa1\x85@\xe1\x89n\x8b\x9a{\xa9\xaf\xa3wV\xa7\xe10\x87\xa0\xdaL\x7f\nJã\xe1\xd1\xf0hx4<\x1a\x1e\r\x8f\x86Gã\xe1\xd1\xf0h\x02~\xa1\xad\xd0Vhk5\xda\xfa\xad0)3\x00\xa7\"\xc0I\\\x12\xcdx\x000\x00\x18\x00\f\x00\x06\x00\x03\x80\x01\xc0\x00`\x000\x00\x18\x00\f\x00\x02\x00\x01\x800 \x80p\xd1>\b^\x90\x03C\xcc9%S\xd02\xf7(\r)L\x83O\xc3\x14M\xbcۓ\x9e\xef\x86\x1e\xefة\xa2ƗwrJ\xfea\xbfd\xf3\x84hբ+=)\xe25\xb3\x06\x8e\xbc\x84\x9dz\x1aV\xb6\xa2\xa1\xac\b\xd3\xf8pE\xbc\x89\xbb\x00\xc2\x03\xe1\x81\xf0@x <\x10\x1e\b\x0f\x84\a\xc2\x03\xe1\x81\xf0@x <\x10\x1e\bﴘ\xb2\xf0\xa1ت8Ü\x19\t]\xe8c\x88\xd1E>Ez\x8a\x1fٱ\x8cxz\x1f\xcc\xda\xf6C!G\xaa\xe9U\x1d\xbe\x8av\x94OF{\xd6\x1a\xf8oZ\x93\x9d\xaa\x7fɚ\x98X\xd6;\xf5\xdf\xe8\xefK[fM\xfe'G\xe5b+Z\x8d\x7f\x13\x8e\xe4,\xb4qe\x0f\xfd\xeaXWq\x13\xafR\x13\xacK\xacc\a\x8c\xdb\x1b\xf3\xa8i\xd4r\x91(\xd5d\xddX%\x9e\xeb jX\xa8g\x89u\xe0k\x05I\xeb\xbbf_˴Q\xfd\xb4廈ʛ\x96\x12\xf6&\xf6\xa9m\xee\x1aZ/\xec\x993\xd8e\xa4[ϝD=w\x12\x95.\x0e\xb6]\xdc\x1b\xd8ü+h_R\x0f\xa8Zou\xcb>\xe8\x91\xd5\x1aW)\x95<\xade\x8bm\x11\x18\rY\xb6\xf2{\xcf\x18\xae\x91u\x99\xdc\\\x84\x06\xfd\xc4\xfa\xacw}\r\xb0\xa7J[]ݬD5߸\xdaL\xd1\xf5)\x95>\x02\xf6\x864\x19\x06.:q(\t\xeer\xe9\xcc]\xf9\xcb\xe2)d\xfe\xe9덕\xbb\xb8\x0e\xb5jm\xd3Y\x0f\xbe\xda\xf5d\x86\\o\x1e\xdb\x11\xc9\x06\xa8z\x1bc\x04\xc5ڲ֠\xad~\x19u(\xac\xe4>u\x06\xb9\xea\a\xba\x9dK9\xf1rۘ\x1cR\xba)w\xba%5\xda\x15o\x1d\xd1\xc0\xd4\b\xcd_Yke\xa891J\xbb\xf3\xbd\xb5\xfd\xa0\xa7\xea\xce\x13\x89\x7f\xba6\xa3e\xe0Q\xd4\xeby\\\x80\x9aϗ\x93?\x88\xe1\x90h\x16\xf2\xa9\xb3R\xd0'\x01\xb82\xc3\xfeZl\x18\xd5\xf7\x10T\xdf\xdeۋ\xc01#\xff\xe5\x85\xf9\xf8\xcb\v\xa9h\xa5Y\xb3\x1fW&\x96\x8b\xa6,\xb7\xc6b\x99'v\xb6)\xfe*H~\x14h{ob\xbds5\xe4K5]\xa6q\xafo\x1a\xa3\x99k\xb2kk\xba\xdd\xe8Y\x9az\x9b\xc7\xd4\f\x98\x86\xbd\x8d\xba\x14\xd4\xc3(t딺\xfe\xe8}\xb7\xc4Ҝ*[>u\xd1\x19\xf5]DI\xa5\xa7QÖۥ\x0f;\xad+\xee\x91^$\x91\x84\xafM\xf7]%}3w\x9e\\\xbbh,\xad\xc0E2\r\x81N\x92\xa3?\x8d\xc0\xf3$5\xde\x11\xb2\x8c\f\xc6DM\xccV6\xb1\xcc\x05\xb6ƠzYh\x10\xf5ʺ2}\xb8\xb2nB\x19\xc9\xcaP\xf0\xa3u\xf5\x93=\xfb\xaa\x8e\x86\xd5\\}K\xecs\x194\xf5\xa5\x9d\x813W\n\xe1z\xca\x16\x12\xf5`\x1a\x97$ž\x97\xdd'U\xac\xe8\x8a\xc1̞\xabއ\xe3\xa3\x15IS^\xa0\xb1\xda~\x81\xf7q\x19\x19\xfbfG\xd0\xc6c.7c$\xabo\xed\xb5+\xb9\xc72\xd8\xe2\xa8\x1e\xba{\x92\xdf\xe8\x82\x7f\x97s\xa8/\x1d\xff\xc1)\xfeX֭\xd4\xf7\xe0\xcen\xf6\xbe\xd8?\xe5A7\xae\x8f\xbaÝ\x9e\xd6\xe7\xfe\xdbT\xeb\xf3\xf3\xa1\xb7C\x7f\xd2\t\x1eM\x15$s\xec\x106ɾ\xa0w҃\xcc\xc9|'\x9a\xb5\xf1Ч\xf0\x14\x81\x8f\xec\xf3\x91\xe1Oy!)\x89g\x1d^2|^r\xaa\x9eu\xba<\xa3qu\xb5Zллơw\x99\a\xb1\x0e\xe6\x868\xed\x9d\xf7\v\xce\x11\xf1=\xb5#\x85\x11/-d\xfa\xec\xf5{\xed\xa4\x00\xc5\xfd\x1e\xf7{\xdc\xefq\xbf\xc7\xfd\x1e\xf7{\xdc\xefq\xbf\xc7\xfd\x1e\xf7{R\x80\xe2\xfe\x8f\xfb?\xee\xff\x8d\xa7\x00=\xf8\xcc9\xb0\xa7.ؓ\xc1Ͽ\x18H\x02\xe6\x01\xf3\x80y\xc0<`\x1e0\x0f\x98\a\xcc\x03\xe6\x01\xf3\x80y\xc0<5b\x1e\x9e\xfd\x86BA\xa1\xa0P\x83\xa7P\xc7\xd2\b\x84s\x96\"P\xabh\x8f\xe6i\x9ab\x125l\n\x05\x81\x82@A\xa0 P\x10(\b\x14\x04\n\x02\x05\x81\x82@A\xa0\xfaE\xa0\b\xd1\x01x\x01\xbc\x00^\x00\xaf4\xf0\x9a\x8a\xc6\xf8\xf2\xb6\x90f\xc9\x1f\xb3:\x8a\xe9đ\xa0\xdeJ\x11\xb6\x9d\x86\xee\xea\xe1\xd8\xc7V2\f!\xb4\xd2_x}\xf7\xf3\xc7\xd4P\xb4\b\xaeR5\x9f\xf5H\xd5s\xc1\xc4\xcf|oU\xe1\x91\xed7\xf2\U000cb859\x05\xb2\x91zm[\x7f#\x1c\x93\x16\xa2)\xe3\xd8j]\xef$\xac\xb8\x1f\x8f\x97%\x92\x91\x06\x9bf\b\xa1\x9b\xd0M\xe8&t\x13\xba\t݄nB7\xa1\x9b\xd0M\xe8&a\x94\xf0<x\x1e<\xaf\x0e\x9eW\xe0\xbf\x06\xed\xa9\x9d\xf6$Nl\xc9\xe8\xc06`\x1b\xb0\r\xd8\x06l\x03\xb6\x01ۀm\xc06`\x1b\xb0\r\xd8\x02l\x01\xb6p\xd1/4\x0f\xdaa\xc1\vr\x80\x899\xb9dR:`#\xd5@\x86i\xf9\x99(\xa3\xc9G<22\xd1\xf0c\x04\xbbu5\xf0\xacG^\x15?\xecWa\x9e\xb8\xab\xad\x8eJ\x8f+x\r/\xa1#\xaf\xaf\xa6\x1e3\x94\xbdmp\v*\xf3$\xab\xf1\x04\x8b;\x04%\x84\x12B\t\xa1\x84PB(!\x94\x10J\b%\x84\x12B\t\xa1\x84PB(!\x94\xb0\xa3\x87\xe9\x13\xde\xd0\xebH\xa7\x8b~\xeb\xf1$w\xb6c)\xd9\xf4Θ\xb5\xf6\aG\x97R\x8flW\xf7;+\xdau>\x19\r[k\xe9\xbfimw\xaa\xfe%\xebfbY\xef\xd4\x7f\xa3\xbf/m\x99P\xf9\x9f\x1c\xa7\x8b\xadh>\xfeM8\xb6\xb3\xd0\x0e\x96}\xf6\xabc]\xc5ͼJͽ.\xb1\x8e]2no̬\xa6Q\xcbE\xd8T\x93uc\x95䮃\xa8a\xa1.&\x16\x84\xaf\x95(\xad\x13\x9b\xbd/\xd3F\xf5Ӯ\xaeD:\x7f\xd4\xfc\xe8\xa6v\xe8u\xf3\xc1/\xb5\xf4\xe3\xe6\\\x8d\xb4p5\xd2\xe5\xd3\xe7\x15o-\xec1\xdcTt#\xdf\a\x14\xbd\xb7\xba\x89\x1f\xf4Xk}\xaf\x94A\x90\xd6\xf1Ų\t\x8c~.\x87Ľg\xcc\xe6ȶM\xeeMB\x9c0\xb1>\xeb\xf3D\xe3\xf3\xa9ҕW7+1\f6\xae6\x92t}ʠ\x88\xae\v\f\xe72\x04^4\xf2P6\xdc\xe5ҙ\xbb\xf2\x97\xc5Sx㐾\\Y\xb9\x8b\xebP\xa7\xd7\x16\xa5\xf5\xe0\xab\xddS\xa6\xca\xf5\xe6\xb1\x15\x93l\xa4\xaa\xb71\xc4P\xa4/k\x8b\xda\xea\x97Q\x87\xc2J\xeeS\xa7\x9b\xab~\xa0۹\x94\xb34\xb7\x8d\xc9\xf1\xa7\x9br\xa7[R\xa3U\xf3\xd6\x11\xb5O\x8d\xd0\xfc\x95\xb5Vf\xa2\x13\x83\xbc;\xdf[\xdb\x0fz\xaa\xee<\x91\xff\xa7k3Z\x06]E\xbd\x9e\xc7\x05\xa8\xf9|9\xf9\x83\x98-\x89\xce\"\x9f:+\x85\x9c\x12|,3\xec\xafłR}\x0f1\xf9\xed\xbd\xbd\b\x1c3\xf2_^\x98\x8f\xbf\xbc\x90\x8aV\x9at\xfbqeb7i\xc6sk\xec\xa5yb\xe5\x9b⯂\xe4G\x81\xb66'\xd6;W#\xc6T\xd3e\x1a\xf7\xfa\xa6!\x9e\xb9\xa4\xbb\xb6\xa6ۍ\x9e\xa5\xa9\xb7yL̀i\xd8ۨKA=\x84D\xb7N\xd9\b\x8f\xdewK\xecܩ\"\t\xa9\x8bר\xef\"J\"\x80K5l\xb9]\xfa\xb0Ӻ\xe2\x1e\xe9E\x12I\xf8\xdat\xdfU\xd27s\xe7ɥ\x8f\x86\xe2\n\x9b$ӠhC\x88\x8c#\xec=I\x8dw\x04L#s5Q@\xb3\x95M,sŮ!\xac^\x16\x1a\x83\xbd\xb2\xaeL\x1f\xae\xac\x9bPF\xb22\x14\xfch]\xfddϾ\xaaSc5W߲\x17\v\x194\xf5\xa5\x9d\x813\x17\x1a\xe1z\xca\x16\x12\xf5`\x1a\x97$ž\x97\xdd'U\xach\xa1\xc1̞\xabއ\xe3\xa3UTS^\xa0\xa1\xde~\x81\xf7q\x19\x19\xa3jG\xd0\xc6c\xac7l\xa2\xab\xaf\xef50\xb9N3\xf4\xe4$\xc5v\xf7\x90\xbfх\xff.\xe7\xbc_:\xfe\x83S\xfc\xb1,b\xa9\xf3\xc1\x9d\xdd\xec}\xb1\xa7z\xc5:\x95ܻ\x9fjŝ\x9e\xe8\xe7\x01\x19r\xeb\xca\xe9ś\xe6S鼊I]\xe4V\xec\x03\x12\x93MD\xef\xc1\aɘ\xf9N4\x85cfd\xe1\x89\x04\xc59\x85\xe2\x8cH,\x8e\xf3\x9cX2\xa0:\x97AuNU\xf9\xce[\x05(\x7f\xfdXc\xa8\x80\xa8\x80\xa3W\x01\xcdSUGsz\x9c\xf6::\xb9=\xb2\x8fS\xa5S\xb9\xaa\x00\xa5\x16ҹ\x0e\xe9\xd5tһ\x12\xdc@p\x03\xc1\r\x047\x10\xdc@p\x03\xc1\r\x047\x10\xdc@p\x03\xe9]\t\xae \xb8\x82\xe0\x8a\xa6ӻ\x1e|\xa4\x1c \xd4\x1c\x10\xdacڿ\x18\x90\x02\n\x02\x05\x81\x82@A\xa0 P\x10(\b\x14\x04\n\x02\x05\x81\x82@AM\xa0 \x1e\x16\x87TA\xaa U\xe3!U\xc7\xf27\x84\x93\x97\xa2T\xabh\xb3\xe6i\xa2shհI\x15\x94\nJ\x05\xa5\x82RA\xa9\xa0TP*(\x15\x94\nJ\x05\xa5\xea)\xa5\"$\b0\x06\x18\x03\x8c\x01\xc6v\xc0\xd8\x0f\xf6b\xfdh\xbf\xbc-\xa4]\xf2Ǭ\xa2bzq$ҸR\xd8o\x97\xf1\xc4\xe1x\xec\xe3,\x19\a\x03\xb3\xc2o\xbc\xbe\xfb\xf9cj0Z\x04Y\xa9\x9a\xcfz\xab\xec\xb9h\xeey\x87\xfdd\xd8Y(\x1e\xc9C\xec\xe1W\xc2Qi!P3\x8a\xf3\x0e+\x1e\xc0;v\xd1(B:!\x9d\x90NH'\xa4\x13\xd2\t\xe9\x84tB:!\x9d\x90NB3\xe1zp=\xb8^-\\\xaflT&\xc8\xe7|\xe4ó\xda\xe0\r\xf0\x06x\x03\xbc\x01\xde\x00o\x807\xc0\x1b\xe0\r\xf0\x06\xcfj\x83\x17\xc0\v<\xab=.\xaf\x05/ȁ&\xe6\xec\xea\xf3\x1b\x9c\x850\xc34\xfd\\\x9c\xd1\xe0{$;r\xd1\xec\x13\t\xfb\x95\xd5\xff\"I~\x1d?\xec\xd7a\x1e\b\xac\xaf\x92J\x8f=xM\xaf\xa4#\xef٦\x1e\x83\xec&\v\\\xc5u\x95~\xdc6\xf2\f\x8b\xbb\x040\x04\x18\x02\f\x01\x86\x00C\x80!\xc0\x10`\b0\x04\x18\x02\f\x01\x86\x00C\x80!\xc0\xf0\xf4\x88\xb8\xf0)Ϫ\xb0Ü\x19\tq\xe8w\xe0\xd3%?9y\xa2w۱Lnzo\x1c\xc8s҅\x84)yf\xbc\x0e7\xb4\xa2\x9d\xe7\x93Ѳ\xb5\xa6\xfe\x9b\xd6x\xa7\xea_\xb2v&\x96\xf5N\xfd7\xfa\xfbҖ9\x95\xffɑ\xba؊\xf6\xe3߄\xa3;\vma\xd9k\xbf:\xd6U\xdc̫\xd4\xf4\xeb\x12\xeb\xd8)\xe3\xf6\xc6\xdcj\x1a\xb5\\\xe4M5Y7V\t\xef:\x88\x1a\x16\xeacbE\xf8Z\x91\xd2z\xb1\xd9\xff2mT?\xed솤\x9fϸgv\xb6C\xef\xb8\x0f\x7f\xb9\xa5\x9eo禤\xad\x9b\x92\xde=\xde~\xc6\x1d\x86=\x8e{\x8bn\xa4\xfc\x80\xda\xf7V\xb7\xf1\x83\x1em\xad\xfd\x952\x0f\xd2\x1a\xbf\xd89\x81\xd1\xd6常\xf7\x8c\x11\x1dY\xba\xc9-J\b\x17&\xd6g}\xb2h\x98>U\x9a\xf3\xeaf%f\xc2\xc6\xd5&\x93\xaeO\x99\x17\xd1偡^\x86ǋ~\x1eJ\x87\xbb\\:sW\xfe\xb2x\n\xef\x1f\xd2W-+wq\x1dj\xf8ھ\xb4\x1e|\xb5\x8b\xca\\\xb9\xde<\xb6i\x92\rU\xf56F\x1a\x8a\xfbe-S[\xfd2\xeaPX\xc9}\xea\x9cs\xd5\x0ft;\x97r\xaa\xe6\xb619\buS\xeetKj\xb4q\xde:\xa2\x03\xaa\x11\x9a\xbf\xb2\xd6\xcahtb\xacw\xe7{k\xfbAO՝'+\xe0\xe9ڌ\x96\x01YQ\xaf\xe7q\x01j>_N\xfe FL\xa2\xbdȧ\xceJ\x01\xa8\x04&\xcb\f\xfb\"\xe0\x8a?X!4\xbf\xbd\xb7\x17\x81cF\xfe\xcb\v\xf3\xf1\x97\x17R\xd1Jso?\xaeL\xac(M|n\x8d\xf54Ol~S\xfcU\x90\xfc(ж\xe7\xc4z\xe7j\xe0\x98j\xbaL\xe3^\xdf4\xd23Wv\xd7\xd6t\xbbѳ4\xf56\xff?{\xef\xc2㶕\xe5\xfb~\x15\u008dF%\xd3U*\xdbs\xe6\x04ppqo\xc5\xe5\xf4\x187\x89\v.w\x06\x17\xe3F\x87\x92Xe\u0092\xa8#Jv\xea\x1c\xf8\xbbߵ\xd6~p\xf3%\x91\x12_\x92\xfe3H\xa7\"Q\xe4\xe6~\xae\xf5[\xafO\xce\b\xa8\x86ݚW\x8a\x9b\xe1%\xd2:V\x18>E_=\xd2z\xc7\xcc\x15\x1cC\xacyw\x9aJ4\x01\xe7\xdcm\x85\xaf\xf4.Ӻ\xf27\x92Ebf\xf8R\xbd~ȳo\x12N\x13\x13\x90 r\x86(\xc900{\xd0\x00\xd9@\xf0\x91\xd3\xdf\x06\x9f\x1a\xe55\x11E\xd3\x0f\x1by\xca\xe8.HV\x96\x85@\xb1Wޅz\x87\v\xefJϑ\xf4\x1c\x8a\x7f\xf4.~\xf2'\x9f\xf9\xe0XL\xf9*\x7f6\xa3N\xe3\x8b2\x1d\xa7\xcc\x1bz=\xa5ob\xde`l\xefD\xb7\xfd\x99v\x1f\xe7\xb6$\x8f\xc6\x13\x7f\xcao\xaf\xfbG\x84Uu\xbfX\x10_\xfe\x86\x0f\xf6\x1e)\r+3\xd1NGuo[a\xe7\xebs-L\xack\n\xa6T\x92p\xb3\xe7\xfc\x95\xdc\xfco\x05G\xfe<X=\x06\xe5_\xd3*\xa6g>\x86\x93\xab܅C\x15-\x96Ib\xf0\xa1J\x16w2\xd4ߎI\xab[\xb6\x93\x93\xbcIb\x85$\x8c\x03\x86d\xb4\x93\xc8N\xbc\x95\x95\xa9k\xcc\x18\x9e65\xd3'\x13\xb8N5\xaesJS\xa3\x02ᱳ\x03\x9c\xe7|8OU\t\xb0\xdeZ\x80,8\x94\x95\x06\x89\x10\x12\xe19H\x84\xaa\f\x16*\xb37\x97\v\xe4kn\x1fs\xe2\x99:\xc8\x01{\xf4\x15ڑ\x12\x16!\x10\b\x81@\b\x04B \x10\x02\x81\x10\b\x84@ \x04\x02!\x10\b\x81@JX\x84` \x04\x03!\x18\x8d\xa6\x84\xadT\x04\x1dP\xa8\r(\x94\x83ۿ(\x98\x02\x1c\x04\x1c\x04\x1c\x04\x1c\x04\x1c\x04\x1c\x04\x1c\x04\x1c\x04\x1c\x04\x1c\x04\x1c\x04\x1c\xd4\n\x0eBar\xd0*\xd0*Ъ\x13\xa2U\xbb\x12=\xe8\xd1sH\xd5\xc2\xec\xd6(iT\x8fX\x1d7\xad\x02\xa9\x02\xa9\x02\xa9\x02\xa9\x02\xa9\x02\xa9\x02\xa9\x02\xa9\x02\xa9\x02\xa9\x02\xa9\x1a*\xa9Bx\x10\xe0\x18\xe0\x18\xe0\x18\xe0\x98\x81c\x93`\xa5\xef\x17ĂO\xc2躔z=Jr$N\xd1dG\xc0\xf7\x14\xdb\xd8\x1e\x81|P8p/q\xc6n\xbf\xe4\xb1\x16u\xc4k炛\xbb\xb7\x7f\u05fd\xd0!ʲO\xad\n\xb2\xbem\x1d\xf5//\xc6t\xe3\x17\xdbG?%\xab\xaa\x89|\xd2C\xff/\xdd+;\xa7\xc0\xef\xea:\x1a\x93\xf7N\xc7t;\x1b̓k\x95\xbc\xab6)\xdc\xef\xc2G>*\x8d\xf0[:]\x94\xbe\xbe\xd2g\xb9\x91.-\x1dOnx\xafn\xf8^\xdd\xf0\xb4yy\xb5\xb9\xc5]W0\xb9\xb6\xf5\x19H1H1H1H1H1H1H1H1H1H1H1\xb8$\xb8$\xb8\xe4YW\xf9\x1a\xa5\xb0\x86V\xb9G\xa5\xaat-vR\xe6\x19V?\x84\xb1\x80\xbd8nb[\xf4~\xc7qL\xd3\x04\xf1\x1b\xe3*\xeb\xb9֫#\x8f\xab\x87\f\x01\xbe4BJ\xd4+\x1d\xc6J\xdaȰZoҵ\x94\x06\xb2v#\x1a\xccȺ׳_柭\xca(\xb4\xff\xf0\x83\xf2bF=/\xf7\x1d傜J\x1b\x1cI\x7f2\x8b_\xbd^\xd1\xe2\xb7/\fd\nd\nd\nd\nd\nd\nd\nd\nd\nd\nd\nd\nd\nd\nd\xdasuńK\xf4\vK\x0f\xe4\x00\xedT\xd8\xd8\xcf\x1boWܺ\xecQ\x99\x1aB'Ã\xf8\xe5\xdar\x9b+\xdb'\x9cB\xc0\xc1\x9f\"\x9f\x8e\xf9/\x9a\xe9#\xcf{\xc3\xff6\x9f\xcf}\x9a\x01\xf4\x0f\x1d\x80\xb3\r\xc9*\xab\xa4v\xac\xd2\\ig\xfc\x1cx\x176@\xfe\u0099,r\xc7&\xf65\xdb^K\x99Ʀ\xe5\\v\x97\x9a,\x8d婾\x8cMô\xf4D2\xffJĞ\xa4\xbc\xf3S\xaa\x8d\xfc\xd3\xc1Yt\xba/\x83\xd7\xf4\xfe\xb4\xadJ\xde\xe9\xaeey?XvN\xc1\xb2\xd3i\r\xbenm+\xfeY\xd8Sz]r[\xc4\xd1[i\xf1;\x19\x13\x91J\xf7R[\\M\x84\xf4\xafXi\x11t0r\xb9sW\x03O\xac;\x1az\x8c\xbc\xdf\xe5\f\x15\xc8?f\x89~q\xb5\b\xb8\x16\xba\xa8r\xf2<U\x8b^\x9d\xb5\x8a\xc6);A\x10\x9b\xe2\xed\xe1|\x1eLC\xfad\xf6\xa4\xed\"\xae\th\x11\xce.\xb5\xe6\xa1*\xcc?\xaex㧑\v\xa3\xa9յ\x923\x80\xdf֢\x16\xe6\x91i\x8d\xd9\xe7_\x9a\x17\xd2\x0fypN\xf4\x90\x7f 휓\xfcP\xd8\xc6\xe4ȗ\xa6\xdcIK\x1aԽn\x03\x92\x8d\xb9\x87\xa6\xaf\xbc%+\xb3\x81ōw\xd9Z\U000d7ab7\x14`3o=\xb57\xe0\xf1|1\xfa\x81\x94\xabDN\xb3e\xed\x13\xc8M#\xbcZ\x92\x9e\xc7\xef\xaea\xfe\xf5\x83?\x8b\x03\xd5\xf3\x1f\x9f\xa9\xaf?>\xa3\a-\x84ǯ\xec\xc3H\xbb\x13\x12u\xad\xb4\xbai\xc2\"\xd4\xed/\xe2\xe4G\xb1\xe8\xc4#\xefM( \xd4i:\rc\xee\xdd\x045*S\xe2\xa57ެe\x94\xc6\xd1\xfa\x933\x02\xaaa\xb7\xe6\x95\xe2f8\x8e\xb4\x8e5\xd5O\xd1W\x8f\xb4\xf11\xf3\x0eǌlޝ\xa6\x12G\x03r\xb7\x15\xbeһL\xeb\xca\xdfH\x16\x89\x99\xe1K\xf5\xfa!ϾI8MLS\x82\xee\x19\xee$\xc3\x10KL\xa2|k\xe0\xfc\xc8\xe9o\x83u\x8dR\x9d\b\xdd重<\xe5i \xa8X\x96\x85\xc0\xbaWޅz\x87\v\xefJϑ\xf4\x1c\x8a\x7f\xf4.~\xf2'\x9f\xf9\xf4YL\xf9*\x7f6\xa3N\xe3\x8b2\x1d\xa7\xcc.z=\xa5ob\xde`l\xefD\xb7\xfd\x99v\x1f\xe7\xb6$y\xc7\x13\x7f\xcao\xaf\xfbG\xc4ru\xbfX\xd0c\xfe\x86\x0f\xf6\x1e)\xcd33\xd1N\a)\xf4\f\x12\xaaU\xf3\xddOf?媾\xd5\xe4\x13i\xe81\x89'm\xd6\xf8\xedZ\f\xaf\x9f\xecn`\x98.\x95\xfbc\xcb\xd3[\xcd\x062@VH\xb7\xa0eJG\x1e\x8f\xddV\xd6`.,\xa8C~\xce\xdc\xe1\xc6\xf4\x1f\xf8\x03\xf8\x03\xb6\xbdc\xd9\xf6b%w\xee\xda\xf4\xd4e\xd8\xf2R\xef\xa8evlx\xd8\xf0\xb0\xe1\rc\xc3Si\xcd\xf7\xc8\xd3R\xb1\xfe\x1e\xf2\xb5\x94\xef\xa0_\xeb*\xa6\xe7X\xa5o`\xdb\x04\xa27\x10\xbd\x81\xe8\rDo z\x03\xd1\x1b\x88\xde@\xf4\x06\xa27\x10\xbdq\xce\xd1\x1bH\x04\x8e\xe8\x11D\x8f \x11\xf8>l\xa9\x89jy\xe0K\r\xf2\xa5s\xaa\xa9\a\xae\x04\xae\x04\xae\x04\xae\x04\xae\x04\xae\x04\xae\x04\xae\x04\xae\x04\xae\x04\xae4̒{\xa7\xe0\x8e\x01\xb8\x05\xb8\x05\xb8u\\pK\x84\x1bԷs\xc0\x93\xea\x91²fBr\x8e\xaf\xa6]z\x8cQ\xcd.5\xd0[\xebة\x01?\x81\nv\xc5S@O\xf5\xfd\xea\xd4)\xacy\xd2\fz\xd7\f\xe1\x0eJM\x11\xf9\x8f\x9f\xa3\xd5\xcdlf\x93մ1WFz<\x8dϳ<\xb7\xa7\x04\xfd\xa9\xa9\x95\xf0e3=\xc0\x92\xc1\x92\xc1\x92\xc1\x92\xc1\x92\xc1\x92\xc1\x92\xc1\x92\xc1\x92\xc1\x92\xc1\x92\xe1\xa3\b\x8c\v\x8c\v\x8c\xdb\x0e\xc65|ga\x19\x84\xf2C\x94\xbf\xbf\x01\xfb4\x8d},뙚\xde\x01\xdf\x00\xdf\x00\xdf\x00\xdf\x00\xdf\x00\xdf\x00\xdf\x00\xdf\x00\xdf\x00\xdf\x00\xdf\x00_\x00_\x00_8\xeb\nZ\xc7\xef\xb9\xc0%\xcdsmT\xc7\x17Gov\xceG\x0e\x84\x19\xaa\xe5\xf5pF\x1b\xc9\f\x8b\xe6EK)\vK\x1e\xd5`b\xc2-Ox\x99\x7f\x82ʯ\xdf\xd4#\x0e\xcap\x18u\xb2\x86vT\x8eq\xca)\xd0\x0ewl\vJ\xbdDjA\xbd\xb6/\x04R\bR\bR\bR\bR\bR\bR\bR\bR\bR\bR\bR\bR\bR\bR\xd8Ka\xbc\x846\x1cAܓބ%u\x00\x8f!\xbf\xa7\x17O\xa2%\x8di\xbc\x99\xc82\x93\x89O\x83\xaef\xc4r\x15ɦ\x9fN$ \f\xe2X\x8a;\xec\xe1ܶ+\xef\x9e\xec\x92i\xcd\xff\xd88\x13\xbfBS^he\xfb\x8fS\x1bVհg\xc1)\xf8\x93V\xd0\xc8\xf3\xde\xf0\xbf\xcd\xe7s\x9f\x06\x95\xfe\xa1\x83u\xb6!\x19h\x95\x94\x13U\x1a1\xed\xb8\x9f\x03\xef\xc26\xf2\xc2\x19\x7f\xb9c\x13\xfb\xa5m\xaf\xa5Wc\xd3r\xae\xc4JM\x96\xc6\xf2\xec]ƦaZ*#]b%\xe2TR\xf1\xf7)\xd5F\xfeiO\x06\x92\x83\xb7:^\x04\x1d\xecn\xdb\xcaE\x1d\xfbj\x93\xb7\x80\x9d\xa4\x1b;I\xa7e\x9aڰa\x1c\xa1%\xb0\xc0p\xd1\xc7\fGQ{\x14\xb5GQ{\x14\xb5GQ{\x14\xb5o\xb6\xa8\xfd\x9e\x1a\xfb>\xa5\xeb\x8b\xce\xfeS.S\xbfK\xb6X\xdaD\xe8\x03\x15-\xda,Hߎ\x94[?\x1f|/\xc4\xca\xcdri\x9e\xd4jFK \xb2Lu\x8a\x1d\xe1\x9eժ\x9c\"\xecSuT>\xdd\x17;\xafv\x90\xf2k\xd0\x15L\x91\x03\f\x9eo\xf0|\x83\xe7\x1b<\xdf\xe0\xf9\x06\xcf7x\xbe\xc1\xf3\r\x9eo\xf0|C\x0e0x\xde\xc1\xf3\x0e\x9ew\xbd\xe6\x00S\fh\xbfL`@C{\xa3\xa1\f\xde\xfe%<\xf6ң\x80B\x80B\x80B\x80B\x80B\x80B\x80B\x80B\x80B\x80B\x80B\x83\x87Bg\xe4\r\x01f\x05f\x05fu&\xccjW\x80\x9f\x1eB\x87W-̖\x8dL\xf6չ\xd5q3+\xf0*\xf0*\xf0*\xf0*\xf0*\xf0*\xf0*\xf0*\xf0*\xf0*\xf0\xaaA\xf3*\x84\v\x01\x90\x01\x90\x01\x90\x01\x90i@\xf6'\x1dH\xb1 \x94R\xd6\xf5(1\xf1\x1c\x99o;\xde\xf7\x14\xd5\xd8\x1e\x81|P8p?qƶ;\xf20\x8b\xba\xe1\x8d\xfd\xfa\xe6\xee\xed\xdfu\x0ft\b\xb0\xecS\xab\xe2\xaboE\x03m0\xe8\xd6\x01Oɤjʞ\xf0h\x97#\xccԨk\x88I\xc3\xf0\xde\xe9\x94n'\x80yr\xad\xa2&[\xe7\xc1ԧ\xad\x85\x8f\xb3}k\xb6\xde\xca\r\xee\x83S\xa7\xdd\x15\xa6\x8b\xd4n\xcd\xce\x17\xdb?\x1d\xc4\xf1\x8e\x92Vڌ\x00\xf6\xf9}\x15\xc2IvU\x8b\xbd\xdd9\x03\xf4\r\xf4\r\xf4\r\xf4\r\xf4\r\xf4\r\xf4\r\xf4\r\xf4\r\xf4\r\xf4\x8d\xf8]\xa0^\xa0^\xa0\xde\x16P\xaf%?\xc1r\x16=ͷ\x06\xe9\xee@?\xf6\x0e`?\xc5\xec\xc7vP_\xf0\xc76`H\xf4ǝ6\xc0?\xc0?\xc0?\xc0?\xc0?\xc0?\xc0?\xc0?\xc0?\xc0?\xc0?\xc0?\xc0?\xc0?\xc0?-\xe2\x1fZ\at7\x8d\x1f\xf6\x80?o\xd5\xefA~\nɏ\ue75e\xb0\x8f~\xfa\x80\x98O2[\x00|\x00|\x00|\x00|\x00|\x00|\x00|\x00|\x00|\x00|\x00|\x00|\x00|\x00|\x00|Z\x04>%)\xcf\x10\x00\xd62\nJ\xb2\x9e\xb9=\x05\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x01\xe0\x00\xe0\x00\xe0pD\xc0\x019L\xb6\x04\xb1Dq\x01LQ\xe7\x18\rQ/ܤ\x01ȡ^`?̡\xa7\xd28\x9aZȬ\xffN\xa73lj\xbe\xd08t=Ee\xe8_\x16M\xcf\xd7J\x82i\xfaI/\xf3O\xba\x990Bi\xfaQ\x87\xac9Y\v\x1d\xac9%\x0f曩>'Qmƪ\xa1\xde\xf5\x8fu\x05\xaa\x97ɭ\xc0\xd7\xf6\xe5\x80\x1c\x81\x1c\x81\x1c\x81\x1c\x81\x1c\x81\x1c\x81\x1c\x81\x1c\x81\x1c\x81\x1c\x81\x1c\x81\x1c\x81\x1c\x81\x1c\x81\x1c\xeb\xe4ܾ_\xfb\xebM|0\xfePgFB\x1f\x8e!\x85\xf2\x19\x15/9\xcconW\xb9P\xd9'Ӻ\xff\xb1\x92'~\x95\xa6\x1d\xdc\xcav\xa4{%}\x8b\x04\xff\xa7H\xc2c\xfe\x8b\xd6\xd4\xc8\xf3\xde\xf0\xbf\xcd\xe7s\x9fF\x99\xfe\xa1\xa3v\xb6!\xa9hu\xa5{z\xa2udڃ?\aޅm\xe8\x853!\xe4\x8eM젶\xbd\x96g\x8dM\xcbi\x06r\x93\xa5\xb1<\x9d\x97\xb1i\x98\x96\xd3H\xbbX\x89\x80%\xf2\xb2\xda\x17Sm\xe4\x9f\xf6l{9x\x13|\f\xba1\xbbl\n\xd7\xe0r\xe6O\x82\x93Y\x86\xf26\xb0\xc1\f\xc3\x06s\x90ad3\x04\xbb\x88\x7fj\xb6\x90\xbeV\xc2\x16Q\xf2VZ\xfaNz^$ʽT\x0eW\x8b \xdd)V\x1a\x00\x1d5\x0f\x91R̍\xf6\x9cXf4\xb0\x18y\xbf˩$\x80~\xcc\xd2\xf8\xe2jA\xaa\xc7:\x145L\x9e\xc7*\x8b1H(\x92\xa6\x18?\xc9\xfcz\xa6\x84\xf3y0\r\xe9\x93ٓ\xb6i\xb8\xe6\x9bE8\xbb\xd4Z\x83\xe8\xac\xde\xe3\x8aw]\x1a\xb10\x9aZ=)ـ\xf9m-&a\x96\x98\xd6v}\xfe\xa5y!\xfd\x90\a\xe7\x8c\f\xf9\a\xd2\xce9\x9dȅmL\x0eQiʝ\xb4\xa4A\xbd\xe96 \x89\x92{h\xfa\xca[\xb2\"\x1aXTx\xb7\x8a\x96\xfe\xa3\f\xd5]Dk\xe1\xe9R\xf5\x96\x82c歧\xf6\x06<\x9e/F?\x90b\x94H>\xf4m\xb0`\xa8\x95\x00j\x1a\xe1Ւt4~w\r\xe2\xaf\x1f\xfcY\x1c\xa8\x9e\xff\xf8L}\xfd\xf1\x19=h!,}e\x1fF\x9a\x99P\xa4k\xa5\x91M\x13\x8e\xa0n\x7f\x11'?\x8aE\x9f\x1dyoB\x81\x98N\xd3i\x18s\xef&\x98P\x99\x01/\xbd\xf1f-\xa34\x8e֟\x9c\x11P\r\xbb5\xaf\x147\xc3`\xa4u\xac~|\x8a\xbez\xa4I\x8f\x99U8\x86^\xf3\xee4\x95\xb8\x8c\x19w[\xe1+\xbd˴\xae\xfc\x8dd\x91\x98\x19\xbeT\xaf\x1f\xf2웄\xd3Ĭ$؝\xc1L2\f\xb1\x14S\x93o\rX\x1f9\xfdm\x90\xacQ\x88\x1316\xfd\xb0\x91\xa7\f\xfc\x82yeY\bh{\xe5]\xa8w\xb8\xf0\xae\xf4\x1cIϡ\xf8G\xef\xe2'\x7f\U000993d2Ŕ\xaf\xf2g3\xea4\xbe(\xd3q\xcad\xa2\xd7S\xfa&\xe6\r\xc6\xf6Nt۟i\xf7qnK\xb2l<\xf1\xa7\xfc\xf6\xba\x7fD\xd0U\xf7\x8b\x05\x1b\xe6o\xf8`\xef\x91\xd2\xd72\x13\xedtp@7\x10\x80\tJ\xae\x9d\x89\xddNa\x9a\x8arq\xf6俒\xdb\xff\xad@\b\x98\a\xabǠ\xfckZ\xcd\xf4\xd4\xc7pr\x95\xbbp\xc8\u00864\xf3\x18d\x8d;\x19\xf6oǧ#.5\xf1\x1b<\x1dsk\t\xbbOC=\xe1\x01!9\xdagd\xbf\xdeJ\xe6\xd45f,χ\xd1\xe9\xb3\f\x14\xa9>E:\xbd)S\x99'\xd9Y\x03\xaa\x04\xaaTU\xba\xac\xbb^ gV\x943;\\\x8d\x906!mB\xdat\xa5M\xd4O\xea0u\x8a\xdbU\bd@ \x03\x02\x19\x10Ȁ@\x06\x042 \x90\x01\x81\f\bd@ \x03\x02\x19\x10Ȁ@\x06\x042 wʩ\x94\x00ޑ=\xa5\x17v\xd2I\xfa\x94-\xa8\xa3++K҄o\xdd\xcf\xd3\xf6\xed,\xd9G\xb5\x99B%\xfd\xac\x01\xe4Pٽ\xf4\xea%Q9օX'\x8b\n\xe8#\xe8#\xe8#\xe8#\xe8#\xe8#\xe8#\xe8#\xe8#\xe8#\xe8#\xe8#\xe8#\xe8#\xe8\xe31\xa7Q\xa9\x00\"\x91G\xa5#7\xba}\x12\xa9\x1c+}\xda\x15\xa5\xb1\x17qB*\x95a\xa7Ri\x8e\x057\x13\x05Sa\xf3\xab\x91L\xe5x\x97\xe2\xce\xe8\x17\x98d\xba5\xc9\xf4\x1f\xfbr\xb0\x95\xc4?9\xcbHo\xeb\x01IU\x90T\x05IU\x90T\x05IU\x90T\xa5\x83\xa4*U\x90\xc0^YU\xca\xe4\x01\x84\xbb\xee\bw\x1d\x8c\xb8\xd1u\xa4k\x83RqC\xa1\xae\xed\xf3\xb2T\xac\xab\xfb8\x04\xbb\x0e\x89\xd2]\xaf\xe8P\xe3㊧\xccVgMs\xa12Ȝ\x83\xe3\xe6{\xd35\xfd\xd3\x02۔\x1e6-\xe7\xd9]у\xcc#;q\xecL=sH\x0e\x9e\xce4\xecr\xabv\x1e\x8b-{P[6\xa9\x0f\xb3`G6,\xbe\xa4 S\xcb\xc9\xdbY\xee\xa5o\xba\xd9\"ճ\x06a\x030\xaf\xbdO\x16\xac\x93\x9b*\xd5\xed\x00\xa6\xdb\xfa9\xde\xcd\xfc\xf9g\xd7ӵ\xcdC\xbc\x99E\xd1\b\xfd߱(\xaaf\xbd\xaa\xb9>\x80\x01\xaab\x80\xeeV\xdfpX@Cˣ\x19\f\xb0k\x814'V\x9a'A\x92\x1c\x96$yHb\xd5ӗ%;M\xadzb^%'8mjȕ=\xa7W\x85\x97\xc9\xc0\xbcL\x0eN\xb1\ni\xf3`i\xf3,\x93\xac\xc2\xf4\x04\xd3\xd3 \xa4Oj\x13Mx=\xdb\xf7Ȳ\xfaV\xfd\x1e)Vw\xa4XM\xfa\t\x19\x0e\x90\xe1\x00\x19\x0e\x90\xe1\x00\x19\x0e\x90\xe1\x00\x19\x0e\x90\xe1\x00\x19\x0e\x90\xe1\x00\x19\x0e\x90\xe1\x00\x19\x0e\x90\xe1\x00\xf9U3\xa0L\xab\xcd\x03J\xae\x9a(\xf2\xfbdV]\xf4\x01L\xbap\xcf-\xe5\x1b\x1d\xd9V\xcc\xf3\xbfu<3[\xb7\xaa\xa4\x9fӢ˭\xfb\xa0\xfe\xfdlw-\xb3ZYT\x8fs\xcd\xd5H\xa1\n\xba\b\xba\b\xba\b\xba\b\xba\b\xba\b\xba\b\xba\b\xba\b\xba\b\xba\b\xba\b\xba\b\xba\b\xbax\xb4\xf9Sw\xb2F$O\xed\xc47n\x8fԩǉ\x9cv\xc4`쁙\x904u\xd0IS\x9b\x82\xbe\x8dĶ\xec\xdc\ueaa7K=\xd6\xe5\xb7+\x96\x05f\x96\x0e\xcc,\xbdG\xae\x1ch\xfc8Z+\xe3\xceԨ]N\x7f\xe4EE^T\xe4EE^T\xe4EE^\xd4\xf6\xf3\xa2\xeeV\xf5\xf7I\x8aZ,\x06 8u{p\xea@\xa4\x8c\x8ecR\x1b\x93\x80\x9b\tHm\x9b}\xb9Ѩɳ\x10\x8a:\x1c\xdcvP\x1a\x94S\xe6o\x9d&@9\x1dBtjS\xa5\"+\xea9\xe9\t\x88\xd1\x10\x88ѡ\x89N H\x1e H\x9ee\x8a\x13\x88\x93\x10'{\x15'i\xa6|\x8dV\x9f\x05Z\x84{\xe77\xf9M\xdd\xc5\x02\vd9ٖ\xe5$\xdb[\x88F@4\x02\xa2\x11\x10\x8d\x80h\x04D# \x1a\x01\xd1\b\x88F@4\x02\xa2\x11\x10\x8d\x80h\x04D# \xd7I\x86\x98\xa5\x94\xe7\x01e<\xc9*\xf5\xfb\xe4=鏣t\x91\xfcd\a\xf6\xe8\xc8Ғnŷ^\xa6m\xebV\x97\xa2\xa7\xb5\x98\x14%\xff\xb8\xfeS\xa3T[\x8f\xb5\x12\xa4\x1c\xf3ꬑ&\x05x\x12x\x12x\x12x\x12x\x12x\x12x\x12x\x12x\x12x\x12x\x12x\x12x\x12x\x12x\xf2ȓ\xa5TĔH\x99ҡ\xd3\xdd\x1e\x89S\x8e\x19E\xed\b\xdf\xd8\x1b?!\x89ʠ\x93\xa84\v\x89\x1b\t\x94\xa9\xb8\x19VO\xa8r\xdc\xcbrW\xa8\f\f8=\x1apz\x0f\x9eiĠ⟢\x11\xa5\xcf\x15\x82\xfc+ȿ\x82\xfc+ȿ\x82\xfc+ȿ\xd2~\xfe\x95\xaa\xf4`\x9f,,\xdb\x04\x03\x84\xd0n\x0f\xa1\x1d\x94\xdc\xd1q\bmâr3\x81\xb4\xdd`67\x9c6\xfbD\x04\xd5\xf6\xc7\xf7X\x95\xa6]\x81N\xdf}\x03j߫;\xdc\v\xe5@4\xed\xb6h\xdaTW\xc1W\r\xbej\xf0U\x83\xaf\x1a|\xd5\xe0\xab\x06_5\xf8\xaa\xc1W\r\xbej\xf0U\x83\xaf\x1a|\xd5\u0ac6P\xda\f9K4\xe7\x01\xc5Ѧ\xd4\xf9\xbd\x82h\xfba']D\xd0nC\x1d\x1dy_8M\xf8\xd6\xfd<m\xdd\xef\"\xf7\xa8\x16\xa3f3\xcf\xea?d\xb6\xc2ҫ\x15/{\xb4\v\xb1F\xb0,\xe8#\xe8#\xe8#\xe8#\xe8#\xe8#\xe8#\xe8#\xe8#\xe8#\xe8#\xe8#\xe8#\xe8#\xe8\xe31G\xcaV\x01\x91\b\x93\xedȍn\x8f\x10٣\xa5O;\xe2c\xf7#N\b\x8e\x1dtpl\x83,\xb8\x91\xc8\xd8*\x9b_\xf5\xb0\xd8#^\x8a\xbbbba\x92\xe9\xd8$\xd3{\x1c\xec\xe1V\x12\xff\xe4,#\xbd\xad\a\x84\xbf\"\xfc\x15\xe1\xaf\b\x7fE\xf8+\xc2_\xdb\x0f\x7f\xad\x84\x04\xf6\x89}-\x95\a\x10\xf8\xba=\xf0u8\xe2F\xc7Q\xafMJ\xc5̈́\xbcv\xc0\xcb\xdcx\xd7\xd4\xe3\x10\xec:$JwMg\xd1,\xd8\xce\xea\xe4\x92|\x05\xedӇv\xf7\xd27\xddl\x12\xeaY\x83\x00J\xe6\xb5\xf7`I\xa77U\xaaC%\xd3m\xfd\x90%3\x7f\xfe\xd9\xf5tm\x93'5\xb3(\x1aAI;\x16EEQ\xb2\xee\xfa\x80LYU\xa6\xecn\xf5\rG\xb0lhy4#S\xeeZ ͉\x93\xe6I\x90$\x87%I*\x00\xb0]\x94\x94k\xceR\x96T\xbd\x03\x13\xe5^b\xe5\xe9M\x9b\x1ar\xa5\x9990Y\xc2dYKج\xbbj mV\x966\xbb[\x93\xe0\x98\xe0\x98\x90>\v\xa5On\r\xc7\f\xac\xd80\xb7j\x0ei\xf2\x95\xaf\xed}o7\xf3\xf9\xd3I\x8a\xa5\x05\xef\t\xe4\xd9,\xf2<\x85\xa9TQT\xdd2\x9b\x80D\x81D\xf7C\xa2\xbb\xd7\x0f\x84\xd6JBk\xaf\xab\x13\xc8\x14\xc8\xf4\x8c\x84\xd6tɸ=\xd3J\xf7V|\xe7\x982K\xbb}\xf4s\xb4\xba\x99\xcd\xec\xc6\xd7\x15kM\xb5a@ɹ\xbaIk\x8f48H\x83\x8348H\x83\x8348H\x83\x8348H\x83\x8348H\x83s\xceip\x9aT2\x91\x86\aix\x90\x86\xe7(\xd2\xf0l\xc3A\xcbh\x1a\a4è\xb7\x0eDBw\x12\x9e+w\x02\x16ڂ\x85\x8a\xfa\t\xa0\x03\xa0\x03\xa0\x03\xa0\x03\xa0\x03\xa0\x03\xa0\x03\xa0\x03\xa0\x03\xa0\x03\xa0\x03\xa0\x01\xa0\x01\xa0\x01\xd5\xc62>\r9\x05z@~\rE\xca\xfd^\xb5\xc7z\xa5)\xed\x95 \xab\x00?:\xf2\xbfͷ\xe4[o\x13\xb8u\xbfܲ'\xb6X\x94\xac\xf8\x91\xfd\xd7&\xab\xbeBk\x95(;\xf6\xf5\xba\xb3R\x19\xb0%\xb0%\xb0%\xb0%\xb0%\xb0%\xb0%\xb0%\xb0%\xb0%\xb0%\xb0%\xb0%\xb0%\xb0\xe5\t\x94)\xab\x81.\x0fT\xfb;\x8bu,pnۣ\xfeױ\xa3\x9d\xc2t\x1b\a\xe1\x1c\xd4\x00\x1bt\r\xb0\xe6\xd1k#iLjl0\xd5+\x82\x1d\xff\xe2,N`\x02Cɀ\f%\xbd'3i\xcch\u17ea\xa1\xa2\x9f\xf5\x82\xaaa\xa8\x1a\x86\xaaa\xa8\x1a\x86\xaaa\xa8\x1a\xd6~հ:\x1a\xfa>\xc5\xc3v\x89\x06HfV\x94\xcclp\x92G\xc7I\xcbZ\x10\x99\x9bI`\xd6\x1d\xd0r\x93\x99\x15=\xb5\xd5\xc4f\x9d\xd14\xa7\xaa\u009e!\xa2\xfdd\xc4?\xa2\xd8Ф\x83z\xca\x17\x964`@N\xb5\x1d$҆'\x1a<\xd1\xe0\x89\x06O4x\xa2\xc1\x13\r\x9eh\xf0D\x83'\x1a<ѐ)\f\x99\xc2\xe0\t\aO8d\n\x13\xfc#?\xb8\x9e\xfa\xf4\xbc\xc5V\x06\xa4\x8fAҠ\xbe\x84\xd3\r\x9dW\xce[\xfbV:\xbd\x95\x1b\x01\x05\x99\x0e˱ \xdbALb:\xc0A[\x88\xb2L\xe07,j\x1eL\x84\xe4]\x9b\xc1B\xee\f\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15\x02\x15j\x9f\n\x05\xcbY\xf44\x17/\xdf\x03\xb1\x90\xbd\x13\xb8P\t\x17\xb2=\x040T\x02\x86\xdc9\x042\x042\x042\x042\x042\x042\x042\x042\x042\x042\x042\x042\x042\x042\x042\xd4:\x19\xa2\xd5@\xf7\x8c\x83C\xb9\xd0[u\x1f@\xa1b(\xa4\xbb\aD\xa8\x98\b%\xb3\a8\b8\b8\b8\b8\b8\b8\b8\b8\b8\b8\b8\b8\b8\b8\xa8u\x1c\xb4\xb0XB%▿\xbf!\xaa\xac3Pd\xb9\xd04\x15_\x06H\x84x2`\"`\"`\"`\"`\"`\"`\"`\"`\"`\xa2v1\x91n\xa6\xa4\xcf\xe5\xbeg\x89ߋ'\xa4\xc7\\\xd2\x10O\x84\x17\x88HI\x8a\x91RmI[\x92nI'\xd5\x15\x85fؙuA\xb1@\xb1@\xb1΅b\xed\xaa2\xa7\xc7\xd0!X\v\xb3g\x9b\\\xd9 Y\xf5I\xd6qS,\x10,\x10,\x10,\x10,\x10,\x10,\x10,\x10,\x10,\x10,\x10\xaca\x13,\xb7\xf2\x93+}\xb7Z\xf1\t\xd8\f\xd8\f\xd8\f\xd8\xecT\xb0\x19\x92G\xf5\x01\xcdRi\xa4\xe0\xff\x85\xb4Q\xc0g\xc0g\xc0g\xc0g\xc0g\xc0g\xc0g\xc0g\xc0g\xc0gp\x00\x03\xc9\x02\xc9\x02\xc9\x02ɪI\xb2\x9a\xf0\x00\x03\xcdڃf\x9d\xbd\x0f\x18(\x16(\x16(\x16(\x16(\x16(\x16(\x16(\x16(\x16(\x16(VgN`\xae\xf8\r/0\xb03\xb03\xb03\xb0\xb3\xdd\xec\f\x89\xe2;FfN\xcaxx\x7f!E<\xa0\x19\xa0\x19\xa0\x19\xa0\x19\xa0\x19\xa0\x19\xa0\x19\xa0\x19\xa0\x19\xa0\x19\\\xbf\x80\xaf\x80\xaf\x80\xaf\x80\xaf\xaa\xe3\xab\x06\x1c\xbf\x80\xb0\xea!\xacsw\xf9\x02\xba\x02\xba\x02\xba\x02\xba\x02\xba\x02\xba\x02\xba\x02\xba\x02\xba\x02\xba\x02\xba\xea\xc6\xdf+\x91\xbd\xe1\xec\x05Z\x06Z\x06Z\x06Z\xb6\x9b\x96-\x82\xf5\xd7h\xf5y\x19\xcd\xc2Ix\xb0\xcb\xd7o\xeanw|\xb7'P\xb3]\xd4,\xd5]p\xffR\f-;\x87@\xd2@\xd2@\xd2@\xd2@\xd2@\xd2@\xd2@\xd2@\xd2@\xd2@\xd2\xe0\x04\x06\xac\x05\xac\x05\xac\x05\xacU\x05k5\xe0\n\x06\xb4\xb5?\xda:w\xb70 - - - - - - - - - - \xad.\x9dò\x128\\\xc4\xc0\xd2\xc0\xd2\xc0\xd2\xc0\xd2v\xb3\xb4U x\x89&ա\xeea\xef՝Ta^\x00\xb4\xad\x00-\xe9+8\x86)\x8a\x96\x9a=@h@h@h@h@h@h@h@h@h@h@h\xf0\n\x03\xc9\x02\xc9\x02\xc9\x02\xc9\xdaE\xb2\x1a\xf0\b\x03\xcdڇf\x9d\xbb/\x18(\x16(\x16(\x16(\x16(\x16(\x16(\x16(\x16(\x16(\x16(Vg\x8e`)\xf1\x1b^``g`g`g`g.;CF\xb0\xce9Y6\x0f\xd8\xcf\xd1\xeaf6\xb3\xf8,\x86\xfb\x17\x82(\xc1\xce\xc0\xce\xc0\xce\xc0\xce\xc0\xce\xc0\xce\xc0\xce\xc0\xce\xc0\xce\xc0\xce\xdagg\xc0D\xc0D\xc0D\xc0D9L\xb4\x8c\xa6q@\xf3\x8c\xfa\xac!Tt\x17\x91\xfc\xae\xee\b\\\xb4\r\x17\xe5:\n\x11\x82\n\x11\x15\xcd `\"`\"`\"`\"`\"`\"`\"`\"`\"`\"`\"`\"`\"`\"`\xa2>0Q\x03\xa1w@E\xfb\xa2\xa2s\x0f\xbf\x03\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\x02\"\xea:\n\xafH\no5\x18\x0f|\n|\n|\n|*ǧ\x90ܼ;\x18\x95Ni\x8e\x187$\x87\x02\x96\x02\x96\x02\x96\x02\x96\x02\x96\x02\x96\x02\x96\x02\x96\x02\x96\x02\x96\x82\xe7\x12\xc8\x10\xc8\x10\xc8PGdH\xa7;bi\x90\xa9A\x18]\x97r \xfa\x90:\x92\xdenn\xfb\xdf\xf7\x94:\x9f&>\xcd\xe1\x9dָ\xd1\x0eȓ\xf4J\x1e\xeeP7\xfcf\xbf\xbe\xb9{\xfbw\xdd\x03\x1d\xd2\x1b\xfbԪ\xec\xe6ۖ\xf1\xfe\xf2b\xfb\x90\xa7\x8415wOx\xbc\xff\xf5\xe5Ŏ!\xff\xfd\x05u\xff{\xa7+\xba\x1dx\xf3d\tzlh\xfcK\xea\x05TM\x85\xa6\xf4Е>\xb5\x8d\xd4d\x9c\x15\xcf(\x05ڎi\xc4\xfd\xe4Σ\xa4>@\xb6\x8f@9A9A9A9A9A9A9A9A9A9A9A\x19A\x19A\x19\x8f\x8826\xcfFF\x0e\xbf\xf8\xf2b\x94\xcb\x1c~\xb07S}G\xa6\x1cQ\xa9\x96\xb3[y3Eq\x01OQ\x87\x19\x8dSo\xe8\xe40Ρڿ\x17\xe9гi\x1cM\xadqI\xff\x9d\xf6\x82nh\xca\xd0 t<Ie\xd8_\x16M\xd0\xd7J\x84i\xf8A/\xf3\x0f\xba\x990Bi\xf8I\x87\xac9Y\x05\x9d\xad9%\x14\xe6\x1b\xab>'ym\xc6\xfa\xa1\xde\xfa\x8ft\x05\xaawqW\xe0k\xfbZ\xa0\x8e\xa0\x8e\xa0\x8e\xa0\x8e\xa0\x8e\xa0\x8e\xa0\x8e\xa0\x8e\xa0\x8e\xa0\x8e\xa0\x8e\xa0\x8e\xa0\x8e\xa0\x8e\xa0\x8eu=\xb2\xee\xd7\xfez\x13\x1f\x8c@ԙ\x91\xb0\x87\x0e\x11\xe4\x81J\xff\x19\xd5\xf9\xfdg\x13^t\xbb\xd2\xffɦ\x99\x06\x01G\n\xa1\xf8M\x1avw+ۜ\xee\x95 .\xc2\xfc\x9f\"\x14\x8f\xf9/Z^#\xcf{\xc3\xff6\x9f\xcf}\x1aj\xfa\x87N\xddن\x04\xa4Օ\xee\xe7\x89V\x97i;\xfe\x1cx\x17\xb6\xb1\x17ά\x90;6\xb1\x99\xda\xf6Z\xb456-\xa7i\xc8M\x96\xc6\xf2\x9c^ƦaZd#Ec%\xb2\x96\x88\xcej\x8bL\xb5\x91\x7fگ%\xe6\xe0\xed\xf01\xe8\xd2\b\xb3)\\\x86˙?\tNe%\xca\xcb\xc0\"ӿE\xe6 ;\xc9f8f\x12\xfftL#}.\x85-\x92\xe5\xad4\xf4\x9d\xf4\xbb\b\x98{i \xaeRA\xaaT\xac\x14\x02:n\x1e\"\xa5\xa7\x1be:1\xd4h~1\xf2~\x97\x93Ix\xfd\x98\x85\xf3\xc5Ղ4\x91u(Z\x99<\x8f5\x18c\x9fP`M!\x7fR\x01\xf4<\t\xe7\xf3`\x1a\xd2'\xb3'm\xe2p\xad9\x8bpv\xa9\x95\bQa\xbd\xc7\x15o\xba4`a4\xb5jS\xb2\xff\xf2\xdbZj\xc2h1\xad\xfc\xfa\xfcK\xf3B\xfa!\x0f\xce9\x19\xf2\x0f\xa4\x9ds:\x95\vۘ\x1c\xa4Ҕ;iI\x83j\xd4m@\xa2%\xf7\xd0\xf4\x95\xb7d\xbd4\xb0\xe4\xf0n\x15-\xfdG\x19*5\x03/Uo)Vf\xdezjo\xc0\xe3\xf9b\xf4\x03\xe9I\x89\xf4C\xdf\x06\vf\\\t\xaf\xa6\x11^-Ie\xe3w\xd7\\\xfe\xfa\xc1\x9fŁ\xea\xf9\x8f\xcf\xd4\xd7\x1f\x9fу\x16\x82\xd6W\xf6a\xa4\xa8\tT\xbaV\n\xda4\xc1\n\xea\xf6\x17q\xf2\xa3X\xd4ۑ\xf7&\x14\xa6\xe94\x9d\x861\xf7nB\r\x95U\xf0\xd2\x1bo\xd62J\xe3h\xfd\xc9\x19\x01հ[\xf3Jq3HFZ\xc7zȧ\xe8\xabG\x8a\xf5\x98хc\xf55\xefNS\x89cݸ\xdb\n_\xe9]\xa6u\xe5o$\x8b\xc4\xcc\xf0\xa5z\xfd\x90g\xdf$\x9c&V&\xa1\xf0\xcci\x92a\x88%\xe2N\xbe5\x9c}\xe4\xf4\xb7!\xb4F?ND\xd9\xf4\xc3F\x9e2\xf9\v\xf5\x95e!\xdc\xed\x95w\xa1\xde\xe1»\xd2s$=\x87\xe2\x1f\xbd\x8b\x9f\xfc\xc9g>N\x16S\xbeʟͨ\xd3\xf8\xa2L\xc7)\v\x8a^O雘7\x18\xdb;\xd1m\x7f\xa6\xddǹ-ɳ\xf1ğ\xf2\xdb\xeb\xfe\x11aW\xdd/\x16\x8a\x98\xbfჽGJq\xcbL\xb4ӡ\x03]2\x01\xc6*\xb9\xd6&\xc6<\xc5nj\b\xc7\xd9\xd3\xffJ\x1e\xf1\xb7\x02A`\x1e\xac\x1e\x83\xf2\xafi]ӓ\x1f\xc3\xc9U\xee\u0081\n\x1c\xd2\xc2#\x907\xeedп\x1d\x9d\xae\xb8\xac\x9f\x19\xbfO^\xe6\xe6\x01\xcd>\xac\xd5\x1c\xa0\x00u\x16\xd4!\xae\xb5ݸV\xb7g:\xc8sxjN\xc1pÃ\x1b\x1e\xdc\xf0\xe0\x86\a7<\xb8\xe1\xc1\r\x0fnxpÃ\x1b\x1e\xdc\xf0\x90\xe2\x10n\x80p\x03\x84\x1b`\x03)\x0e\xbf\x98\xf2\x17\a&>\xabV\x19\x03\xa0(U\x17\xa3\x02\n\xffE\U00056cea\x87\x01b\x04b\x04b\x04b\x04b\x04b\x04b\x04b\x04b\x04b\x04b\xd4\x0f1:#W\t\x00-\x00-\x00\xad3\x03Z\xbbb\x10\xf5P:0ka\xb6nx?\xed\v\xb5\x8e\x1bh\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x01f\x1d\x03\xccB\xc0\x11(\x1a(\x1a(\x1a(Zu\x8a\x06\xff\xaf\x8ePY\xd6뫃hAx\x81\x01\x9c\x01\x9c\x01\x9c\x01\x9c\x01\x9c\x01\x9c\x01\x9c\x01\x9c\x01\x9c\x01\x9c\x01\x9c\x01\x10\x01\x10\x01\x10\x01\x10Y@\xa42\x11^\x97ҟG\xc9\xce\xc89\"m\xa7\xfb\x9eR\xe4\xb7'\xbf;(\x13]/)\xeeTW\xe4\x81\x0eu\x81\xe2\x117wo\xff\xae\u07fcC^c\x9fZ\x95\xd6|\xcb\x0e\xee\x97\x17c\xba\u05cb태\x92\xb9\xd4\x14=\xd1\x11\xfe\x97\xee\x8f-#\xfd\xbb\xba\x82\xba\xfe\xbd\xd3\x19\xdd\x0e\xbayr\xad\xe4^\xa5c_\xe23\xb9\x8c\xa6\xd30^m\xe4v\xe3͔z`\xdfTqw\xd1\xf4\xd6\xde\xea'\xb9\xd5is\xe0]\xb3\x89\xbb+5\x9d\x12\x9f\xc9\xe2\xbe\x02\xdb\x04\xdb\x04\xdb\x04\xdb\x04\xdb\x04\xdb\x04\xdb\x04\xdb\x04\xdb\x04\xdb\x04\xdb\x04[\x04[\x04[<\xebҤ#]\xe2E\xabڣ\x02\xf5\xb9\xa7\x14\xe8K㨤\xfd\x97\x8a\x15{\u05cbI\xc1\x02qe\x8a\xe2\x02Ȣ\xce4\x1a\xae\x9eyʁ\xf0C\xbd\xc6!\xf8\xa3\x8d\x9a|\xbb\xe7QK\xb5A*=\xb8\xc1\n}\x95\x9f\xf72\xff<UG\xa9\x9d\a\x1eT\x03%\xearu\xee(\r\xe8\xd4Ң\r\xf5\xb8תz\xa5\xd4Z}m_\x0f\xd0\x12\xd0\x12\xd0\x12\xd0\x12\xd0\x12\xd0\x12\xd0\x12\xd0\x12\xd0\x12\xd0\x12\xd0\x12\xd0\x12\xd0\x12\xd0\x12\xd0r\x00\x15\x93\x13\x12\xd1!\xba<P\xe9?\xcb2\xad\xfb{\xe4\xedJe({f\x9a\x03\x1c7\x91\xe2\x17j\xc7u\xael\xa7\xbaWR\xb9\xaaQ/\x12\xf2\x98\xff\xa2\xb56\xf2\xbc7\xfco\xf3\xf9ܧq\xa7\x7f\xe8\b\x9emHZZ%\x15\xe9\x95\xeeL{\xf3\xe7\xc0\xbb\xb0M\xbep\xa6\x88ܱ\x89\x9dն\xd7r\xae\xb1i9\xcdIn\xb24\x96'\xf826\r\xd3\xf2\x1bi\x1d+\x11\xbcD\x8eV\xfbe\xaa\x8d\xfc\xd3AXu\x0e\xde\"\xd5\xec\xe8jW\xdc\x14\xae\xcd\xe5̟\x04'\xb6<\xe5\x9d`\xdd\x19\xb6u\xe7 c\xcbf8\xb6\x16\xff\xf4\xec+\xfd\xaf\x96-\xe2魴\xf7\x9d\xb4H\xa4Խ\xd4\x18W3!},VZ\x05\x1dS\x0f\x91R\xf6\x8dF\x9eX{4\x04\x19y\xbfˉ&\xd0\x7f\xcc\x12\xfe\xe2jA\xea\xcc:\x14\xd5N\x9e\xc7j\x901r(:\xa7\xec\x06\xa4G\xe8Y\x13\xce\xe7\xc14\xa4OfO\xdaN⚄\x16\xe1\xecRk\"\xa2\a{\x8f+ޥi\xdc\xc2hju\xafd\xc3淵\xe8\x85\xf9dZ\x83\xf6\xf9\x97\xe6\x85\xf4C\x1e\x9c\xf35\xe4\x1fH;\xe7t\x9a\x17\xb619\x80\xa5)wҒ\x06u\xb1ۀ\xe4S\xee\xa1\xe9+o\xc9\xcam`\xf1\xe3\xdd*Z\xfa\x8f2Tj\x96^\xaa\xdeR\xc0ͼ\xf5\xd4ހ\xc7\xf3\xc5\xe8\aR\xb6\x12\xa9\x89\xbe\r\x16\f\xca\x12\xe8M#\xbcZ\x92\xde\xc7\xef\xae\xe1\xfe\xf5\x83?\x8b\x03\xd5\xf3\x1f\x9f\xa9\xaf?>\xa3\a-\x84ϯ\xec\xc3H\xdb\x132u\xad\xb4\xbci\xc2&\xd4\xed/\xe2\xe4G\xb1\xe8\xc8#\xefM(`\xd4i:\rc\xee\xdd\x04=*\xd3\xe2\xa57ެe\x94\xc6\xd1\xfa\x933\x02\xaaa\xb7\xe6\x95\xe2f\xb8\x8e\xb4\x8e\x95\x99O\xd1W\x8f\xb4\xf31\xf3\x0fǐlޝ\xa6\x12\a\xdcq\xb7\x15\xbeһL\xeb\xca\xdfH\x16\x89\x99\xe1j\x9b\xe2\xc9?\xa5)9MLU\x82\xf2\x19\xf6$\xc3\x10K؟|k`\xfd\xc8\xe9o\x83y\x8d\x92\x9d\x88\xc0重<\xe5L \xe8X\x96\x85\xc0\xbbWޅz\x87\v\xefJϑ\xf4\x1c\x8a\x7f\xf4.~\xf2'\x9f\xf9pYL\xf9*\x7f6\xa3N\xe3\x8b2\x1d\xa7\xcc0z=\xa5ob\xde`l\xefD\xb7\xfd\x99v\x1f\xe7\xb6$\a\xc7\x13\x7f\xcao\xaf\xfbG\x84du\xbfXPd\xfe\x86\x0f\xf6\x1e)\xed/3\xd1N\a1t\t\x16\x98\xcd\xe4Z\x9bX\x04\x15\x00\xaa-Mge\x81+y\xd0\xdf\nĂy\xb0z\fʿ\xa6\xd5M\xcf\x7f\f'W\xb9\v\x87*~H\x13\x8fG\xfa\xb8\x93)\xf0\xedX5\xcee\xfdB\x02}\x9287qj\xf1s\x91>up \x90v!\xd9ݷ\xf2@u\x8d\x19\xdb\xf3$\x83\xfa\x14\x04\xbb:\x8c]\x9d\xeaT\xaaI\xb1\xecl\x02\xcb\x02\xcb:T\x82\xdd\x7fMA\x96\x1d⊅D\v\x89\x16\x12\xad\x92h\x91U\xa6ì2\x05=\xd4A\x82\xf1\x13\r\xc9C0\v\x82Y\x10̂`\x16\x04\xb3 \x98\x05\xc1,\bfA0\v\x82Y\x10̂\xec\xe2\b\xa6A0\r\x82i\xf6\xcd.\xeep\xa18\xa0\xd9E=\xb5\xb3\xec\xdcN,t\xaf\xeft\x0e%\xe7\xf6\x81B\xb9\xfe\x01\xdc\x00\xdc\x00\xdc\x00\xdc\x00\xdc\x00\xdc\x00\xdc\x00\xdc\x00\xdc\x00\xdc\x00\xdc\x00\\\x00\\\x00\\@z\xe1\xb4/CZy\x1e\x8e'CN\xa9\xdf7\xb5p\x7f\xf4\xa4\xf9\xc4\xc2\x15`G7.\xba\x99V|\xebe\xb2\xb6\xed\x9e[\xf8\xb4\xf6\xd2\b\x17<\xae\xf7$\xc2\x15Wa\xdd\x14\xc2G\xbb&\xb7&\x10\x06\x8a\x04\x8a\x04\x8a\x04\x8a\x04\x8a\x04\x8a\x04\x8a\x04\x8a\x04\x8a\x04\x8a\x04\x8a\x04\x8a\x04\x8a\x04\x8a\x04\x8a<\xfe\xa4\xc1U\x91\xe4\x81*\x7fW\x81\x8bY\a\xb5\xfdr\xef\x1e-\xca\xc9\xe5\xd78\b\xdf \xdb\ue433\xed6\x8cV\x9b\xc8WRu3\xa9\x95i\xf7\x88\x17c>C\t\x8c\x1d=\x1b;\xfa\xceDҌ\xf1\xc1?5\x83C?\xeb\x02Yt\x91E\x17Yt\x91E\x17Yt\x91E\xb7\xf5,\xba\x955\xed=s\xe8n\x15\a\x90ul\xd0\xd2F\xb79ƚ\x16\x8b\x1b\xc90\xd6\x11\x88\xca\xe4\x17\xcb=\xb5\xd5\xecb\x9dP0a\x9du\xd2Җ\xc21mb#\xa1\xf2K8\xdd\xf83\x97\xe8\xfa\xd6\xf2\x8d\x1c_\x99\xfd\xe6k\xbd,\x87\xbf(\xcf\xd2\x0e\xcfEA\xf4oؘ}\xf0ڗwE\x96/x\x9f\xc1\xfb\f\xdeg\xf0>\x83\xf7\x19\xbc\xcf\xe0}\x06\xef3x\x9f\xc1\xfb\xecX\xbc\xcf\xce(\xe55\x9c\xf3\xe0\x9c\a缣NBV\x9bn\xed\xf2\x00\xd3#鐭\x85ٹ\x91ž\x19\xc2u\xdct\vd\vd\vd\vd\vd\vd\vd\vd\vd\vd\vd\xeb\x18\xc8\x16j\xc9\x01\xac\x01\xac\x01\xac\x01\xacU\x06k\xf0\x0f냞\x95x\x85uP\b\x12^b`i`i`i`i`i`i`i`i`i`i`i\xa0E\xa0E\xa0E\xa0E;iQ劐\x95a\x11*C\xee@E\x05\xd5-\x00\x86:\n\x1f\x06\x16\x02\x16\x02\x16\x02\x16\x02\x16\x02\x16\x02\x16\x02\x16\x02\x16\x02\x16\x02\x16\x02\x16\x02\x16\x02\x16\x02\x16*\xc7B\xcdD\xe1\x01\r\xd5BCg\x1ew\a$\x04$\x04$\x04$\x04$\x04$\x04$\x04$\x04$\x04$\x04$\xd4u\xd4\xdd\xf1fX\x06\x8f\x02\x8f\x02\x8f:.\x1e\xb5\x1a\xfb\x93\x91a\frS!\x17at]\x8a\x9e\x1e\xa5\xcc\a\x17\x1b\xb1\xe3\xe0{\n+l\xaf\xa1pPA\x83^*%p\xefܸ\x9d\x93\xc7J\xd4\x1b\xef\xb3W\xddܽ\xfd\xbb\xee\x8f\x0ey\x92}jU\x9a\xf4\xad\xc2,\xf8\xf2b\xfbDHɉjf\x9f\xfe,\xf8ח\x17\xd5&\xc2\xef/hP\xde;\x1d\xd3\xedt0O\x16\x97\xb7\x86g\x85\xaeO\xb9\xa2\x9dp\xcc\x04g\xf1X\xeeʨ\xb4\xe4\x95>֍Lg8\xf5ku\xa7\xf7t\xa7\x9fԝN\x1bTW\x9bN\xdcg\x05\U000e9c33@dAdAdAdAdAdAdAdAdAdAd\x01%\x01%\x01%\x8f\bJ\xb6P\xcbS\x10Ɨ\x17\xa3\xbc\xda\\\x8b\x89\x94\xf9]Տ\xc4+e*\x89'V\xa1\x8a\xef\xbab)/\xac(. -\xea`\xa31\xeb\x17\xaa4B@Ի\xec\xc9@Z(G\xbbe2\xb5T\x89v\xeb\x03/\xe9\x11\x053\xf7\xb5\x12m\x9a}\xce\xcb\xfcsT\x8d\xeef\x1ftPQݨ\xb7\xa5\xa8\xeb]\xe7Z\xae\xeb\x8b;\xc5\xdai\v=\x81\x85\xa9ޫha\xda7\x05\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\x04\xa6\xac\xe1\xc5u\xbf\xf6כ\xf8`:\xa2Ό\x84D\xf4\x05-\x0f$\x00Mz\xab\xffso?\xb7]\xb1ٲ/\xa5u\xed\x13\xa0>\xfcVUal\xd7$\xf4\x90\xa5\xa1\x8a\"\xf4\xb2\x1a6\x85\x93g9\xf3'\xc1)\xce\x1fy1\xf0\xfc\xdey\xfeA\x98}3P\xca\xee\x9f6Y\xefg\x89l\x11Ln\xa5\xa9\xefd\x14D>\xd9K\x80ueR\x92\xc4c%O\x92R\xf1\x10)5\xcf\xe8b\t\xe7\xd7\xea\xef\xc8\xfb]`\x90\xe0\xde1\xcbv\x8b\xab\x05\t\xb2\xebP\x84zy\x1e\v\xc0\x06o+.\xa3\x881I\x90zք\xf3y0\r\xe9\x93ٓ&\xe4\xae1`\x11\xce.\xb5\f*\x1a\x90\xf7\xb8⭙\x86,\x8c\xa6V\xeaNvi~[\xabt3\x99J\xebN>\xffҼ\x90~ȃ\"\x14\xe6\x16#\xd5\xce9IȅmLd\x1aiʝ\xb4\xa4A)\xfc6 Y\x89{h\xfa\xca[\xb2Z\x13X\xf0t\xb7\x8a\x96\xfe\xa3\f\x95\nM\xbcT\xbd\xa5P\x8by멽\x01\x8f\xe7\x8b\xd1\x0f$f\xab\xa1S\xfd\xb8\f\x16\x8cH\x12\xdcI#\xbcZ\x92\xc4\xcfﮱ\xee\xf5\x83?\x8b\x03\xd5\xf3\x1f\x9f\xa9\xaf?>\xa3\a-\x84̮\xec\xc3H\xce\x17&q\xad\xe4\xfbi\xa2\x95\xaa\xdb_\xc4ɏbюFޛP\x90\x98\xd3t\x1a\xc6ܻ\ttRF\xa5Ko\xbcY\xcb(\x8d\xa3\xf5'g\x04T\xc3n\xcd+\xc5\xcdh\xf4\xd2:\xd6YHu\xf7H/\x1b\xb3\xe6\xeb\x98\x10ͻ\xd3T\xe2\x10,\xee\xb6\xc2Wz\x97i]\xf9\x1b\xc9\"13\\\xa5\x85\xe1\xc9?\xa5)9M\x8c\x14\x02qY\xcdO\x86!\x96@0\xf9\xd6`ڑ\xd3\xdf\x06\xf0\x19\xf5\xeaJ\xcf\xf2I\xe6a#Oْ\x05\x1aʲ\x10l\xf3ʻP\xefpA\xba\xb8\xea\xeb\xf4\x1c\x8a\x7f\xf4.~\xf2'\x9f\xf9\xa4YL\xf9*\xd2f\xa9\xd3\xf8\xa2L\xc7)\x00\xaf\xd7S\xfa&\xe6\r\xc6\xf6Nt۟i\xf7qnKzr<\xf1y\xd75\xfd#\xe4D\xdd/\x16\b\x95\xbfჽGJ\x13\xc9L\xb4\xd3Q.{S)YE\xcf5=1\f)\x0ePW\x9e\xce\n\x06W\xf2\x9c\xbf\x15\xc8\b\xf3`\xf5\x18\x94\x7fM+\x9d\x1e\xff\x18N\xaer\x17\x0e[\x16\x91\x86\x1e\x85(r'S\xa0\x17\x91\xfd Q\xba~Ү\xc1\x80\x187gA\xe1\x13\x8f&iA}\f\xd4@\x9c#\x02\x1c\xab\x048\xc2e\b.Cp\x19\x82\xcb\x10\\\x86\xe02\x04\x97!\xb8\f\xc1e\b.Cp\x19\x82\xcb\x10\\\x86\xe02\x84\xc8\xc6<\x98:\x8a\x90\xc6\xc3b\x19O2\x88\xb17o\x87\xee\x98i\xeb\xfe\r\xed\a*\x0e>B\xb1\xc9\xd0\xc4\x13\x8fI\x04Y\x04Y\x04Y\x04Y\x04Y\x04Y\x04Y\x04Y\x04Y\x04Y\x04Y\x04Y\x04Y\x04YD0\xe2)\x05#\x9e^\x14\xe2\x81\xe1\x87'\x18w\xf8\xacC\x869\xe0H\xc3\xe6B\fO3\xb6\x10\x98}\b\x10|34\x06\xee\x9f(\xf7\xeex\xe2#T\x10\xa1\x82\b\x15D\xa8 B\x05\x11*\xd8g\xa8`+1\x82\b\x0e\xac\x1f\x1cxfQ\x81\xc3\x0f\a\xec0\x0e\xf0\x04\x03\x00\xf9\x16\xf1\x92\x04)\xcd_\xe4\xefo\xd7\r\xd4?D\xe1\xc3*q\x81\xbf\x99>\x9f\"\xa98\xfcx\xe0\xc7\x03?\x1e\xf8\xf1\xc0\x8f\a~<\xf0\xe3\x81\x1f\x0f\xfcx\xe0\xc7\x03?\x1e\xf8\xf1\xc0\x8f\a~<yHu\x14E\x0f\x0f\xabvx\x92e\x0e+\x12\x8f6\x9d\x18\xbaK\x89\xdcz.\xe4\xf6\x8b\x1a\x0e\xbe\x9aa\x93e\fO\xbc~!h#h#h#h#h#h#h#h#h#h#h#h#h#h#h\xe3iF\r\xb6\x9c2_\xef\xc8\xe21\xc7\x03ʍ\xf1\xe2I\xb4\xa4\x01\x8e7\x13Ys\xb2\nh\x06\xa8\xe9\xb1\\Er\x02\xa4\xbd\xe9\x04K\x9c\x8bK\xdd\x1e\xb1\x8e'Xc\xb1\x8cFuFc\x87\x16\xf5\xd8\\a\xc5Ӭ\xa8\b\xd3A\x9b\xa6\x83\xa1\xc5?\x1eZ8\xf1$Y~\x7fK\x00\x91\x90\x88\x84D$$\"!\x11\t\x89H\xc8\xce#![\xa9\x96\x882\x89\xf5#!\a)\x7ft\x11\x139\xfc\x12\x89\x1d\xd6F\xec\xb0(\"\xf8R\r\xbetH\xac&\x824+\x06i\xc2_\n\xfeR𗂿\x14\xfc\xa5\xe0/\x05\x7f)\xf8K\xc1_\n\xfeR𗂿\x14\xfc\xa5\xe0/\x85\xe8L\a\x97\r;,s\xcfx\xccS\r\xc4\xecލ\xa2\x03`۞\xe3D\x8b\xc1\x96Í\xb2l$\xbc\xf2\xf4\xe3*\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x01\b\x11Py\x12\x01\x95\x88\xa4\xec\xdb\xd3m\xcf\x10\xcaӌ\x9d|\xd6\x05G\x1db\xb4d\x03a\x92'\x1b\x1f\t\xa2\xdf1v\xdf\f\x86\xba\xfb'F\xda{\x98\xd8\bwD\xb8#\xc2\x1d\x11\xee\x88pG\x84;\xf6\x12\xee\xd8l\x9c#\x02\x1c\xf7\np<\x97\xc8\xc6\x01\x874v\x11ˈ Ʈ\xd1\x0e\nLv\x14\xbb\xe8\xf4\x12\x1d\xed7\xb3\x99\xdd\xe0\xe2g\xa8\xce\xd1j\xc44\x9c\xb8\xe0\xc4\x05'.8q\xc1\x89\vN\\p\xe2\x82\x13\x17\x9c\xb8\xe0\xc4u\xceN\\M\xaa\x99p\"\x83\x13\x19\x9cȎ\u0089\xac:\x10B\xfa\xaa\xd6\x10P\xd7\xec\xe7\bc\x7fA{@{@{@{@{@{@{@{@{@{@{@{@{@{@{\x9a\xa5=\xf2\xd3k\xda\xf7i\x97^U\xf2\x05\xd2'#]\xf5%\x9cn\xe8\bs:·\x02\xebkuG\xb8\x06\xa5\xb8\xd0\xd7\x12_\xc7|o\xfd\xa2\x88K\x87\x8e\xb82\xb3߰\fz0.\x92\xd7l\x85\x19\x15N+\x10$\x10$\x10$\x10$\x10$\x10$\x10$\x10$\x10$\x10$\x10$\x10$\x10$\x10$\x10\xa4\xde\bҮ\xc4@z\xfc\x1cz\xb40\x1b\xa2\xf1'\x02E:\x8c\"\x1d7A\x02=\x02=\x02=\x02=\x02=\x02=\x02=\x02=\x02=\x02=\x02=::z\xe4\xe6x)\x94\xc0[\xcd\xf8\x02v\x05v\x05v\x05vU\x8d]5\xe8\xf6\x04RU\x89T\xc1\xd1i\x17\xaa\x02\xa3\x02\xa3\x02\xa3\x02\xa3\x02\xa3\x02\xa3\x02\xa3\x02\xa3\x02\xa3\x02\xa3\x02\xa3\x82\x87\x13(\x11(\x11(Q/\x94\xa8Y\xd7&\x90\xa2J\xa4\b\xceL D D D D D D D D D D D\xbdz1\xc1}\t`\n`\n`\xaaG0e\xeb\xd1i,%\x7f\x7f\xbbn0\x99\x13\xe2\xef*\xb1\xaat\xc5J$rB\xc57\xd0+\xd0+\xd0+\xd0+\xd0+\xd0+\xd0+\xd0+\xd0+Ы^\xe8\xd5\x19\xd5=\a\xe5\x02\xe5\x02\xe5:G\xcaՀW\x16H\xd7\xfe\xa4\v\xfeY \\ \\ \\ \\ \\ \\ \\ \\ \\ \\ \\\xdd\xfbgu\x98^\n`\r`\r`\r`\xed$\xc1Z\x13~c\xc0hU1\x1a<\xc5J9\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\x00\x1a\\\xc4@\xb2@\xb2@\xb2@\xb2v\x93\xac\x86|\xc3@\xb3\xaa\xd2,x\x83\x81b\x81b\x81b\x81b\x81b\x81b\x81b\x81b\x81b\x81b\x81bu\xec\x06\x06\xff/P3P3P3P\xb32j\x86<a]\x83\xb2Lv\xb0\x9f\xa3\xd5\xcdlf\xe9Y\f\x1f0\xc4R\x02\xa2\x01\xa2\x01\xa2\x01\xa2\x01\xa2\x01\xa2\x01\xa2\x01\xa2\x01\xa2\x01\xa2\xa1\x1a\"\xa8\x11\xa8\x11\xa8\xd1`\xa8\x11\xc2\x03\xdb\xe7D\x00Dp\xaf\x02\x19\x02\x19\x02\x19\x02\x19\x02\x19\x02\x19\x02\x19\x02\x19\x02\x19\x02\x19\x02\x19\x02\x19\x02\x19\x02\x19\x1a\x10\x19\xf2g\xcbO\xfe\x8b\xebR(D\x1f\xa6\x8fj\xf5f\x19\x02\xd4\x1c\xeei\x8d#\xed\x01}T\xdf\xe4\xd1\x0f\xf5I\x01\xf8QW\xdfܽ}\xeftR\x87\xc8\xc7y\xb2$\xa5\xaa\xca}\xbeՙ'\x13UD\xb7\x92\xfb\x99R]Vz\xa37\a\xad\t\xcct\xca\xf1\x9e\xad\xf7Y\xd9\x04\xe3\x9e+\x9da\x85\x1d\ad\x06d\x06d\x06d\x06d\x06d\x06d\x06d\x06d\x06d\x06d\x06d\x05d\x05duDȪy`2\x12\xa8aT\xedQ^y\xae\xc5J\xca|dZq\x8f)T\xf4]g\x19\x8d\x0f\xc4e&\x8a\v\b\x8c:\xe4h\xfc\xfa\x85-\r\x92\x11\xf5F\a\xb1\x11=\xff\xc6\xd1\xd4\xda6\xf4\xdf\xe9\xd0\xed&'\x19\x8dQ\x1fs[\xa6\xc6ˢy\xfdZ\x89?m<\xede\xfei7\x13f0m<\xee\x90E+k\xa6\xcfE\xab\xa4\xcd|\xfb\xd5\xe7$\b\xceX\xf1\xd4g\xca\xc9,a\xf5v\xe5Kؾ5@'@'@'@'@'@'@'@'@'@'@'@'@'@'@g\rϰ\xfb\xb5\xbf\xde\xc4\a\xf3\x12uf$T\xa2_\xe0y \ah\xd2'\xfa\x9f\az\xd0\xed*s {TZ\xef>\x19\x1a\xc4\xefV\x0f\xe7\xf6CQ\x0fY8<\xa8\xbd\xae\x95M\xe1\xa4Z\xce\xfcIp\xba\xf3J^\x0f\x96\x82\xc1Z\n\x0eB\xf7\x9bA\x93{\xff|h}?\xcbh\x8b\xb0s+\r~'#\"2\xcf^B\xb1+\xe7\x92t\x1f+\x19\x95\x14\x95\x87H\xa9\x8eF\xbfKl\aZ\xa5\x1ey\xbf\v`\x12\x84<fyqq\xb5 \xe1x\x1d\x8a\xa2 \xcfc\xa1\xda s\xc5z\x14\x85&\xa9TϠp>\x0f\xa6!}2{\xd2\xd4\xdd50,\xc2٥\x96kE\xab\xf2\x1eW\xbc\x95\xd3\xc0\x85\xd1\xd4J\xf2ɮ\xceok\x15y\xa6]i}\xcc\xe7_\x9a\x17\xd2\x0fyP\xd4\xc3\xdcb\xa4\xda9'\xa9\xbb\xb0\x8d\x89\x84$M\xb9\x93\x964(\xd9\xdf\x06$yq\x0fM_yKV\x95\x02\v\xb3\xeeV\xd1\xd2\x7f\x94\xa1\xba\x8bhi<]\xaa\xdeR\xf8Ƽ\xf5\xd4ހ\xc7\xf3\xc5\xe8\a\x12\xdd\xd5Щ~\\\x06\v\xc6.\tB\xa5\x11^\xd1\fg\xad\xdbӨ\xf8\xfa\xc1\x9fŁ\xea\xf9\x8f\xcf\xd4\xd7\x1f\x9fу\x16B{W\xf6a\xa4;\b\xe7\xb8V:\xc34\xd1t\xd5\xed/\xe2\xe4G\xb1h\\#\xefM(\x98\xcdi:\rc\xee\xdd\x04d)Cե7ެe\x94\xc6\xd1\xfa\x933\x02\xaaa\xb7\xe6\x95\xe2f(\x81\xb4\x8e\xf5\xa0O\xd1W\x8ft\xbd1kӎ\x89Ҽ;M%\x9a\x80s\xee\xb6\xc2Wz\x97i]\xf9\x1b\xc9\"13|\xa9^?\xe4\xd97\t\xa7\x89\xe1C\xc00\xa3\x83d\x18X\xe3\xd6\xd8Ԡߑ\xd3\xdf\x06\x1a\x1a\x95\xedJ\xcf\xf2I\xe6a#O٭\x05Dʲ\x10\x14\xf4ʻP\xefpA\xfa\xbd\xea\xeb\xf4\x1c\x8a\x7f\xf4.~\xf2'\x9f\xf9\xecYL\xf9*Ґ\xa9\xd3\xf8\xa2L\xc7)\xa8\xaf\xd7S\xfa&\xe6\r\xc6\xf6Nt۟i\xf7qnK\xbaw<\xf1y\xd75\xfd#4F\xdd/\x16\xb0\x95\xbfჽGJ\xaf\xc9L\xb4\xd3QX{VSY\xf9Ͻ@brR\x84\xa1\xae\x14\x9e\x15\x15\xae\xe49\x7f+\x90\x1a\xe6\xc1\xea1(\xff\x9a\xd6;=\xfe1\x9c\\\xe5.<\x06\xe9dY\x92\x84i\xa8\xc2ɝL\x87\x1eE\xfc\x83\x84\xeeVj\xecu\t}\xdc:#\x85\xcfm\xb5\xea\xc8\x10\x90S\x03њ\bӬ\x1b\xa6\t\xb7%\xb8-\xc1m\tnKp[\x82\xdb\x12ܖ\xe0\xb6\x04\xb7%\xb8-\xc1m\tnKp[\x82\xdb\x12\xe23˰\xd5Q\x04f6\x11\x91y\xf2\xa1\x98={Vt\xcd[;\xf2\xa5\xe8*\xdcr\xf0q\x96\xcd\aX\x9eQd%\xd8$\xd8$\xd8$\xd8$\xd8$\xd8$\xd8$\xd8$\xd8$\xd8$\xd8$\xd8$\xd8$\xd8$B*O)\xa4\xf2\xb4c)\x0f\f\xa2<\xf1\xe8\xc9g\x9d\xf3\xcf\x01\xc7K6\x1d(y\xfa\x11\x92\x00\xf8\xad\x01\xfc\x01GA6\x13\xfex\xf2q\x8f\bxD\xc0#\x02\x1e\x11\xf0\x88\x80G\x04<\x9e|\xc0c\x8b\x91\x8e\bq<,\xc4\xf1,c\x1b\x87\x1f\xd4\xd8y4\xe3Ɇ1\xf2\x8d\xe2%\t[\x9a\xf5\xc8\xdf߮\x1b\xa8E\x89\"\x94u\xa3\x1b\x7f3\xfd?Ezv\xf8\x12\xc1\x97\b\xbeD\xf0%\x82/\x11|\x89\xe0K\x04_\"\xf8\x12\xc1\x97\b\xbeD\xf0%\x82/\x11|\x89\xca\x10\xd6Q\x14\xa0l\xa2\xf2\xe4ɗ\x9c\xacHB\xdaw\x98\xe8:\x89tG٣\xbb*09\xf8ʒ͗\x94<\xa3Z\x92\xe0\x95\xe0\x95\xe0\x95\xe0\x95\xe0\x95\xe0\x95\xe0\x95\xe0\x95\xe0\x95\xe0\x95\xe0\x95\xe0\x95\xe0\x95\xe0\x95\xe0\x95\xa7\x19\xfb\xd8II\x01\xbd/\x8b/\x1e\x0f+7ɋ'ђ\x869\xdeLd\xe5\xc9Z\xa0y\xa0&\xc9r\x15\xc99\x90\xf6\xd3\x138q^\xcez{Dl\x9ex\xbd\xcb2J\xd51\xc9\x1dZ\xecf\xd3E.O\xbf\xba%\x8c\x12\xdd\x1a%\x86\x16\xc5\xd9L\x11˓\xb7\x0f\xf4\xb7L\x10ωxN\xc4s\"\x9e\x13\xf1\x9c\x88\xe7\xec<\x9e\xb3\xc5ʕ(YyX<\xe7 %\x92\xee\";\x87_\xae\xb2\xf3:\x95\x1d\x16\xa8\x04˪Ͳ\x0e\x898E\xa8\xe9\x1e\xa1\xa6\xf0ق\xcf\x16|\xb6\xe0\xb3\x05\x9f-\xf8l\xc1g\v>[\xf0ق\xcf\x16|\xb6\xe0\xb3\x05\x9f-\xf8l!\xc64\aӆ\x1d\\zPT\xe99\x84\x93\xf6\xe5\xb2\xd1\x19\xecm\xdbI\xa3\xf5\x90\xd1\xe1Ɗ6\x18$z^ѡ@\x8c@\x8c@\x8c@\x8c@\x8c@\x8c@\x8c@\x8c@\x8c@\x8c@\x8c@\x8c@\x8c@\x8c\b\v=\x89\xb0Pă\x0eɇn\xcf@\xd0ӏ\x00}\xd6\x1d\x83\x1db\xccgc\xc1\x9eg\x11\xe5\t[A\xef(\x7f30\x92\xef\x9f0\xbd\xefa\xf2#h\x13A\x9b\b\xdaD\xd0&\x826\x11\xb4\xd9K\xd0f\x1bњ\b\xd3<8L\xf3\xbc\xe23\a\x1c\x98\xd9]D&B1\xfb\xc1H(\xf6\xd9C\x04\xa6\xd3c$\n\xdc\xccfv\xfb\x8b\x9f\xa1RJgQ\xe1p*\x83S\x19\x9c\xca\xe0T\x06\xa728\x95\xc1\xa9\fNep*\x83S\xd99;\x955\xa9\x84©\rNmpj;\n\xa7\xb6\xba\xb8\b)\xba:\x01D\xfd\x90\xa1\xa3\x8do\x06\v\x02\v\x02\v\x02\v\x02\v\x02\v\x02\v\x02\v\x02\v\x02\v\x02\v\x02\v\x02\v\x02\v\x02\vj\x83\x05\xc9\r\xaei\xf7\xa7\xbdzUɏH\x9f\x8ft\u0557p\xba\xa1\x83\xcc\xe9\x0eߊ\xad\xaf\xd5\x1d\xe1VT@\x8d\xben\xf5\xa2\xcc\xf7\xdc/\x8a\xc4t\xe8\xf4+s\xfd\rK\xa5\ac$y\xd9VXR\xe1\x14\x03Y\x02Y\x02Y\x02Y\x02Y\x02Y\x02Y\x02Y\x02Y\x02Y\x02Y\x02Y\x02Y\x02Y\x02Y\x1a\x04Yڕ\xd8H\x8f\xa2C\x95\x16f[4^H\xa0K\xcdѥ\xe3&K\xa0J\xa0J\xa0J\xa0J\xa0J\xa0J\xa0J\xa0J\xa0J\xa0J\xa0JGJ\x95\xdc|2\x85rx\xab\xd9e\xc0\xb4\xc0\xb4\xc0\xb4\xc0\xb4\xea0\xad\x06ݤ@\xb0j\x13,8F\xedBX`W`W`W`W`W`W`W`W`W`W`W\xf0\x88\x02=\x02=\x02=\xea\x9d\x1e5\xeb\n\x05\x82T\x9b \xc1\xf9\t\xe4\b\xe4\b\xe4\b\xe4\b\xe4\b\xe4\b\xe4\b\xe4\b\xe4\b\xe4\b\xe4h\x00^Opw\x02\xb0\x02\xb0\x02\xb0\xea\x1dXيy\x1aW\xc9\xdf߮\x1bL\x16\x858\xbe\xda\f+]k\x13\x89\xa2P\x87\x0eT\vT\vT\vT\vT\vT\vT\vT\vT\vT\xabG\xaauF\xb5\xdaA\xbf@\xbf@\xbfΗ~5\xe0\xc5\x05\x02\xd6\f\x01\x83?\x17\xc8\x17\xc8\x17\xc8\x17\xc8\x17\xc8\x17\xc8\x17\xc8\x17\xc8\x17\xc8\x17\xc8\x17\xc8W_\xfe\\\x1d\xa6\xaf\x02p\x03p\x03p\x03p;a\xe0ք\x9f\x19\xf0\xda>x\r\x9ee\xa5|\r`\r`\r`\r`\r`\r`\r`\r`\r`\r`\r`\r.e \\ \\ \\ \\\xf5\bWC\xbed\xa0\\\xfbP.x\x8f\x81n\x81n\x81n\x81n\x81n\x81n\x81n\x81n\x81n\x81n\x81n\xf5\xe26\x06\x7f1\xd04\xd04\xd04д\xed4\ry\xc8\xfa\x04h\x99\xecc?G\xab\x9b\xd9\xccR\xb5\x18>c\x88\xc9\x04\\\x03\\\x03\\\x03\\\x03\\\x03\\\x03\\\x03\\\x03\\\x03\\CuF\xd0$\xd0$ФA\xd2$\x84\x19vˏ\x00\x8e\xe0\x8e\x05b\x04b\x04b\x04b\x04b\x04b\x04b\x04b\x04b\x04b\x04b\x04b\x04b\x04b48b4&\xb9\xe9\xc5u)%\xa2\x0f\xd3'\xb5z\xb1\f\x12j\x8e\xff\xb4\x06\x96\xf6\xa0@\xd25y\bD]R\x80\x80\xe4⛻\xb7\xef\x9d.\xea\x90\xfa8O\x96\xfcVU\xd1Ϸ\x1a\x93d\xa2\n\xfaVrRSj\xcbJo\xf2\xe6\x905a\x9dNi\xe0\xb3\xf5Q+\x99]\xdcqeӫ\xb0\xdb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xcb\x00\xab\x00\xab\x00\xab\x8e\bV5OKFB4\xb4\xa2=\xca\xebε8I\x99\x8bL+\xde1\x85z\xbe\xeb+\xa3\u0601\xb8\xcaDq\x01}QG\x1c\x8d^\xbf\xa0\xa59*\xa2^\xe8\x10.\xa2\xe7\xde8\x9aZ\x8b\x86\xfe;\x1d\xe0\xdd\xe0\x04\xa3\x01\xeaaZ˴xY4\xa5_+\xc1\xa7\x85\x87\xbd\xcc?\xecf\xc2𥅧\x1d\xb2Ze\xb5\xf4\xb8Z\x95\x90\x99o\xbe\xfa\x9c\xe4\xbf\x19\xeb\x9b\xfa(9\x95\xb5\xab^\xaet\xed\xdaw\x06\xdd\x04\xdd\x04\xdd\x04\xdd\x04\xdd\x04\xdd\x04\xdd\x04\xdd\x04\xdd\x04\xdd\x04\xdd\x04\xdd\x04\xdd\x04\xdd\x04ݬ\xe1\vv\xbf\xf6כ\xf8`X\xa2Ό\x84I\xf4\x8a9\x0f\xc4\x00Mz@\xff\xf30\x97\xb9]U\x11d\x87JkݧB\x82\xf8\xd5j1\xdc^\xd8\xe9!k\x86G\xb4\xcfe\xb2)\x9cP˙?\tNvN\xc9\xdb\xc140H\xd3\xc0A\xb0~3dV\xef\x9f\v\x9f\xefg\x01m\x11on\xa5\xbd\xefd<D\xca\xd9K\fv%[\x92\xe7c%\x95\x92j\xf2\x10)e\xd1ht\x89\xb5@+\xd1#\xefwAJ\x02\x8d\xc7,!.\xae\x16$\x0e\xafCQ\r\xe4y,F\x1bH\xae\xe8\x8e\xe2\xce$\x87\xea\xf9\x13\xce\xe7\xc14\xa4OfO\x9a\xb3\xbb&\x85E8\xbbԒ\xac\xe8Q\xde㊷p\x1a\xb70\x9aZ\xd9=\xd9\xcd\xf9m\xad\xea\xce|+\xad\x81\xf9\xfcK\xf3B\xfa!\x0f\x8as\x98[\x8cT;\xe7$g\x17\xb61\x11\x8a\xa4)wҒ\x06e\xf9ۀ\x84-\xee\xa1\xe9+o\xc9\xcaQ`\xf1\xd5\xdd*Z\xfa\x8f2Tw\x11-\x8c\xa7K\xd5[\nؘ\xb7\x9e\xda\x1b\xf0x\xbe\x18\xfd@º\x1a:Տ\xcb`\xc1\xa0%\x81\xa64«%\xe9\r\xfc\xee\x1a\x0e_?\xf8\xb38P=\xff\xf1\x99\xfa\xfa\xe33z\xd0B\xf8\xee\xca>\x8c\xb4\x05!\x1b\xd7JK\x98&\xba\xad\xba\xfdE\x9c\xfc(\x16\x1dk\xe4\xbd\t\x05\xac9M\xa7a̽\x9b\xa0+e\x9a\xba\xf4ƛ\xb5\x8c\xd28Z\x7frF@5\xecּR\xdc\f\x17\x90ֱ\xe6\xf3)\xfa\xea\x91v7f\xfd\xd91I\x9aw\xa7\xa9D\x13p\xce\xddV\xf8J\xef2\xad+\x7f#Y$f\x86/\xd5\xeb\x87<\xfb&\xe141u\b\nfX\x90\f\x03\xeb\xd8\x1a\x94\x1a\xd8;r\xfa\xdb`B\xa3\xa4]\xe9Y>\xc9<l\xe4)+\xb5\xa0GY\x16\x02\x7f^y\x17\xea\x1d.H\xa3W}\x9d\x9eC\xf1\x8f\xde\xc5O\xfe\xe43\x1f<\x8b)_E:1u\x1a_\x94\xe98\x85\xf1\xf5zJ\xdfļ\xc1\xd8މn\xfb3\xed>\xcemIێ'>ﺦ\x7f\x84\xbf\xa8\xfbł\xb2\xf27|\xb0\xf7H\xa92\x99\x89v:*j\xbf\x8a)k\xfb\xb9\xf6'6&\x85\x14\xea\n\xdfY9\xe1J\x9e\xf3\xb7\x02\x91a\x1e\xac\x1e\x83\xf2\xafi\xb9\xd3\xe3\x1f\xc3\xc9U\xee\xc2#\x10M\x96%\xf9\x96\x06*\x99\xdc\xc9d\xe8O\xb4?H\xdan\xa5\xec^\x87\x88ǭ9R\xf8\xd8V+\x90\f\x0005\x10\x8c\x89(\xcczQ\x98pP\x82\x83\x12\x1c\x94\xe0\xa0\x04\a%8(\xc1A\t\x0eJpP\x82\x83\x12\x1c\x94\xe0\xa0\x04\a%8(!\xfc\xb2\x84X\x1dE\xdce\x03\x01\x97'\x1eiٯ\x1fEǔ\xb5\x1bω\x8e\xa2)\a\x1fF\xd9x\xfc\xe4\xd9\x04N\x02H\x02H\x02H\x02H\x02H\x02H\x02H\x02H\x02H\x02H\x02H\x02H\x02H\x02H\"b\xf2\x94\"&O9T\xf2\xc0\x18ɓ\x0e\x8e|\xd65\xf5\x1cp8d\xc3q\x90\xa7\x1e\x00\tb\xdf\x0e\xb1\x1fp\x90c#э'\x1eֈxF\xc43\"\x9e\x11\xf1\x8c\x88gD<\xe3\xc9\xc73\xb6\x17Ȉ\b\xc6C\"\x18\xcf1tq\xf81\x8b]\a+\x9ej\x94\"\xdf'^\x92\x98\xa5Ɏ\xfc\xfd\xed\xba\x81J\x92(!Y/x\xf17\xd3\xfbS\xe4Y\x87\xd7\x10\xbc\x86\xe05\x04\xaf!x\r\xc1k\b^C\xf0\x1a\x82\xd7\x10\xbc\x86\xe05\x04\xaf!x\r\xc1k\xa8\x84^\x1dE\xf9\xc8\x06\xeaF\x9ex\xc1Ȋ\x14\xa4u\xf7\x88\x8e3Bw\x93\n\xba\xa3\U000900ef\v\xd9xAȳ\xa9\x04\tH\tH\tH\tH\tH\tH\tH\tH\tH\tH\tH\tH\tH\tH\tHy\x9a\xa1\x8d]\x94\b\xd0۲8\xdf\xf1\xa8r\x8b\xbcx\x12-i\x94\xe3\xcdD\x16\x9e,\x05\x9a\x06j\x8e,W\x91\x1c\x03i\xc7<a\x13g坷G@\xe6IW\xab,#T\xdd\xf2ۡ\x85f6\\\xa2\xf2\xd4kS\xc2\nѡ\x15bhA\x9a\x8d\x94\xa0<q\x8b@\x7f\v\x04\xe1\x9a\b\xd7D\xb8&\xc25\x11\xae\x89p\xcd\xce\xc35۫;\x89\x82\x93\x87\x84k\x0eR\x1c\xe9,ps\xf8\xc5&\xbb\xae2\xd9ayI\x90\xab\xba\xe4ꐀRD\x92֎$\x85w\x16\xbc\xb3\xe0\x9d\x05\xef,xg\xc1;\v\xdeY\xf0\u0382w\x16\xbc\xb3\xe0\x9d\x05\xef,xg\xc1;\v!\xa4Y\x8e6\xec\xd8\xd1C\x82FO?Z\xb4'\a\x8d\xae\x10o\xcb.\x19mG\x84\x0e7\x14\xb4\xb9\x18\xd0s\n\xfe\x04W\x04W\x04W\x04W\x04W\x04W\x04W\x04W\x04W\x04W\x04W\x04W\x04W\x04WD\xd4\xe7ID}\"\xdcs8Ns{\xc6y\x9ez\x80\xe7\xb3\xce\xc8\xeb\x10C:\x9b\x8a\xe5<\x83 N\x18\a\xfa\x85\xf7\x9ba\xb1{\xffdy}\x0f\xd3\x1e1\x99\x88\xc9DL&b2\x11\x93\x89\x98\xcc^b2[\b\xc6D\x14\xe6\x81Q\x98g\x15~9\xe0\xb8\xcb\xce\x02.\x11i\xd9\v4B\xa9\xce\xce\x03,\x9d\xfe\"\x11\xe0f6\xb3;_\xfc\fuN:\x8a\xf7\x86\xf3\x18\x9c\xc7\xe0<\x06\xe718\x8f\xc1y\f\xcecp\x1e\x83\xf3\x18\x9c\xc7\xce\xd9y\xacI\xfd\x13\xcekp^\x83\xf3\xdaQ8\xaf\xd5$EH\xbe\xd5\x01\x1b\xea\x05\n\x1dk\xe420\x100\x100\x100\x100\x100\x100\x100\x100\x100\x100\x100\x100\x100\x100P\v\x18H~\x7fM\x9b?mիJ\xdeC\xfax\xa4\xab\xbe\x84\xd3\r\x9dcNo\xf8Vj}\xad\xee\bg\xa2<0\xfa\xba\xcdm2\xdfo\xbf(\nӡ\x8b\xafL\xf47,\x92\x1e\x8c\x90\xe4][\xe1H\x85\x13\fT\tT\tT\tT\tT\tT\tT\tT\tT\tT\tT\tT\tT\tT\tTi\x00TiW\xe2\"=\x88\x0eQZ\x98]\xd18\x1f\x81,5E\x96\x8e\x9b*\x81(\x81(\x81(\x81(\x81(\x81(\x81(\x81(\x81(\x81(\x81(\x1d'Qr\xb3\xc7\x14\x8a\xe1\xad\xe6\x92\x01\xcf\x02\xcf\x02\xcf\x02Ϫ\xc1\xb3\x1at\x8f\x02\xbd\xaaI\xaf\xe0\x10\xb5\v_\x81[\x81[\x81[\x81[\x81[\x81[\x81[\x81[\x81[\x81[\x81[\xc1\x13\n\xe4\b\xe4\b\xe4\xa8grԬ\v\x14\xe8QMz\x04\xa7'P#P#P#P#P#P#P#P#P#P#P\xa3\xfe\xbd\x9d\xe0\xe6\x04X\x05X\x05X\xd57\xac\xb2e\xf14\xaa\x92\xbf\xbf]7\x98\x1c\n\xb1{5\xf9U\xba\x98&\x12C\xa1\xdc\x1c\x88\x16\x88\x16\x88\x16\x88\x16\x88\x16\x88\x16\x88\x16\x88\x16\x88\x16\x88V\x7fD댪\xb1\x83|\x81|\x81|\x9d-\xf9j\xc0{\v\xf4\xab\t\xfa\x05?.P/P/P/P/P/P/P/P/P/P/P\xaf\x9e\xfc\xb8:LW\x05\xd8\x06\xd8\x06\xd8\x06\xd8v\xba\xb0\xad\t\xff2\xa0\xb5\xfah\r\x1ee\xa5l\rP\rP\rP\rP\rP\rP\rP\rP\rP\rP\rP\r\xaed\xa0[\xa0[\xa0[\xa0[u\xe8VC>d \\\xf5\t\x17\xbc\xc6@\xb6@\xb6@\xb6@\xb6@\xb6@\xb6@\xb6@\xb6@\xb6@\xb6@\xb6\xfap\x17\x83\x9f\x18H\x1aH\x1aH\x1aH\xdaV\x92\x86\xbcc\xfd\xc1\xb3L\xb6\xb1\x9f\xa3\xd5\xcdlf\x89Z\f_1\xc4a\x02\xac\x01\xac\x01\xac\x01\xac\x01\xac\x01\xac\x01\xac\x01\xac\x01\xac\x01\xac\xa1\n#H\x12H\x12H\xd2\x00I\x12B\v\xbbdG\x80Fp\xc3\x02-\x02-\x02-\x02-\x02-\x02-\x02-\x02-\x02-\x02-\x02-\x02-\x02-\x02-\xea\x83\x161U\x98nf,\rjJT\x8a\x84\xe8C\xeaHz\xbb\xb9\xed\x7f\xdfSz}\x1a\x015\xc7{Z\x03I;\xa8O\xd2+y\xccC\xddpo\xbf\xbe\xb9{\xfbw\xdd\x03\x1db\x1c\xfbԪ\x10\xe7ۖ\xf1\xfe\xf2\u009f-?\xf9/\xb6\x0f|J$S3\xf8\x84G\xfd_\xa6Sv\f\xff\xef\xfa2\x1a\x90\xf7N\xb7t;\x15̓%_Y\xa33\x82\xce}\xfa\xc1\xfai2#\xd9y\v,V\x8a\xe8J\x1f\xdbFl2ѹw\xfa.\xaf\xf9.\xa7M\x8b+\xcd \xee\xad\xfc\x14\xca\xf6\x12@'@'@'@'@'@'@'@'@'@'@'@#@#@\xe3\x11\x81\xc6\xe6a\xc8\xc8\x01\x16F\xc9\x1e\xa5\xb4\xe7Z \xa4̿\xa9\xbekS\x0e\xa4$.MY\xe5\xde\xf5mҘ@\x9c\x9b\xa2\xb8\x80\xae\xa8\x83\x8dƬ7\x90\xd2\x04\xf5PoQ\x9b{\xe8\xb95\x8e\xa6\xd6ڤ\xffN\a\xdd7:\x81h0z\x99\xb82\t^\x16M\xda\xd7J\xb8i\xe5q/\U000cfed90bi\xe5y\x87\xacIY\x1f\x1d\xafI%@曬>'\xd9nƺ\xa4>&\x8ez\x85\xaa7ʯ\xd0\xd7\xf6\x15\xc1(\xc1(\xc1(\xc1(\xc1(\xc1(\xc1(\xc1(\xc1(\xc1(\xc1(\xc1(\xc1(\xc1(\xc1(\xab9lݯ\xfd\xf5\xe6p\x14\xa2Ό\x84>t\x8e*\x0fT\xfd\x9b\xf4;\xff\xe7\x1e\xbel\xbb\xaaN\xc8V\x94V\xaf\x8f\x1a\xee\xf0\xfb4\xe8tV\xb6\xe8\uf540+B\xf2\x9f\"l\x8e\xf9/\x9a\xb6#\xcf{\xc3\xff6\x9f\xcf}\x1a[\xfa\x87N\xb3\x19)\xf8\xc1\xeaJ\xf7\xf4D\xab\xa1\xb4\xcd}\x0e\xbc\v\x1b*}\xe1L\x03\xb9c\x13\x9b\x94m\xafEFc\xd3r\x9aw\xdcdi,O\xe2el\x1a\xa6E!\x12\xe0W\"ÈH\xaa\xb6\x9eT\x1b\xf9\xa7C\xb0\x87\x1c\xbc\xd9\xf0\n\xe9z\x7f\xd9\x14.\xca\xe5̟\x04\xa7\xb5.\xe5\x95`\x17\x19\x9a]\xe4 ;\xc5fhf\n\xff4M\x13\xdd/\x92-\x92ݭ4\xf2\x9d\xf4\xbc̡\xbd4\x00W\xa8'U&V\x029\x1dK\x0f\x91ғ\x8d2\x9b\x18J4?\x18y\xbf\xcb\t&\xbc|\xcc\xc2\xf1\xe2jA\x9a\xc0:\x14\xadH\x9e\xc7\x1a\x84\xb1\x0f(\xb0\xa5\x90;\x89\xe0z\xa6\x84\xf3y0\r\xe9\x93ٓ61\xb8֔E8\xbb\xd4B\xbc\xa8\x90\xde\xe3\x8a7d\x1a\xac0\x9aZ\xb5%ٛ\xf9m-\xb5`\xb4\x97V>}\xfe\xa5y!\xfd\x90\a\xe7<\r\xf9\a\xd2\xce9\x9dޅmL\x0e\\iʝ\xb4\xa4A5\xe66 \x99\x93{h\xfa\xca[\xb2^\x18Xrw\xb7\x8a\x96\xfe\xa3\f\xd5]D\xeb\xe0\xe9R\xf5\x96bU歧\xf6\x06<\x9e/F?\x90\x9e\x92HI\xf4m\xb0`Ɣ\xf0b\x1a\xe1\x15MnF\f\x9e\xe6\xe2\xd7\x0f\xfe,\x0eT\xcf\x7f|\xa6\xbe\xfe\xf8\x8c\x1e\xb4\x10\xb4\xbd\xb2\x0f#EI\xa0εR\x90\xa6\x89Z\xafn\x7f\x11'?\x8aE\xbd\x1cyoBa\x8aN\xd3i\x18s\xef&\xd4NY\xe5.\xbd\xf1f-\xa34\x8e֟\x9c\x11P\r\xbb5\xaf\x147\x83D\xa4u\xac\xf4}\x8a\xbez\xa4؎\x19\x1d8vW\xf3\xee4\x9588\x8d\xbb\xad\xf0\x95\xdeeZW\xfeF\xb2H\xcc\f_\xaa\xd7\x0fy\xf6M\xc2ib\xe5\x11\nΜ$\x19\x86XB\xe4\xe4[ùGN\x7f\x1bBj\xf4\xd3D\xe4M?l\xe4)\x03\xbcPWY\x16½^y\x17\xea\x1d.\xbc+=G\xd2s(\xfeѻ\xf8ɟ|\xe6ce1\xe5\xab\xfcٌ:\x8d/\xcat\x9c\xb2`\xe8\xf5\x94\xbe\x89y\x83\xb1\xbd\x13\xdd\xf6g\xda}\x9cے\xdc\x1bO|\xce\xebl\xfaG\x84bu\xbfX(^\xfe\x86\x0f\xf6\x1e)\x8d.3\xd1NG;\xef^'g\xb8\x91ksbRS\x04\xa5\x86\xf8\x9c\x95\x02\xae\xe4\x11\x7f+\x10\b\xe6\xc1\xea1(\xff\x9aV7=\xf91\x9c\\\xe5.\x1c\xb4\xe0!\xed\x1c\xb8\xdcq'\xc3\xfe\xedHu\xcbe\xfdR\x8e\xfd\xd3+\xb7xM\xf6\x91\xadV\xb1\xe9\x1c\x9b\xa9\\\x81U\x03A\xabe\rD@hA\xe2\xc0\x1d\x9b\xcc/\xca\x1d\xf5\xacr\x05\xf6\xbf\xce\xe1\xa0\x06\a58\xa8\xc1A\r\x0ejpP\x83\x83\x1a\x1c\xd4\xe0\xa0\x06\a5d\vD\xb6@8\xc8\xc1A\x0e\xd9\x02w\x83\xa2]^Vz\xe4\x1cH\xb40[!\xb2\x87\xed\x0f\x8b\x8e\x1b\x14\x01\x12\x01\x12\x01\x12\x01\x12\x01\x12\x01\x12\x01\x12\x01\x12\x01\x12\x01\x12\x01\x12\x1d\x0f$:\t\xe7\b\xd0)\xd0)Щ#\xa3SJ\\\x8aQ\xc9\xc2aJ\xbaO\x8a\v\x19\xe8/\x8f\xaf\x8aEf\xa4Q\xc3\";\xde;*X\xe8\xabN\xa2~E\xd9\\X\xd8\"\xbb\nC\xcb\xdf߮\x97\xd1T\x04\xa7\xf5\xde\xf5,\xa2\xe9\x9d\xdc\xe0\xc4i\xf4\xee\x99$\x95,2S\xc9\xc6\xebN\xdd~\x02c\x05c\x05c\x05c\x05c\x05c\x05c\x05c\x05c\x05c\x05c\x05j\x04j\x04j<\xf3j\x16\x06_$\xf1\xb5Fo\ueaceE\x1a\xa88\xfeT\x8eB\xbfg\x05\x8b>\xd0\xc9\xe1\x9cC\u05ee؇t\xb4\x92\xa7\xa9|ʴ\x15\x06\xbe\xf5\x89M\xe6f\xda\xf9\xa0ƪU\xecxR\xc7u*\x0eXs\xf5*T\x1c\xe7\n\xd4\t\xa02+0\xa9L\x01\xea\b\xea\b\xea\b\xea\b\xea\b\xea\b\xea\b\xea\b\xea\b\xea\b\xea\b\xea\b\xea\b\xea\b\xeax|\xf5)\x0eA\x90\a*\xfdz/\x16/v\x1eJ~]/\x9eDK\x1a\xdax3\x91\xd5&\xf3\x9f\xc6^M\x8c\xe5*\x92\xbd?\xed\xdf.8\xe2h2\x00\xee\xedD\xb7O!\x8d\xe3dPRB\xa3Yo7\x94\xd1\x18P\x19\x8d\x16\x81p\xfd\x02\x1a\x87l\x805Jg\x1c\xebJTE3`\x90\xe9\xdd \xd3m\x99\x8c\xf6\xac$\xfeiYF\xfaZ\n(\x8f\x81\xf2\x18(\x8f\x81\xf2\x18(\x8f\x81\xf2\x18\r\x97\xc78\b\t\xecU\x18\xa3D 8\xe9\xa2\x18\xbb\x05\x0eU\x12c\xf8\xf2F\xabe1ڔ\x8a\xf7ȁ\xd6#.Ke{p\x1e\xd7j\xa6\a0\xbaTi[D\xb4\xb6\x19\xd1j{\x87\x0e\xf9\x9b\xd9\xccnumDJ\x9f\x9a;0\x1c\xf0\xe0\x80\a\a<8\xe0\xc1\x01\x0f\x0exp\xc0\x83\x03\x1e\x1c\xf0\xe0\x80\a\a<\xd4߀\x03 \x1c\x00\xe1\x00xp\x86\xc3L\xf5\x8d\xfd3\x9eU,\xdc\nN\x94\xaa±\x1b\x82\x9fe\xd9V\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00#\x00\xa3^\x80\xd1\x199J\x80g\x81g\x81g\x9d\x17\xcfj\xa2\xbe,\x98VM\xa6uV\xd5e\xc1\xb2\xc0\xb2\xc0\xb2\xc0\xb2\xc0\xb2\xc0\xb2\xc0\xb2\xc0\xb2\xc0\xb2\xc0\xb2\xc0\xb2\xfa\xaf+\x8bH#\x004\x004\x004\x00\xb4m\x00\r\x9e_\xddP\xb2\x94\xbfW\aa\x82\xf0\xff\x023\x033\x033\x033\x033\x033\x033\x033\x033\x033\x033\x03\x1f\x02\x1f\x02\x1f\x02\x1fJ\xf8\x90\x9a\x97\x06\x0f\x95R\xa0G\xc9\xcc\xc8\xf9!m\xe7\xfb\x9eR\xe8\xb7'\xbf;(\x13]?)\xeeT\x97\xe4\xc9\x0e\xf5\xc1\xbd\xfa\xee\xe6\xee\xed\xdf\xf5\xbbwHn\xecS\xabr\x9boe\xc3\xfc\xe5\xc5\xf6\x91N\t`j\xbe\x9e\xea0\xff\xebˋm#\xfd\xfb\v\xea\xf5\xf7N't;\xde\xe6ɵR{m\x1bv\xfd\xc9dF\xd2p\xb0o:8\xdd7\xaf\xf9&'\xce{\xb7L\x12I\x05gfI\xa6K\x80)\x81)\x81)\x81)\x81)\x81)\x81)\x81)\x81)\x81)\x81)\x81\t\x81\t\x81\tϺ\xb0\xe8\xc8 \t)\x16\x92\xe8\xcc}\xe5.O\x01\x92\xc4\x13)\xa3λ\xceH\xca\r)\x8a\v\xa8\x89:\xc4h|\xfa\x02$\a\xd0\f\xd5\xf6\x8a<\xa3\x95\x1ay\xc5\x13\xa3\xad\x02\x1d\xa5Ok\xb26\xdeև\xbc\xcc?D\x95-j\xf0)\a\xd5\x19\x89\xbaZM;J\xef9\xb5\xaah\xc7;µ\xa5K\xf0\x99\xb5\xf5ھ\x0f\xa8!\xa8!\xa8!\xa8!\xa8!\xa8!\xa8!\xa8!\xa8!\xa8!\xa8!\xa8!\xa8!\xa8!\xa8a\xf7\x15\x87\x13\xd2\xd0\x11F<P\xe1o\xa9\x8e\xe8\x0e\xaf\xb1]I\xf5d\xdbI\xab\xd2G\bm\xf8-\x0ev\xef*[\xccN\x05\xfb\xe0O\x11\"\xc7\xfc\x17MǑ\xe7\xbd\xe1\x7f\x9b\xcf\xe7>\x8d\x1e\xfdC\xa7Ԍ\x14\xf7`\x95\x14=W\xea%m_\x9f\x03\xef\u0086\x13_8\x03-wlb\xf3\xb1\xed\xb5(hlZ\xce\xf5\xe2\xa9\xc9\xd2X\x9e\xa6\xcb\xd84L\x8b8$\x98\xafD6\x11QSm)\xa96\xf2O\xfb\xb3<\x1c\xbcu<\x06]\x19\x1d6\x85\x8bm9\xf3'\xc1)\xac7y\x11X \xba\xb2@\x1cd\x1b\xd8\f\xc34\xe0\x9f\x809\xa0\xf3\x89\xbeE\x9a\xba\x95\xa6\xbd\x93^\x96Y\xb2\x97\xd4\xed\nҤ>\xc4J\b\xa6#\xe3!R\xba\xa9Q \x13\xe3\x84\xd6\xd9G\xde\xefr\xba\b\xa3\x1e\xb3@\xba\xb8Z\x90\xf4\xbd\x0eE\x13\x91\xe7\xb1\xd4n\x98\xbc\x82I\ns\x93ثgE8\x9f\aӐ>\x99=i\xac\xefZ0\x16\xe1\xecR\v\u03a2\xb6y\x8f+\xde@i\x88\xc2hjU\x85d/巵\xa4\x80qZZ\xe1\xf3\xf9\x97\xe6\x85\xf4C\x1e\x9c\xb3.\xe4\x1fH;\xe7t\xb2\x16\xb619\f\xa5)wҒ\x06U\x87ۀ$>\xee\xa1\xe9+oɺX`i\xd9\xdd*Z\xfa\x8f2Tw\x11M\xfa\xa7K\xd5[\x8a\x0f\x99\xb7\x9e\xda\x1b\xf0x\xbe\x18\xfd@\xbaA\"\xc1з\xc1\x82\xb9N\xc2hi\x84WKRS\xf8\xdd5\x8b\xbe~\xf0gq\xa0z\xfe\xe33\xf5\xf5\xc7g\xf4\xa0\x85\xe0\xe4\x95}\x18)'\x02R\xae\x95R2MTiu\xfb\x8b8\xf9Q,*\xdd\xc8{\x13\n\xc7s\x9aNØ{7!e\xca\x12v\xe9\x8d7k\x19\xa5q\xb4\xfe䌀jحy\xa5\xb8\x19\f!\xadcE\xebS\xf4\xd5#er\xcc\xea\xbac\xd74\xefNS\x89c\xac\xb8\xdb\n_\xe9]\xa6u\xe5o$\x8b\xc4\xcc\xf0\xa5z\xfd\x90g\xdf$\x9c&\x96\x15!\xcf\xcc&\x92a\x88%\xd2K\xbe5ly\xe4\xf4\xb7\xa1\x92F'L\xc4\xd1\xf4\xc3F\x9e2f\v\xe9\x94e!\xac\xe9\x95w\xa1\xde\xe1»\xd2s$=\x87\xe2\x1f\xbd\x8b\x9f\xfc\xc9g>8\x16S\xbe\x8aTp\xea4\xbe(\xd3q\xcaj\xa0\xd7S\xfa&\xe6\r\xc6\xf6Nt۟i\xf7qnK2i<\xf1\xa7\xfc\xf6\xba\x7fD`U\xf7\x8b\x85\x9c\xe5o\xf8`\xef\x91ҧ2\x13\xedt4\xe2\xae\xf4`\xc6\b\xb9\x96&\xc6+\xc5*\xaa\v\xb9\xd9s\xfeJ\x9e\xf0\xb7\x82#\x7f\x1e\xac\x1e\x83\xf2\xafiIӃ\x1f\xc3\xc9U\xee\xc2!\x8a\x16ҼAJ\x16w2\xc4ߎJ\xb3[\xee\x91\x0f\xbe'\x12\xe4\xe6\xbe\xcc<\xab\xd5\xf4\x97]\x11(\x95\xae\xaeb\xf4b\xb5\x94u\x88bL\xd2\xd5\x15\xed\x18gY\x9a\xb4\xa7\xe5\v\xcf-xn\xc1s\v\x9e[\xf0܂\xe7\x16<\xb7\xe0\xb9\x05\xcf-xn!-\x1d\xd2\xd2\xc1s\f\x9ecHKW\x06\x80\x1a\xa8\xee\t\b\xb4\x1d\x02\x9dU-O\xc0\x1f\xc0\x1f\xc0\x1f\xc0\x1f\xc0\x1f\xc0\x1f\xc0\x1f\xc0\x1f\xc0\x1f\xc0\x1f\xc0\x9f^\xebx\x1e\xab/\x03\xa0\x13\xa0\x13\xa0ӑC']*\x13\xa9\xf2-:*+z\xe9&\xccWלT\xda|=\x11\xbeD3\x1aT\x7f\xbd\xa6\x87\xcf\xc59|\xbf\xfc\xf9\xbf\xcb}n\xec}\xce\x05<\x96͞T&}uQA\x17\x01\xb3\x01\xb3\x01\xb3\x01\xb3\x01\xb3\x01\xb3\x01\xb3\x01\xb3\x01\xb3\x01\xb3\x01\xb3\x017\x017\x017!\xa7\xbe\x8a\x7fT\xca\xf3(\xab=\x0f,\xbb~\x81r\xef:\xd5hLP!\xcf~\x9f \xe5`\xea\x91ɸ_\x95{\xb4\x9b\xf9\xa6d\x02\xb5\x1e\xb8[\xfe\xdcV\xb2\xe1l\x7f\\\xf3\x99\xf9\xb7=o\x189\xfa\xab\xaf\xc9Z\xd9\xfa\x8fz\x85f\x12\xf5\xa8˒\xec\xfd`\x94`\x94`\x94`\x94`\x94`\x94`\x94`\x94`\x94`\x94`\x94`\x94`\x94`\x94`\x94\xa7\x91\xc1\xbf\x06\xaa<\x8e\\\xfee\xael{$\xf5?j\xb6\x93J\xef\x7f\xb8\xcf\x19\x12\xfd\x0f1\xd1\x7f[ص\xb1\x94\xff5\xb6\x97\xea\xc9\xff\x8f|]\xa6\xcb\x00\xc0,2\x10\xb3\xc8 \xca\x054f\xa5\xf0O\xd02\xd1\xcb\"A1\x01\x14\x13@1\x01\x14\x13@1\x01\x14\x13覘@\x1d\x95|\x9f\xb2\x02;\x04\x83\xf3(-P&x\xa4\v\f\fS\xee\xe8\xa6\xd4@k\xb2rs\x89\xab\xba\x83Wn\xc8~\xc1SO\xa1\x04\x81\x06g*\x0f]\xf5H\xd0j\xb5\b\x10\x11\xba-!]\xf16\x83\xfa\x04}\xactx\xa8\xc1C\r\x1ej\xf0P\x83\x87\x1a<\xd4\xe0\xa1\x06\x0f5x\xa8\xc1C\r\x95\n\x904\x0e\x1er\xf0\x90CҸ\x9d\x9c\xa8\x81\x92\x05`E\xf5Y\x11\xca\x18\x80\x11\x81\x11\x81\x11\x81\x11\x81\x11\x81\x11\x81\x11\x81\x11\x81\x11\x81\x11\x81\x11u_\xd0\xe0\x98\xbd#\xc0\xa7\xc0\xa7\xc0\xa7\x8e\x9cO\x8dIPCM\x03\x87+I\x87l/i \x97\x9cTE\x035\v\xd2\xe5T\xf7\xacfp\x8e%TK\xe6L\xaa\x90\x81\\\x93\xe9\x1c\x905\x905\x905\x905\x905\x905\x905\x905\x905\x905\x905\xd0%\xd0%\xd0%\xd40P\x81\x95\xa27\x8f\\\xc5y`\xe5\v2:\xbd\xebD\xa3\xb8@\x85\xca\x05}A\x93C\tG\xa6hA\x05\xc6\xd1nb\x9e\xfcdi=\xf0\xb7𑭤\xe3)}R\xf3\x05\nJ\x1e5\x8c\xda\x04\x95\x16\\\xad\xb2\x04Ǻ\xfc2y\x7f䪤 \x01`#`#`#`#`#`#`#`#`#`#`#`#`#`#`\xe3\xf1\x17#\xa8\x06\x1e\x8f\xa3\x0eA\x91\x03\xda\x1e5\b\x8e\x15\xe4\xa4\xca\x0f\x1c\xe4)\x86\xca\x03C\xac<\xd0\x02Om\xac\xe8@\xb5m\xa4z\xbd\x81\xe3]\x83\xe9R\x030f\xf4`\xcc\x18DY\x81&\f\f\xfeI\x19\x15:_\x06\xa8#\x80:\x02\xa8#\x80:\x02\xa8#\x80:\x02\xdd\xd4\x11\xa8\xa8M\xefSB`\x8b$p\x1e\xe5\x03J$\x8dt\xf5\x80\xc1\t\x1a\xdd\x14\x0ehC\x10n.KT'\x8c\xc9\r\x88\xcf<\xf0\x14J\x05(\xb6U\xbdF\xc0\xf6\xf8\xcas\xcd\xf7V9\xc6\U00090e9dp}\x82\xeb\x13\\\x9f\xe0\xfa\x04\xd7'\xb8>\xc1\xf5\t\xaeOp}\x82\xeb\x13\\\x9f\xe0\xfa\x04\xd7'\xb8>\x9dE\x9ceaU\xbd\xe1\xc4Z\xeeHZ^9\xde\xf2(\xaa\xbd\u05c8\xb9\xec\xa5\xe6\xea\xce\xc9\xd3\x11;-\xac\xb9ښ\xcbB\xc9\xd3ڊ\xc1l\xbe\xa0l\xd4\xf9b\xac\x15\x8by\xccKs{<&\xc0$\xc0$\xc0$\xc0$\xc0$\xc0$\xc0$\xc0$\xc0$\xc0$\xc0$\xc0$\xc0$\xc0$\xc0\xe4i\xc4dV\a\x94\xc7\x14\x97Y\xbbniAh\xe61s\x9d|x\xe6!,\a!\x9a\xc3\r\xd1l\x1c\xb76\x16\xa6Y}g\xa9\x1e\xaay\xdck\xb2 \\\x13v\x90~\xed \x83\b\xdfl\xca.ៜ-\xa2\x97\xe5\x81PN\x84r\"\x94\x13\xa1\x9c\b\xe5D(g7\xa1\x9c5\x94\xf0}\xc29wH\x05\b\xe9\x1c\xb6\xd0\xd1eXg\xf3Brs\xa1\x9d\x9d\xa1\xaaS\xa9w\xbc\x13\x93\t\x1b\xadZES[\xddH\x98\xfc\x12N7\xfe̅\xbc\xbe5\x86\xa3\x9a\xa6\xddZ\xben\x8f\x16\xffE9\x99vx\xf2\t\x9f\x7f\xf3\xa5\x89e-\xef\xd6uI\x12\xf8\x9e\xc1\xf7\f\xbeg\xf0=\x83\xef\x19|\xcf\xe0{\x06\xdf3\xf8\x9e\xc1\xf7\f\xbeg{\x1f/M\xaa\x9a\xf0}\x83\xef\x1b|ߎ\xc2\xf7m\x1f(\xb4\xcb}J\x0f\x9a\x03\x84\x16f\x174i\xc0\x00\x86\xaa\x82\xa1\xe3\x86B\x00B\x00B\x00B\x00B\x00B\x00B\x00B\x00B\x00B\x00B\x00BG\x01\x84N!\xad5@\x14@\x14@\xd4\t\x81\xa8\xeai\xe8\xab9(!\x1d\xfd.\x16U\x98R\x0f\x8eJ\xdd\xfa \x82M\x81M\x81M\x81M\x81M\x81M\x81M\x81M\x81M\x81M\xc1Y\t\x8c\b\x8c\b\x8c\b\x8ch\x17#j\xc0_\t\x9c\xa8.'\x82\xdf\x12\xf8\x10\xf8\x10\xf8\x10\xf8\x10\xf8\x10\xf8\x10\xf8\x10\xf8\x10\xf8\x10\xf8\x10\xf8P\xe7\xbeKǜ\xb3\x05l\nl\nl\xeah\xd8\xd4,z\x8c\xaf\x1d̴\x8d\xb1\xf0\xb5y\xb0B\x9f\xfe\x1c\xce\x02v\xb2\xf9Oj\xe2L\x867\xc5Q\xaa\xa0\x8do\xb61\xff\x87\xfe\x97\xb7\xb6oM\xb4\xea\x90\x16\x1d\xce8\xf85\xcc\xe8S\x83\x9cCC\xbd\xe2\xf6\x9d\\\xc6G\xefdץ$\xf010Z\xf0\xd4\x1e};\xf2\xe3\xed\xe4~;X\x9byJ\xaeө-\xaf\xa9\x19\xc9\xc6\xd0.N\xd3\xed\x18\xbd]<D\x95\x19\xda7\x95\x87\xd8ޝ\x7f\xe0\xe4\x93\U000e7b31\xd3\x17\xb4\x97\x84\x92\x0f\x90\x9f\xf0\xe5\x85?[~\xf2_У\x8cJ\xb9\xca?\xc9\xf9\xd2.v\xb5]\xc9\xe1nd\xe4\a\x92nY\xee\xd1I)e\xa7r4ޕZ\xe9\xb2\xcb'\x1b\x05\v\xec<L\xb2\x9d\x8cR\x93\xe5\xbfլR\x83J\xe3\xb1\x0eU\x7f\xab\xb9\x96m\xe4o\xa2U\xa9f)\x01\x97\x0e\xb6\x95\xd9G\x9df\x90\xe4\xb5\xce\xe5l\xcdIT\x8b \x98\xca\x0e7v\x7f<\x1dy\xf2\x9c\x04\xe8=lX\xaf\xff_\x1b\xfaZɾ\xc1\xe8qDo:\xfb\xea?\xc5K\xfa\x92Ɩ\xf6J\x97\x03\x87tL\x91x\xbe\xe2t\xb2\xd9\xeb>>3\xef\xe0\xcaM_\x831)=\x9fU\a\xa6\xeeTtu\xb4z\xf4\x17\xe1\xffV#\xec\xbd7\xfd\x99]\xd44]V\x9b\x99\xea\xd2tW\xbe珝\x91N\x0f\xd95\t\xe6\xc9\xf8e:\x97\x0e,\x16\xac\xfd1\x9d=*\x95kɗ\x02\xf6\xcd\"S\xb2\x01\xe9P\xb4\x99\xd3\x05\xff\xa2\x13\xee_\x1e\xb7b\xa4\xfe\xf7\xbd}\x9cp3V\x97\xb4\xb6ํ\x19%\xef\xe8\xafV\xbeHpjS\u06ddoq\xc7\xfa\xe0f\xe8\xdd|\xafE\xf5\xda%\x8c[WX\xea\xca\xccrKsJV\xea\x93\xdf\xc9ۧ\x17\n5\xd1lY\xf9\x92\xb2wo\r\xefS\x189vu\fZ\x13j\xefRsJ\xe4>\xad_\xd8g[\xcbL\xa2\x9e\xebeAͤ\xfft\xb8\xae\xb9\x9b\x15\x19X\xf1`%\x90ĦՂ\xf4PA&jz;\xd0x\xb3p\xee\xa0R\xf1\x8e\xbc_\x05\xd9\xd0\xce\xf8ʓ\r\xfc\xd5\xf5\xf5c\xb86\xe6\xa7I4\x9f\xd3q\xb6~\xbaf\x9d\x91znC\xa2iL\x83\xfe%\x98\xb1\xbd\xeaJڶP\xf2\xc5|\xfa\x17;w\x8a\x96\x87ۻ[\xc7,V\x1c*\xc1e\xae\x18\xa4@\x99I2\xec\xde\xd3{\xb7\x9ar\xc5\r\xd2\x17CN\x14\xae\xa5r\xce\xd1*v\x01\xba>\\\x92\xcaX6;H\x0fg!\xda\xe0\x8fԭE<'}\x9cTbV\nKoa\U0002eec1\x94^\xfc\xa3\xde_\xa6\x85\x1b\xabk\xbc໕N\xeaP+\xab4i\xa6-\xaeY\xf7L˚\x98$\xfb\xa9Ά\xfb9xJ4Ђ\xabt\xf6\\\xbeH~\xc073f\xa7\xf4\x94\xf8\x7f\xd9>\x19jQ\x97\x87Рt\xbdv\x8c\x11\xe0\xfd\x9b\xfb\x0fv\x96\xa8\xf5\xa5\xcf\x1e{i\x9c\x86^4\xe1mNm\xd3\xc9\xc1b\xba\x8c\xc2\xc5ڵ+\xd0v(\xba\x84\xe1$|\xa8z\xaf\xfd\x85\xa4\u05f6\x90\x81\x8e\xc0\x05}:\x0ff\xafIckzM\xf1p\xc6b\x98+\\U\x06\x96\xe6\xbb\xef~M\xeb\x85\x14\x1d\xd3\x19\xe6\xca\x1f\x1bn\xa0M\x0fN\xcd\xdb\xdf\xce\xf9N\x1a\xf9+\xfdWނYd\xa3\xfc\xef\xc4HY<q\xb3&\xcb\xd2\xe3#m\xbaT\xb3]\xa9=\x87\x9fM\x12SP\xf9|\xe2\xab\xd3{_ٕY\xd1N-\xf2\x7f\xe2\xc8j\xf8\xc82{g\xba\xeb~\xa90:\xed\xef\xc2\xe9Y\xfc\r\xdbh\xcb\xdbh\xca6\xd5n\xf3\xf6\xdfFyj\xf6\xb0\x89\xeaة\xc37R\xd1\f\nU(5\x85\xd7\x1b\x16\xe1h\xed\xd1\xf6\xf5wn:Im\xc9N\xa66\x10\xabߌ\xdej\xc8ʣ\xc1\x15\x1cD7\x9d\xfb\x9fY\xd1Yi\xd3\x12\x9b\x13\x84\xf0˝\x83?\x97\xfe\"\x16Q\x8e\xcd\b\xe2\x13Q\xa8\n\xa8\x87\x17n\xab\xea+\xa3K\xd2\aޣ\xfa\xc4-\x98\xc1\xd5Q\xd8\xf1B\xd6\xc2ſ]\xc8\xebQSԥb\xcfП굧\x8c\xa9\xb3`\xf1\xb8\xfed\xc4\xc5x\x16N\x92*9\xb4k'\n\xea\xb6=(3\xfb\xbf]:\aE\xbc\xed\xa4H\xbd\xd4\x17\xf3Y\x95\xd72\x17w\xfdb\xc9\xf6\x9e\x9fT\t5)V7Ա\xc7S\xcfa*l=\xfc9Zq\xfd\xbf9M\x98W\xde\xc52\x9a\xc6\x17\xba\xaa\x0f\xff=R\x1f1\xa64\x1fk\xb2\xe7\xaa\xd7\xfc(}\xf5\xbf\x99˔\xb9X?>)\xe4\x92R\xca\xf5\xcdS\xbfI!\x04\xe7\xbe\xd7\xf1ğ\x05\xa9+\xf9\x83\xec\r\xff\xed\xba\xb8\x05\x8e\xbe\x95\xfa\x05w\x01\x8d\xe2\xd7p6\x9d\xf0Ƙ\x1dJY4JJ\x90\xde\x13:\xa4\xadx\xc9ͧ\x91\xbc\\D3c\xe6/\x955'\xa0\x8d͋\xd8~-\x0fQ\x05n\xc4֢\xad\xea\x8b\xc9,\x12{\xa2:\x99.ӯ>\x0f\x1f?\xe9\xda7\x81\xaa\x17ç\xca>\x13\xa7\"\x99P\xc5$~ݬ\xc5#\xe9\xbf\x14W\xda\x01'\xb6]\xbd\vP\xf0\x80ض\x18\x8e\xa5\xb71)\xb3\xc3\x06\f\xe3#\xa6E)\xed\x7f\x95\x109\x90\x8d\xa6\xc5D\xc8]P_\x1d\xf5\xf5\xf2\x99^\x99\x05\x87\x8e^\xf5\x993\xc7\\o\xe1\xbf\xff\xf0@\xb7\xcb\xd9\x00-\xe2m\x05Ϊ\xddL\xb7\xb0a\xc6ӊ4\xbau\xe3-\xf4H\xfegS\xfbz\xb1b\xbf\xeb\x17\xe9Q\xdfv5\x14\xfc!(\xf8\xbbF\xa8\x9d\x15\xb8uZCχ\x9e߾\x9e\xbfs\xeb;|we\x9d\xfe\xbfH\xe8\x7fgϴb\xbd?}M\x86\x028_\xa45\x7f\x0f\xaa\xff\xb9\xab\xfeі\x89\x95\x9eP\xa2\x1b%\x9f\x88\x00f\xb5,Q\xb1\\S\xfb\x95\xf7\xfa\xfd\x9b\x9b\x0fo.\xbd\x7f\xdc\xddʿI\xe9\xfa7\xe5\x10\xcf>щ\x8c\x06\xd6\x01\xd6qb\xac\x83\xc5\x02\x9a\xae\xef\x03\xb1\x84O\nXm\xf6\nZ@\xb3i,\x0e\xb6\xe6\x13ډ\xf5U\xa3Y\xf0\xe8O\x9e\x92\xf3'\xeb\xaf\x14/\xfdI`<\xe2*\xfa.\xfd\xc1\x1f\xffQ\xe4\xcb\x13\xeb\xa7nu\xe3I\x1e[|g\xf9.u{\xf9\xa4\xd63ĕ/\x7f{\xfeX\xee,\xee<\xfc)I\xce\xffx\xff\x8b'.\x82_?\x854e\x8c\xaf\x95x\xa7k\xe7~\x13\x06 \xb2\xb7\x8eh\xe46\xe4\x1f]k\xb0\x7fד\xbb*\xda\xda~}\xd3pK\v\xad&\xacB8\x17/\x97\x10\x84\v\x84\v\x84\v\x84\xeb\x04\b\u05ce\x1d\xf8p-l\xfb\x03\x8a)\xd7\xeeߤ\xc7~\xfb\xf5 ]C ]\xbbǨ\x9d\xb5\xb8c\x82\x83v\x81v\xb5O\xbb*l\x82\x87\xef\xb4\xe6\xd4);0\x1d\xf1\x98v\xa3\xbc l\x0eβ\xf3\xb2B\xec\xc7\xe535u\xd5\xeb\xe5\xf7\xd8Է\xb9v\xbev\xbe\xb5\xfb\xec\xa7諄I\xaa\x891\xa1\x19\x9fD\xe6s\xb3SjP#Ƕ\xdb\f\x9e\xe3:N\xe6N\xc2d\xf2\xad\xfe\xd9\xfd:\xd5\xecԎ,\xd9E\x9c\x05\x9f\xf4\xbf]\xfa\f\x05?I\xa0\xd8Ի2*\xb7\xde\xca\xe5˷\x8f\v^=\xa4\xa5\xf0C\xd3q\xa4\xea\xbbQ\x99\xbe\x99o\xf7\x87\x8cꚛ\x10Ucg$\x12F\x05\x11\x95\x85\xcd8\x97\xb4\x1713ڪk\xdb\\\x16\x85\x91H\xa9Kh\x10'\xe1T\x02iT\xaa\x03\x8e*\xdf,܆2CIR]\xd98Z\xfb\x83\x94ޮ\xa2I9TX\x8b\xe1:nF)\xf3\xea\x996\x95\x8e\xbe\x86\xf6\xfd`\xf6\xa0\xce\x1f{'E\x84\xe4碇\xc6\x1e\xad-\xba\xfb\\=]\v\x18v\xaf5\xc9>2w\xe6u-X\x88ΙM\xcca\xcb\xf1\x84\x16i\"(_\xf2J_pZ\x10/\xfe\x1cj\xb6l&E\x06\xd9]\x96vΓ\r\x97\xff\xfaI\x12@\xd8\xfe\xd0\x19\x14\xfc8\x8e&\xa1\x84\xb4ˊ\xfe\xf8\x8c\xee3㝞\xe6\b\x8d\xf4\xc7g\xcf\xf9\x8f\x15\xfd\xf1\xe2\xe3\xb3\x1f=\xef)ڤ\x93|\xd8D(\x1c)\x1c\xf1z\x89_\xd1չ1\xff\xf8\xec\x95\xf7\x7f>.<\xfaN:\xef͟|0\v3\xe6\xaf\xfe\x9b\xbf\xf2\xd4\x15\xfc\x7f\x1f\x9f\x91p\xce_\xb8-\xbaL\xbeU[\xa2\xba\xed\xc7g\xbfE뷋\xd4\xf7j\xc5:wV\x1f?w.\xf2\xd4K\x99\xff\xfc\xa7\xfa\xe3\x1b\xff\x8b\xfe\xfe\xa6\xa1`\xb8\xa0\xe1\xf1\xa7\xea\xc5\xfd\x85\xe0\x17\xc9\xdbQ\xbfó\x9dͿ\xfe\xf8,X|\tWтc\xfbM\x9fs@\xa6\xe9\xf6x\xed3\xf0\xe0\xce\xef\xa1\xefS\x8d+\xed\xfej}\xaf\xde*\xd5\xfd\xf6\xe5\xb6\r\xc2}\x10X1%\xb5-]O\xa3I\xccB\ns\xa3\xf8\x9a\xc1\xeb\x970\xf8z\xfd5Zљ\xffxŝ|\xa5\xc7\xe3Z-\xc4k\xd9\b$ˈ^<\u0097\xe5K\u06dd\xb1f\xb5*\xfaH+\x00\x92\xbb\xc4\xfb\xc5M\xc9s\xa9a\x9d\xd9K\xd2I|\x0e\x10\x84Ry\x7fj\xc6\x1c:\xb2B\xb4\xd8\x19\x86h&o.\x04\xb1\xe0\x8b\xaa\xe1\x87\xffIG\xe6\x17\x0e]\r\x17:\ue27a\x90\xf3\xa7\xb1<\x9dHa7\xe6\xac\xfb/\x97\x1a\x18\x9bd\xfe[9\xb5\x97\x9b\xb5\x93\x16L휌G9Y\x17K%2\x1e\x13+\x9e\xf3\xd9\xffE2\xf0ȯ-E\x8cx\xe4\xd7\x01\xad\xe2i\x18\xfb㙹\xe5r\xb6\xa1\xf9xy@+YDP\xdb6\xa7!PgBr\xaa[-\x82g\xe1\x0e\x90\xea>\xa7\xf0\x02\x93\x15\xa55\xb5\xad\xc0x[ӐP$͕\x89\xc6)ɓ5\x0f?\\\x98XY>_\xd5K\x1b\xfb\xae\xef}\xf8\xe5\x9e/[\x04\x92\xf90\xd9Q\xf5\xcc͈\xc6\x13\xff\xa7\rKu\x05\xf2\xb0\xf9&O\xea\xcdW\x8a\xd6{wo~e\x83M\xc4v\xe6\xd77\xdeX\xbe\xcb\x00{I[E\x8d\xd4&\xa3\xc0m҅\xcdB8\xe1\xc7K҆`\x9b\xecDr\xaf\xbc8g\x98xZK\x10\x9d\x86\xfe\x05\x8d\xd5\xdf趦\xec0\x8e\xc5B\vBt\x8d\x950߄\"\x88$7\xa0+\xfeجf\x7fXs\xa5\xcdyb,e\xee\xd6!&E\x95,\x85\xc7@\xa7\xc6ҋS'\x17\xe1\x93K˰\x9c\xee\xca>ʹ\xdfJ\x8eH9Y\xa3\x05\aįd/[\xd8< \xfa7\x97:\xc1\v\x7f\xefv\xba\x11\xb18g\xd4*H\x02A\xf9\xc2X\xeet\xa9~\xf4?\xfeǿ\xa7GK\xedd\xa1\xb9HĲ\xafa\x1c\xe8O99\x11\xeb\r\xa3\x83u\x9b\x9cY\x8dƓ\xfa\xb9`,\xa5\xf79݊\xb1\xb4NR\x19N\xac\xa4N\x9d\x1d\x1b\x1d\x9f-H<]\xbc\xef\xfe\xf8o\x95\xb1\x81\xce\xcb\x7f\x92\x14\xb2~\xc5\xef}-f\xa7\xefi\xb8\xff\xf4'k\xdd\xcbt?\xf5\xac\xc8\x1d\xff\xe2a\xe7S\xe1\x0f\xbe\xdd\x1ff(U\x1e\xca\a\xb5\xc1\xfbv\x86\x99ِ\x9e\t?\xdaDgɃt2/%a\xa9s\x87\x1f\xa0͞c\xa5\x06Ͼ\xb0\x02\x16\xfat`k\xf6v\xfb۽\xbcyĉ\x14\xe8\xe0\xd4X\xe7;\xa5\n\xfd\xc12\u0095\xfd\xfc\x0fs\x1c\xe8{\xd1/\xaf\xcc\xc9\xc17\xf2u\n\xa1\xafF\xc3\xf2I\x1ax\n\x14m\n\xa3\x99t<u\x9b~u\xc9Y6\x8b%\xc7\x01͌\xb7w\x9c\x0e\x81\x85)\xe9\xa3;N\x9a#)Y\xb4\xf3\x89ʋ\xf5\a\x0f\xe0L݀{\xfa\xc5\xcb\x1fF\xcf\xe9\xff_\xfc\xa1\x92\xbc\xe8{\xf3J\n\xe3\xcfO\x1e\v\xbcq,\xabf͛\xdd#\xa7\v\x93\xa38\x91\xf4\x93\x15,G\f\xcdg\xbeI\xac\xb7#\xbe\x86\x01\x83\xe9\x05#\xa9({r\xe08\xca\xf01\x15[\x9b\xa2\xdd\x14\xee7R~\x8af\x17\x7f\xcdO\x9e\x85\x9f\xf9\xc0T\xe9\x1d\x16\xd1\xe2\x8ag\x15gf\xa3i8\nF\x972\x1d\xe8\xf5\xe5\x12\xc9b\xb3Y\xaa\xb3y\x11|5\xb3 \xc9\xcb&3\xd4N\xb5\x8f*\xb3\bK\xb9<Ex.\xeb\xaf\xe8<6\xaa\x89\x11\x02?>\x93\xdb\xdc(\x83\xa9,\\eEU\xaa+\xadg\x93\u05cbGK\x06\xea\xc9\x00\xc4%g\xd0\xd3iŤq\xf4\xa8\x91\xf7\xffQO\xf3\xb56\x17\x9fNֲ\xf4c\x05jV\xe3\x90V\xf3\xca\xdeFo\xaav!>\xb8ژ\x9f\x88&6\xa9\x86j\xf1z\xcdb\xa4\xbe\x01?\xcc\xe7\xff]\xf1\xa4 \xfd5\x9cx\x9c\x9eDTzz]\xfe\xe6\x15\xb7\x80\xe4\xd9\xe9\xff\xa3tv\xd1ٌ\x1f\xc0\xcf+\xffQ2D{\xdf}|\xf6\x97\xd1h\xf4\xf1\x99\xca'\x9b\xcfC\xfb\xf1\xd9\xffm\xbe_\x05\xee].\xbd@\xb6\xff\nV\xe4\xe52f\xe9\xf45C\xc1\x88Ӻ\xbd\x0f\xbe\x84Ŭ>\x7f\x8d\xc7i\xab\x02\xd5ZNq2\x9fod\xf2\xd8,s\xbc\x17)!N1J\x93\x89\xd5_\xd9Ğ2h\xdc\xd3\xd41\x12\x9f&I\xdf\xe8}\xa7\x81\xfb\x89\x9b\xdbOe\x94T\u0084\xf6\xfc\xb0\b_\x9e6\xf2\xde\xf1!\xe9{\x05M\xfe䳷\x13\xe7\xfc\xdaLH`\x8e\x15{\xb1\xb9\xfbB\x95\xb9.K\x82?h\xf7\xa9{'#&\xe3+\u05cb\x84\x8d\x11I\xd6H\x93TO\xcd\x0eY\x99\x9b\xb5\x91\x1dn9G\xa6\xcef\x9bo\xa3\xc0\xecK&]J\xe4\x1e\a&\x8b\xe0\xc8\xfb\xcd\xecB\x97&\xcf/\x83l\x9eucZ\x15\x91\x16\x97n\xfd`\x1e\x91\x8e\xa5\xcc\xed\xf7\xfc\\zO\xfe\xef\x89}\x9a\x92W\xd5\x1b\xaa\xd4S\xf4\xf1؟|\xbeL\xb1\xf6\x90\xbb\x8b\xc7.\xd1\x00\xec\x12$\xcdC%\x8a\x89\x92\x048\x19\v\x8dI\x91E#\xf5\xb0Y+\xdf\x1a\xd9O\xe3ˢ\xe4\x99\xdc\xefS\xf1\xa7\xe1\r\x90_\x8d\x06t\x1c\xce8\xa7\x97v\f\\\xaeH\xcdZ\x85\x9c\xcb8r\x06^w\x81\xf3~Y\x90\xba23\x1b\x16\xaa\x86-Tņ\n\x99䡡q\xab$\xddo\xb6\x83\xe4\x02Y\xb7u5l:\x119\xbf\xdb\xe8\xbd\xff\xf5\r\t\x12\xe2\x03\n\xa7\x87n\x9c\x1eH\xf1i\xcb\xf8d\xdbМ\xd7ê\xf4XK\x0e3\x95\xa0<0\xbe\xb1\xfacw\x82&\x13@\xe5\x03\xbfվ\x19\xb9\xec\x87Vţ\x8f\xfe\xe7\xff\xa8o\xfc\xa2c91r\x15\x1c\xcei\xa3VޞUz\xac\x17\xfb\x05\x14_g\x94N\xbd\x10\xf4\x89k2/+\xebs\xc1\t\xeb \fx\a\xf4\xe5\x1d I\f\xcd\xee[>X\xf1!\x9c\xa9\\v\x84\xf1\xbf\xa5=x\x80\xfb\xec\xfe\x16\xfe\x1d\x9b\\\x81\xf5\xbet\xa3\xb3\x02o\x91\x18bd\xe1d攸\xb1\x92\x96\xc2ײ)\x06\x8e\xa8pD\x85L֮L\xc6(\xb2\xd8}\x82>\x91\xca1\xe3\xe0\x93\xff%\x8cVv\xe18\v\xb4\xe1\xf7\xe3\xc6\\Qg^\xb1\xa0\xb7\x89\xabp\xe1\xec\xde\xc3Y\x9a\xe5\xbd\xd4\x1d\n\xdfL\xe7\xf4\xf6\xd45\x85\xef\xf5A}\xb0\xf6\x8d\x9a\xcd\xd6-\xbaP\x14u\x12:\x05\x91~\xa5\xb9\x12}\x95߳\x02\xe4\xddEK\x9d\xc5ޔ\xa9y\x8a\xe9 gC\x84?\xbdb\x02?\xc4\x0eS\xbf<\xec\xe0H\xb6\xfe\xbag\x05\x1d9Ӱ8\x1e\"\x7fM&\x06B\xa9\x03rh8\xb0E\x8a(\xd0f\xcd`J6\x9a\xac\x10,K\xdbΐ\x9c0\xcce\x88>\xac|I\x83\x19-8iz\x81\xdf'gp\xb7E\r&\xb6}k\xfb;c\x19ec\x80\x9egR@.2Lp\xff\x05,M\x92\x1d)\x8e\xfdǂ\xd6\xddx\x9f6s\x9f\x8d\xa2\xb4[0\xda\xd3W\xba埦t\xb3pf\f\xd0\x12\xc6i\xdb^\xe8kD7\x8b\x8bF\xe9\x83\xe8i\xfc\x9d5(\xd9\xfe\xa0\xadP\x8a:\xed\xb8u\xd9Z\xbdw֧s\xd7Kca\xf9 \x05\x8d~\xf6g1\xfd\xeb\x1f\x8bϋ\xe8k\xf1\x03\xd4\a\xb9\x96ӧ|\x9bd\xee\xd8GT\x87\xb6\xf6\xc7\xc5J]\xeaku\xc6NX\xc0\x9a\x18\x99#\xd9t\xa0\xac\xf5\xaa\xac\xddX\r-3$\x87\xaaf\xc9\xde\b\x8d\xec\xa8ݱ\x87\xa4\xa9\xa5\xb7\x9d\xba\x87\xee}\xa1З\xfa\xdaBce\xaa\x9e\x94*h\xee\x8e\x15'%\xd5\xd8\xe2\xc2\xfbA~\xff\x9a\x87\v\x96\x87\x9eL5\x90\xc2\x13\x85.\xcaTQ\x8b\xd5\xe5r\xc8(\x9b\xab\xd8?\x13\xbb\x91\xb3\x8f/\xa3\xa9\xe3\xd9\xcb\xc7\xe0SR?j\xf1\xa4*\x90\xc5\x06\xa6\xb1\xdb\xc8ʏٽL\xd9\x1bU\xa9\xa5q\xa0j6M\xc5\xcd\xc9)\x97\xe5:&?\xf7\xbe\xe3\x87\x19\xb7\x87\xa2\x1f\xb0%:\x8eؔ\x1ck\xc7\ai\xd0\xf7;\x99忿t\xa1\xe9\x7f\xaab9\xbfH%\xa4b\x7fg\xdbW\x11\xbd\xb8.\xae\xa3\xab\xb9\x89\x95.R\xb6IkdJ\xeaV\xf9JRR\x0e\aS\xfa%\xf5\xc5&\x8c?\xb1\xd9\xe9+\x1b\xea\x82?\xb9z\x055_\x8a\xb5\x99\xba\x8b\x89\x1fC\xaaS^<\xaf\xf8nq\xa9\xdb\xf2\x8d\xf6\x1cT\x06Wv5\x93Pum\xcfc7\x14\x7fA\x12\x8d\x95\xb4S:\t۷\xe7\xaah\x99\xe3(\xa7\x86G\xf1\x8d\xa9\x18\xb2\xe6Ʌb\x9b\xa6\x814\xd3V$\x17\xe5`\\\xb0\x89\x1c\xec,\xf9\x17\xf9\xf7\x95\xf5\x8bl֫Ѯ\xbd|\xb7.Ru%\xd2ⴼ\xbfxl\x98٬\x16\x96\xb2\xbc&\xabK\xbeV߱i\xde\xfa\xbcL\xa2哕,\xf8f\xec\xfe/E\xc9\x16\\6En\xedz\x87;}-\x17X\xa7\xdb\xefX\xd9L\xfd6|\xe0zK\xe9\xab\xdc\xd2x\xdf\xd7\x1e&\x1e\x9dY\xe4O\xe3k\xc7ZyM\xa7\xa8)Ғ|\xfc\x17z\x97+ۧ\xbbU\xac\t\x87*и\xdcE\xd3\x0f\xfaWF+U\xc7轍~,\x18\x1fm\f6\x11\x92j\xfd\xd2M&\x81\xaa\nɢ@j\xa7\x8b\x95\x17\a\xfb\x82H6\x87}\x94\xc0\x7f\xa4۵Sؽ/\x91\xd83\x17\xe4h_N\xf1\xdev\x9a\xe8\xab\x7f\x93m\ud7a6\xcdt3\x93@\x18\xb5\xd1\xfd\x1aƱ\xf3\xa1\xc6\x15eWˑS\xe0\xa3H#,\xdb\xebk.MYd\x94\xd1\x15+?\xd1\x01\xe1٫c\xab\xeb\xd87ή\x92d\xfe\xb0Y\\\xa7\bQ~Y\xbeQ\x03\x94\xad\xc6\xff\x12\x85S\x9f\x9d%\xe6\x01\x9b\xea\xc3x\xae*&\x86N\xe5\x17\xbd\xdel\xb0\x88y>\r;\xcb\xdcyr[q\v\xb6*Oaړ\xd4\xe8i\xf1>9آ1\xfb@\x19\x9f\xe8\x94\x1aNk\xda\x1d\xec\xa0QA:a\x06\xbbc\x90\xb5\xb2_\xb1\x92Bɔ\xdbq\xdc\xf2\xbe\xe4\x1cM\xc6Mϖz{a&9o\x8a\x92\xa7a\x15\xa8\x12\x9bڧ\xd5D<$\x975\xb4\x9f\xa9\x1b\xd2ں\xae6\x1bJ\x16Q\xe1\xfb\xaf\xa35\xe9x\x85\xbd\xe0\xc8^\xba/\xd2o\xe7}\xa7*\xe6\xf07\xeag\xb4g\x92V\xb8V\xd1\x1f\x05\xbf\xf8\xbe\xa7\x0eQ\xafwc\xa6|\x8d\x99\xb0\xb3\x0fx\"HMA\xa1\x19:vB\xb3\x0e\xe72;\x9b\x16\xaeL\xf9\x9d\x12j%k\x92\x99f|\x1a\x8e2\xd2\xf5\xf7u^3\xb5\xa3\xee7\xe7ӍW\x89\x89\x8cc\xdc g\xbc{:\xf4;\xb82\xa2\xa3:\xad\xfe\xc7\xc2oub.4g\xebcF\xaa\xb3%\x98\xfe=X\x04e\tsDQ\x8c\xa4\x94\xec\x84O\x9aG{\xadg~\x9e\xd7\x0f\x9c\x83\xb9\x92\u05c8\x11\xda\x0e\xde\x14\xddeb\xeb\xeb\xda^\xad\xd2+\xbb\xa4\xb2\x7f\xec\x90.K.\xb4\xe0i\xc3tN/P\xddK\xd2yY\x91T\x15/O$\x9f\x9c\x99\x96\xbb\x97^S=\xa6@\xaeP_\x9b\xfb*\x1b\xb0\xf2\xaa%}\xebN\xbb\x18K\xc4\x02\t\xfe\xdc-\xde\xff\xe5}4?Sw\xfd\xf8\xac\x8e\xa0\x9b\xfa\xa9\x03\xe4v\xa1agڨ\xc6\n\x13S~ՙ樠\xc2w\x8b[\xf1\x12\xa5\xe6\x19\x95\x98\xbb7um\r\xb8L\x82\x7f\xf4$ŉ\xf3\x83i\xbf\xf3\x82\x05\xaf@\x0eX\x9b\xcc|^\x00_̘)9\xf5\x8e\x95\x04\x95\xe0Qԛ{M6\xc1\x95a[?\xd2$O\xa3\xd6M\xe0\xf7Y\xf0\xb9.\xb5\x89\x93\xdag\x17c-\xf5\xdb\xfej\x97\xd5\xfa\xd7\xe4\x94c\xd8b\x0e\xb78e ;\xb8\x11\x8dX\x82\x93-\xab*\x95\xb6\xbf\xd8f\v\xce_Tn\f\x9e&[\xe3\xf9X\x83\xb9\xa1\xea|)n\xe4\a\xd9H\x93\x86\x86\xb1\xd3ү\xb4ٚ\x8d\x00\xb6\xe9\x01٦\x9d\xa9\xbc\x8fq\xda\xfe\xba\xc4:\x9d\xfa>\x9dv,\xf9\x0e\x86\xe9\xe1y\x11gF\xe7`\xb4\x96\xec\xda0Rwk\xa4\x1e\x8459\xbdO\xd4>\xb8K\xecɩ\xefK\r\xca\xd5\xe5\xaa\xe6MͿ\xeeaf>\n\xe3r5Ȳ\xf4Y\xdd/\xaa\xb0\x9aD\xe1\xf8k=B\xf6 b\xeb\x9e\xfc\xd0\xd9y\xc6Q4\v|\x01\xf24\x04\x8f\x1c\xb7}K\r!\xa57\xd8n\xe7\xf7\xff\x94\x01\x10\xa1\x84Cϝ\xeeO\tr&\xaa\xdaܝ:\xe4Aֵ\xb4\xc7\xe9\x1aչ\x1c\x17j,\x97\xe93\xd4\x18e\x94\x153\xe2mK\xc5pҝ9\x16U\xff\xd4\xf9\x95ҝ}W\\b\x83\x9b\xef\xdde\xde\xf4͟\x93 \x98Jx\x9d\xc8\x1c6\xd7\xf4f\xf5\xe0O8!\xc2\"ۗJ\xbcpBJ\x93\x17\x94_띍6;\xda\x06\x84\x18mVf\x9f\x95>\xf3\x8bG&5\x8f\xfe\xe7\xf3\xe7qeo\x03!\x04\x05\xa3\xf5\x9b]\"f\xb1\xaa\xdc\xf1-\xb9\x10\xb4\xec\x1dᐐ#\xf5\x90\xf8%\x95YI\x16\x8c\x1a\x907\xc6J쾣J\x9a%\xd6b1>\xc9\xcf\f\x1e\rc;U%ě\xa53\x9b`\xd8\\\x91L\xb3\xea\xbe\x13ͺ5ĥ`\xf3CvQ%\xb6sI<P`BO\x1b\xce\xf9\x95\xf7U\x9a\x8d\xcd|\x9b߅\xf1\x03\xc8{\\\xc4\xc5.\x17\x87\xb8\x19\xecT\x06J\xad\xf7\x99+̑=\xafO\x1f2\x9a\x819\xaeޗn/\x1f2\xe0<9ᤓ\x8a\xac\vYÂ\xb7\xf6W\x8fAɜ\xadj\r?\xd4\x1d\xc0Y%\x1fR\xffݱC@\xb2\xf8{q\x04Hz\xbe\x15O\x80\x02dԴ+@U\x03\xd46\xa3S\xd1\xe0W4:\xc9|\xaf\xbe\\\xd4\xf2P\xfb\xc9\xc1\xab`U\xf9\xb1\x9c\xadf͉_\x16F4\xde\xfa|\xef;\x95)D\x1d\x0f\xce\xf1a\x8e\xb1\xaaN\x91\x9b\xc5\x1e[\x8a\xf3\xa3\xdd=e\x0f\xfeu\x81U/\xed\x8aH\a\x8aN9\"b\x91Y\x88i\xe1U\f\xaa/\x9e?\xff\xab\xb3l&\xfeҟHV\r\x9aHO\xa2\t\xab\x9c1|\x12\xa4\x9fa\f\x87\xa6\xd0\xcfS\x90Z\x7f\xaew\xa4j\x8e6\xe0\xaeU\x9e\x15\xe7X\xa9һJ\x8f~\xdf\xda4\x90vJ\v]\xe5\xcf\x1c\x9f\xcal|\x88A4\x7f:o=봴\x90\x9c\xcd:-sE\xa1\xa1E\xdbg\xbe\x95\x1f\x9454gP\xdc\xcf\x12\xea`\x9f\xea$ҵ\x81\x06jf\x19\xf3g\xd6Dۀ\r49\xc8\n\xcf%\xfd\x9d.\x1aeVL\"w\xa7W\xadg\xb6\xb6\xac\xa3\x16+\xee\x9c\x16n\xa1B\xf9`\x1c\x85qt\xf8DQ\xe7\x8b\xfcE\x9d\xa6\"w9+\x82g\xb8\xe4֕T\x91O\xf2\xdfS\xb5\x1c-&\x91\xb3W\xb2F\xa99K\xba\xc4w,H\x1b\xea\xe3\xdcN9\xdcs7\x9f^\xb8\xb3\xf0Iw\xedւ\x94\x8e\xa8=\x98\xf0ޤM\xbbL\xcb{\xa8z\xee\v\x9f|\xa0\xb4ӓM\xd8ǝ\xe3\xac\"fO~\xb1\xc5>^pQ\xb9}\\\x1f\x82\xe2\xd6ԉ\x81<k{F\xc8\xf4\x91\x9b\xa5\xdd\x19\xb4\x87]:\x99\xad\xc5v\xe9\xf4\xf7Ea\xd3\x19O6X\xa7\xfb\xae\x80\xe5\x0eH\x8b\xb1X\r\xc0#g\a\x86\xad\x1b\xf5\xb1\x9a\xb3\xa2gv\xb5\xda\xc7{\xb1\x15=\xfd\xfd\x96\xb0\xec4\xf1-\xb4\x95\xc3@^\xd3@^NB\r\x1c\xb3\x15\x9cr\xe6Q\xf3ۃl\x88\x9bE\xa9\x81\xb4\xc5]\xf6\xfa/\\\xde\xe4*\x8c\xaf\xfc\xab\x1d\xdb\xf0~\xc6\xcb{'\x88\xd7\xdf\x16\xea\xad\xe7QB\x8a\x8d\xe41aK\xccH\xe9\xbe\xde\xe7\xe0I\x19\xe9u91\x15j\\!\f\xdc\xc2IG\xa09\xd3\xd0pk\xa2\xd4\x13\xba~\x9c\xb8\xd4{Xě\aڗ\xe4\bK\xc16\x92\x9eŠ|\x14\x91\xda;\xe5\xd62\x13j\xf6\x8a*\x11\xd0\xe5\x1b\xb7\xdd~\xfe\xb9\x8fE5\xb5+%\x1b\xa2\x1d\x95J6U[\xd5\xc4]!}X\x14\x9d\xe7\xb7aR,Ҳ\x9b6)J\x9a{Y\x92[\x8d+\xa9qK\xb6B\xb1\x97\xb8\x963#\x83\xce,\xff\xcbnU\xe633䕇\xae\x8a\xf1\xf3]\xee\x1a\xaeY2\xd3\xd5\x01҆чmp\xa9\x86\xa9\xba\x9am4Ձj\x92\xdbI\x7f\xc0|\xae.\fd_T\xbd\xa7\xdb&#\x1a\f\xfc\f/\xdb\a\x8b\xc3銹j&\x98\xb0\x88\xa2:av\xab\x94},o\x88\x99\xfb\x7f\xee\f{5N\x85I\x87g\x12f\xa8\xe2\x15ʈ\xe5Z\x85\x1d\xe7:\x13\xee\xf7\xbb\xe8}\xfaZ.X2\x8e\xa3\xd9fmg\xd8w\xc1\x9f\xaf\xbc\xff\xf8\xde\x13\x7fEj'\x8f9s#\x9e\xf2\x19+i\xa6\x11\x1a\xb1\xd3\x0e\xb6Z\x9b\x15\xa2\xad\x82r\xd3\x17\xcf\xff\xfa\xfdȻ\xc9<\x8f\x9d\x1d\xfd\xd9D\xe3SU\xba-y*\x893\xa4\x9a,\xa6\xaa\a\xb5\xe4\x99Tm{\x9e\x18\xe4\x94>K߾\x90\xc2E\\C\xe4\x95r2YkiUj!F\u07bf?\xff\xeb%\xb7U\xe64\xfd\x87i\xeaa\xe1\xff#\xeaZ\xed\xfbX\x9cs\xe0{\xe9sc#\x0e\xb5L\x18\xaf\xa3\xe5R\xdb\xd8}\x9b+%o\xbf\x13\xdf\x1b\x1b\xb6\xeas\xa1*f\xd1\xfckm\xf0K\xbf\x0e;\xc7e\x87G\x15\f\xa6q\xe79\xc1\xe11b\xf4\xcd\\e\x8b\x9f\x88\xa5X\xd7<\xd1n8\x89\xbb\x9d\x9daR\xb8C\xee*ަ\xec\xcaCK#R\xa5\xb12\xf7f\xb3\r\x89\bb\xd8T}(\xa5L\xf4A\xfd\x83jz\xb4\n\x1f\xc3\xc5\xd6i\xe6>_\xba\x8a\x846\ue938`\xba\xd7\x1567\xebp6\xa2\xbd\x82N\xbb\xd1\xdb\xc5\xfa\xdd\xea~\a\xf8+\xb1>\xef\xbdsT\xd8.\xee7\xab\xc7\xca\x1bEn{\xb0\t\x11\x18\xfbf\x1c\x16\xd2?;h\xafp}j\xdd\xe5o\x16\xb0q\x04~\xceB\xf6\xaf\xa9=\x90\xd7\xea\xf3C7\nW\xb5|\xf9\x1f\x7f\xad\xb2'\x98Y\xfe\xfeu\xd2W>w\x14W\xa7\x9aσ)\x17d\xa5\xe3O\xdf Ȍ\x95^\x97\x97\\\x03\xe8Sbq\xccn+\xec\xac+δf=M#U\x0fK|\xad\xbd\x17z\t\xa7}\x92e\x15\xf2/\xe5\x17\xb2\x89\x88\x1b\xcc琕\xbe\xcb\xd2f?lV\xbc\x10/3\x8b\xae\xd0\xfd'\xe3< \x96\x8d܂\x12\xfdVo6\x85Mml\xc1]\xeey2vx\x1e\x96\xcd\xf1\xfdg\ue52d\x17\xa5kD\x16\xbe^\x1d{\xceoq\x15\xcfN\x14~*_\xf5C~<kL|=KS\xe7\x84Ȫ\x97[\x9ej'\xa8*J\xac\x00\x06_\xa0n\xef\xac\xc9\xdc\x1c.sc\xabz6詜:}ڙ\xcaU\xce\x0e\xa7\x9cV\xb9\x8f\u05ee_\xf0+%\xf9.\x84=s\xd4KR\xdfM\xe4\x8c]wa\x9bX\xfe\xe0\xa1{\xacKl\xb5w\xe6\xabL\xb5\x9bh5\x95\xa3\x9cɊ\x80U\xfeЭ\x1b\x96\xc8U\xf6\xee\x0e\x16LD\xba\xe7\x878\xcf9\x0f,6/\x9a\xd68dC\x19\x02͌\x12\a9a\xb1\xf1Z\xa2R\xa4V\x1fw\xcc\xc8{k\xff6\xfe(!\xdb\xd7\xfc\xf8\xd5ǅw\xe5\xfd\x16\xacY\xc5y\xe5\xddx\\\xdfq&\x8b\x85\xe7\xa6\x14\x94\xe4\xbc4\xb4\x93\xb2\xbb\xf3H.\xbf_G+\xda\x13\xe8rV\x89i\x17\xfe\x9d6\x92y\xf0z\xe6\x87s\xae\nn\x8c'Rh\xf3C\xa67\x1f74\xcc\xd4;\x89Ә\x12 \x17\xaa\r\xa6\xd9O\ns\xf9\xb3\xaf\xfe\x13?ei뾲\xdfL\xacZ`/\x86\xe7\x18<\xc7\x06i\x9f\xeb\u0601ʜ\f\xc9ڷ\xdbC\xa8\x8f[\r^\xaa\x02:w\xe7\xad\xe5ҔG\xaewn3\x9c\xfb\xee\xe7ʹ\xe7;4\xe1L\xe4\xee\xd5\x15͍\xceO\xb6\xb8\x13\x15]U\xeeO\x14\xeb\xabc\x94_\x80/Qm_\"w\xf6\xec\xe1K\xe4L\xd5bg\xa2\xcc\x05E\xdeD\xce%p'\xea؝\xe8PÍ\xbb\a\u009fg\x00\xf2BC\xde4\xd9u]\xff|+\xf6\xa7\xb9\xf12\x97lq\xa9qe\x83\n\xf9'\xd8\xe3a\xf5%\x9c\x04\xbf\xd1\x00\xe6\xb7\f\x92~~\x15'zF\x9ew\xd1,\x9c\x14\xe8\xac\x05\x17\x19\b\xaa\xe2\x92,,0Vw\xad\xa9\xcb q\xf1m\xc6\x054\x9d.\x15\x7fP1L6x)Z(P\x7f\xe9\x89\xfb\x0e\x97\xe0\xd6\xf4\xc0\xb0\x14\x1b$@?\x90\xa7S\xf7\xfc\xf1n%n5b\x1a\xfeC\xee\xbc\n\xf2-\tY\xa3\xe5\xff`\xddM{\\\xb0\x83\xce\xd5\xf3K\r\xbd\xe9\xef\x17\x97^\xb0\x9e|o\xf8z.%\xc3W?\xe4\xddi\x1dμ\x80&\x99\xd8\v\x8c\u05ceI\xfa\xa0\xd36\xd0SF\xde\x7fe_\xe22\t\xadVLe\x1e}Ir/D\x9c\x836\\\a\xaay\xea\x85\xfd\x99l\x95\x927\xd1yi\xd2\xd3}j\xd7\xec\x0f\xad\x8e\xbby\xef\x8d$\xbb\xd4ר\xfc\x14\xc6]\xc4H\xbfj,\x8c\xef\x13\xbf\x99x9\xf9\xaa\xb2\xb6\xfaV\xb8\x8e\xdcZ\xd5\x17\x17\x06c\fDl\xf7+\x11\a\xcal\x91\xab\x8c-2\x0f\xab\xed\x15\xfadWj\xafq\x80\x90.\x89\x03\xddw\xe6^\v\x1d\xa6\xb9\x88\x03˓THI\xb8\xa0\x03\x9c\x86#\xb1\xd5[\xe5\xd8\xdcR\xa5\x01f\xe9\xe7K8\xdd\xf83\xc71d\x16\xeb\xd0H\xbf\x80\x18p\xb9\xf1\a\xd7\x05\xea\xd2\xcc\xcd\x16rD\x14]e\xed\xb99l\xba\xb2e\xe2S\x9e0s\x92zũ\xcd\xce7g\v\xb9\x88\x93\x82ƺ:\x87\x9a\x7f\xd9OMW\xc4I9{\xf34>-2\xf5\x8f}\xa3\xe1\xcc\xe8\xef%ul0\xcdmqz\xebL\xaf\xef\xc4\x04xx6\x8a\xb8)\x87\xae\x13\xf6\xbdr\x0f\x87\x82\x0e\xb4_Z\x97B\xfe۬'\xf5\xb5\xea\xc3G~\x99E\\\xa6\xbf\x9ak\xa5\x1f%v\xd5웙\t\xa9v\"\xd9\\\xe3%O9VV\x92<\x02\x19\ff[\xb2\x1e)\r\xfa\x91\x04\xf6\xdb\xdf\xee\xaf\r\x93\xd3#\xacд\x1a:\x7f\xcd;\xeb+\xd9\xf8\xcd\xf9z\xa5v\xb1\x91\xf3\xc6#=%G\xf1\x97\xc9h2\xa3v\xd3\xd6<\x8bh\x7f\xd4G\xcd\xc7g\x05w\xf8\xf8\x8c۞)\x04\xe3\x12\xbe\u00a0{G-)\xf5\x85[w\xe6\v\xf7\xc6\x1cq\xb4\x89\xceٲ\xcd\aE\xc1\xab\xc8\xed\xe9\xef\a\xfe\xb7\x8c{zs\xd5{(\t\x83\xffk\x93\xd0\xc8D\"]\x05\xb1u2\xc8H5-\x963\xd9d\xf2L\xa7p\xb7ӌL>\xeaT\x87\xf2#\xa3'\x05\xe9\xb5%\"\xe17\x99NbQ\xc0O\xb6S\x99\x1dS\xc9\xffbO\xb7\xfdhM\xb6>\n\t\xa3\ti6\xf7.8\x8c\x8b\xaeJ\xa7Y\x9c(V\xadR?Y{\xbd6\xeeH\f:\xa9\x17\x01\x8b\x01އ\xd2\xe9]\xb4\x86\xe7\xbe\xf2uȬc&ql\xeeP\x8f\r\xb9\xbf\xbe\xfaO\xa68\x8f:\xbd\xd4\xf8\xb8\v\xdfW\x19\xfa\xdfHE\x1e\xf9\xad\xe5g\xcaß\xb7\x1a5\a\x8d\x89\x88!\x81uS\xfb\x8e\xa64o\x11\xdf{\xaaG~\x95<*얻\b\x1cGp=\xa6k+\x8a\xdc\x14=l\xed\x7f\xa6\x97X\xb2\x7f\xd54\x10K/\x1f1\x8c\xff\xd5\xcd\xe3\xec}.\x95I\xc2\xca%b@8\xc0gЮ\b>UEbq\xec\x0e\f*v\xab&[\x90K\xea\x92m\xfe\xa3\xc1N%\xa5\xdc\x7ftW\xa2\x9b\xf4\xf7\x16\xa5\xeeJ\x7f\x939\x8cJ\xe7\xeb\xf9T\xc4q\xe8V\x1bn\xab\x858\xb7\xa5\xaa8\xe5\x1e\x97\x99\v\xf21\x11\xb2c\x9b\xa3q\xebI\x9d\x1cY\xee\xf7Z~\xb5'\x88\xdc\xc4>\xb5\xde\xf0\xa6\x7f\xb5\xe5e\xd4\x05\x97\xaa\xf8\xd8\xda\xc4ҧ\x0f1Ӱ\xfc\xd1j-\xcb\xda\x0f6}l\xc5L\x81x\x82\xff\xf7\xf3\xcbL\xef}_(\xacT\xf1\xc8\xcd_S\xe0\x90Z\x98\xb0\xc8zŦ\x16\xf0۵\xaa\xcb\xc3\a\xcb\xd4\xf2ŴF\x93\xdc\xedR\xabɡM%\xcdJ\xee|\xa3\xa9\xa6\x1e\xf5\x9b\xbb\xb7\x1a\x9f5\xe3\xec\x9b\xfa\xfa\xa0\x89\x978Y\xfb\x12\x87\xfe\xe4\xbd.\xa0\xd1\xfb9\b\xaf\x1ah`\xad\xe4=\xe5\xd3;\xfd}۳ۼ\xf8U&\xa7Х\xf9\xe2\xfb\xa2ɾ3\x01Q悎6\x9ct\xd75\xe4d\xb1\xabhK\xb9\x98\x9c\x1e\xab8%=oy_\x11\xacuZD\x92\b\xb8ŦZ\x88,yU\x9a\x8b\x1d4H\xa2\xf2\xa7j\x05\x90&f\xbdbb:b9S\xaa\xaf\xaa\x8b\x9a{8\xaeB\xe6(Nzϴn\xdf|I\xff\xb0>H[\x1dvb%&\x88i)\x9brh\x8b\xeb\xce^ɓ\x8a\\\x90\xb6\x1b\xbb\xd2\x03\xb6\xd6\xf6\xaf\xad\xbaP\x03\x19\x94\xc6\xc1\xda\x7f1ʋC\x059\xb1\xdeܽ\x7f\xf3\xfa\xe6Û[\xefJ\xa1\x04A\xf3\xee6\x90\xbf\x8d\xa7R{\xd1\xe1b\x96\t?\xf7Z=\xf7\xe5u\x81\x18F\xfb\x7f\xa0\x95RV\x15$M\x99\xaeT37P\x87W\x91\\[\xf4@v\xdc3\x89y\xd9\xe3n\xa3|\x84ⅿ\x8c?EkkT\xf4T\xd0\xf1k1\xc0\x18\x1a\x9c֒\xe8\xfc\v\xfdY\xf8\xbfM=+\xea\x10\xe7\x93D\xf77>\x92JU\xd1>\xdf\xd6Ħ\xc49\xe5\xd0\xe7\x175\x99\xe4e\xe5\x81\x1ao&\xbct$\x12\xc8\xecO\xe2\x14\xee\xf8/ZSЇ\xd4y\xa9\x19\x00\x89\x9aL\xeeH>\xb6v\n\x9d}NY\x95\x94\x978\xab>*i\xf2fm\xa4\xe4[\xf6k\x10y\xbb\xa8[Śu\xc9&\x06\xae\xbbz\xc9\rQ@z\xead'\xbe\xf4\xa6*Y2[\xb2x\x0f\xa1\xe1\x1eGZ\xb7J\x1cϹ'\x8bw\x1f5\xcc\xc6o\x9f\xab\x9e\xe9L\xbb\x97)c[\xc8\xdd\xc5c\xf7\x9f\xa69\xe1ڸd\xc4\x1bMc\"%\xf7\xab\xdai)\v*\xeb\f\x8fJ\x0f|ج7+;\xd5b\x85\xbc&zFh\x18\xc9\xfdNS8PX\x9e_\x8d\xbd\xce\u0099\"\xd0*\xad\xf2*\x9c\xfb\xabp\xa6\xc2\xe5\xec\xc0\xeb.p\xde/\xaf\x82\xe9\xd5\x06\vr\xc3\x16\xe4\xe2\xcc\x032ɍEO/\xe5`Z\xd0Aև\xa5\xb67Ȋ\xadS\xf3`\xf4\xde\xff\xfa\xe6O\xd2\xfec\xads\xc1\xf2\xdc~\x01\xa8\xe3\xca6\xb6*=j\x93\xc3,%\x13Xt\xe9NЬ\xc1\xe7V\x97\x8dة<\x1dd\x7f/\x10\x18\xd2&x\x91+\xca\xec\xf0eBG\xb1\x8bN\xf1uj\xd5\xd8%\xa1\xcf^9\xa9->-8k\xf5\x81\r?\x9e\xc1\x15\xad)\x10:\x0eG`\xa5\xe2-\x9c\x81Zڒ\a\xb8\xed6\xe4sT\xb2_\xd5\xde\xf7\xb6\xd6\xedܭdݦ*zlQ\xae\xdcl\xf3u\x94*\xd4\x0eE\x90\x03j\x87\x1es\xed\xd0\xec>\xd3_\x01\xd1\\KZ\xad\"Zk\xf3E=Q\xd4\x13E\xb0Es\xf5D\xb3\xeb\vEEQT\xb4\xfa\xc1\x00\x85\f\x95E\x0f8\xcc\xdfkC\xc16\x8d\xca\xd5l\xcc\xf5\x12\xa2\xadw\x06G\tJ\xea\xf5\xb0\x93\x9d\xb9\xd6\xcf\x14Kr7(66\xf0G\xfa\xda\x0f\x116+\xa8A\x83\xd9\x1d\x16\x85^\xe5\xef\xf5\xfc}\xa5HCƳ\xfe7\xed`\xee\x17\x97\bs\xe4\xa1d\xce\x17\xcaD\xba\x8a\x91\x99\xba\x8e\x90a\vi\xd6U(\xcc\xf2}-\xb7v\x9cBn\xb8\xd7\xfd\x12\xff<\x89\xe6I.\xd0\xf9^\x8d;\x12\x8b\xc8N\xe3\x927Uӄ\xfe;qw\xb8K-\xec\x02\xf1\xa8\x89=\xd1\xeei\a\xec\x8b=\xd4^F\xc1e\x14\\F\xc1e\x14\\>\xa2\x82\xcb/\xab\xbeޖ\x93Ε3\x9dS/{\xe4\xf1\x96\xa0\x13~\x89Lɲ\xc2\x7f\x99\x1d\x85֬l'\x0f\xfcbV\xec\xe4\x1b\x90\f\xd8\xc0!9\xc8zѧZ\x06:\x8f>\x8f\xa4\x16t\t\xb3EAh\x14\x84>ʂ\xd0\xe5\xe0\x1fU\xa1Q\x15\x1aU\xa1Q\x15z\xcba\x8d\xd2\xd0Y\x91\xf2\x98\xeaC\x17\n\xc2\xdbD\xf7\xfc\xa8\x94:i\xa6rs\xb8\x98\x98E\xfa\xb7\x0f&[\xf0\xf3K\xf7\x1b} \x8a\x80T1PH|5w\xbc\x1d\xb2\xa6#k\xfayeMG\xd2t$MG\xd2\xf4Ò\xa6\x17\x9c\x1fȜn3\xa7\x1f*L\xde\xf3$+8\x85%\xaf[:=\xba\x9emڀ\xa8i\xbb\xb1U\u009f\x16\x86\xe4#\xf6\xa7\xfd\xb1\xa5@\xac\xf6\xfdt\xdd\xf5\x935\x7f\xca\t\xd2t\xdfs3\xae\xa8\x17\xaf\xb4\x19\xaa\xaev&[\xcb._\xdf|\xc2\xf3\xce\xdeG\x12g\\\xb1\x8a\xba\xe7\xab5\x92\x15]\xb6\xe5\xda&\xf5\xa4o\x8bwt\x9d\xe3\xdee\xf6\xfeZ\xf5Q`\x92\xfd\xc8\xceO\x8b\xdcl\x1e\x05\xcaf\x19=ɫG*\xb3\xe6$H`\xb2\x96j\xf4\xe6\xdd\xc8\xf1U\x9a\x89*\xf9\xb2J\r\xd3ܛWOEU\xde%\xfed\xbdI\xab5\x06\xa5&]\xe3\xce\xeeZ=\xb3\xcdV\xb6G\xfe\xca\xd8$\xb0\xcc,\xb0ⴔ$;\xad\xae\x1e7\xe14Щ'\v2O\xee\xeb\x9bB\xbf\x14\xc8v\xbf\xe3\xf5r\xa6\xc0\x8a/\x98\x18hM|\xb5\xa8\x1cN\xfc\x92d\x04\x98\xfb˫\xb1\xcf2\xa2\xc8\x06\xdc+\xc1\x9f<\x8fb\x0e1\"\x9dA\x7fk_9\xb93\xdbB\xc54\xccf\x0f\x165V\x11o4Z\xf0s\xce\xd3X\xd9T\xf4in\xecf&\t\x8d6\x82\xccYDW3WF\xf4J\x84T/~\"\x89\xe8O\xe18\"\xe6K\x9f\xe9\xa4\x1c\x8c\xd8\xe2\xcdr\x19q\xe1\xc0\xe4ElS/M҃\xc4`#\xf2O\xf6B\xf3\xa5$K^*ݬ\xb3̥5\xf0\xd9֚?\x15\x02\xd6\\Q{{\xc4Z*\xbfT\x9d\x905T\x1eB\xe5!(\x11\xa8<\xd4}\xe5\xa1\xdc\x16y\xac\xe5\x87\xf2/\xd2n\r\xa2*27\xaa\x11\xa1\x1a\xd1\tU#\xca\xcdj\x94$:\xbf\x92Dy\x99\x1a\x91o\xe7R\x97\xa8ޙ\xd7j\x85\"\x94%BY\"\x94%BY\"\x94%j\xa2,уɐ\xad\x9b\xa9l\xdf\xdae5Z\xe4*\x15\xa1@\x11\n\x14\xa1@\x11\n\x14\xb5X\xa0('N\xa2J\x11\xaa\x14\x1dZ\xa5\xa8\f\x12\xa2T\x11J\x155KEP\xaf\b\xf5\x8aP\xaf\b\xf5\x8aP\xafh\xf0\xf5\x8avI\x9a(Z4ܢE\x15C\x00\x9a\xaf\\T\xc7f\xf6\xb2\x87\xfaD(M\x84\xd2D(M\x84\xd2D(M\x04\xe33J\x13\x9d\\i\xa2\x97۬\xf0/Q\x9a\b\xa9\xaf;)M\xf4\x12\xa5\x89P\x9a\xa8\xcb\xd2D;\xf6=+\t\xefW\x99\xc8\xcaѥZ\x95\xbd\xa4fI\"{\xe7\xac\x19AR\xbalV\x8e\xd3\xd3T\xae5n\xdc\xd84\x11\xf4\x00I\xb1=I\xb18\xee\xe2Ci\xe6h\ta\xb0\v\xb4݀\xe3z\x18\xcc\xd9\xfdv\xc5p|(\x8c\xdcȽ\xdc>\x01\x1bޝ\t\x054\xa07~\x8aI\xb2p\x83\xa7\x87\xdak\x8d\xd4v\xb2'\xd0\xfe\x87\u05f6\xcaN\xb9k\xcaCE\x1c,\x84@\x11\x04\x8a\xd4\n\x14I\xe6\xce~a\"\xcel.\xa9\xa3\xe4~]\x14\"\x92lD\xd0({\xd5(o\xac\x1a\x99\x19\x92F\xf4\xc7d\xbf\x84\xda\xd8m\x01%\xeft\xd5\xc9\xf4\u07b3\xffA\\R}\xc4\xfdzK\xf4JZ\x91s70\xeb\xd2}yH\xb5\x11\xc9\u07b7Gődo?\x8a\xda#-\x97n0\xee\xff=\x94mh \x04\xe0f\x9b\xdb?\xdb]3\xfe\xd9)\xdd%\xa9\x97\xc4^\xa0\x129d\xc7Sᘩ\x98\xe12\x85\x95\xdc@\x00\x91f\xd8y\xff\x18#\x02\xca=\xd0o\x16{8\x9d\xeb\x14\xf8vu\xb9\xa1K\xc1\x9f\xfeD\xaa\t\x88\xaf\xed\xf2\xc9\n\x1a|3\x1a\x90@\xbcz9J̸\x01So\x1b\x87\x84\xa4\xaf\xe5\x02\x1b\xf6\xf1\x1d+\xa5\xa9ߊ\xfbP\xe6*\x0eI0\xf3\xef\xfb\xda\xc3ģ3\x8b\xfci|\xed\xd8Z\xaf\xb5\xbf\x10\xf7V\xf2\xf1_8H\xc0\x89\xcbkѳ\x9d\xc6\xc7f\xceL\xca`\xe4\xd2V\xa7v:'\x81u\xc5\xe4\x94%\xa7B\xd6\x11\xbd\x92\x14\\Z\x84\"}A\x95lT\xe5犾Z\xd5ֹ7Y\x8a\xe9\"\xb5\xe5\xfd\x1aƱ\xf3\xa1\x06\x1ceW\xcb\xe1S\xdfayw͈\x04\xa1\xa6\xd7\xcbY\xba%\xdb\xd7o\xc9)\xb9\x10+\xb4䒜\x9dI;N_\x89\x89ͧ\xfb\xb7\xd1\v/\xccL\xe7=Rjfq\xe1\x01N\\\xa5\xfd\xcd\xe8\x17\xee\xa1&\xe1\x11\x8dloꆴ\xc0\xae\xabM\x89\x92\x95T\xf8\xfe\xeb\\\x1d\x01\xdb\v\x8e(\xa6\xfb\"\xfdv\xdew\xca\x11PBJ\xe4g\xe2\x10,\aK\xf1/\xbe\xef\xa9C\xd4\xeb\xddl\xcf<\xbd_\x1fH\xb6*\xf6\v\x16ࡍ-\xa6\xd8_r\x99\x9dM\vW\xc4,\xaa\xab#\x95\x17\xb2\xc5u\xea\xbcfj[\xddoΧ\x1b\xafb\xca\xf8{\x11\x1e\x878\xe3\xdd#\xa2\xdf\xc1\x95\x11\x1d\xd5i\xf5Δ\xe8\a\xb6}\xa1Q\\\x1f3\xb2j\x05\x9f]!\b9u\xa1~5\x1f\rE\x0e\xdd\x14\xddeb\"\x19\x92^=\xcc\xeb\xbb\\\xa8+\x97Ӳ\x1e\xdf\x1aKm&k\xc7\x0f:)P\x91\x15SUn\xecD\x06j\xb7\x8c\xcaZ\x15Mɗ&\xa9+\xfc\xa6\x8bt$\xccngݔd\x02\x99r\x01I\xf9\x94t\x9bT\r\x95w\x8b[q~m\xac|\xca\xcbѶ\x9a\"U\xbc\x02R\xc5\x0fK\xdc\x02\x9c\xf20\xb5\xfc\x02\x92{\a\v\xde\x0f\xf8\x01\x93\x99\xbfRy1t\f\x80\xfcXb(x\xefp\n\x19\xc2A\x00\x0e\x02G\x9cZ\xbd\xfd\x14\xe8\xf7\xfb\x97\x84\xae\xa7c\xa5\vVo\xb1\xdf\xfdZ\xbf\xb8\xe4\xbe-iĜ\xed\xd6\x13\xaf\x8b\xd1\v*\x16n)GV\xc1\xa2\xed\x94S;\x1f\x9367T\x9d{ō\xfc`*r醪\xfaզ\xb4\xb4o\xe3?a`\x1f\x92\x81=]'s?\v\xbb\xbdE\x89\x89=\xf5}:\xe5E\xf2\x1d\xac\xeb\xc3\xf3\xd7ΌN3\x18Щv\bA\xa7SK\xfb L\xe2\xe9\xcd\xe2\x80ü\xc4(\x9e\xfa\xbe\xd4*^]\xfej\xde^\xfe\xeb\x1e\xb6\U000a3c10WCCK\x9f\xf9D\xc1\x0e\xe4\x04B\xe90\xf5iJ\xe5U?t6\xa2q\x14\x91j+f\x04\x1a\x82G\xaezqK\r!\x05=\xd8\ueb203\xfa\x89\xa0\x12.R\xdd\xefgj\a\xcf\xfdρg\xeen\x12\xa8\xa9\x97v\xbaFu.\x87\xe6\x1a\xf3ka\xfdim\x8aU\t,U\x94\xfd*\xe2p`\xfdS\xe7WJ\xc7\xf6]\x11\x8a\xad\x86\xbew\x97y\xd37RlR\"\x1cE\x0e1\x03\x17oV\x0f\xfe$\xc9E\xe84Ȕ\x11\xb2Q\xbd\xc9\vʯ\xf5\x1eG\xdb\x1em\bN\x96Q\x11\x94\xb8\xcf\xfc\xe2\x91Iͣ\xff\xf9\xfcy|p\x86\x8c\xdf\xec\x12I\x17\xd1l\xc9\x0f\xa2e\x17\x0f\x87\x98\x1c\xa9\x9b\xc7/\xc55m\xb8f\xa56u\xbb\xef\xf8\xf5S\x14;\xc9X\xd5Ϝ\xaa\xd4f\xaaJ\x94=\vk\xfe\xc3C\xfa\n\xb7\xdcqU\a\x90\x86\xb35\x96\x92\xd8\x0f\xd9E\x958\x00\xe8\x14\x1a\x15\xcbW\xef\xadW;I%J=H\x8cGC\xdew$.v\x1e9\xc4a\xa2\x9a\x96Pꂐ\xb9\xa2 MQUT\x91Q\x19\xea\u05eb\xcfT\xab/\xb2\x8ed\r#[K\xdaW5\xe9\x1f\xea\xd3ଗ\x0f\xa9\xff\xeeث!\xd9\x06z\xf1fHz\xbe=w\x86\x02\xaaԴ?CU+\xda6\xcbY\xd1\fh&\xc9Vvͨ5\xa2v\x96\x83\x97ª\xf2c\x17\xa4t\xd0\x11I\xcb\xd1H\xca[\x9f\xef}\xa7r\xb7\xe8\xa4\xc2\xc9ibN\xb5\xaa\x8e\x9eN5\xea\xea}䖰\xde\xd9SV\x0e(\xad\x90l-\x93t\xbe\xe8$0\"%\x99\u0558\x96e\xc5*\xfc\xe2\xf9\xf3\xbf:kg\xe2/\xfd\x89\xe49\xf9\xc0I\xbdYE\x0eB\x86\x86R\xc67\xf5\fc\xfdd\xa7\x00\x163\x9e\x82\xd4\"L%z\x96\xe6h+\xf4Ze\xbeq\x0e\x98\xca\t˦\xef[\x9b\x06Ib7W\x17\xb4)\x7f\xc5\xf6}\xb0U7\x7fXo=\xf5\xb4\x04\x91\x1c՜\xf3\xbf\xba Ѣ\x017\xdf\xca\x0fʤ\x9b3\x88\x1e`\xceu\xc8Pul\xe9\x1ar\x035ǌ\r7klnʐ\x9b\x1cn{\x19r\x93\x9f\x97\x1br\x9d\xf3\xb3\x96!\u05f9\xb7\x14YO\x8a\xdfY\xa5 \xbd\x87\xa43R;\xbeoL\x15Tq\x00S\x00\v\x1c\x16\x16ށ\x83O]f\xf4\x17]0\xe0A\x92v\xda\x15\xc13\\g\xb4\xb4\x15,R\x85\x06\xb4.*\x89\xa1u1S\xd2q\xbec\xd9\xde )\xe7v*\xa4!\x96\xaa\x99\xa7\x16x\x9e+\xf8W\x8b\xa0\xba\xbbנb\xac\x93\x86\xd5+/XI\x05u\xdf\xfa<B֝\xeel\xc2\xc8\uf72b\xb5\xed\x02\xc9o\xb7\x18\xf9\v.*7\xf2\x9b\xda$\x9dU8\xcc\x1a\xd0\x11\xbc~\xe4\xb6uw\x06\xedk\\O\xa6l\xb1q=\xfd}Q\x00{\xc6O\x0f&\xf6\xbeL\xec\xbfh\xab\xba; -\x86\xc15\x05\xba\x9c]\x19\x06\xfb\xa3\x0e\x8dO7\xafwW\x80\xcc\xd6v\xc0\x91_\xec\n\x90\xfe~K\x80|\x1aV\x17\x1a\xfca\xe5\xafi\xe5/\xe7\xb7\xe5\xe9\xf9\x8d:a~{\x90!ԩ\x1c\x98\xb5\xf2\xb6\xb8\xe9^\xff\xe5+i\x8aWa|\xe5_\xedؕ\xf7\xb3\xc0\xde7UkO\x99r?\aO\xca\xd3@\x1d\x93:\xe8\xbbB@\xbeE\xaa\x8e\x90s\xa6A\xfa\x1f:+\x13w\x041\xf3\xd5d\xd92\x13p\xf6\x8a*a\xe8\xe5\xbbwy\xb5\xab*\x96\x9b\xd4֔\xec\x8avh*لm\t\x1dw\x99\xf4a\x11u\x9eߚI\xb4H\ao\xda$*\x85\x13dqn5\x0e\xa5\x06/\xd9\x14\xc5\xde\xe3Z\xfe\x8c\\:\xb3\xc40\xbbi\x99\xcf̸W\x1e\xbf*\xc6\xdbw\xf9\xdaL\xd4\xe13]o\"m\xd8}\xd8F\xa2j\xd8۫\xd9vS\x1d\xa8f\xba\x9d\xf9\aL\xea\xeabA\xf6E\xd5{\xe6\v\x19\x0f\xfe4ߺ#\x16\x873\x16\xe3\xd8LDg\x11|u\xc2\x1cW)\xfb^\xde~3\xf7\xff\xdc\x19\x80\x9c/}\x9c\xc9d\xa2j\xa2(\xfb\x9bk\xdav\x1c\x06M\xb8\xe5\xef\xa2\x10\xeak\xe9\x7f\xfdq\x1c\xcd6k;;\v\xfe|\xe5\xfd\xc7\xf7\x9e\xf8`R;y\xe0\x19-\xf1\xbcϘz3\x8d\xd0d\x9e\xf6\xb2\x95-n\xaa\xad\x9ar\xd3\x17\xcf\xff\xfa\xfdȻ\xc9<\x8f\x1d8\xfd\xd9D\x03WQ-\x9d\xa7\x92tC:\xcbb\xaazP\v\xa2\x13\xabM>Ol\x89I!e\xf6\x82\xf3\xb94\xcd+\xe5.\xb3\xd6\xc2+\x8f\x06\x8dݿ?\xff\xeb%\xb7U&6\xfd\x87i\xeaa\x89\x18FԵڟ\xb38\xfb\xc3\xf7\xd2\xe7\xc6\xd0\x1dj\x111^G˥v\x14\xf0m\x12\x9b\xbc\xd9O\xbc\x88l\xec0up,\xf4\x9a\x7f\xad\xed\x84\xe9\xd7a\x87\xbf\xec\xf0\xa8Z\xef4\xee<'8\fH\x8c֙\xablM\x1d\xb1t\xebR:ڡ\xc8)\x86jf\x98ԃQe\xe5ك6\x90\xea\xf6\xd4\xc9BA3\xf7fk\x0f\t\vb\x0fU}(\x15r\xf4\x91\xfd\x83jz\xb4\n\x1f\xc3\xc5\xd6i\xe6>_\xba\x8ak\xf0\x86\\c4?\xdd\xebʞ\x9bu8\x1bцAG\xde\xe8\xedb\xfdnu_\x85\r\x96X\xcf\xf7\xde>*\xec\x19\xf7\x9b\xd5c\xe5\xdd\"\xb7G\xd8\xfc\x14\x8c\x873\xae\x17\xe9\x9f\x1d\xb4a\xb8\xce\xc2\xee\x1e`V\xb1\xf1p~\u0382\xf7\xaf\xa9\x8d\x90\x17\xec\xf3Cw\vW\xdd|\xf9\x1f\x7f\xad\xb21\x98\xa9\xfe\xfeu\xd2W>w\x14-\x97p>\x0f\xa6!=\x99\x0eB}\x83 3Vzq^r}\xa9O\x89\xb52\xbb\xb7\xb0\x17\xb2x\t\x9bE5\x8dT\x9d?q\"\xf7^\xe8u\x9cv\xb6\x96\xa5ȿ\x94_\xc8N\"\x0e=\x9fCV\x04/K\x9b\xfd\xb0Y\xf1j\xbc̬\xbcBG\xa6\x8c\xe3\x81X@r\xabJt\u07b5l8\x85-ml\xd1]\xeey:vx&\x96M\xf1\xfd'\ue50d\x1c\xa5KDֽ^\x1c{Noq\x81\xcf\xce\x13~*_\xf5C~<k\xcc{=ISg\x85\b\xad\x97[\x9ej\xe7\xa7*U\xaf\x98\x06_\xa0n\xef,\xc9\xdc\x14.\xf3ǫz>\xa8\x99\x9c>\x81ڙʕϏ\xa2r\x85\xdbK.\x16\xfcbg\x15F\x118\xaa\xd6^L\x1f>t\x8fu\x89]\xf7\xce|\x95\xa9\xa6\x14\xad\xa6r\xa63q\x11\xe0\x9a\xad}\x99\bX\xf6\xee\x0e.Ld\xbb\xe7\a\xbb\x02\xde\xf3\xc4+8\x99\xf9c\x97r\xf8v\x06jC\x86\x8eJ26\x138b\xc1\x11\xeb\x88Sm\xfcؒCT\xfb)<\xdc\xf5\x93\xf5w\x92S\xa5eߜھ\xb5\xb2\xb5\xecrs*\xaa\xbe\xd1\xd1\xfb8^M\xfb\xbdZ\x13.Gj[\xaemzL\xfa\xb6xG\xd7\xees.\xfb\xf7ת\x8f\x02\rd\xa5\x8fy\x91\x9b\xcd#\xef\xc0]\xca\xca\xf2*S\xb8\xa0^%\x11(\t\x05Ғ\x8e\u07bc\x1b9\xbeʨ\xbd\xf3e\x15`\x9f{\xf3\xeaܾ\xbcK\xfc\xc9z\x93Vu\f\x1aM\xbaƝݵzf\x9bAn\x0f\x13\\llp\x99\x05V\f.I\x9eZ]=n\xc2i\xa0\r]\x05v.\xfd\x06\xea\x95迓\xda\xdcw\xa9\xfe\xcb:\x1e\xd1/%D\xe2~\xc7\xeb\xe5\">+\xbe`b\xbf5\xa5XE\rq\x9c\xe1\xa5x\xf0\xdc_^\x8d}\x96\x1bE6\xe0^\t\xfe\xe4y\x14s\xf61\xd2#\xf4\xb7\xf6\x95\x93;O\xd9e\x8b\x1d\xe08h\x8dE\x8dU\xc4\x1b\x8d\x16\x06\x9d\xf34V,K\x9f\xe6\xc6\x00gJ\xd3\xeb\x10\xb69\x8b\xedj\xe6ʈ^\x89\xe0\xea\xc5O$\x11\xfdIG\xad&v\xd2g\xba@7\aHHjLfcɋئ^\x9a\xfa\xc8I\xb8\x9d\xc8?\xd9\v͗+6?h?\xd0\xce\xec\xa45\x1c\xd2\x1c\xf1y\xaf\xe8\aW\xfc.\r\x7fp.\xaa\x17\xff\xe0\xde=-U\ak\xab\xa5I\xe0\x8c\xb8<\xc4k\x89`\x9f\xf21\xc5k\x84\x86\xd8\xfem\xdc\xc3C\x96\x93\xfd\xf8\xd5\xc7\x05\xbd\xd3o\xc1\x9a\xbb\xf6\x95w\xe3\xc5\xd4U3Q@Y\u07fb\xfd\xed^eތ\xe25\aD\x8e\xe4\xf2{\xea_ҳ\xe9r\x9e\x1b\x8b'\xefwR\xce\xe7\xc1\xeb\x99\x1f\xcec\x9eiZ\xb8\xa3\xa1\xfe\xb8\xf8\x90QN\x1e74\xf9hOJb8\x14\x98]\xa86\x98f?\xa9\xc9\xecϾ\xfaO2\x03\x8d\xf8-\x93:V-\xb0\x17C\x7f\x80\xfe0H\xfd\xa1\xe3x\x06#B%k\xdfn\x0f\xa1FXڪYO2uhF\xad\b\x83\xbc\xa0t\xe7\xb6%\xb5!\xee\x13\\pȋ4\"h;\xc7F}q;\xf9\xf1\x16\xef\xfe\xa2\xab\xca\xdd\xfbc}u\x8c\xbatp\xed\xaf\xed\xda\xefΞ}]\xfb\x9d\xf9Z\xec۟\xb9\xa0ȹ߹\x04\xde\xfd\x1d{\xf77\xe23\xe5\xee\x8bp\xaf\x1f\x804ѐs{vq\x1fr\xe6\x15\x83\xa6\x1b/s\xc9\x16\x0fwW|\xa8\x90ӎy\a鑓\xe07\x1a\xca\xfc\x0eBRү\x12\xfb\xca\xde\x06w\x11i\xfc\x05\xa6\xa2\x82\x8b\x8c\xff\x81\xcak`\ru\xc6\tV[\xc9d\xb8h\xf5+L\xb4Y^*۟ʁ`\x93\x1fD\v\xe5(s\xe9\x897=]`\xec&Ǝic{\xe9\a\xf2t\xea\x9e?\xde\xfd\xff\xec\xbd\vw\xe3֕.\xf8W0r\xcf-\xe9\x0eIUَ\x93\x94\xd3YK\x96T\xb6\x92*\x95\"\xa9\xec\xe9\xd5ʊ!\x12\x94p\v\x04\x18\x00\x94D\xf7\xe4\xbf\xcf~\x9d\x17p@\x02\x14U\xaf\xe0NO\\\"\x0e\x0e\xces\xbf\xf7\xb7s\x8ar\xa7 \xcd_\xa9\xe7<\xaa\x8f$F\xdb\x01\xfe\x81:\x9e\x04@c\xbc\xfc\xf0\xf9@\x82N\xe0\xdf/\x06AT\x8e\xf7T|K\r\xe6\xed>\x8c\x91X\x95q\x12Dp\xdc(^G\x05\xd1+ 9\x81\x82\x83\xaf\x8c\x82_\xaa\x93\x18\x18\xa4&\xf6gβ;\x83\xe7\x96a5\x8e\xb8\x8cxx<\xe10!\xcaI\x98\xed֤\xcf@\x8f\x84q%\xbf\x8a\x17\xcc.\b\xa6$\u07b9\xb4a\xcc;e\xcfQR2\xef\x85JE\xc0\x99Q\xd2\x01N=K\xe5)\xf9T\xa9\xeb\t!\xe7\x93\xffS\x05h\xa1\x81\xa2ADh\xb2\xe8啀\xc0\xba\xd1S\xb7\x10n\xcf걊G\xa6%)\"Y;\u0557\xd8{\xa2\xb4\x88\xb4/\x973\xc1\xd9N\bGOG\xcdj%Zu\xc9\x05QP\"\xba\x8b'hn4q\xdaI!\xf8*\xa1ǲ\xb0$ӑ\x95\x910Pg\xf3\tp\xe7|\xadtPe-dA\xb5\xae\xa0\x81\xcd@\x12\xa6\x1c\x13}\xde,\x12\xf2\xacЯ\xa9\xb2\x85|\xfe\xaa\xbf\xaa\xa5`\xc3/\x01Ԩ\xaf!\xdf\xd0<I\x8c?J\tJ\xc8\x10\x94 VE\x95\xc4\t\x11u\xef\xb7\t\xc1{<\xc2]\xb1\xad\xfc\x8a/8\x15\xc2f\x0e\x9e\x05\xd4\x0fu\x86\x0f\xfe[\xdd'~\xcckx\x83\x93I\x8b&\x15W\xb5\xa5u$\xec\x1bE7+\a\x92)\x11\x11\xd7b\x8eG\x0e\x15\x18\x83HV1\x97鑔#V\xb2ј{tz\xb1\xaflw\xb2\xc3\x1c\x16\xc2[\x17\x96HY_\x12\xe1W\xfcu\xc8Tld\xcdx$GrT܍G\xe3\x04\xc6\r\xa49ɀ>\n\xab\xb9\xda\xf1\xf4p\xb5\x83c\xafTȴ-\x81^\xe4.KUiLM)?Xjʱbq@Dg\x18Y\x8a\x8c\xc23\x15\xea\x1e\xfe=\xc5\xffҾ\xbb\xc4Uh(\x88\x85\xff\\\x18\xab\xa5\x91M\xf3\xa8\xd0A\xbe\x15\xa9\xe6\t\xeb<.*\xc5v\x9c(\x13k\x18\x95\xa2<\u0382\xe2'\xb3%\xc7\xc6H\x14\x901\xf1T\x16\tE\x81АS:\x1d\x13\xf2sh\xee\xf6\b\x83N\xb5p$Ȧ\xc6,\xad>\xe0\xe1ȾV.\x9c\xfb\x98\rی)\xab\x83f%\xba\x8a\x80\xac@ۈP\x16\b.\x1bϸ\xef\"\xcfB\x0e8\xae\\f4\xdba\xa8\x11\x7f6\xc6E\xbb\x0f\x97\xaat)\xb30\xde$\xfb\xf6\x87\\\xb0\xec\x98\xea\x95һ\xda\xce\xc6\xf9\xb7Ho\xf8 \xaa\x18-\xb4\x1e脑]8\xd7H'\xf6\x02^\x917\x04ˈ\xa9rid%g\xcaƖZ\x1e9\xf0}\xac\f\xdf\xc3$\xd0\t\x12M\"\x8a\xb4D>\x83\xbe\x02\uef28\xf63`\xff\x85\x16N\xc8\xdb\xf0\x88\x14\x1e}-\x90\xb5\x92\xd8b9)Ђ\xd1RIYa\x90q\x9a\xacr\x11GkՕf\xdf\xf0:\xf0L\xf7\xb9\xb6\xbb\xae\x83Ԭ\xb0\xa5\xc6C\xfb\xefS*Բ}=Y*\x99\xd7\xe2\xfbD\xe5B\x9b\xb3\xa0*\r\xea\x19\xcbD\xc0\x15\xa7\\ɸ\r\a\xb3\x9f+\xbf\xa8b(ԉ\xfej\xb7=v\xdfZ1\x19n0\xe0\"ͥB\xc4ry\x9a尭\x8eY\xc5wJn\x9a\xcb\xc5\n4\x0f\xe1)\xff\xef\xe7\x83\xca\xea\xedye\x976Yr\xf56\x9e$1/\b\xaa\xceTsn\xf1I\xc9\x05K\x91\xc5L\xb4\xf5\xd1UpLo\x03њc]\xc1\x06u\xde\xd9Bl\x9e\xb2\xeb\ag'bW\xdbN\x02\x9e\xf3\xf8Q\a\xcf$>\x86\x14r\xb5\f\x0e=\x06\xeb͒\xf6\xf2-\f\xb0\x13 h\xf3\xf1v\x9f?\xf5\xe9V\x13\x1fVpJ\a\xea\xc1\x9eﰯ\x055\xad4\xf8@\x04\xc7]\xba\xc7Ǌ5J\x9a\xab\x84\x83w\xabD\xec\u0091\xa8WL\x9a\x84m\x81_\a\xd9\x00\x87\xad\xaa\x17ҽ\xe7\xc2\xc5\x18\xdc\x01\x02\x96\x89\x922\x01\xea\x050[\xac\xc8\x10\xe6K\xbb\x0f+t_1e\xb3\x84jt\x9bb\xb0\xbe\xd39\x01+c\xe7\v\x16\x18\xc8\x05UE0]\x11E\xbf9 \xab/%`\xb5g\xccݵR\x9ce+\x95\xa4G\xa1\xb2.\xd0\x1a[\x8a\xa9\x9b\xfc\x9c\xd9\xfb(œ\x1c\xdd\xfb \x83\xf5C\f\xc7D\xd2\xc0\x1eV\xd3\r\x12\xc9\x12\x9b\xd1\xef\x01\xb9\aqWr\xae\x96\xf12\xb0\xfbо\x10qÏCLoS\xd7\xf2>\xba\xbeͲ\xf7ҝ\xf5\r\f\xa1K\x167\xb1\x0ePCae\x88&\x17\xcd?\x1c\x03=\uaa3d\x17\xaf\x8f\xda\xf97\x8dڹ\xcd\x12\xe2\xc0:\xcaϊ?P92\xd7\x11\xeeL\x84\xbb\x85\xcbӆ\xea\xad\"\x1d\xedCw\xa6\x94\x03\x89\x17YAvҞ\xb3=R\x13C\xa0۔\x97l\x0fYe\xfdY\xb4\xa7U\xf1\x8d\xd5\x03\xdf0T\xc7퓏\x8d\xf1e\xdaD\xd5\xf5c\xd6]\x98k\x97u\x15UV^\xcc0\xb0\x9a\x98<\xf9\n\x1de\xb4\fZ\xcd:\xe7\xa5\xd6\r\x1f\xd3\xd6\xd0y\x88F\xc6\xeb(\xcc\t\xe1\b\x1e=\x92\xe74\x19AjM\xd4\x10\x80V \xeb\xdbh\x8a\xce\xd1\xf1p\x01\xfb\xb1Ùu\x06#~\r+s\x86E\x91\x8dcj'U\xa6,\xc6筷\x15\xe5\xb9\xcf{r\x8c?7\x7fk\x8c,$}F\x94\f\x98\xc6\xf8}4\xf1\x8a\xc9\xf0\xd9z\xdf\xef\n6\rbw\xf8\xef\x13\xa0\b\xb5\x91\x93);\xcf\xee\xe2\t\tR\xb2\xa3\xdd\xef\x94\xfa@\x8b\xed\xd7M\xbd#\xa6Q2\tc\xa1\xd1G\xc6p\xc2d\x05b\xe9/\xc6dZ)R\xc0\x0fG\xd4\r\xb1T,\xeaU?\v\xd1\x03\x88S\x9e3\xe0J\xb9\xf6\xd7\xf5*\t\xe1r\x04\x94Q\xf7\x9c\x82\x9a\xbd\xc7s\x8d\x88\xde4\xa1͐\xe3\x05.\x027bV\xb9\x90=\x0f)?\x13\x9e\xae20Փ\x1b\x16\xb1\xef^(\xe7\x02\xf3v:\xa2l\x1d\x9eƑ\xfd\xddp\x9cgE!8\x18'SwD\xec\xe3\xe6`~\x89\xd0\xe3g\x8a\x0f(\xd3,\r\x7f2A\x9f/\xb9\x9a\xefue\x8cI<%kx\x19\xbc;9*FM7!\r\x9b\xc0\x82\xa9{\x1a?\xcf\bK5x'2\xcb0\xb5\x1e\x83\xc4\xc7\\\xcf\x1d~-6 u\xa8#\xf42v/c\xf72v/co\xc2Vk\xf4\xe3\xb3\x12\xb4\xeb\xa3\xff\xc0\xd26\r`\xbd\xc8\xed_\xe5/N\xeenڎ^\xf8\xfe̅o\xde\xd8\xf6\x12x\xa5}/\x86\xf7b\xf8\x17'\x86g9\xa6N\x8b\xc6\xf9\x1a\x03\xca.\x16t\"\x0e\xc6\xe8\xadh\x92ƛZ2\x010\xfc\x12\xe8\x06\n?!\x0f\x14\xfe\xe2\xb4]d\x9d\xca\x03\x82\xf0T:\xadZ\xa7\xa2\xe2i\x99\x13`\xdfO\xe1\x1d\x01x\x99\xdf@h\xcd\xe6\x04\xe7-2܌B]b\f\xb0\x19\xdf\x06\x18]\xcd\xc0\xd27\x98\xe6Z\x7fO\"\x98\xf9d(\xbf\xcd\x1cK\xfc\x15\x1c\xa2A\x93\xc0\b\xbc^\x9a\xef\xa5\xf9^\x9a\x7f\x8c4?\n\xb8⥹\x84\x14\x04\x87a\x8b\xff\xc4@w9\xa9\xe6\xf12[HL\xa2\xd5ix\x83\xf1vp\x05\x90N+ϻ\xa4\xf9K\x99\xb5\xb6\x12\x81C\xf0<\x14\xecCh\x0ex\xac%v\x91\t\xe4\xb6ƾ\xb9\xde`\xba\xae\xaa\r\x8dL\xa1\x85\xc5\xde\x19\xf0i\x06\xc22\xdf\xd3\x03\x8dYS_`o3C\xa7\x95,\xa3\xbb\xb6\x01pLtٔ\x166\x1dj\xca`\xddb\x8e\xab\x91>(\x9eR\xe4.\x0f4\\y\xebC\x85+o\xb5\xccz\xfe\x1a\x83\xb8o\r\x9a7}\xc6wYa\xb5\xae\xeb\x9d\x01{\xb8\xd6\tV\n\xdc\xea\xa7\xcb˳\x80\xdaw\xe6\xe1\xd6\xf2\x9d/|\xc8p\x95\x06\x9eK]\x89\xe2\x0e\x83|a\x87\xbd[\xabZ\xe1\x8f8bO\x00ej\xbe\b˵z˱\x81\x02va`\r\xc4\xcf\x03B\xb1\xc8\x13\rWA\u0084pA\x0e@\xa2sI\x04:\xf8\xdfNp0\a\x7f\x13\x98\x8a\xe0\xaf \xd0\xfb\x00./2Ģ\x8c\xe6ʐ\x87\xbb\b\xaf_\xed\xfc\ufadd`\x06\x1a\x11]\xd2n\x02)/@\xe3\x1e\x9bxfs9\xddS\x8a\xd1^\xd4\xc9\x00\x9a\xbe\x8f^b\n\xc1\x00\x04\x96\x02\xffwQ\x0eD.\x1d\x04\x14|8\bn\xa3\x10\xa6\x98͙a<j\xf4\xeb\x0eV\x9b\xbb\xbb\xa5\x8b\xfb\xb8K+d\xad:\xb6\x1fI\U000946c6\xeb\xcc?\xe8\x1a\x8b\x02\x19\xb5j\r\xcd]\xf6\v\xedM\xb9)z>\xcc\x1c56\x8b@0^\xed\xc0.sma\xa5v\xa8\a\xfc7\x96\x18\xbeځ\x16\xbb\xc4\xfb\xf6Z\f\x8e\x98\xa9\x7f\x84\xccg\xada\xf2\x0f2V\x91\xc5+#\x85e9T9T\xa4\xe8\xe4\xd4C\xaa\x8a樗\xb8h\x0e\xfcl\xfa%\r\nt\x13\xfdK\xe1\xce\xc5f\xe24\xef&\x86S\x7f\x8f\xfeE\xefHJ̰\xa2\x19\x14\xbee\xbbځ\xe1\xc0\xaf\xc4\"\x9at\n\x91\r\xc3\xc0\xa7\xe4\xc0\x8b\x17Q2\xf53EO&\xa2\x10\xcb\xc6\xeb\x82s\x11\x18\x01\x12FU5n#֢\x18\xd8\xf2h\xda\xc8ou\xe9\xc5<\xec\xf0Q\xfdM\xa0U\xfel\xcbռ-\xb4\xe9]\x8d\xd69\xa4\x0e\t\xe4 \xb8g\xea\xc6\xf1\x88\x03\x11\xb5-\xe2\x97g\x0f˖\xcbqפ\x9a)\xbd̢\b?\xbbᛝhB[\xf2\xe9g\xca\x0eG\x96\x11)^\xc17R$\x04>r\xb6\x04i\x05\x13\x92]Q\xce/\xe7\x14p\x1f\x98\xc8K\x19\xd0\x05\x9a\xe9\x8a\x18t=L\xe3Na\xa5Q!\x92̒`\xb2\xe0J\x14\x98\xfc\x8c\xb7\x16\xd8\x0e&\xca,\x19\xcf\fT\x979.\xff\xa8\x1dӇE\xf8\xb1\xc1\x1c\x04K͏|\xb4R=\x14\xb8\xeb\xccJ\xb5\xb1\xe6\x86z\xc0\fHF\fC\xa2\xad\x13\xab\x12r~+\x19\x96\x821Ƕ\xc1\x15M:\xacL8\x87?]\xcc(*\xd7&\x00h\x8e\x80\xf7u\xef:\xe1JV\xfe\xb1\u0082\xfa\x12\xd1\xe4\xe6#A\x8fi\xc3Sa\xf4\x94\xf4\x87\x19\xda\xea\x84X\xb9\x85\xb4Nx\x888\xd1Ud\xa2\x83Th%\nU<\\j\x1da\xa6Ry\xcbgc\xdb\xf3Z1\xa7Jv\x97Ys6\x82\xd4\xc6_\x19\x8f\x0e\x9b֥\xe7\xf5iCx4h\xbd?\xcd2x\xa3\x92\x11d\x91\xc6\xe0\x19\xb4x\xc6|\x96\xf2\x87\xad]o\xea\xfa\xc9E\xc15b\xe0\x96h\xe3\xa3%\xc1&\xde\xe7\xab)7mg$\xb4\xf3\xb5H\xba\xf7Z\baZ\xa7YI\x8a7\x03:\xa0\x9aP\xb1.\b\x8b\xc7g\x8eЁ2\x14qm\xd1.p\x7f\xe1\"a\xe1%\xd0\x11\xa2q\x88\xc1\xddd8U\x9a\x85\x00\xdf\xe1\x85G\xc1\x14\xa3\xa7qܶF\xbf\xf4\x8f\xb37\x1b\xf6f\xc3\xdel\xf8H\xb3!\xbb6`7\x85\xb5k\xbb!2\xb2\x8d\ff~j\xd4\x1b\xfc*\x06\xbffզ\x9b\xbdo\xd5z\xb7e\x15+\x03\b\xc4\xea\xa3\\\xe9\x01\x96\v\x19SQ7\x96\xec\xb45\xc2\x1e\x99e\x9a\xc0}\xb3\xad\x8d\r\xcd\xd4\xc1+\ba\xb0Ѵ\xd5ݦiLl\xf6\xdd`\xd5߱\n\xb9\x13\xdd\xe4\xec\xf8m\xaf\x96\xb0\xd6ʪӰ@\xab\xe6\xb1\xc59\xf8&\xd0ATAժ\x8d\xa4b\xb53zA\xa1\x90=\xaa\nY\xa3Ђ\x81\v$\xa0X\xaeL\x86\xb2)\x179\xb2\xf0\xaaz'q\x88F\xd1B\b\xe3\x88K\xe8d\xa9Eo\x9e\x15\x15;\xda,\x9b\b{F}\x85\x828\nĹ\x06\xa5\x0f\x93\n'v\x99\x18\xa1\xb0\x8ci쟴\xa9]BYc@\xebޝ\x90l\x00\x0f\xee\xf7o\xe3\x892\x121F\x16<\x00Qg\xfc\x1e\xee]\x82\x90\x97)rE^\x0f\x81b\xd4\xd4\x1e\xab\xb0\x197+\xe5\xcd\xc9\xc7N\xdf^\x06?\x98\x0fF\x0f\"z\x14\xcb\x02\xa5T\xaaΜ\xa3k\u06dd\xf9\x04\x848vنJw\bc̗\a~8\xa5\xce`\r\x17\xe8-\xa2\xf0NX\xf3i\x84\xfe\xfe\xfd<\xba\xcbƒ\x85\x8b\vGi\xbb\xe32\xc5#J\x8889\x8e\xcfC\x90\xb89\x99\xa8\x1c\x916\xcc\x15\xfc&u\xc4h\x11\x19Jo\xc8 \x1a\x06\xad\xac\x9a \xed\xf7\u18fd\xe4\xd8K\x8eې\x1c\x1f)\x1aZ\x94pے\xa1\x87\x85\x845\xc6\xf1\x18?\xb6=\xf6'\x96\fmn\xfa\b\xc1\xb0\xba\xda\xe8su\x05\xac6\xbe\f\xa0>j\xff\xc9xD +X\a\x86)\xe9\x06\xd6\xdav\xe6\x8d\xed\xc6?\xf5Ŀ'\xfe=\xf1\xff\x10\xb9\x03}\fPW¿\rs@kS\xc0\xe7g\x06h\b\xcf>Ɵ}\x90@:\xe6{\xf4cTR\xab]\xf4NÇ'\x86J\xb8\x11\xdbAp\x11\xa7\n\xdf\x12K\x14\xa5\xf3E\xa9\xfa\v\xad\xb8\b\x05\xfb\x85\xba\xf7T!\xba\xa3\xc3\xfe\x03\a}\xbb.>1\xe2-\xb3\xc53Ԕ\"\xf65O\x9dP\xf4\x16^\x95\xde\xde\xf2q\xed-\r1\xf7\xefN\x8eV\x13j\xaa|[4\xc0\x8c\xae\xcf\f!\xe9\xc9sv\xd0\r\x8c\x01\xa3\xec\xad[\x06W\xf4\xd6\xd5\x0e\x05}!缒cx\xb5#\x00\xdb1ŉ\x93\x8c\x02\xfc\x9a\xdc\xc1\xe8.\xfa\x85nՔRP\x82{\x8cna\xb1m\x16)\x80$\xaa_\xcfG}\x1b\xd2d#\xaaacSW8\x14\xde\xe1\x91\x0f\xe5A]8\x14n\x13\xf3\xa1 \xc1\x98\xaaV༭ȟ{e\xfd\xd1\x11tS,j\x11P\x0e\xc4}\\D\xfeܡI\x94ƾ\xcf\x1e\xd1\xef\x14l\"\xce\xeb\xd5_\xe5~\x06\xe6s\xfcy\xdaj*x\x16\x9ay\xf0\xc0\x90~O\xf4W\xa6\\\x81\xa3Te\x9d-\xd2x\x1bR\xc4R6\x87\xe3\x8f\xec#5\x8c93\xed\xacQ!\x84\x10\xf5\x8b\x82\x9c\bb\xa5\x8c\xddZN\xfc\xa9!\xa1J[ێ\x1bR\xab\xdc\x06\xe2\xe6W\xa5QT,&\xd5\xdd!\xbb^\x90\x8d\xc9\xe2\xe8\xd8\xf4\\\xf3\x12i \x8cIU\x04\xc8?rL\x1c\x91X\x8e\x88!\xc8\xd8LG\x1d\xe2\xea\xd9.N\x06\x8eg\x04\xde|\x062\\\xa5{\xa9\x1f\x03\x12O1\x8f\x19W\x13\x04\xce\xe0\x15e\x88qA\xc4Ap\xfeÁ\xae\x8cM\xe6>J\xe0\xc8a]8\x1e3J\xb3\xc5\xcd-\xfd 1\x1b%\xc6V\x88\x04\xcdc\xc2\xf2\xe1\f\xed\xea\x1a\x11\xedԹօaι\x13\xe7\x142l\x97\x91іD>Y:\xa3\xfc9#\x9e\xf1\xf1\xda\\\x8f\xac+\xe6M\x17\xbfֲ\x16\x02#\x99\x85\xa1h\xba\xb2\xdd\\^\x9d\xfcӲ\xee\xab\r\xc9܇\xbe\x1b\x85-\xbc\xc6l?\x8do\x16\xb9\xce\x1d\\k\\\xb6\r\xcb?\x88;\xdd=9<^\x8a\xcd%\xa9\xe3.\"0\xbdP\xc7{Da.X\xbbd\x1b\x8f\x11.\xb1\b\xa7\\\x86\xb1(\x16R\xe1H\xc5@݆\n\x10X۔\a\x18ђ2iA\x88])n\xd3\x1c\xbc\x94[qWHbS78\x1a\x7f2/\u05c9m\xe7\xfb\x8d{\xc33ŉB\xc7\xd7qʫC\xcbL\xdf\x1c\xb9\aS\xdf\xd4\xeaէe3#\x18\xc0\u008co\t\x1e6\xb5\xa9\x1eGsg\x11\xc7~qA\xc9\xfa\xbb\\l\x02Z:\xd3ǟ\xf7\xe1ӕ\x98q\x8f\xb3\xc2{\x1b\xad\xa5\xab\xad̉9\x9eBB\x19\x85N\x050\x15\xc6SB\xaa\x15\x1eJJq˝\xcf\xeaz\x9d\x84؉\n/\xc5z\xc3\xd1D\xfc݈\x0e0\x81^\xdbk\xe2,\x95\xf65\xd8\xcf}\xebU\xd8g\xdcK\xeek\xa7g]\xf0}\xf1\xa8X?[f}\xdax\xbf\x8d\xa1w\xd7e'\xd8\xf1b\rK\xb6\xbd\xf5\xfa\xcc֪\xb2PkX\r'+\xf7\x19\x9c}\x06goS\xedm\xaa\xff.\x19\x9cL\xf5>c\x13n\xf3\x04>b.g3\x1eL}\xe8}V\xe7\a\xc8\xea\xac-u\x9f\xda٧vn-\xa0\x9fOW\x9f\xdf\xd9\xe7w\xf6\xf9\x9d}~g\x9f߹I~\xa7KC\xfb$\xcf>ɳO\xf2\xec\x93<?\xdf$O\xd1K\xfbL\xcf>ӳ7/\xf6\xe6\xc5\xcf*\xd3s%\xed\xea\r\x83\x9b\xe7|v\xb1\v\xf6ٟ\x9fL4\xe2*C\xedg\x15\x92\xd8h\xa8\xea,\xcd\xf4ɠ}2h\x9f\f\xda\v\x97\xbdp\xd9'\x83v\xe0\x15\x9fMF\xa8#=~\x12i\xa1\x1bʎ\x9fh\x82h\xa3t\xdeg\x89\xf6\\\xa1\xe7\n_\\\x96h\x1fg\xb4\xe5|\xd1N\xec\xa0\xcf\x1c\xfdr3G\xfb\xc4\xd1\x7f3S\xcdg\x9aB\xfaQ3H\xd7Q\xef>\x8d\xb4O#\xed\xd3H\xbf\xd84\xd25\xda|\x9fK\xda\xe7\x92\xf6\xb9\xa4}.\xe9\xbfW.\xa9?'\xa2O(\xed\x12\xa2\xea\v\x03\xcb\n\xb8\x1b\x98:y\xf7bt\x88\x15R\xc5X\xc8\x16\x99\xf3\x88j\x9a\xfa̭+\x1a\x1b\xc6#,\xdb\xc9I\xc9\xc8W\x88B\xb8\x947]\n\x7f\x82\xb7s+Z\xb3Jp\xc9\xd8 \xb1\xfa]-\xa0\xca\xdciB\xf8\xb9VkGc`\xe5\xed\xef\x9f\xce\xd4v\xb5\xd31Oa\xdd\xd0`d\xc6܃\x83\x9bd\xe3b\x1fդ\xe1\rhhѾ\xae5\x9b\x17_\x91\x95\xbd\x8d\xdcb\x9f\x9e\x9f\xf0\xf4\xe1\xc6'g\xd9\xe4@\x9e\xf9\xf44%!\x18\x03sp\xab߅\x9b1\tB\xfd\xb6\xa7bvo\xeb\xeem\xdd\x1f\xdf\xd6]\xb3\xfbrҟ,\x86j\xb9\xed\x01\xea\x11\f\x9e\xd8\xd8~\x1d݆w1\xec\xb2\b\xfb\xea:ny>\xf8\xf1!,ݐ\x15Ė\xbe\xcf\x16Tg\x9d\xa1^\xc5\xee\xf8mP.\xfd\xd9҈6\xb6\xbc\xab\u038d\xc1\xbd\x89Ԯ\ail1\xd0\xd7 \xd7\xd4\x17LIL\x8d\x94Z\x8e~Qe\xdb,\xc0\xf4.\xcb-\x93q-\x17>b\x9b\x1e!j\xb6a\xfc\xff\xea\xb9\xcd\x13s\x1b\xdam\xcdk\x1e\xc5\x14\xf0\xd63KxB\x02E\xa4e+D\xca\xef\x11\x94\f\x9fq[\xc9҉\xac\xc0_/\xc3\xfc&BE\x06\x1e\xce\u0087\xf3\x88\xb46\x0f\xf9\xb2\x1fֆ\x01\x9a?\x1c\xc2$\x86\x13f\x92\xed\x17\xca1\x00\xc3\x10듘\xdbФ&Nb3\xbc\xef\xf1\xa9\x9c\xc6b\x06\n)\xdbc\xd2\xe0M\x9c\xaa/[w\x18\xa9\xd5\rq\x00\xe6g\xfc\xd37_\xd3A2ox\b\x06(\xba\x8f\x1d\xeb@\xa5\xfc\x06/Z\x0e\xa9\xb2صQ\xe5Z\x95\xc4\xd8Sll\xf4\xc2\xefW\x107J\xe7K\xa20O\x9d\b]MB\xe0\x06\x16\x8b\x19{\x85\x91\xa0S{\x9c\x13\xb6\x86!\xe0a\xa8N\x1f\xa6\xbb [4ҋ\v\xfc\x8e\x9dt\xb6\x81\x80\xb0J͆\xb5)iY\x0e\xcf\u07bd+\xe3D\xf4\xf9\xb3\b>\x05\x13\xbe\xf1\xa8\x7f\xdc>\b\xe1V\xc1\xf3\x00^\f\x16\xe6\xcd`W\x93J\xf6]\x85hҐ\xce8QO%Q\u009b{AFA\n\xb0*\x84\xa4\x00\xf3\xff\x1em\xa2x\x10M\xfa\x1c/\x16\xef\xb857\x85Ӥr*1\b\xb7\xd5y\xd8H\xaf\xbcX#ى\xcba5\x11\xa8\xd0\x00yW\xdf\x16tPљh&\x04\xf2J\xfb\xedR\xe3k\xda/\xbd\x01\xb8\xf8\x83\xa0\xcb\xee\r\x82ht3\n~\xff\xdc\xce\aE\xf3\xa5|\n\xe7\x0e\x8c\x91Os\x9a\xddC\xcb\xff\x9b]1\x85\xdbQ\xcb[\\]\xaeƹ\x9a\x1b\x95K[}\xbbfa\nC3\x96Z\x97@\xb7\x18Du\x87j\x83\xa8_\xeb\xed\x0f\"\t\x8b\x92H\xc3e\xec\xb3\xd0\xe0\xe3\x00\x83\xcf\xe9\xea4\x1chE\xe7\xea\x14\xf8{\x1d\x1c\xef\xd2]\xe5z˳\x04\x8e\xf8=4/\xc5\f^!a\xe4\xe0\tӛV!\xb2+\x84\x04\x9a\x1dL7\xbb&\x7f\xcf\xe4\xc7(\x8d،S\x9f3Y\xd5AF\xc6\xfd\xbf\xd1\xed\x02\xf5\xea&k\xfdݷ\xebh\x05\xed\x81G`\"\xaame\x0e\x87\x81\xa2Y\xcaw\xe7FD\xf4f\xa7\xde\xec\xf4\x19\x9b\x9d\xbe\x7f\"\xb3\xd3\xe8\xc9\xedN\xf6\xfd\x11\x1bT\xael\xdbD%>=\v\x14\x11\x97\xb6\xf6&#\x95|\xb0\x19\x05\xe7Q8\x19\xa2os\xe3\xc9m\xd1t\xc5\x14\xba\x9b\x0ehV\xd8O\xd9)\xea\xd3\x04\xba\x11\x934\x11p$\x01\x16>\xb9ݥ\xf0y\a\tB\x05\xad\x14Zg\x12\xe6-T\xfc\xf1R\xaf\xbd\xf0\r\xb3\xe6\xa3T\xc1\xc3\xf0\x89\xbf\xdeɻ\xb1\fM\xe2m\xf3\xa2\x84\xe3\x12\xf1 ͚h\xcen\x16\xc7>\xe6\x9d\xd6\x06.R\x84!\xa9\xbe\xc0\x88$\xbc\x8e\x92\x00\xa8u\xbeda\xd9(\xa9\xc23g\b\xa7!\xee0\x91\xf4ȧo\\\xfe\x05\xba\xcc\x04F\x8e\xfbSߣH#\x05\x18\xc2<\x89\aG\xa1$wY\x8c\xf3\x03\x89\vo\x19\xa3d-\x85\x95\x88[X^RJ\x90\xea\n?(\x1d\xc9wi\x06\xc3y\x98\x87\xb3\xa0X\x820\xf0`Q\x021F\xbbc+Z\xba\xf2\xe8\xad\xe2+\xfa\xcfP\xbf\xdc͟\xf75\xfb\x8f{\x97p\xef\x12\xde\xd4%,G\xe8X\xa2d\x80\xf1\xe7\xf1\xf8\xa2\x01\xca\xcc\xd7\xca\neB\xf5F\x99\x820\x16\x03#m\xb1!\x87\xdd\x16E6\x8e\t\xeaIBΖ\xc1_\r\x04\x8f\bG\xbbH\xaa\xa3\x87\x10C-༥7\f\xf8\t\xd7pA\xd7t\x9cd\v\xa0\x1dQQ\x847x\x83\x91\x9a\xc5\x18\x97\b\xaf\xfd\xed\xec\x82\xc5\xc8$\v'\xd7a\x82\xe4-\x0f\xf2E\x9aRhܢ,05\x1az\x13亽\x91\x93\\p%F\x9a\xab\x1d\x82a\xb3Ҭ\x8b\xa8\xac\x1ej\x9eة\xf7L[\xcf\xea\n\x97~\xe6\xc3\xe0\x92\xe5\x82yrx\xb5\x13\x88\xe4Ț\xb8\xfc\x8d\xc4\xd7}\xce\xd6\x04\x0e\x94\xd177T\x96\xa21\xab\xbc\x05\xc6\xfb\x15&)\x9e\x01ù\xa3Gڬ\x91\xc0\xe9\xb1j\xd3\xd9\x01\x9b;~FU\xa2\xc9\\f\xb7Q\xcb%\x864X\xee!ZJX\x15\x81\xe5\xbbI2\xd8r\xb5\x82\xbbd\x85\xf9\xe7\"\x84ٖK\xd8\xe87\vd\x84\x189\xf6\x80\xbb\x8f\x99\xeat\nټI_\xd8d\x9a#M\xf4\xfe&\xdf23\\95ߜ\xf4\\\xac\xa3\xd0q\"\xf6\x8amk>ݩG\x83@\xe4kUI\xbcU\x82\x91^\x8a\xb0\xb2\xb1\xad)Ɋ\v\xabmb\xbcCM\xd6\xc2\xd5'\xd4\xd3Hmgm\x122v\xb1\xf0M\xc4z\xa8L*\x13\x92\x8d\xb6w\xfa\x9c\xc95\x8d{\xf5\x80\x9b\x0f\xe0\xd6F\xb9\x19\x91\xd4\x1c\x85\b\x1a\x99\x83,\xd3v\xac(\x96\xc0R|qĳ\xc5Ul\x1d\xe0\xd5d\xe0Tg\u0089\xffb\xc3[\xa3\x85~\x80\xb8\x88\x18q\v\xbf\xa0\xb88&\n\xc5\x06\xdb\u0096\xf1Y\xc4W\x997\x06\x16\x15\xd9\xfd\xcc2%Ք\xa1\xe0:\xc4]\x90\xf8v^\xda\xc2x;zS`o\n\xfc\fL\x81\xeaI\r\x86\xfeK\x89H+$\xb5\xd7B\xf2\x1c\x1b\nb\xd9\v\x17\xda`\xf8\xe9ƭ\xad\xa6\xa8댉\x85\xce2\xb7Y\xec\xd6C\xd9\xd6\f\xf2Cǳ}ݘD\xdevȇY\xcaY̭Y\x96~\xa3b^\xc4\x1d\x10\xa9\xa1\x89\xd9Q}\x03\xd0\x12)\xfc\x9fhMUn\xa4ۭw\xb9&+\xa2\xd3\xf02\x0fӂ\x06\xd0\xecXt\xdb\xe8\f\a\xc7\xe58\xd6\xf3(u\xebHR\xc8QA\x95\x13\x85&\xae\x94r(\xb7\xe2&d]\xda+\x85\xd1\x03&\xf7\xb7\v\xe0\xe6\xc3<\x022\x82\xe9\x84\xd1\xc3\x1c\xd4k\xc9Jd\xa3\x11\x92\xfe\t\xf4\x1d'\x85u\xb2\xcdL\xba\xe4\xf4\xe5:\xa7\x8fe\a\xfaKQ\x10\xbdL\xcf\nY@\xfd\r?8{\xab\v\xea\xda\xfc\xcdV\xecbF\xeb xŹ\xa7\xef\xd2\xf7iv\x9f\xee\xf9\xbe\xc3?\xd4\xd4=4%\xb8\xe7R\xd1\x02\xfd\x91\x8d,6\x9d\x02CW\b{\x06\x10\xb9\x8f\x1a\xfd\xa4\xa3F\xe9\xe7jnԇ\v!]\xa3_\xf4q\xa4\x1fZf\xfc\xfc\xe3J\x1f/,\xf8\xfd\x8d+\x1aWH\xb1r\x1aN\x17\\N\x05>Z.\x15\x13h\xe8\xe6\tcR\xad\x87\x1a#ce\x98\xaav\x9d\x01\x81c\x15\xbc\x12|\x84\x11\xa1\xacL/\xe6\x94xl\x02V\x13\xaa\xe1\x84\x1e\xb9Y\xf7hUV\xc0\x9b\xac'\x95\xacvG\x13`o\xac\f6C\xe3\nEH\x85\xc9x\x81\xe4\xd9\xd9\x16\xd7j\xb0K\xaa\x7f\xf8\x10\xcf\x16\xb3ʣp\x8c^-\x8a\xc8S#\xb0\x03\x1c\xf7F\x01\xb9\xfd\xfc\xfdr\xea1\x7f~\xa2\xaa[,\x15Y\"\v\x88.\xb3S3\xd7rbuՆ&Q`\xf5\xd0:2\xf5\x05\xc1q~\x93\r\xf4P\xc9\xc0D\x88D\x93\b\xcb\v\x14\xda\xed\x894]\x0f2N\xf9\xe1\x849\x16\xfa=\xe8f\x86\x84\xad\xcf+\x87\xe6Իx\xb20\x16S\xbb\xb2\f-\xfdL\xd15W\x0fA\xe7M\x14⦠\xc4b\xac\x964.\x81<\xda63\x11K0iR\xebb\xa0\xad\x87\x9a\t\xae\f\x8b\xeep7& \xd3\xd1퐀Yz\xe7\x05\xae\xfe\x96B\xa5\xdd\xe7\xacih\xa9DΓa}\xe2B\xe3m\xb6\f\x90*\xda\u05faA\xda\x12\xa6\x9dU\xe3,As!\x9d\x12\xb8\x05\x11\xe6Գ\xd20\x16\xcf\x01G\x19\xd6\xcdr\x9b\xea\x9e+C\xa57\x97d\x9b\xdc\b+\x9b7\x88ڭ\xe2\x8cG\x9d\x03\x8d\xb5\xb1\xfd\x8d\xd0\xc3\xc1\x8e\x16\xea}a\xc8\xe6\x99/\xdfV\x9eiF\xcf \x1e\xd6\x03589\xecN\\\xa6q\xbc\xa2\xa4\xc3Gj\xd0\x00d'ؖ\xe5mVDv\xff\x88\xbd0\x8b\xca\x0f$2\x1aE\xff_\xff\xaa-d\x93\xdfB\x9e;\n4j\xa5Fٷ\xed\xc3&\"\xb7)\x86u\x9bd\xcc\xd8Z\xda\xc6\\\xdb\x04\xedQa\xd8t\xd7i1\n\xe4R\xb5 \xe4-\x87iW\t\U000638b7\xcd\xd8-.\xbc\xe9\fZ\xc4x\xeb\xc7~+L\xe7\xc0\xef\xc1\xe7\x18\xf8]o\xa3V\xe3#\x84\x84ץ\x81\xdax\xcd3\xed\xf4\xa9ěh\a\x11\xe8\x99\xd0kb<\x98\x84\x18\xf3+\x8e\xf0W\"\x88\x84$I\x84\x90b\xd0(z,\x8e\x80{:\x11\x1fh\x1bD\xa0\xf9=\xafE\xf0\xef\x1e\xd0H\xb2)ԇ\xae\x01h(ƇYqū\xad` \xdb{\xb7QX!\x00\x94\xc2\xf1\x80\xeaE\xb0\xe5;PUi\x8e\xa8\"#\x88\t\xcc\x1f\xa5\x8f\xe6\xc0\x98\x0f\x1c\x85\xb3\xa1\xd8\xe1\rY\xa2\xe3Op\x98\x9e#/\xc6\x04\xb3\v\xb2\xfc\"30\x94\x99\x1c\x9e\xf7+C\x93\x06\xc1-\xb0\xd9!F\xa1\x14\x11rP:xip\x92\xde\xe4\xa8V\xf1+\x1bOM\xbbZ\x9c\x89!\xb1\xa8O\x8bH\xc8\xeaI\x91XOyEn\xc6\x1d_\x1c\x11?\xdd\xe9\x91MU\x80w\x86p\xd2\x11\xb30\x9aX\x13V*\x15\xdb\xc7L\x89>\x15\xe3Pf7,l\\GST8\x18\xef\x17\x8f_\x98\x1bi\xd6V\xa56], \xd3Eu\xa9\x9a\xabqZun͒\xe9\x1f\x15\xc9P\xf8Q,\"\x99\xa4\xba85F$$%\xa4{\x14{\x01Y\x85\xb1/\xeb\xa6v\xde\x00\xca\v\xc3L3T\xd2\"P\xd30\xde\xe7baI\xf9(\xa0]/℃_\xed\xaf\xb1\xa8GՙUq5E\x13\xa4\\1\xf9\xce3)\xbe\x8b\xb32\xf5\x7f\xa1\xa7\x14\xa9E\xa2ê\x8c\x00\xa5\xd4\xe0+:hW;\xc1\x86\x19\x95_\xbbPG\xd5\r[a<WAR\xaeZ\xaa˄\x9a\x1a%ב\x02\t\xbe\xda\xe1\x1b\x84\x00\xa1W;g<pX\xd5+\r4\x85OhWf\xe1|Ns\xa4\xab\xe3\xf2\x03٭ZHt\a+\xfd\xea\x88('\x12\xca\xd5\\P(\x19\xba\x92m\x85\xb1\xf5|\xe9\xb3\xe0K\xa2\x15|y|IO\xac\xe7K\xeb\xf8\x92^\xaa\x9e/}\x1e|Ioأ\xf8\x92:|ݹR\x05e\xfe)8\x93GƬͳަM\xb4\xbfC\xb6\xb6F\xaf\x1cVG'sg\xe0F\xf5\xdaa\xd7\x1f>(_\xc6Ԅ\xfc`,%\xc4\xe4'\xfe\x88孛_\x9f4\x18\xfd\xc3\x05\x98{\x18\xcf\xea\xc3\xda2\xb8\xfc#\x9f\xd8Vq\xe8O\x13\xc8=\xfaȑܟ\xfd\xcdjqjk\x9aim\n\xd5\x16\x1d\xc9\xeb\x13\tPۗ\x9f\x1a31<\xc9@OJ\xba\x9b\xcf\xd7#\x13\x92\x9c+\xa7\xb0\\\xdcaXN\xea<J\xa2;\xb8Bl\x00~\x9a\x1c\x8bn\a\xb4\x81\xa8V[lBR\x9f收\xc8\xefY}\xac\xb6\x9e\xe6\xf3\xc9\xec\xfc\xe0\x89\xafL\x8b\x93\xe5\xb5\xf34\xc2~\xb7&\x81U\x85ȣߐ;i\x9df4ؒ\x16\xb45R\x89!\x1c\x9f\xa8F\x15\x04o\xd3MRd\xfd\t\xdf\xfeT\xe8\xb4\xe14\x9a\f\xa1V2\x82\\S\v\xe4k\r%\xb7Zv\xa0\xe7\xd5S\xd8t\xbd\xd7\x02\x83Y5O\xa2\x89\xfbI\xfd\r\x15آ\x92\x04[8A\x9f\x9c\xa1\xb5_\x00\x9at\x1e\xdeKw\xbb\b;\x81FMd\x15+\xd7co\x10\x14pU\x930W\x17E\x9f\xcbz`\xd3\a\xd4D\xbc\x86\x82u\x84\xad%\xeb\xfc\xe4\xa8\xdb'l\xe4\xa9Q\x9a\x8d\xb8\xfeJJ\xd1ش\x8b\x04\xf0\xf1h\x851A\x91\x03\x1c\x88\xb4*\xc2\x14O\x83_\xeb\xf7\xffW*\x8d\x84\x9eouR\xb4\x11\n\xf7A\xdd:;\x96\xb3\x1b\x04\xe1\x93JZ\x9f\x1cIҫ\x1f&\xf7\xe1\xb2\x10&\x89\xdb|\x13\xe6\x13\n\xbe\xd5\xc9&m\xd6y;\x82ᓰ\xdf\nټF[%\x06\xa4\xfc%\xbb\xae\x7f\v~\xac\xc1!\xd5kM\x88\xb7\xe3\xffd\xd7}jo\x9f\xda\xfb\xf9\xa1\xfc=+>\xffdދ*P\xb7\x1d\xeag\xe3\xfe\x85tO\x9f6{\xb7\x85\tϦ;\xeb\xb2s\x0f=\x81\xc1\x9f\xde$6\xcbޥ.L:\x0eR\xe15P~\xf6GW\xe4\xdf\xdaO\xadp\x05;\xc8:2K\xd9=\x91\xf6,Ϯ\x1b\x027_7dʢ\xc8Be\x11\xb7\x15<\xd9&\xa1\xf7\xf5\xea\xb4\xdd\x15\x19\xbb\xa3\xa7M\xd9\xfd\t\xb3t\x03\x9d\xa5\xab3x\xa5\xe8h-C\xb7M\xfalS\x8e\xee\xeeu\x1eGӽ\xa7\xcaν\xf0\xe7\xe3\x0e\x943\u0557\x96;j\x9f\x96{)^[8\xa8v燪f!\xcc\xe6\x15,T\x9bʠ\xf6\xe5\xf1g\xdf\xca\x03殒\x9f!$\x15\xbeߧ\xd5~zi\xb5\x7f\x91m\xd94K\xc0\x11\x81\xfb\xd4\xd8\x0f[b\xe5S\x94\xb76ΰ\xad3\xf36\xf5Yj\xa2\x90\x8f&U\xf2a\xc9\xda~K:W\x10=D\xe3\x85ԭ\xc5b\x1dY\xf6\x1e\x16\xf9}\xcd\xf2\x02\xf7a\x8e\xb7\xdfC\xad\xc6X\x01\xf8\bXQ\x02KrAN\x9b\xa2Q\xc6T\xe9\xb8J\a\xc4\x1a\xd4\xfc\nj\xef!v\xa5\x88\r0\x8c\xbc\xbcd\xe6\x1b\x96z\xc4R\xb1\x96?\xab,\xeeԞ\xa0\xc0\x80\r\xd1G\xa0\x0f\xaa}\x8d\x92J\\~/\x17\x8er\x1c\xd1@\x92\x15T\xb880\u058c\xb5\x89\r\x03آ\xf1\xfbl:}\x8d\xb6\xb7u3\xb43dxH2\xd4Y\x98\xbf\xe7\xeb\x0eW\x16'4e\xf6\x13\x1cY)\x89ߵ\xb4\xb70\x17\xf3'\x9aUV\xbc\x96\xb9S,\xa8@\xfdt\x81\xa9\x82xʋ[\x81\xa8\xd3km\xcc\xfe\xf9\x82+\x1bS\x06jYJ m\x1a'vq\v\xae\xdbJ\xbd*\xe4/4\xf3a\x19V`ߵǺ\xaa\x06\x15\xa5\xe6XR\x04\xe0\x05\xb6\t\xf7|\x86\x1f S#\xf7#\x1bƞfg\x14/\xec1\xd8\x1d\xc0\x02\x93\x9d\x94}0/t\n\xaf5\b~\xa7y\x90\xb2\x12^R\xe3\x81k\x1dc\"ɼ,\xf6\xef\xb3\xfc=Ɵ\x16\xfb\x92\x19\x94\xc0-\xdeG!`\bK9,\xb3\xa1ٻ\xfd\x96\xa9\xd8a\xba\b\x93\x15xv\xces\x95\x91T8\xb9=\x94\x88\xc48ƅT\xbe\x9d\x18@\xe3Q\xf0:\xc2\x05\xff\xd5\xed\xeaW\xe0\xe8h-\\\xa4d\xccB``4\x11+4\x9d{\\B\xf5\xe3$C\x1bz\xf0\v\x96j\x9e\xa2̆\x02\x16\xbd>\xb0/\xe9<\x1e\xbfW\xc3\x00\x12\r,\x89o\xbd\\\t:\x10s\xacu\xae\" \xa5\xadɍ\r\x14E\x82\xb3@_+IN\xa4L\xfa\x82\x01O\xd8ֆ\x85}\xd9`\x8a\x1f\xc5##\x1f\xb4V\x81mp:\x13\\-\bt\x8d\xb2\xe1\"\xa7\xd1јC\xe7m.*L%\xd29U\n\xa3\x84\xc8\x13\x88;\xcd\x16\xefRc\x0e\xb0\xfd\x0fah\xa1㟲{\xe0C\xf9 \xf8/X9즈j\v\xff\x9f8\xa7_\x91Frw\xb8\xd0\xf7\x11.=\xc8\xe2:>\x9b\xe2\x03\xe1\x96\xfe\x8aq\xdeiA\xacJ\n\x0e\xff\x1a\x80\xbc\xf8\xb4g\xf7+\xb3vC8\x04\xf9\x10\xc4s4\xeek\xa4ko\x81m떮#\\\n\a\xa0\xa1\x1eT\x85T!\x9d\xa22;K\x01gD\xed\x8d\x03]j\x10\xe9\x1c\xb8,q\xe1Ȉ\xd0:\xbc\x14\xedV9y\x15xB\xca;,oS)\xf2\xdd\xdd\x11N~dQ\xe1`\x18\x8c\x04\x84̐\u05fd\xe0O\x01\xb7\xb4\xa6\xbd7\b\xe2\x11\x8cL\xd7J\xc7U\x87\xafM\t\xda|\x92\x11\xf1\xd2߆E\xb0)ۧ@\x8e\x9a!\xe1\x0f\x82.\xa0\xf0\x1a\xf9`\x14\x9c\x92{(Y:\xa4\x02HG!\xf2-E\xc4\xe2M\x86\x83\xd6y\tp\x1cwqtOk\x81\xa7\x15/\xcfP\xc0s\x04\xa4}߃ҾM@d%DՖ\xec\xc8I\t!\xf2F\x97]\x0e\xa1\xbe\xefxXDd\xa3\x10\xc6\x0f\xc1\x9a֩@@\xd5\"\x9c\xeeY6\xb9\x94\t\n\xd0\xc3\nQ\xb5\xc1\x18\xa0\x1f\xad*\xa4 \xa6\xa7\xbfx]\x06$\x16z\xcc\x00\x8eHƭ@\xecQ\xb7\xbf\x83\xcf\xdd,\x8e\xdfftn\r\x1c\x85W}\xc1\x91J\x91\x15KL\x0f\x13\xf2\x1cI\xd2\xcd\xcd\x02n7|\x95E\x15IL\x05\x9atKl\xb0\x18\x8a\xf0H\x05\xee\x95˫\x88\x90$\xe0r̅\xc1\x17\xaaK\xdb\xc5\b\xbd\x9c\xbf:\xfc\xe6\x9bo\xfeH%\x0f\x14\xd6\x04\xfc\xfc\xee\xf2p;v\xaaUx\a\x97\xc6n`|\xc1\x9cd,01\xb6Q\xe2Y\xc5\xca\xf8\xa1(ݣ\x14\x7f\vࠢ\xf3ͱ\xd5p\x16\xe57\xd1\xf0}\xb4\x94\x8c\x82\x1do+P\x84a\xc27K\x8a܂\x17\xb03\xd6\r\xd6\x1dg\xa2\xae\f\x13\x92c\x14\x00\x8a\xf1\xb7\b.S\xb3l\xad$\xe8J\xdf\xda\xe0L\x83b\x94f\xf7\xf0\xad\x1b\x93 /\x167ك/\xe7\xb0\x13c\x8f&\x8fؖ\v\xd5\xc3&\x95i\xd4\xc1ӑ˩\xd7\xfb*\x0f\xda{`a\x99\xd3\xde\rۻa{7\xecg\xe0\x86U\x97u\x80Xa\xc9bb\xe0ԁ\xca,\x9e\xbc\x8aY\a\xf7\xa6C\xa66q\xd6j\xba\xf4\x89Ni+\xae[E\xc6]k\xaf\x1f5\xd1;\f\xbf?\xcaz\xe8\xf3I\xa9\xa5\xed\x1dS\x9f\x9ecJ\xb6n+\xce)WT\xe8\xbdT\xbd\x97j[^*\x9b\xfalL\xbb\xfc~+\xebaG\xdf\x15גG\x15%V\x91\x99\n\x11\x11T\xc0:\xaa+\xb3L\xf8\x19:\xbdl\xf4s\xa1\x86I\xeci\xbc<\xa3\xca\xea\xab\f\x97\x92\xd0R\xa2\xdd&\xd0o\x96fȅ\xb1a\x04?\x87I\xac\xe8\x11\x1a\xd1_\x06\xc3\xe0j\xe7\x00\x1d\"W;\xc1\xae@U\xee\xbdT>\x12E\x1a\xf0\vh\xef4\xfd'\xcb\xef\xe9\xddWY~\x1dO\xaev^\xa2\x12\x04\xff*\xec1\xc0+\xc5 (\xdeǌ\x87\x92F\x0f\xf4\x1b\x06\v\xc3\xe5Œ\rd\x15\x05m\xacH\x9f\x95\xc63\xb4\x8cJ\xee\x1dA\xe1B\xcca\x7f\x89Țc4\xa2\xeb\xefk\x8b\x8a2\xe3\xe7ܸ\xe0\xbd@8\x11\xf8\xe2=\x06w\xf8.\x17\xeb\xbb8\xb9\x9f\xe0He\xf9\xb2\xc1\xd7\xe6*Z\xfc\x96\x19\xa92\xbe\xe7\x18\x03\x93\x9a:\x93!\xe3q2b\xdb\x04>\x00_]\xc0+\x1a\xf4\x15\xd1\xee\xe31\x8c\xf4\xb7(\xcfh\xf8HxL\xf1\x19\xc7G\xf7\xa2\xa5^m\x9f\xaa5\xb6n\\\xb4v\x86?9\x03\xa3\xceb\xcb_\xcch\xb44\xa6n\x80w\x99\xd5Sԡ\xf1\xabR7s@\x0e\vEϢtt\x1fÉ\x8a&q8\xca\xf2\x9b}\xfck\x1f\x9b7\xc5\x02\xe58\x91\xb5^\xe3\xb7s\x06k\x06\n\xc0-m\x871Z\x82UOz\xf9\xe0\x14\xc7\bt\\\x14\xb0\xa6j\xec\x136[Pq#*G\x84\x91L\xa3 x\x83\xcd\xe4\xbcXWS\xaf>\x9a\xa59H_\x8e\x18\x1c\xdbb\xd4\xceYl\xec\xff]\x8f\xb3y\xf3C\x1f\xe9oښ\x8a\x16\x05:\xe7|\x13A\x0e\x9f\x84\xb0\x1fQ\x92h\x93\x83\u0600(Ǐ_EN_ \x93w\x88\xe2\x00\xf7n\x92E\x02D4\x9f\x03A\xc1\x98\xba$W\x9e\x99\x1c\xf7ü\x00{h\x8f\x9f<\x8e#\x8fǩ\x8d\r\xa5\xc9&\xed<na\x97^aHi0N\x1fh\xa9O6\x93&㥩\x8f\x92\a\x95\xa5\xbe\x9e\xe2\xae\xf04\xf9\xba\xf8\x8d\x80'\x16\xa2\x13ѣ\xfb\xd0\a\xab\xa9L\x82Nx\x81\xbe\x88\xdb0\xc1\xad\xdc\xcc*}\xf3\xb9\x18\xec\x06U\x9cx\x04\xe0'\xbe\xac\x94\x0f\nA\xa0\xf9*bL\x12l\xa8\x1d\xd0;\x9e4\xf6\xceF\v+֠p?DB\xb3qv\x7f鶍U\x01\x17\x1f9\xc8\xdc\x7f\xee\xbe\x0e\x93\xf9mo\x89\xed-\xb1\xbd%\xb6\xb7\xc4~\u0096\xd8\n\x9d\xfa\"L\xb1\xd59=\x9d-V\xbe\xd4dШ\f\xa4\xb7\xc6\xf6\xd6ؖg\xb67\xc7\xf6\xe6ا7\xc7v#_\xbdA\xb67\xc8~\x1e\x06\xd9\xcf\xca\x02\xabnYo\x82\xedM\xb0\xdd\x0f\xf1\x17bs\xf5\x8b\xec\xbd\xd1\xf5\xf34\xba6\x91\xb4\xde\xea\xda[]\x9f\xc6\xea\x8aYg<%`\x03ڃc~\xbc\x88o\xf0ڞ\xb3Ʋ*ӂ\xeb\xc1\xcbk\x94\xff\x87\xd7]T\x9d\xde\xf4ڛ^?E\x15\xf7i\xe9\x05\nA\xf6\xa5\x90E\xc1J\x93Q2\xe5\xb4L\x10\xde\xc2\t\xa7;\xc0a\xb3ʼ\xb4\x91\x84\xbb\xdd\xdeu6ʣ(\x8f\xef(\xe8\xbfZ\xe3\xd6\x02s{\x82amfft>\xc2'\xc5\xd2\xdc\x1b)X\x9b\xb0\xaan\xe3w\x80\x7f\xd6\x17\x16BI\xe3\x1d]\x03\xbf\x88\x82B\b\xec\xd0l\xae\xa1aH6ᛣ\xd3zu\x8a\xd0\xd3\x02\xe3\xdc\xfa\x81qH\xd1u!q\xac#\u0082d\x17L\x1c\x82ĩ\"\xe2\xac\xed͏P\xa3^\x03\xd1;\xcf\xee\x10R\x13\xdf\x1fX\xa2\xe8\x01=A\xdd'\a\xd1;\x8dۀ\xd5t;\x12\xca`ݛ\x9d?\x9c\xd9yc\x85\xa2\x9b\x04\xd4ۗ?\x01滱\x81w3\xa6\xd1:\x1ew\x03~\xec\xb7?\xd8,\x18\xff\x84=(\x89\x02\x87S\xb2\x8cX\xe41֪ֈ\x01\xc7\xe9\x8c)\"\bG\xf2\x1d\x92lI\xf7.\xa8x\xbb\xa4\bf\xa2\r2<\x18\xe1,H#\x82\x9e\x10i\xe0\xda)W\x87\x1d\x8e\xf5\x89\x9ae\x13\xc6S\x86F\b\x13Q\xf3\xb5)\xf1\xdfW^/\xf7\xe8\x96\xc7\xf8\xf3j\x01\x84 '\ǹ\xbc\x88\x18\x9cb\x84Z=өi(h\xbe\u009c,\xc2.e\xe4\x06;F\xdc:s\x86\xd4D@<\x8c\x81\x8e\x93G\x82\xfa\x11\x7f\x7f\x9a\xe1\xaf\x1d\xd6@/vmX?\xc0=\xff\xee\xdba\x94\x8e\xb3\tl\xd6\xd9_\x0f/\xbez\xf1<8\xbc8\x0fDov;\xb3\xece\xd7˒$\x84E\xec\xa1x\xefN\x8e\x9ef\xb2\x86^,\xe8\xf0\xd6?M\xae\x82h\"g۔6\x0eU\xf9wz\x91\fwpԘo\xbe\x8f\x96\xda\x10zG>\x89i\xc6C3\x94\xaf\xcc2\x18I\x1c\x95S2\xfaޖ\xb3d?\x9f\x8e\x7f\xf7\xf5\x1f\x9e\x7fU\xb0sy\xf8\xed\xe8\xebы\xd17Wi\x80\xff\xaf\xfb\x9b/\xbe\ued2d\xb8l~\xf8ᓧ\\\xfbG\t@\xc6\x0eYq2\x99\x17<әj\xa2FI\xd0\"\xa6\r\xaa&X\xdaC\xf2\xbaГ\xb8(\x16p\xaamE\vHY4js\xacWe\xdak\xb9\x9e\x86\x92Ħ\xf4\x84\x8cr\x10\xa8\xb2\x82Z֤\x15M\xe30y\x94\x89sC\xf5\xe3_\xb5=\x13[\xe9\xc1/\x17ǨD\xc4\xe3\x1f\x92l\xfc\xfe\x028x\xf4s\x96,fQs\x81\x11m!\x0e\x833\xe4{E\x89\x12\xc6Q\\\xbcwЭ\xa1\xeb\xd1Uz\x95\x1e\xd0?\x83\xe3\x1f.\xd0f\xff\x9e\x91\xc1\xa2\x87\x98\xf0\xc1\x18\xa8\v]\x0f\xbab,ng\x88ܝ\xc1d\xcc;aRP\x0e\xbb`\xa9\x17\x88\xaa\x8d=\xff\x86\xf8\x95b\xacE~\x9f`\xed\x8f\xeaW\x91\xb9)\xd0\xf6\x99qu\xa0\xfe\xb2\x7f\x9f\xc7h\xddN\xb12\x87z\xe9\x8e\x16\x01\xed\xbc\xf3y\x06\xc2pv\x0f#*n\xe39\x88])Џ\x19\xce\x19Y\xde\xc5\xf1\xeb8]<\x10\xa8\x1a|\x1bA\x99*\x9c\x8e\xbb:9\xaa\xb3\xbaiq\xe9UT^\xc5I\xa4@\xd6\x04U\x93\xa4{ꈽf\b\x03u\x8f\b\xec\xb0f3\x06u\xb9\x8c\xe7/\x83\xe3\xb4 \x14%\x85\x126\xadt\x15\xeb)\x19\xfc\x80[\xacO/\xd9\xfeX╚\x8f\x82c.\x1dT\xbc\f\xae\x90)\x7f\xcbE\x16\x1f\xa6\x05\xff#-\xf1_ 2\xce\xd8针4\x9ak\xb0\x01\xf5\x1a\xfa\xa9\x16\xa9\x15\t\xdf\x11i\x02\xbdI\xb0\xe4\xfb\xb2'_\x85\xf7Eć\xf6\x1a\x0f->\xf6j\x81st\x97\xf9qw\xf1d\xe9\xc7\xeaD\xadY_ B\x19\bѥ\xa2;❦\b\x16i\x84+*\xbd ]\xb6\xd7\xf0\x15\x10\x00y\x84\"\xf2~1\t_\f\xe83\x82\xebĀ4zLp8\xafv^\xe0\x02_0B\xbeB\xeb\x91NLK$֪K\x1c\xcc\xd5\xcest\x9e3z\x0f\x1d\xfc\x84\xc0ƨ\x7f>\x7f\xcb \x9aͥd]\v\x8f\x18\xde\x12\x14 \x9b\xec\xdeK,c\x93/\",b\x93\xe1x\xc6\x1c\x89\x80\f\x97eN~\xdf|\x1eV\x9c\xc9\xcc\x1b\\6Z@\xd5\xc5ʅ\xbe\xda!/\x18\xb6z\xd2Cd!h\xe9\xeb[\x17r\x18\xa0\fD\x1d\xb9\xa0sC\x0e'\x1er\x18\xec\x1e\xccB\xa0V\x16\x81\xd9\xfb@\x97\xa1\x89\xfcO\x89\xcdx6V=a\xb5\x95\xa4Z\xb2\x1a\xa8\x9f\xc5\xe5E\x86\xfcE\u008e\xe2JY\"\x90(\x9b\xfb7\xde\x01l\xd7ܯ]\x80c\xd4\x01#\xe9\xd4\xfe:WTn3\x18\x04\x84j\x1e\v\xd7t\x19gCXi2\xb9\xa1Bf\x15\x82!\x96\x84\xf3\x19\x10S\x1a\x04Q9\x1eQy\x99\f+@\x90\x1e\x05\xcdw\x8b\xbd\xbdQ7\xbc\xa7\xeaT\x80_\xb6\x9e\x0e\xb4\x1d\xae\x9bSx\x97\x81\xd0;_\b\xd4㇛\x97=\x91\xc6SZ\x96\x04\xea\xc2\x14\xc3sV\x9d\xe7\x96\xc73T\xc42\x94\x16,a\xe0L\xfc\xf5n\x80\x8a\xc6\xe3\xe8,,o\xeb\xcc\xdaz\xe6Yq\xf5\xac\xeaA緀Zã{\x14>m\x1anP?5`\x93\x8f\x8f\xf9E\xfcS\xab\xaa\x88\x9e\x1fw\xdc\xfe\xfe\xff\x06\xc2\x02Jn\xabe>\xdd̞\x1d\xf0\x15\xfa=8B\xff.=e\x1e\x98\xa5F\xaa@.p\x8d\xd6/~dP%\xabB\x12\xd2K)5\x88\xff|w~\xe2\x89C#\xb3\xcf\xcd\x1bܾ:P;~\xee\x90[\xa0\xf5\x014\xb7S:\xab\xc8|\xc8\xfc!\xff\xfc\x05\xa5=\xafF\xa9\xc7\xe0\x15\x17\xec\xf5&\x976\x91x\xb9!@{\xaf\x03!\xcdM]㜼=Ã\xcdzm+?\x1a\x19\xe6\x8d\xc0\xf2\x865\xc1\xb0\xbdT8ڶ<\xd8ދy\xfc\x00/\xe9bMEpq\x8bu\xff^\x82~\x90\x941\xc8Y\xbcb\xb8\x84\x052c\xb5vA8\xa6\xf0&\x8c\xa7\xe1\x82a\xf0\x8e\xe4\x00\xe97\xfc/\xbc!i\x1f\x9a\x87t\xd6Y\xf8\x9fX[\xb5KZE\x9c\xeaGr\x95\xe3\x84\xc8mT\x02\x8b\x9fXQ<\x05\r\xb9\xc1\x05\xd2 eբ\x80\x82]\xa3\xb8@\xffZ\xbe\"\x02CJ0\va\x8e\xf0U\b\x90oE\xf6j\x11Q\xe4\x90\v<\\F\xefkA8\xf0\x05/\xe1\xa0\ah\xcdF\n\xf9\b\xdaQD\xe3<*\x85z\xd0\xfa\x9ez\v%~B\v<\xb0\a]w\xf4YE\xa3\xb8\x1d+%\xa2\x1a\x17\xb2~\x17rX\x0f\xe4\xb0\x12\x85\xc2U\xfbk\xb4\xf4F\"\xeaO\x16\xf3p\xbc\xe2\xbb\xf4XѺ\r\a\xe0\xa8GJ\x86\x10-\x1d\x18\xbfw|z\xeb\xeaz\x06>\nT\x85\xe3\xf6\xbc\r\x8fX\x7fD?\xa3#\xba\xd5#\xf0CL\x05\xe0<fo~\x10\xe0\xb2s\xf9\x13v\xb3\x99\xb22\xdf\aN\xf5\xe4\x90\xc5\xe1\"\xb8\x86iXb$2\xcbP\xc7\xff\xe5\x98\xe4\x0f\x87\x888\f\xae\xf1\x8b\xd1\xef\a\x01t\x80X\x84\x8c]\x1d\xd1y\x81o\xa3\x1d\xe6Z+\x88\n\xc4P*\xe75Ժ\xef]\xc4}\xacT\x9f\xa6\xfa\xa4\xe1\x9drӼr\xbaTvU\xb4\xa2j&$F`Jj\xd8k\xd0E+\xf6\x85/wqd\x1b\xaf\xb5\"\x7fk*\x9b\xa8\x0f\x1f^\x9c\xb4\x95\xeb,'\x80\x92\x98i9\xe2B\x8b\xc1H\x181\"_\xee?t\xae\xb4\xde\tz\x8f\xf3`\xf7\aX\xf2`\x1a\x85%0\x8a\xbd\xaa:Hmv\x94\xf5\xed'X\xcdğ\x99$\xae\x9f\xb3\xc5u\x12\x17\xb7\x17Ċ\xceq\xa5=N\x9b\x86\xb6|\xd7s\xb5\xe2z\x0f\x99\xad\xc9v\vc#U(JuQ\x13\xe3f\xc3\xc2\x05a\xa1\t NXf\x8a\xd1\xee\xaa\n\x96zT\x1b\x8d\x18/\x90@\x9ag\xefҹ\xf3t\x1c&I!\t\x11\fҎ4G\xf2F\x98\xba\xaa\xb2-d\xe0EM+\xcd\xd4Lb&(\xb8\xc6ddm\x9c$\xec\"\xd7z!\xa7\x89j4\xa0B\"\xfco\x0e\x04\xc0\xf9F\x9d\xccrz\xcd\xd5\xe1\x1e\xa8\xad\xae\v<\xbcx\x9e\x1a\xaafY\x91\xa7\xb2\x81\x10\x9a\xf1Q\x19Q\x84\x03\xcd\xf1\xdfLeFyd\xfd58\xf5\xb4\xfa\x18\x17\xc0\x1a\x87u\xf4\xf1\xd7\x7f\x87C\x8f{u\x81\x05\x91\xd7\xec\x94\xdb\xe6c\xed\x13\x8d\xa2\xb2K\xbe\xdfށ\xf4j~\xfd\x12\xf7\xadY?S\xd9{/\xc9k\xcd\x12\xa3\xb5\xd2\r\xf4^<\xf5\xa3`\x8dz\xb7\xc23uP\xb2\xa4䋅1Ϫ>d\x18\x19\x8fc\x838(+\x02\xc5aϵ\xcf\xffl=U\x84\\\xaa\xfaX^R\xcc\xf3[\xe4\xa9!\xa3\x96\xac0O\x167qz\xb5\xf8\xfa\xf9\x8b?b\xfa/F\xb3\xfd\xac\xa7@7A\x9dcy\x03]\xa7\xb8\xf3&\x03O\xce\xe1\n\xb6Р>\x1e\x86s\xb6\xe3\xc5\u07b5\x9dL\n\xc9\xf3\x9dew\xb0\xc2go/N\xfe_\xf8\x9ay\x89\x85|\x95ߦ\x03\x1b<\xce2Xq\xef\x170z\xc5\x1eE\x97\xe8\xa0\t|\xc1'\xba\xcd(\xc2y\xc3n\x9b\x96*\x9a߾\xba\xd8@|\f\x03|5\xb0د\x98RP\x9cD\xbf\xa6\x82\x87\x98F\x94\x83G9\x88\xa8\x8b\xe3k\xd3B\xc7iL\xb8\x02\xd4\xcap\r\x10\x14\xd6Gk\xcc2\xa0\bX\x90\xa6&l\xea'\x9e\xf9\xf0\xfb/A\xbf\xe16>\xd8\x11\x9a\xa8\xeaħ\t\xc1\xa0\xd0R\xa0\xc2O\xf7\x7f:>8\xda\x17\x13D\xa1\x1c\xbe\xfbc\x9a\xf8\xfe9<|s\x8cZ\xd0mv\x8f\xd56@\x16\x1a\xc6e\xa7\x132\xf7:\xb3\f%{Wp\x94\fU\x87\x92\xa0\x99<ˀ\xb8\x82\x84sK\x9al\xc8\xd60̃\xe4\t\x96y\x04z\xbee\xfc\xdb\xeff\xeb6_\x7fZ\x8b\xd7\xd3o@͒\x86\x87|Մ/t+E,\xc9m\b\xd3\xc7\xf8D\xb2D\xa0\xb0\v\xbb\x92\xbb+\x1c\x95c\x1a\xd5>\xc5\xf2\t\x97|\xf2\xf9U\r\xcb^!\xa6:;\x91bj2L\xb8\x80\xff\x05\xcd^2(e\x0e\xde钰\xf0\x94\xfb\xf7\b\xf9\x007`͍\xd2*M\x1eN\xb2\x82k\xe8!\x1bt\xe6\x18Nfp`?\xd8\x16\xae\xa4\xea=-\xefiyO\xcb{Z\xfeE\xd3\xf2\xd7\x19\xa8\t5\xd3\xeb\x17G\xd0\xe3t\x12\xe5\x1d\b\xfa\x98^P\xba\x95\x1d\xcf\xf8\x16\v\x88\x95\xe1\xf8\xfd(8\b\x0e\x9df\x1d#\xbc\xed\xb7|1\xdeyt#\x01\xb1N\x84\xb7\xf3\xcdO$P{\xbbV\xc3\x0f\x18~\xbd\xfa\x14Ζ\xc5?\x93!\x9f\x85\xe1|b\x8e\xe1\x97\xc2\x0eZL\xb0UD\xb0\x1c\xe5x\x82T\x80\xf6!\x9e %\x94\xe8n\xf58U\xf7\xea)w\xa2\x89\x04\x90\x87\xf1\xe4\xec\x90P\x91=n\x12\xe7\xf9J,e2*q\xfb\xe0\xe4,\xb8\x0eq\xce0t\xf29\xab\x88Ӻ\xad\x03e\xbflQ6\xe2`\xb9ϭ\f.1\x01ү\xfa\xcb\xf0a\xbeV\xf2]\x8c\x84~\xbf\xb4\xaa\x11\xbb\xf5\xe8\xff\xfc<\xf8_\xff+\xf8\xd3\x7f\xfe\xe1\xbbo\x9f?\xdfE\x9e\xf4\"\x98\x84\xcb=\xbc$\x12\xe7\xa1C\x9f\xff\xf3?\xe1\x0e\xa9\xaf\xe0]\x93c+]\x02U\x7f\xf1\xfc\x0f\xd2\xcb7p\x8d\x17y\xb1\xb7I\xa1A\xbd5\xd9l\x9e\xa5\xf05\a7\xa0Mb\x97NV\x12\x8c&\xd5Q-\x8e\x80kb\n\xccC=\xf52\xcf}e\x86\xf5p\x02j\x10`\xae \xe3\x8bY_\xa2\x04\a+^\xe26\n\x13\x10xƷ\xd1\xf8\xbd\xf5\xde\xc8\xefKn\x00\x19x#\xa8\x02\xbe\x99\xae\x1e@=\xf9\xd0\x1dR\x13T\x9b\x17\xfe\xe2BC\xf3\xae\x19\x82\x83g\x88O\xafv~\xa2\x8f.\x112\xf0j\xe7\x922\x1c\x90|\xbf\xe24\x86A@\x8dޥX\xd53\x853\xd6\x1e\xcd\xe0R2\x83\xda\r\xe7\xa5=\x94\xf6\xc4B\xf5Ո\x1e\xe66\bv\xd9\x7f\xe9\xfc\x889\xd2{p=\x12)\">N\xe0.\"\xe7\xc6\xd1I\"3\xecV\x0f¾\xed\xe8\x96U)\x8c\xaf\x05\x92M\x9f\x16s\x8e\n)\x1alG\xa9n\f\xc6\xe6\xa1i\xdb.\xdd\xdb\a\xf1\xf4A<v\x10\xcf\xc6\xf12Uj\xd76n\xa6N\xeeV\xb1\x10\xf4N9l\xc4\xe48\x99ˈ\x99\xc3\x06\x13\xbbBdyKz\xf4\xf2\x8f\x8a^\xfez\xfd\xe6l\x8dvZ8X=\xb1\xfbl\x01\xcc\xdd\xe1}\x04\x88\x9bFJ\xe7A\xb1YA\xedP\x03|\x13νz\x02?\x12a\xcf-\xbcC)3H\xe9(ЙBM\xd2\x02\x03\xb6z\xb9o\xcb\x14\xeb:N\xc3|y\xe4=\xea?\xe8g&~F\xa2\xd2\xe1\xf7\x80\xcf\xfd1\x1c>\xb2S\x93\u058c\xfb$Ď@hSش<\x1e\x83\x1e\x15\xe6\xe1\x18\xf1|\a\xc1\xb3\xe13\xf8\x9f\x7f<Cu\xe6\xd9\xe8\xd9(\xb0\xbf\x13\xa6\xea[\x01\xa2k\x04\x1c\x981\xa6\xad\vK\n\xceA2!6\xbfw\x97\xaf\x86\x7f\b\xf20\xbd\x11\x05\x1e\x06\xc2a\xa6\x1cSo\xf5L\xc3\xc3W3ط\x04\xce\x1d\xa1\xcfa'\x88]\xad:\xa4\xa6\x14\x804\xe03\x81v\xe7\x94\xecI\x93`\xb2P\xf4R\xe9\"p\x16\x11\xd9w\x14\xbc+t.,\x87/\x91\x1dJ8n\xf0b\xf4\xe2\xf9\xff\x13\xe0ŤCDg@\xd9&7\x0e\xa9\xa9\xe1\x90`\x18\x87w\x1f\xeb;X\xbfm[\xd9ȟY\x99\xa5\x95M\x81\xaa\xf0\xf6T\xf6\x91\xbaW\t\x0e\xd6\x06Ѻywq\xcd\xfeQkٿj\x7f\x9dv\xf1Q\xd1M=\xb3\xed5\x8b-i\x16\x8aiv\xe5\xb2\xc7\xe9]\x93\xa3\xa6\xde\x06n$\xfa\xa8\xc9\x13\xafy1\x86\xfae\xf3E\x12JPg\x94\xde\xc5y\x96\x92_\xe4.\xccc\xcc:\xe7\xfbMp=\x97\x82\xa8D&_\xb1xI\xe2\x83\xee\x13\xf6\xcc\\GE\x16\xe5H\xab\xeb;\xe4[1\x0fc8\xdca\xe1\xff\xac\a5bm\xae\xbb\xb8\x1b\xcb\xce\xc8\x19Hd\xee\xe2\xe8~\xff>\xcba_n\x868\xe7\xa1\xc8\xeb\xfb\x94\xf7\xb8\xff\x15\xfd\xc7w\xa0U\xe0j3\x06\xca\xfdm$\x8e\xf4\xc8Z~exfie\xd2>\xd9Vw\xf1\xd7hyA\xfb\xea\xb3\xcd^\xe8\x1dG2/\xd0\xed\xfaժ\x8a\x88敚\x82H6\x17_\xbe\v\xf6\x88\x19\xcb\xf4\x89\xd1f\xc8\x04\x9f\xd9n\x01\u05cb\x91$i\x9e\xf9\xa8\x9dk\xa8Nf?V!\xdd\xc2%\xac\xe0m[\xff\xd7\xc3\xeb5\xff\x8f\xaf\xf9\x9fx떩=ڒ\xe6\xaf\xf8E/\x86<\x91\x18\xf2%\x15'\xf3\b\x1a\x1b\xa9\xf4 \x0f\xff\x1f\x8eq\xf3\x05\xa3\x87\xf3\x8ad\x01\xc7\x01\xc39\xe6\xfc\x96F\x9fy\x8c\x18q\x8d\x10et&YM\xa8\xf7N\x05\x8a0\xa4\x02\xf4\ru\xc4mm\xc1\xeaQ\"F\xb05\xc3\x1a\f\x80\xa4\xc0\x9b\xfc3\x97\x1f\x04\xdeF\"\t\x01\x1e\xb1\x944a5D\xfc\xcec\xb8\x12T.\x8c\xa6A_\xa2\xb4\x8e\xf2\x16n\xcdiVj\xac=VI\xd8\xdf?\x06\x9a\xa6B]`\xaeЃ\x86\xfc\xe1k\x88_P.M\x15%4\x13\xf7\xa8Kě\x88\x90\x13\xd31\b\"\xd1\xf4,\xb1˳\".SF\xcd\xc9\xec\xa6Y\x7f\xb5ിV\xdc\x02\x19\xa5i5\xefo\xb3B\x927b\x83\xa0J\b\xc0\xbc\xe7\xeag\x1a\r\xe5\xefXcUt\x13>A\xeb\xb9\xe2\xcb\xfa-^qf\x11\xb0\x89շ\x85\xe4\xc8١\x0f\xb2D\x14\x17V\x1f\xf7\xb71\xacR\xcc\x05\x98\x94\xbc*\xab\xa4\x17\xc2A\xd9+\xa2r!K\xc3>l9@\xb1\xe4\xba\xe6\xef\x11,^\x84\x8dQ\x80\bP\x85\x16\x1c0҈\xb2\xaf\x14_\xc3\xcf*K\b~\xe4\xd9h\xf4\x8c\xe3\x02Uu/>z\xf8\xfb6\xd8\bH\x8e\x97\x19!V\xfd\xeb\v\x17֊-HkkpB\x1a\xe9\xdfSP\xbdO\x90֙y?2\xe4\xae\n\xabF\xd4\xcf\x0f\xeae\"\xc6f\x04\xb6\x11s\xc8\x18Z\x9a2\xab`\x15-ѵ\x86\x9c\xb1\xa3\xef\x98\x1c\xaa\x02q\xcfiX\xcf\x7f\xff\xfb\u07fb\x19wϿ\xfb\xf6[\xf8\x05\xe4iԲbчe\x95\xe9\x82*#e8\x9d2\x89\xa2\xe0=\xa4.\x1c\x84&y\x8e\xb3\xf8涔\x00F\xa4\xfdI<\x96;\xcd(y|ԕ\xe1\x93\xfa2\xfb6#\x84=\xaa\xe39-\b0\x9c\xe9\x1d_\xcd\x02y\x84`\xb4sgfM\n\xc7\xe8\xb8\nʳg'=;\xe9\xd9\xc9Ӳ\x13\x0en\xf6\x15B\x14\xcc9\x02\r\x97\xe8x\x1d\f]\a\x14\xc1R\xb4B\x87B\x1f\xa8Tꅐ\x02v\xe3\xcbV\xcdo\x16\xb3H\xa0vY\xa1+\xf3%\xa9t\x82\xb3\x9d\x8d\xdfc(\xfb\f\b8,\xc2\xe1\x9b#<\x92\x14\xc6\x1aO\xb5x\xcb'=\xbb\x031w\x82\xbe\x01\xb6\xe3\x19\nP\x04\xff\xb1\xfb\xf3\xc1\xf9?N\x0f\xde\x1c\xef\x11ь\x1e\xe6p^\xa3\x89\xc5\xc2\xf4\x94\x9f9fA\xb9f\xca8h\x15\x93@\xed5\xd1x\xef&\xdf@\xce}\x9c\xce\x17\xa5҂\xd5\xed_\xa4\xe3[\xf4!\x89\xfb\xc1\x1eV\xb1\x84\xef?(Z\n\xcb\x14\xce\x15#\fa\x1d\x16\xf8\xf1\xff\xf8\x8fA\x10G/\xe1\xbf\xe6\xc5Qp,m\xad\xf92\xbd\x88\xd0\x05tmf;\xc0\xa8\xf50\x9f\xd0\xed\x86\xeb`\x9f8=A\n\x91/\xf0\xdc\xc1<\xbd\x9as\xbb{S\x86\xc5{\xb8\xb0)^\x8f!j\xa1C\xeb\x80\U00065386\xa8\xd1b\x81\xc0P\x0e\xc2P\xef\xc2\xfeW\x92\xa1<\fu\xab8\x85?\x8a\xdb(I:%!\xc9\xeb\x1e\xe0F}\xda\x02\xea\x86T')u*kO\xa7\x9c>\xe9=\x90ǧ\x97\xe7\xffu\xf6\xf6\xe4\xf4\xb2?\x97\xfd\xb9\xecx.a7\x9b#x\xfc\x0e\x112|kvn\xa5\xacԗc\v\xac\xf58\xbd\x83C\xdb\"4R\x80\x8a[\x86F\xc2\xd4^\xe5٬y\xeeb\xfdt\x9cD\xfe\xf5\xa8/\x84\xf6\xea\n\x8f\xb4n1\x1b9fZ\n?\xfc\xc7\xc9\x11\\\xe0\x93W'\xc7\xe7\xa3\xe0 \xc1r\x86\\gő\xd8\xf2H2cB\x82`\x8c\xd0\xc2Ƶi\x9dO\x93\x14&\xb5\xb0G\xc1/\xf8\x9c\xa539\xb8\bƪ\x00ae\x82\x03#>B\xe7E6\x8e\x8d\xf2\xa3\v\xe9i\xdb\fF\xe8\x85\xefI\xfe\x03\xa16\xa2\xc2\x10\xe2\aWsex+\xd86}?\x17|\xb4\xadb2\xb5>\x9e\xea\xe8\xe0\x1e\x8b\xdeJR\xc9\xcc\x1bL\x7fd\x11T)O\xd0Q.3\b\x16\xfb\xd4K\xd1\x00\xee\xc2e\xad\x93\xec>\xb8\x05}\b\xbe\x99\xa0\xddT\x8ca\xb6\x92\x88u\xbc\xc5\xfa\x85\xe5q\x81X\xe5@\xc1\xed\x9d\xe6\xcf\xc0\x8e\xa2(\x98d\xe1\xc4*\xf7R\xb0\xaet\x14͓l9\x13\xe0N\xd0:\xb1\xaa\xdft\x91\\D\xa5\xbfr\x10uz\xb6H\x92\xb3\f\xb6\xcc\xe3\xfc:\xa1%\x9ac\x1e윚`\xfd,\x12k\x0f\x92\xfbp\t\xa7\xe9\x14\t\xeb\x00\x18\x03\xb0\xb13\xa5%\xd8\x1a%7D\x16\xf5R\x9c\x1dex\xe3\xa8\x0f\x14\xfaow\xc0Z\xdd}\\D\x8f\xa0\xb9\xcd{\xf5\x15\xf5\x84\xe4\x94\xff\xf6\xad\f\xa6f\x8f\x97c_j\xeb\xc1\xd8\xd2\\)\x89\xd8l\xa3\xa4\xb7\x89\xfb\x87\x8e}\x8c\xb5!\x8b9\xbc\x11I\xac\x97\xec\xa8\xfe\x04_\xef\xa2\xe1V\xb4\xce\xdb\xd4#\xa6\xe1C\x97\xc0\xd9\xce\xf2\xec\xda3\x85\xb3(\x8f\xb3I<FI\xe1Z\xe5N\xe8a\xf1\xabd\xe7\x90\xdf\fYR\xb5\xd9㩪\xa2\x01?O\xa9<\xd2\x16\xb6J\x1d\xedb\x1fC\xe3\xf0\x7f\x86z\x95\xbe\xd2C\x1c\xd2W\x8b.\xf8\xfa\xb4\f\xadT5\xb3\x10F\xbd%\xfd\xfc\xe8\xf4\xe2\x1f\xaf\x0f~8~-\xc1D\xd6\xd5\x14\xad\x84\xc9<\x95\x11\x0f\x15\xba\x0f\xa9\xf2\xbb\xfaݽդϪ\xd8\x02\xe4\x7fE\xac-=\xc6\xe3\x04\xe2\fZ\f\xb4w\xca\xe2J\xc7\xf8\x88\xfd\xa7d\x9c\xa2\f\u009b\xf8N\xe5\x8f\xf1Q\xf5\x17ֵ\xf2\x8c`\xabp[\xb0\xe74\x92\xa3oe\xae\xa2\x00\nd\xe0z\xc1ִ\x1c\xaeT\x1es\n\xa6\xea\f\x15x\x14r\xa5\xbcKuHGo\x8f/\x82ӷ\x97\xc8 \xee\"\x85\x92@\xcfiZ\xd7\x11\xbe\xc1\x13\x9d`=\xa3%?\xd4F\a2Z\x90\xa7X\x10\x89\x15%\xbd\xday>\xa2\xff\xefj\a癓\x81!-\x90\xb0\xda3P\xa7;\xa4B\xf61\n~z=e\xf6Oı\xf4\r;\x83\t\xb5\x90y\xc6N\xfb\xd6\xc2\x0ff\x94\xc6\x1b\x92\x83B \x9fu\x1f~\xba\xc0PJ\xb4l\xea\r\xe5(->Wba\\\xe2\xdel/\xd0ׂs\xd5DC\xb4is\xe8JAy\xd3Z7\xa6\xae\xceP\x95\xbd\xb1\x1d\xf7m\xa7\xa8\x86-\x10\x1f$1\b\xe4\xc1\"\x8f\xcb\xe5!\x97\x04\xf4\x05\xd9p\x03\xcb|K(ߊ\xe3)CM\xe7\t\xb2t\xb1\xaf\x060\x94\xa2\x84\xfb\x9d\x14,\x15\xf8\x19\r\xf10\x18\xf5\xa9\xd6k7h\x1bgI(9\x12nB}m~\xd1\x1a\xa4\xbd\xfbjeP\n$\xa18\x04Z9Ű\x82)\xd9&\xa1\xab\x9aF\x81\xcb\xc8i\xbb'\xaeb_ \x9c\x1f\xdeD\x89Ghx\x9d\xaee\xc8r\x97\x98ˡ\xcd\xf1\xdbW&q\x17\x85UL\xbcl\xc0;\xc7~ߦ>\xef\xcf/\x96\x9e\\\x1b\xb0\x8e{I2\t\x81\xe5\x11\xa2\xa2\x9f\x82\xdc˕Ycd\x92\x05\\\aPW\xb2y\xa4\x94\bm\x13\xa4\x12.\xa2\xce\xc8\f\xe1\xa0\xe5\v\xbbG sQ8S\x11w34\xfcbW@\xc3\xf3\xac(\x8c\xd6Ý\xa9\x9c\xe8\x82-\xe6jv\x01{-\xc8\x10\x98c؍\xfe\x9a\f˱J\x92\xe83\xd0X\x1d\x01N9\x11\x9fE\x8eA\xc5\x1c\v\"\x15hXo\xc6\xfe\xb4\xeb\"\x95\x91\x162T,m?\xa6\xe3\xcf)\x01\xa6C\xe9j\x12\x17\xc2u\xd1@\xaf8\x1e/\xb4\x1a)-\xf5D\xc1\xefQ\xef\xf2\x93՝\xad/j\x19Μ\xaei\xc2\x129\x9d\x88\x81\xc3\x1b%zXE\xa8\xd7Ξe\\A\xf5\x8e\x8d\xf2\xfe\xa3\xe6=ip\x1efqJb\x82\xa48\x9f\xad\x81\xef\xa1\xdaBf1\x94\xcb\bV\xdb\xfcbۯ\xac/X\xb5ٙo!\xca\x02\x10Sr9\b\x12\x90\xf6\x90\xd8}\x18\xe4\n l\xaa\x0f\xf3.\xba\x99Ȃ\xc6p\x13\\\xa9\x1d\xa8\x8a\x14V_\x14V\x15L\x84\xef(\xd0F\x8di\xca\xc0\n\xa9\x96\nw\tG^\xc6\x05\xa71e\xe8|A\xc6 \x84}`\xa37\xe4\xe8\x13\x88\xa2o\x9f\xff\xf1;\x8a?/\xd8\x04Qf%|Q\xcd1\x89\xd2\x1b\\)\xbe\x10\x98kg\x14!\xbd\x00I<\x8bK\x1e\xf8\x8b\xaf\xdf_\xbb\x9a\x1b\x95ҳ\x96o\x98d7m\xe5WϾ6\xa9\x98\xe9D\n\x92\x82\x96Lnbφ\x99\xfaP\xda_;\xe2\x92\x144\x13\x15p\xefx\x9c\xbd'\xab\x16\x04\xec\xdcoL[S\x9f\x84\xaf_g\xe8\x11^\x90XH7L6\f>\r\xeby\x1d\x8e\xdf_f\xaf\xb3\x9b\xe2mzL>,g,dH\x19\xdf.\xd2\xf7\x15\xfd*\x039uQ\xa2AT\xe4\"߄\xa7\x82\x97ĤF\xf9>M/\xd1C\xac\xcd6\xa1\xc0\x11\xf0)\xb0\xfb/\xec\r\xfe\xfa\xf9\xb7\x9c\xae@\x06\xcd?<\x87g)\x8a\xeetm\"A\x98.fa\"\xe5\x1b\xccA\xc0\x85n\xbd\xf1\xbe:l\xadY\xe3\xe5\xe5\x7f\x11_\x8c\xcb\"J\xa6\x03F\xc1\x11Ѫ\b\x9e\x11\xcdy&\xd7\fIv[\x86\xc6\xc2\x12\x17'+\x9a\xd0J\xe4q5\xa0\x91\xaa\tJ\xf9\xb2B\xbeMVw\xb9\x9b\x8e!\x90y5\xder\xcc+Q\xa0\xee\xdaQ\xc9\x16oű\xa7\v|\xb8\rE\xe2gk\x02-\xf4\b\xab\x82[k%\xe2\xce\x02\x96\xf1\xa8\x10\xd9DGC\xe8\x1a\xa0ki\xe9\x93(U\x16\x02N\x8b\xa5\xa0\x91v[\t\xf1\xc5\x1e\xc5~\x84\x10=Si\al\x9c\xa3)\x96\xc4qS\xad\t\xc7v\xddfG\x8ezVh\x1dV{K\nl\xcd,N\xc7Vh\x81wR\x17\x02ɶ\xd5\xee֮s\xe0\x9e4\x98Q\xa5\xbc\xa0#0\xb0ɵ\xee\x9e\xf5De\xf3\xcf^k\f\x05\xb1(~\x8ew\x8a\xec\x90\xf0\x0fB\a\x19\x05T\xaa\xf1\xbf\xafvp\xc87\xe3\x1c\xf5\x80[\x98S\x8e\xfb\xf7\x12\x86\xfe|\xf4{F\x17a\xdf\xd9\xed\xe2\x1a\x9b\xdcd\x19\b\x98\xff\xb0\f\x82\xf5\x97\xfe\xdeɝRĿE?,\xbd\x88\xd3H\x8b\xf1\xb12,\xc9\x14R\xe1\xd8\xeb\x82T\xbe\xfbv\xfd\xbe\x90\x15\xa0\xf9\b\xe2c\xa7\x96\x93\xb6\xe5\x90\xfd\x84\x1d\x14,s\x1b\x1aV\xd9:\xd7\xe2\xe0-;\xb1b(\xa7\x8b\xd95\xc6\xfa\xb0\xc1ʲW\x89\xa1\x06\xd448\xea'g\xca:\xa3b\x87\xec\xa0%\xacƉ/\xa7\xd4\xd7 x\x1e\xfc)x\x80\xff\xff\xbb\xdf\xfd\xee\x9b\xefZ\xc6\xfa \xb0\xd8ə\x8f/\x81 I\xa8c\b\x9e\xa4\n\x97`L\x80\xaa\xd6!\xe3\xf6\xb2:|q\xb3y\xe3\x9b\xf5\xe0\x9dn3\xc7ױ\xd4\xe3\xa9\xec\xa9kQ7\xbd͐\x8e\x05Ρ@\xed\xbc(myP\x80F\xd3\bE\x05x\xb5\xe5\xba\xfaͩ\xab\xe6\x95\x06'\a\xa7\a\xff\xb8\xf8\xf9\x90<\xb6\x12vD\xa6R;\xd0,\x9b\x88\x95\x15\xbf0\xb1\xce\xeb\n3\xeb\x88kj\x992\xb9\xb9\xaa\xc4\xc5ndr\t+P\xb8\xa52S\xf9\x1d#p\xca\xcbl\x9cy\"g\xce\xe4\x89$p\xe7V\x8cݻ\xa33\x94\xac.\x0f\xcf\\\xf1\xe9j\a~\xf2\"\x1a\xad\xbb\xe0\xe4\xc0Yq\xc3\xe9\xb9\xe4\x98\xe3ڈ\xf5\xb2\xa0\x9fm\xa9sDu?\xb9\xc0\xd7\x14\xa5+\x104\xf1P\x15\xaa\xee\x80\x05\x8aG<*UtkV;Xƾ\x8a\xad\xe0\xa9;\x9c_¸\xf4\xc67\x8a\x0f\xdb\xc7GJ\xb4\tjt\xaa\x1aL|\as\x8d;\x96s\xf9\xa4\xa5\x90D\x93\xf5\x030m\xb70\x86K\xf3a\x94\x1dxu֏A\x1ana\x00\xb2!-O\xdby\xd3.y\x9bIҖ\xfc\xa1\xcf]\xe82\x14\xf7\x1c\x88\xf9\xe1\xc0W[*\xa6\x12\xc5>M\x1e\x16\xa4`\x9dj7\x8f\x86{\xd2ɣ\xb2L\xf0k-W\xe5r\xc5\xe9ij\xc9kc\x9d\xa5\xa6\xe5\xb1\xf9-\xeau\x87\x18\x01\xdc\xccj}p\x8b\xb6\xd8yr\xa4\xf5\v\"\xd8\xc13\x16\x82^\xee\xef\xffIw\xf2\x8fx\xf2\xe7g>ʧ\a\xe0)9\x1b\x97JI\xd6\xfe\x11\xda\x11[\x81\xad\xba\xd1\xda1\x12ܹ\xe2\xb6\xfb\xa1p>\xbf\x95\xe3\xd0\x02\x83\x8f\x03\x87T\xacT\x87\x15p\xa0A\v_n\xd1.َ\xf6\x02~\xbe\xd12[ ~\xf1\x8d?\xf8\x93~\xdf\xfa\x1e\xb6\xbe\xd7\xe8\xe7\x8b38E\x1c\xf5\xe6\xfbb\xf0\x81\xef\xf7/Md\xd9یo\xb6\xa2\xd0\xed\xa8^\x87Su\x7f\xbb\xac\x9bmQ<[F\xa5\xa2\xb5\xa3G\x9c\xaa\r\xfan\xb3\x88~TD\xa7\x81\xc1\xe9\x98\b\xbbӀ_\x8b<\xa7\x00\n\v`\xd2q\xa2\xf9\xcbԣ=zI\xff\xa5\x03sHJ\xbf\x8a\xfb\x91\xff\xfa`\x8c\x9f\x80\x986\a\xd8\xf8uC\xd6\a\xeb\xe6yكGG\xb94\x0e\xc77\xe5\x13~P\xbb\x87\xcfD\xf5\xf6N\x0e\xe9F\x83\x84\xeaJ3\xd5.k\x04G#\xc0\x8d6\x96r\x9aՑ\x8aJ\xb9y\x1cG[\x13(\x1fʆ\x98{J\xa9\xf1\xfa\xd0\xd0'ƥ\xb9\x02\xce%\x16\x87;\xfb\xcd\xfdvM\xe7\xdc{\x8fY\xaaUQ4,\x15\x9eo\x92\x1fN{\x85\x06\xea*\x82\xba\xc08\xc6B1LG\x93(\x9c\xd8\xca#)Y\xb4\\\x8a\x90H\x97\x14\x14\xe0\xcb\r\x1d\x87\xc9X\xb2\xae\x88\vUz\x1c\x05?ЩA\x8d\xd9\xfa\x0e\x06+\x17\v]\x9f\x18\xc8\xe55^!S\x8fB\xcc\a\x1c\xdcH\x863\xcc7\x1b\x87s\x8c\b\x86\xcf\xff\x0e\xf5\xbe\x1f\x0fG\xad\xf9Y\xf7\xa3\xad\xe8\xd8VNt\x03\xd5=\nae\xd3c\t\xaf\xf0!\"\xd9\xcf\r\xcd\xf5!\x01\x8b\xfd\x87_\xd1!\x1bUj\xeb7\xfe\xf8m\x1fg\xc6d\xa1\xc8\t\x06\x1d\xb9\x9do\n\f}\x94ݧ\xf7\xc0&\x0f\xceNV\xa5h[\xc8\xfd\x13y#\x80Wh\x05Xq\x97\x97\t3ݟ\xbb\xbd>\xa5yb\x06\xb3\xf5\xa4f\x85\xac`\xb0/\xac\xa9K\xdd7\xb4\xa4o\xc1^^\xeb\x98*z\xfc\xab\xc5\x16X\xcd=1\xb6\x9ef\xb65\xb2R\x88\x91S\x18\x8d?\xd7\xc2\xffP1&\x14][9\x99T\xec\xa5^\xa5\x00[z+w\x98\xa26\x06/\xc6I\xe1\x83\x0f\xbd\x04\x92\x97\xa0\xd3\r\xc8\x19û\x0f\x02Jۄ\xff\xa6\xaal;\x19\xaf\xe7!l6\x93%)eн\xbe\xf1+\xfc\xba\x86\xb5A\xfdc\x83\x04P\xc1fC\xbc\xefY\x8b\x8cO\xbf\x1bBU%6y\xa8\x8e\xf7\xe1sI\xec\xf4\xd7\xff1\x1bOn`\x98\x88|TR\t)[Ю\xad\xab\xa2\n\xd0\xcd\xc2\xe9\xb5b\xe9\x13\t \xbc.`\xbdP\xf2\xcf\xfd\xb9\x87\xc60\xb8(\xa7\xc3?\x00\xfdC\xd8w\xc9v\xe1\x90\x11\xbc\xab&G\xd0\x1e\x89\x06\x84\xabd/\xfa\xe5\r&;\xaf\x1aO\xbd9\xeb\x1a\xfe\xa4*\xe9ɡ\xd7\xd1d\xec9Vu\x1d\x05\xd6d\x97\x7f\x1c\x8d狁4\x00mk\x96\xe5ˁn\x84\x0f\x9d\xb7\xa4\x05'\x1b\x19\xe1b\xa3+snO\xd5\\\x9a\xb6\xa4\xaa)\xa5\xbd\xa1\xa1\xeb<\x91\xeba\x11\xa6\x1ao\x19i*\x1d\x18\xa6Ч\x87\x7fI\xe9\xe1u\xc6<\xa9o\xf9Gb\xcd\xc7\x18,\x02\x9bӾ\xacQ*\x01&\xdaE-\xa5\x13\xd8\x01Sy\xb4\xd5\xe3<\x8b&\xf1b\xd6\xe0\x92+\xa5\xa2\x83\x84\xbd\x06\xdcXG\x01\x85\xe3\xf7|\xa6,\xcf\xfa\xa5%j\xc1\x93\xab\x9d\xab\x1d\xe5-\a]I\xdf\x06\x15Leyعs\xeb.\xa8E\x91|\xc5]i\xb8\x87\x94\xfe\rѲ\x8d\xe3wU\xd0.}\x00F\xef7\xdb\xfd\x16\xbdF\xda\xeaQ\xe8(\xc2+\xe4\xe8\nX\x1f\x8c\x9bI\xf4*\xe9\x10d]\b^\x1d\b-\xd3j\xa74\x11o:\xc7\x18a#و\x14\xf9\x0e\xef2\xc1\xd6\vsI9.\x0f\xf1\f\xb6`\xa1b\xa3\x9c6\xe6C\xf7*N\x8b\x12c\xe0l\xe3K.m\xc1'\x17j\x8eV\x96\x05%\x03\xa8k\\,\x881\xcaW\x84\x17\tf\xbc\xa5\x12\xea\x84\xf0\xea\xfe\xa7q\xe2n\xbfJ\xd6\xd1\x13_\xa4\x92\xc1V\xdbL\xff^b5\xb3\xe1\xcd\x02t\x00\xdf.v\xb5R\x8e\x14\xa3\x1d\xfdm\x11\x82RQ.\x1b\xb9\x98R\xe4\x0e\xd8G\xeeK)v\x1a\x88\xdfa\x81\xe1\xaf4\xed\x89\xc4i\x14J۳\x1c\xeeU\xfc\xbcy]\x98Fgu\x93e%\"\x17\xb4\x11\x9b\b\x8aV\xf4R\x9f\xfdi\xee\xef\x04\x06T}\x1dvE\x80\x1d0L1\xcb\xe6t\xebw_|\xfd{N\xe9\xd8\xff\xc3\x1e\xb2\x80\xf4\xfd\x90/\xc1\xee\x8b\xef\xfe8\xfa\xfaw\xdfҳ\x17\xdf\xedQR\x99\xf5\x9c\"\x82\xc7\xe4?\xda\xfd\xfa\xebo\xa5\x93\xaf\xbf\xdd\x03i\xf8\xec\xee;s\x15(\"\x17\xf9ւ\x050,\x02iI+\xaa\xac\xf6\x1c\xa45\xe4\x1a\x05fq\x16\xd9 \x18Ê\xa1\fh\x8e\x8e\xa9bP\b\xb7\xc2g\x98\x90\xf0\x00\xf2\x92b\x94\xf4u2\x19\xf9\x81%\x81X\x9dzW\xdf\xf0|\xac8O1\x05\x1a\xa6٬\"q[ᆪ\x14\xd7$b㛝\xa3\xc1\xabDzm\xdaT\x9d\x88\x01|\x1a\xf4*\xab\xf4\xa1\xa0\xeaq\x1a\xba\xd2\xe0l\xf5\xbf\x9b\x86d\x95!\\sE\xfc\x16\t\xfb\xe9\x8aˡ\x8d!\xe4կ\xea\x99^\vH\xf3\xb5p\xae\x04q\xcb]\x18,'\x022\xe8\xbb\xd4آx\f\xdc\xe0=[\x1a\xb3\x8c\x94j\x9bY>\x8f\xa7\xe4u\x9f\xcbT\x14\tkJ!\xf3\x0fn^7\xd1t2ά\x8a\x8f\x90\xeb<\xb7\xc3$\xf4\"\xac\x8c\x95\xc0\xf9\xe0߭\xbd\x0fj_/\xb0\xc2\xfd\x8a}\xe7\xe7\xbc\xf3\x04\x14H\x8c\x84\t\xa0\x02\xf4\xa6jQ3\xaa\xd1i\x12옫h|\x04yd\xe55\xb19\xf5\x10\r\xa7E\x1c\x12\xd6\xf6d1\xa6V\a\xba\xff\x87\xe0\x8c;s\xcar\x91M\xec\xe5U\x1a\x04\xff\x83\xff\x13\x98\x17^\x06\xff\xfd?W@*\xb9P\u058b\xe7#\xfc\xbfы+X\xf7\xa0\xf6\xe0\xeb\xd1\xd7\xf0\xe0\xef\x03\xee\x83>\xf4\x12\xffE}\xe0!\xe4\xc6!G\xc3\xe1\xac\xf0\x87?|\xf7\xfb\xdfqo\xa6ɵ\xdb\xe4\x9b\xe7\x7f\xfc\xd7߱\xd7\x7f1\x98\x17\xcb\xd5\xe4\xef\xaa.\x83P\x17ĩ\xa1dɗ<\x98\x10&\x12\xe8\xe1\xbf\xc4o\x0e\x02=j\xfa;P\x03\xbfv\xdb\xc2\xc7\xed\xa6\xf0g\xf0\xf7zq\x04\xb5`\x1e)\xfd\xcc\xde_\x12\x03\xb2\xe9T,\xee\xa4hKP\x91\x05\xbc/\x98Ca!\xc4\x18\xf7\xbe\xb0)\xa4\x89A'\xf8\xf8I\x84\xa2V\x11NYf\xa2\x8c\xec\xeb0\t\x81N\xe5\xac6s\x16\a\x8b\x9ee\x9c\x80ȳ\x9d,wW\x1c \x04\xa2\xac\xc4Z\x90˃\xad,\tr>ŝ\x8c\xbe^Y\x1fX\x87q(\x12\xf5ҵ\xfe+g\xbe\x86&\x18\xf0sL\x10\xa1\xae0\x8c\x1e\xbb\xb2|\x1cT\x8a\x8exvCS\x95\x96\\+Z\xb7ՅlH\xbb\xb5lڰ\xb5w0&\x92\x95\xc53\xa2V\xcf^\xe2m\x0e\x90\x93C\xd7Q\xc1\x15\x82\xa1\xb7p\xb79\xd8t\x05b$K3\x05[\x1e\x8e\xcb\x05j\x16̣t\tV\xba\xd9Ȭ\x90`̖\xc5\xdd\x18\x88\x06\xfe\xc6$\x16I\x17\xdfg\xa1iۡj\x9a\xaem\x8d\xa6\x01U\x1bt\x19\xe87\xa3o:\x8f\xe7\x8f߬\x19\xcd\xef\xbfs\x06\xf3\xf7\xbe\xfa˖Q\xab{\xb4\xe8\xbeh\x85]\xb4b\xb0S0\x99j\b֏Jea\xa8\x89x0n\xb95\xf0Tz\x19Y2\x1e\xb2K\xd0\b\xc7*\x81P\x9a\xa06\x99I\xa8\x18ߜ\x93\xb3\x829fq\x1bb\"\x99A\xc2\x13坤Q\x9d\xbeJ\xfch\x10\x14\x19k\x13̷\xf1c̃\xf1\xc2՟\xe19\xdau\xd8\xf3\x18۰\xa7=\x9e2\x14\xa1eE\xd9Si\xb4*S\x988\x80\x8c\x87\xd1\x17&q\x01\xd3[r\x9e\x89\xe9CMSE\xf1\x98'\"?\x9ff\xd6\xc40)y>\x8fB\x8a\xb4\xa0\xdc:k\x01a&\xa7U)\xc6)~\xce\xdf\xc2\x1b'\x96 \xe7]K\x98C\xad;\x8f\vT\xa3\x14\xff\xda\"3\x16e\xe3_\x9b#\x93\x1b\x86\xdd\x12\x95\\\xbf\u0be2\xe0<vM\xd3\xfa\x1c\xf7u\x12>\x85\n\x89\xcevl\xeb@\xf6\xd5\x10\xfbj\x88\xdb*\x9a\xe0R\x9a\xd6\xe4Ɇ]\xf3\x90'\xeb\xb1\xedQ%ʮ\x9dѡ\u2fe6~Ȏ'j\x93\x1fyM\x90\x97\x0e\x86\xad.\xd7C\xe7\xb3[\x10V\xb5\xe0\x14\x19\xbc\xe0\xad\a\x0fUL\r\xd6\x1b\x87\aMc\xbc\x18\x19F9ϣ\x94\xec\xad\nC\xba\x06\x89l\x9b\xfc\x1cd@\xaf_(\x1a\xe7\r\xd6W\x9c\xfa\x05=\xde|\xde\xfc\xbe5\xe9F}\x93\x00\x1a}\xfb\f\xbf;.\xf3ԋ\xa0h\x03D\x87&y\xa8\x1d\xa6\xefz\x00/\xdf';\xad3\xd1\xc8\xfa76\x84R\xd5\xc1\xee\n/\xb1\x1d\xa8$\xa3\x83\xa4K\vM\xc9W5\xec\xdf\x1c\x90\xd5Ͳ\xf3\xa6\xd8\xc9~\xfa\xc1?\x85()\xf1շ\xc6\xcf\n\x85\xb5nq-\xc1\xdcen*\x01\xf4\xe4\f\xec\xe2\xdc\xe0\v\xd3궭\xa0\xad\xfa\xa9\x1b\xacR\xb83㑲\f\xc6/\x8d\x9a\xa9\xeb_\xa3\xe5\x9a(\"\xa4eD\xb3\x9d\xfaj\x9d)\xac]ύ\x92\x80\xd6\xc7/\xd5c\xf5\xc43g\xe9\xa1H#\x06\xee\x9f\x14\xa9g\xfd\xa6\xc2\xf9\xf4\x0fN\xb0\x1f\xfa\xa4G\xca\xf1&\x7f\xca=<\x00\x85n\x91\x96\xf2\x80\x92\x15F\x9c]\xac\xff\x84Q\x9d\x9cm!\x0e\xf0\xd3\b\xeb\x92?\xa39\x88\xe3Q\x1e&C\x896p\x03\xbe\x06\xd5h/7\x02\xac\xf6\xf6S\x06\x83)N\xd9\xe1\x18\xf3\vAlg\xa5\xebcә\x87:Ǻ\xe9^\xa3\x04\xe8\xb9\xd0wR\xe3!\x14\b`\xb9\xb3\f\x8c\x88\xaa\xfe=\x85I(v\x91\x00[\xab\xb3N\xcbr\x12\xa7w\xc4\a\xf8\x8cy4\xbe\x86\bj\n\xc3ᇔy\x89P\xa6\xe9\xbeX\xe3\xad\x048\xd6\xce\xce\xf5\x0f\xa2\xe0\xf9\xe8p\xaf\\>N\xb9\x1cwI\xf2\xc0\x18\x00:3\x98ᑍ\xe9\xa6MZ:w\xe9EJ\x9e\xf3'\xf2\t\x0e5\xc6\x171.5\x15\xe7\xc1x\xd6\xec\x9aj@\xb7\xb9\xc5+\x14\x997\xf18\xcfT6&u\x8c\x7f\x00u\x9d5\x84\x8f\x94\xb5\fѨ20ܹ\x9c\x82owi\n\x18[\xc6ժ\t\xb5l^2\x8cWp\t\xab\x83:\xd4ho+\xa9\xa4\x95\xdb\xe7\x1d\xbc(\xda&\xd1 \xd24\x00s4\x1e\x15.\xd1\xdb\x05\x1ea\x17\xc0,\xb5\xce\ao\x96\xf1i#\x14k\xbev\xe9\xd8\n\x8e\xd2\xe7R\x9fȧ\xcdY>\bn\x17\xb30\x1d\xa2\xbd\x98$h\xeb\xb1\x12\x16*9\x97\xc8\x1eB\x95\xba\xf4\xef\xe2\x19hJ\x9d\xa5\xf0)\xe3\xf9Ǻ\x1c\x19\xa2@J\xc7\x14E\b\x04\x06g\xce\xc8\x1b9\xdf\x1d8\x14\x06jYes\x8b0^\xe6aZ\xd0P\rB\x96^37\x11\xb6!\xbf0\xf1#\x11\xe8P!\x90g2܋\xa5\xba\xae\x14Չ{\x80\xc6\xf1$z\x10\xd6^<\x92\xba\xb0\x8c\x02\xc3:T\x91n\xeb\xc1\xb5\x19\xb1\x9e\x97(\x9a!\x86\xe2\xc4b&\x03\x86v\xfa\xd5\r\xc0Ŀ\x92\xa8\xfc\xb5a9d\x10')n\x84OOr3[e\x04\xb14\xb7?\t\x1f\x19>,\x7f\x9b\xfe\xda`\x81\xc9c_l\x05\x15\x023\xf9\x80\xcc\x15\xb9\xb1}\xed-\x05\r\x15\xd88A\x1d\x92\n-\xa9г\x12\x8e\x04\xbd\xddI\x8f\xc4\x17.xh8\xc8\x06]\x91+\xf2\xc96\x05zѬ\x01\x02%\xaf\x1e\xf4\xd5\xe7\xbc\xfb(\xb5=\x8d\u05f66F\x89\xfb\xb6\x16m\xf7\x14\xe5\x93d\x10\xfc\x12\xe6\x98t\xb17\b\xd2\xe8\x9e\"\xc41\xc0R\rw21\x18h\f\xab\xe7\t#\xdb\xd8,J\xc2z[s(6n\xf0ԨG\x15/\r\xc3\xff\xf7.\x9aO\xc1EC{\xb1\r\xf7\f\x9d\x99\xde5ӻf\xb6\xe4\x9a\xd1d\xa5\v\x1d\xbah\xe0X\xd6C\x9d\xbch'\xe4R ,=\xd5wb\x10\xc4#\xd8\x7f\xcc\xf6\x12\x01\a\xc4\xd8}8d\xb7\xe8\xd4׀]q\xba\xc8\x16E\u0089D\x14\x9b@H\xe6\x1ek\xa3W\x9b5\xc8yFz\x16\xeb8\xe5\x90ј\x16sCs\x10\xa9!\n\xf3\xf2:B\xfd)\x9eE\xedt\\|\xf1\xadh\xab+T]\x91\x1a\xe83\xb68/onO\xcdm\x00\x1b\xb8P\xd824}ް\x97\xc1\xdb\xf4&#cK\x1e\xbc\x92X\xcb\xf6A\xd3\x16\x1fn8\x16Lj\xbc\xb0\x01\x94\x1d&\xe6(ߞ6J\x81Z@d\x9aT\xd5\xd4ar7Q\x8azGC0;\xdaX=\xa7\x05\x13\x1f8\xd4>\xed\xdag\xd3\xfa<D\xe3\x83\x06\xa3\x98y\xe6\xa4\r\\!\xb4\x9d$2\xb2\xd5\x15덌\x95\nU[#\x7fa\xc0C~\xa0\"\x8e\xa4\x1d\x8143\x80$U\bT\xf5K\x1c#/;yjp\xb3\x06\\G\xfa\"|\x99\f\xa3\x83\x9e\xed?۫\xf9\x9c*༗֛x\xf70BtI\xe3x6\x19H\xa1V\xe4\x15<y\xa9\xaaB\x15\xe90`\t\xb5\x1c]Y\x86~%\x81;_H\t\x99\xddg\xff\xdf3\x90\xbc\xcb\xf1\x1e\f<}V\x06\\f\xe5\x92\xd3\xf4tGX\x05\x94Q)\tC3\x89\xc71ڋ\xc7\x18\x87E\xf2vƴH\xca%\xda\x18epk\x9es\xe5\x00\xce~E\xfc8P\xc9\xf6\x81\\$\xe5-[\xa8S \xba\xbfEy\xc6\ta\xf2d\xd4\x01\x02\xb6\xe1\x14\xbd:l\x9f\x83\tw\xf8\x1a\x98֡\xd4HP\xa9z\xbe_9֞,\xfaב\x86\xac\x97\x90\xec}D\xa4Ǜ0n|{+\x99\x9b\xd3\xe2\xd2+\xbc\xbf\xd2g\x87\xf37\x15\f\xb4\xed|\x9dVژ\xb4*\x01\xd3&0V\xb1@`\xae\x81\x9c\xc5\xe3\x87\x11\\\xb3\xe8\xa1\xfc\x96ch\x1f\xa6\x05\xff#-\xf1_ \xa4\xcc\xf4\xd9 \xf9'\xd7h\xfc\xea5T\xb6\xac\xca\xce~D\xa3E\xba*\xcf\xeaա\xaaa\x0e\r\xc5\xe8ڎ\xdd\xe0\xfe \x02\xe7\xaa\xdemg&A\x99#ҡ\xdaֽQp.}p\xa2$9Q\xe1C\xe3H,\xf0\xf2PҬ\xf1r[@\u0605\x1f>\x88g\xf3\xcb/\xa7E\xbbi\xc3\x1dM&\xf7\xb1\x10]\xb8\xc4\xf8\xea^'\xc4\xe4{x}\xdd\xd7$\x89\x9a\xbe\x16\xd0\xe7Lh\x05|\x94\xba\xd8\v\x8ec\xf2\x0f\xd3_\f\xc20\xbb\xb6\x11\xec\xf4\xdc\xe8d\xe3\x8e)h\f*\xa3\xa2\xf2\xfb\x18\xe4?Ɛ\xcb0\x8dHv\xd9\x06\x01H\xa2\x873]\xacg51hn\xeb\xfat\x89\x9ba\x95&\xddV\xad\x94\xa5\x1f\x84R\x87+\xbb\x8bY\xe9ۗ\x82$*(\x01\xd97\xd0p\x01w\x9a'\x8b\x9b8\xadꞓ\x1c\be^W>\xe5\xf7\xba\x01\x84~W\xac\xcbF\xd1\xe07T\xf6\xb5N\x06\x13\"练\x9f$y\xb1\xb3\x8b\xad\x8fL(ħ@\xe9\x037Qpyxԣ\xe6\x8a\xdc+\xcf\xff\xf1\x03pN\xcdx\x15\x0eB\x8c\xba\xb9}0Y\t\x84\xbfM\x01\xb73g\xaf\xeaW\xef\x13%B+\xe2\x9a̸.T#.ac%\x9b\x92\x05\x9b\xfd\xb7\xa2\x18[\b\x1dpu\xd0\xd2z\x17U\xe1\x86\x10hM\xbd\xcdw@\xb6Mc\x903.2#\x01Ĉ\x97S\xf9\x8a\r\x8b,\xa5t\x1a\x06R\xb0\xe9\x95j\xb6`\xf2&7\x1ap,9\xfd[\x02\xc8\x19\xfc\xcd?\xaa\xce^\xe8\xf5Y\xb3\xe6Ȯ\xa2L?+\x12\xe3\xa1D=\xf9\xe9\xc9OO~z\xf2\xe3\x16\xa2E$\x81\xb6\x99\xfb\xaf\x12\x82S\xed\xa2-\xf1\x1b\x8a\xf6(%Hn\x98z\nJ\r\xdeM,VL\xe7\xd8B\xbfGC!\x9c\x90S\x85\xe4&\x7f\xbf{wrdy\xfa(\xd7\xc4\xfd\x96.\x93\xb0R\x8d\x02\x1a\xd3\n\xceɌb\xb5\xcfL\x1a\x12\xca\v+{\xca\xda\x19\f\xff\xac\x8d\x1fvK$sj\xe4ޤ\xe5\x10\xa3Q\xb1\x1c\xb7`f\xd7脵$\xf5\xc1\xd1B\xb9\x833\x95\x8f\x04.\xd5\n\x85\xa6\x00+5\x1ci\xdf\xda\x1a\xf3\xe3᱑\x8c\x8f\xe2\xe2}\x87\x83b^\f\xf0Må\xe0X\xffH\x95`\x02U\xc6\xf38\x85\xb3\x1e\x8d\xaeҫ\xf4 \x80o\x06gG\xac,P\xbc)\xac\xde\x14o\x10\x1d5\x89z\n\xab\xf5\xdf'\xf8\tz\x87`D\xae#'y\t\xfb\x14\x84I:s\xbf\xe1Y\f\x99_\x89\xdf\x11s\xc1\xe4\xd3\xed\x94|<i\xf8Ӑ\x9a\xce0X\x98\xa2\x7fF\xd2\xcd\xc6꾃{19\xf5\xc6a\xb7e\x93rN䲒|\x80&\x9d\xfb\x90k\x9e\v\v\xbd\x8c\xe7\xc0y\xd2b\x91G\x06\xa9\xa7\xcaM\xe3\xa2\x03C\xa5t\xe5\xe2嶍\x06\x8f\xc5}\xba\x81\x9f\xf5\xb1\xc4#\xe3\x05\xee\xc0\xacy\xbf\x05\x92\xd0;\xd4cu\xc2\xd6,.\xf0\x86\x8c\xfd\xecn\xf5\x8eت\xd4u\xadн\x04\xe8\xd8, \x82V\xc8#\xaa\aXL\xc2\x17l\x96\x93r\xce\x02\xfd\xa6\xc6\x14\"\xf6\xd6\v\\\u074bx\x16'a\x9e,\x1dtI\xd3\x12ɔ\xea\x92!\xbb\xb0N\xf3.\xfc\x8a\xbd\xe3\x1d\x00\x16}\x17\xa9\xd2\xc1x\xf8\x96\xcc\x10\xf7\x9er\x1fV\u009eL\xfc\xf4\xfa\x9dU\xec[N<\\c\x87\xe0\x1c\x1e\x8f\x82w\xc2_\x85:.%g\x12\b\x87j\xf1!\x8eW\xb3\x1c\xf68\xe9\xaa.\xbc=\xe5|\xb4\x04\xd7\xc49b\x90>\xe6Y\a~a_#«\x91B\x93\x9c\xe6P-/\tJP\xcca\x14 \xe1\x11$\u070f\xf2w\x17i\xc1\xf3\x96j\xbe\x9e6\x9b\x8f{T%\xe5\x04\xf0\xd0\x10\xb6\xe5\x197\x01_y\x8dm\xaa\xfc\x81Y^\x05\x1f\x1d\x05HL\x9e\x8d\x9e)Z\x9c\u0530cu\xa7*\xa9\x84\xf1\x9fݕ\nޢ\xe5\xee>\xc6b\xb3\xf1\xb4\x01\x86\x96:\xb0\x81U\xdd^4{]\\\xdb\x1f\x95\x9db\xc4i\x9aXSȐ\xac\x9c\xefL\xa8O\xbc;\x7f\xed\x7f\x9b\x95[\xbf\x0f\x87\xab\x1b\xdfj\xe7\x8b\x01\xb8S\xef\xb5wF\xfd\xc8\xf1\xddӢ\xc31\xd6\xef\bi\xa7\xf3\x8c\x0eLU\xd5r\x1a\x95\xe2\xda\x14\xe8<\xf3\xc6V\xe4\\\xa7\x9c\x8d\x95\xeb\xec\x87j\x8eZ\xc0\x97\x908,ڽ\x8ed b+\x10^\x8c\xc8n\xe6Qf\xf3,\xc9n\xfc8\x8d0\xda(D\x88\x16\t\n\xf8\xe9\xf8\xe0h_p\x99\nEz\xf6oTg\xfb\xe7\xf0\xfc\xcd1\x86\x030\x8e\xea0\xc4\xf2\xe2~\xc6\xedC\x1eVx\xc38\xfa\xeaR+\xa0\xe0\x0f9\xca\r\xe9\x7fm\xe8,))\xf9\x94\xae\x9e\x11G\xe7\b0\xa7jn\xb7c\f۞\xf1:\x0e\xf1\xd3\xe5\xe5ُQ\xd9\xe4\xecu\x1e\xdb\xfe\xdeT\xe5E\xe8\xf2\b\xd84\xf8\x11\v\xa8\xa8\x84\x97V\xe8q~\x9f6\x02*\xca\xe1\xce\x02)\xff\r\xff\x1c(\xb9\xcd\xd8\r2\x84\x1c\x1a\x05\xff\x05\xd2\x12\x96\x88\b\xafaݕ\xec\x87z\xe0\x15\xf5\x85BlJ\x8b\xfc\x13\xec\x0e\x83f\xc2\n\x86\r~v\xd3\xceC\xde`\xe9\xb3\x19\xc6[P?\xf2\x99X\xa1 \xd1\xdcG\xbc\x1aX\xd3\xf7\x9e\"\xcc\xd8\xf9*\xefl#\xf1\x1d\xfb\xe7!2dS\xf3\xa5+\x19α(\x94\x86LC\xe3\xc0\xff\x0e\x90y\xac\x8d\xe7\x15\xc4<U\xd9\xd1\xfd\x82\xa5\x15J(\x8br:\xa9e\xa2\x02\xc0/\xf0M\xac\xe3\xf8;\xa9Z\xd8T \xb1sx8\"\x8d\x8d\x90h\x96\xf9\xe8$-\xdf\xe6\x17&|\x15\xe3\xf5|A&\xf4\xbbm$\x95cge\xf8p\xc5J\xfb\"\xe3Z\xb6\xe7d֦yo\x1a?s\xc2*\xc6\xf6i\xb3\xeb.\xc7r\xe3\xa8.J\xe1/\f\xc4I\xbc\x1d\x00\x1b\xe53\x9c_(\x9d\xb4M\r\xae\xbd\xcf\xedZ\xaf\r\xa8\xe2\x89wa\xf8\x81\x0e\xe6\f\x9501V$Hb\x1f4\xe8.fi\xd5LMho\xf7\x187=\xa61\xaes\x84W\x97\xb4ic\f3\n0\xc6\xc0\xe8\xbf\v\x05\x16&fM\xfc~\xa7\xa0`\x13l#\xb4\xe7G\x1f\x90\xa3\x90\xe2\xcag\xb1\xb9\xa2:dS\x05\xae\x00\x1a[\x97\xef\xbb,\x00\x1d\xe2\xe3\xf9\x05Z\xaa|\xb9:\x87g\xfc\xc8\x1a\x86\xe1\x05\x9c\xe9C\x0e\x0e\x04\xb1\x14\xb4K\xfc\xd7m\x96\xbd7%\xb6\xb4\x05\xa3\xc3(\xf5\x97\xd58\x9b\xce\x11\xdcу$\x0e\v?S\xa1GR,\x94RU\xc2\xf9\x1c\a\xacp\x99\x11\xbe\x0e\u0383\x82\xfb\x154\x1d%\xc7ǩ\x94G\t%\xb1\xbf428'L\xe2\x8b\x1cAT\xb7v\xeaN\xfdC\xe3\xef)\x899\xbc\xce\xee\xaa \xc5\xed\x03\f|\x18æ/u\xca\xc9pD`\xf24\x93\x0e\x84\f\x8b\xfe\x02s\xe9 \x91ӷ\xb8\xaa\x03\x97\"R%gP\xf8\xfeI?ܺ\xf0\xed\x17\xb8W\xf0Ke\xd4\xd5\xfaT\xb5\\\xb1F\xf9\xe7\xc0\xe5\xe5\f\x01\x96)\x16L\x84E$\x1d\xa2f\xa4\xef\x15\xef\x00\xe9,i\x16t\xbb\xd8\x03p s\xae\xd5^\a'n\xce(\xc0\x83\xa5\xf6Ml\x165耧\x1bZ\xc3A:\xb98\xbc8i\x1b\"\xb2\xa2q\x05q\x83Z\x92Qi$\xff\xee\x1a:澵\x05\x1b2G\xe2 fb\x98\xe0\xdd\xfd'\xb2(\f\xb5\xaa\x97\xe5\xbb\r\xe7\a\x8b\xf2\xf6(.\xc6@\x03|깂\x85P\xe3\x8ai\xb4\xfa\x85\xe0\xf0\xa7\x03\xb8\xec\xd0\a\x97j\xa2\xb7|n?\xf5\xa9\x8b\xa8\xf0\xeb\xf2\xfe\x0fI\xf3֟\xe9\xed\xe4\x1b\xdd&\xd8\xce\"\xf6\xa6o \x9f\f\xa1\xb5\xdf\x10+\x1a\n\xefՉjK\"6\x110\xe7uǇ\xca\n,}\xf7\x043X\xa6X\xda\xc9\r\v\xe3\xcc#\xee;\xd6m\xfe$\xb1qs:\xdf\x7f~\xf9'ː\xfeg\xcd>u\t\x16\x1d\x92\xcb\x12vC\x96%ޑ&\xc3\x1d\x0f\xe0o\x8b0ၟ6Y\xb9\xdc\xc9\xd4\xfbS\xab\xa4fr\xaa-*\v\x86ՓOQ\xbe\"\v46\xd5|&\n\xe9\xb3`\x17Ħ\xbd\xf6\x01\x95ܫ\xcc济\xa4l\v=N\xeb\\\xac閩M\x80\t\x13쟓\x1f0\xaf\x8c\xe3\x06C\x92x`;\xe2\xf9?P8xɷ\xdcR\xee0\xd5F h\xc2T\xbbMva\x8cX\x03\x0e.\x83\x12\xf4\x8a\xe0\x0f\xdfqŜo\xbe\xfe\xee\xf9^7y\xe5\x03\x9a\xe3\xbb\xc6?\x10\x91\x13\\)<\xb8r\x1cx\x85),\\߰\x1a)\xdc8Xi\xe0\xf2\x8b6\xdb\xfc1w\xb8\r\x97\xefR\x8f\xa7\xe7\xdf=\xff\xee\xf9wϿ{\xfe\xed\xf2\xefyϿ?I\xfe\xed\x8f\xf6\xfb\xb2\x98\xf8_\xa3\xe5ev浛 N\xa9\xc9\xe1F@16\xed\xa0\xa5AJ#\x86V\xb8\xb1\xcdV\xa1q\xa3W\x14\x9fy\xcd\xcc\xf2\x05\x89%\xf3#\xb4\xf4\xf5c\x9f\xa0~\xece\xadDk\xa5N\xec\fAg\xf5\x0e9\xe5\xcb\xd0L\xac\xaaŊ\x1dL\x1e\xda\xf1\r\xd4i$E88\xdcB5\xb3\xe20\x18\xb0\x87N\x1b\xb5i}\x88_ǰ\x94˱\xafV\xb3~d\xfb_\xc6\xd66\xb0\xb9X\x8b\x8d\"\xad\x88\x7f\x00\x8d\xfe\xb8\x99\\ܪP~K\xc11Mt\xdf\x02sA\x11e\x14!\x052\xcd\x05M\fw\xf0,\x8f.\xcaln\xb5\xbfe\xef\a\xa24Z\x86\xcf\n\xbeap\x8d\x91\xa5\x18wZƉ퍈\v\xc1\xba)\xe1\xc0,RB\x15u߄;D\x9e;\x04\xb5\xc3\xecv\x95A<\x0e\xa5N\xa4\f@\xa0\xb8\x14:aŚ\xaa&\xe1+\x1a\xa3\xe6\xc7\x15\xef\x119/\x9ea\xc1D\x90$\xb0\xbe\xf4\xb4D\xfaf\x8d\b۩R\xc3bmUc\x90A\xba3\xc0\xa0\x02*\xe4\xc6\xf9\xad\x84\xb8HG\x05\xff\xb2+\x0f0\xcc\x03\x9f\xa2y\x067n9\xe2\x18\x9cnK\x8b\xae\f\xbd\xaaEg!\xd1T\x1c0\xff\x1c\xea\xfd\x1e\x92\xa3d\xff+\xf3\x88~\xe8\xe2\xcd\x11?\x1a\xc35\xe3i\xf2l\x8a\x1c3\xff\x96H\xa0oش\xc6*)\xbaa\x03hC\xed]\xb3\xd6겂\x03%\xaf\xc9Quc\xef\xe5\xf5\x91\xe0*&\x96\xdf\"[\x94\xe3\xccD\x19JK\xcf\xc1\xa0۶ \xfeg\x8f\xff\v\xde\xf5F\xba7\x8b\xcbs\xf4\xba\xfb\b\x9fz\x16P\x1d\v\x1d\xb1\xc9eN\x05+\x15\xf7\x8b!\xbc\x11\xb3\x05\x16\xcc\x0e\xec\fI\xec&\xa0\xd0Q_\xc1\xa7\xaf\xe0\xd3W\xf0y\xda\n>s_,\xc3\x05\xfc\xea\xdc\"\xb9\xb9QJ\n\xd7d\xdb\x13\xc3Q\fa\x15\x87\f5\xd1E]\xd2\x14\a\xc7\xfc\b< \x8b\xac\xb5\x04\x042o`\x11\xf3U\xc4\xf0\x84\x93\vU\xd0\t0\x8f\xfdY\xf8`\x13E\x01\x82YVR?A\x92\x062C\xc1P8\xcc\xc6\"\xf6\x9e\x14NQ\xdbt\x7f\xa2)\x11\x8f\xe2O\x8a:b}\x93\xa2\xe4b\x8b\x1e\xeb\xe2Β\xf0\xd0%aq+\x15\x9c\aj\x86\xe7L\x19\x1a'*\xcfU\\\xe8d\xd5\xf4U\x8cK\x8b\x05\xc8M\xb7\x1fo\t\xe0\xac\xf8\x14eu\x800\x11\xad\xccC*\xaf\xa5TO\xe2\xacՙ}\x9c\xa1\xf35\xe0u<G^\xeb\x9dK\xad\x95'\x90\x1d\xe701S\"Ś\xaa[\x86z\x9f\x18\xe1\a\x0f\xad\xaeCJx\x14\x1a\x97\x86Ᾱ\xc9$\xbe\x8b'\x91\xac\x93\xdeg\xd1lB\n؇\x9f\xb9\x8a3s\xaeŌ\xe1\x8f\xf8\xe8|_\x11$T\x18\xd0Cp\xbd@\x90de\xdbt\xc7\xfd1v!\xf6\xc8Ko@\xd8\xfa\xf4\x0f\xd0j\\K\x97Z\xd2\xc0ykCʥ 9\xa2\x83B\xaf\xe8\xb5\x1fm\xd2}\xeeBNZ\x82/\x996{\xf0ɏ\t>\x89\xfc\xb6h\xdc ^\x93\x8d\x14\xafi|\xb3`,\xe7}V\xf9\x86c\xcex\x1d\xeaa\x0e\xb5浿\x85\xd8lK*\xe9\xa1/{\xe8\xcb\xed@_V\b]gq\xf7«3\xb8\xcf[\x88\xbb\xa6\x86\x8a\x91umIצ\x9f\xac\x7f\xd4\t\xa8\xfc\xee\x1f\x8c\xae6Z'\x02$\x91\v!0\xa2\x82Vo\xb6zsI;h\x04\xb6z\x9d\x85\x93\x1f\xa4\xcc\xf9IzC\x95\xb3\xebө7\xaaU~\xd3 }!\xd5N\x1f\xaa\xda\xe9p\x84\xf9\x05\xba\xb5/\xd1{9ňw$\xedT[K'\xd6Ie,\x1b\xb3\x81\xbd\xed\xe8\xf9\xb4;i\x8eGn\x0eG&\xe7\xb2\xf8\xcdV\x8c\xcfڍ\xa3\xd3\vI\t\xb2|O\a\xbf\\\xb8\xaf\xdbpi\x16c\xf0\a-w\x1e\x03\xbcS\x1b\x02\xa2\x01@\ao\xe7Q\n\xa4c\xfc~\xfdxZl\xfd\x05+\xbe+w\x9e۴\xde\xf8\xfa.\xc5M\xe7K\x9d)\xc32-Е\xcaڨ\xe3\xe2~+\xb8\xec~\xac\xe0i\x11U\xba\xdf\xca\xd5\xf3\\\xa9\x15\xf7\xcf\xe3o\xf5lC\xbd\x95\xc1\x83\x89\xd2lqs[ŤI\"\x8eYI2\xc4\x05q\xeb\xc6M4쌁\x1f%`\x8b\xb4\xd9\xf8\xb9\xbeT\x9ft_v\x16m0T\xe8.\x8e\xee\xf7\x05\xf3t\x88\xae\xb1\xa1\xd0\xc7}\x1a\xd3\xfeW\x9co\xd0\xfepÊ\xad\x0eԢ&\xf6q\xe6@\xf9d9\xd4\x18W\x12\aî:\xac \x86\x1eL\xdc\xfb\xe5#b\xf3/\t\xd3>\xe1\x18z%\xf0\x8a\xb3U\"\xf4\xe9S\xe8X\v\x93\xf9m8`\x11\x88S\x0e\xb4;ׄ\xf5\xbf\xc5\xd3@v~\xca\xe6P\xc5\xe2\xec\xc0#\xd2`S\xab\x1ft\xab\x8a\x1c\x85\x0en~\x1b\xa4\x87x\xdc>\xd1\xe9\xf4\xd5E\x97P8h\xde:\x87\x19\xdbn=\x81\x823\x05\x1b\x9d\xf4\xab2\x0f\x05D zp\xe3\xb8p\x98\x92\x7f\xf8\xd8\x10\xabtZl1\xbb\x17\aƃ\xddz^\xefƓr\x82\\r/\xf2\x1b\v\xffJnR\\\x1dw\xb7\x9e\xf2\xf3\xd4k\xdft\xe8u\x1d9/-\xa4G\f\x987\xe1\x14\xbfq&)+\xf4\x98PCp\n\xba&\xbc&\xb9\xc4\xffT\xf5\xd9\xde\xf9\xd4;\x9fz\xe7\xd3'\xe1|\xba\x8enû8ә\xe2\xc6A\xfcɸ\xa1\xf4\x90\xd8\v\xc5\xf5\a|\xf2\xbc\xc8\xf0&.\x88\x0e\xa2S\xa3\x8a\xb9\xf0'=I~ss\vĩU\v\xb4\x95\xf1A\xbf\xe07\xcd:\x8f]ß~\xd4\xdbd?\r\x9b\xacm\x901\f[\xd9b$v/\xa18\xe5\xedk1t\x10\xb6a\x985'\xb8\xb7\xcb\xf6v\xd9\xed\xd8e]\"ו2\xfa\xad\xb2\xce\xe3\n\xdb\x01-\x9bև\x03\fV\x06^\xc1b\x84\tP\x14\x1f\x8a\xcd+\xfd\x8c\x0e;\x10\xb8y\x88\xe8}\xea\x923\r\x12#\xafhό\xc3\xcb \x13\xa0Er\xd1\xea<\x9a!P\x81*\xb1\x88\a[\x14\x85\xb6\xa4\xa0\f\x8b\xf7\xc5~8\x99a\x15\x1f\xa0\x92C)\xe8\xdc\xf2\xf2\xb7\xacEP\xe5\x83+\x16\x9d\x19z\xec\x16\xfd1\x95\rW3\xfezp\xec-\xdcO\x8f\x86\x8c?\xeb:7ҥ\x89\xfb\xa5\xb7\x94씮\x14+\x1e\xbd\xa4\xebt7o(=U\x1d\":\x89\x84\x1b\b\x1bY_\x80\x13\xfc\xd5\f'8ƀ\xc1T\xc7\xc0\x8b߿\x0e\x8f\xab\x8a\xf0\xa0\xfd(إ\x12[\xf0ST\x8e'{\xbdJ\u05ebt\xbdJ\xf7ɩt!]\xeb\xd1G\xd6m`\b\xebt\xb77\xa6\x165B<I\xcd:\xa7\xdcs$s9\xd3`\xa7b\xa8T\x19\xa6\xe7\xca\xf2\xf7\t\xa9u8\xf5GktH\xd9ۊ,\xd0\xf6\x80m\x89~f \x0f\x9bK橅\xc6R\xbf\x06\xd3\xc8ɱ\xc6\xfbF\xd1I\xf4\x99\xba\x96\xd7\xf4\xfdKe\xfd\xaf\x83%\xad\xc3\xc69\xb5^\xa3\xd4\xe3\x81\xc2\x01SN\xd0\x01\x96\x83 \x82\xce9x'\xa9\xfakԉ\x87\x1e('H\xc3\x18\xe41\x13a\xda,\x1c\x85\xe3?!\x863Y\xa0\x99>\xc8\x17\t\xab\xc7\x15q\x83\xfcI\xb0\xa0G\v\x1cх~\xe1\xe4&\xcd\xf4\xcf\xc7TH\xaf\x11][>\x83\x85\xa5\x90os\x9f\x04u(\x0f\xd0\xdf@l\x0f\x87'bb\x01[]\b\x9e\xb3\x1ep\xf4\x80L\x81L\xe5V\x9a2\xdd/J\xb6\x8b\x92\tW\xa1\x8aKb\x16\xe3\xdb,\xa3\xd2w4o\xea\x17H\x0e1V\xde\x17)\xe1-\x17\xd7ꞳK\xcck\x98eG\xb0RjA\x94\xb0\x85\xdd\x18\x8c\\J5*\x90\xfb̰\xd3\xfb\b3\xf3T\x9dO\x9d\xf4`\xba\x9dET*\x02+\xefI\xb9\tkKL\xbcj\x815;\xdcxԁz^\xdb\x1a\xefrQ]\xc0\xd1\xde \x90h%\xe4\xcc0FX:\x10}%\x05\xbe\xbc\xcdɉIK\x91ȇ\x95l\xc3(|(|`\xb8\x1f\xb4\xbe\xda\xe1\xd9]\xed\xe8\x9a\x1d\xd0alH\xa0\x0e]\xe6d\x98\x9c\x93\xd9\xe8]zrlF\xf7\xbd~i\xb7\xd83\xcby\v\xfd\xab\xd5\fs\xf6\xaa\xb8\xbb\xb0\r_\xf1\x99\xea\xcc,\xe1%\xa8$\x92\x89\xec_\xe3\xb6\xc7_R\xce\xf4\x8e8{\xdap\x82i\xaa(\xa6\xcc0\xa5\xb8\xb4\x8f\x04z\xe8\x06\x1a\xa9\x94n\x93\xc83\xea*!\xf8\xa0l\a\xb3\xa1\x8dF0\x8e\xc2B\xa3\xd1\xca(0E\x8a\xa5\xac\t͚\x86\x10\xa9\xa9\a\xbbT\xf1}\xb2\x88$Z\x84\x05\xac\xbd\x81\xc5\xfa\xe8J╓\x14L\x84\xbb\xc3\x1a\x94&\xad\n}\x9f\xa5\x9e\x1f\xc9w(\xc3\xd1L\xba\xb238\xc1\xe8\x9a]ID\x0f\xf1<\xfaw\xceyl\xd8\xd0X\xffT\xab᪇\xe9aB\u0091\xeb\xc1KaQ\xfe\xa4j\xed\xfa\x8b\xe6\xbe\xc6B\xb9䚽\a\x02\x93\x95fqYeg\\n=\xaeѣ$9U;\x17\xc7u\x89\x18\a\xf4\xf2\xba\x81I\xb6\x9b,L\xc9\xef\xf1\xf6Qu\x1c\x96\x8e\xe8\\P\x0e\xf1v\xc6\b\xba'\x86\x93y\xe2\x8d\x16\xb30%\akx\x8d\t\xce\xdc\x0e6lB\xb9\xf8X\xcfU\xe0\xadY\r\xa7Rĥ\x9em\x03\xbc9\xe6\x19ֿ\xb5{\x9d\xc7\xd1t\xcf\xc9C\xb4W\x03ē6ݯ\xf1\x15\x98\x8cB\xeeUK\x15\x979\xaa\x84\xaf\xd0Q<\bޥ\xef\xd3\xec>\x1du\x84\xf2Sb\x81s\x84\xda\v\"\x87\x14\x13\xdb\x14~Pma#}\xaa \tk\x00&\xba\x96\x90Xƥ\x00\xa9\x826ǔi7z\x18'\vf\"\xa2\xba\xeci\x93\x12\x86\xf8\xa7q\xef\xb7ݺ\x92\xcf;\xf3&\x9c\v&F[B\xec\x01\xb1\xe8\xed\x05\x1b\xda\v\x1e\xa5\x979\x97\xb4\x83\x8ev\x14F\xb3,=n.(\xe0iDV\xdfB\xe0A\xe0\x16\xa6,^L\xa8U\x81\xb5\xad)\xa6P¬N\x85iV\xc0:\xb8\x80\x93곹\x90\x81\xa9R\xfeW~\x85\xf2w\b\xd2S\xa2\x8fڞUw\x16+I^\x83\aR\x9e(\xcd\xe0\xfe6K\x8c\t\x1ce\xfcSRox\xb8d\xbd\xbcF\x14\x90<\xba!\xb3\xaa\x8a\f\x02\xeaP2\x8f\xec]\x95\x1f\xcbU\xf9Zv\x8d\x14\xd2m\xf8\n\xd16һ\t{7\xe1\x96܄\x8a\bu \xe5Z!\xaa\xd3\t\x16\xbf\niP\x8d.\x875\x92\xf2\xdf\x1a\x11\x88$RۀB\xe1\x9e\x01\x1c\x9d\x1c%;\xf4\xc2\xe3Y\x8e\xcc\x1d\xfa^\x99Q\bں\xf2\x85\xb7\xe7\xda\xfc!c\xb0\x88\x911\xa0\xba\xa3D\b\x8e\x9a\xcd/\xb5&\x8aJ\xbc\x87b֛x\xc2:\xb9G\xac\x1f\x98X\x94\xa0\xfaq\xb2\x11\xd1?Ig\x7f{\xbe\x1d\x83\xc4ie\x84+ܐV\xcbs\xa3ط\xd9b\x93\v\xcdtG\xcf\f\xb7I\xab\xbbL\x91\x81j#\x06\x13\x13o\xf2\xee\xa2\xc9H5&\b'\xe1-\x88Ԅ\x8d\x84\x92{\xe1\xb9\xd4\xdb\x1d \xba\xf8x\x11\f\x94\xc2N\xd2\x03^\x99\xe7i}m5\xa0<\xf4\xfd\xac\x104*\xd8\x16\fm\xa6\xa0l9Ê3\xfd\x1c&\xf1Dϟ\xb7\xfd\x04ԱӬ\xc4\xff\x1ccmKX\xae\xa3,*\xe0'\xfas\x14\xfcX\xf2ʽ\xf6\x83\x8bqߞ=\x03\xed\x1e\x8f\x10~\xdff\v\x856\xea\xe8}\x80-<\xa1de\x19\a\xb1y\xf6\xb9s\x17\xb6\x86D\x8ewo\x1f<|\xecǞ\xc0\x8a\ue6bb\xfa\x91\xe2\xc1_7\xbe,\xce[\xac_\x9dhk\xe3@d#S \x01.\x17l\x92\xa9\x91  c\x82{\xc6\xfd\xb1䒄\x98R!\xa6)\xe2\xa3p(o\xe21\xb0\x83\xfc\x86\x90\xc0Ʒ\xa3-8\xfe\xabw\xd3w\xd50\xb1\x00m\xbd\x14\xe1P'\x1c\xda0\x9af:\x13\xb5rS\xaa\x06\xd2\xfa]\xa9\xb5\u0600\x8aٶ@\x05\xa7dY\a\xf1p\x1f\x9c\x1em\x9f\xa8٤j\xf5J\xfb\x03Z\xe4Is,\v'oI\x10\x81F\x00#\xf1\xba\xaek\x8cW\x1a0Nj\x18\x04J\xfa\xca\x02DZ\xac[.X\xd0\xc2\xd5<Z\xa6\xe1,\x1e\x8bf\xc2:X0\x85\xb1 X\xed\rZ\xf0\xf4EJ\xd1Te2\xa6\x942#p\x82\xc64\xdb\xd1\n\xea\xe8}\xb0Α\xf2=y\x8a\x10+\xbfT`j\x11\xb3\xbb\b\x0e؍(qd\x05\x16ن*\x0fcj\x9c\xd8~C\x90%\xb3\xc5D\x05\xde\xe7{\x98͠J\"7Ԇ\x9a\x1c\x9e\x1c\x9d\xfbp\xde\xe8AUN\xe0\xf2\\R\xefI\x0f\xcb6w{\xbf\"\xe3\xf1\xcdy\xc5TY8\xb6'\xa4\x02K\xd8\xee\xfb2\xf8ә<\xc0x\x9d?\x83p\xa9\x7f\xb8\x90\x9aB\xb8\x05'G\x7f\xf6\x1a\xe4B\xbfB]?o\xe2\xe5\xe4\x17\xb6q\x15/CVp\a;\x8bT\xfc\x06x\xfa|5X\xad\xc7$\x14\xe4YR\b\x11QO\xe2\x04\xdd\nH[\xa2{r䍂\x1f\x96\n}e\xa0\xef\xa0\xd5S\xe7\xa8N\x92 \xe9\x7f\xf7\xbf\x9a\x85\xe9\"L\x86\xf8\xc7P\x85$\xe5M`ӫHKS\xe0\x96~\xd6-f+\xf5\xda1\xc4\x15\x1c\xadP1u\x13\xb4!\x8foi\xa9\x1d\x0f\xce\xdfH\xaa\x16w\x88{\"\xa9\xd6gx\x17Ə_W3\xd2\xed\xd0z\xe5ݯ\xea8Ĉ\x87Ĕ\x87$\xef)\x17\x89\xa7\x950qlD/`gX\x05o\x8c\x84\xc7w`\x0f\xcc\xc3*\xed0\xa9\xe6z\xbbL\x8e\xaf^B\"\xc0\xc6\xe1\xe6\xe6c\x1d\x86\xf3p\f\x87\xfd#`\xa0\x8c\xe5\xd3\x1e@byR\x9do\x99\x95\x94YY\x9b\xf5ƙZ\xa6l\xf0P%m\xe9a}\xf8\x05Q\xce\n_ME\xfdL\xa2Q\xb5\x18\xad.\xae\x0e\xdcq\x1d\x1fţn\x90\xeefK7\xc88'\xb7}\x87&묺Ɩ\v\x8b\xb6\xc6p\xdbeJUs2\x92\xe7\x19\x9c\xad\x15\xe4\xd1Bख\x1a\x9e(\xe5p\xa3Ǯ\xf4\xa1\xea\xff\x04\xbb\xa7\xb3\x85=\x9f\xc0\x11\xf0\xe5FҘ\xe2I\xb1\xbfX\xc4\x1c\xb3\xc2\xf1\xa7X\a\xc0.A\xbe\xd1M\xb3O\x13\xbe\xd5\xd5\xe5M^v\x1a9\x8a=\xfeHal\xe8D\vףٚ\xe2\x86\x1f;%\xee\x8dR\xafɗ\x87\x9b\x18\xa1\xa9H\xd7\x05g=9\xcd\xee\xf1\xd9d\xb5\xf0(4\xe8@\xf2ěO\x90\xcaa6\xd4^\xa5\x96\xfb%Ǎ\x8f\x92\x1a\n'd\xd3Q\x92o\x9f\xa4\xef\x8ah\x85\b@/\x12\xffQ\x83%d.\x90\xac%gx϶\x81mK\x8d5\xc7\xc5/\x05\xe9\xe7\xcaD\xd4\xe9\xf0\xd74Z\"\xec \x84\x0fv8\x1c\xe4\xdd;\xfa\x03\x84\xb5\x92\xfe\xf1\x1e\x0fQ\xa2\xbc\x19\x83\x9d\xac\xe0+IĞ\xef\xe89B\xe8\xce\"\xd3F\xfcD\xee\x0f\xc0p\x1e\x96V?\xaa\xc6\aO\byS\x0ec)\x81O\x81\x1e\xe6q\xa9\xd8O\xbd\xe6\xa8\x03\xab\x05\xd5O\xcf+VJ\xdfy\x95y\xd6:\xfc\x01~G\x95\xcb\xd7Ϩ\xc1\x19\xeb]\r\x1f\x1ft\x1a\x06\xca\x1b\xe4\xfb\x94\x8e\xfeʥ1\xa6b\x80\x86zpv\xa2b{\xb0`f\x0e\xf7\xfd\xc5\xe8w\xa3\xe7\xfe\xe2\x16\xee\x1e\xd6\xfd\x1c\xf4x\xf58H\xcc}\xb6\xa0\xa4\xf3a\xfeL>\xfe\xcd\xe8\xc5w\xa3\xe7\xc3\xe7\xa3\xeby6\xfav\x18\xce&\xdf}\xdb0\x84\xea\t\xa8\x8fB\xb5X9\x90\xc6έ\xf3\xe6\xed\x1a\xf5\xf6\xce\x1d\x9b\xeb\xe1\x81F\x94G\xfe\xde\b\xa3B\x92 \x94f\xae\x92!Ƃ\x86-\t\x11\x9c\xb6a\az!\x14\x85\x8e\xa5\v^Ga\x9e\xb2A\x9fv\x01t\xad\xdd\xdf\xed\xa9^\x87\xf1\x84i>\x1c\x01x\xf2\xfbQ\x96\xdf\xec'\x88\xf7\x80\x7f\x82\xb8\x01\xdc\x19\xff\xf5\xbb}\xf3\x02\x9c\x94\xdbr\x964\x1bf͵\xf4\u07b4\xb7\xba>\x0f\xb7j}\xdb\x14\xf1\xa8\x17m\xb8\b\xe8Ɋ÷\x1f\x95\xe3\xfd\xac\x18J\xf5t9\x81G\xd1u\f\xb2䏧\xef\xf6\x19\xe4\xe2\xf7\xc1\xee\xfdm\x14\xfd\xb6\xdc\xf3\x1fD\x8b\xca\xd5\x05\t\xfdl\xe3M5\xc7\xc2\xdd\xc4Kgwuuc \xd6\xe7\U000117c0\arUYŽ\xb9\xd86\x88\xd9\x13\xe0\x8f\xc0 f\xc8\xc1\x173\xe5\x06ޏ\xd2ị}x\xf7\x1f\xf0\xee?.\x16\xd7z\x1e\xffx\xa3\xc1?\xf6_\xec\xe3>\xef\x9f\xfft\xf1f\xff\x86˯\fy\x01\x86\xc8,\x1aNA\x03wⰑW8\x87f\xaf\x95\xa7\x91X6I\xe67\xceoT\x9di9\x1c\x87v\x85=Q\x8b3/&\xc9*\x17\xbb\xba\xe7V\xf4\xee,\xa4\x7f\xbeR\x1d\xe2.`-1P\x9c\xe8\"\x92\xbb(\x9b\xba\xc5\xe7\xafv\xee^\\\xedx\x8f\x91\x19\xd9\xca\n\xb3<E\xaa\x1f\x9f0\xaa\x10\x8fHGx\"!\x17g\xe1\xa8\xe3N\xac@E\xda\x10\x10)\xa6\xc2X%\xfb\x0f'Jnб\xd5f\x8f:E;\xa8\xf9\xd5\xc0\x90:\xae\xeb\xc9T\x86\"\xb5\x1e\xc2`\x1eG\x1c\xa8\xa6\x0f\x10N\xa1\x8cBu\xaa\xf0j\xe6*qQ\xc0\x82\xc4ic\"&\xa8.\t\x15\x81\x89'\xc1_.ޞ\xee\xff\x98\xa9\x98_.y\x8f\x16%q\x85\x14\x8b\xf1-z=`hxH\xd1&\x15\x8d\x80\xbc\x82p^\xe0=U%\x00\xfe\xfb\xeb\xbf3\xb9\x88\xb8r\xd9@\xc5`\xeb\xc8\x00\xb571\xc7`Z*\x95.\xa73\xcf&2\xe8{S\x80$Ku\xc5\x1a,\x0f\x83\x15\xd1p\u05eco\xff\x0fr\xe9\x7f]\xed\x10%̱\xf6\x19\xfe\x00\x7f\xd3Gu(Hj\x01T\x99\x8f\xb3+/\x8fon(\xf6\x85\"\x13\xd0߾G\xa5\x89\xd0Wa5Nk\xc5\xc9j\x83\x81\x85\xc0\x91\xb8\xb3è\xcf\xe8!\xf8\x9a\xef\x03R\xcal\xb2'D\xb2XB\xcb\ar\x10\x00I\x8c\xa4\x14 \x8c\x99\x9cSd꾏\x92d\xc8\xc13\x93\xe0\x9e\x8d\bjA\xd9\xd14\xc7\x02 U\xca\xd2%K\xae\rp\xd7\xd3\x05U|\xa2\x88b<\xb0\xb5\x18?\x1f`\x88M\xe9\xad\xc8>\xd80\xd5H\x91\x94\x17 \xa8\xb4\xc4#ƎN\t\xbb\xb2.\xe8,\x9c\xf0\r\x0eӭ\xe7\x87\xe1\xb4\xc9\x006^\x0eŘO\xe9b\b\xfeL\x86\xbd\xf1\xd27O`\xdf\x1e׀\xf1\x9c<\xed\x01AM\xb35\xbb\xaa\x96\xf3\xf6\xb0\xccJ\x8b`\xf7\xec\xe7=\x15z\xc5@s:\xbe\x8a\xac\xec\"A\\/ɐh\x1c\x0eY>\nN8x\"\r\x93\xec&\x93\b\xf7m\xdbX\xfbX\xe6>a\xb9OX\xfe\xd8\tˡV\xa4\xccM\t\xcce\xd5Б\xf7\x8e\xf3\x98\xd4\xedQp\xb6\x8e\x8el\xcf!\xe3\xa5\x1f\xad\x13\xe1*\xb4\xb1%\x90U\xc5\xf1\xa4|,\x96\xe4\xbf/\x8eR\x15\xddP[\xb8\x8d\xb3\xa5?\x89ezl\xe2t\x8dk\xb5\x8cꬾw\x98\x84\xf1l=ˣfL\xeb\x16E\x94?\xd3$G*\xd0\x00\xa9\xa7\x16\xac\xf8T\xb7\xaa\xe7G=?\xea\xf9ѧ\x00\xa0!\x86\x01P^\xc3<\x1c\xc3\x19\x87{\x1a\x8f\xc5\xd3/\fI6O\x18\x0f\x05PaY\xe2\xa7b:\xfc\v\x91\x8f\xc7PT\xa2P[\xe7>\r<\x9bF\xfb\xe4|f\xab+\xb3m\x86Ì\xe31\\gE\"\xf5\xea\xf6b\xe2I\x8aJN.\x19\xc2p\xcb\xe6w\xe3\xee)\xd5 q]G-ҩ\xe7\xd8n\xe2\xe6\xd6~2\t\xd4b\xba\xfe\xa8Y\xd4\xc3\x0f\x93E\xfd\x8e<\x1d\xcal\xaa\xab\x04\x84\xf8\xef\xbc\x1ch\x17\xc8\"\x9d\xc0i\"ڎc\x12\xd6H\x86DL\x83W\x01\r:\x15{U\x1a6F\xads*\n%*^\xed\x9c\x03=\xfd-\xba\xe0b\xbf\x88j\xc1\x00\x1da\xaa\xd2`\xe0\xdbɒ\x90\x0fjD\x04\x86}\x1d1h\av2Y\x93\xe4ݘ\x9e\xbd\x99\x99\x83\xae\x94?)\xb1\xb1\xa9\v\x91\xda #\xf6u\xac>v\"\xa2\t\xddo\xe0\\\xc5\a\xe1W\x8f\xc6X\xf1r\x9c>!\xb2O\x88\xdcNBd3E|\x8cT\xe3O?ilZ\x85\xf4\x86\xe5D\x17\x9a\x05\xb1:\xd5Ff.\xf3QpF]\x92d\xf7x\xd6\x05\"\x039\x97\x8a\xf0\x1ej\a\xbe駮\x82\x93\xf3\xf0\r\xe5*\xd7)\x88yh\xbc\xb3\xb6\xfe \xaeǙ`o\xe9:(B`\xd1\x19\xb6M\x1aß\x1b\xd2\xe7\x86/:\x84\xb2\x19\x8f\x8b7\xd9I\x05:Wkq\xc2Y\x9c-fN\xa9\xb1\xa7\x9e\xa2M\xe8\xdbRH5\x01+7\xaa\xe02\x1d\xcdi\xbb&\xebv\xc99\xb7:ԑ\\\xa7\x05%\xad\xe0i\xba\x8e\tw\xebq\x12\xe3k\xfc\x9a\x81UBy\x86V\x00\xce\x7fQ\x9c\xae\xf5#^X\xad5v\x99\xb1\x95\x92\x12\xb6\xc5\xc8t\xfc\x8c}\xbc\xaa\x81\xa3o\xbc8\xb4晖T\xee\xc9M-@9F\xdck\x98\xc1\xcfħ\xa0\xe5\xab8\x89\x04\xf6\n\xf3Hf\x98\xa4:\xc1ڳ)a_\xc5)\xc2\xd8 \x8c\\*\xe66\xf2f\xb3cZ\xa2ֱ\xee\x90N\x17S\xe2\nh\xfbTbTR\x91\x16\xf8pE|\xac\x7f_~\xd6\xcfT\x14\xb0\x9c\x10\xcb\x19)\xd2S\xcdav\x1d\x8e\xdf3\xfbE\xc79\xcdzc\xff\x9c\xadζ\xa4\xb3:5\xa7)\x11\xa7I\xc3\xdf\x12\xd5\fǈU\xd6H4\xcd\xea\xc0\xd2\xfd|\b\xa4\xa5\xf8D\x88gs\nɹK1e\x86N\xeaHE\x01\x12\xab\xfd'\x96\xf6!\x87\xc1\xd8\x16\x9a\xa5f\xd2\xfe:it\xaaxWd\xf5\xaf\xf2\x94\v\xce\xd4|\xe6h\x90\xcfFO%7?]b\xc8Jt\xf1\x06K\x9b\xce\x11\xf0\x8e\xf5\x91\xb4aum\xb3\xb5\xaf\x18r&:<\xfb;\xf0b\xaa\xf88\xb7\xe2\x1e\xd3_9\x02S\x94l\x99:fpV\xe05\xa6\xc2\x18\x92/\xe9\x04\xaa\xa5rle\x94m\xbd~\\\x88\x83\x81\xd9vp\xb2\x11\xf8\x10\x01\x16\xee\xf3p>G܌\x9c>&F\x9e*\xe7Q`\xa4\xdaӈaJh\x1e\x8a\x12\x8cT5\x9e\xb3\xbd\xaa\xdeN\xe7\x9e\xd8AMw7\x8f\xea\x97J=R$W\xc5r\x85M6\x03ߺb\x1c\x9bJ\xe0]\x14\x9a{(\xe7߇S`\xdb\x14u\xfb\xc5-\xe3\xa6k\xbc\x15\x1c֊3\xfcYĄ\x05\xa9\x97\x92#(\xf5\xdaڧ\xa1V\x17\xb0\x9d\x05g\xbd\xf1\xa6\xb7\xdb|:\x00R5\xc6Rl76h\xdb\f\xa6\xb7\xc9\xf46\x99'\xb2\xc9<\xc6\x1c\xd3\xce\x12CF\x18\xe1Sm\xe2u\xb6\xa1\x13 \xae\xdf}\xb8t\x94\x00,\xecj\xea}>\x95\xfc\xdfI\xfa\x0f\xef\x8bc\xf4\x80\xc4\xe3\x1f\xb0\xcc,*\xe3\xbe\xfc\xf9_.j\xadl\xb9\x0f\xf3*~\xb9\b\x8e\xe2\xe2\xbd}\xc3Y$\xb1\xd3*\xc3@2\xa5@\xda\xc2t\x13\xed\xc1A\xeaM\x924VF-L\x0e&IN\x8f,!\ns\x8cx\xf4TJ\xb7\xa09\xb6\xb7\xc0\xf8&\xefȟ\xb8\x8c\xbf\x81\xba\x8d\xd3\xf7\xac\x9dzT]0\xfc=8\x82\xbb\xce\xeb\xc6\xe5w%\x89\x9a\xd6\x06\xd7\x04\xf5oU\x99\u05ecH\x97ѫ\xcf{\x87\x8cֈ\x86!\xe3#\xef\x90\xe9\xc1\x85\x94\xee~\xcaQ\xe3\x87jWY\x8f\xbfYo=\b\xac\xbf\x95\x96Z\xbb\xe4\x14ߤTY\x8anR0\x0e_\x06\x10B4\xbf\x9d\xfa\xb4a\xf8\xfdՅ\xb3\xb1\x01\xfe\x16\xe8\n\xd0\xf6frɅ\xdb0\xa7XG\xd8Dt\x98JI\xe8\x0e\xdb\xc9\x1f]\xb1\x97\x98\x8d\xe11e\x1e\xd2\xef\xee`\xb9\xad\"\xa9\x9a\xb8h\r\x8c\xa0\xee\x15\x99\xa9P\x19\xcf\xc6J2_\xa1\x18\xebO\xc7\aG\xfb\x922S\xecϖ\xc5?\x93!\x7fr8\x9f\xec\x9f\xc3\xd37\xc7\xc0P\xbbL\x9eޮM\x195\x10\x81M\xf6\xa8V\xf0\x84\xf2\xf7T\x16\t\\\xa9!W\x15\xa7#\xa3\xedr\xd7Qy\x8f`\xb15Y\x1f\xd7ïy\a\xc7\x0f\x98c\xc54֠c\xb3\r\x924ڑ\x18C\xea\xe6@\x0e\x12\x8aQ\x90\xbfc\xe3\xa0\x19\x01k\xc1\xa0Co\xf3\x02\xc9<;,\xb7\a[z\\Ğe\xbe8\xb1\x0f\x96r\xc0Љ\xbf\x85\x99$:*W\x81c\x05\xf8\xca$\x8fѢ\xbe\xfb\x03\bA\xca\b\xbbׅ\xb4A'\xcd\x17a\xea\x91f^\x1d\xba\x17\xe0U|\r\xab{\bcĜ\xe9\xed\xb3\xdb\x0esyuX\x9b@\x12=4\xe5X\xbc\xd2\xcf\xdc\t\xddD@\xcfⱉM\xab\xcc\xc8ʷ\xd8׳cs\x01\xed\x0e\bw\b76\t\xe6\xc9\xe2&N;M\x00\x86\xb4b7\x12Jm\xf7̈́\x1eT\xf6E~\xacR\xa6u\xfbP\xdf\x02\xb2\x1b\xa1B\xb3(\xa8\xc2!\x19\x9f&\xd1<B\xbb\x93\x90g\xf55Iءr\xebȐ\xc58ɘ,\x9dV\x82\xfa\xab.\xc1\xcd\xd8b\xc1~\x01\xe7\xc7\xc3c\xb7\x89\xbb,\xf0\xf8\xc9\x04Æ\b\xfaGK\x8c0kC\x8b&8\xeb\xf6\vY[\x8eڒr\xfc\xbf\x8f9\xff\xa8\x1eU\x96P\xff\\\xb1\xf6\xb9+\xa8ů\xc7.\xd5j\x9e(\x8b\xb4\xaf\xe7\xb1\x11W\xd4s\xaa.\x0f\xce\u009f~\xfb\x93<q\x17\x87\xb9b\x86\xaeN#\xb8\xd4\xe7\x1b\x90j\x8c\x82V@p\x9b\x9c\x82\xa1\xdcj\x8b\"\x9a.\x12F\xf9\"\xe8Q\x02\x92S\xef\x10\x1c.\x1dDx\x8d\xa1\x8e\x92\xe5\xff\x15\xbcM\x87\xb4\xe4\x8au\x10\xf8\x10Z#撹\x8f)\xb4\xe9\x92\xf2C\xf1\xed_N^\xbf\x0eN\xdf^\x06\xbf\xbc=\xff+=\ff\x8b\xa4\x8c\xf9[:-\xe4\x91g\x17\x874\xc7\xf5k\xbf\x17ja\xab[\x11\x17^\xceyrQ\xe1\x9dp\x98\xf8\xb7\x0f}\xcf;̑\x06\xd8L\xea\x11\x1f.\xf1\x98\xeb\xf0g{\xaa|ڒ\xe5POJ\xed>e\x15;u\xd4:\x8c\x8e\xbeS\x1d\x13\x89\xb3o\xe7\rn-\x13\x01&b;7\x1c\x04\x84J\xf1\xdfW;yv\xb53\xc0t\xe8lZ^\xed\xfc}\x84 \xc0\x9ccN\xf94Cy\x8f\x9cU\x05:\xa2\x97\xc14\x0490f\boB9\xa4\xe6[\x94\xe7\xf6\xbf\xa2\x8f\x0ee\xb0\x9dL\x15\xa9\x8fb\x9eVt\x9948\xf5)2O\xc7*pT\x1d\xe0\xb8^]Tw9][\xb4O=\xd5\xc6v\x8c\xe1(s\xc2\xfd\xe4K\x96ĳ\xb8\xe4\x88\x04U*ϸ\xab\xc4\xea\xc4&\"\x89\x1cv\xe1@\xd2i\xb20\xce0\xab\xae\x18\x1a\xaa\xa9\x04\x1f\xf6\xac\x01g\x8d\x91\xac\xed\xb4E\x91\xb0g\x8a^\xc5\xcam<g\xb7\xccY\x96\xc4c\x9f\xff\x85%\xf39\x88AEC\x12\x12\xeb0¾\xacBa&\b\x83P\xba\x19\x03\x10!\xbf\xce#\xc2^؝(W\r\x95!K\xb9ꘂ\a\xaeR\x8dbo\x10\x1c!\r\x8b\xdc\x17'\f\xebK\xef\xda9¾\xf7\x91ܝ\v\x9aڮA4\xdb\x1b\xe9_\x15\x04\xb0a'\x12Q\"Sea\xd7vV\xd3\xe6T?\xb6\xdd\xf8%ZH\x16,\xebx\xba\xb7Y\x99\xa5\xeb\xa4\xc53O+\x97\xa1s\x8bC\x96k\xb1:\xa4\xb5\xcd(\x8bu\xd3\xf9\xbb$Ux\x86V\xbd\xad\xb8\x15\xf7Yި\xe3\x9c9\xcf݉\xa9w7\xb2]t\x99\x873\x063\xf6\x7f.\xb2\xebe\xe9\x19\xf4\xdf\xf8\x81;Z\xf5\xe3\x93\x19\x85\xe4\x03\xd5Q\xe6\xd7\x1e\x1f\xd3\xf9\x0fG\xee\xe8\xce\xc3IV\x04d\x90\x85\xbb\xd8`\x8a\\5\xd0G\b\xbe0\u008dD^\x98D\xb3\x00R\x00݈N\xdez\xfcC\xfc\xc0\x9d\xbe\xfa\xb1N\x01\x9b\x0e\x95)\x98\xce,\xa2\v\xf9\x96\xaf\xad\x18|\xeb\x88?'\xda\xcfŻ\xa8\xcf\x05\xaeA\x96\xde`\x85wB\xccg\xbf\xa2ʼ\xa0z\x0f\x86\xcbM\xb2\xa8\x90\xa2\x97\xf8\x12\xe7\xc3,\x9d\xef5$_P\x83̛IF\x8f\xdeV\xac\xa5\xe6\xe7\x15\xda\x18\x15\xbdh\x96t\xd5\xc6ĩ\xa5\xf5o| \xf5\x1c6:\x96z>\xcd\xfb\xdb142\xb62\rI\x8c\x84>'\xda\u07b8(TE\xabP \xd3K\x82\xe2\xd2q\x91\x19\x15\x01Σ\x19\xb2f\xf8\xbf<\xbc\x0f\xc8s\xc3\xd9_\x9b\x05Sn=\x8c\xb2\x98#\xb4Q\x13\x1f\xf8\xd9~잟\xbb\vz\xf4\xd4\\@\x8d \xce1l\xaf\xce\xcbZ\x06\x9f\xb4\x8d\xc2\xdc \x00\xb3\xeefmL:;\bn;\xa6\x9d\xdd\xdf:\xd2\x12\x1dC\x05\xbf\x85\x87\xc8/\xc1\xac\bs\x93/Տ\xb7\x06\t\x1fH<\x18\x83i\xa1\xa44\b\xa8\x9a\x86\x88\xa3d\x86\xd8zD3\x0f\xbaCR\xdd9\xe7\xc1Q\xc4\x06U)5A\x11N\xe6\x9c\xc9Z@B\x8a\xaa\xa1\xba(\x88G\x04TX\x89\xcbL\xd6\xe6a.&YX\x81x\xb2DYm\x9eP\x14\a\x87G\xbe>\xe9\x10\xf4\xb7N\x14[]\xb5\x87_\x0fVȐ\xdaiV\x89\x86\x9aON\x8e\xea\xc1P\xd3\xe2\xd2['բ?\x14\x8f\a;O7\x18\xf6W\xa4\xf7\xd0&lԦ&\xcf\xd3\xfd֨\x97\x1a\xe0\xe2\xf8a\x04\xca{\xf4P~\xcbj\xfcô\xe0\x7f\xa4%\xfek\x14\x9c \xb9\x1b\xc7\b\xd1L\x812\xb9\xa6\xb0\xea5<\xa9\x8bTC\xa3\xf9\xcf\xfc\xa4\xa1\xfe\x05s5\xc1\x96\x84C\xb0vM\xdbon6\xf1\x96\xf6\xe0#9\xc6\xce\xc7\xcauk`ܤ\x02\x15V\xf0]\xa4\x8cAǚ\xfd%\x87\x98\x15*\x82R\xebm\xb0\xbe\x1c \xc4\xfe\xddJ)lB\xbb\xecK\xc2\xf6\xb0\x15=l\xc5ǂ\xadp\xe2\xb0\xecԳ\xeb\xe86\xbc\x8b\x81\xb9\xa8\xe0\x8d\x86(\xa0GL\x14GE(w\x92}\xddE\xbd\x9e\xacàx\x83$\xbd\x0e\xa0_8U\xbbiR\xec\xda\xc3  <\xcc\xfa0❟\x90\xac\xbb!\x00\xd2G[\x99GcPd\x93\xd6\xc1\x80٤\xd9p\x8a\xfcD\x19\xe2\x99\xf2з\b\xa9\x1e)(c\x9e\xa8\x06\x96\xcd3\a\x16\xe1\xe1\v\x1aI\xf9\x88J\xc9]\xe8\x17Nn\xd2L\xff|\xfc\x10\x8d\x17~\xbc\x8bKcZ%\f\xd2$\x11tf\x02\xe7\x95\abnʹ\x19\x17\r\x18pE\nA\xc2\xd5\x03\x8eL\x857\v\xfb\x94\x8e\x88\xb2\xeb\x828\nrp\\\x8a^\x93eE\xe4\x14\xb8\x81\v\xc6\x05\x1a킝r6\xad\xee\xb9\x00\x9cy\r\x85?<\xdezA\x94̏ݐZ\x87\x7f\xdc\x10\x0fF\xe7\xd8b\x86\x9d\xdeG\xf1\xcd-\x96@\x8cGp\xa8QnĢFV\xb7\xb3\bu\x1d\x8c\x9a\xb40\x93Ֆ\xd8\xd5\xe7v5{\x10R>\xd09wխ\xf1.\xd7 \x88\xca\xf1ho\x80y\xb8\xf3EIh\x120FX\xba\xb8T2\x98¼\xa7\xa5H\xe4Ê\xab\v0/\xe6bL(\x06\xe7j\x87g\x87\xd8\x10̪\xb1\xc3ت\x1f\x06\":\xef,\x9b;T\x9d?\xce\x06\x865,\xe6\x19\x87\xf3\xcc͑\xc6*\x82\xdf\xeb.v\x8b=\xb3\xb8\xb7\xf05\xb5\xb6hŦ|VgO\xb6\x91c\xf4\vM*\x9a\x9c\xb9c\x92\x8c[\xff\x8a\xb7\xbd\fR\x19R\uf3f3\xc3\r癦\x8a\x14\x12\xd8L\x80\xf7\xc2\xec3\x1a\xf4\x06ڎBwKHiE\xe83@\xea\x1b\x8d`L\xb8\xef,d\xabQ \x1c0K\x1bRd\x12\x87\x10\xa9\xa9\xab\x1a\t\x8bH\\\x16\xf0\x90\xd3sY\xe2\xd8\x1bXD\x9dn*\xa9T\xcc\nʜ`\x87\t\xfd\x98]\x12h\xe9,M\x98\x88\xf2o\xf0\x94~\x91L4\xd4\xd2\xf2\x88}\xcbsSE\xb3\xe0Oq\x15vU\x7f\xb7r\xfe\xf0kx/+\xe7\x90\xfa#\xb2YP\xe4\x98\\c\xbc\xad\x8c[\xae\x9d\x15D\xab\xe2\xed\x1c\xc0\xfa\xc1\xfb\xd7ZF\u0bfdyd\xe0\x1a\xb9X\t]\xc6]\xccCBdgDy\xe6K\xa9\xe4\xd0\xc4\xcei\x96\xf2\xafw:\xf7\x151eR\x93\xc5\x04ws\xcf2H\xe2\xce\x18\xac\x9aq6\xa4\xdacp\x96v\xd5a#$k\xdc`\xe7!p\xe0\xa1i\x81\x97\x1d\xeb\x9e\xe2nZ\xcd(\x10\x88a\xa7C\xa7\xf4\x92P\xf6{\x9a˝2\x90\xe9\xb90\xf1\xc0J\xb9\x7f*\xb3y\x96d7˿F\xcb?[\xa4(\x14\xb8j\xa9L\x8ai\x8cD\xac\xf8\xcc\xea:\xccf\xf9\xe2\xc2\n3r\x80\x98\xcc\a|0Lv\xb6x\x9b\xe4u\xbdiZ\xef\x18h[\xce\x18\xef#\x15\x17\xdcn\n\xbb\x81x\xae\x0f\xd1<\xd3tB\x11v\xebQ\xfd\x14\x99\x82\xc4\xc1\xaeZ\xf6\xf0\x06\x93\x05ʽ\xef+\xc5a%\x17\x04-\xddW;\xeaT\x81د?p\xb5\xd3\xc9wno\x89G2\xd9\xea\xa9U\xe4\xa9Xu\xa5je\b\xcc\xd2=ɩ\xb7\x16\xa0͙O\x97n\xe5q\f.tϼrJ\xd8\x1dK\x00\x10\xe1vx\xcd-+\xe8\x17\xac\xe3\x1aa\x16Z\xb4\x90h\x9dV\x9f\x8dXk\x9f\xa2^\xb6m'\xdb6\xadY/\xe0~B\x02\xae\xb3I\x1fO\xca\xed>\x8c^\xd4\xfdTE\xddU\x98\x9a\xd6S\x93\x8a\xa8\x1cc\n\x8d@9\xe7\xc66 \x86\x122F=\xaa\xe6\xbf\v\xaa&\xccsx\x9f\xe5pč\x03\xd0\x02\xc9t\x96\xc9\x03\x94\xb9\x1a\xca\xd2\vʫrp\x1d;\xa89\vh\x8fǫu\x99\xe3\xf0^!l\xc2 x\x97\xbeO\xb3\xfb\ue05aX<$\xc9\xc2I\xb1\x8f\x8c\t\xffg\xa8\v\x98~\x85\x7fY\x981\x83f\xe4͊\xb4\x83\x1e<\x99\x85B\xe0\xa8\u0381o\x17\xda}\x11\x1d\x02ʹ\xcb\x0f;\xf8f\xd2qtz\x01\xf4a\x1a\xdfxI\x87~\xeax\xb7\xe0W\xf4\xed\xc2\tA\x9a(\xae\xfc\x8c\x83\xdb'\xea\x0ee\xa2DS*\x0f\x89\xect\x93\xe0]\x8e\xe6\xac\v\x9e$\xef\xb3\x1fgUl3~\x9d\xa0E\xb8mprf\n\xa3\x8b\xf5\\\xa1\xedPd\xe8Ą\xdf`NP`}\xa6yp\xc1\xd1\x02]\xa9\xf4\xc8~A\xf5\x8c%/\xefV\x13\xfa\xbaʕ\xad\x0f\xdc\xc6ɡė\xe0̤}eR\x04\xc131\x92\x11MJE\xb0\xb6\x9aP\x846\xff\xa8>\x99\x00\x91\xe5\x12\xe6ܺC2l\xc0\xceJ\xd49\xbf\x84Zx\x1e\x930\x9b\x15\xaa::,v\xa8\xd58\x1a\x95\xb3\xd9[`\x88\xfa8\xf2`hQ\x8b\b\xab\xb0FkW\x95\xdb\x05\x93lF\x1c\x10\xc9\x19\xfa{\x87t\x94\x92,{\xbf\x98\xb79=\xd2\r\xa6S\xb4\\m\xe7\x8dG\x9c\x9f\x16wX\x96e\xe5M\xe66\xfa>\xfb\x0e\x9c\xbe\xd1\xfe;ꋴ`\xe9\xc0\x1f\x16\x85:x\a\xc4\xe2lҀn\xc3\x0f*\x806b\xe8\xe9\xe1k>:|\r\xee\xc4vǳ\x1d\xa2\xd1\xe3\xd4\xf485\xdb©\x11\xda\xd4\xde\x1b}\x01\x9ap\x0es\xc5\x18\xa9\xe8\xc1O\xd5*m\x80-%\x13R\xbc\x86\t\xae\t0\x10~n\xe3\x03\x13:\x05\xa3\x06\x9b*\x96\x02;\x86G1\x9bI\x9dWNj\x01\xb99\v\xe4\xa4\x12\x8e\xa8zgT\xb8\x1f\x1f\x05\\\x8eV\b\x8c\x13oUm˥?1O%\x9a\x10\x0e(\xd9槕\xd7\xeb\x13\xacs\x95i\xf1#\xafu\x9d\x85\x931\x02h\"\xc6ȱ&\x0f\x7f\xb0\x95SI\x1dʄ\x8e\n\xb9\x15\x1c\xa6\xaa\x95\xcaj\xa8\xc8l<Ml\x8f\xa5{\xa8\xcap#\x0e.\a\xfa\x92q\xef\x1e\xbb\xb8\x8d\xe7L\xf1\r|\x1f[?\x9c\xe2]\xf0\x89\x97W\xe9U\xfa\x82M\x87\xf0\f\xc9Ə'G\x9a\xd9Sr\xf6\x05M1\xf8\x9a[\xc1V\xddĈ\x8aB\xfc\f\xbd\x19\xbbitϑ\x81:ZMD)\x95\xc8$\xbd\xe9\x8fK\x97{\xc17\xdc',\xe8,&\x83\x1f\xf6\xcb\x1b\xff\xf6\xfc\x99\x88\x8a\xf9\xfd\x10\xfe\x0f\xfe\x1f\x8e\x95 -\xe1\xab\x03g\r\xb4\xfd\xc8*\xb4kV\x02\x8f\x9c\xf9D\xa1\xcc\xe45\x80Odc7Q\x0e?p(7\xff\xf4ݷ\xa4\x7f.҃\xa6\xbd\xc6)\xe0\xaaa\xa0\xf7\"\x15\x8aY\xe6K\xa6\x99\xb5\x9a\xb0p\x82\xa8\x1au\xf0\xae\x88\n]\x8a^%_\xc52A F\x98j\x8b\xc7_\xd07aQ/jg\x1e\xd6C\x9e]\x83j_m\xc0(\x16\xb5s̋\xc7,\xc3\xd8\xcc\xea\xfd\xd3=)\xec\x8bº\xf4\xff\xcf\u07bb0\xc7m\\i\xc3\x7f\x05%\xd7\x16\xa5\xd4p(\xd9\xde\\\x94\xacߢI\xcafE\x17\x86\xa4\x9co\xbf\x97\xa9\b\x9c\x01I\xacf\x80\t\x80!\xc5T\xed\x7f\x7f\xfbܺO\x03\x8d\x19̅\x12e\xa3*\x15\x8b\x83F\xa3\xaf\xe7~\x9e\x13W\xean\xad\xb0~o\xf3\xec4\xcf\x03\xb4\xe4\xd8\xc6#\xb3\xebQ\xaf\x17\x1a\xa9`a\xe3\x12\xed\xe2\xd9na:A\x9cK2\n\x16\xf3$p\x1c$;\x14\x9f\xa4X\x0e=\xae\xecr\x83\xc5,+\xe7\x85\x18\xcb+\x97t\xc1\x9f\x82\xea\x9fϣ\xa7\xf0\xadg\xb8\x8e\x98P\n\x8e\x00\xc0>\xad\x8d1\xbd\x92.\x18u\x15\xdd|\x05\xc1%\x0e\xa0\xcc/\x96:\xe61i\\Us0a\xb5\x10B\xeb\xf1mx\b\xe9\x917Ӝ\xde\"|\x17ޯq\x17\x0eU\xa1p\xd8Y\x7f\x98\xb4}§\xeb\xa1\xc0\xbf\xae\x9bR&\xaf\xd3l\xfe\xa95W\x1aV\xf8\xec\b\xdb`\xdf\xf8\xf5\x9c\xd5>\xcc\x19ip\x149\x932\x81Am\x17\xe4R\xe0\x99\x04\x0e\x03w\xd1ܵ\xc2,\x8a\x11\x01\xeb_\xb3^!7\xb5\xe8+ڃιDG\xdeF\xc0\xd6(V\x8e\xbc`\xa1\ue3bc\xbeԻB5\xec\v\xf4\xfd\xe0\xb9\xc7;bf\xe2\xaf\xe6\xa0i\x8fR\xbb\xb5\x03S4\x17\xa2\xb8\a\xa6C\x8b\xea\xed\xad!6\xfcik\x0f\x18\xb31\x00\xd8^\xe8<\xb6\xa9\xf0\xed\xe7t\x81\x12܂cH\x0fHi\xa9a\x9a\xc5!c\xbd;\xbe\x01\x8dx\x04!,\x87I<\x9e\x98\x16\xe6\x1c\xe4 c7\xbeI[gĮ\xf1\xbc \xaa\v\xc9S\xd4\xdazc@\xe9\xc1\x94r\x8c\x8a\xe1\x94Kt\x04\xeaP\x19\x04\xbc\x06˷i{\x95\xb3\x1b\x8f\xdd?tm\xf0}\x88\xc0!\xef\x8f٠\x8f\xc0\x13\x80kpB\xd4GlW\x96\xb9\x91\vAJ\xd2\x17\x94\xd2\xc0\xa66\xd3b\x96\x83e\xfa\x96\xdc7ם\x89G\xdc\xeav\x87\xab\xe7\xdf\x7f\x8a\xc2P>9\x95\x8a\xbf\n\xbc\x9dʂ\x8f\x8dF\x84I#\f\xa9\xb7?\x1a\xc1_\xe7\xf9\xc7$d\xbbX\xd0Z\xa5(\xdd\xdd$\x88\x12\x1d[\\\xa0\x98\x1a\x9ae\x86\x96\xaa\x96\x16th\xb6\x8c\xd2\xd6\x05\x9a2\xc8\xc2\xd4\xe9j5\f(\x91\x9c\x121\xd9\xd5f\x03\x9e\x0f\\\x83\x11i\xbe#k0\xb7\xd7\x0ec\xae\xd8Ly\x8e^>\xbb\xcbU\x04\xd9T\x15:i\x94,\x01\xe2\xff\t\xf6\xdfP\xa7\xb7`X\xb0\x83\ue02c\x8eF\xb3\xce\xc8\xea\xe3\xacl\xb3ȟ\xd9\xe0\xa2\x05f\xf8at\xe2~t\x94\x1e\u05ecfC\x96\xe05kƄ.G\xf8q\xb9\xea\x04\xa3e\xfe\xe1\xd9rױ\xd9\xf2\xdcڰ\x1c\xce\fG\xc3\x19\xe1s\x1f']\x8b5\x17O\x0e\b\x16\xe7\x15\xb0\x00\xc8z\"\f\a\xd68A\xe9\xd9\xd1-\xfen\x94\x1f@\xb3y\x9bT;\x03\xff\x11\xfc\xcd]\xef\xc0\x01\xdb1\x92u\xb23\xac/\xac5\x81;oH\x9b-\x9e\ao#\x84\xf0\x812\v\x9f\xe7XA\x06\xbf \xc6V`\xe51&(c\xeb\x1b\x1a+\xb8{\x06\xd1}>\xa7\x17@`ƭ\xbc\u05cb\x94|\xb2\xf9^PT\xa0m\xdaA\xb3,|g\x7f\x92B*q\x18W\x89\x1frnl.L@\xf82&K!A>>a\x85CV%\xcd\xfe\x87\xa6\xafs\x999\xe7\xb9D\x1d\x17dO%yJ\x0e.\xba\xc7P\xba\xc7\xfd\a%E-\x87\x8d\xea\xdb\xf4\xee\xda\xd9u\xb8\xbb\xe9\xac\xfbͅ\xb1\x1e\x9f\x1c\x04ܫeb\xf3\xfa\xcc:\xa4\xb3\x91.$ \xfc\xf5\xa5\x85\x877\xab֊\x10O\x9f\xe1%\t\xef\\\x94\xd1S\t&\xa2\xb2\xaet\xa3إ\x1fՆ\xc4o\xe8a\x1d\xb3\xad\x9bv\x9e\xcd\x14\xc2\U000caab6\xe7\x98\xc2m\x03!\xdc\xd6v\x9f\xd2I(\xeb\xb06\xccY:\xdet\xe5\xc2\x1e\f\x9f\xb2J;Ѵ \xd8\xee\xf8\x8a\xa0\xbcB\xdc߶\xaf\x95\xf9\x88Y\xb6ٕPE$R\xc1눺\xd9\xc9|21bX\x91T\x81;y\\k\xd1v1u!\x8b\x1c\xe44j\x1c,\xb6@j\"\x95\x113=S\x10\x99\rt\xc41\x95\xb4\xb5\x12\n\xc4\x02(\x1e\x0f\x7f-\xd09F\x1f\xb3Zy\\2n\x17\xc8!\xb7\xe9\x18\xaa\xc5\xc0\x87\x80?OE\t 2\xc8\x04\x7f\xcac\x1aF\xaf@?\"\\\x83\x81\f\x7f\xc4\x05DƈL8 \x82q\x88\x7f0e\xa6\x84Z\x1e\a0\x83\x9b\x1cóV\xf6\xb7;\xb1e\x8f\xd6\xe1\x1b&\xc0f\x91v\xf1\x17\x98\b\x7fi\xd7P\xaaxw\x96o\xc3e\x81\xc0_\x01\xc8ҭ\n\x190\x80\x83\x0e\xc2\x1b\x0e\xd4\x10\xe4\x7f\xc7:\x80\xa8]\x96;6\xedu+\xd8\x01\n\a#e3/\xc6h\xafH\t\xd1\xc1\xeb\x0fS\x9d\xa9\"\x0e\x1e/8\x89\xa9\xd7\x1f\xaa\x01\xa5\vxKK[\xbc\x8c\x8e\x19\xf2J\xa5+\x98\x06\x02\xdc\n\xd2n!a^\xe4r\xc1\x8fY\xd6,\x15K\xa8\xb4}\xfd\xcbȎ\x8c\x9e0\xa9\x9bԀ\xf6a\xf4L\x14O\x81\x897l\x06\xb5\x15\x91\xf06\x1c\xeak\x89\xe0@\xc5\a#5!:\x04\x1c\x90%\x05G\x95\b\x18\xf0\x1aD\x10\xf7\x1b\x8d\xb6\bԁcWdm\xf0\x180\x19\x93>\x00d\x89\x05\x7f\x0e\xd9S\xba\x8b\xb9\xe5W\f#\xac\x83.\x99\x89\xec\x11\xa8\x985\\8\x8f\x989\xf2\x03\x87\xd7'u[ \x83\xe2\x13\x8c\xc8\x1a\xd1)\x1c\xbb\xb0\x01\xa3\xb9\x04\x8c\xfa+k&\xf8:\x9d\x8a\r[\xa9\xfeim1Q\xc8/M\xd3I\f\x87\xa3\xbc\xa1r\xbd\xb5Vݴ\x8a\x80\xd7m\xb3\x10\x1d\x18\xec\xae\"#\x8fZ\xeb\x00U\xbd\x05\xa7\x87\x9f\x90́\x8f\x82\x17\xcams\\0\xd44\xb6\x05\x15\\\xc4)\xb99@\x9e\xc3d\x86\x81\x0ey\x06+%a\r\xca\x0fe\xad\xc7JP\xec\x06\xa0\xf7ϧ\x16\x8f\x02\x0e#_e\x17#-W!\xc8f\xa1\x97\xf6T\x93\xb7\xea)\xfb\x85\xe5/\x8eb\xe6\x1b\x0f\xc6r\xad\xa2\xa0ܑV.\xff\x00\xbc\xc1\xcd71\b\x9a[`\xf8\x9c!\xa2e\xbd\x9fZ\xf0\xae\x9b\xfd:,\xccir{f\xe9\xd2\xeb\f\x98\x14\xa2\x8b\xee\xad\x00@\xdf\feB\n\x1e\xb4\x90\xa0'\x8a\x9f\xb2\xbcc44\xf3ü\x14K\x0fD\xa1\x1bM+C8\xe3D\x87\x18\xe32\x12\x19q\x9d\xe8\xe4h\x8c\xf2=\x91'\xfbcqx)\f\n\xd3Y\x92A\xc8$\x04\xeab|\xf8\xad|\xaa`o\xbc\xd4gr_&\x8a\x1a\xdb\xfeF\nӂӭK=P\xecF\xc6aA\xae\xa8\x17\xa4\x9a\x853\xb7\x0e\x1c--\xbc\x99-\xb5F}\xf7\xed\x13\xb5\xd6\v\xb0\xb4|a,U~ \x11Q\xed7\x01\xfe\x93\x84R8\x05\xbb\xa6\x13\xb4\xf4\\<A\xfam\x1f2\xf6\xac\xf7\x1c\xb8\xc8]n]\xb2\x86\xcc@\xac\xa8D\xf8\xcbW=\xce\xc1\x9f\xa5h3V\x92\xd1QS0\xc3\x0f46c\xdc\a!\x14\xcdUȒ\xe5ډ\x18}\xc9H\x8c(\xaf\xfa\xdb \x81\x19\xfc5\xb8:\xb81\xad\xe2\xbb;g\"\xb5\x8a\x0f\xd1\\\xcb\x7f'E\xceY\r\x05\xa3\xf9\xca\xe3\x96\xf8Z%S\x04KϢ\xd7K\xd98jNk\x186˺x\xde\xdfe(\xef\xeeO\xa0p\xcc\xc0\xfc\xf9\x8a@w\x06\xd1[s\xac\vO\xbf\xa2F[\r,\xdd\xfb\x86g\xb4KC\x0e\x06\xf8\n\x19\xefr4\xbdT\x05X\xeb\xb4D\x9e\xc4\x15ꬵ\xcav\xbah\xe7\u009d\xc8\xf6\xb9.\x82\xa3^\x16\x98\xf1\x00Q\x19\ru\x1554\xe4\x88\xc3\xc80\f\x0e \xd6f}\x04\x11\xe5\t\xb9\x98\n\x94\xbe\x88n\xad\x88\xc5P\x9b5.\x84\xb6\x1a\x87\x12e\x05\x89Է/\x8b\v\x02\x9e\x92!>\x06\x93\n\x8e\xd8oI\x94\xd1\xf5\xf3\x12\x8d\x0fe\xa3M\x04y\x8fF\xfamٯz\xf3Ж5\xbb\xf4K\x11bua\x7f\x1a\xac\x02[O\xab\x18H\xbaݢ*.?:F\x9b\xecr 6m\xbd\xfa\x9d\x87\xbfˢ\xf7^p\x8a\x00KyB.\xad\xb7\xa2\xa2\afy\x83B1\xa3\x92[\x1f\x98S\xea\xa5\xfc\x86\xca.\v\x86\xc5p\xc6\f\x99\xde\xc0b\xe1\xd1!\xf6yA\xea\x81Y\x9b\xdb4\xb9#\xc8$#C\x80\x12O_M\x98\xa1\x12\xad\xf6\xbfb\xcd\r\xe6cV9\xa89\xee\x1aN;/\x87\x89$\x16\x80\xcb=>\x8c^\f\xa3\x9f\xc9J\x84}\x9d\x85\x16K$}t\x8b\x92\x19f\xa1\x91ȃ<.\t\xff\x8f\xaf\xb7h\x8fd= C\x03P(\x8e\xf1C\xceBb\x86X\x88\xc2#b4\xc1\xb0=\xaa\x9c_R<r\x17\xb2y5\aw̿\xe6\xe6\x9a!\x89\x04\xa3T\xc3\xf0t\xf1\xe4/\xf2\xdb\x0fÿ\xd8\xfeͿ\x81`\xda3\xf2ð\xbc\x1d\r\xff\xc2L\x9e\x83\xa2\x7f@\b\xb1\xc5\xd4\xd6j\xae1\xbf\x84_\x8f1\xc35xo!C*\xcdP\x02\xfd\xa9\x88\xb1tD\x8ath\x03_c\x96$\x94;*\x9dC\x02\xa6\xe9\x1bW\x88\xe2\x18\x90\x8d\x83\x8cP\x92\xddaL\xe0̬\xbd\xd4\x1d\x85X\xef&\xb9\x8e=W!\xcar\xa4\xb1\x92\x18`\xa5*\xee,\x9dN\x931\x90>\xf8\xa8XK\xe9\x05\x90\x14\xd2ɀ\x11\x8c\xe8\xd4\xe1\x10!P%U\xackN\xe3#\xba\x87_\xf4\x9a1\xf9\n-F|UYqR\ue8a4A;\t\x02\x856\x8c\xfa\x8b#\xb5\x15r\x8d\xe5VR܂\x94-v\x1d\xc2\xdbP\xdf4\xbd4G\xef&\x9eT\x0e\xa7\xf3#\x81\xc4C7\xa0\xecTz\xfa`\x19\xc2\xc1ř\xe4גC`\x04'\x1fB\xf7R6\xb4\xdc\x1b\xbd-\x1c\xbe\xf2\xdds\x99jG\xa7me\xae\x15-S\xd9\xddo\xab^چg\xe1\xdcv\x87Z\x92\x14\xdfl5\xafq\x03\ad\xe7j\x01\xa2\x90\xbb\xccܶ\x19R\xfd\x16&,\xa5@\xb7h\x8d\x18\x14\x88\xc0\xfe\xd7\xe4\xbe\\\x94$ЊB*\x8f4\xc4*,\x11\x9c\x178\xfa\x94-\xe7'\x9dI\xa0(\xfd\x00&:\xf0\xe0Ot\x91s\xc4\b\xa5\xb6\x8c\xb7\xd5\bc\xedRr\\\x9cﶻu\xf6q\xad\\\xb0Mb\xe8\x1f\xae\x8e\xb8=\xe2\xb4\xf6IK\xa8\x16:7 \xa5\x1c\vXC(\x1c\x90H\xdf\xddO\x06\xc7\xcc,\x04P\xf8#\x90+\xa8%\x98\x89\xad\xed\x0f\x83Z\xe7\xd5l\x8e\x17\xf0\x03y\x12\x80\x04\x03\x89\xfa\xb0\xfd\xad\x00\xd84;L\a\xa0\xb65K\xa0\x82W#\xffc\x80\xf8\xd9\f9\x91\a\t\v\xddam[s\xba\x88\\\x82e\x91\x928p\x9f8}*,\x9f{\x0e\x85\xb5\xf6\xb2f\xb3nl\xe8\xb9d\xfc\x13\x8a\x1e\x84\x82\x02׀\"=\xb5W\x91\xb9\xa2\x94\x02ٳ\xf7\xd1\x7f\xa9\xe0֩\x87\xc3\xc7\xee\x86\u0ee5\x8dU\xa28\xe2\xaf\xf7\\,\xc3P\x8eV\xc7P\xe6\xe3bAwt\xf2v\xc0\xe8JR\xda\xf8m\xab\x91\xb9\xd1Dt\x12\x14\xbcﬦB\x06\x9b\x04t撵\x0e\x04\x99P\x01g\x16\bdd\r\xfaΞZ\x00\xbeC\x14c\xfd\xa5Rz\x02\xae\x00\xd0\x02\xe9\xb4ģ\x81\xc1j7IZX\xb1ғ\x9dH6+=\xed\xc1\x86=_\xcf\xe3\"6\xfc;q1\xd8\xdaTQ\xb3즂^pfm\xe1\xc0|\x8c\xe8\x8aɕy4\x9b\xc4\\\\\x1e\x81\x15\x8c\xd2BH8P\x91\x87\xecc\x88cpi$%\x90\xc3\x05w:*\xf3\x1c\x03J\xfd~1\xb2\xd4u~\xcd\x13UUO\xf5\xa8\xb8\x84\x18\xd9-\xad\x95\f\xadԌ\x8c\"\x89\n$\x8b\xba\xd5\x1cF\xfb\xe4+(\x8d47\xd0vS\x0e\x13\x1c\xa7W\xe8٬HF\x14\xa7\xb28!\x94(J\xe7l1\xedi\x01\xe9>\b\x83\x1a$\x0f\xc3r[A\xb7\xcd\xc3%DYb\x85\xfd\nW\xa7\xe6\xbe\xe1^\xda\x007e\x1c\xb4\xa5\xc1\x1ad\xdav\x16\\\xaa\x7f\xe5%\x9aIÔ\xf9o\xa0\\\x92\xc5]\xaa\xf9>\xfdۻ\xb3g\x00Qn\x18\x83\xc5f\xb5\x8a\xb9*U`\x03ł\xce\x18\xb4n\x99m6\xbdq\xa1\a\xb0t\xa1\x01ԞY\xf3\x8c>d\xce\xe1\x9a)d\xe4\xe10BY^\x9a\xb3\xbe\x87~\x0e\x19Ϯ\x99\xbb\x97T\xb8\x1c\x16a\xbf\x81\x86\xbe.\x81\xe42\x05\b\x87\xb2s\x04\xc4&\x19\xef\xb4\xc0%\x10\xd7\tX\x8f_\x1dD\xdf}\xf7ݟ\x10\x06\x96A\xd5I\xf9vl\\\xacߐ\xe41\x02\x94\x04sg\xae]\xae\x12\xa7v\xb8\x88+\x15\x86+i\x1f\x18\x9f1\xaegf@\xc0\x03\x00\xf2踼-\xe0_\xb4\xcb\xf7熖\x80\xdb%(\xe1\xcbC\x8dHoh4\xff\bc\xb4\xfe\x81Q>K\x13\x11\xf2\x8bD\\\b\xc1\xec\xe6>E\xb8G\xfa\ue47e\xb7\x89\xf4]\xb5\xdebu\x85\xddmr\xa5\xe5D`\x12\x11\x83\xf7\x97E@\xe9v\xf8\xc5ѭe\x1a\x84\xff\xbdIf\xb1%xݳ\x8b\xe5\x95V\xc0\x04ݠ\x01\x9c \x0f{\x00\x85\xc7\x02\xa0`\xcf\xf5\x96\xcc6\xf6H\xf5\x10\b=\x04\xc2\xd6 \x10<\xaa\xb3:\xb1jMl\xd3\r\x94`\x87\x0e\x10\xc8W\x8d5\"-\x9ahPM\xf48\x84\x93\x02\x03\x95\xa4\xfaZ\x1a_S-\x8dV\xd5 P\xcarI\xd1\xcd3QHuA\xa8Z\xe9Ͷ\xf2O\xf4x\xa5\x12Pg\x88\t\xa7\xbeF\x8e\xefpa\xa8\x87\xaa\v\xb5\xadjP`\x1b|\x97M\xee\x83\x10\xea\xd6[\x87\xfet\x00\xab\x8d\xc7{w\x85\xe1UϨ\x1c\t\xbc\xa9R\xcf\xc0\x97Hڦ}(\xb1xFS\xfe\x85\xeb\x06\xce\xfd\xe8M\xe50\xb7\x9bѬ\xae\xc7O8\x18\x1b\xe6\xec\nU5v\xbb;B\x9e\xa0\xcb:\xbc\xd70\x9e\xfc~\xc68\xdd\x0e(X\xe3\xb4\x02\x8c\xa8E\xf8\x86\xb8\f\xbc\xc3\x1c\x14\x97\xf2F1l0\xe0% \xc2h\n\xa4\b\xa2Ew\xf3\x99Y\xcf}B\x04_\xfe\x81,\xb7\xfdsG%\x99\xfel_\xb53N\xdfE\x8ai3\x0e\xfe\x11ƅ\xc6g\x01C\t\x1a\fm\xb4,\fg\xa0\x93tm🏸J\x1f^%\x88I\x87\xe8\x12.\xea@\x86\xdf,\x0fM\xcbY\x1f\x86\x87A\xee\x8f'\xab\xf5n\x13O\n\x84\x86y\xb1\xfb\xe2\xf9\xf3NQ\x9b\xad\xc7)\xbf\fQ+\xf8ٳe\xdc$\xf1\xc4\f\xd5l\xe7\xe8#_Z\vq!\x10\xf1X\x19M\f4P\x1d(!+ubs})\xec:\x9e`Jv\xc1\xde\x10,\xa4\xc9F\xee\x022\x9dGMC\b\xa4l\x04\x823\xb8T)\xda\xe4\xf3\xccFT]\xe5\x00\xa7\x83\x14\xc9&\x11\xab\x140\xc0DV\xb8\xf8\xecS\x95\xa4\xfc\xf8\xe3Juá\xb3\xfd\x91\xb8\"\xb9\x04\xe1\xf9\x8d\xd9C\b\xd0\v\xd4]2\x9dM\xe7S\xcc\x13ATbN\x10\x99C\x05`k\xcc\xc2\xf5\xa7eV\t%\x92H\x82\xd6m`\x990C\xf0:%㤖\x95\xfa\x9da\xa0\xfc%\x1b\x01\xf2\xa2c|/\xb0۟\x92\xc0\xf9\xfd\xf9\xfc\xfc䧤\xaa\xad\x1d4\xd7a\xf8|.VYD\xeeح#\xa7\xf9\x1c&\x93\xf8\xbe56\xe7\xed|z\x99\xa0\xd0ЌAq\a\x11\x1c{\xe2Vc\xeb\xe2\xc4\xcf^a<e\xf8\xe2\x16\xb2,|K\xbc\xf3\xa8ѷ:FX/\x0eJ\xfa9\xbf3\xb3\xae\x8c\xac\xf9\xd4\x05\xe0<Sk\xef\x0e\x91\x17\x90\xfb\u0085\xb0\xac\x7f:\xd8\u0379\xe2\x11緖\x9fq\xe5E\xf5\xce9\x9d}\xff\x90\xbfpE,_`\xbf\xb2\xb1\x1bL\xaf\x1a\xcd\xce\xc0\x05\x1f8\xfe\xe7\a'\xf4H]\x00\xa8\xd2;\xe2P(\xc3\xcao)\x02ܴĜ\xd4!\xfe\xeb&\xcf?\x96\xcem-B\xd4\n\xf7\xc3~\xd9\xdd\x100\xb5\xe7\xf3j\xe5ˡ<\xec\xb4\x01)\xc4\xfb\x98\x9ejK˯\x05W\xf2\x8bߐvnF\x89\xde+\xd4a\x9d\xc9;\"w\x93\xd4]\x13HĊ\xd2\x10B8\x98.\\w\x1a+N#\xf4\x19\a\xf5\xe6J;Dd5\x17\x1e\xae\xeb\xb1\xd2RK\xd8\xecs\xe4q\xcf\xff\xf0\x87?\x98-2\x03\x02Y@\xf2\x17$\xb0.\x86\x108F\xe57,\x94&$)\xb2,Ѳ\x93e\x8a2\bf\xc5#\xb4\xc2$\x95\xec\x04r\xe0\n\f\x00\xa1\xd9a_V_\x00 \xb6d`\xae\xd9G\xf37ᦹHZ\xf2\xaeJ\xd4\x18u6\xb5+\x001\v\x1d\t\f\xafuc9'^\x90\x9a\xecܖ\x82\x8a\xe8Ȝ\xd8>۱p\xfe6\xcf/\xef\xabd\x853\xc6o\xb0~\x85\v\vH\xe5\\\x81\xc7\xdc\x04\xf4\x9b\xb9\xb0/i/\xd1x\xe3\x9cb`\x89r(\b\xbci\x9c\xc5\xd7\xe8\xd0\x04qJ\x00\x9d\x00\xe0\xe62\x011\xbc.W\x17ɵY\xc3\x02\x16\x88\xf5\x8eƁ\xbe\x0e\x83\xe1\x11X \x02\xe0\xcclYp\xa4\xd4\xf0\xa3\xf0\x18JH\xa1.VR\xdd\x16\xaaf\xferH\xc1\a\x8eJ$,A)4\xa9\x01\x01}\x82\xb6 \v߮J`X\xf4\xc4\xd7\xd49\xd0\x1dJCHQ\a\x19\xa2}\x81\xc3\xea4\xe0I\xac\x8c\x9a1\xe5\xe8\xbf\xc4\xfd\x9c\xc5i\x11=u\x05\"\x18\r\x9b\"e\x01\xf7\xc3\xce\x13\xac\x1e1T!\xa1\x12@\xa0P\xc5\\\xc2\x04ފ'\x91\xcc\x05\xd9a#\xac\xd2m\xc5<\b\xf3\x06\xe0o\xcb6\x99\xb3\xf8\x8b\xf4v\x94H\xe2.\xf6\x16\x82Zn+=\xef*\x93{\xf5\xbc\x15T\x000\xd6\t)\bB2k\xe7\xc0\x907L\xa5\xea\xac;\x9f\xfex\xd8(\t\xdf\xe5\xfa\x9e\xc6㼌~\x9c\x18\x0el\x16\x01\xa3\x10\xba\xded\xf3I{\x8b\x17^_L!Xz\x7f\xa7\xb9\xa1`y\x01;\x8a\x0e\xf0\xf5ˀ\xb3\xa6$W\n&\x02\xd8.w1e\xa0p\x89\xf0\xf3t\xf62:R\x80\x8a!\xc3QZ\xae`\x18B\xf8\x84\xf2\xe5\xb6ˆo\x18x\xfcMq9n\x05\xc1\b\x87\xa6\x14x(\b\xc1\x902\xfa\x02C0;\t\x11\xff\xa5X-\x7f>\xda?\xdcc\f\x89R>\xbeg>\xbewj\x9e\xbc9\x02K\xe5M~\xb7[\xe5\xbb\xe6J\xed\xa6UЩ\x9d\xdc\xe3?\x9b\xae\x13z A\xfa(\x14\x98e\x83\xdab\xf8;\x90\x04s ߗ:O\xcf4\xdeK\xaaўY\x97\x9b=\xee\xfa\xf3\xcd\xc5\x1e\xe8\x80\x05ǯ\xb2~`\xc6\x17I\xf3\x87\x1e`\x87\xda\t3\xc3?\x16\x9d\fx\xce\aC-\xb5\xf9\xfa\xe7[\xdc5\xd9\xedRKh\x80\xaf>\xf0\x9ct\x8a\x12\x02\x9c\x9c\x82P\x17Ȑ\xa4G(\x87\xa8\f\xbbx\x0e0\x10\x95x\x1f\xa8\v\xff:\x1c_\x81<y\x9b\"\b\x03\x17\x93(#{\x1f\xb4\x80\x93N\x1el\xba\x9d1,e\xa6\x0e\x8deފ\xdbJ\xe7\x11\xd1W\x1b\xe7\x11\x12̳\xcfu\"\xdb\xd9rό{f\xdc3\xe3\x9e\x19\xf7̸g\xc6_\x1d3n\xc3G\xfb\x8a9rBŢ̈\x1d\x8eM\x90/7\x9b\xd5\xc3\f|\xf4Vdƅ{O\xe1\xda\xf4\xd1\xcf}\xf4\xf3W\x10`\xc7\xe5j_\x13T\x16\x9e\xe7\xf0=@\xa4A\v.v\x8f\x7f\xb3\xad\xdfJP\x16\x92\x82\xadx'\xf9\x18r\x1a\xact\x17\xbe),\xaa\x96\x98\x1f\xfck\f\x14\xf3\xaet\xb9J\xe4X\viy4\xc1d\xc1\xa3B\xe1e]+\x93\xfa\t\xa3\xf9%\"\x80\x8ck\x05K\xdb\xd6\x01=@\x18\xbcȉo9\xa5\x1dc\x06\x0f\xc0\x89\xe4\x88ݑ\x8d\xd1Ì\xce\xc1\xa19\x95\x048f\x15\n\xd1 N\xc5\xec\xfe\xd8\x17إ\x9e\xae\x17d\x1af\x88\x1dCM\x83//\xa8\x90\xbc\xb8}-\x10U\xa1\x00\xb4\x10\x8b\x18\xe3r\f?\x8b\x8d舴x\x8d\xfa\xc9\xcb*\x17c\x1a\xf5\xa3\xae^\xbczj1\u242cW\xbe\x98\xd0P\x1f\xb2Lq\xbd\xa8\xef@⟂\x85\x89W\xac\x1e\x8c\xa0\xcd\xc1ô(\xafz\x15a2\x9c \xd2ڔ\xe4\x15_\a\r\x8f\xb0\xcf\x1dy\x14\xb9#m\x9b\xb3~\xe4\x88\xeaeO\xf5>\xd2\xe4x\xd3\x18\x800\x99\xefsU\xfa\\\x95\xed䪴\x93\xc2MD\x89p\xfeJkS[\xe1\xbe.WwW̧i\x865\xe3[\x83\xcf$f,k\x04\xa1]Y\x00\xe28ʒ\xbb\x89\xf3\xee\xab\\\x9aK\x01J\x81\xa0\a`\xc5\\\x03\x00N\xba\v\xec\x1c\x15\x80\xaf\x9d]\x0f\xb0Ӵj\xc6\x12\xdaT~\xdfJ\xf6<z\xaa!0B/\x80B\x06\x90\x15\xf0_\nZ\xc6\x01=\xeb\x16\xc1\xc4\xebX\xb6\xee\x8a\x15\xe6\xdd\x02\x89F#ﺔ\xf8\x98\xa46\x8e\xa96\xa7\xc5\xccy\x9e\x9676@L\xaa\xae\x10,\x1c0\n\xcf\x1a_\x8f\x98|0\n\xfc͝\xd1[w\xd3r7\xde]B\xa1\x17\x86\x7f\xb5\xa2a\xfbH\xd8薉\f\xd9+\xee\xa9Ы\xcb\xd5\xe5cD\xe8\xd6dE\xe5e\x1f\x91+\xe5\xf8*ҝ\xb1\x9aN\x1b\xed\xe9\xe8ĐQ\u0557j\xb5\x8c\xecr\xe2e\xfd\xa29\x00,\x94\x84\xb9\xcaȨ8\x18\x05\xb4m\xeb\rؓJ+\xe3B\xf5\xc27p\x00\x1e\x16\xca\xe6\xf0F\xc7#˳\xdahV\xdcbX=\xc0\xd3\xdc\xe3\x1a-\xbbp\xedv9cc\x8f>\xb2\xf7\r\xfewWv\xa7\xdc\b\xad\xbbC\x166_\x10擸\x92\xbe\xe6cq_\xea\x99\xd9P\xb43+\xe7\x90H\x80<Rn\x14[`*\f\x8e\xe4\xebը-\x88')\x8ed\x18\xa7\xc9Ճ\xde\x18\b\x85U\t\x82\xeb\xe7y\xaf\xc2/Z4\x8b\x05\x8d\x1bF]\x01\x8fS\x80u\xed\xccÏ@d\xd2ؔȅ\xf6\x9e\xb6R\xcfs\x8fb:bmw\xf8)\xb1\r\x84q\x11\x90\x9a\x1a\xb3z\xe6\xea\x0e\xb5\x8f\xb8\x03\x99Z\x84\xadw\xea\xaf\x16K\xf4n\xbcd\xb4\xe1:3\v\x16o\xa7\xf4V:\x19>\x94\x8c\xfbppz\b\x81\x8a\xd41\x19w\xddXG\xc81\xa9\x96\xa9\x9c\x97%5\xb1\xe6WK\n\x84p,\xb6\x80u\xdb\\\xb1\xaa\xfdD\x85\xe8\x82Z\xe2\xbbF\x1b\b_\x9c`\x96\x9b*b\xa7\x8c\x96-\x96\xbb5G\xca\x05\x98\xe1hw]X\xba\x1d\xf6\xb6l\xe7\"t\x17v\xea\xf3\xa7\xe9\xeb\xe1\x89\xe8\xf3\xb8e\x94VbK\n\x1e֫o\xaf\xe9\x11l\xa6\t\xac*\xe0kaО\x8ef\xf3\x81Q\x91\xa6yq\xffL\"\xee\xd3B\x80#y\x84uR˩\f\xff\b\xe0\x82\xd2\x17\xc2\xd8w\x16\xb2\x0f\xbd\x93/\x057k\xacc\x8a\a\xae\xce\x17\x96\xdf\xc9n\x8d\xd0S\x04\x15@(\xb4U\x06\xe59/\x8d̛\x8a\\\x19#\xda\xe6%\xde\x11^\x89\x81H@\\{\xf1\xc5œ5\xb4\xba\xa1M\xae\xfe\xdb<6\xaa\"\x959\xb5\v\x16\xd83Z\x81\x97J\x8b\xcf9\xbbt\x05C\x18\xbd\xfa\xb7y\x1e҆\xbd\xc7\xe0\xee7\x02\xcb\xf5u\x01(Љ\x11s\xe1G\xa8}P\xa4\x94\xf2`\x96\x1c\xa3\x04ƈUi\x81\xb4{'j\xefD\xed!\xa4\x1e\x83\xdfR\x14z\xbc\xba\xc3/\xed\x15S\xb4\xa5\xa3\xbbQυ!\xa7-\xc9!r\x84%\t*'\xa3\xce\xc1\x9f\xf2\xa8f\xba\xb9\xdfO\x93\xec\xceF:\xf5R\x9b\xa3\xa3\xd6\xc4\xc7\xc2\xf2Y\x01\x8a\xf8\xbd[\xe3K\xba5\x8e\xe1\xe7E{\xc4ƒ\x95%W\xaab\xa4 A\xa1\xb7\xadT\xe6\xf3\x8fn\xef\xc3\xe8}\x18\xdb\xf2aԩ\xdb:d\xb1\xcdgQk\x12d\xa87\xb4\xdcSΫf\xa6\x84j\b\xbe\xd8\xf4W\xc0\x1b\x01\x14\x05\xe8G\x9c!I\xa5\x8d\xf0\xfa\x13\xb6\xba(\xc8\xd8N\x13\xd9\xfeU_jG݆\x96\x83\xf5\xd1L\xa7\x1d\x82\xbc\xaf\xd2IeK\v)+6.\x86\xd8e\x8bx\xf4\x91\xecױH:\xe1\x8a=$2\x04 \x87\x86+DzwѬ\xda͛\x8dF\xde\xf1\xb2\u008d\xde|D\x97\x11C͜\x12\x88\xd7<[\xc1\xfe\x7fu\x87\v\xea\a\x05\xf3\x8a\xedr\x88\xb4h\x97\xb52C\x9d\x90\xf0\xe8\x8cw\xccg\x18\xdf\xc0\x95X\xff\xdc3Zr\xe2t\xa9\xe3\xf6#\xe7\x15D\xf6\xbd\x18Pzt^%\xed\x15ck\xb1XxlBq\x17x\x9c\xfc\xbe\xa7\xf1'\xf4\xc2Ɣ eֶ\xfe5\x82z\xbf[\xaf\x1a\xb9*\xe5J\x01\xa9\xbb\xdc\xfd\xae\xed^\x95\x9e\xfb\xfcGQ䉰9\a%\x8d\xdaz\xb1\xd7z\xd1z\x89\f\x8ed\xce\xf6\x03\u0090ـ\x8amd\n\a\v\xbd\x8a\xdad\xc5;\x95^٢\x05⾝\xdck\x9a\x891qw)U\x02\x84\n\xda^y\xfa]\x81\r炶\xbf\xb6\xddk\xb9v\x9c\x94GE\xd9B\xaa\xab\xf7\x9c\n\xc4:o\x01y]U\xf5n\x0f(\xaay\xd7@\x1a\v\\5,\xc7g\xf6M2\x04\xa9>\x1f\xb9\xa3\t4\x05\xbfP6\xbe\x10\x0e!\xcc'!\x83\xa3\xf9\x95\xa4r\x9b\x87\b\xbf\xac\xfb\x91\x05\xb1~\xdeG0\x85pݏ,\x80\x94\xf0>\x82y/\xeb}\xa4\xedX\x8c\xe2Ir\xfc\xae+\xb8\xc3\xc2\xe6>\xd4\xc7̶\x89\xf8-\aب\xd5q0\xd2ޡ\xecB\xb1\xd1Otn\xd4\xday\xa1.\xf7\xf3\x81097\xcb\xf3\f\x1d\x02Y\x88\xa0'\n\x87V\xab\xfc$˺\x7fr\x1c\xfdD/\x87k\xaa\x14yE\xb2\xe9aK\x85\xca\xf3z]U\xee\xf9ľ\x19ѫ:&\x97ʡB\xf8<\xe6\xa0>~\xc4\xd1N)w\nG\x85o\x95J\xb3\x93u\xc1{\x88\x02.B5\x99#\x0f\xe1ȷ\x89.N\xe7\xeaH\xa6\x84`&yy\x83\xe8u~\r\xf1,3qr\xd2\xec\xe2t2\xdc,\x91\xbd,'GT.=pA&\xf15\xe9|\xd0`o\x9c\x96\xe8O?;{\x1d\xb1J=\x92\xc1T7r\x9c\xacˈ\xb6(\xbc\xaa\xb4\xfda\x18\xafc[hSp,)\xf2\x1e_a\x86/\xe0a6\x80\xed\xfc&\x1d}<\x81\xd5b\xeb\x9aif~\xcb\xd4O-q\xdf\xd8\xedIkF\xabl\xdf\x19\x7f\x1fZ\x06\x91Mݍ\xe1Ҩ\xe1\xef\x11\xbdZz\x9bd\xbaL{0\x96\xca\xde\x1es\x10xX\xc3v\x18\xa0\xb0\xb3Q\x7f\xc7.c\x1d\xf9\x87\xd5\x00\x99\xba\xd0?\x16\xa0\x9a\x93\x87\u009f\x1a\xcdmeV҉\x81\xf4l\xa3g\x1b=\xdb\xf8\x12l\xa3-\xe5\xba\xe7\x1d=\xef\xf8\x82\xbc\x03/K\xdb\x05\x8b\x00,\xb7\x94\x1b\x859\x988lI̓oPQQ2\x88\x01ԝ%w\x1cP\xccs9\x84\x97\xb9~!S\xf8\t\x82\xf4A\xed\xc27\xf1'\xfa\xe0Y\xfa\xef\x84z\xe9S\xeb\xb7\xed'\f;\x83p_X\x7f-5\xfd$\x9f\x10V\x00\x06\x00\x18\xdc4L; _b<\x99\x99\x9d3G\xaeHG\xd1\xe8&.\xe2\x11X\xde\a\xd1\xce\xee\x8e\xf9\xbf\x7f\xee\x00\x01\xd8\x19\xee\xd0\xf1\x00\xec\xc5x\x823G\xacg\xb9d\xea`\xa1\xbe\r\x05\x10\x7f\xff\xbd\xa1w\xa3|\x8c\xac\xaa\xc0d\x89\x86g/..Ӫ\x88\x8b{H\x8b(K,\xad\x0e\x05\xe8\xe9\x85g\xd4#y\x05\x81\x1fAB\x01Y\xcd\xf0n\xc9JW\x86\x94\x98uN\xaa\xaba^\\\xef\xddT\xd3\xc9^q5\xfa\xfe\xf7\xdf\xff\xf1\x9b\x92H\xc9\xee\xf7k\a\x8d\xab <8ѽ?\xb3\x8f\xcc\tE\xe6\xe0\x04\x0f\x83\x13s\xcf\xc8\xe6-Ȭ\xf7Tf!۽L\xb3\x18\x81[\xd55\xca\xe4\x10!\xa0}t\x8c,\xc3B\xd3 \xa0+\xcal\x84=K\x13L1\x8f\xc0\f\xf0\x060\xc7\xf6'ʹ\x100Kb\x804\\ &{cG\xd2A\x04\x81N\a\x98\x8c\x00\xffD\xc4\xd8\xec>J>Q\xf6\x8f\xa5x4\xa0,\x81\xa4\x05\x8e\x9f\xc4\x12D\xc0\x01q\xd8rN\rM\x1fn\x96\xb1\x11\xd4<Я\x83B\xec(\x9d\xa4\x98\x8anV纈\xa7 \a\x8e\"C\xd5\xc6X\x0e\x842\xc0,)\f\xb0\xd4u\xfd\xd1\xcct;:\xa1\xa9\xf5Qv۪\xda\xf9\r8\xc6\x13͗4~\x00\xd9g<\x04\xa6\x00\xb7i\x91g\x88\x1bw\x1b\x1b\xca|9al\xee\xe1Ev\x91\x9d\xb3E\x13\x15C\xa6\xd4Ul\xf6\xbe\xe2\x0e\xcd\rS\xec\x1c\xa5aK\x80\xb0\xb59=\xbbD\xc3\x00'\x18\x01\x7f\x83\xdfl2\xf9,(4\xbdU\x82\x19\v\xfcՖS\x86\xd0]\xb7\xf7\r\xfe'D~$t\xb8-$\xf8\xde\x13\x96y\xe1E\xd2a\xcfG@\x14_(\x96\xfd5\xb9?[\x90YVk\xa2\xf6\x1d\x186\njԨ\x1e\x88\x05I\x11\rM\x1d3%B\xd2*w\xa6\xf8\xb5\x8d#\xc6\xcb:\x8c|\x18\xf8t,\xed̛\xe1Z濪M\xc6DΝ\xd2II\xebox8\xf2\xce=\xf3ù\u009b\xdbG\xd9}\xe1(;>\xfc\xeb\x86\xd7\xf9~\xceRXŦ\xb1u\xccsz!\xb4\x0f\xaa\xdbNP\x9d\xa2X+\t2\xaa\\E\x93\x10\x8d\xe3\x19\x95+\xa0;\x84\xa2f\xb3\xde\xc9\xdaR\nT\x00\xa3#J\x8a`\xa0\x94\n\xe4\x98a\x99\x93y)'\x1ee\xe1\xa6\x19#v5\t)\xd8g\x18\xbd\xcd+\x8bsK\x86D*\xa072d\rg\xc2\xf3\xf2\x8c5\x161\x80\x02\x06\xc9t\aEH\x9a\xd2Q\x1b\x11\xf2\x8c\xd5\x03\x8a\xce\xf2e\xb0\xc0\xf8}V\vQ^\xcc\xd0\xdcR\xc9\xdaX\x91\xdf-\x12\x99\xe7\r?\xccK6hq\xac\x14\xb0A\xa0ݼ7\xf23\a}\x98\xa1ւ\xec\u0f98O\xe0\"/\xf8\xb2\xabI\x01\xb0\xb4\xe5\x801\x03\x1ao3\xb1\xe0m\xc6\x0f\x92<\x94\xea\xba\x16\x04\xe7`\r\xbd$\xb9\x8a\xd5\rWa\xa0\xe7[&\xd5|F\xfd'Ea\x18\xbe\xf90\x18\xaf(\xfb~\x1a\x17\x10O(\xc2\xc30:\x81\x11ZY\x00И\xd1\xd8,\x1c\r\xbe\xc9\x16\x17\xfc\xc8\xcep\xb8C`\xbb \xc4A\xd9/\xb2\xeb\xc1\xef\xdbH\x9f52\xe2y\x0ec\xc2\xdb\xfe+\x14\xbe\xb6\"{\x9d\xb6Wf\xac5\xf0\xfdf<\x10\xfb\x145\xdc\x1b\xd4x\xf2\xf9\xf5\x8dv1P\xd9@\xa8\x94r\x9b8\x12\x87jr{2܂\xfd2g\x8f*uJ]\xa5\xd8\xf5Cߒ\x11[ʣ+\xb4\x06\xe5r\x1aD\xf0{ԯ\x8fs\x18\x8f\xec\xc7]\x95.\xfe\x16R\x05\xd9\x15\x1a誆\xe9\xc5>M\xcb.\xce<v\xb1u&\xb1\r\xd6p\xa6)\xff\xdaX\xee+\x14\xf2z\xc7\xd7\xe9\xa5*h\xb5\xb5\x92^\x1a\x99\xe6\xf7\xdf\x7f\xff\xdb(\xf2\xd5sߞ\xfbn\xca}7\xb50\x94\x1d\u061cx\xce\xdf.\xe5\xf4\x8e\a1$\xc5N\xe9s\x90y\x99l\\\x1a\xa1\xae\xc2.'\xfc\xf3\xc2hD\x90џ|\n\x9bCt\x03\xe7\xa3\xc4_k\xe0\xdd\x1eڎ\n\xa3U\xe1Ά6\x03\x82,^K2t\xab#yi\xc8ET\xff\"\x1c\xa5\x13\xacu\xaa\x7f\x1eF\xd1\xdf\xc1\x90\x8d\xafP!49\xc7\xd6\x13Z\xef\t\xb0|\x14\x94O\xc0\xe9\tv\xff\x93\"\xbd5\xd7\xfd:9*\x8d&т\xed\xb1\xdf\xd2R\xd01\x9c7\x1f\xb5\x1e\xac\x90\x06$\x11\xea \x1br\x88\xd3\xe6\x97\xd9+\v\xd4q\x16c\xe2\x03\xbf\xc0\x94\x1aNZ4F\x8aO.\x04\xea?\xa5C\x95\xe5\xff̒\xbb\x7fBo\x86aB\x04\x83\xac?f\x95d\xb5Z\xb7\xb6\xeb\xd6\t\x00\r+\xe6\xe0%\xbf\x8b\xefK\xf2\x16\xf8}\xa4\xe5\xcb\xe8ų\xa8\x98#\xf8\x99\xedc\x1c}\xfb\fE\xb1\x83\xfd\x93\x7f\x9e\xfd\xf7\xd9?\xf7\x0f\xdf\x1c\xbf\rޘQ<\x8b/\xc1\x1f\x90&-\x90$\xba\x05\x9e\xa0\xf1xol\xf6\x8a\xbd\x17\xf3,\x03\xa1\xc0\x8e\xa9V\xe5@\xebv\x9c\\\xe3ux]ę\x8alRx\x1a\xf3\f\x11\x8dW\x8849\xd0s\xc1x\"Y\x8f@\xd0\xf6<\xd3똹C0&\x1d\x14b\x8a\xb8\n\xae\xff\xd4͔`\xc3K4\x12\x99\xf3z\x1f\x815Ҝ\xf9\x84J\xc1\x14yn\xb7\x1d¢V\xa8qHQC\xa7\xa6\x03\x17/\x16(Qn)fZ֊(Ǫ\xcc\"\x8e\xc3Ŕy\x15\f\x16\x8db\x9e\xed\x93D\x11>\x17?\x1d\x1f\xe2,\xe7\x19\x1b\xb6\xaa\xe2\x9eL[\x0e\xef\xb6~\xd4\xdf\xc3r\xf2\xce\xdacA!g\t\x88`\x86Ma\xady\xbe3i\x16&7\xc0ΓUɔ\"I\x8a%\xb7Ц\xb2F\x9c\xba\xa0\xfb\xc0z\xbd\xcd3صE\xb1E\x160ޭ\x0f\xb26\xbe\xc41:8q\xcf\xe6R`\xa3B\x9c`x\xe7\xafs@gbʎ>\a\xf1mQA\x9d\xb8\xb2ˋ\xe1S\xae\x00\x11\xa4\xb6\xe4\t\x89\x1e\xfc\xa9\xf7f\a\x9fGO\xe1[\x84S\x03\xa1]\xe8\xe4@i\xa0Fj\xae\xa4\v\x1c\x12\xee\x180\xe8+B/\xcer\xa8\x06}#c\xb2\xd1b\xba\xf6\xfcW\xb0\xc1\xf5\xf3\xff\xbe\xb5.\xc8\xfb5\x8e\xbf\xbe\xff\x18\x9a\xe7\r\x93vP,\xa8\xcdBM_\xf3\xe5(\x93\xc5\xc9@\x18\xf0ƚ\xdfH>\xb50\x05HN\xa1/\xd67\xf8\a\x9dB\x90&\xe0\xf6\x01]4\v\x90O\x1b\x1f\xb3ɝ\xea\x03\x8fx\xc9;{?\xfc$\xacv\xd9\x13+\xe0\x86dN|@\xfe\x11\xca{\x8d/\x01;\xcef\x1e\x97\xf9UuGR\x1f5}\x8aKI\xa5n\x06\xd1\xf4\xbe\xfc\xd7\xe4\x99\x04Pqx\x01l\xc6\x04\x8b\xae{\xad\xa3\xef\xbe{\xfe{UQ\xc3ܛO\xf7\xa4\xa9\x01\xf6^\xe64[A\xb6\x14\xac\xc9\n*\tg\x18\xb8\t\x1a\x16\x82\xd2\xd1\xc6g\xe5\x1d\x82s\xb1g\x85=\xf5\x05Z\xa8\xec7\xfa\x98\xbb\x1e\x89\xa9\x8f\xf7z\x14HL\xbaHL,4\xe5\v\xe3\x141\x11\\\x86\xc5\xf4\xa6K\xb1\x17;\xa3\xaf\xa9P\x8b,\xc0\xa6\x10M\xc2f:\xfbM\xb1\xf9>\x15.oeN\xfc<\xba\x84\x1be.\xf45jE/\xa3\xdf1\xcb\x1a\x18j86߫\xf2\x1c\x17\x1b$/\xb6\xe4\x19\x8a\x7f\x13\xcf\xd0\x0el\xfe\x99\xce\xcc{\x86\xa4\xd2N\x94\x84U\x0e\xf6\x00\xf4eV\xf7أ\xd1\x04\xb3Q:\x8b9!\x96\x8d\xab\xaaP\x1e\xb0H\xd35\xfc\x92\x17H\x7f\x7f\x87'Y\xc5H\x94=\xbf\xd92\xbf1\xab\x9dc\x1e\x95\x7f&\xce\xf3\x8fIhE\x17\xb4\x96\x1a3*\v\x04\xc5\t1t\xa0\x8b\x03m\xf8$\xee\xc4|\xfaxA\x11\x05\u05ec7\xe4:U\xd8\x1f\x0e-F_\xb8!\v\xf8Y\xe00\al\x95\xa7\x92\x89chXY\x80\\LS\x0f\xab%\xa8%\x9c\xcc'\x933>JMU\xb3\xd6\u008f\xd2\xf1\xf3\x86Ji\x92\xb9\xfafuC(^\x03C\xac&\x12ڊC \xb3\x88E\xffu.7\\\x1d\x7fa!\xf3\xac6&\xc4\xf7F\x89\x90\xa3\xe9\"yr\x99\x8cb\xf8\xaa\xfc\xcd7\x8c\x17N\xd9l\a\x9192\xe1\x9e\xd1\xeeav\x06\x8c3\x96\xc0~$\xddy\x9d\xc0$6\xf8\xec\xd1\xcc\xf7\xbeq\xe1Ȼ\xf8\x13\xac\x0e/\xa5!ݻ\xf1\xae\x19\xde\x16L\xe8\xe1ԩ^\xbc\xeaū\xa6x\xd5F\r\x14\x11\x10\xbf\x97bD\x82)\xc3\xca\xf6\x9c\xaf\x8bG\xf1\xc4\xeb\x1b\xb8֏#\xc4/\x90\\\xb8\x04\x17\x1dc\v:\xe2\xa2o*\xed\x88\xf8\xb2\x96\xd0\xd3\x16\x18[oS\x0f\x90\xf5\x04#\x0eD\xe9\xe3e\x1fA\xb1-\x7fk:G\xc9Vq\xf9\xd1ݟ\x04\xf8\x8bB\xfcq\xbf\xb3T\xb2\xcbR\xc9\xdeV\x02h\xbdcܳ\x9f>\x90v[\x81\xb4\rJ\xb7\x1a\x8d\\H\x1c\xf1\u0091\x8b\\\x87\xa8\xe3\xb3\x1e\xa1\xf7Q\xd0Bٍ\xed\x11\xa9\x9e:\xf5\xd4i\xbb\xd4i\r\xb2t\x92\x17\xedd\t\x1e\xba\xb4s\x1d#k\xfe\xc7\x17\xc2h\x1e\xe0\x1b\xa9\x13)\xf8\xadI\xa3\xb2\xa5h\vx2\xd1٢\x02\x14\xad\x1d\x92B\x10m\xec\xe3\xe1۳\x7f\xbe\xde\xff\xf1\xe85\xa5\xc3\xc2k\xa5\x8b\xb2U\x96Pz\a\xcd\x1d\x1c\x8b\xcb\x01\x9f\xd4!X\u0558V\xed@\xe4\xd5\x0e\x87\xff\x99n\x8e\xf8n\xe0R\xd8l!\t\xdb\x04\xbf'\xaa\xefP#W/Z*\x10\xb0c\n\xaap6\x98pLo>nىs4\x1f\x14\x18\x9bAx\xae\x10\x13\x99\xbb@^e\xdd!\xc8G,kB\xe17\xe63\xff\xf5\x96\xbb\x06\xf7\xf7\xeb<\x1e\xff\x18Ob\xa3w\x14\x10\xdf0G+O\\\x96\xe9uִ\xed\xfaq\x88\xa9\x8a\xd0b\a\xe5\u0601\xb9\xf0\x00\xc0ɉj\x19\xf8\xc9\x00~\x06#XU\xa5\"i\xe9\xc0Yt\x80\a\x04\xec\x98K\xb7\xab<\xa08w\x8eY\xe2\x05\x96J\xc2Z\xc9\x13\xf8L\xf0\xbc\xad\x11\fǴ}\xd7<\xe7\xa8x\xf9m\x0f\xaf\xf9.\xac:\x1e\xe9N1\xa8\xb3\xc5[酻Ɏ\xb9p\xdb\xfa1Y\xf8\xa5\"\xaf\xf2Q\x1b*\xcb\xf1I$\r\\M&\xbc\xad\xd1\x19\x856\x97\xd1œ\U000c34cb'ȍ/\x9e\xbc?<\x01\xec%\xb5'\xe6i\x18\xf9\x11\x03\xb4Ç\xf6-W^*<\xb0\x16\x9a{Φ.\t6\"\xab\x1cv\xa6\x8e\xa0\\x\xeeI\xae<Ӄ\"ή\x93\xe8\x05t\xf6\xfb\xff\xfc\xcf\xef\xfeӴ\xd3Q\xecFV9\xde\x7f\xbb\xffϳ_\x0e\xfe\xf9v\xff͑\a\x1a\x14[\xbc\nu\xa2'y\x0e\xe1\xa9\xf3\x19\a\xb5\xa0\uf60e^\xa6\xc3\xd1O0\xf8R\x05K\xc0\x1260\x89jn~\xe2ݼ\b;\xf0\x8a\xa5/%\x191\x9ej\xbb\xbd\xa1FϘ0\xd9V\xe6~\xe6R'I\x0e+\x05\xc0\x8e&f\xd2Iq|b.z\xc6B\x95\xc3\xfb\x11\xecX|\xad\x82\x90/\xcaq\xaa\x8fd\xbb7\x868`v\xbd\x1b\x8b\x8a\xb7*\x7f\x9cW\x862\x98Cn\xb6jx\x9cU\uf2b3e\xe1\xa8\xce\xe9\xd5\xc6\xcc\xd8{\xa7qy\xe3\x8aؼ\x04:\xc5\x14\xe8Ba\xf7xBc}\x1fk\xb5\xaed\xe5\x9b_\xb4\x8fĐe\xfeU\x83\x19\xb3\x16\xf9\x8c\x8fA\x8d\x16S\xe4\x87\xf9\x85o\x84\x11\xa7+\x8e#1\x87E:\xf3\x02\xb6\xa7qƝP\x9fp\x14\xcd\xf1\x05\xf3\xb4\xe9\x04#\xea\xcbNt\xfc\xcf\x0e#xЅ\x82\xab\xb3\n\xf6o\x16\x11G7pK\xc76\x80\x81DFs[~\xc1\xecs\x05Yq\xf1\x04\xce.\xa0\xbfQ)R\x96y\x9f^<\xb9x\xf2l\x10\x11\x94\x15\xbe\xe4\xd6qh\xdf\x12\x9b\xbb[\b\xb8%7I<\xc6\xf8sw]\x80%b\x04\x05b\x9bqp\x99E]F\xe43\x8d\x18\v\xd2]t ;9\x88\x84\x91\xd2\x1d\xf3Y\xe91\xdfO`T\x8c}{\xf4\x89\xb4\x1d\xa0L۽^\xb7i\x01Esv\xd3YI\x1eR6\xa3\xc0\xdcҰn\x93\xf0`\x8eO\x02\x1a\x8ez\xe8[\xe6\xdcj'\xba\xbe2\xf0B\xeb\x84\xe1\xa3.\x91K%\x91\xf7\x19\x02\xf8C\xa1T\xc7w,M\a\xac\xa1\x12\xeeDi\x93N(\xad\x06\xc9\xff_ݲ`K\xba\x92\xa8\x93\x963\b\xcc\x01l5\xaa\fW\xce\vR\x84b\xf79\xa3\x94\xa5\xb7p\xae*\fN\x1c'\n}\xeb\xf8\xc4t\xb9\x8f\xa0l Mq\x1c\x0fJM\xac\x99B\xad\xbf\xddK\xdeV!\n<\xc6\x19\x84\x19\xf2%p\x83\x14ii\x85Z\x03n;±\xff\xfa\xa9\x10\x10;B\xed\xba2\x83\x83C4\x86x\xa3B\xc7\xf32\xd8G5/8J\xf3\x00\xd8 j\xe8\xc58\xb0#osw1\x848\xa4\xd9m>\xb9\xc5X\xbe\x1an\xc4髃\xdd\x17/\xbe\xfd\x0eㄑ\xc1?]\x82\xd5\x04\xad)hӊi\xe7\x8c'i\xfa\xf5nʢ\xd3{N{|\x82%\t\xda\xd7\xcdkf(\xbeټ\x84\xe3ޕ\xc0H\x159J\x8a}\x06(v\xbb\xc4r\x94\xcc\x138@\xbb\x14\x00f\x16\x8d\xcf\xfa\xee\x9d\xe1\xd5ViFJ\x84\xbe/C\x8aP\xe7.n\x05\x82\x9fUi\xd2\xce\xe16\x81\xab\xfd6Oǜ=\x9d\x9b\xbfo\xf2\x19\xee\x88&(\xd8\xf0-\x8b\x9c\f\xab\xc9\x14\x81\xfc\x88EZ~\x04I\xae\xb2\xd1\xdc锏\xed؎\xbf\x9c1Z\x0f\x8c\x90\t\x99\x19c~Y\x8e\xe6Ţ!\x82\x9d\x80ܚ\xde(\x11.\x9e\x12\xb32L䂑hO\xf25\xc4,\x80\x8f\x18\x8ap\xe0UrC\b\xed\xab\xa1ѓ\xea\xe6\xe0&\x19}|۪\x00\x05\x1aYROs\xa0\x16#h\x11\x89\"e!5\xed\x19o\x16\x0f\xf99\xd03Ħsҝ/\x8a\x1a\u0590F\x97P\x93$Sh\x86\x8e\x83ʇ\x87\xd1\xdf\xe1\x02\xc1\xe2\x01\xe1\xdau\\\xc9\x0e\x8d\xe4\xc1TiW6\xa1\x00w\x83yQ\x82\xc9s̷\x04Q\xbd$`\x97\xc6a9\n\x9e}\xfd\x829\xa0\x1du\x8a\x89\xea<$\xdd\xd49\xa5\xdc(\x18\xe3K\x7fh\xde\x1fHY@\x8a\x96\x15\xb6\xcbhΝ\x17TZY\x81B\x84\v\xf3\xc2\x1c\x8bl\xcf\x12\x88\x9bA\xfd\xd7e`a\xc8\xcc\x04\xc9\xd7h\x92\xcfǻ\x8c\xa4UH\x06\xa7\x87Ʌ&Io\x8e\xb4\xc61\xfe\x1a\t\xf9Wg\xc1\x13q,\x81T<\x1f\xf7\xce\xfb\xb0\r_\x97\x1cRL\x82\xa4i\x04/\x83\x1e\x11\xa5Ҟ\x82\xfc\x14Net\xab\x85\xf2~\x1dr\x17\xea\x15þ\x0eh%\x99\x1dP\x89OG\xdeT`im\xf0\x1e\x1b\xb4\x13\x96\x1e\x94\xbch\x87\xc1t\xc4\xf0\xf4m\xaf\x95\xa1X\xabx\x9dH\xb9\xdc\xc5\xe3I\xb0\xab\xbbL\xb6\x95\xe3\xc9\xff\xfe\xee\x95a\x04w\xe6*\xef\xad\xc4\xc4\xf1\\\x85\xf5n\x11\xa0\xe8\xe8Y)\xa2M\xd5\xff2\xd2\xe1\xa6\xe6k$\xd7˽\xd7l5\xe9X\xd5{6\xbf4\x8bw\xf36\xaf\xb0\xb4\xfa\xbe\x88\x9f\xcdunk9\xa0\xcb\xcct\x8f\x92QR?\x9f\xe5\xf0\xedY\xad\xea\t\x1b\x15\xb9ON\x90\xabu\x8cN\x80\xf9%\xd6\xcf\x15\xee\"\xd6\xc12\x88u{\xe6̖.\x81\x88\xa9\xbfd3\xe1\xb3Ya\xf4\xdf\x02\x83\xfd\f\xe3\xe5 &Aa\xae<K\x00G9\xc5\x11\xc47&W\xf3\xc9\x19\xa6\xc7\xff,\xea\x8e\x10\xe3\nE\xbaY\x8cE~\xcfN\x7faᏆ\x0e\x86\xfd\x13\n;'P\x13\x90\xad1S;\xe7\xf2\xcfĶ\xa1C\x8a\xa7*\xe0\xe4\xe2\x91N\xe0¦\xe5\b\xf8\xfc}\xf3\xb2C\xed\xeb\x98\x01\xa5\xe5|#\n\xe9\xd0?\xd1U>\x01\xa0\xe6d\x17\xdc>f\x99w\xad<%\xa9\x83pi\xc0\xdd`\xd3\v\xc7\xe0\xd2P1\x8b\xe6kDvJ\x12?.!\x01\x9e\xbdQD\xa3`\xa5\x14/i\xc9\xc2m\xad\xa7\x8db\xa1\xf0\x7f%\x15\xced\xe9\xb8\xd8H\x1d\x86Q\x15\x94\xc7[N\x1f@\x11\x84\x14\\0\x8c\xb9\xa4\xea\x81oCų4\x9f\xd2\f$B\xcf\n\xa7\x92\x14\x8a\x1a\x13*\xb2U\xe9d\xd1\x01\xabhJ?\xb1\x19\xdf\xd3|l\x18\xe0W\xa4\xe8n\x04+i.,\xf8@\xf6\xaf\x90\x94\x05\xb4\x05e\x00=\xc0St쬠dX\xc0\f@\xdc\x05\xc0\xa9\xc6Tt\xee\x15\x80\r\xb0\xdbaD\xa8\xe0\x8e\xff!8\xee8\xd0P\xb4(\xf9\x18\x1c\x82\xb7h\xac։Vo\xb7n\xbe^\xc3ZP[\xbb\x03\xe4\x9b\x01\xe0\xd3P3\x1f\xa5؋\xa1\"\nZ_\x98\x95\"\xbbC\xe3j\xc5\x10\xc5\x03\xaa\xd2mn\xf2;M\x96\x95\xbb\xc4\xdf\x03{\x15\xc4T\x95\xab\xaaO\xfa\xb4\x0f\xbaޚ\x8b'\xfa5s̴\xc7\xc9\tQ\x89w\x93\xac\xceF\a\x935\r\xd0\x1cE\x0f\xb5\x0eue^\x04r\xad\xc47\xa4B\xb9VV\x15\xcb*\xd4\xf2(}\x87\xb3\x95\xf2B\xd7\x12\xab\xe9N\x97\xf7ln\xc4d)\xc3eG*P\xc0}BB\x06\f\xe5\xf3l\xa2\xce\xe2\x97\xe5\x11\x9fЈ\x1e9\x95J\xf2\xa7\x12o\xc4̣\x05%\xd7\xd6@\x946欑Z\x10\xa3\xf5\xb6\xc2\xeb\t{\tߤ-2\xcby9O'\xa4G\xd8E\x16\xa6b\x97ٺ݀ӱ-\t\xa9+\xda\v\\Y)w\\\xc0\x10\xe0\xf6\xdd\xfb\x8c\xd50\x11\xf7\x83\r̚\xb0\xfb2\xf7SP\x0f\xadX/\xb66.p\x88\xe2\xeb\xb3e\x83\xd9&\x11\xe1E\a\x9b\xbem\xb7kI\tr\x90\x15\xa0#t~F\xab\xb5\x9e\x12Q\x14\x88\x93^\x01\x97\xa6\xb2\xc0@\xaf5\xaa@\\\x87VM}\xa2\xd5\xf8\x88h\x8cv\x7f\x06\xe4\xf8M\b\xb5\x99`TV\xaa2\xa1t=\xc9Si[\xadN\xb48ج\xb1z\x9d\xa8q\xdd\xcbA\xec*\xe4\xe4`F\xb6\x84\xe2\x1f,c\x8d\xab\x80&\xf07\x85\xf4\xb7-\x1a\x81\x9b\xbc;\xebZ\xb8\xed\xd4\xc3\n\x93\xb7u\xb5\x1dF\xb9\xd1\xc8\\\xbf\x99\x1a:__\xbd\x19\xdf@\xe8\x00\x91%A$\xbf\x84\x13+\xc6 \xb7\xe1\x90\bcڎɬZR\xb6t\x8d\xf5y\x8a\x9c\xc3c\x8a\xab\n\x84{Z\xc2\r\x82\xc1\x17\x95%\xf9\xc5>\x13\xbf\xc0\xcdܰ\xe1]Xs\xe4s^\xb9!;+\x86Z\x8bxu)\xf6ť\x9e\xb4\xa2\xd3-)\x9b\xd2\x02B\xf7\x8bߠ\xbe\x17P#\xda\x16\x1f\xa1\xf1\xf0\x97\xed\x80e\xd9Ur\x8f\xe7\xe8\xac\x04[椆\x85${1G\x99\x8etS\xc6\xe4\xafymp\xa1`,, A\xceN\n\xb0U\xac\xbf\xebᐶ\x0f\xb8g\b\xbeR%\xd7\x05\xd7\"2\xbaw\xa4\xf6\x04\r\xe4\x84\x12\b\xff\xe6l\xa9ă\x95\xe1\x94\xd1yAo\x9bf\x17\x82\x10G\x97\xf1>\x9f[\x97\x13%3\xd8\xf9\x95\x81\x95z\xeb\x1eRzy.\xaa\xe5.\x82\xfa7g#\x8b$\xb6\xcd\xee\x8c[\xba\xe8iiOK\x1f\x1f-m+\xdd\xd5\x13Ԟ\xa0>N\x82z~pr\x96\x8f>&\xd5~\v\xd2v\xad\x81\x8a^\x02\xe5\x96~#\xa1:\xc7\xcaw\x94\xf3\x1b\x95\xf8N\xa7\x80\\ \x86\x8bPI\x7f\x06b)\xebod\xfb\x8c\xac\xc4\x03\xaf\xf2\xb8$\x02\x1f\x87#\x05g\x1b\xc7\b*\x98\x9b\xed\x04\x04>t<\xda9\x18\f[\x02\x9d\xf31\x1b\xa5\xb1\x11\x9a<\xaa\xca|M\f\xbet\xb5\fOA\xff/8\xec\t|\x18V\x98O$\xbb\xcbĆ\x8e/`o\xa1\x1a\"\x03\uea79\xf9\xfc{\xb0\x8a=\x05E\xc1\x80\xa9\x95\xc3\xe5E\xd4(\x9dM\xcd7\xc4\x1b\r6\x13\xfb\x99x\xb2\xe1\x16\xbe\xcd\xcf`\xaas\x80\xdb9\xc1`\x16\xf7\v\x87=\x1c}JF\xf3*L,\x83\xf5O\xfc\xf1\xd2\b\x01δ\x81\xc9D\x11A\xe1p\xd6t\x9a\xec\x8f\xc7!\xf4\xbdsyTק\x11\xb2\tBy-\xa62}\xfb\x0e,S\xf0\x82\xd4QB\xae\x00|܈?H\xfc\xec$\xe9\x8dr\xb8Q\x02\x01\f\x109\tp\xd1n\xcbC^(s\\)\xaaj\xccT\xbb\xd2\xeb\xb7\x02!\xa3\xad\x0f\xd30&\x0et\xe4m\xc3\xfa\xb9\x97\xd3S\xe2Y\xa71\xe0\xe9Bg\n\xf3:3\n\x88\xd8\xfa\x8b\x19܀\x92\x88\xe8h\xfd\xa0\xc0\x9e\xad\xf3\x85D<\xb3\xd8\x7f\x91\x7f\xfdД4ۮ\xc0\x11\x1dz\xed8\x94\x95\xe1\xfb\x80n\x01\xf3)#9\xa2Ogjd*v\xfd\xa0kJ76\xc2\x0f\x82\x8e*\xc9GR\x99U\x18\xe4C\\\x8d\xbf\x12\xaao\xe5\xdf\v\xc1\xac\xaa\xdcv8\x87\xd0\xe2\t\x81\xaf\x8b\x03\x9e\x13\xc1\fF\xa7\xd6\xc0-\xb8\x90\xdd#\xe0\x97\xe5\x9f\x05\xf2q\n\x85\xd0\xf0cԵ\xac \xf6.\xeb\xc0\x8e=\xfcL\x18\x7f\x9e>\x12bZ\xfcy\x0f\xf8\xddt\xb4S2\xbe\xb0\xb9P\x80\xe1-8̈́\xb6,&~zY\x8c\xfc0p\n\xac\x81\x90i\xdf?\xc0?q#\x98\xbf\x87\xa4iā\xf1(\xe68;Nu+s\t0\x86\xab\x00\x01\xab\x96Zڥes&\x84\x19\xa6\xa3\xf9$.\"8y\xd7y\x11.\xcf\xe4\xf6\xee\fC\xb4B\xa1\b\xf5&u\x02\x06029\xa1\\#\b\xa3\x7f$\x9e\x12]\x93\xdd\x04+;\x1d}{\x0e\a.P\xb8\xe6\xa6怏g\xea^+\xb6\xf0\xa3E2\x1f0>4\xea\x05\x80\xc4\xcb\xdf\xe4\x03R\xe7(\xb0\xa6X\x93\xee)3\x9d\xe46\x1dUF9\xfa\xff\x93\"\xc7\xfdʒkB\x92\xae\xa9\x14\x15\xc7\x1c\x19\xd2\xfc<z\x8a\xafE\xe9t\x9a\x8c\xc1g?\xb9\x7fVK~\xe9\x06\x14\xd8Bp\x7f\x11\x17\x7fU\xa3\xb7\xb5\x05\xb6\xa4-\xb77\xca\xde!t\xac\xc2\x01\xf3\x90\xf7l`\xbf\\9\xbb\xfc\xff\x03\xbb\x04@\xaa\xd7xt\xe8\x9ct\xa7\xe0$t\xb7\xe9\x1e\xfe\x95\xa2\xec\bV5\xa8>\x8b\x88&\x109x\x99x`&@\xce=\xecZ\x96\x16\xeb\xa2\n\xa6\x865\x93Z\xefʣI\\\x9a\x1b\xf1\xe3\xc4H\xb5 t\x87J\f\xfc\xfd\xac\xd1\xca\x1bs\x16\x99&\xd1aZ~\xd4ْqC\xfa\x8a\x05we\xa7$;\x01s]\xf1Ee6\x86ǉ\xbd\x1bCo\x9b9&4\xfaK\x18}\x89s\xec\xae\xf7\x86&\xefYh\x00q\xe8\xdf\xf3\"\x81\xe9\a\xd6N\x1e\xd5\x17\f~',||:%t\x06\x87\x10\x8ck\x020V\xfcH\xad\xc8*\xa3\x97\xcf\a\x87\f\x06\x9f\x96!ã\xe0\x90\xf1\x81\xf8x\x1fr\xd4\xf0\xa1\xfa\xa8\xcd\x0e\xdf\\\x05H\xf1\x81\xf9\xfdՙ\x7f\x8f\xe0\xb7\xc8\xfc\xd8\x1c#\x1e\xcd\xf2&.\xd8\x0f\tJ\xf9$\xbdJ\x80J\xaf\xe2-\xc1\x8f6\x86\x98B\xb4d`\x88\xf8\xbb?DjkK\x1a\xc8M\x89e\xf9H\xf3\xe4;S\xbb2\x81[a\xd8pb\xd4\xd5R2n\x7f>\xda?\xdc\xe38\xfcr\x0f\xd17w铻\xb3\xf1ީyjt\xb5\xe9x\x95)\xe3ۍ)\xa3\xbb\xe8M\x1c\x00\x87>\x90G\xb5\x89۟y/\x90\xf0\xaa\xfa\x9c\xb6\x98\xf7*\x83\x93N\xeb\xe3\x1b\xe7wٝ\x11\x19\xf6O\x8e\x03vA\xf7P\x8fQ\xdeA;[|)\xa5\xa9,)\xdeΐ\xd5\xc7\xeb\x83F\x16t\x98\x06N\xd2\x11?\xf1\x97\x14Lz9V\x83\x1es\x19\x92\xfb\x85\a}c\xba\x8a\x034\xdfZa\xba2\xf2\xfa\\\xaf\x02ie\xaf\x0e\xfc\xf9\xbdJ/\xcdp\x0fn\xe2,K&\x0f\xc0fV\x98ƫ\x83\xc6\x04&ɧ6\x1e\xff\xca>\xf3't\x9ddX7\xbcf\xae\xb73\xc2\xf8Y\u008fس\xb3#E\f\x03\x1b\x92\x11\x1b\xa7f\x93\xf9u\x9a\xad4\x01;$=\x050k\x15\xa1\xf1\xe3\x83\xdan\xf0\x8fuҵ`\xf59\x98QŘ\xc3\xd2KG\\@\xc1\xc6\xe9]&0Q\x86\x9dZij\xd8_}vף\xc49\xabÜ\xfa\xa7\x83#\xbf\x89?c\xf3\xf8\xb1J8fr\xce\xfd3\x86\xc9u_\xafƬ\x1b+\x97V\xa7\xc9,\x0f\xac\x17=\xa8\x1d\xea\x14\xb11\xf22E\x02D:\x99S\xb9\x8cv\x81Gz\x95\xc3\xca\xdfi\x8c\x8bBqB\xd2\xc0O\U000a8d83\xf6\xe7\xd5D\x82\xe1꼖wf\xcf\x0er-nk\a\\\x9f;\f\x19\xab\xe94\xa6\xfe3?\xf1gn\xad\xebX[\x1c\x04\xb8\xbcPlB/\x84\x9cW9ܶ\xc2I\xed\xdc*\x9b\xf19\xe7f#A\xc3l)̺\xc6 k\xf2\x14\x9aÊ\nx\xc1\x85\xa7T\x05\r0\xf6_\xab ~\x85AW&Ic\\\xb0\x17e\xa5\xebn\xa0\x06\xfa\xf6ݹQM\xa9\xbbr\xe3\xfb\x04\x1f\x84bH+l\x95\xac{}\xa7\xd2rT\xa6\x81\x1c\x93\xb3\x83\xb3\xe3\x9axM\xbf}F\x12\xd3\xed\b\xe3\f\xd6:\xbe8\xa1\xfa\x82d\v\x1c\x85\xec\x1b\xd3\xeef\vA\xc2Ŷ\xb4\xe7o]\xf2\xb9Y\x85\xc6,Dq\xde֔\x8f,z\xbb\xb2\xe6\xb1驅\x81uߜ\xb7M\xcdeV\x8b\xe6:\x98\xc4i\xa0\xd2\xccI\xa8\x99\xee˧=*\xa3\x16\xcen\xf0\xed0\xe4\xec\xda\xf7\xd8MdW\x16\xc7\xfdD\xbf\x8c೫\xac\xd7\xd2Y\xe3\n\xde\xe4U\x9e-\x134N\x02\xad\xfc5\xa3\x16\a$\x12M\x80`\xba\xe8\x0e\xe0\ufae9\x8a\xab\xcc20\xb4\xc6$\xf3\x02\"X[\xe5\xdd\x13\xefy\x8d\x11\xf1\xb3\xb5T\xdeU\xe6\xe1\x8dA\x8d]\xaa\xfa\xb5\x151G\x94o\xc3P\xd2\f\xe3O-\xa2\x99`\xa4\x0eXu\x85\bs\nE\u05ca\xe2*#\x94\x91ԇ\xf8\xafy~y_\x05\xd6\xf5o\xf4\xc0_P\xf9\xf1\xc1\x8c\x1c\xfc\x81\xfa(\x8b\xcbP\x1d\xad\x1f\x0f\xfdѝ\xc6㼌\xd0n\x16\x1d&-\x16\xa3\a\x12\xbd\xcc\b\xd7\xe2Zf\x12\xf5\xc9B\x15\xb6\xe4\xf8] \x8a\x87\x1e\xd4j\xe1\xf2\x8f͈\xac\xb6Ӯ\xe3<\x00\xfca\xa5\xa4\r\xfaZcȄ\xa7\xdb\x12w\xe4\x0fXb\x8e\x96\x985\xb6W\x03\xb1{BJ\xa3\xfc-\x96\\\xc0n\xf3Pp\xbb\r\x1a)\xc2Qr\x0f\xb9\x11\xc1\xf0=\xf0b\x94PJ iu\x01\xe8\xc7\xfe\xb8o\xcf\xf0\xd1C\x13K\x19\x01\xe5\x894I\xfeB\x9f\x06]\xeb\xe6\xb4n\xd5S\x1dX\x03\xb993.\xfd\x03\x05\x98\xee\"\xb4ʛ&\f{\xc3A]N\xbf\b92\x06O\xa8=*A\xffh\x96\x04\xb6\xcf\x1a\xe3r\xcfă\x84eOӬ\x84ȧF\xb9.\xeb˵\xe3#\x7f\x17\xcc\x02%\xec\xd6\x1a\xd2\xcdO\xbbR\xd0䗭\x14._\x1c\xcd\x16\tF\x1e\x84|'_ӛp\x99\f\xf5\xd0\xdf\x15\xf8\xc5n\xcb/^\x9c\xdb\xf2\xcd\xc0\xb7\xc3{\xe1\x1e5\x05\x05Xx%˻U\xf7\x82>\xa4\x00\xaeu\xceٲ\t\xa4'\xe8Z\xb6;/w\x82\x1bB\xa3\xe0\x84\xdd`\x1cE\xbdE=\xb3\r\x9fKeTN\xfc\x1d;\x8cO\xe2d\xb9\x9e\x03\xa7W\x91\xc6{\a\xd5\xc3\n\xd3ǘc\x15\xacG\xf6M\xedàI\x9e\xe7\a\xaa\xac'\x87\a\xfa\x90e\x97\t\x94\x85ˢ\x17\xc3\x17\xcfW8\x83\x0e\xe1ѝ\xc1\xb7\xf6\fҾ\xaf\x18X\xfb\x86)\x91+2\x99J\x95B\xfc\t\x03l\x95+\xf5)E\xdfb\tc\x1b\xb1\xf1\xacsA\xccr~\xd9\xed8\xf1\xb9\xc1-r\xa7\xc9n\xd0N\xb9\xe0d\xe9\xb1\x00LV\xf4\xf4V4T\xac\x90\xb8\xaa\xe7\x17\xd2\xd3\xdase\x9bm,\x8c0\xa5\xffQ\xfc\x02ҢI:\x05\x881\xf8'\x01E)\x0em\vЈ\x7f\x18\xe6\xde\f\xcaq\x17\xb8-\x94I\xc5\xc0ހh\x8bAu\x8d\xa1H\xe4\xc24Y);\v\xe6y&Y\u12d7\x8d\x85\xe4\xe0\x85u\xcf<߸\xab\xdb\x1dOr\xc4[\xb2\xb5\xd3]\xa8:/\x97$\xd6\xd5R\xb2ڽY\x1a\xa2\xd5\xf9\x84\x9c3\vk5\x124\x00gT\xaf\xec\xbbRS^\xe6\xb9\n\x8fF\xbd\xb2\xc1x\x94c\xca\x1fQ\x9b`\x19\x1e\f\x8b\x96돃D@=\x84\xb6\x03\xb3X\x88Y\x9cFQ\x13\xb4t\xe6\x84\xe6v\xf48\xcc\xe7~\xc59\x15,p\x13\xe8\xd1\xf1a\xab\xd8\x1d1,ҏ\xe8\x95z\x83\x98s\x00\x01\x12==;\xf9\xf1\xcd3\xd8}\xb4=\x1f\x1f\x86\xe1<\xf4gZ\xc1\xca\xcaz\xab\x8d\x87\x93\xb5}Jmw\x98ِu\x1630\x90V֎\xd1\xedt\xfc\xb13\x9f\xf8{\x82\x01\xfb\xe3\x93|,L\xe0\xdc\xc8\x1f\xe1\x80\xcf;lL\x11mF\x1ee\xa9\x95\xe2\x9d\xc6QKW\xba\xb8;\x86ςȹK\xf1҆\xe3\xa6,\xafL\xe1\xac\xcd\xd0jW0\xac\xd6\xd3\xf2Y\xed&\xd0\x00\x80tֆ۸\x17\xb3e\xf3q\xe1\xb3\xfb\xe8]\x97\xbc\xd5\bįA\xf0\xa4\xf8q\xb54\x96\xe1J\xd6!\x7fLfGxB\x8d\xd1\xd1\xef\x8dQ(\b\x92\xfapj\x13\x1e\xd4b\xe8w_<\x7f\xde\t(\xacvN\x92[\x04\x9b\xbf}\x01\x82ߋ\xe1\x11\xfc\x19\b\r\x80\x9f\te\xb2 t;\x82\f\xc0\xb7\xa32\x9f&wxB}tI\x8c\xa3v\xde\x1b\x81\xf4\x83春-`\xa3\xd6Lk\x83\xf8\xf4\x99\xc0o`\xact3̬\x85\x87\xff\x1d\xbd>\xc4\xc4!\xaa\x1b\xaa\xdbf{\x80z\x8a\xf2\xe4\xb5a@*r\xda\xfd\xc0\xb8\a\xc1\xe2o}\x99\x86\x8d\xca48\x14\xa0\x83\xb0\x06y\xe8`\x82H\x19\x018\x1d\x04\n\x05\xf8>\xb4\x84\x9a\x01\x19\xa5:\xbdL'p\x8f\tG\x99\xae^D\a\x14\xbf\xda\t,\xcf\r\xe7UZ\x94x\xbc̉\x9c\xce\x1et\\\x9b\xa7\n\xb8a\xbf\x8e\xbf\xc6Q\xb7IM\x9fo\xb8.f\b^RaQ\x96\xca,J\xc4\xc0bߔu\a\x91\xbe\xf8] 0Wp\x88l9\xd6\xcd\xd2Bޤ\xa3\"\x97\xa5\xeb\xeb\x8cl\xa5\xceȶ*\xe0\x01\x03kϻ\x03Q\xa3\x96\x88\xaa\x1aZ$n\x85O\x02\x1b2K$\x8d\xf2M\xfc\xc9\fe\x12M\x92\xec\x1a\xb4\xcb+A\xb4è\xf4\x17\x1f\x7f$\xec\xd6IzY\xc4\x05\b\x86\xce\xc2`vs\x16\x17\x92\x8b\x96\x8d'6\x92~\x8e\x99\x13\xbf\xff\xfe\xe3\x8fm\xf6\x972\xccBIE\xa8sіN\x98\x83\x86\xc5J>s\xea\xce\xc0\xd9\x05e\x0e\x0f\bJ\x86\x80\x9fWF)\x04\x1f\x18f\xf8ΞR#k >\xa8\xf3YZ\b@@E\x1a^C\x865\x82F\x9e%\xd5A\xa8\x95z^\xb2\xf5\f\x92?d\x1c\tc\xedKI\xd0\x14%\a\x8c#CA\x85D\x94\x92\"\xe6]WJV\xd8\x005\x02\x13\\B\x16\x13[\xa9\x84\x80|!\xfc\x92W\x11<\x89S\xb8G@\x10'\xc9'\xde!Y\f\xa4Nu\x89\x062\xb0\xae\xaf\xe1\x8e\xc7\n\x18\xbe0\xa7s\x92\xc8\xd1\xe4\xa1lk^\xbcqnG\x02\xc1\r*\x81T\xed/j?\xb2+\xee\xcc\f\xa2\x04\xe6\xf7\xc1\xf7\f\xb1\x8f\xe2C˱\xe4Q\x1cg\xe6\xd2e!\xc6ct\xc6\xe6\bRn\xae?i>\xb2\xfb\xe9\xfe\xdfW\xe1/\x19\xba\x9f\x86\xd0.1\x0eߙ-\xe8\xf0Sc}\f\x95w\x06\x12kS,PCw!\x82\xc0L\xf3q\xb3I\xf8v\x97\x8d\t\xc9\xf4g4\xc2V\xd45\xaf:\v\x8d\xea\xe9[\x10\x9b&\x83\xe8\xefq\x01ђ\xcf\x06Q\x96\xdc1\xf0\xe0H(\x0f\xa9|,\xc1_\xcd[\x80rW,\x91\xc43 \xc6\xe0\xea%\x91v\xe2WJ\xc296\xca%\x85\x96 \\\xca\xcd>\xf2A\xf4i\xab\xa4rP_\xca\xed\v\x96r\xa3\b\nowd\xb1\xdc\xf6\xac\x8b\x8e\x1b\xd4\x7f\xfb\no_u\x85\xb7\xad\x94>^\xbb\xbc\xdb\"\xda\x15\xa8\xf4֝~\x9d\xb50\x19\xf5\xd0:,\x9bE\xdfR\x82B\xa6\x8e\a\x91\xf9Đ\x82d\xb9\xaeU\f!\x99Fv\x9c1\x1e\x05\xf4\x94f\xf3|^N\xee)\xe6\x16\xa4!\f\x9e\xa9QC)=\f\xd9l\xefX\x05:\xa7h \xb4\xf24\t\xe5(l\x02\x10l\x89\xab(\x1f\x11\x9a\xdfH*\x96\x10\xecvj\xe5Y\"jP\x1c.\x89\x8b\xea2\x01o:}\xb2\vR~}\xa0A\xec\x00\x12\xa5\xf0#\xc4\f\xec\xb5䑀L\\\x02x\xf1e\x02\xf9\xad\xb5\xf1lQ\t\xa4Ul\xd2E\xb5\xc7\x0eV߭\x14\"\x18\\\xe7(\x06\x16`\x88E\xfc\xcd\xe5.F\xc0\xb6\xccJ\xbcLr\xfe\xf6)z\xfaՂt\x94F\x93Z0\x10\x8a1\x11<\x16/\x99\xc4 7\xaa\x83\xd7\x0f\xd8\x18j\xc3\x14\x81x\f\xfa\xbd)u\xe1\xef\x12\x87\xa1qJ\xd4\xe7\xe9\xe5MV\xe3\xe7\xd6hy\xcd\xe1\xd1\xc7\"~T\xb0\xe5\x12¢_L\x0eш)\xef6\x9a\x91{\x01\x01\xbc\x11\xac\x031\x9bЖj˰\xd8\xf8\x120\xa8\xa7\x9fx\xed\xb8ta\xd33\vMO\xb0e\xc0\xc1\x16\xe8M\x82S\xf4؝s\x1f\x87\xd2\x00\xff\xff\xf0\xbb\x0f\xc3輈\xd3\t\xba\x92\xcce\xb8a\x80\x04\xb3\xae\x90\xb1M\xf7\tk\xd1ĖO\xeaϢM'\xa6\x8f\xc2\xefË\xec\";\xe2\xf8\xbb\x97ч\xbd\xab<\xff\x10ݡȃ\x87\x86\x7f\x1a\xd0\x7f\xf7>\xa0<C\xff\xbe\x8c\x8b\x0f\xfe\x1b0V\xf5\xd6\xf8\x03\\\x8a\x0f{I5\xc2V뜃\xc38\x99B\x92~Țytrzt\xb0\x7f~t\x18\xedR\x84\x05\xb2\a\x91\xf7\xe0<ڷk \xe9\x97\b\xf3]\xee\xd1W\xbeݳ\xed@^H\xd8b\x8d\x91\x8a\x11\xd9ӭF\xa8\x88\xfePu\xbf\bN\x94\xe23\xc6\xd8\x16\xe2F\x9ag\xa7\x17j7\x13j{9\xf2!\xe5HZ\f\xa3(?jY\xd27\"\x96\xc1r\x87Tl\xa2\xc40\x19\x06G+\xec\xc5Q\x17t\xcb\xf3\x83\xc1\x10\xc0<\x01\x1aw\xb0., \x84Xɑ%\x96yK\x95\x95\x10Nsm\x8a\x94\xd0\t\xb6\x13\x8e\xbe\x01\x03\x8ai\bG\x12\xe8#\x8a\xa2w\xe6\xd8\x18j\xce((\xc3\xe8\x84c\x8b\x1b\x05qO%\x80쑯\x9d J\xaf\xa8n\xd8.\x9d\xae\xe1\x18S']c\xc1\xa0\x0eDb\tٶ\xeamje:\xc9ŋ\f\xc61#\xcc\xdc\x1c\x19\xc2\x0eZ\n\x12\xa5\xba\xb4\xc7~\x1c^ӆ\xd0\aR\xb6\x113̀\xe1\x93a\xf1\x1d\x9cc\x0e\x1b\xc7J]P\f\x84ߓ\x18KH\xc6\xe0\x83\xe8\xea\xa4m\a\xd6k\x9a\x94e|\x1d\x92\x94\xc9I\x10Y'\x01\xb7\x14\xd8*\xa0\xfdc\xd3Y:)\x95\xedЍ}%;\xfe9\x8a\v\xf0\xccV\xbf\xb1\xeb\x01\t\x11\xb8R\x8b\xbbn\xbb\xccg\x1eк\xedu\x80\x8bj~=\xc7X\xcdW\x10r9\x88\xdeg\x1f\xb3\xfc.\xfc\x81\xc5\x16Iwv\xec'\x86\x1bIka;\xa0\xf7\x98X\xf3\b\xcc\xc1\xb6B\x82#P\xbd=\xf0\x8b\xda\x03\xf7\xad\x19\xb0\xb6%k\xdb\x00\x17\x89\xf6\xbd-\xb0\xb7\x05\xaeo\v\\Ĝ\xbb\x1b\x03\x97I\\\xed\xc4\f\xabg\xb3\x8a\xcfј\xa3V\xc5\xcf\xe3\xc2\xc9\x14\xca\x11\x06\x8cw\xd34\xc3\nk\xed\xf8u\x10\xedg\x16x:\x9fF\x995镌e\xe7\n\x12\xc7\xe0N\x9a\xdcۺ\x92\x8e\xd0C\xbc\x9e\xf3mc\xa11[\xf3\f\x80\xc9\xf2+\xac\x9f\xe5\x92\"FE\x8ceN\x06\\'\x8d\r\"\x10Z\x0e\xa5\x02!j\xfb\xd6\xf0S\xe0\xb6~\x10\xfe\xf3\xe8)|\xccb\x01\a^\x00\xec\xb92ϱ$/\xa1\xdeသu,\xcb)\xd0\x14?\xa7\x10bz\xff\x1a\xe2\xec[ f\x9d\xf9\x13J\xb3R{,t\x9b\xa0\xb4\x04\x02\n\x1a0\xc0M\tq1\x0e\"!&Q\n\x88F\x0e\x99\xbc@{\xe6P\x12Ϝ\x96\xbb\x84R\xf71b7\xfa\xb7E\xdcӐ\xde\xfe\xa2\xbcx\xdeqn\xedE\xd8\xf6\xb9ƚ\xa1K\xe0\xba\x06s\x9cC\x9e\x05\xa3\x90*\x1d\x8d\xd1\xe5Z\xc1qi$\x90.[\x8ciZ\x97\xcaG;vU\xd9,\xa61\x99\x10\xf1\xb3\xe8\xbd?\x01H%>\xc5[\xce姏\xec}\x83\xffݕU(7\xa31З\xcb_\x18\xb8\x1b\xd8\\\xdc̅T\xc4UM\xea\xb6HRu|k\x84\x91uw\f\x1f\xd33\xa8\xa9\x8dX\x1c \xb8\x8d\xf2ٽ\x95:\xa03\xbf\x94R\x13ܕ\x87\xb9C\xb9\x8d\xae\x10\xd5S\xd0_\xbdwS\x023\xf7Zi@\xf3g+o\x13\xec\x0eT\xf8)\xf7\x9c\xfb\xbe\xdc+\x12[E\xd4\xfd\xfc\x8d\x99ˮ]ӕ\x82z\xcf\xf9-\xd1n\xa5\x97\x9f0\xb4\xb5E;\xb2\x06@\x88\n*\x81A\x03V\x01\xdfqOF\x88-e\xe6`Y\x1d-\xe4Np\xab~{\\I\x82\x0eVՂL\xa9\xb1\x94XOl\xbcGGlL\x92\x19\xcel\x8d\xcf\xc0٣&\x91\xd4\x01%\nE\xe5#-\x18\x8cG˹\xea\"\x04\x0f\xc0_\xc3\r\x15\xe5\xf7\xfe\x10W\x11\xfd۪W\xd5\x1at\xa9_\xd5\xce:\xb95y\xb3\x04\t\x18\xf2,i\xf3ߤe\xa9~d\x9bO[kd\xb5!\x0f\xdad\x82l\xa5%\x9a\xf6\x80\xd2\xe1\xaf\x00\x98\xfc&\xb2\xad]\tTeU\xf6邊\x83\x99\x97\x12\xa6\"A\x99\xac\x14\xa5T\t\nJ\xa2C\x9c\x8c\x11\xde J*-\xa7d\xe37l\x06@s\x90\x970\x85\xb1\x8e\x18\xf9\xbe9\f\xa0\x81\xb8\xf8\xa0S\x05\xe1ԁ\xf58W\xca\xc2\x1c\x1c\xa5\xec8\x86Nq\x9a\xaeԕ\xb2O\x18*\xa67;y(\xb5\xc2\xd9U\x96\x97\xe2e\x83H\xc7R\xbc-\xa7o\x89\xc4!9\x7f̝\x19\x1f\r\xac5\xe0i\xa8\xa2\x17r\xde1\x95\x02О\v\xce'b@ \xf3\x86f\xe4\xeb\xe0焉9uh\xae\xd9^\xd7\xc8\xee\xe0}\nο\xca+\xa3\xfc\x06WA\x89\x9f\xbc\x16\xfe좧i6\x9a\xcc1Ȏ^\xc3t\rd\xa3\xe17\x9e}\xa1\x05\xa1\xe9\xed\xcb\xe9_\xe1$,]\x038\bX\xea\x16->\xec\x85bƥ\x9a\xd9Ӕi\xb1\xfa)\xc9\xf5\x88\x8c\"\xc7\f\x18ᰦ`<[e\x9a\x1eq]\xef\xcc\xfb\x83\xa7\x90[)D\xf2(O\xbcf\x14_vsqG\x87\xab\x8c\xfa}\x16?\xe8\xc1\xcc\xd8\x16\xf9%N\xa4\xa4\x03,\x12\x13\xcf%3ΐ\x0e`:Z\x00\xe4כ*\x92\xe2\xd1+Iu\x1b\x13E}M\xb8K\xb5\xaa\xebd\x9fu\x17\xf3 \x8c\xc1O7\aA$\xbb\xa6f\xa12\xe0\xf8X\xa4U\xf2z\x03fd<-\x87P\xa8\xa1\xc4\x00O\xc1\x15\x00c\xf3\x7fE\x17\xf2\x1a\xf5z\xf1dMY\xd5\xebE\xd9\x12\x97ٺ\xd5\x1eӸќG\x89\xb5\xb5\x91\xc1\x95\xbcx\xf2.;\x84\xd0d,{\xcd*<\xa8T\xf2\xf3z\x86r#\xcc\xe7\xf7\xd3`\"`\x97\xb8\x06\xfb\xfa\xb2\xc0\x06\xdbp\xc5\xc8\x06\xf7\x01\n\x9c\x81\xaf\x8c&qA\x15\x04h\xe1\xe8\xe5\x13P>\xe0\x92\xab\x1c\x80>ġ\x0fq\xf8\xfaB\x1c\x9c\x81\xfc\xc1#\x11\xce\xeav\xe2\xaa54!Q\x97q]\xb5\xdev\xb0,x\xe0\x8d\xe3\x93`\xab\x12\xf6\xe8\xd7{\xde\xe6x\xb6\xe8\x90w$u]\x83\xbf\xeda\x91K\xbe٨\xdd'?vd\xf4\xb7㔇\x81\x12\am\t\xfc\x95\xb8b\x1e(V@\x92\x91B\xc0\xaf\x10\x8d>D\xe0\x11\x85\b\xa8\xa3\xbca\x8c\x80\xed\xa8%H\xc0{\xee礸g}|\xc0\xe3\xc8\x17\u0099\x84wg\x9b6=G\xdb\xfbX\x81\xcf\x1b+\xf0\x88\x9c\xfa>\xe1ؘɟ\xb2\x8bw\xb1c\xa9\xd9>\xc22Tt\xf4u\x0e\x90P#4\\I[-\x03\xb4 0J\xdb\xf3\xbc'_\xbd\xda\xf4h(E\x18\x84R\x80:^F\x8b\xe1(\xfdSߔ\x93ܙ\x0f\a0\x93)\xc9\x06.;\xe1\xc3\x06f\xaco5\x82\xd7\t\xb4O\xd9\xed\xf6a\xed\xe3\x16o\x17\f)v\r$\x8f\x8a\xcds\b\x8e?\xd6\xea\aϗ\x0e\x8b\xf9;\x1e\x93\xc0\x14ON\xbc\xeb\x1d\x10\x9e\xb6G%-}ۘR\xb6\x04@y\xcf[#\xa0\xbak\xb6[\x8a\x8dz\xb3F\\\xd4W\x11\r\xd5\xcd$>\x03\x88\x8d\x10H\xbe*\xd8k\x81\x91\xb5\x19\x91^D\n\x8e\xa3cZdVߕ\xac\xac\xbd\x154\x92+\xc0W\xf3\ued61\x93\xe5\xa1\x19\xffĬ\xdb\xe2x6\x80f1\xfb\x86ja\x9ay\xbb\xe6\xa9\xd2X\x1d\xf7#\x8e\f{\x97\xe4PZ+\xb5\xa2\xb4'\x84IF>\xf8\xe0\xd89N\x87\x92q\x13\x06\xbb\xc4\"\xeb\fg\xe6\xde\xe22\xbcZa\xa5\x8c\xba\x93\xdaL\x8f>\x8d\x92\x84\nc\xa3\xd6'\xfb]\u038b\xabx\xe4\xd0\x1cԀH\xc1\x1bFo\xf3\x8ac\x80\xec\x04\xf5~\x18vaD.t\v\xb8\b\x14\xaa\xb7\x1d\xdcP\x17\xc1ƸŰ\x8f\x1c\xcf\xd59\xbe\x0e-\xbb\xe5\xa2tb\xb9\xe2\x18}\xf2PAs\x0f\x1c\x0f\xa8Ql>OL`\xc7\xf9,\xe0\x96ZVU\x9c\xb3\xce6\x81\x88\xb0\xb7\x06\xe5R\x907\xfe.4\xc8\\W$@W0\x13+\xbaB\aF\x8e\xdc\x1a\xa3m\x8fdĸ<\x17\xaf&i\xb9\\H\x1aƬw\xe6\xee&/\x13\x8ay\xc2\b\t|M\xc8S\xaa\xea\x1aߠ_\xb5\x04XJ\xbf\xc5x\x15\x03\xe6*\xf1\x84ekL\xd7y\xfd\xae\xbb\xc0.@D\n\xc5w\xf9Q]0\x93\xe1\xd2\x10\x15:\xb2\x7fM\xee\xcb\xcd-\xb3\x12\xfb\xb5(LR\xc2\xf6\x9a\x01\x92e8Br\x93\xa8\xc0UlK\xadQh\xb5\x16\"\xb6LW7|\xd745\xe1ާ\xad\xc4\xf2\xbc\xe6\xf5u\f\x1f\xd7+\xe4\x1a\xaf{ţ*.\xae\x93\xf6\xb3\xdc)\xaakӰ6\xe5S<\xf7\xfe\xfém\x8e(|\x91\x806\xb7\xf2\x0f\x1d\xd1\x16\xf0Kl;\xa4\xadk Ţ\xe0\x89%r\xe1\xa2\xe0\t<\xfa\xddo\x0e\xdd\x14\xa22\x1b_\x88\xa2\xf3g3\xa3\x84Qe\tQ\x1a\x16~?zj\x96%-$^ީ\xc9\xc2\xe9\xba\xe67̳5\xa8\x8bzi\xf9JY\x89\xa6\nD\xa7\xf8Y\x05\x869M&\xce\xd8%wҗ\xcf10\xe8\xc5\xf3\xe7\xff\xa1n\xd0(\x9e\xc5F\x14\xbaG\xaaq\x8f\xe6\x92$ED\x95ˤ\xf6\r\t\x80\x81\xb80\x10\x98\xee\x13\xef*\xeaD\a\x1a\x0e\a\"\x81\x80lh\x88b6]V\x97\xd4\xf8\xd3\a;\x068N\x1c\xa1օ\x85\xa9R\xf8Ӗ\x02{\x9a\xec{!\ad!\xc41o(\x97\xd2]\x16y\xc0`\xa1\xe6(\xcf)|\xc8\v\xd1\xd9J\xe8\x90\xf2-tw\x82頡\x84Λ\xc4\v\xd5Ût\xd0Pc\xf4+\xbb\xcf^\x9d\xfd\x04\xd6\x1fY\x15\x02\xb1\f\x1c\xdap;\xcfR\xec\x84P\x98\x1e('9\xb7\x9as\xfc\xa5b\xc0\xd28\xb0\xef\b\xde\x19\xd8p\x02\xf5Dx\x9a\x1bW\x1d\x96\x9a\xc3j^qd\x93\xd9~H5\xba\xcf\xe7\x8c#3I?\x12\xb0}\x0evW\vl$\xed\xa9R*ĉN\xee\xddS\x02i\xb7@\xf3X\x8f\xd2p\xe3\xa2\u0099%\xd9x\xcb\\\xf9\xf8\x10'\x88\xae\x8db\x1e\x8a\xad<5\xbfZ\xb3\x98]k+\x15\x8f\xd3\x11F\n`A\x18\xde.)\x19$\xe6\x01#\xf7\x19%\xbf\xba\x87\x18~3\x86\xb5N\xcc\xcf\xe7\xe7'\xc7\x19\x1a\x12Z\n\x0f\xfb\r\x1cb>*\xb8T\x91\xf8:\xb1\xe0A\xa0\xd5\xc1rF\xc7\xd9(\x9f\xe2\x95.\x84\xbdy\x98C\xb0\xf3f\v\x01Z\xdaU\xb4\x95\xb7k\xf6>\xfe\xb9i\xee\x93\a\x8dQ\xffH\x0f\xbc\x13m\v\x96\x8em1rg}\xcfU)!\xb3\x1bW\x90\f$\xea\x89\x1e\xe6\x9aD\x85W\x90\x87E\x96\xb8\xd6\xf2\x13iI\x85\xe0M/\xf0ѓwg\xc7\xff\x1f\xafr,\x97\x14y\xc9\xf1\xd1\xd1QtV\x8d\x81\x99~7|1\x88\x9e\x9a\x8f\xb3\\\x9dÍ\xa2\x89'\xe6۳\xbdy\x96~\x8a\xca\xfb\xac\x8a?\r\x90\x1b\xe2V$ń\x7f|f\xebL\xc4\xd71 \xb0\xba\xbd\"oP*;\xca~\tC\xe5H\xae\x05\xa2L)P\x82~g\x84\xf6\"6Zu\x01u\xa6K\xb9\xdc\xd6\xe9\xe1|\r\x86u^\xe0R\x18\xfa\b\xe5\xc4Ix~\x7f\xfa\xba6\xd3\xd3W\a\xd1w\x7f\xfa\xe3\xef\r/0\x8dK)Qt\x9dZ\x1b\xdb\xce\xde\x0e\xe6$\xaa\xa2'\x037\x83\xb12\x14\x19M\x03\x85-\xa8\xb0a\xd8\nU\"\xe0-o\x9e\xc3Mn\x14\xdc\xf1_\xc0{\xb4\xf0Z\xd9V~8\x038q\xac0X\x92-\x89\x8b&\xf0\xf0Jt\x01\xe1\x0e\x13H\x17y~^\xee\xed\xfd\x050\xbc~\xd8\xfb\v\xcc\xfd\x87\xff\xf3\x972\x89\x8b\xd1\r\xac\xef\x0f\xd1\xee\x0f\xf2zD%#\xe8\xff\xe1\xa1Ue͕U\xc5/\xe0\x8b\xb2\xfe\x03\xf1\x16\xb2\xdbK\xae\x88\xf0\x04\x12c\xe5\x00a\xea!a\x1c\x92\xf9Ȣ\x06\x9a\xddB\xca\xcb\xc6Y\x84\xaeE\b\xf7\x9d\xff\xb3\x03\x8cr盝:\x11\x80\xb9\x04b8\xe8\xe7@\x00\x8f\x0f\x1e\x81\xcd$yr\xa6]kn5\xb7\xcb\x02\xea\x94\xf5\x7f\xbb\x9c\x1d@\xb4ˋ\x8a\x98G\xb0\b|\x04\xcf#l`\xa9[\xcc\xfc\rN\r\x82\xb7\xe5E\xb5\x0e\u009d\aqw\x99\v\xb3\xd4|\xb2\x89t\xa7\xf7Ȑ\a\xa8\x1a\x18\x7f\nxf\xe2\x00\xf6\x9d\xf9Qx \xf4\xcd\xc7\x0f'\x03\xf5U\xa0\x8cIz\x9bt\x14\xd5\xe1\xe3\xcd/\x00\x16g\xa9\xe6\xb1\xc97\x96o\xa0\xf0\xfd\x10\xce5\xed\x99Y\x95\xdbtL\xb5\x19\xd3l\x0fV\x80ȫ'\x02\xc1oǇ\xe5\xa6\xeb\xfbf;\xebKjxp}\xdflk}\xe1\x1b\x1d\xd6\xf7\x04\x8b\x0e/\x8c\x8b\x7f1\xfcSkl<\xbf\xdf\f\x8cϒ\x8a3\xcd\xf7n_\xecq\xb3\xa1m\xafKj\x02\xa9LG\xf3I\\D\af\xbf\xa3\xa7T\xae\xebş\xbe\x1d\xbe\xf8\xfd\x1f\x87/\x86/\xf6\xbe\xfd\xfe\xe2ɳ\x10\xc0\xa6\xb5\x7f\n\xaf\xc5\xfb\xf8\x96>Nu\xad\xc0\x9c\xb9\x83\x99\xdfb<&KZ\xf2\tr\x96\xcc>V\x85V\xca`\b~V\x0e\xbb\x82(=\x8e\v\x1b\t\x86+Ȣ\x8dt\xd9t\x1c\xc0\xf6\xc4_\x9b\xf6?\x98\xb0\x0e\x8eh\x84E\x1c\x9fp]\xe8_\x00hR8\x13I\xfa\xcdE\n\xb9\xffi\xa2\x01\x98]Z\x00\xfa\xfa\x04\xc47\xb3\xa1\x9d\xa7on\xd8jC\x8b\xf8{\\\xc6A\b)Ů\x80\x10\x8e\a\xfd\x1e\xdf\xce\xe7\x15\x96\x99\x85\x05\xc0%\xc2㿀\x9f4\xa4\x8a\xe5'\x9fXI\xc8uKn\xc0\x00b\x12\xec\xb6\x18N\xd0I\x95f\x97P\xaa\x14\x04\xb0\x8c\x9a\xb1/+f\xd1W\x04bO\xf8RR\xfd\xbeYE\xfe\x1e\xa7\xbc\v\x9a%\x9d\xefkH\x00a\xe9\xbaD\x11\x16\xe2\x7f&\xf7\xbb\xf8\t4Ѐ>0\x88 \xeb\xcet;A+/K_\x83\xc8\x1aN\xa2\xb3\xb3\xd7\x033\x05\b\x98A\xeb\xee%\x16{\xbb\xa5*\x80\xc8\xe5\xe0\xc0%ը\xcf&\xe9âz\xc0\xcc/\x01\x98\xa9\x03{\xc4ni\xd3\x1b\x90\x15\x10\xa5x\x94\xa8\x8f<\xb6e)/\xbe\x1f\xd0\xf3\xe3|M\xb3\xdc^\"\x8d\xb0\xa15\xc3\xc6jv\x906f\xe6\xcc7V\xec2\xdc\xd71'\x8a\xfa\x01f\x93Y[\x0ePQP|\xea\xe2\r?\x7fK\xf1\xb4\xfc\x17(QM\x99G7m\xcb\xcaJ\x9a\xa0\xe6M\xc3R[\x8d\x1a\xfb\xe9%\xbdK\x85\xc7\xf6\xdeW\xba\xda\xf3*\x9d\fa\xe1\xaa\xc2\xec@\xf5\xae8\xebl\xcd\xe0\r\tgc\xa8\x87!\xf1CnF\x9f\x8c\xf1ؒ1\xd4\xcel\xd3\xe8ʹ\xa1\xcf\xc2腈\xad'xhB\xb4\x19\xf39\r\xfac\xd4\xc3:\xfa\x14\xa9Q\xd3x6\xd3\x1e\x8c\xd2\xdcx@Ƌ]\xc4\x1eY\xdflU[\x06\vc^&Z\x91r\x8d\xd8C\x88\xce\x10\xb4\x7f&p#\xa8\x0e'\xf28\xec\x11\x8d\x05\x03\xf2l\x99%\xaa\x1a\x0e\x93`5e\xebs\xa9ۙ\x9b*\x13|\xa5\xc5\xdcȔ\xe3j\x0e\x15\x84\xffe\x94/\x9a\xe98\x9f\x82\xa1?\xb31\xfdl@\xc1\x01\x0fZ\xad\xf7\x1c\xbe\x9a\xb0\x7f\x82\xd2(oS\x8e\x9c\xb17\xf7\x02G\xa4|\x02\xf0\xe3\xfb\xd3c\xdd/;\xc1L\xdf/\xa3\x17`\xa9)-J\f\x1b\\\xb4\x83\"\xce\x1aˀ\x1a,\xba\x94ct\x16Ғ^d\x17U\x04\xa6\x02q\xb2\x81\x94\xcb#\x80\n\x8f\x86\x86\b\xf5\xbcȾ%\xd3̇\x97\x1f\xa0\x94\x1fD\x92R\xd9\x13\x18\x04X\xcf9\xb2\x90+\x1b\x92Y\xb6>H\xfa\xa0\x1b\xa9\xe6\xffn\xd4\xd0kꪾ\xbf\xfc\xe3s< \xe8$0D\x81:y\xf9\xfd\xf7\xdfٟa|?\xb2\r\xb7L\x90H\xfa՞\xb9V\\\xcbq\f9\x83\xf00*\x93}\xe3`\x81\x03\xc66\x04oe\xdd\x19#\xb3\xc1SLR\x9d\xb8^H\xc3\xcf3\x9d(`~\b\x1d\xde\x06Ʉ\xf9nn\xa6w\xee\x9a\xee\x82Q8\xfbA=\xac\x05?\xca\x02\xa0\x9b\xa5\xc4\xf0r\xac\xd3b\x0e\x1f\xc6S4\xeff\xab\x8fs_\xbcZ\x96\n@\x10\r\xc6\xc0\\1\xb5\xf1v\x95\xb0*\xf3lG\x92r w\x01\r\x82Ѿ\x84\x14rZ\xf0\x0e\xf7H\xbe\x18\xa4\x7f;\xe2s\xd3\xf1\xd7\xe7.\x84\x0f+\xa9\xb2K\xcf\x06E\xa3̑\xc7c6\xf4\x14:\x06\x10\xcb_\xe2\xf8a\x94\x93\xfc\x9a\xdco\xd4;\x18\x9d\xae'\xb9yOG\xa3o\xc5\xf1\x8a\xb3Y\x04j\x8d\x87\x97h\xbe\ru\x10#\x97\xaf\xf1\xd5ݍP;2\xc7W\x05\xa1s\xe0\x1dq,\r\x95Y\x0eQ۾\x87\x91Ɛ\xdb\xc1q\xae&\xa1\xe8\xa5\xd7g~=\x9aa\x8d\x12\xc9yE:ɥ\x86T9)x\x1f~\x1aD\x86\xf4\xe0\x82LaCg\x981\x0f!Q\xae\xbe\x04.\xaf\xec\xee8\xbdB\xad\xa6\xc2\xd5.\adJ\x15\x1b\xab\xf4\xf1I\x11\x04`4H\x16\xe3\xd1(\xf7\xca\xc7C\x0fȇ\x1cѨn\f\x81\xb9&6x\xf6\xf6\x18\x87i\x97h\xc0\xa6[C\aْ\xe9\x0e\xa5asW)\x85\"\xe9\x16v⦳\x87\xd9&3\xc2U\xcc\xc0m\x11\xcc\xdecKz\x96\x1b/\x1aT\an\xed\x8f|k\x031\xf9\xea\xa98\xfdې:\x85\x06\xec\n\x11X%\xcc[\x7f\xc8Z1\xba.\x12\xaci\xdb\n\xc1\x89\xf0)3\",\xe0\t\x9b\xc4\xf7fZ%G\xd74d+ǔÂT\x19\x96\xa4\x88\xad\xc6\x1e\x99)\x9d\x9b\x82\x992^H\xe8\x0f\xb3\xe4\x12\x92/\xd8\x05!N\x1c\xca\xc1\xf6\x93+\xe1\x02\xec\xf9\xe1A\xe6\u009fA\xf4Y\xe5\xe7\xc9\xc0#s\xd1\xc6#P$\x90֕I\x854X\xa25\xdb(\xb6w9\xcc8x\x15\xf0>M\x92\xabJ\x13\xc3\xe1\n\xce\x0f0\x8d\xc08[\xec.\xf6Y\xa8\xa0\x1c\xbdi\t\xb5\xe7@\xb0t\xd7\xd0\x10$O\xaf\x84S\xe1h\x9b\xec\n\xde\x01Ʉ\x12aX\x1c\x01\xfaaIL<\x81\xa4\x17\x11s\xe4\x11զ\x86mI2Z\xb2+#\xa8U\xa5\x93\xc5/\xf0\b\x18\x81\xf6&\x89Ai \x9e9\xe7\xc49_8\x1d\xd4\xfa\xe6]\xc5\xdd\xe1\xe9a\x90|\xc6f\x06Y\n\x14\u05f9\x7f\xfd\x12\xcfh\xad`\x16\xcf3\xb9\xb6\xd7\xd5\xebe\x99\xef\xd5k<\xac\xbd\xeb\xae,\x06Ɖҡ\x18\xac\r6B\xf5\t\x01\x99\xaf\x10\x86\xac\xf7\x13\xf5~\xa2\xde\xc4\xf3\x85\xfcD˓\xbe\x89\xf9\x98\xbd\xf5\xef\xffzR\x7f#\xa0b+&(\x9f\x18\xaei\x84\xf2:9Z`\x90Z\x87\xb6\xba\xfeV\xa2\xb2\xee\xb5ak\x7f-\x110L`m\xfcd-\xe4\x85\xebƭ\x19\xf2\"\xbd*Y\a\xa3\xd2X\t\xc1h4VB1J=\x85l\xef*ޝ\xc0\x99\a\xa6\xfcb\xf8\xc7f-Vx9 \xd2JI#H\xa1f\x16K߁\x93i\xe6A\x85|yH\xc3\xe8\bB&@\xa4\xf1\xc52\xcc1\x9f^\xa2\x85j^Ru\x01\xd4m\r\x19\x7fwʒ\x83V\x9a\xb1\x86\x06\xc2\xfb\xa6e\x89\x98\x016Pǖy\x00\x1d\x92\xc6\xf2T\x96\x84-L\xe6\x9eK\xf2*4x\x16\xf8\x00\x13S\\.+\xaa\xc7Z\xe1\x87Y\xb0u\xd1};\xe6\xf8a+B1N\xab\xde\x16\x19\x9e\xee\f%h\x96@'l\xd0آ\xba\xe4\x9d\x1bt\xa5\xa1n\x9bw\xda\xd0\xf0^\xda\x03j\x93\x85--\"\xeb\b\xbbP\xf46\x83(\xbf`\x9f#8p\xacO\xaf\xbd\xe3\xde\xc0\x17l\xbcj\xb7\xd9\xfeo\xba\xf5\xee6`\x82ƃ\xef~bT\xd3\xff]U\x88]\xe8\x04X\x87\xe6j\xc7\xc1*DW\xbd\xc74\xac\xb5[[\xeeE\xc4\xdc+J\xc8Y\xb01\x8aN\xee\xbf=D\x81\xa9\xa9\xad¯\xed\x17\x87E?N\x8cp\x00(hb\x04\x9di4\x12K\xe6c\xbc>2\xfc\x057\x87\x9a\xacsi\x1e\xe4ΠT\xfb\x99n\xcd`\x19\x1f\xa4\xb3S\xdf\xfb\xa9\xd1.y\xe3S\xb44g\xcb\xf7\xbf甏\x86S\xaeH+[08\xd7 \x92\x12\"ҙ:\xc2\vV\x18\x8dX\xfbob~\xfa\xd6\x01.\x0f\xd6\a\x9c<\x8a\x80\x13\xb7K\xb2Xn{\x1e\xea\x9c\xf7\xb1'}\xb5\xd0\xedƝ4\t\xe26\x14\x7f\xe4\xc2[\xa1\xac\xd0\xd3J\x94\x15^h\x8a\x82\xe9\xac%\xb1\xc6e\xc0\x10\x19\xe4\xdc10@k;\x80$\xe6\xac\xe7\xa4\xe5\x97\x19X\xb2\x9cţ\xe4\xac\x15\x10\x8a\x9e\x94\x88'\x89MK\x96\x1f \xd5\b\x020ʑ\x99\xd9x\x97\x905\x86\x11\x83Qz\x12\xc2\x18\x05R\xf8w\xe6zi\x80EM<\xe8)\xcf\xdd-\xf9\xb5\xa5\xbd\x16>LUi\b\x9e9\xd6#\xf2\x12\x8bd\x01\x90\x15\\4\x13?\xa0\x9aӜ\xfc\x11m\x19\x80J\xd9WB\xf8-\x82ZV\x9b\t\x8b\x80<\xc0\x13^:\x1c\xbe\x1d\xe9\xe7Z\x9aY\xf8\xeb\xdb\\\xa6\x15E\xa4p4\xefZ\x17\x19\xc5\xc1U.2\a;7\xcd[\x81[|\x15\xd5Ӣ1Ĉ\xa4O\n\xa46\xfdT\xf9(\x9fȍ\x81\xf0(\x87\xc1\x12\x03\xc6IR\xa0x\x9eSR\xce\xd8v\x12S\xf5\xad\xa6H\r\xac\x8d3\x1eǼ\xb1uQ\x9d\xf6\x91\xe0\xef\x10E\xc5;\x17\x03\x92\xa7\x95'\xcf\x0f\x0f\x92A\xa3\x96\x8b\xdd\xd9 \x0227n/X\x1aW\x1a?\xd6\\\xdfw\xecKĵKܨ\x9e\x9e\x1f\x9c\xc0r\xbd?<y&\b\x03\r\xa3&\xce׃\xfe\x1b\xe8eԹ릻\xcd\xfdx-\x88\xadk\x9cYI\x01\xe9|f\xcf\x18\xce\xc6K\xecVd\xa9!\xa4'-Yo\xa2\xa6&\x1cOF\x99n\x98\x9b\f\xd1|\xa9\v\x91\xb4T\x9dP\x03\xdfխ\x7f\xcaZMZ\x1bx\xe91Fϛkj\x19\x84\r\x02\x85\"\x84\xa8+2\xdf\x11\xd6\b}ܥ\xa5\xd3\f\x9d&\xf8l\x00\xe6\x8d.ڡ\x9a\x98Q\xb8\x8b\xbc\xa4\x1b\xc3\ue4e0\xde\xe3\xc0\x0fe=\xbdB\xc1xE[\xb4j\xa7\xd2\xfa=cL#\x7f\xb9\xbep8wD\xf9\x02D\xdaIB!\x94\xe6\xe4Q\x9c\x14\xc3\xc8\x12ɮ\x1c\x19\aq\xd14\x8f\xad\xf5\x12O\xf7\xb3z0YȐ\xffPZÑ\x1f)\x95fK\x0e\x9d\x84\x05u?u\xe7\xcdÆH\x14p\x86V?u\xcd#\xc4\xdaJjw\x1a\xea\x86\xe4@\xab\xa1z\\\xe7C\xa7'\xf6\x05O\xdd8OJ\x17#\x8bq\x8a\x0ft\xe8\x1e\xf0P\xd5\xe3\xef\x16J_\"\xd3:|L\aH\xd3X\x1eVG\xe9ĕ\xccrp\xfc\xcd\xc3\t\aΝLXI\xdfV'\xa2.\xee\f\x94~\xe7\xe8=\x89\xac\x98\xc9)\x04i\x80\xdeR\bJ\x89\xf5\xee\x01\xf3\xe7\xbd\x1c\xc5%ǰ\xf8C\x01\xb3 &\x1a{\xd6_B?7\xd7\xfc\xbeN\x01\u07be;w1:\xb0\xed+\b\x96\xfb\x19\x1f\xb1б\xfcL\x92$l9\xec\x17\xe0\x83- &x\xd7P-w\xe7\xd7\xdfo\xca$ s\x01\xa5\xae\v\x14\x17\xac\xa4\x8d\xc2:\xe2\xff\x9a\x99\xcaoG.P\xb5)\x97)i#\x15\xd4)\x1b\x91\xaab\xb11.\x19\x8b\xa7\xbb\xcc!\xf8ȑ\xda\xdc?\xbb\x83\x82\x93\x10  sh\xb8Y\xc9ya\x18\x04W\x96\xf3)\x9fH\x84ߵcG\x00m\xdc\x1e\xee\xed\xe9\xddM\x82\"(\xc6\xd6V\x14'\xaaz\x97\xf1p\xf7\xcfZ\xfa\xd7!\xbb\b%\x163\xe8Sa\xee;\xa2-\xe1\xf3]\x146i\xdb\x06\xd8\x10\xa54\x01j\x86\x10X\x0e`U[\x1b\xfd\xdf\xe8\xe2\tM\xe0\xe2I\xf4\x8fa\xf4:\xfd\x98\x00\xff\xc7\xc0\xb8\xc0\xd7DP \xd4\x02\x95\xf8\a\x11M\xb9p~\xc7*\xd4H\\t\xb4\x1e\x00Y\xb2\xc8\xf7N\xf1\x84zDO\x8dr<\x02pq\x84\xb6\xe7o\xdfY\xac\x04y#\xb0U\x80\x11\x84\xed\x9cH#'\xc4\xcc\xe6\x7f`@0w^\\\x98\xfcFl|uP\x84\x13\xb8\xdd\x14\xa6\xd9\x16\xa2f\x9aX\xa04\xb1#_\xe7F\xa0\xe5\x80\xd5\xf82\x9d\xc0#\xc1\x8d\xf7\xc3\xe5\xf9\xf8h\xb4\xb5\x88\xe1\xd6||\x1dMdm\x05\\[\x11\xa0\a)\xe8\x83\xcf\xfa\xe0\xb3/\x11|VR\n\x8e\xbbEL\xff\x92\f\xf1#\xc7k\x06\x985\b\xcfւ̚$mM{s\xa3\xa3\xb07/D\x1f\x9b~\xb6Fg\xbd\xaf\xed\xb7\xe8kk\x1e\xce\xde\xdf\xd6\xfb۶\xeao\v\x93\xadm\xd1\xc0\xb0\xe93D\x03\xcf:0\x0e\x1ff\xe4u\x9a\xcd?\xc1\xaf\xf3l\xbf|_\"\xc2\x1d\xe2\x10'\x80\t\x1dO\x10\xc3\x17fzU\xe2?\x03T\x12\xe4\xfd\x93\"\xbdM'\xc9urT\x8e\xe2I\v\xda\xfe~KK(J\x8b9\"\xa0\xfa_\xb1(:\x8a3\xb9\x03.\xd7e&/G\x89}\xbb\x99Ѩm\xcfU\xe1eߪJN\xac\xa7\x1c@\xfa)H\xd3i0\xc1\xb2\xd9ȧ\\#\xfd\x84\xb4H\x82\x18㱳t=\xb666'^G~\xaf\x99\xd6v\x81b\x80\x80>\x86,+g\x1f\x8a\xe2yu\x93\x17;\x88P\v9D8\xff\xff\x16E\vh\x04\x85\xaa\xb9q\x01\xe5\xa1\xc0\xba\xd0\\\xa8\xca=\x1d\x88C\xb3\xad\xfa\xe1j\xd9P\xbc\x9c\xaf&ɧ_\xf2\x89\xd1d\xdbWS\xb5\xa1ż\xbb1\x1dˊ\x8a\xa9\x11Z\xddR\xaba\x14\x1dI\x1cU\x96N\xa4\x9e\xb1\x82\x89ӭe\xf1\xa8T\x14\xa9v\bʞp\xa2{\x82\xfa\x11\x80\xbe\xa1\xe6|G\x06=x!\xbe\xb6\x89I\xbaCmm\xcf85\x8a'`\xf4G\xb2Bm\x97g5VJ\xaf1\x02\xae\x86\x11d\xddrF\xf5\xf5$\x90Uxmȫ\x19XF\xd7\xc8[\xc5\a\x99\x9cL\x03\xa7\xc6wv\x7f\xbc\xe4B\x1e\x06\xdb9D/R\xf4\xd9\xc6\u05fc\x9cV\xf3\x1d+\x00oW\x03o\x9eMTt(\x1a.\\\xf9?\xc0\x92\x18\x9b;\xc29\xa3\xf6\x82\x993\x867Ь\xd7\xe2\v\xd82\xf6\x85w\xd0'\x124lkwW\xd8\n\xd6\xea\x82.\xc9$iBJ\xf2\xc9\rQ\x81e\x81|\xcd\xdb.\xdbՙ\xf6\x1f.~A26\x1b\xdbhs<Ť\x16\xdbRv@h\x01\xe7!\x9a\x82taY\x03\xeet\x86\xc5\r\x19\xfa\x82_\b\xb3\x01\xe1km\xe5\x06\xba\xc2\xdd[(\x7f\xc9b\xe4\x1a2\x01\xbc\xfb5\xaeLK\x8d\x04@\x910\xb7\xe8\xf8\xe4\xa09~~Pc\xaeJ(P\x8e>\x00\xfa0\xf7\xe5g~%ͼ+\x10^8\xe8\x9fM\xbe\xe1\x8fK\x1c\xe4\x8a\x03\x90\xd7:\x0f\xe2\xe4\xf80<\x00\xf3`Տ\xc3+\xdd?\x1c\x0eF\xb6\x8f\xf4\xc7\xc9/b\x81\xae\xa5f\x04\x1a~\x9do\r0\xaf?\xcd\xf2\xed\x93\\\x1f\xa3\x1b=;r_\x02Z\x90{\xd6E4\xbb\xc4\xea6\x80r\xe3\xde\v/\x1a\x149zgX\xeei\x9eW\xafL\xc3\xf2\xde\bH\xd3P\xe5\xaaP;b\xd4@\xdaY\xae\xa3[H%5,\r'lع\x85؇o\x12\x9f/Lo\x86YO\xcc]\xc6\xfe$\xf4C3\x00\x8f\xd8k\xbcu\xd5#\x14\xcbi\xef\x15;<9;\x11e~\x9cd\xf7\x9eO\x91'p\x15Oʤ\xf6u\xd4ݻ\f\x1d\xf6\x02\xdd\x1f\b\xc3\x02QH\xe2\fa\xaaO\xe2>\x97~\bmD\x98ݴWG\xae\xb7\xb4EP\xday,\xf0\xcaY\xad\x82\x82\x88\xbe\xe0\xfd+\x89\x89\xe9\"\xe3\xea-4E[\xb5\x17\x19\xdfj,\xcai4\x8dI\xd9G\x1d\x88\xbb-\xf4\x82\x19$\xa7\xf6M\x867`p~\x14\x95\xcad]\xf2n\xbb\r\x10x\xd1њVBz\xb0\xf2\x1c\xb8x\xd7vF~v\x84\x83\b\x8d\xbb\xa9E6\xed\x05\x8d6]٭\xee\x9dk\xee\xe0i\xda>\xebm\x8e10\xd9\xdb6\x95綡\xe74\x04sj\x12\xcd&\xf3\xeb4s\xbaN@:\xe7&]E\xf3\xd5]S\xae\xfe\xe0\xc2P\xab\xb60+\xf7z3\xbe*\x9e\xcd\xca=\xfaη{\xaaΡ٥D\xa0璸D\x981\xf6\xbbO\xc5j\x04\x80\xfb\xa8\xe9\xaa\x0fP\xf4\x84,\x8e\n\xae\xf3\xaa\xbcER\n\xcf+\xc2\x16S\tl\x8a \x84Bý\xab\xabwu}\x05\xe6U\x16W^\x13\x01G\x04Cu#\xe0\x84\xdb`d\xc6\xf8gM\xcarW\x1b\x05\x13ә=\xc9\xc7O\xcbg.\x86Cu7\x8d\xb3\xf8\x1aNͯ\x15\x05\xdc+\x1c\xd7\t\xee\x81\x7f\xd7\xd4\xeb\x11\xe2g\xbb\xe1\xad\x06\x14ީ`\xb0\x9e;2\x01\xd8/aH\f\x9c\x80\xd5\x101Su\n2z6\xce\xef\xf0u \xb4\xe6\xc4\xcd\xe66\xa4\r\x97\x9e\x85q\x10\xfa1\x94\xe5\xb1/\xea\xf6p\xc9\x15\xbb]\xd3W\xe0zp\x85tC\x85\x80\xeb\x8dj0b\x16^-\x16\x86\x89j\nrV\x00\xf6\x02+\v\x92Һ\x03\x81K\xf2\xf2\xca6|\x03P6\xeb\x1c \xca\xf0\xa3\xe7i\b*\xeb\\\xcak\xc1\xf9\x10=\x81GY\xd9wE\x8f\x80\x80O>\x91\x18\x1a\x88A>\x9bE\xc0ᰐ\xee\x96`\x00\x0e\xa1,\xdȩ\xa8\xf3\x1a\xeat\x89(\x81\xd8RD4\x02\x845\x8bd\x88r|\t\x97\xc0B\xb3\xe18\x82蟦\xb3\xb2\xad$1=\xb3\xd0fv= >\x16Wjq\xd7K\xae{~\xe5\xf7:\x10\x00\xcd\xf3\x02\x04\x89W\xa0\x98\x0e\xa2\xf7\xd9\xc7,\xbf\v\x7f`qIQ}\x82\xecG\xd6J\x00p\a7\x1c\x06\xe0?\x0f\xa1\xb9\xbb\x16\xbd\xd3\xff\x8b:\xfd%pToHhX\x8e\xa0\xc2\xc8\xc6\xf9\xa8\x84AAM\xa3r\x0f̂\x00\xf3W\xee9|\xbfr\x8f\x8f\x1b|\xc7\xfd\xbc]뙢\xd3}\xe8\xc0W\x1d:\xe0\x0f\xef\x91D\x0f\xd4\xc8\xdcƢ@8^\xc0\x7fn\xed,u\xc1W\xab\x14M\xb5x\x9af \xa7ݟ%@\xd9\xcb`i\xbdt:\x9f*-\xbc\xa4\xa6\xec\xc4I1\x9e7K\ue308\xc9E\xcd\xc9\xd2n\xd1:\x80\xcbޣ\t\x148)\xe8\xe9\x90\x1fP\x95\xcaN:*\xe2\xf2\x06\xc14\xa0\xd3T\xacЦ\x01\x144\x03\x91ݖV\xf7aD\x9fGO\xe1cb\xa1\f\xbd\x00\x9aQ\x99\xe7h\xcfN\x91\xa7\xe0\x80\x9eu+\xe7X\xb4\x96\\\x97b\xec\x16\x0f\xd4.\x90\xa8\x19\xf2\xae+]\x1f\x93\xd0\x05\x97?\a\x8f?АyZ\xde@\x80\xf2\x1d\x94\x85\x97\x18\xef\xe8\xdfI\x91#'\xd0h\xa6\xde\xd4_<$\xb9\xdd\xfb\x06\x8cr\xbbi\xb9\x1b\xef.\xa1ǋV\xaf\\\x92j\x92\x17:\xd9\xd7\x10\xb9\xc2\x1c\x8e\xdbD\n\x82\xaazz\x0ecV\xa4\x91Q>\x87\x1a\xe7\xc7W*Ӊ\xfac\x9d9e\xa3\x95R\x98\x89뢲=\xb3\xd5ܭsH\xca\xdd\x0fI!\x8f>&\xf7\xa5\x038\x15\x03\xabC\x8132t^\x8ci3\xe9\xf0\xd1\xe2\xb8|\x16%9\xad\xbc[\xb0\x0e\xb7ir\xb7\xc7Y\x8c\xbbp\x83v9\xa2n\x8ff\xb1\xf7\r\xfew\xd7\x16\xe8\xddn\xe6\x88,H@4\xe4'r\xf8\x99\xe1\x11\xb8\xbb\xa7\x89\xe0\xc2j/\x82\x10\t\xf0xd\xe5\x1c\xf2\xa9\x90\xd9y\x86=\xf0R\x8d\x90\xb7=\xdc\x19\xff\xc6\fm\xd7N\xb1;\x1a\xf4I>\x96\xe9s\xbc\xed*\xa4\xbcE\x94\xaf\xb7\xa8W\xffh\"Z\xd7\xe9\xba\x16\x85-\xd5jJ\xc3B\x16O[\t۹G\xcc\x1c\x1d\xb5\x1b\xf4\x94(:b\xdbJ\xde^\x8d\x8f<S\xf0K\xea\x0et\xa3\x1aV\xc7\b\xaf\x93^\x16\x16\xa1\xdd \xc9\xca\xc1puu\xfdר[\x1e\xfe\xf8\xf0\xa1\xa4J\xa7\xbbץ\x89\x19P\x8e\xddiR\\'\xbb\x86\xbe\x98/\xb0\xc6\x1dh%.\x13( \f/`t\x03T@\xc1\x8b\x9a\x8c\xbbn\xa1#\xa67\xf1\xad%\x80\xb60\x8b\"\x8aRaD\x11CW\x00\x8c>\xd6y\x17\xc5\xde\xf4\x13\xa0Q\xb7\x04\x91\xbck\xb4\x81Bc.\t\xf1\xda\xfd\xce\xc3h\xb1i\xf9W\xa1C\x19a<\xc2]\x17\x90λ=\xff\x1b\x1c\xed\xee\xe2D}\xa24O=&\x11.\x1e\xb9\x14Ё:\x9a\xbe\xa0\xfa\xc4\x01\x96\x80X\xe4\xa8jʯEr\x9b\x86u{\xb2\xbb\xd0St\xf8\xf3WP\x89rn\xfb\xe7\x03\xfd\xc4Ն\x97W\xb7T2\x1a\xe6h.\xdb{T\xd9\x0e\xe3d\x9agA\xa7\x1c\x8a\xf2T\xeb\x03ָ\xd5l=\xc6.p\x12\x05u\xcd\xea`@ď?\xbd\xcf,\x85\f/\x94iS\x93\xf2\xed\x18\x15\xf9\xe0Hع\xeb-\x1a\xcf\v\xa1!\xfc\xfdȕ5\xba\xc4|\xbe\xf8\xb2\xcc'\xf3\xca^\xa8\xa7ɧ\x97\xd1\x7f>\x8b\x10\x1a\u074c\x13\x8e\xb8\xc4i\xe6\xe0\xfdm\x1d\x04\xfb4\xbc\x12\xdf\xf4U\xea\xf4\xc5\xf3\xffx6\x8c\xf6k\xdf\xc3d\xe0Ɉ\x8dԨp\xab\xaf^B0\xc6<\x1b\xd3\n\x0e-\xe8\b\xeb\xd8ϭ\xd0\xcd\xea\xbfyj$\xef#\xaa\xda\xfcRbMI\xbc\xe7#\xf5\xdd\xf3\xff\x18\xc0X\xf1\n\x9b?d\xa8\xf5\xd9AR\xbc/\xe5^:\x97&n<m2B<\x98S5d\xee?\xe4\x03\xf1\x16{:\x03|\x91\xb9a\a\xcfp͑ě\x97S\x16\xa2ˊ\x836`\xb93Y\xae\xa6\xc3\x14ӷ\xf9).p\x89\x16\x7fx\x9b=\xac\xfet ۾\xbe=\x00\x85\f\xebq\tg\xa24\xbd\x81^XoE\xd1X0\xbc\tb\xf6\xbc\xc3\xda\xcb@oM[\xea\ab\xa8䄡\x1c\x8f\xbdb\xd4_2\x86*0f\x91\xd1N\\\xeb\x1b\xfcdF8BO2\xada\\9\x11\xe5\x0f4\xf4\xbcH\xaf\xd3l\xe11\xd3ߏ\xd8U\x0f\x8bT\x06\x8e\xfbg\xac\x82\xe9\xd3\x10C\x8f\xf3{\x88bX\x9f\x88t\xa0\x1cg\xf3\xe2\xba3\xcdhP\x8aR\xce&\x98\xd1\xe9\\ځ\xf8\xafmD6\xa4K\xfc\xbe\xa2\x04\x16@H.3(\x1do<r\b\xd7\xf6\xf9\xa64\xe3G\x8b\xe5`\xee\xbd+\x87\xf1BBG\xbb\xd0\v\xb9\x01\xa7\an\xf1bX9\x88@\x9dN\x931\xd4~\xd1\xc1\xed\xfe\xe6\xf1\x9d\x1dP\x82\xb5u\xff\xd6IN>\x19s@1ߵq\x8e\x8b\x03\xd5\xeeͷ^\xf0\xf5\xd6\v\xca7\x14\xde\xc47\x90\xc0\\\x82\xb1\xe2c\n\xca\xee\xa0u\xd8W\xf3\x02.\xe9\xa0v!\xebc\xc2^k\x91\x1c\xe8:j\\64\x16TH\x87\x82#\xdd\"\xc8\xd2zL\xf33\xb2ʶ3\xbf\xfeI\x1e\x83w\xa8\xf5\xce !\xe0\xdb\xe2\x9f\xf7\xab\xf4S2\xde\xe0\xd4\xc3\xc9j\x1c\x1f\x18\f\xb4\xfaCs\x9bW\xb8\x0e|v=\u0382\xc2\xfc`\xc1W\xed\xb1%\xa4\x0f\x06i6\r\xa8{uS\x1b';p\xe3\xe8\xbb\x1d\xb9\t\x1dp\x9f_=\xcc\t\xef\xc0m\xdaB\x18\x9b\xaa\xcb<\x8b\xf6#\x8a\xa2\x94\xc6\x11\xb7\xf6\x832l\f x\x16\x11e\x03\xac\xc1\xdcҖ\xb9C\x03\x91\xf7Bþ\x01p6\r\xdb\x06\x85`\a\xc6\xe7B\xb3m\xe0$\x98g\xe9g\xb3\xc4\xf3t\xec\x87O>@\x8e\xcc\xf1\xa1\v\xd5.\x82\xb0\xef\x82ݾ\xf5\xf8Օݴ-1\xa0M9\x83\xdaE_v׃\x8b)\x958\xb6\x14H\x1b(\xa3\x8eSo]\x1a\xff\xb9\x17\x13M\xb1\xf5\x7fv\xbf\x81h\xfef^V\xb8\x97\x1d\xd5\xf9*.?\xa2\x06O\x15!w\xc1\x96i\xfd){R5\x0e\x7f2\x1b\xbc\xb7\x82y\x937\xd5\xc6\xc2v8.@<\x03A\xd9\xceT\x17[\xfa)i\x06T\x18K\xbc\xa6}\xb8f\x1f\xae\xf9\xf5!\x93X\xa7\xf6\x9f\x1f(lr\xf8\xe0q\x93\xfa\xfeԣ\"Q&z\xe0ؽuC\xf8ah˂!\xc3E@?ˬT\xec\xe3&\x13\xdc^`\"\x91\xe85\x03\x11\xdcj\aΏ\xf6\xf5\xc5\x15\xadU®\x17\\k\xb8\xf2BJ\x02\xe6\xdb6{x\xd3L\x00\x05\xc0\xa1\x1ahi\xe3\xe8XjgR\xbe\x1dc\xb4^\xf9E,\xad\xcd)טsw\xdf\\\xfbbģj\xee\xeb\xf1\xe2\xf8p\x8b\xa2\xcf\xf7Jk\xb2\xc8M\xbf\x86c\xbe\x14\xcf|튅\xe5\x18\xa8>\xbe{m\x04\xf0\x84]\xda\x01\x8f6π\xa6d\xfe&\xb0F\x00d<\xf1֯\x992f\x94\xc0\xeb\xa4:[2=\x1b;\x00\xe7j\x85\t\xba\xa8\x0e3\x89\xd4\xc87\xffF\x1d[\xe5\xcf`\x96\xf84\x9e\xed\x12\x94!\xca\b\xb0*\xc9'8G% \x16\x18\x01\x93\x9f\xda)\xbb\x9e\xc7\x10\xc8\ta\xb1\xb7y\n{]\x159\x90\x1a\x14c8\xab\x95\xf8jI\xf6[\xe6\xea\xe2jOU\xd5j\xdauI\x13\xc0\x1d\xddE\x10\x85\xa8\xbc7\x92\xd1'\x1bIAkFº_o\xdbM\xc4\x0eu@ST@\x17$\a\xd5\x1b\xcaC\xc8\xeb\x96x\xf1\xcf\x16\x11\xb1\xba\xfe\xb34C\xacC\xe2[\xed\x95%*QGuhK\no َ\x92g\x11'\x12\x8f\xfd$\xfd\x88G\x8f\xb2qm\xa9\xf5\xe6\x9bd\x9c\xc7\xdf\xef];\xfc\x96\xab<L@\xad跁\xd9>@\x9d\xf9m\xa9\u05edɈ\xa9_\xd7:\x90\x8b\xb8\xf8\x9c9<r\x10Τ\xd8ĂJ\x17\xc1J\x97\af\xa2\xd1\xd3#s]/\x9e\xbc\xf8ӷ\xc3\x17\xbf\xff\xe3\xf0\xc5\xf0\xc5\u07b7\xdf_<y\xd6(|\xc9*˚\x85/\xc1(<\x83ļ\xaa\xd05\x8fa\b>\x89\xac#S\xc0\xb6\xa7\xaa8U\x9d\x05\x8e\xd2q\x00d\x1d\x7fm\xac\aNX\xab,\re\xe5\xf8$\xa2%#Tڄ\x8c\x8et\xfc\x9b\x8b\x14\x92\xf8i\xa2\xcdo\x1f\xd1\x02\xd0\xd7\xcdJ\xa1}\xb3\xf3\xf4\x01\x17v\xa5\xa1E\xfc=\xb6\xe4\b\x15'\x8d\xd2\x02\xc3S\x1eX>\xaf \xd2\x11\x17\x00\x97\b/\xdc\xfa\xb9\xa3\xfe\xe9\\Rp\xbb\xaf\x86ݫ\xfd= i_\r\xbb\x9dC/ 'ka\x94\xaa\xfehi\xbb\xd5\xc3nh\xb7\vƵ\xa8\nv_\x8e\xba/G\xfdX\x8al.8\xc2}%\xea_s%\xeaE\x1b\x1f,B\xbd\xe0\x85\x85\xf5\xa7[+@\xafG\xee6SB\x96\x93\xbb\xbe\xaa\xf4o\xa5\xaa\xf4\xf2\x1b\xd0\x17\x94\xfe\x8d\xf1\xba\xee$/\x9cq\x1e\xac\x0f\xddWv\xfe\xad\xa2\xcd/2\x83\xf4\x99\xe2=\xc8\xfc\xdai\xe2]\x15\xd8@\xc2\xf8*Jl\xb8\xa2s\xb3T\xb3'\xc9%\x94\xc2J\x88\xecB\xdbQ\xaa\x82\b\xce\x11\xe6\x1f\x11\xa0\b\x1c1\xe4+%\xc9c\x97\xaa\bꗬ\xe6\x1c\xb6\xad?L!美s_\xc7y\xdd:\xce\xcb\xe4\x99%7\x17$+\xef\xe6\xc2\x0f\x8d\x9bk^\xecV\x8e\xf9|I\x01\xe65\xeb/o\xa9\xfc\xf2g\xa9\x98\xfc\xe5\xcb$/\xb3\x94.>\x12\x98\xe9\xc3kۊ\xffQ\xb7\x90\xf6e\x8f\xfb\xb2ǟ\xaf\xecq7\x93\xfbWQ\xf1x+\a\xae/\x92\xbc\xd6\x11}\x98\x13\xf8\xf9\xca#?\x8a\xea\xc8}q\xe4\xbe8r_\x1c\xb9/\x8e\xfcPői\xe4\v\x8a^-\xaf \x16\xf9iC\x14M\xe8jw\x85\xbcK*aO\x8b\xb6\xe3\xc2Ь\x80T˿7aF\xf0w\v\xe7\x06ĳQ:,\xa2\x97\x97\x8b\xf5ᕰ\x15\xb2\x16&b`Q\x19\xfe\x9e\xc3B\xf2Q\xb4\x92\f\\U\xecH\xe3\x03\xe3\u0097sX\x10\xf0NI\x92\x193\xad\x18\x1cg\x86x\xa6\x9f\xa48\a~6`\xbb\x81\xa6'\xd82X\x93\xacћ\x90E=vOi\xaa\x9c\xa8\xc0\x01\xc5ч\xdf}@\t-\xc5̰r\x12c\xf1\x13\x8cZ-0Ǘ\xb2{o\x81\x9e\xc6N\x10S\x9f\xe5\xba*\xb6\xc8\xd9\xf0\"\xbb\xc88\xeb\xb8|\x19}ػ\xca\xf3\x0f|AH@\xa1\x9f\x06\xf4߽\x0fx\x81\xe8ߗq\xf1\xc1\x7fÉ5\xf8\xf3\xf8\x03P\xb8\x0f{I5\xc2V\xab\x9e\x81\xa3\xdbt\x14\x86A\x92'Q\x02\xff(Y>E\x83/(\x00 \x17\x82\x85\x97 \xd6r\x05\xe0\xcc\x14\x18\x05\xad\xf8*\xa9\x90\xeeBpk\xca!\xe16@\xdd\xe5#p\xec\xdd0\x8a\xf6uџQ\fU\x93\x88\x02\xf1@0ʼ\xb4\x80m樝\xbc;;\xc7}ȣ\xe1p\xb8\a\x87m\xef/0V\xb8-?\xec\xc9[e\x9fϷmg\xc8\xd8\xc8,U\xd2\x1a~~\xa8\x1fK\xee\xaa\xd8\\6\x92\x98\xbc\x9e\xfb\x10\xc3\ar\x81\xb8\x98\xbd6\xf8Dd\u0530\x8ax\xc9:\x95\f\xef\x18#\xb8\xa2'c&f$v_X\xb2\xd6%\xbb\xacF\x12[\xaaൕ\xee{\xcc)\x15R.\xb0k\"\x85-/\xf8\xf9\xd3'j\x9b\xb0\xdd\xd4\tUeq\xa3t\x89\xda \xfdzw\x8d\x11\xc2\xe3\b\xed\xe3\xd8\xc0\x9e\x8c\x98W\xd2l\x90-շ\x8e$\xe5\x89R\x9c\xf2Tۑ\xa6D\xa5\x85\xd1i\n\x17d\x1a\x7fjʣ\xf0ccB\xe6GYq\xe8[@\x18a2\x03\xd2\a {\xacc~\x1f|\xbc\xf9\x854s{\xaa\x00\xd5\xd6\xfa\xc6\xe2͓\x13\xd6t\x04\x1e\xf2~Y\x13v\fH\xa2{0{b\xeb\xdeEC\xed\xf6\xb0\xdctm\xdflgm\tH2\xb8\xb6o\xb6\xb5\xb6\x01p\xc1\xda\xda\x1aQ\xee0-\x8b9~\xfa\xc7\xf9\xf8:\x84*\x18h\x84\xcc:\xb3\xc0\xbd9\x1f\\\u009e4\v4\xb6\xed=@#\x14\x13و\xeaWf\x98\xf5\xf9\x1c}>\xc7#\x15\xb6\x1eC2\x05\xff\x1e\xb8\x8a]\x12*\x96\xdf\xfaep\bo\xbaT\x83z\xb8ѭ\x87eP\x176C\xd4n\r\xb93\xd0M8<\xb0\xa5a\xa82M\xa0i\x1f)\xf8\x99#\x05\xb7$\x11\x87NY\x1f\xfd\xf7\b\xa8\xf1\xda\xe1w\x1d\xe8H\xe7R-\x1dIq\x17Z\"\x85[D럹\xa0\x8d\x16J\xbc\x1a\xb4\xf3\xbeo\xc9S\xa1\x16\x82\xe7{Q\xeb\xe3\xe2I\xd3\xf9xa\x01B\xccS\xacɫP-\xe3+,g\x02'\x93\xbfd\xe4[\xc0*N \x98(ELK\xf1L\xd9V\xe4\x9f\x1fF\xaf\xc0\xdfH\xe6Z*Z\x06R\xa6\xb9\v\xb7\x18\xafn\xf43\xb0$\x1bRV\xdc\xdb\xde\t\x96\x98|,p\xb3\x9ek3\xe7t\x0e8)\x86\xbd%\x9fX\xc2\x06\xffgE\x98\x18Fi\xbb\x00q}\xdf\xcdu\xab\x88\xa1\xaa\xe7\x15w\x82\xbc\xfa\xf5\xc1-\xd9\b\xd4_ˊ\xb5\xd8\x156\x04\xed\x17\v6%:\xcb\xc9\x11L\x1b\x83\xa6\x8c\xd5\xf7\xe5\xe2ɋ\xe7\xcf\xffc\xbbK\u070eS\xf3:\x88SC\xe1\x0en\x84pv\xa9\n\xaa-V\xa9Ԝˮ\xe2\xce\xda\xe1\x7f\x9dŤN\xb4\xa3Q'DUVVe\v58Q\x88\xa8D\xdc\x110\x1c\xf0\x1c\x10\\4#\x0e\xa9:\x92\\ֳ\xeed\xa3\xee\x921\xa7\xf3\xbb\xf5,\xd9\xe7e~d\x9c\xa4\x9f\x93xR\xdd\x00\x15f\xe1\xd8\xfd\x90|\x9a\xe1)\xc7^\x9a\xc8\x10\xfe\xfb\xadH_\x0e\x1f醚\x8aR\xda\xc1FR\x1bR\xc8\\\x82`\xbfM\\\xaa5\xbe\xe5-ZӘ\xaf\x1f\xbb\x94\x9d\xe6\xf6\x06\x0eyt\x17\x97\x84\x94^\xaa\xc2\xe9F\x88\xa4H\x9c\xc25\xd4.\xa1\x1b#\xd0M \xc2s\x0e5B\xc8Kw\x9fT\x04\xf3lU\x05\xee,dLp%!\x86\xd1~\xa4KrI\x00\xc74\x9e9!\x06\xe1\x9d-t\xaf\x1a\x9d\x1b\xb9&`\xdaO\xd5|\x1dc\xb9\x80P\x02\xf2\xfc}tr\xf8\xa3\x1a\x0e\x00\x1e\x81\x02f(\x12Nf\x1a\x17\x1f9\x97\v]*\xd0\xfbS\x80\xa5@\xaa\x19c\xd7fi\x9fQ\xc0\xce\xc7\xe4^(&\x8c?\xe0\x1a\x86\x8f3\xee\xbd+\x10\xb0\xc1\x14-\f\x93\x1d\xdd8\x1dg;U\x94\x8f\xcc)\xa7\xe0\v;c$\xfe\x14ÖV\n/d\x9a\xdf\nֳdaE\xb1\x91\xdf\xe0\xf0\x8c\x90?\xc2:-\xdcF^\x10\xac\xf8K\xf5\x01̸\f\xfd/\xee+,\x1as\r\xce\xdcr\x9a[\xf4'X\x1f\x97PG\x91=\x82ӆ\x92\x86\x14A\xc0\xce^\x03Ȕ\xbaC\x801\x03\x9eL\xb5\xd8$\x00c=V\xb4\x0f\x1a\xde6-\x89\x8d\xc3\xfce}t\xba\xcfR\x84\xb0Mk\xca\xfeo\x90\xc05Ct\xbd\x8a\xf5\xea\r\xaeHPX\xe88\xb0\xf9S/\x1dM\xb9\x1e\xa1l\xa2ކ\xc0\xa7\x11\xadL\x85\x915X\xde\xf6\xea\xf9(\x1b\x83W\xbbG(\b\xde\aTGH)2\xa3\x81\xeb*\xa8\x89ޑ\x94\xe5%_\x0e\x06\x181+s\x84\x10n\xc1-\x85[qzbs\x94\x91\xb9X\xf1\x045Z\xf3\xad\x1d\xabt\xb9\xf1m\xc7\n+\x1e\x8e6\xd0\x1c\xd3\xc4zA\"\x0e\x1b\xbb\x06Y%c\xf0\xc4\xcbt\x02\x8f\xcc8\xa7\xf1\xc7D)sxf(HJ\xbbR\"\xf6\xa5\xf8\xee\f\x1dIh\xa9\x93E\x06\xee\x1d\U000fd176G\xdc\xf9\x12\x88;\xf0\xabw\x8b\xd8\u0558d\xe8\x18\x1e\xafgf\xf5\x89\xceZ\xd0:\x01\xd3H\x8d\x94\xadg\x14\xf1;i5\xaf6hb3\t\xbb\xd1Y\x9f\x88\xfd\xabO\xc4^\xca_{{l\x9f\x8d\xbdMsp\x80\\m\x83\ued5a\x82\x1bt\xef\xac\x03\x83\xd0Ď+L\xc0\xafR\x03\xc4\xfc\xbbl\xe0Ђ,[\xe2?\x03\x94\x11D\xec\x93\"\xbdM'F\xec=\x02\xec\xe8\x16\xc9~\xbf\xa5%V\xea-\xa68h0f\xa2\xc8\t\xd6B\xa5\xb2S\f\xedL^\x8e\x12\xfb6j\x95\xaa\xc2\xf5\xc0˟\xac\x8a\xb9\x8e\x90\xb8\xcc\xf3I\x12cIU֙\x0e\xe2\x19I\xcdi(dk\xbf\xd9ȧV#\xfdD\a9\xf0\xd8Y\x8a\x1e\xdb\xe42'FG~\xaf\x99\xce\xdd\xe0\xe8K\xf3\x1e(/\x953\x13̫\x9b\xbc\xd8A\x05lT$4\xff\xff\x96\xb4\x01\xa0\r\xa4\xa8\xbbq\xa1\xf1\x01\xa3\x8dBs\x01.q\xca\a\xe2\xd0l\xab~8\\!\x86\xdf.\xa7\v\xc2/;\x04\xea\xf3b\xdeݘ\x8eeEŬ\xed\x02\xe6!\x1c\xeeH\x10n\xb2tbUz\xd1k\x8c֢Z{\x05x\"\xb2\xec#J5\x9c2\xccFC=\b\f\xfb\xa8\xf1Y\x03˼\x94\x12\x92^\xbc~\xe9\x19۩\xe9\xc5\x13\x9e\xc0\xc5\x13N\xa9\xda\x1e\x9fjf<\xa8\xf5\x95\xe8\xff2\x18R\xcfK\x19\xd5\xd7\xd2\x06\xb7\x9b\xa5\xa4\x95\f,\xa1k\xb4\xad\x12FK\x12\x18\xd0\x14Bwu\x7f\xbc\xe4\"\x1e\x06ۉ\xa1L\xd2U8Q\xady)\xadf\x8b\x17\xaa~\x15\r\xf9\x98(\xa8.tA\xb8$h\xb0u\x8d\xcd\xdd`\xbc~{\xb1\xcc\xd9\u009bg\xd6j\xf1\xc5k\x19\xfb»\xe7\x13\a\x1a\xb6M4M\xa76Q\xc9\xe6\x0eQE\xbe\xa4\x89$\xcc'6t\xfb\x97A+5o\xb9lWg\x9a\x7f\xb8\xf8\x05\xb1\x166\xb6\x11%\xac+\x04{\xa0İX\x8c\x9eH`\xaf!w\x01a\xf0-K\xc0\x9d\xce0\xd9\xc1\xdcv\x10\xa1\xf8\x850\xf9\x17~\xd6\x16'\xdc5V\xd5\xc6\xe0J\xb0*[\xb8\x03\xc1\xaa+^\x97\x96\xc0f3v\xb8\xa9\xc7'\aͱ\xf3\x83\x1aCU\x82\x80\xca/\x86T\rsW~\xe6W\xd2\xcc;\xfe\xe1E\x83\xfe9i1\xfcq~\xb8\xea\x00\xe4\xb5\u038389>\f\x0f\xc0<X\xf5\xe3\xf0J\xf7\x0f\x87\xa1\xe1\xec#\xfdq\xca\xeb\xb51\xc3\x12荩\x8b^\xe6Y\xf2i\x96o\x97\xd4\xfa\xa1Θ\x95,\xf7$\xa0\xed\xb8g]D1*0\x1c\xa1\x8fH\xde\v/\x18\x94I|gX\xeci\x9eW\xafLCr\xff\x85ꖇ\xda\x11c\xe6J\x8f \xc7\xd1\xed\xa3\x18xK\xbbK)\x87\xc6y\\\xf0M\xe2\xeb\x85\xe9\xcd0g(8@n\xc7H\xfc\"\x8e\xf0{D^\xa9Z\xba\xc7\xccH\xff\xed\xbd\x92;\xeb\xecD\x14\xf6q\x92\xdd{\x99\xf0<\x81\xabxR&\xb5\xaf\xa3~\xdee\xe8\xb0\x17\xe8\x9d\xc1\xec6𰉯\x86\xa9=\x89\xf7\xa0#\xb6lD\x98̈́\xb6\"\xdc\xd2f-\xb4\xf3V\xe0\x913\xed3R\xa2.x\xc2ʄkg\xba2v\xea-41[\xf5\x16\x19\xdej\xac\xc9i0͒;\xb6\xc0\xe1C\x94F\\\xf1j\xb6\xd6\xc4t\x15\x01[K\x01n\xb1\x1aኣn)\xe7\xf8\xbfAm\xb1Ce\x93M\xaah`\xf8\xd0VY\xed\xf2b-f\xa2\xb7mj\xcdmC\x97i\b\xe0\x9c\xcd:\x9b̯\xd3\xcc\xe93\x01)\x9c\x9bt\x15\xc1WK\xa6\xee\xeb\xb1>\xbe\x8c\xa7m\xd7b\xedvq\xfb:\xac}\x1d\xd6թ`_\xb2\xeaK\x96\xaczМˇ(WU\\\xc6#8\x86\xfb\xd7\xd7Er\x8d\n\x7f\x18-\xbe\xd6@\xe5/\xdf\x00(a\x8e\xe0Vf\xa4\a\x84\x9bu\x9a3vW\xcc\xef\xc1%c\xa3\x8ej\xd288#\xf7\xec\xcc\xd6vkV\x8b\n\xb42\xe3\x00\xc0R奲OH\xcb\x13!X\x0e\xf2\x158\x90\xbcᢀ\xeb\x8e7\xe2頂\x80\x14\xf4J!\x8f\xe5\x05\x83\x1bZ<moZ\x80p\x0f:Z\x89\xd5\xcb|\xd3\xd6\xfa\xa7k\x95\x80\xd4\xf0.\xeb\x95_\xb4\xa8\x9c\xf8\xc2\x10h\b\x1a3\xb0\xf0\xe6x\xf2\xc0\xf6\x83>[8\xef\xa7\x04\xd4\xe6\x99֯\x92\x02B\x8fǠ\x84\xc6\x11\xf8\x8c(\xf5\x16\xba\xff\x11\x84*\xe8\xa0Ћƿ\x86\xd8P\xc8ۻ\xea\x81\xe5DH\x81\xb1\"#>\x8e\xb9q\x94/\xe7\xe9\x84\xe2\xf6N-^\x16Z\xfe\xd5h\xf1\\\x04\xbea8\x98:\x13\xf4>\xc1l٘;\x89M\x86\xe366\xd3\x1c\x99e\xbb!\nh>N\xafȑ)\xab|:sr\xb4\n\xc0\xec\xc0c\xda.7ت{gy\x1f\x0f\xf4\x15\xc5\x03m1p\x87\bJ\x90\x13[&B\x01\xb7\x1ey\v\x11\x81\r$\x05\xb9\x9b\xee\x13ěW\xf1oc\x1f\xe4o\x84\xa2\xb4\xe0i\xac\xa3\x8e\xfb\x83]\x887\x1e`\x12L\x92\x17\xf2\n!\xe6\x96\xe4\xc3\xf1W\xcf\ah\f\x83\xf3'`q\xe6d!\xd2\x02\x19-\xf9-\xff%\x91g\xae'\xf9e\xacЭ\x19\x97nL\x01\xf1^\xac\xfcm\x1aGgs[\x0f\xd9\xf3\xa1\xd3\xcfp\x1a\r\xf1LN\xcd\xce\xf4\xd1C=A\xec\t\"\x13D\xbe\x13M\x92H\x0f\xf0\xa2\x92\xd1{\xb5\xdbj\xb3\x1cTG\xbc\xee\xb0ד[B\xd5N\xa2}\xa6a \x1c\xcd1\xb6\xbc\x9a\x17\x88*\x99\x14E\xbe\x92\xacß\"\xb3'_\xfb\x80N\xccH\xb9D\xee\x15\xe9\xe2;'H\xba\xa8\x06\xc0\f\x19\xe0\xd57߯M\xf4\xf9\xfb\x0fM\xf1\x85|\xafM\xf8\xc3\xe1\x9c\xe1v\xa1d\xf9f˲\x8f\xe5|\\\xb1\x9c\xe1-\xda\xf4\x80\a\x0ea\x1f\xc9\xf9\x951\x89\xb5\xc3.\xd7\"R]*\xe2\x04N\xd7R\nՁ4\xf54\xe9\xf1Ҥ-\x13\xa3\x9e\n\xf5T(H\x85V!?Je\x0f\x84|\xcb3\x96-\xb5\x8aZ\xb36Z\x1cB\xb0H\x90\x9al\xc1\x93\xad\xae\xdc\xc8\x06\a\xbdW\xac\xd3J,\x8dl\xd5%+~\x87\x9a\xd5Uc3\xe1\xcb0\xadk\v\x140\xa4Ώ\x0f\xd0y\xd2\xf2\xd0Þg1Zh\x03\xf9s\xa6R\xde\x00r\xa6UԀ\n\x12\a\xab{\xec\xa5M\x83\xd1\x14\xe2\n\xb98\x86\xc0Z`\xb1\"\x04/\xb6\x1fAH\x12\xf3\xbe\xed\xdd\x19\xe1\x1b\x89\xb8\x1d\"W\xb2<;\xe5\xaeߟ\xbe\x0e\xac\xca[\xbf\x01\x13\x12.\xca\x00\xb5\xce\fY\x9e\x17\xe2\xed\x8c\xc1\tQ\b\x81\xbf\x89o\x13[\x8a\xd6\\\xf9\xe8w^\x04\x1a\x9d\fԿbZʫ\xf9d2\x00\xff\x05B7$3\x1b\x1b\a\x98\xd9g\x881O\x11QL\xa4pDT\xfe\xa4r\xc7ïh\x04d\f?\x01Ge\x84\xfe\xd9+\xdf>_j\xab>\x12\xb28d\xbeg\x03\xb6*(\x15\xa3\x03\xce\x1cQ\xd8\f\xb7CO\t\x85\xba\x8c.\xa0\x1e\a\x84i\x9b\xef\x01\xf6\tD͛?\x9fQ\xa5\x01\x7f\x1e\x1c\xfd\xac\xde\x05*f\x1a\x0f\"kg\x82\x88\xde\x15\x03\x93\xf8\vX\x1c.\x14q\xa5\x1e\xd7\x1d\t~4\t\x15\xb8\xb28\xe9\xf5\xdb\x17\xb9z\x18p8\xa6I,\xd9\xe2*\xe9\xdeŲ\xaf7\x8b\x053\xa81Z\xb7\x19\xaa \xac\x1e\xad\xbc\xb7o\xee\x8eF\xec\xc7?\xe5>\xaf4H\"8\x8d\x01\xfe\x02?\xfb\x83\xa3\x9f\xe8\xbe\xd8\x13\xf4\xfa51a\xfe80m\xf2\xdb\xedW\xc4\u074c\xf2O\xd5r\xd1\xf3&>\xfa\xb1Mޠ\xaa\xbe\xd8y`R\xc8\xf86\b\xe6\xd1V\x88\xb0=\x85\xe6\xa8/\xe1\xd6\xfdj]=h\xbd<\xdb[<{\x17\xd0:.\xa0\xaf\xc3\xf7\xb3\x8a\xd3g\xa1\xb7\xa7\xd5\xcdS\xe4\xed\x82k\x8b\x93Gۋ1\xf6Ɖ\xab\x80\xcf\xd3Ѭ\\-\xf5\x01q\x95I۹nvy\xdf\x10\x95ӊ\xaa+\x81\xe0\b|O\x99\xc3P\x94䪙j\xb0 ,\xa1\xe4F\xb9j4ظ\xf2KX\xf5^\xa8\x9e&\xf74y{^\xa86Jb\xa1\xe1\xd6!&\xbd\x8f\xeas\xfb\xa8\xd6pN-\xf5JupG\xf5~\xa8\xc7k\xf3ݲ\x03\xaa\xf7<\xf56\xdfe\xa4g\x15\x9bo\xbb\xafi\x91\x93\xa9\xf7.=RJ\xb3-\x12\xd3Ӗ\x9e\xb6\xf8\xb4eU\xa2\xb2X\xe4\r\x81\x02\xa3\x96\x89\x9bm\xaf(\x8a\x7fT7\x0e\xb2\x1bj\x14G\x9c92\xd0\xc1\x13\x90~\u06dd>\xed>\x1fq\xf9\x90GEp_\xed\xe1\xa5\x118\x1b\xe5\xea\x1a\x1aB\xc7B\xe6\x912Nw\xea\x16g\xd4\xf4ʀ\x81\xa1\xe6\xa6Z\xa1\xdb\x16\xe3\xaeH\xc6m\"\xbb۶X),\x9e\xd4\x0e\xea\t\xba\x80\xd21\\\x16J\xdf\xe6mdC\x8bg\x87?\xa7r\xde֥\x02:\x01\x94\x13\xa0\xec\x01\xf0\xabX\x9a\xc1\xdf\x1b\x90\x06DD\xe7\x8a=)\xa22\x88\xf7\x04\x87\x00\x84\x9c6\x14\x95\xa2\xba\xd9b\xd3#Cꋠ#Ӈ\xa4N\x96\xb3f\xb3\x1e4\x14$\x16<\xda\x17O\x00\xbbǌ\x1d\xa8_:J\xf6G\br+\x8d\xcbz\xeb\xd6+\xcaݼ\x97\xe9\xd2\xc8l7+\x9e\xd3\xfcJV\xbb~\x84\xb0*8\xa4\x84ry8\v\xc6\xeb\xa6\x0en\xb8\x8b'0\x92\x8b'\x03\xf3/\x1c\n\xfc\x13\xc6u\xf1ğ\xe9\xc5\x13\xab\x9e*\xf5\xd3\xda\xda\x14ǅ&0d\xe1εW\x98\xd9\x1b\x8e\x92c\xa2\x9b\xd3YW\xb9I\xbcmm\x93o\xeb\r\xf5\xecp\x97\xac\xab7\x8e\x83\b+2{\xfe\xe4G&\x13p\x96UL\xb8s\x06Һ\x92#\xd1[Y\xdc\x05\x8b\xdc\r\x8bG^\xb8u\xd7)L\x1a\xe2\xc9\xec&\xee\x93\xe9~\x13\xc9t\xbc\xd7}J\xddo1\xa5\xae\xe5\xa2\xf7\x89u\xbd\x05\xbf\xf7\xaa>\x8a\xc4:\xbe\xa1_,\xbd\x8e\xbeߦ\xfa5\x99G\x9fjדȞD\xf6Nί'Վi\xd8\xd7\xe0\xcc\xe4\xa1~Ѵ\xbb5\x19B\x9f\x82ק\xe0mp\xe4\xfbD\xbc\xdee\xb1q\"ު\xa4\xabO\xca\xeb\x93\xf2V&O=]\xea\xe9R\xd7ԼN\x04\xa9O\xd3\xeb\xd3\xf4\x1eo\x9a\x1ezt3\xf6O\x03\x93\xc8Ȋ\x0e\x95,\xd2+T\x8f**\x97\x00\xafX.\x82\xce\xf12w\x19f\xec\x01=`~\x04\x18\xfd\xa6\xdft\x94\xc082\xa8\xd2:\x8a\xe7\fk\x9e\x17\xe3\x04\xe9'\xa3\xad\xda\x0f\r\xfb\xac\xc1>k\xb0\xcf\x1a\xfc\xc2Y\x83ʠ\xd2\xe7\x0e\xf6&\xdc^\xce\xeds\a\xbf\xb0{k]\xbfV\x9fM\xd8g\x13\xf6T\xba\xa7\xd2}6a\xef\x86\xfb\xbcn\xb8\r\xfdo}\x96a\x9fe\xb8\xf2y\xee\x9dk\xbd\x11{\x8d\\\xc3\xce\x04\xa9\xcf;\xec\xf3\x0e\xc3V\xa2\x9e\xda\xf4\xd4fY\xf6ag2\xd3g\"~m\x99\x885y\xba\xcfG\xec\xca\xcdB9\x89\xf0Y\xbe7\xdd\xf3\x13o_l)CqOv\xb3OU\xecS\x15?G\xaa\"\x15(\xec3\x15\x7f\x03\x99\x8a\xb4\xd5}\xa2\xe2o1Q1|\xcd\xfb<\xc5\xde7\xd0{p\x1fE\x9e\"]\xd0/\x96\xa6\x88\x9foS\x0e\x1b\x8c\xa3OR\xec\xe9cO\x1f{\xdf\xe9ד\xa4H$\xeckp\x8e\xd2H\xbfh\x8a\xe2Z̠OP\xec\x13\x14\xd7?\xef}~b\xef\xd4\xd88?q5\xba\xd5g'\xf6ى\xabҦ\x9e(\xf5D\xa9krb\ajԧ&\xf6\xa9\x89}\x05\xc1>\x17\xf07\x90\v\xb8\xf3\xbb\x9d\xd6\x1c@\x9b\xb9!76\xb2Tch^ܻ\xcas\xefel;\xbf\xb4\xbb\xb9\x83\r\xe0\x90u\xee\xb6\xcf:l\xb7\x90\xf4I\x87\xbdI\xb6\x97_\xfb\xa4\xc3/\xeb\xabZ\xcfI\xd5g\x1c\xf6\x19\x87=\x89\xeeIt\x9fq\xd8\xfb\xd4>\xa7Om#gZ\x9fm\xd8g\x1b\xaez\x98{OYo\x94^#ٰ#5\xeaS\r\xfbTài\xa8'5=\xa9Y\x96iؑ\xc6\xf4y\x86_[\x9e\xa1/F\xf7i\x86}\xd9\xc3>\x97\xb0\xcf%4\xf4\x01Ĩ\xf1|\x02.\x1c\aIW\xa4\xa6\xd7\xea\xfe`\x12\x97\x01i\xc5{le\xbd\xa9\xb9\xf6\xb0\xac\xec\x14\x9eq#\xc3\xe1\xa1\x15\x122&$\xf6\x11\bk\xd7f\xec8\xbb\xa1!\x15\tO\x94=O\xe0\xbb7?\xa4ci\xd9\b\\\x80ֽ\x94\xbam)\xd5[\xb1\xfa\x02\xaa\xbf\xd8\v\x1e\x17\x97iU\xc4Ž\x88\x8f\xc8\xec\xe7\xe5\x1c\xa1\x90\xcd\xd6\xdc\x1a^QF\xd7s\xf3\x9f\t\xae\xb8y\xf5\x8er\xecL\x0f\xb5\x93\xc2k\xc9\xe9\x9e\xc1\xabI\x064\xa6\xa0\xcd\x11z\x8f\xad\v\x19L\xf5\t\xb2 \xfc\xaa\x7f\x88\xddG\xcd:\x95f\x9c\x05y;a\xcf\xc6ܑ\x1d'\x10d\bV\xe0P\x9e\x1c/%\xc5od\xf7\xb5\xe9\f\xa3w`\xa5\x87@\x15\xff\x8b|ħq\xf1\x91>\xf5\xc1\x1b\xf6\x87a\xf4s~\a\xf1\x00\x83(\xbd\x8a\xa6\xb0\xf9\b%\xdd\xe8\xc9L\x8c\xdd\x06w\x86\xc3\u0088Ӣ\xde\x19\x87w@\xb4\x01\x1c\xc4B\xe8l95[\x04\a\x92\xae\x1d\xa4\xde\x02Ab\x03\xa5L\xbc\xfe9/\x1d\xb7e\x91Ծ]\xe6F8\x88\xb3\xde6\xfe\xf9Տ-\x0fюa{&w\xa2\xe0\x8d99V t\xb8~\xadP\xaed)9\x1eU\x86ָ&\xacl\xa0\xb5z\x94\xa4\xe6b2\xb5I\xd8_\xe6G\xac\x81\x80\x8aw\x92\xec\xf3)\xden\xa4\x1b\xea\x143\a2?\x90RC?}\xf7\xed\xcaʙb\xb8u\xa5\xcc绝0`\x96\xb2\xef\xb09\xa8\xd1$d\x17\xf2\x97\xbc)_\xf76\xa2\xcfi#J\xc5F\x843a+Q\x8d4o`/Z.\a\xf6\xf6\xa3\x87&ห\xd2\xee1\xd2\xee\xb5-R]\x89^w\xf0+#\xcb\xc0\xb9*5\x90\xf6\xf8\x04\x0eQ\x88\xdc\xc9#:\xa5\x12q\xedN\"\x86d\x13=\x8a\xc7㔣:\v\xa3Ȧ\xd3$b\xa27Ÿ=\x8cU\x8cL\x8f\xc3'=\xe5\xebC'\x1e!uٖl\x06\x12P'\xd6\xd1~\x15Ϡ\x8b\x95\x89\x85\xf4\xd7 \x15\xf6\x86oJ\"Z\xa4\"\xfd\xd8w\xc78\n\x92;\xd3[/\f=\"\x87\x99,V\xc32\xba\xba(\xb4\x80\xb5\xf4B\xd0g\x15\x82\x86\xbf.)h)aۊ\xfcs\xc6t\xbb\x85\xb8\xc1c:\xa8ڔ\a\xd4\x04\xf5^<\x83US\xb8I\xb2\xdbf\xa7G٭G\xbb|\x05\xd2<\xfd%.\x80\xe0\xa4\xd9\xffP\x80\xaa\xf9\xb7$I\x14\x1b]ґ9\x17\xb0\x19\xf4\r\xdc\n3\xc2W\xe6~\x04G\t\x0f\x16\x8f\x14Z\x9c\xf1\xa5|\xe0\x01\xbbO\xe1\xb8\x05\x98.p)\xf8\tS:\x80\x8a\x8b\xcc\xdd.\xee\xa3\xfc\x16\x1cB\x92\\fI\xe8\xc0&\x9e\x8c\xcc\xe5F\x1b\xc80:e6\xb5\xa17\xda\a\xaa3\x874\x9f̧\xc9\x1b\xf0\xf6\x842l\xd4\xd3\x05\v\xaf\x9a=첫\x0fQb\x10\xfe\xdd:\xf0\xe5c\xae\x0f\x17=\x1b\xf9x{cm\xa6\xfb\x94f\xe5\xe3klrF\xffl\xf1\xd0\xe8\xa7*ה\xd2\x03\vC\xfb+\xe0-\xa4ɐ\xe9\v\x18(\xbd\x84?Sl\xfa\t\x90\xa1\x12\xea\x1aʢ\xb0\xd5z|\x9f\xc5SH \x12\xfb>\t,Ë\xec\"\xd3\xdfN$\xe3P9\xbd\xc6\x7fn$\x8cʗi(\xf1Ȭ\x03:\x92ͪ&\xd5hL\xe5\x15#'\x9c\x0e\xc1\x01W\x17\xc0\xdc8\x8a\x80\x18\x06\xa9t4\x89\xa3O\xb38k\x11\xc8\x02\xad@\xa0\xbaӾ\x83\xc6p᥈\x8eS\x94\xc0k\xe3\xa0\xed\xbb\x97\x04{\xe5\xb0\xf7\x1dl\xd3w0\x05b\xfe\x0e\xa7\x12\xa0\x81\x87a\x1a\x15 jr\xabj\xf7\x1aP?\x11Rvl=k\x86\xa5\xea\x8f\x0e\xa2dx=\x8c\xfe\xefœ\"\xa7\x98\x882\xbf\xaa.\x9e\xfcc\x18\xbd\xcd+r]\xe3\xfb\xbb\xf4\x9aл\x93_؉V\xa6S\xc84\xbd\x8a\xd3\tx\xf9\xc0\xb7\x87\xb4\x0e\xdf\\-\xd1\xd5\xd1\xf5\x80\xe8\xe7h\xbe\x8bi\xa91\x02r\xcc[\x12J61!!\x84\xac{\xbbh\xbd\xd4h\xe9,\x99\xbf\x9d)\xedģƁ\xd1+\xe2\x1dpV\xb8aA\x88\x11\xa0\x15\xfbQW\xb5\xc1\a\x9dƆzM\xe2tJ\xa9o\x9f\xe5\xb8 \xa1V\x1f\xf5\x83~\x0e\x8d(U%\xc1\xa1\xd2Bs$\xf0\x9b|\x9c\xb4I)\xaa\x89Z\x19\x80\xc1\xad\x0f\xfb\x00\x86\xa1\xfd\xdcz\x8eXd\xd8\x1cPC\xa5\xa2\xbf\x83\xa7l\x9e!\x0e\xae\xf7\x91\xe3\xe94\x19\xa7\b\a]\x92k\x9e|p\x169\x00Ր]D\x1b\xc6\x1e\x05L\xe0&\xcf\xf2\x82\"\x8aJ\xa6\xaf\x94\xec\x9e!\xc0\x00\xec\x1d}\xe8\xcc\x1a\x89\xa3+\xb3\x90\xf3\"\xb4:\xab\xaa\\,2\xd55.O~\xf2\x15\xae\xa6\xaa\x15\x16\xbb\xc26\xa4z\x8b\x90c\xcd;9\xbd_푘\x92\xb4_\xcd\x17b7\xb1%\xb5\x88\xec\xbd\x1d\xa9w\xa6\xadmF\xea@\xd3\x02\x86\xa4\x05t\x8d-HD\x86\xf7\xab\xca\f\x1a\x9c]mlǵ0\n\xe1\f\buiKܓ\x1e\x1fc\v\x88f\x1c'\xf8/\x1f\xcc\xe3V4\xe8={\x10\xdd\xc3\xccp3\xd4$\x1b\x1f\x93 \xe0\xa6N\xd9Ht\x06\x13XOA{\x15\xec\xabS\xc1\xbe&\x05\xac\f\x1a\x9a\xcf\xe8&\x8f\xecŠ\xf8\xc02ŘJ\xbc\xcd{L\x15\x98\x0e\\&7\xf1m\x9a\x17\xc3\xe8$\x9f\xcd'(Csń\xbfZ\xaa\x18\x95\xf7F\x9e\x9dvIr^J\xd8\xc8-i&`n\xef<h?\x83\xdfe\xec\r:\xc4\xe7,0\\L\x89\xb87\x17\xde\xe8u\x89=\xf9\rj\bD\t\x97g\x10\xa5Cs\x14\xf1\xd5Ot\xf7w\xa9u\xb7\x12\x11\xcbgJ3\xdc\x16\x8bip\x88n\xbe\x8ae\xa3\f\x8bҡV!q\xba\x8dO\xf4r\xf5\xa3\x93\xab\xeb[\xb5\x1dѺM|\xe9\x85\xec^\xc8\u07b6\x90\x1d$]ۡ\x82\xec\x12\\J\a\xd9K\xa9\xb1\xe4\xacD\xad\xacv\x97\xc2x\xe0\x18\x9d\xa6\xd77\x00\x8cxG6\x19k\x1e\xaa9v\xe4\x05\x841\x12\x8e$\xbf\x16\xe8]\xbc\x9a\x83\xc0\x1f\xdd%x\xdc\xc9\xfb\x11OJp\x86Ar\x87\xb5\x12\x9a\xa6\xe4~<\xfa\x14\x8f*\u0381\x98&S\xb3\x01\U000b581fyV\xb3\\\xbd]\x9a\xd5\xe5\xdep\x8a\x05\x8f\xb9C\xfeS'Ya\xf9\x9e\xb0S]\xa92N\x00\x8a\xdbE\x88z\xd6+\xaf\xb4\xf9\x99h\x0e\xe4/\x1a}\xe8m8\x87QZ7\xf9\x16?\xa9\xd9K\xb5Ӎ\x97j\\\xa4\xb7b\xee}\xf3\xdeP\xbb\x1bs\xb5'\x89\xb03\x16tt\xb4=vB\xd03$\xf8\xfc\x94T'\x93\xf9u\x9a\xc1(\x9f>\vg\xd3\xc9$\x82\xa1\xfe\xf0\xd4\xe1e\xf2Ț\xc7\xd8G\x94q\x9d\x97-\x17\xa7yQT\xf7mWe+R\x978\xf7W=k-\xf2h\xb8\x9d=oVZ]\xf9\xa0\x8d\xdb\x0e\xd5\x11d\x0f\x867k\x12\x1b\x9a\x8fم\x86\xb1a\xb2'fK\x8d\xe7\xc8HE\xd6U\x12.V\xe1\xf3\xacň^\x84\x84\x88\xa8@7\xf1\xf9\x81\x85f\x9a2xjem\x9a2\x8dw\x9b\xf8 \xa5\x98\x95\f\xc0\xbd\x00\xc9{\xaf\x88\xeeg\x9bq\xc0\xdblO\xc0\x9bV.\xfe~\x06\xeem;t\xfej\x1d\x10xfu\x1ctk@\x8a\x9bN\xecׄ 4t\xbag8}\xf0:\x80=uL\x01\x13F\x02*\xe1p\x9aC\xfa\xf78\xad^\xe5žՓ( \x04\xbc1\xe5\xe7_\xc65\xfcW\xa4\u05ed}i\x02jᦗ\xe63i\x9a|i\xba\x11\xba\x96\xf5Q\x0f\x9d=Q2\x98\x834F\xae\x9e\x9d]S\x8c0\"Hi\x86\x10\x92]풧\x13Y\xad\xd6O\xb9#yX[P\xde\x1b\xd6\x1f\x8c,dve\x92__\x03X\x1bHC\x96\xb9hHJÅJ\xb3\xb6\xb7\x1e\x18d\x90\xa7A\xe2A\xe0(\xa5\xd3D\r\xf8\xce\xe8\x8ajЛ\x05xA\xdf\vv\x92\x11$\xfa\xb8\xa3>\xee\xa87z\xf7\x06\x84>\uea0f;\xea\xe3\x8e\xfa\xb8\xa3>\xeeh[qGa\xec\xb5E\x02X\x1f\x81\xd4G \xad\xa0\xb8\x05$\xf8\xdeC\xd2{H>s\x18R72ׇ\"\xf5\xa4\xb4\xd7\xca\xfaP\xa4_O(R\x98\xae\xfd\n#\x91Z&\xfaY\x03\x91\xd6c2}\x18R\x1f\x86\xb4\xb1|\xddG!\xf52\xf6c\x88BZ\x8f\x04\xf61H_4\x06i\x81\x90Ї \xf5!H+\xdf\xe3\x95OZ\x1f\x80\xf4Y\x03\x90\xf4.\xf4\xf1G}\xfcQ\x1f\x7f\xb4֝\xe9D\xe5\xfa裯-\xfa\b\x0e\n\x06Ô\xbb\xd0\x16\xf5\x1b\xf7\xa6\xd7\xc0\xee\xf5\x819\xa5\xf9T\n\xb6\x1f\xdaa4g\xd3\xd6\xd2\x17j}\x90XǬ\x93O\xb3\x1cHI\x9e\xd9\n1<@\xa89\xcc\x05/P\x9a\xb9\xb4\x85?iͣ\xbf\f\x11[\x1cZ\xfc0\xe4?P\xe0\xff\xa1\x87\x94\xed\xedĿ\tH٦\x99V\x85\vB\x1c\x01,\x15\xd6x\xba\x8bEh\xb6\xfb\x8c\xaa\xd5l\x96\xc4\xc5r\x8a\xb3M\x12\xd2\xd1V\xeb\xeb;\\\x9b\x00ޱzO+\x8d\xfa\xbc\xd3\x11\x8b\xec\x83\xd1܃<\x1b\xafH|\xed+\xae\x14\x19\xf1J\x17-$\x85\x96G\xb6\xa9\xad\x11\x917\xbcox\xaa\xed\x965t\r\x10\x8b\u038b\x18\xd9b\x9e\x9d\a\xb9\xdek\x10\x9d*a}\uead5}ϐ6\xbc\xec`]`]\b\x8e\xa8\xb9\xcb݄\x9b\xa5lq\xd0.P\xfc<\x9f\xc6\xd9n\x91\x98\xab\nQ-\xdcN\x0e\xa1\x935\f\xb1\xbb\xcc\xe7\x15\t\x82n\xe8-!Kq\x19ڴ\xf7Y\xfa/\xa0\xf1f\x9e\xbbwyaD\rK\x9b\"z\xc7m\x92,\xd3N\xd9\xe9\x93\xcb\xeeT]\xd1\xf4\xbe\x81\x94\x13\xc8\xe69V\xb6y\x15OJ\xf3\x9f\xf7\xd9\xc7,\xbfk\x91o\xf0\x87\x86|\x03a]i3\xc4\xcb}h\x99)g\x9b\xb7'\xec\x8dX\xd4\xda\aDn\x95oz\xbf\xc4\xe3\xf1K\x98\x83u\x9b\x8e\x81C\xb4m\xd7\x06\xbe\x89\xad\xcaϽ\x1b\xe3\x11HSʑ\xf0`t\a\xab\x1bv'<\xd8<`\xe4\xc5k\x8a#\xa1\r\\ \xf4x\xe9\x14\x93y\x11O\xc4\xd3ѠF\xf0\x8d\xeb\xbcH\x83#\xb4\xcf|B\x88\xbaU2V\x02\xe4\b\xc7\xf2\xff\xda\xfb\xd2涑d\xc1\xbf\xc2\xf0n\x84\xed\tJv\x1f{<\xcf\xc6nȲݭ\xd7>\xf4,\xb5\xfb\xbd\x18O\xec@$DaD\x02\\\x00\xb4\xcc\xe9\xf0\x7f\xdfʣ\x0e\x00\x05\xa0PUt{v\xf5\xa5\xdb\"\x80\xac\xcc:\xb2\xf2N\u31ebt]P\xe2\xc7#\f\xad~\x98\xac\xd7\x0f\x1fO\n\x84\x1e\xef\x11+&#K\xd6ȇn\xb9\x1b\xa7)ע\xe2H=\x1e\xcb\r\xc6\x03\xeb\xdb\x15xZ%Fڭ\x13{\x941\xd0\xfa\x8b\x15\x83\xd7\xfcd\x04\v\x9c,\xba\xbd\x91Q+\x9c\xcc\xd8\xe1\xff\x01_\xfcO\x80hE\x82\u05ee\x1bH\x8d\xbfK\x04譆#@3\x05\xde24\x17hq\x12\x1a3\am\x99_\xf4\xed&!\t\xad\x04z\xd2\xf6W\x88\xb3M\xe3\x1ds\x13S\x98\bm\x0f\x14\xd3<[\x17wi\t\xe5\xb2\xed\x82\xc9MQ\xd6=G\xe2B=\xc380|\x95\xf7~\xbbͰA\xd0\xc0\xb0\x0e\xdbL\xee\x02\v6\xfcD-\xb4\xfc\xdb6\xd3\x03\xf84\x97\\\xfd\xbc\xe4\xd5\xff\x9a\"\x90\xdd\xf15\xf4vK\x8dLZJdV6\xf6\x9a\xd2\"M\x0e\xb4\xe2\xb6\xd3ҙʭ`A\x8bX\bV\xd4\xe5I+{\x1faK\xf3\xe9\xc6\xd9b\xa6\x03\xbc\xb3\xb7\xfflO\xefY\xdcn\x9a\xd1b#FA\x8e\xa4\x9d+\xc07\x99\xdc\xd7U.\xe9\xc4|\x91s\xd6ݬ\xf0\xb3qk4zs\xaa\x19\xc2\xce|b\x04\xf1\xa0\xa0}Lmw\x11\xe8R3'\xe4\x9a*\x02\xd3z\x8cwWZJ\xb3\xb5\xbf\xd6wA3o\xd1\xfc\x10Ou\x93\xe2j$\x19\x81\x85Ym6\xa7\xa0T[BBc$\xf0}w\xf9\\\x03O\xceU\x98\xad\x12J\xe78\xf8ꚣs\xefF\xc83\xb2j\x06\x1fԳ\xd6|\xea\x8f b\xe3\x06\x9a4Zf\xf5k\x90\xa3QDb\xfat\x1c\xa9\xe0d\r\xddf\xc2Q> {\xecQ\x9a\x87\xdfo\xc9j\xae\x96)\x93E*\x85\x18\x16*Y,ҭ\x10\xaf\xe9\xc8w\xf5\xc7\xc6\xe3\xae\ni>n\xf15<\x1d\xf0\x13\x19\xd1\xf0\b\x812!\xf9\x1dI\x97\xcbL\xb0\x83O\xd0\x14\xe3\x12\x1a|\x82\xde\x00\xa9\xc2\xd95\xf6䮩m\xae\x06\n~\x10n\xed\xf9G\xf0Cc\xea\xba릞\xa95\xe2\xe5\xc1\x96É\x98\xd3\x05\xde\xe8\tPP\xb3\xc7}`;\x01\xbdQ\xdb\x00\xbfD?\t\x06C\xbaX$\xebt\xec$\xb4\xdfWF\v\xb48km\x05.\x98\xa4ɑmL\xca\x16\xe9\xff>ݮ\xc5\xc2U\xe7I}\xa3쎍\x1f-6H\xa3\xb1\n\xbeb1A\xb6^i\x98[\xfe\xf5\xe2\xdd[\xb1;į\x19\xf6\xae\xb6m\v:K\x8b\xa2\x14\x04mŒ\xa0l\x87\x93pL\\\xe1X\x82\xe7\x8e\xd5\n(\xf5\x95\x06\xd3!\x9a\xb3a+\x81S\x91X8\x1eN\b\xbf\x82\xdb\xf8\x8d\x94&\xe9c\xc4s\x97/\xc5\xe5}L\xf3\xa0_\xe1N\xd4wEyKn\xfd\x9f\xcfO\x84z\x8eL\xa8\xe4F\xf7lF \b(CeBߖ\x84Z\xd8\xd5ܴ\x11R\xb3\x1c\xd9Z\x87a\xf1gO\xbaˋi\xb1\xb2\x895ۗ\xd2Ͷ\x96=\xcd\xed\xbaA{\xc1\xad\x9e\r\xf3\x8d\xa8\xeb\x06\\L\x02?̪\xc1\bqV\xa5o\xca)|C\xfb\xdb\x05v?\xbd\xbc\x1c0\x11\x8f\xccw\xe7\x9d\x03\x9c\x94\xc3\xce9\x9f\x94\x98g\xa1$\x84'\x1f\x82\xa7_\xc1\xdem\xf2d'q\xa6\xf3\xc1\x00\x17\xa7\xe7cl|\xa6\x9d\f\xca\x10\xa9\x03\x8a\xfe\xc6K\xf27\xa7\xddsL\x89̘\xc6\xfc'\x0e\x12\x00[ԓ\x1e\\ȡ\xd5P\xd4\xc4w\xe7\xbf^\x9a\xb6L^\xaf.\x84:\xb9\x85M\xd4\x06@VU\xb2\x84g+Ȃ\x9e-\x84\x10\xb4b\xd7i\xbe\x17«\x10\xa3\xd2\xcf z5f\xaaN\xf2\x7f$\x84\xc0\x93\xf3w\x17\xe2?'\x97\xa7?wpi\x0f\xd8\x1d\xa4\x03\xf4\xc1\xe1\xf6͔-\xd3\xec\xeb\xc5\x18\xa2\xa2\xd6>\rUߕ\u07fc\xbd+\xbb\xe0!\xe5\vq\xfe\xe5X.\"\xc5WV\xe3Hj\x1aw\xbf5\xe8p:T_\x9b\x90\x03\xf9\xb2?\f\xa8\xb8}o6\x8d\xcfn\xfanw_\x89\x7f\xe7\xe0^\xfb\xe1\x02\x9d^\xdd\xe1\xdf5_\x90\xfa)\xff<\xfb\xf4\x83t\xbe\x89\xd3x\x95\x1aEA\x92\x15\xb8\xd5\xeb\x03)@\xc0$\t#\x88Y\x8c\xb2$/9^\xf0E\xb1\xd8m\xa4\xf3\xd0\xd2\xe5\xd1\xf6\x1aݹ\xc0\xd8Q\x1b\\`\x00_\xae\xd3\n\x1a{\x18\xc7^B\xf8\x9d\t\xa2\xbb6\x8dq\x7f\xefJ*\xbbrm3\xde\x06O\x04\xccm\x97n\xbc\x96̘\xb5|O\xabM\x17\x16^\xfa\x10.\bUd\xd0IE\xc6\xea\xddv[\x94b?<\x9bA\xd8.\xa4U\xd4\xff\xf5\xc7\xf9\xecz]$\xf8\x0fB{>\xfb\xcb_\xd1\xc1z\x9d,\xd2߿̅z\xbd\xfd\v=2\x7fG\x16\x9ag\xeb\xb6J\xf4>\xb9\xeb*<\xf0cgz\xe6\x0f(:\x0e\u0088\xf7u\x1ak\xba̭h\x9d9\xe3\x05:\xb8\xf0\xe3\x11\x9f\xa9\xeb\x02v\x0f\xec\x99f&\xe9\x8b2\xb9\xaeg?\xce\x1e\x81\xdf\xf0ٓ'\x7f\xaf\x8a\xfc\x88\x8e\xdbqQ\xae\x9e<\xee\xee\x19:j\x96\xcd\xf2\x9f+u\xc0;\xcft\x00\xf0\xd9\x01U\xf7\xd64\xbc+\x9f\x8b\r\xd1\x1c\xfe\xdcB\xcbW\xc2a\xbd~gN\xdcװety\x98\xc0#\xdf\x7f\x13x\xb0x~\xc0\x11\x1f\xd0(*\xfc@\xd3<\x1a\x98\xfe\xd5\xe6`\v|Zp\xf3\xf4\x1bC\xef]I\x01\xde'\xb8;\b\xd7\xe1\xbb\"\xcdw\x9b?`[Q\xc3\xe4\xcf\t\xc4\xec\x1fx/\t5c\xbd\xab\x84\xbe\xfa&\xf9\x9cm\x1a\xd4\x1a\t#\xfa-1\xfc\xc0[\xea\x8e?\xd4\xecإ\x8d/\xfa~\xb2,b\xb6\xb4\xff\xfc5\x996o\xb9\xf9\x83M\xf2\xf9\xac\xe5?\x86\x9bz\x85)}\xea\x92\xc5\xeb\x9e_\x7f\x9d\xe6+\xb2\xa6\xb8\xbdo\xcdF\x19\xf9\xa6\xb5\xa6b\xd7_5_^\x16\xbb+RF6Y>\x89\x80,\x9fF@\x96O'\xa0\xb3)\a\t\x10\f:\x13\a\xabqa\f} \xb4\xab\xaf\xc5>\xe7\x0f\x8a<\xfd&n\xb2mR\xc3I\xb3\x9e\x1c~f]\xa7o\xe5\x16\xda~\xcb\xc8i!\xfc\xf7\t\xc1%uV\xd3u\xd0\x1b0\xdb\xd5u0&\xb8}^\x15ˎ.\xc5KF7&\xcc\xf3{\xad\fu4\x80\x92\x81\x17\xb4\xc0\fc\x0e\x94!\xd6\xd4\x05\xa0Fq\xceF\\\xa1Ʒ\x1e\x1f\xcf\xde\b%\x1a\x8a\f\x82\x89\x16tG\x19VE\n\xc2vW\xa2寭\x0f\xb12?7\xb0\xb5\xb8L͇\x7f\xfc9\xd5\x06\x88\x7f\x0es\x81]\x95\x18\xdf/\xf0\x9a\xb9]l;b\xc6\x1b[j\xd5f\xa4T]\xee\xb4MW\xbe\xc7\v\xbboo\x04,\xf9\fV2\x9e\xdd\xce\x1e\xe0\x17\xacR\xd0?\xfd\x8a\x98B\xf2\xf8\xc2\x18o7\x8f\xb3u\x858\x06\x19OK\xef\xf1c\x9e\xbc\xefλz2\x89q\xfe\x91+\xd2\t\x17>VA~\xff\xb6K09\xd7.\x9d\xba\x04\x1c\x9f\x9c\x9f\xfdd\x0fo\x93Ot\xa2\x8e\x8c\xb1`ߓ40ɐ\x99\x8a\x1c\x12T3\x17\xccq\xa5~F\xae\x14\f\x92k\xaf\x19@\xd4\xd1x\x18\x86\x87\xb3v\xb2\\\n:\xab\xe7\xfbS\x8c\x01?={\xf1\xfe>{\xe1>\x7f\xf2ۉ\xf8ϭ\xb5F0\x187\xeb\xd6BQ{\xbf+\v\xcb\xc3һ\x91\xdbo\xb4c\xd5\xf4qc\x9f\xa6\xceV\x9es\xcb\x05qj\xae\x92+!ШL+*\x10\xcc \u0092ؐO0n\xaf\x8a\xf2\x85\f\xd8B\xcfS\xffa\xeeЙ\x80\xf5\x19&\x8c7\x01\xbc\xa6\xa3)g\t\x01!\xf9\x0e\x92\xe3\xc5ϴ\x13\xc5\x1f4\xbf\xba\x8aL!D\xb7\xf5\x96!\xc1>\x82\xf4z\x19\x96\xc9.\xf2M!\x0eh\x9e\xd6\x10\xa6r\x94^_g\v\x1c\xf6N\xecS!\xddU\x99\xd0\xdeĦc\b P\xee*r<'[\xe0B%V\xadn!'\x18\xc0\x96}\xf8\x84>\x97\x9c\xd9S\xa0;\xeeX\x8c\xc2\x16dJ=\x92\x1e\xa5\x82\x81Jt\x99\xcb\xc8\xf1 \xf80\x95\xb1\xf2@2\x80F\x9b\xbf\x1c\x9e\xe2,\xb82v}#dS|GN\x16\xa0\x90巕\xae\x80\xc3S\fT1b\xaf\xd0C\x82\x16\xa3g4=\tF\xe6\xb6\xe28\x14C;;'\naH,(a\x80\xc5\xe9NUB\x02`\xb8\xab\xd87c\x00\x90\xbcA\x10X\b\xe4\x04j\xff~$иK\xcae\xba\x84\x7f\x89EL\x96\x14\x1d\xfc\xefG\xef\xd3d}t\xb65~\x93\xb5gާ\x9b\xa2Na\x93\xcd\x1ee\\\x12\xa4\x100\xcaǰ\x11Vi\x83d1\xac\x7f\xe8\xdc\xc0)\xb8\xe8\xdb\xe9(A\xa8\xfb\xad\xb3\xeb\xe5\x13\x15\x1c\xa9~\xd0Wl\x96\x9b\xbb\xfc \xe8\xf7\x1f\xe2\xa9E\xcdt\x193%]\fw*w\x90O\xecɒ\xe6Ӧ[V>\x99cD\x04\x16\x10\x93g\v\x02\xe9\x998\xc5,\xc5~y\x82\xe3\xdar\x05\xeeŎ\xe8b\a\xcfkg\xee\xe8w\xfbR\x1ef۫-z\x9f\xff\x18 \r\x05s\bK\x81\xc3i\\B\x86ZXϣ\x8a\xfd\x93\xa5\xbf\x9b\xf2\x99Q\x05\x06Ή\xccU\xc9Zy'=\x9a\x8b\xcc\xc3zK\x7f6\xf2T\x90J$몚\x96\xec\xb8\xe8IvT\xb2d#\xe1ў\xad\x10\x92\xeeؓ\xf4\xb42\x93\x9e\xb4\xdcI?ws\xd0^b\x88-t\xa3\x91s\xdex\x93UK8@\ny \x94\x84\x113X\x8b\xab\x86\xc1\xa1\xb9I>\x81AO'\x000\x8b\xbb6\xe5\x17\x8c|\xfa\xf8\xe0\xd8]\xe7\xb95r(\xf1\xdf\xed\x14?9\x97\xaf\x8a\xe2a\xe7Ec\v=\xbc\x16/<\xf6\xd3\x18\x06r'\x8f{\x93\xc8h\xb3Y\xe1\xd23##$k\xec\xf6\xc6\xf6\xc6\x14\xac\xa2\xb6\xd7{\x1bJ\x93\xd4Ϛ[\xb5ڭ@jM\x97\x8d\xcc\xc9~\xb2&dG\xda\xeb<\x9aO]\xb3$Qa\xe0 \"CB\xe0\x12\x95\xbc\x1efz\xee\xac\xd8&\x82\v\xaf\xf7,\x7f\xb7\xc6\xdc`\xa0$D\x17/(\xdfV\xf0~A<lqY\xdd\"\x87\x9bD\xfc \xe0\x03\xa9\xdc&\xa7\xbe\xd1C\xc0/rh\x1dW\xac\xef\bഋz=;}}6SQ:\xf6\x96?\xc8yl2\xe7Ug\xb5\xa4\xb0\t\xd0g\xf4\xc6#<uY\xbeX\xef\x04\x00\x90\xa4\xe7\xf8\x81P+Ai\x98sk\xa29_As\b\x9f\x85\x9f\x97\u0604H\xfe_\xd7\xd1&ADp\xc1\xcf\xfbi\xec\xa87}\xebSS%\xb6Z\x9eF\x99R\xeb\xdd>\xb6\x14̕>}7{$\xf4qXx\x8a0Nfl\x04\xec\xa2Pj\x9c\x1f\xb2\xe0\xff\xd8\xcaоxܒ\xbd\xe2\xb4\xf9BG\f\xd3\xc1\xe7t5\xca<-\n\x83\xee\x98<\xf4\xf5\xd4Pd\x12U\xbdv\xa6s\xb6\x99|\x8eine\x8d\x0f\xde\xc2+Co\xc1GR\xec\xbc\x17\xda\x0f!\xb4\xf7Π\xf9\xb4\x99\x15m\xac/M\x9ee\x9f\x89cr|o\x9f\x8cj\x9f\x1c\b\x9f7*e\xb4\x8d\xfb\xddëO\xe4\x9e\xda\xe14\x8e\xe2A42%̇\xd9\x1e\x1a\xdc.D\xb9\xf8\xd0k\xbc1\x1e\"\xa7\xac\x9a\x16\x1c\x9d\xe1\xfa)\xc9\u0590\x06>\xcd !ͷ|\x9e\xcaBl/\xccP\x91U=\xd2U\xb2\xd8ý\">js\xc6{\x97ʽK埋e\xdd;\v\xee\x9d\x05\xf7\u0382I\xce\x02\x01u躙\xa2^\x87\\\xb5\x1f\xf4]\xe3y\xcdR\xdf\xda\xfe\xb6\xcb\xe6cY\x04\x02\x9b\xcdBB\xcf\x1d$e\xa2\xb2\xc9\xfb\x06\xeeP\xbeg\xeek.G\xd7\x03\x84\xf0w\x9e\x96Y\xb1\xbcH\xa1\xdeEe\xafI\xbf\xdcq\x99.,M\x8e/\x8aE\xbb.x\xe7\xf2\xb5\xa6Kn\x93\xb1@\xdc@\x1f\xf0j\x94ū\xb0\xfd\xa2\x90s\xb8\xb09\x06\xf1\x12;\xa3+\xf4\x1fiY\x18\x16.\x82\"\xd4{n+\f\x86\x9a3^-N\x90\xae \x95i\xcee\xec)\x1b\x1ai\x02f,\x88R\x96?\xdd(\x12\xab\x85\"o\xbbJ\xb9I\xb1\x19\x1a\x96 \x1bg\x82x\x90k,ͮ@\x1c\x13\x9e\x9b4ɭ8\xea\x89\x1e\x8aS\xbe\x97-\xbce\x8b\xa2\xdc\xde$\xf9\vN-\xa9\xad,FL\x02l\xa2%\x14\x95K\xe1ޕw*\x04f%+\xdc\xcd\xd4s\xbb\xd1\xc5Bn\x8c\xa5\x02\x00[\xfe\xbb\xe3\xff&&\x93v7m5\x1eZ5\x17\x15\x9f\x10V85\xd7\x18a\xf8䚪\xdb\xc2\x17\x1f\x19\xe9\x8f\x0f\xc4@9\x16\xf5+\xd5`B\x86@\xeb˓R\xdcg\x9fL\xeb \x81\x7fX\xe9\x8f*\xb6\xaa\xbf\xcct\x11.B]\xec\xf4\x0em\x92\xbbb\x92\xbb\x98u\xdc\xc8`\xa0\xb4\x9b\x86\x81恢7\xb2\x16\xc2\xf5n}-P\x87 \x15\xe2\x01\t3l2\x17,\xc4\xf5\x04'\xad\xd8\xd58\x170\xa6\x94\xab\x04\a\x9c\xfd\xf8\xf4_f\xa7E~-\x10\xac\xa5%UN\x85\xec\x11\x12\x16\xc5rޠ\x83\x03\xc0\x1b\x13\xd3%\xee7v\x15\x01\x87\x86\xf2\x04\xab\xa4\xbc¾ۺs\xa0DRp\b8ϰ\xd4\xd6ex\xd7ڟ\xfd\xab\x80\xbcO2\xae--Y\x06Le\x81w\xa1l\xdb\xf1Y,9\xf6<W[\a\xaa\xb2HiV\xb6\xfe4\xf6\x88\x8cޓ\x1c\xe2H\xd9隃\x1dϨ\xa8\x13V5\xa3\xfb\a\x84\x8eg\xb3\x87D\xc3\xc3\xd9\x11\xef\xeb澯\xfe<{\xf8<Y܂\x00\x91/\xe1-҃\xd1RԜ8`\xc0\x85d\x93M \x92\x82+\x05\xe9\xcf\xe0\x93)S\x03,\b͋\x04\xba\xcb\xcb\xf9AɈࡹ\xdf\x02\xf0Z\xc18\x8e\xe8\xe0l\x8a5m\xe9h\xae?I\x96\x9b\xac\xc2X\xacv3\xb8!\x10\xdc\x05\xce\x06Ǭ\x949\t&ָ\x8b\rԂ\xe8v[\xf9̓\xf3g!C·\xdf7\xbf݉M\"n\x9b\xc5t\xdac\xc1\xb1\x10$\x80\x15%g%\x84\xe1\xe4\aƊR\x01\xb50(\xe9\xdb\x03\x93I_\x7f\xdfE\xe0\n4T\x8f\xa1'|\x172\xe8\xf7\x96\xe3\xb5\x00\xe5\xe4\x9a\x04\xd8\xc0\xe9O?\xa1\x14\x17\bD\x05\xa9\xfbB\x10\x17\xed*\xe5\xeb\"\x90\xf7\xb0\xcd\x05\x8atyop\xc2ė\x18q5-\x8e#\x1d\xb5@X\x96\xf9\t\x84\xd8%\x17\x94\xd2\xe5n=u\xc2-\xa8\t\x81\x03D\x90*\x18NO\x9bT\xe7Y\xf7\x01\x10\t\x0fk\x9fV\x9f8L{\x01bi\xadh8r>\x12\xc6O\x18\x0f\xa1?\x80l\xf7\xf1\x81\xfe\x9b\x15E\f~\x921\xe0\\\x9e|\x93\xa0cZ\xbc\xb1[`\x99\xb0\xdb4\xddΘ%\\e묛\xed\xd4\xf2\xc1J\xf2핔\xdd<\x88\xcdH-0\xe7t\x9d\x89Fۭ.\xc9=\xd1\x10\xc3Q\x04\xcdQm\xe3\x88\x193\xe6\xb1aC\xae\x12\xaeK\xa6\x1c;\xd0g\xa4\xc4,`\x8c\xb0؊\xb9Sʸ\xb9z!^\xfd3PrH\x84\xb75\x94T\x0fg\x18ȡ\x1b+R\xbb\x184\xb1\xeawPL\xbeI*T6\xf6i-\xbb\xfe\xf5\x04\xbfu\x16x \xc2I6\xd2-\x8b\x85i\x97\xa7*|\xa8\xe6\xa1\xddEa\xa3\x8c\xf5ڌ\x17a\x8a\xaa\xc19\xaa\xa0\x8d\xcd\xe2V\x86\x92\x14+\xb4\xd2\v\xcc\x15V\xaabP\xa3\xa7\x81\xd0$\x00\xa3\xbfv\xfb\fӃn\xc5|zЌ\xb0\xc8\x1a\x88\xa8\xb6\x96\xe9\xe7t\xb1\xab\xb1\xae\x1f\x9a\xa9\xb5\rM\xdbo\xc0\xb6\x95\xb1\x0f\x02\v\xd4ղ'\xe4V\x8e\xd4\xdc\tl4 \xdbb^̮\xb9]\xa1 \x98\xebm\xa3\xde\t`\x1ah1W\x90J-\xd7\xf9̳\xb5\xd2\x1e5F\x8b\x02\x83\\ \x18'\xa9\f0K:Ą/\xbbC\xe5\x999\x8c\xb5\xdd<#mM\x0e#\x96\x8e6i\xb9J\x8fn\xd3=o\xd7\aַP\xf3IW\xf0\x12~\xc0nv\xae\xd7\xd2\xdaV\xd7\xcdɤ:\xa80C\xaf\xc4\\CaoT\xfd\xe7\xe6\xa4\x19\xb6\x02\xec6MU\x909U\x87'8\x97v\xd2\xf9L0d\xea#I>\f4*,\f\x0fTq\xa5k%J[K\x98\x8d\xc4^\x87m\xa8\xa1\x89Y\xd9\xd6bno\xd7q\xa5\x03\x81\xbf\xfd\x9f\x1d\xd4|F\xafx\x82\x13X\\\xeb\xa8\x042\x82\xf0\x04\x83w\f\xd6\a\a\xab\xd8\\.\xfe~\xf9\x19\xac\x9a\xda_r\xf2\xf6\x05\x98_Nr.\xfd\xda\x1a\x9b\x1dk\xb8\x1de\x0f\xa5\xd9\xc9,\xdfA\xf7\x04\xfb\xab\xe2\xe4\x18ݖZ=B[(t\xa9\xef \xd9`\a\xad!\x99\xe1l\xc8HK\xd4\xeb_\f\xfa\x0er\x82\x1a\xeb\xf8^\x0f\x8c\x96\x11c\xf2{\x88\xe4\x95A\xfa\xd8m\xfb\xbb8ls\xb4\x1e}\x99m\x93\xacĹ\xe6\xb0H\xf3\x994X\x19`\x00\x82\x00\x05X\x88\x97\xb8\xe4>\xe4\xfb\xaf\x11'\xb5\x1f\x8c\xa9\x85@\n\xf0s\n\xc0\xba\x98\xff\xc7\a\xe2Ϗ\x0f\xf8\bb\xebUڂ\x1fť\x00\xbfK\x9e\xa6\x8c\\\x90\xa2\xac$-t\x9f~|\x80\x0fI\x14pX\x93\xc9\xcd\x7f\xfd\x8e\x9a\xb9D\xe3\xa7\xce\xc0\x9a\xfd\b\xf2\t\x17\xcbe\x8ai\x1e\xc0\x1c\v\v\x84\xd3\x03lF\xce\x1c\xbe\\\xa6kU{\x1ef\x1by=9\xa9Z\xd7&p\xdb\xf9\x03\xf9u\xf7\xf6Dn\xdc\t\xd3NU\x0e#\x11\x01?(G\xb5\xc2;\xd9rt\xa7\xa5\x9d\xfb\xe8\x05@\x889\xf2\x7f\x85\x7f\aU5/\x8d\xa4v\x01\xfcaE\xb3\x04\x1b\xf3&ے\a\x899\x9c\xf4\xe7a\xbdI5\xb5\xb4\x97\xce\xf2\xf9\xecmQ\xc3\xff^~\xc6`#\x98\xdc\x17EZ\x89_\xf1\x17{\f0\x82\xb4\b\xbd\xb4\xab\xb3\xaaQ\t\xc3t!\xa9:ō\xd3q\x06\x82\x88D\xa4s<L\xb7\x1d\xb2Y+\f\xc6\x1f:\x1f\x1b\xe8\x0f\x80cP\x14\xae\x8dO\xc8\xfb\xba\xc6\xd0uպ\x99W([\xccp\x81(\x1ey\x92\x13\xdc\xf9\xb8\xc9V]֎L\xf0\xc4\xe8\x8c!\xcd\xed\xdcex\x9f\v:k\x81\xa4\x8e\xb2C:!\x88x\xce\xe1\xd6@\xcfZ-\xf2\xa7\xa4\xcc\n*\xb6\x8b1\xe4\xfazR\xfe<\x15\x85\x8c|\tzU\x02\x97\xd5\xfdY\xe73\x89ؗ\xeee\x05G<\xcbw\x16\xe1]>1\xfc\x112~\x03{\xef\xc0\xdfpim\xb2Z6K\xa6RH(\xd2b\x17<\xe9\x9f\xe1\xe8^\xa3=\x85:\xb6\x18\xd8\x01\x8a\a\xc6\xcf\xe3T\xe9\x18\x06\xc3\xd9\v\"/\xc6\xdf+\xa78;d\xe1\x10eU\x85\x0fH\x12b_\xa4\xaa\xf6\xaeݖ\xb0\x040\xe2\x92dh\xbcp\xc5[\x02\xcd2KY^ʅ\xc2+Ϥ\xc2D\xcf\xfb)\xcd\nm:\x04\xb0\xa1j\xe0(\xbe\xb1\xdfJ\xce\x13\x93'f\xf2:[I\xa78\x10K\x05\x95\xd1\xfd\xb3!\x91\x1eN\xe2\xec:\xbd\x9bm\x04t\x98#\\Qjk\xafd\x1e*H\xcb\xca)\xddfr\x8a)\x12\x82\xc2g\xa8K\x90\\>\x9a?)afK\xb2A\xaf\xe5\xf44j\x87_\x8b븖\xaaYz<\x14]ګ\xcds't\xd2\xf3p4\xa5W\xd3|<\xac\x8c@\a3\xfa]\xab5\xaa\x92\x8f\\\xe4\xab}#jR\xb0\xe6RLTJTK\xb7*N\x19Om;\x98\xa0\xc6|\x05THx\x1f\x19 17\x01\xa7z\xb6\xcb7Œ|\xff\xe0hR%\xaf\xb9\x05\xf8y\xb1ݭ\x13\xa3\x8ax\xb5\x17\xe2\xfa\xe6x\xf6>M\x96Gp\x00c{\xb7ş\xd4\x18w\xb1?\xc2/\x8a\xf5\x91\xc0\xf7\b\x95,1v\x8e\xe6MKD\xdd\xfa\xfau\x96\xdfZ\x92e\xf8\t]\xf8\xbf\xbe\x7fݎ\x17Ъ\xb7\v\xb9\x01\xea\xf9\x9blQ\x16\xb27oo\xd5X\x88 8\xaa\xb9]\xee\xd4\xd6\xd4\xdd\xea\xca\xea\x19\x9a\xbc\x1a\xec\x19\xe4\x7f\xad\x81YY4G\xe5ʼ\x18Cc@\xa6\xc8\xefRr\x8c%\x00)\x97m\x03l\x11\xc5\xfa!_ͻ\x9c\xb4\xee\x1dh\xd2 \xee\xd0Y\x05)\x18tC8\xf4\xa0Z\xb6{\xd9\x1b\xfcZ\xac\x9a\xaa\x88\\\x17ź\x92z%\xb1Q\xc5\xf8\x92\xf2*\x13\x17\xa8P\xbb\x94\x7f\x98\x9a\xee`ܷ`n\xa8\x93!'4\x9a_a V\xca\x1c\x15O\"\x9e\x9d=\x1a\x18%\xc3l\x9d\x06q\x18\xb4t\x05\xe7aY,\xaa'0wG\xab\x9d`\x17O\xcc9\xf2\x17\x9e\xe7\x0f\xb8\xbf\x98=a\xec\xb2\x15\xf4.\x9b\x91\xd1\xf2\x1a\x8a\xb9Ψ\xd4\x16?Օ\x8d\xfc컬\xba16\v.I\x05\xb0q\x004\xb3\xa8\xfef\x82\xcd\xeaD!\x1e\xb4:n\xb5\x1a\xc3\xf0!\xb8\\\xf3\xfd\x1d\x96!+\xb3\xd5\r\x84\x01ܑ\xe0-\xcb\v\xc1\xbb\xab\"\xa3\xf6\x96\\\xa9?\xc3;\x9a\xfd\xfd\xb4\rᒡ8\x1c\x15\tic\x18\xf82\xf7\xca\x16\xc2\xc6ƒ\x03z\xda~\x85XH\xad\xfe\xec\x04\x1e1\xa2\xd8d\xfb\x8e\xccS\x9a\xc9\xdf\xc1EHl\xf9XuȬg\xab]R&\xe2~\xa0I\x96\x82\aܛ\xdbm\x9aWGl\x05#\x93X\"8H\x05f\x96mR\"\xad(j\"\xefT1\xb9\xf2\x82F\x9b\x95\x8a\x06\x93C\x9a\x9d!\xc4(\xef_\x9d\xfe\xf0\xc3\x0f\xffB6߄«\xc4Ͽ^\x9e\x1e\x7f\xcc?\xe6.\xfc\xff-\xd8\r\xc0\xa4\x89R\\\xec\xfb@\xf5\x9b\x8d\xd1}\\\x1a\x87~r\x88\xec{\xab\x84;\x19ӧ\xd2\x13ewQy{\x17\x14Vw\xbd\x83\xd6^tU\xc3\xe2\xf0\xcae\xb5\x11\xc0\xd3\na\x92Ӊ\rX\xd0x\xa6\x03<\xdb\x1bo]A\xd0w\r%\xfb\xf6\xba\x11\x1fd\x9c\xa6\x18Xe\xbb\xa3\x86b\xec:\xa3\xf4\x04\xa3\xb6\x11\x11[f\x86{\x06\xcf\x18\x9a\x0e`\xbf'\xb5b'f\x8e\xb6\x8e\x19\xe3\xa0\xc7\xe6\xe1g\xaem\x1c\x1e\x9c\x81D\xcdh#x\x8a\x0f\xb4ގ\xc0H\xe7r\xdf¦_f\x90\x90J\x93I\x81;\xe2̈́%\x9f\xa6`\x89\xa6\x95\xcfЀL\x1d=Fr\xf6(/(\xbc\xbcT\xe6[\\\xb2F\x96d%\x8d\xca\x1c\xd9-G\x03\xf6\xf7x\x96\\\xd7\x1c\xf1\x8e\xb3#\v\x9b\xb0-T\b9)K\xa0\x8d\xd05ĉ4\xc0\x93\nQ\x00\t\xce\xf6\xa2\xb2U\xa0\xea1oL\xd3պX\xdc\xc2\\\xbf\x93\xc3Xw\x14[\xbeU\xbc\xa8!ً\xab\x18T\x82R\xf2\xa3\xeb]I\xa5\x02r\x16\x10\xafwpM\x8b\x19XC\xa7\xa0\xd5\r6\xa3M\x9a[r\xd6N,\x97-\xedx\x96\xb7\xe2\xfc\x95$q\x82\xa5C\xa0ֈ\xb7\x9fˮ\xa7\x14\x12\xccJ\x0e\n.B\xf3XR(\x18A\x12\xb3\xfb\xc3SyNi\x95\x7f\x11\xb7\xee:\xade\xa0~\xb2\xc0}V\xb1\x97\xc0\xd8_\xf2\xbc\xa2[L\\+ZU\xe0)6r#İbaxe\x05\"zP\xd2\xe8\x1b\x83\xc2Xb\xa0\x9b\xa4\\\xda\x06ytq\xf6\xd3/g\xaf_?\xee\fG\xd7\x1e\x8e\xb2\x80\xe0C\xa8\xeeB\x9cC\"\xa1\x19\b\xa4C\x81\xa1\x82s\x82Ř\v\xbc\xe59\x98\x80\x9a\xed\xd5d\x194y\x16̩\xb8\xce\x05\x9a\x18?\xa7\xb6+/\x04\ue479\xd8\x065z<f\x10\x17\x95S@T\x81I\x06\x10\xbf\xb2Ih\x15\xc9\xd1\x05\xea\x8bVT\xday\xad-\u07b8T\xb1\x8f\xb8\t\xbb\x87\x9d\x05\x15\xc6Vz\xea\xaeR\xc1\x1b\x14\x13\x18\xba\xa1\xdc\xd8\xc8\x01\xf5\x98\xa8\xf7\x96>\xfc\xfdq\xa7d\xea\xefƝ\x1b\xc7D\xed\x1a\x8en\x13D\xbf\x84\f \x81v\xb9g!\\\xa9\xb0\xa5Y\x18B\xb9.\xc1OZ\xe4\xa94/\xf0\xe9R{\x93 \xa9q(\x1a\x97\xcdb]\x1e\xd4\\d\xe4\xe0\xf9\x11\x86\xac\x03\x9c,\xad\x14\xdb$\x96\a6X\xbe\xf7\xf8&\x9d`\xf1r6t\xae\x04\xef\x82_\xed\xa2\xf4O\xc6S\x9e\xb2bK\":&\xe5g\x9f\xe7J\x85\u05f7ڜRn\xe8S\xe0kXm\x98D\xe6wo_\xff\xc7\xec\xec\x15\xbe\x8dP\xe9rl\xecy\x99\x00\xa2C\xfc\xd5\r\n\x83\xcduZ\xab2\x90H\xaeB\x81\xeb\xea\x1a\xb6\xb7\x135,/\xea:\xc0OP\xea\xb8\xc2u\xbf\xcar\xad\x821\x01\xd5\xeeZPL\x1cW%\xa9\xd0\xe77|q\xa1n`t\x0f*w봒\xb7\x9a\xa6wn\x1a\xba\x84\x1a\x98/\xccC\xbd\xc6\xc2\xe8r\xc3ШҚ\x8f\xa4n\xa0\x83\x98\xb6\xef0zl\xa7ck\x06\xb0\x8b\xce\xf4\xe9\xdc\a\xe9\x04\x91\xcbD\x8a\f\xf1G\xe6\xefRD\x81\x99y\xfb\xeeR%\x80a\xd4\xf6\x11\x14;\xa8\x05?\x99+\x91\x8f\v3\xf3k\xdf?\xfdnv\xcav\x19q\xb4\xfe\xcbӧ4\x9b\x82\aU\x02S\xcaG\x80\xd3\x01\x01\vl=\xa4\xcb\xca\xdc.\v\xd4E\xf9\x9e\xbe\x860^y;\x91\x18&\x84Ժ\x966H3u\x81\x94XЁ\xf7\xb3Grϊ\xd3ԑT\xc8l\xa9\xc0\xbe\x87/\x8e\xe8֣,\xb3\xc78\x95'\xe8z\xe0\x9e\xd0B\v\x93'\xa2\x95\x0e\x12\x97\xad\x8a\r&\x18P\xaf\x19\x88\xd7Ξ\xf2$\x96O\xcc\"\\\x90l\xb8m\xe8of\xb9\x06\x05E\xee8\x01\tw\x1av\xb1\x9dh'\x1a\x92\xc1\xb3\xc1\x88\x8d\x93\xbc\x1dΐ\xcc\xd8&\xb6V\xda{\n\xb1.X|\xa2ب\xdb/\xcb\xc1\x90.\xd4J\x10˙\xbfJ\x8d\x97%\xadV\xbf\xef\x81\xe8\f\xb43\xca@\x99\x04\xc5\xe5\"o\x1a\xce\xe02\x17\x92\x02\bop\r5e\r\xe0\x04\xc8\xc5d\x90\r\xec\x17\x1d%!\xc4T8%w\x99L\a\xb1FV\x88M\xd2\n\xac\x10\xcfo\xb2\xa5\xb8\xae0\xbd\x11\xd0\x7f\x82\xc5Z\xf0!\xa47.\xc4\xf6\xae\x1e\xd3m\xa4C\x95\x98\xa0\xfc!D\x9c@?\xc9\fԅ\xa4\xba%F\"c\t\x9a\xe3I\xf3\x0e\xec{\x8c;Q\xd9o\x88&\x1d\xeayC\xfe\x80\xf3\xbf\xe5}b\\b*:\x82\xac\x9c\xca\xf6nN;\xeb\x83B>\xfe\x94\xadS0\x9c\xb3\xb1-!%\x11\xcd\xe8`y\xd2pY\xd6ϴ\x061\x97\"9\xb3\ne畲<(F\xf9\x1eA\x87\x05K4\u008eĦ^\xf7x\xc8ߐC\x9c\x1do\xb7\xe9\xbe2\x9c\xa6]\x1b8,F\xb9J \xfd\x0fߓ\x95\xc2ğ\x8f\xaaE\xb1e\xdb\x1czB\x1f\x1b\x06\xb8\x84\xb3\x8a\x95\x93\xb4\xa2\x88\n\xec\x1dZ\xcb\x18B:C\xdc3\x11r\xa6\x17\xa9\x87\xf5\x8eI\r1\xdc\xd9Cɐ\x99Jc>\xb3\x7f\xd8:XWF\x19\xd8\xc4\xc1\xab\xf45\x88\"/\x9dr\xa3\xa2Oe(i\xc8\"\fC\xaf\x98*JM\x91\x1a2\xb9\x87\xa4\xaa\x95\xb68!(\x02F\x927\x19\xfcH\x11\x00\x17\v\x1a\x83\xf8\x12\x10\xafl\x04\a\u0093\xce\xcd\xe8@\x9cT<H\xf3q^܆\xcfHoAkv\xdd\xd4U\xd22m\xf5\x9fp\xee\x06K\x8c\xd9\x17\x83\f\x9a\x8d\xa6\x9b\xf8\v/\nf\xd2\xe7\xddE3\xa2p\f\xb3h;\xa6\x83bY9\xfb\xe8\xe3\x03\xfd*eD\x99\x8f8&@\x1c\x94\"G\xbfV33\xf7\x18\xfc\xd5\r;}R\xa6\rI\t4t8=Kr\xc7k\xac\x8e\f\xf9\xa9h\x88I\xa4\b@X\x89\x04*\xc5I\xb2U\x00Wԭ\x80_\xbc\xbd\xf8߯O\x9e\xbf|\x1de\xed\x14~\xf6d\xc7;\xb1?\xdfsOD\x9b\xef\xff5\xdfj\x12qN\x87⋻y\x83\x9d\xbc~\xad\xdec\xd9\aY6\xde\x7fx\x81\xa9\x88\xb4\x86]\x97碕\xdae\n\xeb\xfa\xaa\xd8$y\xb2\xa2\xe1ͻ|N\x11o\x10\xdbC\xbaX\u07b82\xf0*\xc9\xe4^ao\xa7\xfcR\xdf(Z4\xa0u\xe3\xa8EH\xb7D\x11\xbd\xc4}\xa3\xee\x03\xe9\x88\x05\x17:\xe2\x05lC\x039L\x80ջƊ9D)\x8a]0)Hq\xd8[{\x92K\x7f\xa8\xd1Cň[\xa1@\xd0\xc3{k\xdf\x18\x1e}8^ \x8fo\xc0\xc1\xb2\x98\x19\x1e\xd09\x7f\x800\x8d\xc2r\x80%\xc9:\xca\x01@\xe5\xf6t\xeb\xe4\xd2\x12J\xa8<\x04pV\xd1C\f\x90*\x1d\x89\xd2\b:p\xf3\x10_R\x15\x0fm\x91\xa6\x96\x9dT+\x12\xadO\v,\xf57\x88\x99\xab\xaba\xa2\x8f\xfb\x9b\xf7M_\x1c\xda7=\xc7\xe3\xd3\x19\xf7׳\x17\xf2\"a\xd9\"\xcbY}\x04q\b\xaf\x03:\x1f-_\x87\xf4\"\x89a\xe8\xc67\xb4\xe5\xa6\x19\x1f\f\x9d\xbb\x05X\x06\xc1\xfa\xa6\xee\xfdn\x15Z\xd6\x18\xa5sEl/\xde\xf3\xe2m\xe8\x1fn\xb8\xb8\\\xb7I\x88| ~\xa8\x02|\xfc-\xf6\xd6\xf5\xc77\x9ek+~\x9a\x93\x15\xddH\\\x103\x01\xb6\xe4}\xb1\x93F\xb9=\xaa\x1dw\xb9v8\x8b3Mj\xc4\x1a\xc3\x14\x9b\x0f\xd5A\xe1\xcbLyiY\xb4\xa8\xf0$\x97\xac\xb4\x1b\x92\x00\xde\x1e\xed(F\xa3\x9a\x88\xaa\xf7\xcb\x11\xe4\xb0Ǧ\x96\xd7\xea֭$\xbb\x94u\x13\xa3C\x03\xa7Nz\xa4\xacq\xe8p\xd1\xcd!\x12U\x06$\xa1x\xa0,Q\x1f\x1f\xe8\xd4g\t\xc7,9\xc0\x97\xb0\xfeP_\x96\x1d\v\xaa\xb8\x9b\x8e\xe8\x84Px\x01Y\xca\xd9\xf5%WW\xe7\x1f4\xcbh`\xe9\x03\xc1:\v\xed\xa3\x9560\xf2x\xe4i\xba\xacP\xec\x83a\x05\x8e[\xb8Q*s\xbe\x10\xc39\x05Ã\xea<\xfb\xf1\xfb\xefg\x8f~\xcd\xd9\x1c\x8fΨ\x97\xd84籵~@\xb7\xbe\x81\xbe\xf9\a&\xb7E!J%\xaa\xac˰\x10a\f5Pޣ\xbd\x1d\xbe\x85^'o\x1b\xf5\x02{\x10\x8b\xa3\x85\xf4\xb2\xeb\xa8\xc3\a2\xb9sL\x02\xee&\xfb\xa0D\x82\xca\x1f[\x82\xc1\xe8\x9da\xbdX\xb8:˴6\xa2D\xb0\xd4\fo\x9c_\x14\xe6\xb3\xf3\x93\xcbӟ\x95\x0ezU,ŵ\xe6\x8c\xd7p\x85\x8e\xc6c\xc5\x1c\xbb\xf5:rC\xaaz$\v\xfe\xcaJ\xbfi\xbd8~\xdc.\xe4щ|\xb2.\xe3E#\xe9\xaeNJ0R\x89\xb5\r\x89)믯e\x116z^Ţp\x95i+nH\xb1ƥ\xde(6\x87\xf5\xdbtM\xb69\xebVx\xff\xe4\x064kŷ\xd6\xe5\xa2\xdfl\x17q\xb4T\x93\x1f\xa0\xf1RV\x98C\xa5\x88\f\xa4f\xa6\x10\x19\x86\x04\x1eY\t\xa5ڀ\x17\x83\xd5!\x85Ut%\xf4x\xb4\xa2\x9f\xe5\xc2cxR\x93\x90^\xa1j\x97Q\x94\x02\xc9\xcbr\xfa)\xac\xadV\xd5\xe2\x92+p\xb0\xc9\xfavY%\xb5\x8fd&\xb4\xf2\x9az\x95\xc9\x7f=\x83\n\xc4s\xa0\xae\x00\x1a\xf1Ϡ\x1dF\xa9P\x96\xb8W\x8c\xd0Fɕ\x1d\x1cZdD\xd3+\x17#)\xc0\xd4\xcaoP\xc4ro*\xd1}Ų\xb0\x8ae\x8bbi\xb9\xbe.T}\xfa\x9f//\xcf\xe5R\xc0\xbbZ\xbc\xa7x\xfb\xf9\xec\xa9*\xe8\x95֣>\x8c\x1f\xbe\xa78\"!\xc2\xda\xec\xbdЁ\x1am-\x14\xe6^U\xc5\"C\xc9]Y,J\xf4|A\xa9r0\x9f\xd1_8\x9f\xb4\xc23(\xb5%\x04\x1dJ\xcaFH4V\xdb{\xa1<\xb0R9\xc6\x11\x95+\xd4\x1ai\a\x16G\bzÔ\xaa\xbd\xdc=\xe9g\xa8\xf8#\xcb\xe8\\\xa3˓\x15\rF\x0ff$Fr\xe1\v\x9e\xb6\xfbjg!\x02\xdb\x06dݕ\xad\x1b\xca\xecf'\xe4\xd1#\xb1jK\xe4\xb0\xc6c\xe5G\xe6$\x13iޑ\xb7\xfe\xb1}$\x0e\xe8\xb0q\xc2|\tQ>\x94\x1f\xa1\"\x8a\x0f9\x11\x01\x19\x8e2\x9f\a\xcde\xb0\xa5m\x93\xc7\x00z\xa7\xef\xeefߚ4\x8e\x1c%E\x8bS\x7f\xb1\x00\x03̱\xa54!\x19\xc5M\x05\xb4\x91\xb5\xaf\x93bN\xa4\x83|\xb1NJ\x92\x9f\x04'GV\xc6\v\x88\xac\f\f\xe5\xcb\"\xa5\xa3\x0eٴ%4\x1e\xc8\xec\ne5|\xa9\x15f\"\x17Z\xd5\xdfa\x9a\xd13A\xda\x05Y5\x04i\x82w\x1a\x94\xc6^o\xf0F\xa3u\x89q\x8dW\xaa\xebB\x02\xf4\xac`Jߟ&B\x16\xea\x9bA|(\xf5\x00Nv\xb2\x16e\x806\xaf<\xe9\xd74\x93f^\x18\xd4\x04\xae8\xd4^\x96\x05\x16\v+\xf3\x04\x85\n*t\xf9\x1a\xfc\xc3]Y\x02/\a\xbb\x84ȵ\xe8Z\xdd\x17TM\x88EB\xfeGخ8\xda\x1cL\x8c [\xe1]\x90qO\xe5f\xabl2\xe9r\xa6\x84؈5\xd9P\x85P\x06\xa1*2\xc6\x1f/ܜ.㤦]\x002\x026\x05&\xa2\xa0|\xe6\x91X\xa8\xf43\xb0\xd6\xd9+\xc0\x95]u\xdbm\x9a\x94\r\xc3=\xc5؛i\x8d\x88|5[\xeeR\x92n\xf1\xeb\x9b\x04kB\xb7\xe6P\xecj\xbe7Ѳ\xf6\x92\xc2.\xabg\x1f\xf3\x99\xd8\xd8@\xaf\xd8\xe4G\x1c}\n3\xa6~\x94\xf2=\xbb\xb0\xe5\x14҇\xe8$\xf8\xcbӿ\x1e;@\xa0\x04,B^y>\x18\x84\xbd\xb4I\x10\xc3\xc7ɑ\x7f\xe0$\xc8\xce8\x84\x9e*\xb7+#\xe4\x93\xea(\xe3ʧ%\x86\xbe\xf4\xe4\x88\xf9\xb2\xd1>\xa4\xbc\xb9e\xb0\xa0\xff\xa2O\x9ak<\x96\xd9Ӕ6\xa8|\xdd3}\x04\xe98\xbd9\xf9\x0f#7\xc7L\x91($w0?\xb7\xb0\b\x9d\x9d\x87Af|\x15\xa8\x13\x9c\xcc.\xccLQ\xa5\x1a\xdca\x9c\xb0:af!\x8f\x96\x1f\x843I\xf8\xa4\xb0ʂ\x97\x88R\x16\x95$\x886\v1*z{\x15\xf4\xb9\x99$$\xc4\xdc݆\x99\tȕ\xea-\xb9\xb9dpF\x8e\x8e\x929\xa6\xab\xe4\x90\xdb\xc1\x83XrV\xf1H\xf7(\xbbt\xdc9SX\xe6iQr)/\x95M\xf0\xa6I\x93\x93I\x8cW\xbb\x8eͧ\xc4z\xe4Z\x11\xcct\xc9l\xe6@5Í\xfbe\xa0Eۥn\xbc\xa2\xe6\xb8\xcd\xd3m\xb4\xb3\xd0`\x129\xa1%˥\xec\x86\x16>\xa8\x10*(\"C\xbb8H\xf9\xc1\x18M3ZWf=\xa8!@7\xf8&L\xa3*\xb3,x:\xa0c\x14Y߉ũ\xdea\b\x9eM9d\xf6\x90\xf9\xe6\xcb\xc7=\xecX\\$\x18\xaf؛\xdcsv\xadC\x14\xe7\x8d,\r[\x01o%\xe2\xeaD@J%\x14kp\x01\v\xc82\t\xe9V\x14>i\xeaQ\xe4o\x85\bU\xb8\xa9\xd7hB\x800`*\x9c{d\xc4W0\xa0\xbay%)\x98Pz\x17\x93B\x04\xfb\xbcK\xb2Za\x99\xdcJm\xb0\r\xdeQ\x89\x1f\xb7;˶b}ˤ3~z\x96ꏴX\x1f \xeb\xf67\xac\xbc\xf2\xc9Zx\x04\x7fn\x96\xc3\xe0Y²\x95$P\xa0\x03\xdf8\xd4m\x9b(\xe2:\x97!e\x1d3(\xffޓ\xf1+\x96\x06\xe4\xb8?\x81$q\t\xb7\x16\xb4\xa3\xc2J\xdab\xaf\xbda\x87\xfe3\xae\x00pGq\xad\xcdx\xfc\xe3\xf6\xe7T\xfc\x8f?\xb2|`\x96y\x97\x1bSUcj\x03{\t\x1b\xfd\xd9\xecOڊ\b\x963\x01\x00\xcc<\x7ff;!r$<\x01\x18\xde-\xa6\xb2B\x19\xb3ii\x06\xee\x96~\xae'\xdbdJ\xf0\xd9m\xd2\xe3\xf7\xc9\xddKY\a\x14V\x9f\xf6\xc8\xef\xd1\xd4-c\xa3x\x95E\xee\xfd\u07b7&r/@\xef\x82\xc8SPl\x94&v\x9c\x1b\xb7o\xbc\a\x9b\\\x04\xd9\x11mO S\xcb\x1f\xbbc\xe3\x01ñ\xf0\xb13\x0e\ue7ce\x97<v\x1b\xd4\xf5#\xef\xe1&W:\x9e\x80BO\x99\xe3)\x10,5\x8e'|>T\xe0x\n#\x19\xa8n춌\xed\xd2\xc6\x13hp\xa8\x1c\xec\x86C\b \xbf\x8a\xc6\x13\xa8\x1c(g<\x05\xa9\xdeZƓ\x80\xf4\x14\x10v\x9b\xe6\xc9_\xc7\xc0\xc0\xa1~\xb1Ub\xe8\b`\xe6S\xb3~\xc6M!\xc4y}\x1aA\xd9P\xc5Jdg+4\xc4]\x16\u070f%\xab\xe6$\xf9$\xb2\x01\v\x8a\xd6`\xa3l\f\x02UG늬\x13\x02\xea^\x88\x93\n\xf4\xdc\xf4\x90by\x152WH1\x91_W\x8eMz\xe7x\xf6\x1fŎ\x92\xffr\xf6\x97\x91\x00 \xa42|_\xd6\xe9ڮw\xab\x8c\x9cb\x84\xfc\x93'\xb33\tk\x9b,n\xc5\x1a<#\xcc\xde\xecO\xce\xcfxX.\xaf\xfa\xbb\xf8\xa2\x96\xb3\n\xa2!\x16\xaa\xf9\xdb߅:\xf8\xec\xe3\x83y\x96\x8bݜ~|\xf07x\xed\xcd\xfe\x9cƒ\xef3(\xf9\xf6\x86\x9f\xe3\xeb_hH\xfa\xe5\xa41\xdc\t\x19=\xa5SM~\x9e\xd0\xcf\xf45\x13\xf2\xf2\xf3W!\xa4\xb1\x96\a$\xe7\x1d\xd9\\ﲒ\x13\xadЊ\x8d\x961h5\x87\xb6\x88\xfa\x86\xea\xb1\xdd\xd2\xfe{F\x83|\xc4\xd3\xf3\xf1\x81\x00l\x10/\b\xa3\x87\xdam\x8f\xaf|\xfaN=\xd1THH&,\xa6G\xbe]\x1bh?\x83`\xbd\x82\x9f|\x993\x11\x17\x05\x19\xf6\xb86\xca\xff\x12:\t\xb9\xb1ь\x8c\x960\xa0\x1ft\x9c}\xb2\xc1D\xfe]\xbeI\xca\xea\x06\xb2\xfa\xc9\xfa(S\xba\xd0E\x8c\xf5\f\x1a\xe7\xc5\\]09b\xa87BF58\xb9\xa3Y\xe3D\n,K\xa4\xdb\xd6\xecr\xd8)\xb2\x82\x19\x95V\xab\xd3-\xd7\xcd^\x14\xdb\xfd\xec\x11\xd5,\x13\xec\xe4\tE\x1d\xc0\xb4=օ\x15:\a\x11KV\xd0N\x91\xfb\xf0a%\xc3\t/\xc0kM\xb9\xb6\x1a\xda\xecz\x97/\xb8\xb0R.\x145\fn\"ց\x8bMX\xea\x1d\xc0ŕ\xa0\x1a\x8c\xb1\x13\xc5\x16ٕ\x18M\x9a\xd5\x1a=\xd9\xf1]\x86\u008bm\xc9\xe6O\x01D\xbd\x8c\xaf\xca9|t\xf9\xeeŻg\xb2H\x01\xb6y\xa4:?\xcd\\?\n\xd0\xd8\xe5\xb79x\xfc\x19\xb0:#\xbf\xf2ﭜ\x8b\x85\f\x01g\f\xc4\xd4?n\xa9т\xa6\xae\xf2\f?\xda\x18\xb7\x8e\x8a^\xa6\xe5\x1a+;5\xbc<\xadT\x00[\xcdMeR\xb8\xda\xd7鰉bWg\xebc\b\xa0\xac\xcbc\xc14ߕTKn\xd0P!^?*\xca#m\xed\xea\x03.\xabݟ\xe5ׅ\xad\x18\xf8ua\xd4;\xa5w\xa9~\xb62\xc1\x1f\xa3\xbd\xe9.}(\xe6\xfa.\xe1\xb00P\x02\xc9\xceG\x15\xef\x8c\xd7[\x13\xbfI\xfe^\x80\xb1i\x93\xe5\xf8\xffUV\xeb\x00b\xf1ǩP\xf7\xb3\x9a\xfe}Y\xa6)\x98\x02\xc0\xe0q\xb5\xcb\xd6\xcb\x17\xf4\xefU\xa1?\x81\xec\xd1l\x8d\xf6\xab\xed:\xa9a\xdc\xee\xc2\xea\x8fm\x15\x00\x14\b\xdbC\x8dR\xcfS\x8dd\xcf\vF\xecR\xf7q1\xf4\x94\xe6\xca\xfa\x04g\xcf\xf6DM\x82\xbd\xc5%\xef\v\xb0X\x1c%\xabU\t\x9d\x05\x8bR۱\xc4\x7f\x1a:=u\x80\xbe\xa0THk\xf8\x15?kٵȟ\xd3\xc9\xf7h\x94\xfd\x9f5\xd2\x19U_\x81c\x14\xd6>>\xb8\x0f\x04\xbb\xef\xcd\xfc\rE\x14\xe98\x9f\x8047]\x93\x11\xc2M\xb6\xe9\xc2\x1e\x0fl\xd4q2|\x9fXM\xad\x90\xe5\x1f0=\x16i\xe4_\xb8\x06\a\x1d\xbd\x11\xb3\xa7\xdf\xf9\a\xd4\x1c\xe2d\x14\xf2\xe2\xb6\xcc>\xa1\fa\x89\xf1\x80l\x8fC\xe2ڭ\xd5\xef\a\xe8T\x06\x87\x03\xbd\x16K<OF\xe7\u0381\xfe\x13\x97e\"\xa4&Y\xdeƒ\x87\n=*P\x86\xe3LM\x1a\t\xfap\xf0w2\xbb\x04\xb21\xd9=\x86\xa1\x89h\n?\x8eRF\xa87v\xe2\xe7f\xe4\x04\xbfg\xd6 Q\xced\\T측Q\x9f\x14\x14\xf1+&\x9cA\t\xb4\xf4\xe8\xae(\x85\xf4\xacN\xba\f\xb1\x94\x85\x87\xd44\t\x81\xd7e\xc8j4T\xb9\x1d\xf5g\x8c\x81|\b\x98\xd0%&\xba\xbc\xa2\xf6\xa4,wZ\x87\x93\x8e\x82\x96G\x94=\x1c\xe8T\xe4x\x81\xe6@\x83~,\xbf\xad\vA}Cw\xf6k\xae-\xa7\xcb|\x18\xf7\xb9\x11\x8em\xeez\xf2\xe4\xff\xf5\xfej\x8e{5\xb7\xebSM\n\x9c\xf0\x94\xe6\xbe܋\x04\x7f\xbcH\xa0\x03o\x83\x8f\xfb\x85U\x9eh>\x0f\x97,\xb8\f\r\xce/\x96\xca\xdam!\x99\x84\"&0]\x15rS!f1\xe1\x06GB;\xc4\x7f\x1a\xde\x0f8\xfc\xf4O\x8b\x8aȅW@\xb9K\x9e\v\x9d{\x8d:\x1f(\x05\xe7P\x1bR,\xe0\x1b1Ǜ\xddF\xdbj\xe5\x03K^\x90\x04\xd1-\xe4{BOh\xa3\x9f\xbf|\x83\x91\xa3\xe0\f?=\x99]\xd1#\xc3>b\x14\xa0\xe1\xbaeiS\x8cyH\t<\x18\xa1\xaa)u0\x06\xf4\xc62\xa1\xb6$o\r\xdd\x04\x0eC_\x8c\x84!L\xf2\xe9\xe9Uo\x994\xfb0\xe6[\xf9n#G\xdd\xf2\xcf4\x1e\x8dϬ\x17K\x17\be\x1fz\x85\v\xc6\xfcs\xb6\x02\xef\xbc\xfa\x80z\xbd\xab&\a+I\xcb\x16\x93\x15\xcbf\x81\x04\xecs\x04\xa9\xde\x06\x00q5T\x18}\x96\x9a\x1d\x9e\xa4M\\\xdd\x16\x04x\x83ա)zg\x8f\xf1\x14\xb3\x1bB\xc8J\x1d\x976\xa8v\x18\xca\xc7(\xde\xdd\x14k\x89\xe8*E=\xf6\xa6I\x94,i\aUl\xf6\xb3Jl{,\xac\x9aTT\xfc\xea'\xcbtϩF\xb3x\x01a\x89\x9b\x88ˌA\"\xb7 X\xfc\xf0\xe8\xfb\xa722⻧\x8fi\f\n8\xb2\x8eB\xf1<ۛ\xe4*\xa5\x1e\x06`\xbfHʬ\xd2\xc1\xa3fUoyq\xce\x1e\tfq\x95\xa8\x06j\xe2\xaf\xeb\xa2x<\xfb-}\xb8\xd4a\x16-s\xef\xb3ٟ\x98{\xce\x1eq\x12\x8c\xf6U<\x86\xd5\xff\xee\xbf?}\xfa\x14y\xc6y\x92\\\x889}\xf4n\x9b\xe6\x177\xd9u=\x9f\xbdH3x\vkͨ8\x0e\xb6\x92\xb2=\xf0{\xf1y\xe5\x16\x8d$\xb8V\xbaؕ\xe9\xc5m\xb6\xbd|}\xf1\x01X\xc8\xdefĲ\xbc&\x99P5\x13?\xf5r\".\x96\xd4e~\xc6i\xd3%\xd0\xc59+\xf2\xd5\x1aa/\xc4\x15\x98`\r\x0f\xf4\x91\xe8\xbc@$R\xb3\x1a\xaa\x05h\xcf\x7f\xae\xfa\xac,R$\xe3\xac:\x99fm\xd4܀\xa7*kK\xb3$\x81\xcd\x19\a\x9ai\xa2\xb0\x86\x02\xb0\xecُ?\xfe \xcbp\x1aC`\xa9MJ6\xe4#\f\xa6d\x81>\xb6Sg!\\q#\xd5%\xb2\xa2\x97\xc4\xfa\xc2M\xb2\xc6&,\xedyK1\x05\x90\v\xe1f\x9b-\xcc]\xba\x86KNQ\x93ò\xaf\x19V\tU\x1f\xb2\x9cw\x8c\xca\xc9=\x8e\xa812\xe1\x8d\xda3\xbd\x8d2?hb\xe5$H\xfa;\xfc\x18\xa2\xf4Ͳ\xc5\xe4\x01\x19\xe8˩\uec3ea\xe5\v2\x91\x9e\x90@\xf6\xc2\xddL\xd5\xf2\xebޝP)\x8fz\xd10\xab\x14xɪL+\xb4\x93\x97\x946\x00\x19\x06#\xfc\xad\x85G\x97\xb5}+<\xed\"\xa3\xb2w\xd8dE\xce@B\xf4\xcf\xcd&=\x1c\x8fX\x89-'6\xbd\x90\x1c\xae\x04\x93\xd8K\xde\xf4\xdd\xd3\xca)P2\\r\xebQQ\xdbo\xf8\x98V:\x9d\x8ez3\xe1OU\xedA\xe2\x05*\x9aO\xe0\xceh\xf8\x86v\x87\x9a^\xc6\vB\xb15Ʃ\"\x94\xe7zuXE\x1f\xa3֕\\\xc0\xbb\xdfa\xdar.\x81\xf1\x99\xf13.\xcdde%\xbd\xf6\x89\xd02\xb3_\t;\x03$\xfd0\x06\xd7o\x0e1\x9c\xe2ޣp\xefQ\xb87\x1f\xfc\xff\xebQh3\x81oޭ\xd0A8ȷІv\xef`\xb8w0\xfc39\x18\xda\xfb\xf7\xde\xcbp\xefe\x18\x13\xf3\xee]\r\xff\x0f\xb8\x1a\xac\xf7\xf6\xbd\xbf\xe1\xde\xdfp\xefo\xb8\xf77\xdc\xfb\x1b\xee\xfd\r\xf7\xfe\x06\xc7;\xf4\xde\xe9p\xeft\xf8c\x9c\x0e=\xa6\x8c{\xcfàa\xe6[p?\xf4p\x8e{\x1f\x84\x9aH\xae\x19\xc2s\x88\xae\xa4\xdf.^\x82\x05'[<\x87Z\xf1\x17\x90\v\xf4\xa1X\xef6\xe9\x05ꑶ\xde\xc6B^\\\x90\xc2w\x0e\x92&J\xe13\xad_\x1d/\x04\f\x17\xd8\xe6\xdd;\xa2\xb39\xc3|0J\xf05\xc2\xdf\a\x11\xc60\xbc\b\x90\xe3\x8f\"Z\xd7\td&\x13uA\xe86 y!\xdd\xc4e\x14\xf5\x7f\ba\xefEV\xddF\xdbI6\x80^\x84X1s\xa2\xe7U\xb6N\xa3\xd2\xd3\x06\xe8MO\a\xb31z\x9egX\x04&\x84\x02\x06ჳ\x1c}\f\xcb\xd3d\x9b\\e\xebL\xb2Z_TM8>\xf86\xf0\x18E:\xdd\u07bc\xba\x88\xb5O\xbaм\b\xe8\xe24J\x06\x14\xd2,\xa3\x91с\xe6EF\x17\xa7Q2d\xd7\xe5\x86\aɛ\x8c\x0e4/2\xba89\x93\xd1'\x8bz\xd0 ]/\x01\x04H'\xdf4\xec\xed\xce\x10o\n\xc8w\x12L\x05b5N\t4[|\x93l\xc3\xf0g ~XK\f\x9cq}\x99\x7f\x8ap\x82;Ђ\xb0\xd789\x93\xf1K\xba\xbfྤQ\b1\xe0\x05\x91b\xe2\xe5LL\xf8\x190\x00\x05\xa1?mߟ\x97\xc5ߩ\x9f^\x14\xec5\xb8 \x1a\f\xac\x9c)\x89v\xb5\xd9\x00\x06Q3\xf1\x82C\x9bC\x1az&\b\x88'ތ\x813\xaeg\x1bk\xac\x83\a\xc2\b)\bk\xc2\xc5\x19\xf5\U000e2b23`~\x8e\xa6\xb6\x00\xc4\x11\x13g\xbcU\xf9\x85`\xc4\x11R\x10\xe6\x84\xcb4\xd4\xdf\xef\xf2<Pc\xb1\x02\f'Db6\x8d\x9eK\xec\x11\x05h\xc7#I\xc3\f\xa7\xca\xc0o\x1aa\xbf%Y\x1du\xa1\x18`8I\x12\xb3I\xf4\x84\n\xdb\rP\xc148\b\xdb/\x92tS\xe4/9\x88#\x04\xf9&$\x1f\xdc[\xb8\x8c\xa2^\xdc\xe5wI\xb9<9?\x8b#gX\x01z\x11b\xc5l\x02=t\xa7\x83\x9d&\x12=\x1a` =\x06f\x93\xe9\t\x97\x9ez@F\xa1\xc9Q\x82z\tm\x05^d\xd1l\x1d6x>\xf4X\xf1\x1a%\x86\xcfZo?\xbd\tt4Ay\x91\xd0\xc2\xc6\x15\xfbP!˄\x13\x82\xb7\x93\x88%_\xbe\xd8]Ui\x14\xb4\tR\b⌋+\xeaQ\xf6I\xd0\x0eq\xdf\x1b\xc1\xe6\xa3\x06\xa0 \x9c\x9dT\xe7\x97\xf9\xa7We\xb1\x89\xc0WL@~x\x9b\x988\xe0\xfd!)\x03\x11\x16\x10<1\x85\xb1\xddP\x8c2\xb3\n\x8e?\xba\xae\xf3jo|0\x01[l\x84\xe0\x83&\x8e\xec\x84_\xf0\x19\x93@\xbc\xf1t;[\xf0f\x84\xf5\xd7`\xbc\xf1u]\xfd\xcf\xe9\xe2$X\xae\xd5P\xbc\xf0\xd58\x8c\xa1\xfb\xea4\x96TԄ\xe4\x83v\v\x97Q\xd4\xd7\xe9\xe7hȷ`y\xa1\xdf\xc6g\x9c\x80bq\x1b\xcf\x05g\x01\xe7GF\x17\xab1J~:}y\x0e\x11jb\xb0\xbc\x8e\x19\x150\b؇\xbaaLG\xe9\xcc\xea\xf7鶈F]\x17\x9c\x17M\x16\xacF)Y\xef\xa0b\xf9u\x15\x8d\x16\x1b@/j\xac\x98\x8d\xd1\x03=O\x7fJ\xebp\xce\xdb\x00\xe4\x83\x7f\x13\x13\x17\xbc\x7f\xc6\xf6\x89\xa1H\x13\x14_\x8c\x19\x87Qt)B8\bW\x0e2\xf6A\x94G\x1fŲ\xa8\xea\x93u\x96\x04i=\n\x88\x17\xa6\n\x03\x17\\ϓ\xfa&\xd69\xb4\xc1\U000e5803\xd7\x181g\x17\xa7\x17\xd1,G\x1d`>dt1\x1a\xa3\xe1\x97t\x7fY\x00\xe5!\xb8+ >8k\f\xc6p}\x9d]\xa7\x8b\xfd\"\xcc樀\xf8\xe0\xaa1\x18\xc7u#\xee\xa9$_\x05\"+\xa1\xf8a\xabppG\xf7\xacN7qP\x06Hah#.\uea07jyMHa\xa8;\xe9{\xfau{^\xa5\x0fꘁ\x19\x84\xba\xac\xcd0\x8cz\x91,\x9f'\xeb$_\xa4\xe5Y\xbe\n5\xceZ\xc0y\x11a\xc1j\n%\xe1\x1e\xb2.\xb4P:\x1c\xfdd\xaf!#\x8aJ\x83\fd\nL!\xa4\vϏ\x14\v^N\xc4ĺY;\xc0\xbcɘt\xb3\xbe\x8d\x175\xfb6<d\xf6\xed\xc4xٷ\xfd\xd9\x1b\x13\xf0\xd6y\x1e\x1e\x18+\f\x9cq\r\xbd\x00\x1a\x80\x82pvb\xff\xea\xedP\xee\xdf\x00\x14\x84\xb7\x13\xef\xd7o\a\xb3\xcb\x16\xa80\xdc\xdd\x18\xe5\xdbb\x19\xb6\xa9\xa1ϔ\x0f\x9e0\xae\vr\x11|\x9d\x06\x18_T]}\x9c\xf8n\x84\x14$\x13\x8e7ή\xa9H\xf0r\x94\b\xfa\x06 _\xb4\xdd\xe3\xe6\xe1\xedf\x10J\xf06i\x81\U000e584d\x95\v%\xc1\xec\x9aa\xf8\xe2\xecƤŋ1\xe2\xb4M8\xbe\b;\xc7e\x9b/\xbf\xa7\xb2'\x9b@7]\x0f\xc8PRL\xec\xa6P\x05\xf1|\xb1\xc8\x01X\xa1t >N\x04\x84\xde\xf5\f\xc3\x1ba\xa7\x1b\x1e^\f\xbf\xdc\x15\x14odݯ\xf4\x8b\xbd\x18ac\xefq7\x11e\x05\xc9\x1bm\x8d\xcb\x18\xea\xa4\x19\xbd\x82\xa6\xae1\xb8\x8c\x05\x9c\x0f\x116\xac\xdc(\x89\xa2{FP;\xa7j\x9c\xdaM\x16\x9e\x9e܆\xe5\x83\x7f\a\x9f\xa9\x04\x9c\xae\x93l\x13\x93\n\x04\x18\x83\x14\xc2̋\x9eP\x89\xa1\x17h4\xba\x9cd\n뗡\x17C/\xd0h\xb49]\x1d\xf6/\x83\xef\x92\x01\xb0\xf1\xe8s\xbbm\xac\xdf\xc62\xf5\x8c\x02\x8fF\xed$sP\x1bB\xec\x83\x18\xeb\fz\x1d\xbf\xd8'/֡\xf3:o\xf1\x8fZ\xbcS\xe6z\xc0n\x8a\xba\xc8\x0f\x13b3\x06ۋ\xcc1|G\t.\x82\xb2\xa6\xc4\xe7^h\x17K\x17\xccb\x18w\f0\x9e\x98:\x9bv\x8cwCu\xc4\x16\xa8@̝4Dx?\xaf\xb3Xsn\x80\xf2\xc5\xde\xc4\xc6\x01\xfb(V5\x13\x8e'\xde\xee65\xf1r\xf0mF <Qu\xbb\xb3\n\xa1\x89-vP\x0e\x0f2\xe8\xd2ϡ\b\xb7\xa0y\xe2\xde\xc6Ʌ\x8c\xd0ۖ@\xf8\"\xect\xa7\x8a\xf7¯Q\t\xc4\x17S\xc7˲X^\xa6\x1bh\x01\x9f\x06b+\xc1x⫰\x98\x80q\x84\x83g\x82\n\xc4\xdc\xf5 \xca\xf7#\xecd\x13T \xf6\x8e;\xbb\xac\xef\x8a2Z$\xb9\r\x9e\x1f\x1d\x16\xbcF\x89\x91e\x9e/\xa0\x94\xdb\x0e\x8a\xc7\x06_\xf9v\x90^$\xf5`7NUq\x15\xb6&\x00\xc0\x0fc\x18\xd9\x01?0\xad\xc9\xday\x11\xf6\x90\r\xa0'\xfe\x16\xcc\xc6\xe8\xf9\xb7]\x015\xd2cQc\x01\xe7C\x8b\r\xab1J\xde?\x7f\x11\x8b\x8a\x16(\x1f\n\xda،b\x9fn\xd7\\#\xfb\x94\xea\x0e\a\x86^[\x01zQb\xc5̋\x9e(\xe2\xf10\xe4h\x14\xba\x8b\xd0\xd6\xcfC\xef\xf6^\xa0\xd1\bt\xba\xef\xad_\x86\xde\xfc\xbd@\xa3\xd1\xe6$\rؿ\f\x96|\a\xc0ƣ\xcfM:~\xcf\xddc\xa29ج\x00\xfd\xa8\xb2a\xe6J\x8f\xb8\x1e\xa85L(\x1d\b(\x04\x7f\xc2d\x12\xdeᜡ\x05,\x18\x7fGN`|\x11\xce\x01Z\xc0\x82ip<\xf1\xe6\x17\x11Nz\a\\8\x1d\xd3N\xb6\x11@\x12\x85\x14\x13^\b-\r\xbcƈ\xb9x\xf9:\xcbw\x9f\xdfm{\x8a廓ф\xe4C@\v\x97Q\xd4\x17\xc9:={\x17K\xf0\xb4\x80\xf3\"\u0082\xd5(%\xe9\xa2\f+xB\x10\xbc\xf0\xa5\xb1\xddP\x8cR\x00\xb5\x05\xca\x1fi\xf7ҧ\xf4~\xa4\xba\xa7\x1d`\xfe\x14L\xa9xJ_\x84\xde`\x1a\x8a?\xd6Nw\x16\xbd\x1a\xa7\xfaX\x1b\x96?\xea\x13j\x8e\xd1\aѸK\a\x9a?\x11SyK,\xdb}\x04\xc3\xfdT\xab}o\xe7\xee)Xs\xf3'\x1fl\xb9\xa1\xa4\x1b\x96'\x8bE\xb1\xcb\xeb\b\xc82\xa4\x00\x9c%.\xd3P\x0f\xe7.mh\xe1$8r\x9b\x81\uec13\xf1\x0fD|\nơ\x05\xda\f0\x01\x18;\x95g\x1bl\xc49\x19c_ͣ\xd5\xc6\xdb\t\xe3`m\xa3ٝ*\x00k7\r\x03Z\xf1$\xab\xf4\xddE'\x8c%\xfc\x1e\x1a\x06\xedE\xdb\b\xb6\xce\xd4F\xa71\x1ae\x93\xe8\xb9<=\xbf\x80\xd2>\x11\x8a\xa4\xb4@\xf9\xd0\xd0\xc6f\x14\xfb$\xb0\xd8-\x02\xf0\xc24q)m{Y\xacSjS\x16\x84\xa4\x82Ⅹ\xc6a\f\xdd\xf0\x98n\xffHn\xc7\xf8mz\xedM\xa8\xe8b\x80\xf1G\xf6\x8d\x93\xd0B\xef\xc6\xd1-ڰ\xfcq\x9f\xa0[|\xa8\xb67i\x99~\xc8\xcaz\x97\xaccF\x19\x0eC\xf6\"n\x18\xd71R\x7fK\xa1yq\xba\x8c\x18\x1d\xd7\x03҇\xb8>솨\x12\x10\x96\x9b\xac\x82>\xa1\xad\xae\x89\xd8\xdf\xf3\xbb\xe33\x180Yg\xff\xf0\xf3\"\xbaC\x9fB\xf1\x04\x9cc\x11O}8v\xfe\xdc\xdas\xa8\xc3LK\x93\x9a\x83̑\xaf\xfa\x120\xdcW\x98\xabQuht\xbe\xde\xef\xfc*/9\x80\x8dH?b9B\xe7v[\xa9\xee\xaaڥ\xf8>\xfd\x94U\xbegd\x18\xe4$\xfaF\xb0\v\xa2\xcd{o\x8f\x83\x8dH\xa3\xcb^5!\b\xfc\xd7\xc5\xde7ù\a\x947=\x066^4\x04ň\x8c\xc1\x8c@\x95[\\H/yQ\xb6`\x13\\\x04\xa2\xfc\xb7\xdc{\xb1u\xaf\x92\xc5mD\x9a$\xc8\bt)\xec\xbch\xf3\xb52\r\x80\x8b@Өթ\x9f\x1eo\x1b\xd4 \xc0\x184\x8dۤ\x06\xa8\x92\xed\xc6#\xd2E \xa3P\xc6\xd8M\xa0M\xeeZ\x92`\x82\xe9j\x82\U000e6a45\xd5Dz\xb2|\xf5\xebv)p\x8ex[\xf5\xc0\r\xa2І\xa7/\xa9ا\xeaz\xb7\xbeH\xe3mұ\x01\xe2\x10o\xc3|\xc2,`\xfcA0\xa9\bś\x1e\xc2a*\xd2QX\xbe\x82\x14\x86\xfcTFO\x1f\xc5\xe1\xf1\x06\xac@\"&svc\uf153\xa1a\xf9\x93a\xe0\xe3GF\x14\x99\xaf\x05/\x069S\xa5>\x93+D9'Mx1H\x9a|fLF\x17\xe7\xe4\xb4!F!+\xe4\x14I\x96\x1e\xe9\x06\xea\x85\x1c\x83\xd0\x16\xae#\x04\xef\xea\x1bq?st49En\xd3\x1c\xf4\xe8\xf4\u038b\xce!\x80\x93\xc8\x1b\xc4,\x80*\xefs7\x064\x1au.'p\xf0{\xffc8\x0e6\x1e\x95N\a\xb2\x03\xe1\xd7\n*3\xfb\x15\xe9\xea\x87\x16F\x95\xc2i\"1tvc\x9f\xb8\x0e\xd4 \xe2\xba8\x86\x12\x19\xed\x00Z!\xc7%\xd6\xe7(v\x81\xc4;\x8f=\xb0#\x13\xedu2\tL\xd4\xe3\xd9\x04\x19\x81\xc8\t\a\xb5(\xb3\x7f\xa8\x03\x8eŻ/vX\x12\xeed\xb1H\xab*\xec\xbc:\x01\x9fJ\xaf\x1b\xc6\x13)\x7f[\xe42!\u192e\xcb\xecj'ޏB\xb6\x15r\x10\xcdv\\'\x12| j#\x93\x1aN\xe7E\xba\xbe>Ԏ\xee\x81\x1dDq\x1f\xbeq\xc8\x0e\xb9\x93\\\xe1\x1f\x82|\xc7\xfb\xa9\t\xeaP\xab\x1e{\xc5#\xac\xf6!W\xfa\x10\xab|\xb0\x15\x0e\x93AܠG'\xddU\x0e1\x01\xd1E\x7f\xc8\v{x\x84\x90I\x18\xc1\xddg\"\x0ev\x7f\x0f\x80\x0f\x9f\x82\x1879\xdb\xe4\x0fH\xfc!(\x8fD\xf6\x01o\xf7\xc1\x01\xc2' \xce=?\b+\xd6\x150:\xc8\xc1f\xc3\xe3R`x\a\xdd\x13\a\xd9\x0f\xb1\xf6\xc2\xc1\xf7\xc1\xc1\xf6\xc0a\xd7?\x9ed02\xc4af\xc2QF(*q\xa9f\xf9\nD\x8dӲ\xa8\xaa\x0f\x90\xe5R\xe4\x11\x8aλC\x9f8\x03\xae8O\"\xfeg\x98\xd6\"\xaf\x935\x04K\xf33\xcf\bg'\xc8\x01D\xf7\xe1\x1a\x83`o\x8f\xa23\xf4\xf8\x84\xbbx\x1b\x9d\x00\x05p>G\xe8\xf1\x89w\xe3y.\x80B\x18\x9e3\xfc\x03L\x80\a\xab\xf3\x0f#\xb1\xc0\t \xc9)\x94\xc4\xf2I\xa4\x8d\xea\x17N҇\x8f\a\x19\xb1\xb6\x9coPI?N\xc3\xc4\\%\xf5\xe2\x06>\xf9\xd7\xe2\xca\a}\xf3\xfb)\b7\xc6uG1(\xf2\xd9\n\xc8\x17i\xd7\x18g\xf3\x1b\xdf+\xa9\r\xc3\x17g\x87\xeb\xc5|\xdd\xf7l\xb6a\xf8\xa2\xebp\x16\x1b\xaf{\x9f\xc1.\x14o\x94'\x9c\xb9\xef9\x1fEH~y\xd8\xe9kA\xf2@\xbe\x8d\x8b\x0f\x01\x81\xbb\xdb\x02-\x9c\x10\xf7\x1d\xdf\xfa0p\xef[\xa0\x85\x13\xe3~\x1e\xda\x1f\x86\x9e\f+\xbc\b\x04y\x9c\x16\xf1Yhu\xeda\x88\x01d\xb5q\x1b&l\x91\x96uv\r\x1e\xec\xd4Hu\xd2?^d\xab\\\xdc\xe7P&.\xf5,\x972i\x84Iy\xb9\xd3p\x8f9\x11a\x05r\xfd\x86:\xdcԸ\x8a\x11Ӡz\xd7י<\xca\xe1fƁuO\x03\xe8]\x10g\xf2(\x87\x9b\x94\xe8l%\xa0\xe6\x8e\xc78\a\x9c\x18\x97\xab$\xfd\\\xa79\x18،,$\xecJ\xec\x19G?\x04o\n\xa9\x83x\xf9\x93\xe4\xcb\x05\xc6`\xc6\"\xcd\xe1\x84\x0f}\xee{\x9e\xc7`\xc6\"\xcf\xe1\xac\x0e~\xee}2ǡF#1\xf4ԅ\xc7ݻC\x8fE\xf4\xa4\xf8{\x1b\xa0\xa0\xec\xbeA\x80\xa1$\xba\xe6\xf3\r~\x1b$\xa0\xb9A\x8eF\xa7\xab\xf85\b$&\x9f\xf5\xceZ\x1f\xc70\x80\u0090\fv'\xc0\xd1(u\xccf\x1f\x84\x11\xf3b\xf1\xcel\x1f\xc70\x84¨wK@\xae\xbb\v\x96AtƽX\x82\xb2\xdf\xdd0\x9dL\xed\xab\x8b\x9f\xcab\xb7\x95\x00\x02*\xc4;\x03\x0f\xa4\xba\a\xe3ɔ\xff|yy~\x96\xafʴ\xaaΓ\xfa&\x12\xc9-\xa8\x81\xb4\xb6q\f!\x12\n\x17}Hֻ4>\xa5\nt<r5\xb6\xd3i.\xaa\x1aJݾO\xf2U4bM\x98\xa1T6\xf0\x9bL\xdeً\x98\x841\xb4@\x92$NӉ\xa1ՎE\fA\v%\x86q\xf2%\xe6\xb9\x10 \xd2|\x19\x97&\x06\x1a\x874\x89\xa1/\x85\x11EV\x03b\x1c\xda\xfc\x84U\x83\xeb\xc4%lj\xf5\xb9\x11\xdc|\t\x8b(\x94\x1a\x10\xe3\x10\xe6'\x8eʏcʢ\r\x98\x91\x88\xf3\x94B\xf9\xf3\xcb\xd7\x17qi\x13\x00\xe3\x10\x06\x98M\xa6\xeam\n]Xoϋu\xb6\x88%V7`\x06\xd2\xd6\xc4/\x8c\xbc\xf8,\xa5\x0f|L\xa2\x83\x98M\x03R\xc4K\xa2\x037&\xc5~\x17F\x03\xc4y\xea\x17!\xeb\x027&\xa9\x88g \xa9\x9e\x9d,\\\xe0F%u\xac\xd7\xc58\xa9\x11\xef\xcc\x0eܘ\xa4\xfaݟ\xd0\x01\x9e\xdb\xf4De\xc7\x1d\xb8\x81\xa4v\xf1\f'5\"k\xb2\u008eM\xb2\x1f\x8bꀉ\xb8\xa1\xad\xb0c\x93\xed\xb7\xb1\xb9\x9dk<\xa7\xac\x06\x18H\xa0\x81Y\x00U\xb1\x1d$\x16\xc8\xd1\xe8\fp\x90h \x11Ok\x13h42\xfdΧ\xfe>\xe2\xc1l\x02\x8dFa\xe8Q\x8c\xaa\xa6\xb5\xc1ƣ\xd2SY\v/';\n4\x94\xc6)\xa5e{\xbeׅ[#G\xbd\u0601G\xa0\u0602q 屝\xef\x11\n\xecN\xc0y:\xf1\xbb\xfc\xa4\x82\xaaH\x87\xf1\x0f\xf5\x81\x0f%\xbf\x0f\xeb\xc9\xf4s\xaf\xe2\xc3Po\a\x1eH{\x0f\xc6\xd3)\xf7\xcd\f\xeb\x83\x15J\x97C\x86X\xdfg\x11\xafW\xafL\xb1A\xbc<I\x8ay\x9fzf\x8d\x8d\xe06\x9d\xb0\xddv\xbbƆ\xe6\xc9\x1a]\xbcՁ\xce\xdd\xd88\xa1\x930J\xc7\xf0\xd4\xe4\xa4\xdcs2^\xb0\xc9t\x00\xdc\x14B\x87\xb0\xf2\xa6'\xd0F\xea\x069\x12\x95\xee\x96\xd1\x01 \xbe\xba\xcc\b\xc8H$:\xe81\x03_\xfbZAG@F\xa2\xcd\xc1\xf69\xf4\xb5\xa7\xd9s\x04d,\xdaƍ\x9d\x03_\xfb^\x8f# #\xd1\xe6pIn\xf1E\xc5\x7f_~ʼ\xfbE\xf6\x80\x9aBK\x1f6\x93h8/\x96/\xb2\xaa\xdc!\xe6\xcfw˕\x9f\xb25\x0e5\x802\x1b\x8e\xa1D\xfa\xf2G7\xc8q\x89u\xe0\x96\xe3@|\x0f\x9f\x1b\xe4\xb8\x04O?\x8a6 ޒ\xab+\xec\xc8D\xbbH\xb3\xe5U\xb2\xd0-\x02O\xd7;\x81@)\xb4p/\xa9\xa6\x17\xd8\x14\xc2\xfa1\xf2\xa4\xe4y\x96/\x05\x7f\x8eJ\x10ÌB\x97\xc4/\x8c<_\xfe\xe3\x027&\x99\x0e\xbc\xa7\x17Dt\x1a\xa3\x117\x99*\x12\x11|Շ>X\xfe\xb4\x18\xf8L!#\x0e\xa7\bc\x11\x93yCT\xa6\x10\x85\x1b\xf8\xb2\x81\xe8\xe7?\xda\xc1\x0f9\xf1\xf1\x8ez\xf8\x19\xf7:\xdc\xf0\xd1{\x18%\x06\xfe\x02P\x18\xfa\x80\xc9\x14\xec\xb9\x18a8\xf6\f\xc8\x1f{\x89\x89\x13\xf6\x9c(\x1dE\x82\xe9\xc0\U000a084b\x8f\x1f\x19ᜪ\x0fd\f\xa2&q\xad\xbe\xaf\x03\x0f\xfb\x10؈4\xba\xb3\x81\x0e\x84\xd8\x04Ƣl*IQ\xa4\x966(oB\xa6\xca,\xca\xc5\x18\x8e}\x10O\x98\xca\fbr\x81\x18\xc7\xdf\xf3\xdc\xc7>\xf0\xb1Nz\xc0\x11\x8fv\xb6\x83\x0f\xb5\xcfi\x0e\x17S\x1ap\x82pw\x16R\x1a\x05\x93\x83Q\xf7\x17Q\x9ax\f\xa3^\xa5u-vWe\xaa[\xcb\xf32\xad\xfcl\xa3\x03\xe0\xa6\x102\x84\x957=\xbe\xa7a\x04d$\xba\x1cN\xc8\xc0\u05fe\x16\xcf\x11\x90\x91hs\xb0qVuQ&\xab\x14+\xa5\xd2?O\u05c9_\x0ec\x0f\xa8I\xb4\xf4`\xe3E\x83\xf7\xbe\xeb\a\x17\x81\x16\x97\xfd\xa6\xbe\x94\rOc-L\x17\x9e\x1fE\x16\xbc\xfcI\n_';\xccX\xa4ъ\t\xfa*\x0e\xe8~\xa1a\x00\xde\xcfӤLK\xec)\xd8%\x83\x1e\xce\xf0\xe9\xac٩O\xa0U\xef\xb7B\xf0| 0\xf8%\u074b\xbf\xf3d\x83\x7f\x9b\x1d\x01\xc4\xcf\x19@\xba\x11\xa4\x80\xd7\xd7@\xe4\xc1\xb3\xbf\xb4\x86\xff\xcb_\xbf\xfc\xf5\xcb\xff\x05\xb3\x0e\xf1\xa5\x851-\x00"),
+	}
+
+	// define dirs
+	dir1 := &embedded.EmbeddedDir{
+		Filename:   "",
+		DirModTime: time.Unix(1792047619, 0),
+		ChildFiles: []*embedded.EmbeddedFile{
+			file2, // "v1.10.3.json.gz"
+
+		},
+	}
+
+	// link ChildDirs
+	dir1.ChildDirs = []*embedded.EmbeddedDir{}
+
+	// register embeddedBox
+	embedded.RegisterEmbeddedBox(`offline`, &embedded.EmbeddedBox{
+		Name: `offline`,
+		Time: time.Unix(1792047619, 0),
+		Dirs: map[string]*embedded.EmbeddedDir{
+			"": dir1,
+		},
+		Files: map[string]*embedded.EmbeddedFile{
+			"v1.10.3.json.gz": file2,
+		},
+	})
+}
@@ -0,0 +1,106 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package lib
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+)
+
+// Generated reports whether ksonnet-lib has been generated for the Manager's
+// Kubernetes version.
+func (m *Manager) Generated() (bool, error) {
+	return afero.DirExists(m.fs, m.versionPath(m.K8sVersion))
+}
+
+// SameDefinitions reports whether the ksonnet-lib generated for the Manager's
+// Kubernetes version has the same type definitions as the one generated for
+// version. If it does, either can be used in place of the other without
+// changing the objects that are generated. Both must have been generated.
+func (m *Manager) SameDefinitions(version string) (bool, error) {
+	definitions, err := m.generatedDefinitions(m.K8sVersion)
+	if err != nil {
+		return false, err
+	}
+
+	other, err := m.generatedDefinitions(version)
+	if err != nil {
+		return false, err
+	}
+
+	return reflect.DeepEqual(definitions, other), nil
+}
+
+// GeneratedVersion returns the version recorded in the swagger that the
+// ksonnet-lib for the Manager's Kubernetes version was generated from. It is
+// empty if ksonnet-lib hasn't been generated.
+func (m *Manager) GeneratedVersion() (string, error) {
+	data, err := afero.ReadFile(m.fs, filepath.Join(m.versionPath(m.K8sVersion), schemaFilename))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	_, version, err := swaggerDefinitions(data)
+	if err != nil {
+		return "", errors.Wrapf(err, "ksonnet-lib for %s", m.K8sVersion)
+	}
+
+	return version, nil
+}
+
+// Remove removes the ksonnet-lib generated for the Manager's Kubernetes
+// version.
+func (m *Manager) Remove() error {
+	return m.fs.RemoveAll(m.versionPath(m.K8sVersion))
+}
+
+// generatedDefinitions returns the type definitions of the swagger the
+// ksonnet-lib for version was generated from.
+func (m *Manager) generatedDefinitions(version string) (map[string]interface{}, error) {
+	data, err := afero.ReadFile(m.fs, filepath.Join(m.versionPath(version), schemaFilename))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, errors.Errorf("ksonnet-lib for %s has not been generated", version)
+		}
+		return nil, err
+	}
+
+	definitions, _, err := swaggerDefinitions(data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "ksonnet-lib for %s", version)
+	}
+
+	return definitions, nil
+}
+
+// versionPath returns the directory of the ksonnet-lib generated for version.
+// Like ksLibDir, it prefers the layout of older apps if the directory exists
+// there.
+func (m *Manager) versionPath(version string) string {
+	legacyPath := filepath.Join(m.libPath, version)
+	if exists, _ := afero.IsDir(m.fs, legacyPath); exists {
+		return legacyPath
+	}
+
+	return filepath.Join(m.libPath, KsonnetLibHome, version)
+}
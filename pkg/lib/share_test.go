@@ -0,0 +1,133 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package lib
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func stageGeneratedLib(t *testing.T, fs afero.Fs, dir, swagger string) {
+	require.NoError(t, fs.MkdirAll(dir, 0755))
+	require.NoError(t, afero.WriteFile(fs, filepath.Join(dir, schemaFilename), []byte(swagger), 0644))
+	require.NoError(t, afero.WriteFile(fs, filepath.Join(dir, k8sLibFilename), []byte("k8s"), 0644))
+}
+
+func TestManager_SameDefinitions(t *testing.T) {
+	changedSwaggerData := strings.Replace(blankSwaggerData, `"definitions": {
+  }`, `"definitions": {"io.k8s.api.core.v1.Pod": {}}`, 1)
+
+	cases := []struct {
+		name     string
+		swagger  string
+		legacy   bool
+		expected bool
+	}{
+		{
+			name:     "same definitions",
+			swagger:  strings.Replace(blankSwaggerData, "v1.7.0", "v1.7.1", 1),
+			expected: true,
+		},
+		{
+			name:     "same definitions in the lib directory of older apps",
+			swagger:  strings.Replace(blankSwaggerData, "v1.7.0", "v1.7.1", 1),
+			legacy:   true,
+			expected: true,
+		},
+		{
+			name:    "changed definitions",
+			swagger: changedSwaggerData,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			stageGeneratedLib(t, fs, filepath.Join("lib", KsonnetLibHome, "v1.7.0"), blankSwaggerData)
+
+			otherPath := filepath.Join("lib", KsonnetLibHome, "v1.7.1")
+			if tc.legacy {
+				otherPath = filepath.Join("lib", "v1.7.1")
+			}
+			stageGeneratedLib(t, fs, otherPath, tc.swagger)
+
+			libManager, err := NewManager("version:v1.7.0", fs, "lib", nil)
+			require.NoError(t, err)
+
+			same, err := libManager.SameDefinitions("v1.7.1")
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, same)
+		})
+	}
+}
+
+func TestManager_SameDefinitions_not_generated(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	stageGeneratedLib(t, fs, filepath.Join("lib", KsonnetLibHome, "v1.7.0"), blankSwaggerData)
+
+	libManager, err := NewManager("version:v1.7.0", fs, "lib", nil)
+	require.NoError(t, err)
+
+	_, err = libManager.SameDefinitions("v1.7.1")
+	require.Error(t, err)
+}
+
+func TestManager_GeneratedVersion(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	stageGeneratedLib(t, fs, filepath.Join("lib", KsonnetLibHome, "v1.7.1"), blankSwaggerData)
+
+	libManager, err := NewManager("version:v1.7.1", fs, "lib", nil)
+	require.NoError(t, err)
+
+	version, err := libManager.GeneratedVersion()
+	require.NoError(t, err)
+	assert.Equal(t, "v1.7.0", version)
+
+	libManager, err = NewManager("version:v1.8.0", fs, "lib", nil)
+	require.NoError(t, err)
+
+	version, err = libManager.GeneratedVersion()
+	require.NoError(t, err)
+	assert.Empty(t, version, "ksonnet-lib for v1.8.0 hasn't been generated")
+}
+
+func TestManager_Remove(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	stageGeneratedLib(t, fs, filepath.Join("lib", KsonnetLibHome, "v1.7.0"), blankSwaggerData)
+	stageGeneratedLib(t, fs, filepath.Join("lib", KsonnetLibHome, "v1.7.1"), blankSwaggerData)
+
+	libManager, err := NewManager("version:v1.7.0", fs, "lib", nil)
+	require.NoError(t, err)
+
+	generated, err := libManager.Generated()
+	require.NoError(t, err)
+	require.True(t, generated)
+
+	require.NoError(t, libManager.Remove())
+
+	generated, err = libManager.Generated()
+	require.NoError(t, err)
+	assert.False(t, generated)
+
+	exists, err := afero.DirExists(fs, filepath.Join("lib", KsonnetLibHome, "v1.7.1"))
+	require.NoError(t, err)
+	assert.True(t, exists, "ksonnet-lib for other versions should be kept")
+}
@@ -26,10 +26,11 @@ var (
 	VerbosityLevel = 0
 )
 
-// Init initializes ksonnet's logger.
+// Init initializes ksonnet's logger. Warnings are collected until
+// FlushWarnings is called.
 func Init(verbosity int, w io.Writer) {
 	logrus.SetOutput(w)
-	logrus.SetFormatter(defaultLogFmt())
+	logrus.SetFormatter(newWarningCollector(defaultLogFmt(), w))
 	logrus.SetLevel(logLevel(verbosity))
 	VerbosityLevel = verbosity
 
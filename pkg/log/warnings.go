@@ -0,0 +1,113 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package log
+
+import (
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// warningCollector is a logrus formatter that holds back warnings so they
+// can be printed together once a command has finished, instead of being
+// interleaved with its output. Entries at other levels are formatted by the
+// wrapped formatter.
+type warningCollector struct {
+	logrus.Formatter
+
+	mu       sync.Mutex
+	out      io.Writer
+	warnings []string
+}
+
+func newWarningCollector(f logrus.Formatter, out io.Writer) *warningCollector {
+	return &warningCollector{
+		Formatter: f,
+		out:       out,
+	}
+}
+
+// Format collects warnings and formats everything else.
+func (wc *warningCollector) Format(entry *logrus.Entry) ([]byte, error) {
+	if entry.Level != logrus.WarnLevel {
+		return wc.Formatter.Format(entry)
+	}
+
+	wc.mu.Lock()
+	defer wc.mu.Unlock()
+
+	for _, w := range wc.warnings {
+		if w == entry.Message {
+			return nil, nil
+		}
+	}
+	wc.warnings = append(wc.warnings, entry.Message)
+
+	return nil, nil
+}
+
+func (wc *warningCollector) take() []string {
+	wc.mu.Lock()
+	defer wc.mu.Unlock()
+
+	warnings := wc.warnings
+	wc.warnings = nil
+	return warnings
+}
+
+func (wc *warningCollector) flush() error {
+	warnings := wc.take()
+	if len(warnings) == 0 {
+		return nil
+	}
+
+	if _, err := fmt.Fprintln(wc.out, "Warnings:"); err != nil {
+		return err
+	}
+	for _, w := range warnings {
+		if _, err := fmt.Fprintf(wc.out, "  * %s\n", w); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// TakeWarnings returns the warnings collected so far and removes them, so
+// they won't be printed by FlushWarnings. Commands that report warnings
+// themselves, e.g. in JSON output, use this. It always returns a non-nil
+// slice.
+func TakeWarnings() []string {
+	warnings := []string{}
+	if wc, ok := logrus.StandardLogger().Formatter.(*warningCollector); ok {
+		warnings = append(warnings, wc.take()...)
+	}
+
+	return warnings
+}
+
+// FlushWarnings prints the warnings collected since the logger was
+// initialized as a grouped summary.
+func FlushWarnings() error {
+	wc, ok := logrus.StandardLogger().Formatter.(*warningCollector)
+	if !ok {
+		return nil
+	}
+
+	return wc.flush()
+}
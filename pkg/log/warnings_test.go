@@ -0,0 +1,79 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func withLogger(t *testing.T, fn func(buf *bytes.Buffer)) {
+	ogOut := logrus.StandardLogger().Out
+	ogFormatter := logrus.StandardLogger().Formatter
+	ogLevel := logrus.GetLevel()
+	defer func() {
+		logrus.SetOutput(ogOut)
+		logrus.SetFormatter(ogFormatter)
+		logrus.SetLevel(ogLevel)
+	}()
+
+	var buf bytes.Buffer
+	Init(0, &buf)
+
+	fn(&buf)
+}
+
+func TestFlushWarnings(t *testing.T) {
+	withLogger(t, func(buf *bytes.Buffer) {
+		logrus.Warn("first warning")
+		logrus.Info("info")
+		logrus.Warn("second warning")
+		logrus.Warn("first warning")
+
+		assert.Equal(t, "level=info msg=info\n", buf.String())
+
+		err := FlushWarnings()
+		require.NoError(t, err)
+
+		expected := "level=info msg=info\n" +
+			"Warnings:\n" +
+			"  * first warning\n" +
+			"  * second warning\n"
+		assert.Equal(t, expected, buf.String())
+
+		buf.Reset()
+		err = FlushWarnings()
+		require.NoError(t, err)
+		assert.Empty(t, buf.String())
+	})
+}
+
+func TestTakeWarnings(t *testing.T) {
+	withLogger(t, func(buf *bytes.Buffer) {
+		assert.Equal(t, []string{}, TakeWarnings())
+
+		logrus.Warn("warning")
+		assert.Equal(t, []string{"warning"}, TakeWarnings())
+
+		err := FlushWarnings()
+		require.NoError(t, err)
+		assert.Empty(t, buf.String())
+	})
+}
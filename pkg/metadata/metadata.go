@@ -36,6 +36,10 @@ const (
 	// created from.
 	LabelComponent = "ksonnet.io/component"
 
+	// LabelNamePrefix label contains the name prefix of the environment an
+	// object was rendered for.
+	LabelNamePrefix = "ksonnet.io/name-prefix"
+
 	// GcStrategyAuto is the default automatic gc logic
 	GcStrategyAuto = "auto"
 	// GcStrategyIgnore means this object should be ignored by garbage collection
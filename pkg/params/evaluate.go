@@ -16,17 +16,19 @@
 package params
 
 import (
+	"fmt"
 	"path/filepath"
 
 	"github.com/ksonnet/ksonnet/pkg/app"
 	"github.com/ksonnet/ksonnet/pkg/util/jsonnet"
 	"github.com/pkg/errors"
-	"github.com/spf13/afero"
 )
 
 // EvaluateEnv evaluates environment parameters.
 func EvaluateEnv(a app.App, sourcePath, paramsStr, envName, moduleName string) (string, error) {
-	snippet, err := afero.ReadFile(a.Fs(), sourcePath)
+	envDir := filepath.Dir(sourcePath)
+
+	snippet, err := app.ReadEnvironmentParams(a.Fs(), envDir)
 	if err != nil {
 		return "", err
 	}
@@ -36,9 +38,12 @@ func EvaluateEnv(a app.App, sourcePath, paramsStr, envName, moduleName string) (
 		return "", errors.Wrap(err, "modularizing parameters")
 	}
 
-	envDir := filepath.Dir(sourcePath)
+	paramsStr, err = Inherit(a, envName, paramsStr)
+	if err != nil {
+		return "", err
+	}
 
-	moduleParams, err := BuildEnvParamsForModule(moduleName, string(snippet), paramsStr, envDir)
+	moduleParams, err := BuildEnvParamsForModule(moduleName, snippet, paramsStr, envDir)
 	if err != nil {
 		return "", errors.Wrapf(err, "selecting params for module %q in environment %q", moduleName, envName)
 	}
@@ -51,6 +56,50 @@ func EvaluateEnv(a app.App, sourcePath, paramsStr, envName, moduleName string) (
 	return envParams, nil
 }
 
+// Inherit applies the parameters of the environments an environment inherits
+// from to the component parameters in paramsStr, so the environment's own
+// parameters are applied on top of them. The environment furthest up the
+// chain is applied first.
+func Inherit(a app.App, envName, paramsStr string) (string, error) {
+	env, err := a.Environment(envName)
+	if err != nil {
+		return "", err
+	}
+
+	if env.Inherits == "" {
+		return paramsStr, nil
+	}
+
+	environments, err := a.Environments()
+	if err != nil {
+		return "", err
+	}
+
+	chain, err := environments.InheritanceChain(envName)
+	if err != nil {
+		return "", err
+	}
+
+	for i := len(chain) - 1; i >= 0; i-- {
+		parentDir := environments[chain[i]].MakePath(a.Root())
+
+		snippet, err := app.ReadEnvironmentParams(a.Fs(), parentDir)
+		if err != nil {
+			return "", err
+		}
+
+		sourcePath := filepath.Join(parentDir, app.EnvironmentParamsFile)
+		parentParams, err := evaluateEnvInVM(a, envName, sourcePath, snippet, paramsStr)
+		if err != nil {
+			return "", errors.Wrapf(err, "evaluating parameters of environment %q inherited by %q", chain[i], envName)
+		}
+
+		paramsStr = fmt.Sprintf("(%s) + { components+: (%s).components }", paramsStr, parentParams)
+	}
+
+	return paramsStr, nil
+}
+
 // modularizeParameters adds a module prefix to component parameters.
 // * Given a root module, it will not update the component name
 // * Given a module nested under root, it will prepend the module: eg: `module apps -> apps.component`
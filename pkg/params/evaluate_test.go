@@ -28,27 +28,119 @@ import (
 )
 
 func TestEvaluateEnv(t *testing.T) {
+	cases := []struct {
+		name         string
+		libsonnet    bool
+		json         bool
+		expectedFile string
+		isErr        bool
+	}{
+		{
+			name:         "params.libsonnet",
+			libsonnet:    true,
+			expectedFile: "expected.libsonnet",
+		},
+		{
+			name:         "params.libsonnet and params.json",
+			libsonnet:    true,
+			json:         true,
+			expectedFile: "expected_merged.libsonnet",
+		},
+		{
+			name:         "params.json",
+			json:         true,
+			expectedFile: "expected_json.libsonnet",
+		},
+		{
+			name:  "no params",
+			isErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			test.WithApp(t, "/app", func(a *mocks.App, fs afero.Fs) {
+				envConfig := &app.EnvironmentConfig{
+					Destination: &app.EnvironmentDestinationSpec{
+						Namespace: "default",
+						Server:    "http://example.com",
+					},
+				}
+				a.On("Environment", "default").Return(envConfig, nil)
+
+				sourcePath := "/app/environments/default/params.libsonnet"
+				paramsStr := test.ReadTestData(t, filepath.Join("evaluate_env", "component_params.libsonnet"))
+				envName := "default"
+				moduleName := "app.project-1"
+
+				if tc.libsonnet {
+					test.StageFile(t, fs, filepath.Join("evaluate_env", "env_params.libsonnet"), sourcePath)
+				}
+				if tc.json {
+					test.StageFile(t, fs, filepath.Join("evaluate_env", "env_params.json"), "/app/environments/default/params.json")
+				}
+
+				got, err := EvaluateEnv(a, sourcePath, paramsStr, envName, moduleName)
+				if tc.isErr {
+					require.Error(t, err)
+					return
+				}
+				require.NoError(t, err)
+
+				expected := test.ReadTestData(t, filepath.Join("evaluate_env", tc.expectedFile))
+
+				assert.Equal(t, expected, got)
+			})
+		})
+	}
+}
+
+func TestEvaluateEnv_inherits(t *testing.T) {
 	test.WithApp(t, "/app", func(a *mocks.App, fs afero.Fs) {
-		envConfig := &app.EnvironmentConfig{
-			Destination: &app.EnvironmentDestinationSpec{
-				Namespace: "default",
-				Server:    "http://example.com",
-			},
+		destination := &app.EnvironmentDestinationSpec{
+			Namespace: "default",
+			Server:    "http://example.com",
 		}
-		a.On("Environment", "default").Return(envConfig, nil)
+		environments := app.EnvironmentConfigs{
+			"default": &app.EnvironmentConfig{Name: "default", Path: "default", Destination: destination, Inherits: "base"},
+			"base":    &app.EnvironmentConfig{Name: "base", Path: "base", Destination: destination},
+		}
+		a.On("Environment", "default").Return(environments["default"], nil)
+		a.On("Environments").Return(environments, nil)
 
 		sourcePath := "/app/environments/default/params.libsonnet"
 		paramsStr := test.ReadTestData(t, filepath.Join("evaluate_env", "component_params.libsonnet"))
-		envName := "default"
-		moduleName := "app.project-1"
 
 		test.StageFile(t, fs, filepath.Join("evaluate_env", "env_params.libsonnet"), sourcePath)
+		test.StageFile(t, fs, filepath.Join("evaluate_env", "base_params.libsonnet"), "/app/environments/base/params.libsonnet")
 
-		got, err := EvaluateEnv(a, sourcePath, paramsStr, envName, moduleName)
+		got, err := EvaluateEnv(a, sourcePath, paramsStr, "default", "app.project-1")
 		require.NoError(t, err)
 
-		expected := test.ReadTestData(t, filepath.Join("evaluate_env", "expected.libsonnet"))
+		expected := test.ReadTestData(t, filepath.Join("evaluate_env", "expected_inherited.libsonnet"))
 
 		assert.Equal(t, expected, got)
 	})
 }
+
+func TestEvaluateEnv_inheritance_cycle(t *testing.T) {
+	test.WithApp(t, "/app", func(a *mocks.App, fs afero.Fs) {
+		destination := &app.EnvironmentDestinationSpec{
+			Namespace: "default",
+			Server:    "http://example.com",
+		}
+		environments := app.EnvironmentConfigs{
+			"default": &app.EnvironmentConfig{Name: "default", Path: "default", Destination: destination, Inherits: "base"},
+			"base":    &app.EnvironmentConfig{Name: "base", Path: "base", Destination: destination, Inherits: "default"},
+		}
+		a.On("Environment", "default").Return(environments["default"], nil)
+		a.On("Environments").Return(environments, nil)
+
+		sourcePath := "/app/environments/default/params.libsonnet"
+		paramsStr := test.ReadTestData(t, filepath.Join("evaluate_env", "component_params.libsonnet"))
+		test.StageFile(t, fs, filepath.Join("evaluate_env", "env_params.libsonnet"), sourcePath)
+
+		_, err := EvaluateEnv(a, sourcePath, paramsStr, "default", "app.project-1")
+		require.EqualError(t, err, "environments inherit from each other: default -> base -> default")
+	})
+}
@@ -0,0 +1,8 @@
+{
+   "components": {
+      "ds": {
+         "name": "base-name",
+         "replicas": 3
+      }
+   }
+}
@@ -0,0 +1,8 @@
+{
+   "components": {
+      "ds": {
+         "name": "json-name",
+         "replicas": 3
+      }
+   }
+}
@@ -0,0 +1,130 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package pipeline
+
+import (
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// replicatedKinds are kinds whose replica count is set by spec.replicas.
+var replicatedKinds = map[string]bool{
+	"Deployment":            true,
+	"ReplicaSet":            true,
+	"ReplicationController": true,
+	"StatefulSet":           true,
+}
+
+// applyDefaults sets the environment's default replica count on workloads and
+// its default bounds on horizontal pod autoscalers. Values set by components
+// are left alone, as are the replicas of workloads scaled by an autoscaler.
+// A default bound isn't set if it would cross a bound set by the component.
+func applyDefaults(env *app.EnvironmentConfig, objects []*unstructured.Unstructured) ([]*unstructured.Unstructured, error) {
+	if env == nil || env.Defaults == nil {
+		return objects, nil
+	}
+
+	d := env.Defaults
+	scaled := autoscaledObjects(objects)
+
+	for _, obj := range objects {
+		kind := obj.GetKind()
+
+		switch {
+		case replicatedKinds[kind]:
+			if scaled[objectKey(kind, obj.GetNamespace(), obj.GetName())] {
+				continue
+			}
+			if err := setDefault(obj, d.Replicas, "spec", "replicas"); err != nil {
+				return nil, err
+			}
+		case kind == "HorizontalPodAutoscaler":
+			if max, ok := nestedInt64(obj.Object, "spec", "maxReplicas"); !ok || d.HPAMinReplicas <= max {
+				if err := setDefault(obj, d.HPAMinReplicas, "spec", "minReplicas"); err != nil {
+					return nil, err
+				}
+			}
+			if min, ok := nestedInt64(obj.Object, "spec", "minReplicas"); !ok || d.HPAMaxReplicas >= min {
+				if err := setDefault(obj, d.HPAMaxReplicas, "spec", "maxReplicas"); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	return objects, nil
+}
+
+// autoscaledObjects returns the keys of the objects that are the scale
+// targets of horizontal pod autoscalers. A target is in the namespace of its
+// autoscaler.
+func autoscaledObjects(objects []*unstructured.Unstructured) map[string]bool {
+	scaled := make(map[string]bool)
+	for _, obj := range objects {
+		if obj.GetKind() != "HorizontalPodAutoscaler" {
+			continue
+		}
+
+		target, ok := nestedMapNoCopy(obj.Object, "spec", "scaleTargetRef")
+		if !ok {
+			continue
+		}
+
+		kind, _ := target["kind"].(string)
+		name, _ := target["name"].(string)
+		scaled[objectKey(kind, obj.GetNamespace(), name)] = true
+	}
+
+	return scaled
+}
+
+func objectKey(kind, namespace, name string) string {
+	return kind + "/" + namespace + "/" + name
+}
+
+// nestedInt64 returns the integer at a path in obj.
+func nestedInt64(obj map[string]interface{}, fields ...string) (int64, bool) {
+	v, ok, _ := unstructured.NestedFieldCopy(obj, fields...)
+	if !ok {
+		return 0, false
+	}
+
+	switch n := v.(type) {
+	case int64:
+		return n, true
+	case int:
+		return int64(n), true
+	case float64:
+		return int64(n), true
+	}
+
+	return 0, false
+}
+
+// setDefault sets the field at a path in obj to value, unless the field is
+// already set or value is zero. SetNestedField fails if a parent of the field
+// isn't a map.
+func setDefault(obj *unstructured.Unstructured, value int64, fields ...string) error {
+	if value == 0 {
+		return nil
+	}
+
+	if v, ok, _ := unstructured.NestedFieldCopy(obj.Object, fields...); ok && v != nil {
+		return nil
+	}
+
+	return unstructured.SetNestedField(obj.Object, value, fields...)
+}
@@ -0,0 +1,57 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package pipeline
+
+import (
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_applyDefaults(t *testing.T) {
+	cases := []struct {
+		name     string
+		defaults *app.EnvironmentDefaults
+		expected string
+	}{
+		{
+			name: "with defaults",
+			defaults: &app.EnvironmentDefaults{
+				Replicas:       3,
+				HPAMinReplicas: 3,
+				HPAMaxReplicas: 10,
+			},
+			expected: "defaults/expected.yaml",
+		},
+		{
+			name:     "without defaults",
+			expected: "defaults/objects.yaml",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			objects := readObjects(t, "defaults/objects.yaml")
+
+			env := &app.EnvironmentConfig{Defaults: tc.defaults}
+			got, err := applyDefaults(env, objects)
+			require.NoError(t, err)
+
+			assertObjects(t, tc.expected, got)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package pipeline
+
+import (
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// annotateIgnored sets the environment's ignore annotations on the objects
+// they select. Objects are matched by their name and labels before the
+// environment's name prefix is applied.
+func annotateIgnored(env *app.EnvironmentConfig, objects []*unstructured.Unstructured) ([]*unstructured.Unstructured, error) {
+	if env == nil || len(env.IgnoreAnnotations) == 0 {
+		return objects, nil
+	}
+
+	for _, ia := range env.IgnoreAnnotations {
+		selector, err := labels.Parse(ia.Selector)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parsing selector of ignore annotation %q", ia.Key)
+		}
+
+		for _, obj := range objects {
+			if ia.Kind != "" && obj.GetKind() != ia.Kind {
+				continue
+			}
+			if ia.Name != "" && obj.GetName() != ia.Name {
+				continue
+			}
+			if !selector.Matches(labels.Set(obj.GetLabels())) {
+				continue
+			}
+
+			annotations := obj.GetAnnotations()
+			if annotations == nil {
+				annotations = make(map[string]string)
+			}
+			annotations[ia.Key] = ia.Value
+			obj.SetAnnotations(annotations)
+		}
+	}
+
+	return objects, nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package pipeline
+
+import (
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_annotateIgnored(t *testing.T) {
+	cases := []struct {
+		name              string
+		ignoreAnnotations []app.EnvironmentIgnoreAnnotation
+		expected          string
+		isErr             bool
+	}{
+		{
+			name:     "without ignore annotations",
+			expected: "ignore-annotations/objects.yaml",
+		},
+		{
+			name: "all objects",
+			ignoreAnnotations: []app.EnvironmentIgnoreAnnotation{
+				{Key: "argocd.argoproj.io/sync-options", Value: "Prune=false"},
+			},
+			expected: "ignore-annotations/all.yaml",
+		},
+		{
+			name: "by kind, name, and selector",
+			ignoreAnnotations: []app.EnvironmentIgnoreAnnotation{
+				{Key: "argocd.argoproj.io/compare-options", Value: "IgnoreExtraneous", Kind: "ConfigMap"},
+				{Key: "fluxcd.io/ignore", Value: "true", Selector: "tier=frontend"},
+				{Key: "argocd.argoproj.io/sync-options", Value: "Prune=false", Kind: "Deployment", Name: "backend"},
+				{Key: "unused", Value: "true", Kind: "Deployment", Name: "frontend"},
+			},
+			expected: "ignore-annotations/selected.yaml",
+		},
+		{
+			name: "invalid selector",
+			ignoreAnnotations: []app.EnvironmentIgnoreAnnotation{
+				{Key: "fluxcd.io/ignore", Value: "true", Selector: "tier in (frontend"},
+			},
+			isErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			objects := readObjects(t, "ignore-annotations/objects.yaml")
+
+			env := &app.EnvironmentConfig{IgnoreAnnotations: tc.ignoreAnnotations}
+			got, err := annotateIgnored(env, objects)
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assertObjects(t, tc.expected, got)
+		})
+	}
+}
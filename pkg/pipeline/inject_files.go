@@ -0,0 +1,75 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package pipeline
+
+import (
+	"encoding/base64"
+	"path/filepath"
+	"strings"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// fileDataFields are the fields of ConfigMaps and Secrets whose values can
+// reference files, and whether their values are base64 encoded.
+var fileDataFields = map[string]map[string]bool{
+	"ConfigMap": {"data": false, "binaryData": true},
+	"Secret":    {"stringData": false, "data": true},
+}
+
+// isFileReference returns true if a value is a reference to a file relative
+// to the environment directory, in the form @./<path> or @../<path>.
+func isFileReference(value string) bool {
+	return strings.HasPrefix(value, "@./") || strings.HasPrefix(value, "@../")
+}
+
+// injectFiles replaces values of ConfigMaps and Secrets that reference files
+// with the contents of the files. Paths are relative to the environment's
+// directory, so each environment can provide its own copy of a file.
+func injectFiles(a app.App, env *app.EnvironmentConfig, objects []*unstructured.Unstructured) error {
+
+	for _, obj := range objects {
+		for field, encoded := range fileDataFields[obj.GetKind()] {
+			data, ok := nestedMapNoCopy(obj.Object, field)
+			if !ok {
+				continue
+			}
+
+			for key, v := range data {
+				value, ok := v.(string)
+				if !ok || !isFileReference(value) {
+					continue
+				}
+
+				contents, err := afero.ReadFile(a.Fs(), filepath.Join(a.Root(), app.EnvironmentDirName, env.Path, value[1:]))
+				if err != nil {
+					return errors.Wrapf(err, "reading file for key %q of %s %s", key, obj.GetKind(), obj.GetName())
+				}
+
+				if encoded {
+					data[key] = base64.StdEncoding.EncodeToString(contents)
+				} else {
+					data[key] = string(contents)
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package pipeline
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/ksonnet/ksonnet/pkg/app/mocks"
+	"github.com/ksonnet/ksonnet/pkg/util/test"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func Test_injectFiles(t *testing.T) {
+	test.WithApp(t, "/app", func(appMock *mocks.App, fs afero.Fs) {
+		envDir := filepath.Join("/app", app.EnvironmentDirName, "prod")
+		require.NoError(t, afero.WriteFile(fs, filepath.Join(envDir, "nginx.conf"), []byte("server {}\n"), 0644))
+		require.NoError(t, afero.WriteFile(fs, filepath.Join("/app", "shared.txt"), []byte("shared"), 0644))
+
+		configMap := &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata":   map[string]interface{}{"name": "nginx"},
+				"data": map[string]interface{}{
+					"nginx.conf": "@./nginx.conf",
+					"shared.txt": "@../../shared.txt",
+					"handle":     "@nginx",
+				},
+				"binaryData": map[string]interface{}{
+					"shared.bin": "@../../shared.txt",
+				},
+			},
+		}
+
+		secret := &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Secret",
+				"metadata":   map[string]interface{}{"name": "nginx"},
+				"stringData": map[string]interface{}{"plain": "@../../shared.txt"},
+				"data":       map[string]interface{}{"encoded": "@../../shared.txt"},
+			},
+		}
+
+		deployment := &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata":   map[string]interface{}{"name": "nginx"},
+				"data":       map[string]interface{}{"ignored": "@./nginx.conf"},
+			},
+		}
+
+		env := &app.EnvironmentConfig{Name: "prod", Path: "prod"}
+		err := injectFiles(appMock, env, []*unstructured.Unstructured{configMap, secret, deployment})
+		require.NoError(t, err)
+
+		require.Equal(t, map[string]interface{}{
+			"nginx.conf": "server {}\n",
+			"shared.txt": "shared",
+			"handle":     "@nginx",
+		}, configMap.Object["data"])
+		require.Equal(t, map[string]interface{}{"shared.bin": "c2hhcmVk"}, configMap.Object["binaryData"])
+		require.Equal(t, map[string]interface{}{"plain": "shared"}, secret.Object["stringData"])
+		require.Equal(t, map[string]interface{}{"encoded": "c2hhcmVk"}, secret.Object["data"])
+		require.Equal(t, map[string]interface{}{"ignored": "@./nginx.conf"}, deployment.Object["data"])
+	})
+}
+
+func Test_injectFiles_missing_file(t *testing.T) {
+	test.WithApp(t, "/app", func(appMock *mocks.App, fs afero.Fs) {
+		configMap := &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata":   map[string]interface{}{"name": "nginx"},
+				"data":       map[string]interface{}{"nginx.conf": "@./nginx.conf"},
+			},
+		}
+
+		env := &app.EnvironmentConfig{Name: "prod", Path: "prod"}
+		err := injectFiles(appMock, env, []*unstructured.Unstructured{configMap})
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,167 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package pipeline
+
+import (
+	"strconv"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// disruptableKinds are kinds that get a pod disruption budget when their
+// environment has a default one.
+var disruptableKinds = map[string]bool{
+	"Deployment":  true,
+	"StatefulSet": true,
+}
+
+// injectPodDisruptionBudgets adds a pod disruption budget with the
+// environment's default policy for each deployment and stateful set. Workloads
+// that already have a pod disruption budget, one with their name or one
+// selecting their pods in the same namespace, are left alone.
+func injectPodDisruptionBudgets(env *app.EnvironmentConfig, objects []*unstructured.Unstructured) ([]*unstructured.Unstructured, error) {
+	if env == nil || env.Defaults == nil {
+		return objects, nil
+	}
+
+	d := env.Defaults
+	if d.PDBMinAvailable == "" && d.PDBMaxUnavailable == "" {
+		return objects, nil
+	}
+
+	var budgets []*unstructured.Unstructured
+	for _, obj := range objects {
+		if obj.GetKind() == "PodDisruptionBudget" {
+			budgets = append(budgets, obj)
+		}
+	}
+
+	var generated []*unstructured.Unstructured
+	for _, obj := range objects {
+		if !disruptableKinds[obj.GetKind()] {
+			continue
+		}
+
+		podLabels, _, _ := unstructured.NestedStringMap(obj.Object, "spec", "template", "metadata", "labels")
+
+		covered, err := hasPodDisruptionBudget(obj, podLabels, budgets)
+		if err != nil {
+			return nil, err
+		}
+		if covered {
+			continue
+		}
+
+		selector, ok, _ := unstructured.NestedFieldCopy(obj.Object, "spec", "selector")
+		if !ok || selector == nil {
+			// Without a selector, the workload selects the labels of its pods.
+			if len(podLabels) == 0 {
+				continue
+			}
+			matchLabels := make(map[string]interface{})
+			for k, v := range podLabels {
+				matchLabels[k] = v
+			}
+			selector = map[string]interface{}{"matchLabels": matchLabels}
+		}
+
+		pdb := newPodDisruptionBudget(obj, selector, d)
+		budgets = append(budgets, pdb)
+		generated = append(generated, pdb)
+	}
+
+	return append(objects, generated...), nil
+}
+
+// hasPodDisruptionBudget returns true if a budget in the namespace of obj has
+// its name or selects pods with podLabels.
+func hasPodDisruptionBudget(obj *unstructured.Unstructured, podLabels map[string]string, budgets []*unstructured.Unstructured) (bool, error) {
+	for _, pdb := range budgets {
+		if pdb.GetNamespace() != obj.GetNamespace() {
+			continue
+		}
+
+		if pdb.GetName() == obj.GetName() {
+			return true, nil
+		}
+
+		m, ok := nestedMapNoCopy(pdb.Object, "spec", "selector")
+		if !ok {
+			continue
+		}
+
+		var ls metav1.LabelSelector
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, &ls); err != nil {
+			return false, errors.Wrapf(err, "reading selector of pod disruption budget %q", pdb.GetName())
+		}
+
+		selector, err := metav1.LabelSelectorAsSelector(&ls)
+		if err != nil {
+			return false, errors.Wrapf(err, "reading selector of pod disruption budget %q", pdb.GetName())
+		}
+
+		// An empty selector selects no pods in policy/v1beta1.
+		if !selector.Empty() && selector.Matches(labels.Set(podLabels)) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// newPodDisruptionBudget creates a pod disruption budget with the policy of
+// defaults for the pods of obj.
+func newPodDisruptionBudget(obj *unstructured.Unstructured, selector interface{}, d *app.EnvironmentDefaults) *unstructured.Unstructured {
+	spec := map[string]interface{}{
+		"selector": selector,
+	}
+	if d.PDBMinAvailable != "" {
+		spec["minAvailable"] = intOrString(d.PDBMinAvailable)
+	} else {
+		spec["maxUnavailable"] = intOrString(d.PDBMaxUnavailable)
+	}
+
+	pdb := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "policy/v1beta1",
+			"kind":       "PodDisruptionBudget",
+			"spec":       spec,
+		},
+	}
+	pdb.SetName(obj.GetName())
+	if ns := obj.GetNamespace(); ns != "" {
+		pdb.SetNamespace(ns)
+	}
+	if l := obj.GetLabels(); len(l) > 0 {
+		pdb.SetLabels(l)
+	}
+
+	return pdb
+}
+
+// intOrString returns a count as an integer, and a percentage as a string.
+func intOrString(s string) interface{} {
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return n
+	}
+
+	return s
+}
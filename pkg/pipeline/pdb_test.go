@@ -0,0 +1,59 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package pipeline
+
+import (
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_injectPodDisruptionBudgets(t *testing.T) {
+	cases := []struct {
+		name     string
+		defaults *app.EnvironmentDefaults
+		expected string
+	}{
+		{
+			name:     "with min available",
+			defaults: &app.EnvironmentDefaults{PDBMinAvailable: "1"},
+			expected: "pdb/min-available.yaml",
+		},
+		{
+			name:     "with max unavailable",
+			defaults: &app.EnvironmentDefaults{PDBMaxUnavailable: "25%"},
+			expected: "pdb/max-unavailable.yaml",
+		},
+		{
+			name:     "without default",
+			defaults: &app.EnvironmentDefaults{Replicas: 3},
+			expected: "pdb/objects.yaml",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			objects := readObjects(t, "pdb/objects.yaml")
+
+			env := &app.EnvironmentConfig{Defaults: tc.defaults}
+			got, err := injectPodDisruptionBudgets(env, objects)
+			require.NoError(t, err)
+
+			assertObjects(t, tc.expected, got)
+		})
+	}
+}
@@ -131,7 +131,7 @@ func (p *Pipeline) moduleParams(module component.Module, inherited bool) (string
 		return "", errors.Wrapf(err, "resolve params for %s", module.Name())
 	}
 
-	return paramsStr, nil
+	return params.Inherit(p.app, p.envName, paramsStr)
 }
 
 func stubModule(module component.Module) (string, error) {
@@ -158,13 +158,20 @@ func stubModule(module component.Module) (string, error) {
 	return string(data), nil
 }
 
-// Components returns the components that belong to this pipeline.
+// Components returns the components that belong to this pipeline. Components
+// outside of the environment's scope are never returned, and filter can only
+// narrow the components further.
 func (p *Pipeline) Components(filter []string) ([]component.Component, error) {
 	modules, err := p.Modules()
 	if err != nil {
 		return nil, err
 	}
 
+	env, err := p.app.Environment(p.envName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "load environment %s", p.envName)
+	}
+
 	components := make([]component.Component, 0)
 	for _, m := range modules {
 		members, err := p.cm.Components(p.app, m.Name())
@@ -173,6 +180,7 @@ func (p *Pipeline) Components(filter []string) ([]component.Component, error) {
 		}
 
 		members = filterComponents(filter, members)
+		members = scopeComponents(env, members)
 		components = append(components, members...)
 	}
 
@@ -184,7 +192,7 @@ func (p *Pipeline) Objects(filter []string) ([]*unstructured.Unstructured, error
 	return p.buildObjectsFn(p, filter)
 }
 
-func (p *Pipeline) moduleObjects(module component.Module, filter []string) ([]*unstructured.Unstructured, error) {
+func (p *Pipeline) moduleObjects(envConfig *app.EnvironmentConfig, module component.Module, filter []string) ([]*unstructured.Unstructured, error) {
 	doc := &astext.Object{}
 
 	object, componentMap, err := module.Render(p.envName, filter...)
@@ -234,6 +242,10 @@ func (p *Pipeline) moduleObjects(module component.Module, filter []string) ([]*u
 			continue
 		}
 
+		if !envConfig.IncludesComponent(componentName) {
+			continue
+		}
+
 		componentObject, ok := v.(map[string]interface{})
 		if !ok {
 			return nil, errors.Errorf("component %q is not an object", componentName)
@@ -304,6 +316,22 @@ func filterComponents(filter []string, components []component.Component) []compo
 	return out
 }
 
+// scopeComponents removes the components outside of an environment's scope.
+func scopeComponents(env *app.EnvironmentConfig, components []component.Component) []component.Component {
+	if len(env.IncludeComponents) == 0 && len(env.ExcludeComponents) == 0 {
+		return components
+	}
+
+	var out []component.Component
+	for _, c := range components {
+		if env.IncludesComponent(c.Name(true)) {
+			out = append(out, c)
+		}
+	}
+
+	return out
+}
+
 var (
 	reParamSwap = regexp.MustCompile(`(?m)import "\.\.\/\.\.\/components\/params\.libsonnet"`)
 )
@@ -327,6 +355,11 @@ func buildObjects(p *Pipeline, filter []string) ([]*unstructured.Unstructured, e
 		return nil, errors.Wrap(err, "get modules")
 	}
 
+	env, err := p.app.Environment(p.envName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "load environment %s", p.envName)
+	}
+
 	var ret []*unstructured.Unstructured
 
 	for _, m := range modules {
@@ -335,7 +368,7 @@ func buildObjects(p *Pipeline, filter []string) ([]*unstructured.Unstructured, e
 			"module-name": m.Name(),
 		}).Debug("building objects")
 
-		objects, err := p.moduleObjects(m, filter)
+		objects, err := p.moduleObjects(env, m, filter)
 		if err != nil {
 			return nil, err
 		}
@@ -343,7 +376,11 @@ func buildObjects(p *Pipeline, filter []string) ([]*unstructured.Unstructured, e
 		ret = append(ret, objects...)
 	}
 
-	return ret, nil
+	if err := injectFiles(p.app, env, ret); err != nil {
+		return nil, err
+	}
+
+	return transformObjects(env, ret)
 }
 
 func labelComponents(m map[string]interface{}, name string) {
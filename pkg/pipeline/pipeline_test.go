@@ -101,6 +101,9 @@ func TestPipeline_Components(t *testing.T) {
 		a.On("EnvironmentParams", "default").Return("{}", nil)
 		m.On("Components", p.app, "/").Return(components, nil)
 
+		env := &app.EnvironmentConfig{Path: "default"}
+		a.On("Environment", "default").Return(env, nil)
+
 		got, err := p.Components(nil)
 		require.NoError(t, err)
 
@@ -128,6 +131,9 @@ func TestPipeline_Components_filtered(t *testing.T) {
 		a.On("EnvironmentParams", "default").Return("{}", nil)
 		m.On("Components", p.app, "/").Return(components, nil)
 
+		env := &app.EnvironmentConfig{Path: "default"}
+		a.On("Environment", "default").Return(env, nil)
+
 		got, err := p.Components([]string{"cpnt1"})
 		require.NoError(t, err)
 
@@ -137,6 +143,77 @@ func TestPipeline_Components_filtered(t *testing.T) {
 	})
 }
 
+func TestPipeline_Components_environment_scope(t *testing.T) {
+	cases := []struct {
+		name     string
+		include  []string
+		exclude  []string
+		filter   []string
+		expected []string
+	}{
+		{
+			name:     "included",
+			include:  []string{"web", "worker"},
+			expected: []string{"web", "worker"},
+		},
+		{
+			name:     "excluded",
+			exclude:  []string{"debug"},
+			expected: []string{"web", "worker"},
+		},
+		{
+			name:     "excluded wins over included",
+			include:  []string{"web", "debug"},
+			exclude:  []string{"debug"},
+			expected: []string{"web"},
+		},
+		{
+			name:     "filter narrows the scope",
+			include:  []string{"web", "worker"},
+			filter:   []string{"web"},
+			expected: []string{"web"},
+		},
+		{
+			name:    "filter can't widen the scope",
+			exclude: []string{"debug"},
+			filter:  []string{"debug"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			withPipeline(t, func(p *Pipeline, m *cmocks.Manager, a *appmocks.App) {
+				components := []component.Component{
+					mockComponent("debug"),
+					mockComponent("web"),
+					mockComponent("worker"),
+				}
+
+				module := component.NewModule(p.app, "/")
+				m.On("Modules", p.app, "default").Return([]component.Module{module}, nil)
+				m.On("Components", p.app, "/").Return(components, nil)
+
+				env := &app.EnvironmentConfig{
+					Path:              "default",
+					IncludeComponents: tc.include,
+					ExcludeComponents: tc.exclude,
+				}
+				a.On("Environment", "default").Return(env, nil)
+
+				got, err := p.Components(tc.filter)
+				require.NoError(t, err)
+
+				var names []string
+				for _, c := range got {
+					names = append(names, c.Name(true))
+				}
+
+				require.Equal(t, tc.expected, names)
+			})
+		})
+	}
+}
+
 func TestPipeline_Objects(t *testing.T) {
 	withPipeline(t, func(p *Pipeline, m *cmocks.Manager, a *appmocks.App) {
 		u := []*unstructured.Unstructured{
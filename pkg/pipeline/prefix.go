@@ -0,0 +1,236 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package pipeline
+
+import (
+	"strings"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	clustermetadata "github.com/ksonnet/ksonnet/pkg/metadata"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// unprefixedKinds are kinds whose names have a meaning to Kubernetes, so they
+// can't be prefixed.
+var unprefixedKinds = map[string]bool{
+	"APIService":               true,
+	"CustomResourceDefinition": true,
+	"Namespace":                true,
+}
+
+// namePrefixer prefixes the names of objects and rewrites references between
+// the objects so they point at the prefixed names.
+type namePrefixer struct {
+	prefix string
+	label  string
+	// renamed contains the original names of the renamed objects by kind.
+	renamed map[string]map[string]bool
+}
+
+// prefixNames prepends the environment's name prefix to the names of objects.
+// References to objects in the same render, e.g. a ConfigMap mounted in a
+// Deployment, are updated to the new names. A label with the prefix is added
+// to selectors and pod templates, so instances of an app running in the same
+// namespace don't select each other's pods.
+func prefixNames(env *app.EnvironmentConfig, objects []*unstructured.Unstructured) ([]*unstructured.Unstructured, error) {
+	if env == nil || env.NamePrefix == "" {
+		return objects, nil
+	}
+
+	np := &namePrefixer{
+		prefix:  env.NamePrefix,
+		label:   strings.Trim(env.NamePrefix, "-."),
+		renamed: make(map[string]map[string]bool),
+	}
+
+	for _, obj := range objects {
+		kind := obj.GetKind()
+		if unprefixedKinds[kind] {
+			continue
+		}
+
+		if np.renamed[kind] == nil {
+			np.renamed[kind] = make(map[string]bool)
+		}
+		np.renamed[kind][obj.GetName()] = true
+	}
+
+	for _, obj := range objects {
+		kind := obj.GetKind()
+		if unprefixedKinds[kind] {
+			continue
+		}
+
+		np.rewriteReferences(obj.Object, kind)
+		obj.SetName(np.prefix + obj.GetName())
+
+		if np.label != "" {
+			np.addLabel(obj.Object, "metadata", "labels")
+		}
+	}
+
+	return objects, nil
+}
+
+// name returns the new name of a referenced object.
+func (np *namePrefixer) name(kind, name string) string {
+	if np.renamed[kind][name] {
+		return np.prefix + name
+	}
+
+	return name
+}
+
+// rewrite updates the name at a path in obj if it references a renamed object.
+func (np *namePrefixer) rewrite(obj map[string]interface{}, kind string, fields ...string) {
+	parent, ok := nestedMapNoCopy(obj, fields[:len(fields)-1]...)
+	if !ok {
+		return
+	}
+
+	field := fields[len(fields)-1]
+	if name, ok := parent[field].(string); ok {
+		parent[field] = np.name(kind, name)
+	}
+}
+
+// addLabel adds the prefix label to the label map at a path in obj. The map is
+// created if it doesn't exist.
+func (np *namePrefixer) addLabel(obj map[string]interface{}, fields ...string) {
+	labels, ok := nestedMapNoCopy(obj, fields...)
+	if !ok {
+		labels = map[string]interface{}{clustermetadata.LabelNamePrefix: np.label}
+		unstructured.SetNestedField(obj, labels, fields...)
+		return
+	}
+
+	labels[clustermetadata.LabelNamePrefix] = np.label
+}
+
+// addSelectorLabel adds the prefix label to an existing selector map.
+func (np *namePrefixer) addSelectorLabel(obj map[string]interface{}, fields ...string) {
+	if np.label == "" {
+		return
+	}
+
+	if selector, ok := nestedMapNoCopy(obj, fields...); ok && len(selector) > 0 {
+		selector[clustermetadata.LabelNamePrefix] = np.label
+	}
+}
+
+func (np *namePrefixer) rewriteReferences(obj map[string]interface{}, kind string) {
+	if path := podSpecPath(kind); path != nil {
+		if podSpec, ok := nestedMapNoCopy(obj, path...); ok {
+			np.rewritePodSpec(podSpec)
+		}
+
+		if kind != "Pod" {
+			np.rewritePodTemplate(obj, kind, path)
+		}
+	}
+
+	switch kind {
+	case "Service":
+		np.addSelectorLabel(obj, "spec", "selector")
+	case "StatefulSet":
+		np.rewrite(obj, "Service", "spec", "serviceName")
+	case "Ingress":
+		np.rewrite(obj, "Service", "spec", "backend", "serviceName")
+		for _, rule := range nestedMapsNoCopy(obj, "spec", "rules") {
+			for _, path := range nestedMapsNoCopy(rule, "http", "paths") {
+				np.rewrite(path, "Service", "backend", "serviceName")
+			}
+		}
+		for _, tls := range nestedMapsNoCopy(obj, "spec", "tls") {
+			np.rewrite(tls, "Secret", "secretName")
+		}
+	case "HorizontalPodAutoscaler":
+		if target, ok := nestedMapNoCopy(obj, "spec", "scaleTargetRef"); ok {
+			if targetKind, ok := target["kind"].(string); ok {
+				np.rewrite(target, targetKind, "name")
+			}
+		}
+	case "PodDisruptionBudget":
+		np.addSelectorLabel(obj, "spec", "selector", "matchLabels")
+	case "RoleBinding", "ClusterRoleBinding":
+		if roleRef, ok := nestedMapNoCopy(obj, "roleRef"); ok {
+			if roleKind, ok := roleRef["kind"].(string); ok {
+				np.rewrite(roleRef, roleKind, "name")
+			}
+		}
+		for _, subject := range nestedMapsNoCopy(obj, "subjects") {
+			if subject["kind"] == "ServiceAccount" {
+				np.rewrite(subject, "ServiceAccount", "name")
+			}
+		}
+	}
+}
+
+// rewritePodTemplate labels the pod template of a workload, and adds the
+// label to the workload's selector, if it has one.
+func (np *namePrefixer) rewritePodTemplate(obj map[string]interface{}, kind string, podSpecPath []string) {
+	if np.label == "" {
+		return
+	}
+
+	templatePath := podSpecPath[:len(podSpecPath)-1]
+	np.addLabel(obj, append(append([]string{}, templatePath...), "metadata", "labels")...)
+
+	// Job selectors are generated by Kubernetes.
+	if kind == "Job" || kind == "CronJob" {
+		return
+	}
+
+	selectorPath := append(append([]string{}, templatePath[:len(templatePath)-1]...), "selector")
+	if kind != "ReplicationController" {
+		selectorPath = append(selectorPath, "matchLabels")
+	}
+	np.addSelectorLabel(obj, selectorPath...)
+}
+
+func (np *namePrefixer) rewritePodSpec(podSpec map[string]interface{}) {
+	np.rewrite(podSpec, "ServiceAccount", "serviceAccountName")
+	np.rewrite(podSpec, "ServiceAccount", "serviceAccount")
+
+	for _, secret := range nestedMapsNoCopy(podSpec, "imagePullSecrets") {
+		np.rewrite(secret, "Secret", "name")
+	}
+
+	for _, volume := range nestedMapsNoCopy(podSpec, "volumes") {
+		np.rewrite(volume, "ConfigMap", "configMap", "name")
+		np.rewrite(volume, "Secret", "secret", "secretName")
+		np.rewrite(volume, "PersistentVolumeClaim", "persistentVolumeClaim", "claimName")
+
+		for _, source := range nestedMapsNoCopy(volume, "projected", "sources") {
+			np.rewrite(source, "ConfigMap", "configMap", "name")
+			np.rewrite(source, "Secret", "secret", "name")
+		}
+	}
+
+	for _, containerType := range []string{"initContainers", "containers"} {
+		for _, container := range nestedMapsNoCopy(podSpec, containerType) {
+			for _, envFrom := range nestedMapsNoCopy(container, "envFrom") {
+				np.rewrite(envFrom, "ConfigMap", "configMapRef", "name")
+				np.rewrite(envFrom, "Secret", "secretRef", "name")
+			}
+
+			for _, envVar := range nestedMapsNoCopy(container, "env") {
+				np.rewrite(envVar, "ConfigMap", "valueFrom", "configMapKeyRef", "name")
+				np.rewrite(envVar, "Secret", "valueFrom", "secretKeyRef", "name")
+			}
+		}
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package pipeline
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+func readObjects(t *testing.T, name string) []*unstructured.Unstructured {
+	f, err := os.Open(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	defer f.Close()
+
+	var objects []*unstructured.Unstructured
+
+	decoder := yaml.NewYAMLOrJSONDecoder(f, 4096)
+	for {
+		var m map[string]interface{}
+		err := decoder.Decode(&m)
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+
+		objects = append(objects, &unstructured.Unstructured{Object: m})
+	}
+
+	return objects
+}
+
+func assertObjects(t *testing.T, name string, objects []*unstructured.Unstructured) {
+	var buf bytes.Buffer
+	err := Fprint(&buf, objects, "yaml")
+	require.NoError(t, err)
+
+	expected, err := ioutil.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+
+	require.Equal(t, string(expected), buf.String())
+}
+
+func Test_prefixNames(t *testing.T) {
+	cases := []struct {
+		name     string
+		prefix   string
+		expected string
+	}{
+		{
+			name:     "with prefix",
+			prefix:   "staging-",
+			expected: "prefix/expected.yaml",
+		},
+		{
+			name:     "without prefix",
+			expected: "prefix/objects.yaml",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			objects := readObjects(t, "prefix/objects.yaml")
+
+			env := &app.EnvironmentConfig{NamePrefix: tc.prefix}
+			got, err := prefixNames(env, objects)
+			require.NoError(t, err)
+
+			assertObjects(t, tc.expected, got)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package pipeline
+
+import (
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// injectServiceAccount sets the environment's service account on the pod
+// specs of workloads. Pod specs that already name a service account are left
+// alone.
+func injectServiceAccount(env *app.EnvironmentConfig, objects []*unstructured.Unstructured) ([]*unstructured.Unstructured, error) {
+	if env == nil || env.ServiceAccount == "" {
+		return objects, nil
+	}
+
+	for _, obj := range objects {
+		path := podSpecPath(obj.GetKind())
+		if path == nil {
+			continue
+		}
+
+		if hasServiceAccount(obj, path) {
+			continue
+		}
+
+		fields := append(append([]string{}, path...), "serviceAccountName")
+		if err := unstructured.SetNestedField(obj.Object, env.ServiceAccount, fields...); err != nil {
+			return nil, err
+		}
+	}
+
+	return objects, nil
+}
+
+// hasServiceAccount returns true if the pod spec at path sets
+// serviceAccountName or its deprecated alias serviceAccount.
+func hasServiceAccount(obj *unstructured.Unstructured, path []string) bool {
+	for _, field := range []string{"serviceAccountName", "serviceAccount"} {
+		fields := append(append([]string{}, path...), field)
+		if v, ok, _ := unstructured.NestedString(obj.Object, fields...); ok && v != "" {
+			return true
+		}
+	}
+
+	return false
+}
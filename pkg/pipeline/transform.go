@@ -0,0 +1,104 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package pipeline
+
+import (
+	"github.com/ksonnet/ksonnet/pkg/app"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// objectTransformer modifies the objects rendered for an environment
+// according to the environment's configuration.
+type objectTransformer func(env *app.EnvironmentConfig, objects []*unstructured.Unstructured) ([]*unstructured.Unstructured, error)
+
+// objectTransformers are applied in order to the objects rendered for an
+// environment.
+var objectTransformers = []objectTransformer{
+	annotateIgnored,
+	prefixNames,
+	applyDefaults,
+	injectServiceAccount,
+	injectPodDisruptionBudgets,
+}
+
+func transformObjects(env *app.EnvironmentConfig, objects []*unstructured.Unstructured) ([]*unstructured.Unstructured, error) {
+	var err error
+	for _, fn := range objectTransformers {
+		objects, err = fn(env, objects)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return objects, nil
+}
+
+// podSpecPath returns the path to the pod spec of a workload object, or nil if
+// the object does not contain one.
+func podSpecPath(kind string) []string {
+	switch kind {
+	case "Pod":
+		return []string{"spec"}
+	case "Deployment", "DaemonSet", "StatefulSet", "ReplicaSet", "ReplicationController", "Job":
+		return []string{"spec", "template", "spec"}
+	case "CronJob":
+		return []string{"spec", "jobTemplate", "spec", "template", "spec"}
+	default:
+		return nil
+	}
+}
+
+// nestedMapsNoCopy returns the maps found in a slice at a path in obj. The
+// maps are not copied, so they can be modified in place.
+func nestedMapsNoCopy(obj map[string]interface{}, fields ...string) []map[string]interface{} {
+	var cur interface{} = obj
+	for _, field := range fields {
+		m, ok := cur.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		cur = m[field]
+	}
+
+	s, ok := cur.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	var out []map[string]interface{}
+	for _, item := range s {
+		if m, ok := item.(map[string]interface{}); ok {
+			out = append(out, m)
+		}
+	}
+
+	return out
+}
+
+// nestedMapNoCopy returns the map at a path in obj, without copying it.
+func nestedMapNoCopy(obj map[string]interface{}, fields ...string) (map[string]interface{}, bool) {
+	var cur interface{} = obj
+	for _, field := range fields {
+		m, ok := cur.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		cur = m[field]
+	}
+
+	m, ok := cur.(map[string]interface{})
+	return m, ok
+}
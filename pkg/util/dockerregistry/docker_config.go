@@ -0,0 +1,145 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package dockerregistry
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// dockerHubHosts are the names docker config files use for Docker Hub.
+var dockerHubHosts = []string{"index.docker.io", "docker.io", defaultRegistry}
+
+// dockerConfig is the part of a docker config file that holds registry
+// credentials, as written by `docker login`.
+type dockerConfig struct {
+	Auths      map[string]dockerConfigAuth `json:"auths"`
+	CredsStore string                      `json:"credsStore"`
+}
+
+type dockerConfigAuth struct {
+	Auth     string `json:"auth"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// defaultDockerConfigPath returns the path of the docker config file, which
+// is in $DOCKER_CONFIG if it is set, and in ~/.docker otherwise.
+func defaultDockerConfigPath() string {
+	if dir := os.Getenv("DOCKER_CONFIG"); dir != "" {
+		return filepath.Join(dir, "config.json")
+	}
+
+	home := os.Getenv("HOME")
+	if home == "" {
+		return ""
+	}
+
+	return filepath.Join(home, ".docker", "config.json")
+}
+
+// readDockerConfig reads a docker config file. A missing file is an empty
+// configuration, so registries are accessed anonymously.
+func readDockerConfig(path string) (*dockerConfig, error) {
+	config := &dockerConfig{}
+	if path == "" {
+		return config, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return config, nil
+		}
+		return nil, errors.Wrapf(err, "reading docker config %s", path)
+	}
+
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, errors.Wrapf(err, "parsing docker config %s", path)
+	}
+
+	return config, nil
+}
+
+// credentials returns the username and password stored for a registry host.
+// Credential stores and helpers aren't supported, so only credentials stored
+// in the file itself are found.
+func (c *dockerConfig) credentials(registry string) (string, string, error) {
+	hosts := []string{registry}
+	for _, h := range dockerHubHosts {
+		if h == registry {
+			hosts = dockerHubHosts
+			break
+		}
+	}
+
+	for key, auth := range c.Auths {
+		if !containsHost(hosts, dockerConfigHost(key)) {
+			continue
+		}
+
+		if auth.Auth == "" {
+			if auth.Username == "" && c.CredsStore != "" {
+				log.Debugf("credentials for %s are in credential store %q, which isn't supported", registry, c.CredsStore)
+			}
+			return auth.Username, auth.Password, nil
+		}
+
+		decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+		if err != nil {
+			return "", "", errors.Wrapf(err, "decoding docker credentials for %s", key)
+		}
+
+		parts := strings.SplitN(string(decoded), ":", 2)
+		if len(parts) != 2 {
+			return "", "", errors.Errorf("docker credentials for %s are not in the form <username>:<password>", key)
+		}
+
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", nil
+}
+
+// dockerConfigHost returns the host of a key of a docker config's auths,
+// which can be a host or a URL such as https://index.docker.io/v1/.
+func dockerConfigHost(key string) string {
+	if strings.Contains(key, "://") {
+		if u, err := url.Parse(key); err == nil {
+			return u.Host
+		}
+	}
+
+	return strings.SplitN(key, "/", 2)[0]
+}
+
+func containsHost(hosts []string, host string) bool {
+	for _, h := range hosts {
+		if h == host {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,101 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package dockerregistry
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_readDockerConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "docker-config")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	auth := base64.StdEncoding.EncodeToString([]byte("hub-user:hub:pass"))
+	data := `{
+  "auths": {
+    "https://index.docker.io/v1/": {"auth": "` + auth + `"},
+    "registry.example.com:5000": {"username": "user", "password": "pass"},
+    "broken.example.com": {"auth": "not base64"}
+  }
+}`
+	path := filepath.Join(dir, "config.json")
+	require.NoError(t, ioutil.WriteFile(path, []byte(data), 0600))
+
+	config, err := readDockerConfig(path)
+	require.NoError(t, err)
+
+	cases := []struct {
+		name     string
+		registry string
+		username string
+		password string
+		isErr    bool
+	}{
+		{
+			name:     "docker hub",
+			registry: defaultRegistry,
+			username: "hub-user",
+			password: "hub:pass",
+		},
+		{
+			name:     "username and password",
+			registry: "registry.example.com:5000",
+			username: "user",
+			password: "pass",
+		},
+		{
+			name:     "no credentials",
+			registry: "quay.io",
+		},
+		{
+			name:     "invalid auth",
+			registry: "broken.example.com",
+			isErr:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			username, password, err := config.credentials(tc.registry)
+			if tc.isErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.username, username)
+			assert.Equal(t, tc.password, password)
+		})
+	}
+}
+
+func Test_readDockerConfig_missing(t *testing.T) {
+	config, err := readDockerConfig(filepath.Join("missing", "config.json"))
+	require.NoError(t, err)
+
+	username, password, err := config.credentials(defaultRegistry)
+	require.NoError(t, err)
+	assert.Empty(t, username)
+	assert.Empty(t, password)
+}
@@ -63,13 +63,16 @@ func (n ImageName) String() string {
 	return buf.String()
 }
 
-// RegistryRepoName returns the "repository" as used in the registry URL
+// RegistryRepoName returns the "repository" as used in the registry URL.
+// Official images on Docker Hub are in the "library" repository.
 func (n ImageName) RegistryRepoName() string {
-	repo := n.Repository
-	if repo == "" {
-		repo = "library"
+	if n.Repository == "" {
+		if n.Registry != "" {
+			return n.Name
+		}
+		return fmt.Sprintf("library/%s", n.Name)
 	}
-	return fmt.Sprintf("%s/%s", repo, n.Name)
+	return fmt.Sprintf("%s/%s", n.Repository, n.Name)
 }
 
 // RegistryURL returns the deduced base URL of the registry for this image
@@ -81,23 +84,28 @@ func (n ImageName) RegistryURL() string {
 	return fmt.Sprintf("https://%s", reg)
 }
 
-// ParseImageName parses a docker image into an ImageName struct
+// ParseImageName parses a docker image into an ImageName struct. The first
+// path segment is the registry if it looks like a host, i.e. it contains a
+// "." or ":" or is "localhost", like docker does. The repository may have
+// any number of path segments.
 func ParseImageName(image string) (ImageName, error) {
 	ret := ImageName{}
 
-	if parts := strings.Split(image, "/"); len(parts) == 1 {
-		ret.Name = parts[0]
-	} else if len(parts) == 2 {
-		ret.Repository = parts[0]
-		ret.Name = parts[1]
-	} else if len(parts) == 3 {
+	parts := strings.Split(image, "/")
+	for _, part := range parts {
+		if part == "" {
+			return ret, fmt.Errorf("Malformed docker image name: %s", image)
+		}
+	}
+
+	if len(parts) > 1 && isRegistryHost(parts[0]) {
 		ret.Registry = parts[0]
-		ret.Repository = parts[1]
-		ret.Name = parts[2]
-	} else {
-		return ret, fmt.Errorf("Malformed docker image name: %s", image)
+		parts = parts[1:]
 	}
 
+	ret.Repository = strings.Join(parts[:len(parts)-1], "/")
+	ret.Name = parts[len(parts)-1]
+
 	if parts := strings.Split(ret.Name, "@"); len(parts) == 2 {
 		ret.Name = parts[0]
 		ret.Digest = parts[1]
@@ -113,3 +121,9 @@ func ParseImageName(image string) (ImageName, error) {
 
 	return ret, nil
 }
+
+// isRegistryHost returns true if the first path segment of an image name is
+// a registry host rather than part of a Docker Hub repository.
+func isRegistryHost(s string) bool {
+	return strings.ContainsAny(s, ".:") || s == "localhost"
+}
@@ -55,6 +55,7 @@ func TestImageName_String(t *testing.T) {
 func TestImageName_RegistryRepoName(t *testing.T) {
 	cases := []struct {
 		name     string
+		registry string
 		repoName string
 		expected string
 	}{
@@ -67,11 +68,17 @@ func TestImageName_RegistryRepoName(t *testing.T) {
 			name:     "without repo name",
 			expected: "library/bar",
 		},
+		{
+			name:     "without repo name on other registry",
+			registry: "localhost:5000",
+			expected: "bar",
+		},
 	}
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			in := ImageName{
+				Registry:   tc.registry,
 				Repository: tc.repoName,
 				Name:       "bar",
 			}
@@ -140,7 +147,11 @@ func TestParseImageName(t *testing.T) {
 			expected: "foo/bar/baz:latest",
 		},
 		{
-			name:  "foo/bar/baz/qux",
+			name:     "foo/bar/baz/qux",
+			expected: "foo/bar/baz/qux:latest",
+		},
+		{
+			name:  "foo//bar",
 			isErr: true,
 		},
 		{
@@ -163,3 +174,54 @@ func TestParseImageName(t *testing.T) {
 		})
 	}
 }
+
+func TestParseImageName_registry(t *testing.T) {
+	cases := []struct {
+		name     string
+		expected ImageName
+	}{
+		{
+			name: "foo/bar/baz",
+			expected: ImageName{
+				Repository: "foo/bar",
+				Name:       "baz",
+				Tag:        "latest",
+			},
+		},
+		{
+			name: "gcr.io/org/team/app:1",
+			expected: ImageName{
+				Registry:   "gcr.io",
+				Repository: "org/team",
+				Name:       "app",
+				Tag:        "1",
+			},
+		},
+		{
+			name: "localhost:5000/app",
+			expected: ImageName{
+				Registry: "localhost:5000",
+				Name:     "app",
+				Tag:      "latest",
+			},
+		},
+		{
+			name: "localhost/team/app@sha256:abcde",
+			expected: ImageName{
+				Registry:   "localhost",
+				Repository: "team",
+				Name:       "app",
+				Digest:     "sha256:abcde",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseImageName(tc.name)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
@@ -150,10 +150,18 @@ func parseAuthHeader(header http.Header) []*authChallenge {
 
 // NewAuthTransport returns a roundtripper that does bearer/etc authentication
 func NewAuthTransport(inner http.RoundTripper) http.RoundTripper {
+	return newAuthTransport(inner, "", "")
+}
+
+// newAuthTransport returns an authTransport that authenticates with a
+// username and password, if they are set.
+func newAuthTransport(inner http.RoundTripper, username, password string) *authTransport {
 	return &authTransport{
 		Transport:  inner,
 		Client:     &http.Client{Transport: inner},
 		tokenCache: map[string]string{},
+		Username:   username,
+		Password:   password,
 	}
 }
 
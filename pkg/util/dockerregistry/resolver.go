@@ -75,20 +75,23 @@ type ResolverClient interface {
 
 // DefaultResolverClient resolves digests for a docker image.
 type DefaultResolverClient struct {
-	clientFactory func() *http.Client
+	clientFactory    func(username, password string) *http.Client
+	dockerConfigPath string
 }
 
 var _ ResolverClient = (*DefaultResolverClient)(nil)
 
-// NewDefaultDigester creates an instance of DefaultDigester.
+// NewDefaultDigester creates an instance of DefaultDigester. Registries are
+// authenticated with the credentials in the local docker config file.
 func NewDefaultDigester() *DefaultResolverClient {
 	return &DefaultResolverClient{
-		clientFactory: func() *http.Client {
+		clientFactory: func(username, password string) *http.Client {
 			return &http.Client{
-				Transport: NewAuthTransport(http.DefaultTransport),
+				Transport: newAuthTransport(http.DefaultTransport, username, password),
 				Timeout:   15 * time.Second,
 			}
 		},
+		dockerConfigPath: defaultDockerConfigPath(),
 	}
 }
 
@@ -100,7 +103,22 @@ func (d *DefaultResolverClient) ManifestV2Digest(image string) (string, error) {
 		return "", errors.Wrap(err, "parsing image name")
 	}
 
-	client := d.clientFactory()
+	config, err := readDockerConfig(d.dockerConfigPath)
+	if err != nil {
+		return "", err
+	}
+
+	registry := n.Registry
+	if registry == "" {
+		registry = defaultRegistry
+	}
+
+	username, password, err := config.credentials(registry)
+	if err != nil {
+		return "", err
+	}
+
+	client := d.clientFactory(username, password)
 
 	resolver := newRegistryResolver(client)
 	if err = resolver.Resolve(&n); err != nil {
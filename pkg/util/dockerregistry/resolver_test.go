@@ -65,7 +65,7 @@ func Test_defaultDigester_ManifestV2Digest(t *testing.T) {
 			defer ts.Close()
 
 			d := NewDefaultDigester()
-			d.clientFactory = func() *http.Client {
+			d.clientFactory = func(username, password string) *http.Client {
 				tr := &http.Transport{
 					TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
 				}
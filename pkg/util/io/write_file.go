@@ -0,0 +1,54 @@
+// Copyright 2018 The ksonnet authors
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package io
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/afero"
+)
+
+// WriteFileAtomic writes data to a temporary file next to path, and renames it
+// to path, so readers never see a partially written file.
+func WriteFileAtomic(fs afero.Fs, path string, data []byte, perm os.FileMode) error {
+	dir := filepath.Dir(path)
+	f, err := afero.TempFile(fs, dir, "."+filepath.Base(path)+".tmp-")
+	if err != nil {
+		return err
+	}
+
+	// The name of a file may include the base path of the fs, e.g. with
+	// afero.BasePathFs, so the temporary file is named relative to dir.
+	tmpPath := filepath.Join(dir, filepath.Base(f.Name()))
+
+	_, err = f.Write(data)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = fs.Chmod(tmpPath, perm)
+	}
+	if err == nil {
+		err = fs.Rename(tmpPath, path)
+	}
+	if err != nil {
+		fs.Remove(tmpPath)
+		return err
+	}
+
+	return nil
+}
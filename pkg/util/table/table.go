@@ -21,6 +21,7 @@ import (
 	"io"
 	"strings"
 
+	"github.com/ghodss/yaml"
 	"github.com/pkg/errors"
 )
 
@@ -37,6 +38,8 @@ const (
 	FormatTable Format = iota
 	// FormatJSON prints JSON.
 	FormatJSON
+	// FormatYAML prints YAML, with the same structure as JSON.
+	FormatYAML
 )
 
 // DefaultFormat is the default format for output. It is a table.
@@ -47,6 +50,8 @@ func DetectFormat(formatName string) (Format, error) {
 	switch formatName {
 	case "json":
 		return FormatJSON, nil
+	case "yaml":
+		return FormatYAML, nil
 	case "", "table":
 		return FormatTable, nil
 	default:
@@ -110,25 +115,29 @@ func (t *Table) Render() error {
 		return t.renderTable()
 	case FormatJSON:
 		return t.renderJSON()
+	case FormatYAML:
+		return t.renderYAML()
 	}
 }
 
-// jsonOutput is the structure for printing JSON output.
+// jsonOutput is the structure for printing JSON and YAML output.
 type jsonOutput struct {
 	Kind string              `json:"kind"`
 	Data []map[string]string `json:"data"`
 }
 
-func (t *Table) renderJSON() error {
+// structuredOutput converts the table to the structure printed as JSON or
+// YAML. Each row is keyed by the header.
+func (t *Table) structuredOutput() (*jsonOutput, error) {
 	if len(t.header) == 0 {
-		return errors.New("headers aren't defined for output")
+		return nil, errors.New("headers aren't defined for output")
 	}
 
 	out := make([]map[string]string, 0)
 	for _, row := range t.rows {
 		m := make(map[string]string)
 		if len(t.header) != len(row) {
-			return errors.New("header length doesn't match row length")
+			return nil, errors.New("header length doesn't match row length")
 		}
 
 		for i, header := range t.header {
@@ -138,15 +147,37 @@ func (t *Table) renderJSON() error {
 		out = append(out, m)
 	}
 
+	return &jsonOutput{
+		Kind: t.Name,
+		Data: out,
+	}, nil
+}
+
+func (t *Table) renderJSON() error {
+	jo, err := t.structuredOutput()
+	if err != nil {
+		return err
+	}
+
 	encoder := json.NewEncoder(t.w)
 	encoder.SetIndent("", "\t")
 
-	jo := jsonOutput{
-		Kind: t.Name,
-		Data: out,
+	return encoder.Encode(jo)
+}
+
+func (t *Table) renderYAML() error {
+	jo, err := t.structuredOutput()
+	if err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(jo)
+	if err != nil {
+		return errors.Wrap(err, "converting output to YAML")
 	}
 
-	return encoder.Encode(&jo)
+	_, err = t.w.Write(data)
+	return err
 }
 
 func (t *Table) renderTable() error {
@@ -39,6 +39,11 @@ func TestDetectFormat(t *testing.T) {
 			formatName: "json",
 			expected:   FormatJSON,
 		},
+		{
+			name:       "yaml",
+			formatName: "yaml",
+			expected:   FormatYAML,
+		},
 		{
 			name:       "table",
 			formatName: "table",
@@ -90,6 +95,12 @@ func TestTable(t *testing.T) {
 			rw:     &bytes.Buffer{},
 			output: "output.json",
 		},
+		{
+			name:   "YAML format",
+			format: FormatYAML,
+			rw:     &bytes.Buffer{},
+			output: "output.yaml",
+		},
 		{
 			name:   "unknown format",
 			format: Format(99),
@@ -151,6 +162,11 @@ func TestTable_no_header(t *testing.T) {
 			format: FormatJSON,
 			isErr:  true,
 		},
+		{
+			name:   "in YAML format",
+			format: FormatYAML,
+			isErr:  true,
+		},
 	}
 
 	for _, tc := range cases {
@@ -193,6 +209,10 @@ func TestTable_header_and_row_length_must_match(t *testing.T) {
 			name:   "in JSON format",
 			format: FormatJSON,
 		},
+		{
+			name:   "in YAML format",
+			format: FormatYAML,
+		},
 	}
 
 	for _, tc := range cases {
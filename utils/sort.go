@@ -67,6 +67,26 @@ func (l DependencyOrder) Less(i, j int) bool {
 	return depTier(l[i].GetObjectKind()) < depTier(l[j].GetObjectKind())
 }
 
+// DependencyTiers groups objects that are sorted in DependencyOrder into
+// tiers. Objects in a tier have no known dependencies on each other, and only
+// depend on objects in earlier tiers.
+func DependencyTiers(objs []*unstructured.Unstructured) [][]*unstructured.Unstructured {
+	var tiers [][]*unstructured.Unstructured
+
+	lastTier := -1
+	for _, obj := range objs {
+		tier := depTier(obj.GetObjectKind())
+		if len(tiers) == 0 || tier != lastTier {
+			tiers = append(tiers, nil)
+			lastTier = tier
+		}
+
+		tiers[len(tiers)-1] = append(tiers[len(tiers)-1], obj)
+	}
+
+	return tiers
+}
+
 // AlphabeticalOrder is a `sort.Interface` that sorts the
 // objects by namespace/name/kind alphabetical order
 type AlphabeticalOrder []*unstructured.Unstructured
@@ -52,6 +52,36 @@ func TestDepSort(t *testing.T) {
 	}
 }
 
+func TestDependencyTiers(t *testing.T) {
+	newObj := func(apiVersion, kind string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": apiVersion,
+				"kind":       kind,
+			},
+		}
+	}
+
+	objs := []*unstructured.Unstructured{
+		newObj("v1", "Namespace"),
+		newObj("v1", "ConfigMap"),
+		newObj("v1", "Service"),
+		newObj("extensions/v1beta1", "Deployment"),
+	}
+
+	expected := [][]*unstructured.Unstructured{
+		{objs[0]},
+		{objs[1], objs[2]},
+		{objs[3]},
+	}
+
+	tiers := DependencyTiers(objs)
+
+	if !reflect.DeepEqual(tiers, expected) {
+		t.Errorf("actual != expected: %v != %v", tiers, expected)
+	}
+}
+
 func TestAlphaSort(t *testing.T) {
 	newObj := func(ns, name, kind string) *unstructured.Unstructured {
 		o := unstructured.Unstructured{}